@@ -10,15 +10,206 @@ const (
 	HintSizeName   = "HINT_SIZE"
 	PublicOpsName  = "PUBLIC_OPERATIONS"
 
-	OperationLogin        = "auth:Login"
-	OperationRefreshToken = "auth:RefreshAccessToken"
-	AccessTokenPath       = "/"
-	RefreshTokenPath      = "/access-token/refresh"
+	WebhookMaxAttemptsName = "WEBHOOK_MAX_ATTEMPTS"
+	WebhookTimeoutName     = "WEBHOOK_TIMEOUT"
+
+	ScimTokenName = "SCIM_TOKEN"
+
+	ImpersonationTtlName = "IMPERSONATION_TTL"
+
+	LoginMaxAttemptsName   = "LOGIN_MAX_ATTEMPTS"
+	LoginIpMaxAttemptsName = "LOGIN_IP_MAX_ATTEMPTS"
+	LoginAttemptWindowName = "LOGIN_ATTEMPT_WINDOW"
+	LoginLockoutName       = "LOGIN_LOCKOUT"
+
+	TotpIssuerName = "TOTP_ISSUER"
+
+	// SmtpHostName sets the SMTP server delivering emails. Emails are only
+	// logged if it is empty.
+	SmtpHostName     = "SMTP_HOST"
+	SmtpPortName     = "SMTP_PORT"
+	SmtpUsernameName = "SMTP_USERNAME"
+	SmtpPasswordName = "SMTP_PASSWORD"
+	MailFromName     = "MAIL_FROM"
+
+	PasswordMinLengthName = "PASSWORD_MIN_LENGTH"
+	PasswordMaxLengthName = "PASSWORD_MAX_LENGTH"
+	// PasswordClassesName lists the character classes required in passwords,
+	// separated by comma, of the PasswordClass* values below. Set it to
+	// `none` to require none.
+	PasswordClassesName  = "PASSWORD_CHARACTER_CLASSES"
+	PasswordClassUpper   = "upper"
+	PasswordClassLower   = "lower"
+	PasswordClassDigit   = "digit"
+	PasswordClassSpecial = "special"
+	// PasswordRejectListName is the path to a file of common or breached
+	// passwords, one per line, which are never accepted.
+	PasswordRejectListName = "PASSWORD_REJECT_LIST"
+	// PasswordHistoryName is the number of recent passwords, including the
+	// current one, that can't be chosen again. Zero disables the history.
+	PasswordHistoryName = "PASSWORD_HISTORY"
+
+	// PasswordHasherName selects the algorithm hashing new passwords, one of
+	// the PasswordHasher* values below. Hashes of the other algorithms are
+	// still verified, and replaced on the next login. Parameters of argon2id
+	// are set by the `PASSWORD_HASH_*` variables of go-utils.
+	PasswordHasherName     = "PASSWORD_HASHER"
+	PasswordHasherArgon2id = "argon2id"
+	PasswordHasherBcrypt   = "bcrypt"
+	PasswordHasherScrypt   = "scrypt"
+	BcryptCostName         = "BCRYPT_COST"
+	// ScryptCostName is the base 2 logarithm of the scrypt CPU/memory cost.
+	ScryptCostName = "SCRYPT_COST"
+
+	PasswordResetTtlName = "PASSWORD_RESET_TTL"
+	// PasswordResetUrlName sets the page of the frontend where users enter
+	// the new password, the token is appended as the `token` query parameter.
+	PasswordResetUrlName = "PASSWORD_RESET_URL"
+
+	// LoginIdentifierName selects the identifiers accepted by login, one of
+	// the LoginIdentifier* values below.
+	LoginIdentifierName     = "LOGIN_IDENTIFIER"
+	LoginIdentifierUsername = "username"
+	LoginIdentifierEmail    = "email"
+	LoginIdentifierAny      = "any"
+
+	// LdapUrlName sets the directory authenticating users without local
+	// account, such as `ldaps://ldap.example.com`. LDAP is disabled if empty.
+	LdapUrlName          = "LDAP_URL"
+	LdapStartTlsName     = "LDAP_START_TLS"
+	LdapBindDnName       = "LDAP_BIND_DN"
+	LdapBindPasswordName = "LDAP_BIND_PASSWORD"
+	LdapBaseDnName       = "LDAP_BASE_DN"
+	// LdapUserFilterName is the search filter finding the user, where
+	// `{username}` is replaced by the login identifier.
+	LdapUserFilterName        = "LDAP_USER_FILTER"
+	LdapUsernameAttributeName = "LDAP_USERNAME_ATTRIBUTE"
+	LdapEmailAttributeName    = "LDAP_EMAIL_ATTRIBUTE"
+	LdapGroupAttributeName    = "LDAP_GROUP_ATTRIBUTE"
+	// LdapAttributesName maps directory attributes to user attributes, as a
+	// JSON object such as `{"departmentNumber": "dept"}`. Only integer values
+	// are mapped.
+	LdapAttributesName = "LDAP_ATTRIBUTES"
+	LdapTimeoutName    = "LDAP_TIMEOUT"
+	// LdapOrganizationName is the ID of the organization the users of the
+	// directory belong to, the default organization if empty. Logins to other
+	// organizations aren't tried with the directory.
+	LdapOrganizationName = "LDAP_ORGANIZATION"
+	// GroupRoleRulesName maps groups of external users to local roles, as a
+	// JSON array such as `[{"group": "cn=admins,dc=example,dc=com", "roles":
+	// ["admin"]}]`. Roles named in the rules are granted and revoked on every
+	// login of external users, other roles are left alone.
+	GroupRoleRulesName = "GROUP_ROLE_RULES"
+	// OidcProvidersName configures the OpenID Connect providers users can
+	// sign in with, as a JSON array of OidcProvider.
+	OidcProvidersName = "OIDC_PROVIDERS"
+
+	// OauthSigningKeyName is the PEM encoded RSA private key signing ID
+	// tokens issued to OAuth clients. A key is generated on first use if it
+	// is empty, so ID tokens can't be verified after restarts, nor across
+	// instances.
+	OauthSigningKeyName = "OAUTH_SIGNING_KEY"
+	// OauthLoginUrlName sets the page of the frontend where users sign in
+	// before authorizing OAuth clients, the authorization URL is appended as
+	// the `return_to` query parameter. Users without session are sent back to
+	// the client with the `login_required` error if it is empty.
+	OauthLoginUrlName = "OAUTH_LOGIN_URL"
+
+	// OAuth 2.0 grant types supported by the token endpoint.
+	OauthGrantAuthorizationCode = "authorization_code"
+	OauthGrantRefreshToken      = "refresh_token"
+	OauthGrantClientCredentials = "client_credentials"
+	// OauthClientAssertionJwtBearer is the RFC 7523 client assertion type of
+	// clients authenticating with a JWT signed with their key.
+	OauthClientAssertionJwtBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	// ServiceSubjectPrefix prefixes the `sub` claim of the tokens issued to
+	// service accounts, telling service principals from users.
+	ServiceSubjectPrefix = "svc:"
+
+	// DefaultOrganizationId is the organization that users and roles belong to
+	// unless specified otherwise.
+	DefaultOrganizationId = 1
+	// RootRoleId is the role holding all permissions, its holders cannot be
+	// impersonated.
+	RootRoleId = 1
+	// PlatformPermissionPrefix prefixes the permissions of platform
+	// administrators, which only they can grant.
+	PlatformPermissionPrefix = "platform:"
+	// PermissionCrossTenant allows platform administrators to work across
+	// organizations.
+	PermissionCrossTenant = PlatformPermissionPrefix + "CrossTenant"
+
+	OperationLogin               = "auth:Login"
+	OperationRefreshToken        = "auth:RefreshAccessToken"
+	OperationVerifyTotp          = "auth:VerifyTotp"
+	OperationBeginWebauthnLogin  = "auth:BeginWebauthnLogin"
+	OperationFinishWebauthnLogin = "auth:FinishWebauthnLogin"
+	OperationForgotPassword      = "auth:ForgotPassword"
+	OperationResetPassword       = "auth:ResetPassword"
+	OperationBeginOidcLogin      = "auth:BeginOidcLogin"
+	OperationFinishOidcLogin     = "auth:FinishOidcLogin"
+	OperationOauthAuthorize      = "auth:OauthAuthorize"
+	OperationOauthToken          = "auth:OauthToken"
+	OperationOauthUserinfo       = "auth:OauthUserinfo"
+	OperationOauthJwks           = "auth:OauthJwks"
+	OperationOpenidConfiguration = "auth:GetOpenidConfiguration"
+
+	AccessTokenPath  = "/"
+	RefreshTokenPath = "/access-token/refresh"
+
+	EventUserCreated            = "user.created"
+	EventUserDeleted            = "user.deleted"
+	EventUserRolesChanged       = "user.roles_changed"
+	EventRolePermissionsChanged = "role.permissions_changed"
+	EventTokenRevoked           = "token.revoked"
+
+	AuditRoleAssignmentExpired = "role_assignment.expired"
+	AuditAccessRequestApproved = "access_request.approved"
+	AuditAccessRequestDenied   = "access_request.denied"
+	AuditUserImpersonated      = "user.impersonated"
+	AuditUserLocked            = "user.locked"
+	AuditUserUnlocked          = "user.unlocked"
+	AuditLoginIpLocked         = "login.ip_locked"
+	AuditTotpEnabled           = "user.totp_enabled"
+	AuditTotpDisabled          = "user.totp_disabled"
+	AuditRecoveryCodeUsed      = "user.recovery_code_used"
+	AuditWebauthnRegistered    = "user.passkey_registered"
+	AuditPasswordChanged       = "user.password_changed"
+	AuditPasswordSet           = "user.password_set"
+	AuditPasswordResetSent     = "user.password_reset_sent"
+	AuditPasswordReset         = "user.password_reset"
+	AuditUserProvisioned       = "user.provisioned"
+	AuditIdentityLinked        = "user.identity_linked"
+	AuditServiceAccountCreated = "service_account.created"
+	AuditServiceAccountDeleted = "service_account.deleted"
+	AuditSessionsRevoked       = "user.sessions_revoked"
+	AuditTokensRevoked         = "user.tokens_revoked"
+	AuditUserDisabled          = "user.disabled"
+	AuditUserEnabled           = "user.enabled"
+	AuditUserRestored          = "user.restored"
+	AuditScimTokenIssued       = "organization.scim_token_issued"
+	AuditScimTokenRevoked      = "organization.scim_token_revoked"
 )
 
 var (
 	Log = utils.NewLogger()
 
+	// WebhookEvents lists event types that webhooks can subscribe to.
+	WebhookEvents = []string{
+		EventUserCreated,
+		EventUserDeleted,
+		EventUserRolesChanged,
+		EventRolePermissionsChanged,
+		EventTokenRevoked,
+	}
+
+	// OauthGrantTypes lists grant types that OAuth clients can use.
+	OauthGrantTypes = []string{
+		OauthGrantAuthorizationCode,
+		OauthGrantRefreshToken,
+		OauthGrantClientCredentials,
+	}
+
 	ResponseMessageCredentialNotFound interface{} = "invalid credentials"
 
 	ResponseMessageCredentialsInvalid interface{} = "invalid credentials"
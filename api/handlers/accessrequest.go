@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Converts the access request entity to its API representation.
+func accessRequestRead(ar *ent.AccessRequest) AccessRequestRead {
+	return AccessRequestRead{
+		Id:             ar.ID,
+		OrganizationId: ar.OrganizationID,
+		RequesterId:    ar.RequesterID,
+		RoleId:         ar.RoleID,
+		Justification:  ar.Justification,
+		Duration:       ar.Duration,
+		Status:         AccessRequestReadStatus(ar.Status),
+		ReviewerId:     ar.ReviewerID,
+		ReviewComment:  &ar.ReviewComment,
+		ReviewedAt:     ar.ReviewedAt,
+		CreatedAt:      ar.CreatedAt,
+	}
+}
+
+// Reviews a pending access request on behalf of the current user, who must be
+// an approver of the requested role other than the requester. Approving the
+// request assigns the role to the requester, limited to the requested
+// duration if any. Either way, the review is recorded in the audit log.
+// Impersonators can't review requests, or they could approve their own.
+func (s Server) reviewAccessRequest(
+	ctx context.Context, id uint64, status accessrequest.Status,
+	comment *string,
+) (*ent.AccessRequest, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		return nil, err
+	}
+	// the review is the approver's own decision, not an impersonator's
+	if nil != token.actor {
+		return nil, errAccessDenied
+	}
+	scope, err := s.accessRequestScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	me := token.user.ID
+	ar, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			ar, err := tx.AccessRequest.Query().
+				Where(accessrequest.IDEQ(id), scope).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			if ar.RequesterID == me {
+				return nil, errAccessDenied
+			}
+			approver, err := tx.Role.Query().Where(
+				role.IDEQ(ar.RoleID), role.HasApproversWith(user.IDEQ(me)),
+			).Exist(qc)
+			if err != nil {
+				return nil, err
+			}
+			if !approver {
+				return nil, errAccessDenied
+			}
+			now := time.Now()
+			// only pending requests can be reviewed, concurrent reviews of
+			// the same request are resolved by this condition.
+			n, err := tx.AccessRequest.Update().Where(
+				accessrequest.IDEQ(ar.ID),
+				accessrequest.StatusEQ(accessrequest.StatusPending),
+			).SetStatus(status).SetReviewerID(me).SetReviewedAt(now).
+				SetNillableReviewComment(comment).Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			if 0 == n {
+				return nil, errNotPending
+			}
+			action := api.AuditAccessRequestDenied
+			if accessrequest.StatusApproved == status {
+				action = api.AuditAccessRequestApproved
+				var expiresAt *time.Time
+				if nil != ar.Duration {
+					t := now.Add(time.Duration(*ar.Duration) * time.Second)
+					expiresAt = &t
+				}
+				err = assignRoles(
+					qc, tx, ar.RequesterID, []uint32{ar.RoleID}, nil,
+					expiresAt,
+				)
+				if err != nil {
+					return nil, err
+				}
+				err = checkSeparationOfDuties(qc, tx, ar.RequesterID)
+				if err != nil {
+					return nil, err
+				}
+				err = userRolesChanged(qc, tx, ar.RequesterID)
+				if err != nil {
+					return nil, err
+				}
+			}
+			err = recordAudit(
+				qc, tx, action, ar.OrganizationID, token,
+				map[string]interface{}{
+					"access_request_id": ar.ID,
+					"requester_id":      ar.RequesterID,
+					"role_id":           ar.RoleID,
+					"duration":          ar.Duration,
+					"comment":           comment,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			return tx.AccessRequest.Get(qc, ar.ID)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ar.(*ent.AccessRequest), nil
+}
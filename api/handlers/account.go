@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Whether the user is disabled or deleted, which can't authenticate until an
+// administrator enables or restores them. Locked users are only kept from
+// signing in by the login lockout, their tokens are still accepted, so failed
+// logins of others can't sign them out.
+func accountClosed(u *ent.User) bool {
+	return user.StatusDisabled == u.Status || user.StatusDeleted == u.Status
+}
+
+// Sets the user to the `to` state if it's in one of the `from` states, returns
+// errInvalidState otherwise.
+func setAccountStatus(
+	qc context.Context, tx *ent.Tx, u *ent.User, to user.Status,
+	from ...user.Status,
+) error {
+	if !slices.Contains(from, u.Status) {
+		return errInvalidState
+	}
+	return tx.User.UpdateOne(u).SetStatus(to).Exec(qc)
+}
+
+// Restores the soft deleted user, setting it active.
+func restoreUser(qc context.Context, tx *ent.Tx, id uint64) error {
+	return tx.User.UpdateOneID(id).Where(user.DeletedAtNotNil()).
+		ClearDeletedAt().SetStatus(user.StatusActive).Exec(qc)
+}
+
+// Revokes the tokens of the user and soft deletes it. The user is marked
+// deleted, so its remaining tokens are rejected even where the soft delete
+// interceptor is bypassed.
+func deleteUser(qc context.Context, tx *ent.Tx, id uint64) error {
+	if err := revokeUserTokens(qc, tx, id); err != nil {
+		return err
+	}
+	err := tx.User.UpdateOneID(id).SetStatus(user.StatusDeleted).Exec(qc)
+	if err != nil {
+		return err
+	}
+	return tx.User.DeleteOneID(id).Exec(qc)
+}
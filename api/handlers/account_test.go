@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+var accountStates = []user.Status{
+	user.StatusActive, user.StatusDisabled, user.StatusDeleted,
+	user.StatusLocked,
+}
+
+// Puts the user in the state without touching its tokens, locked users are
+// locked out for an hour too. Deleted users aren't soft deleted, so that the
+// state is checked without the soft delete interceptor.
+func setAccountState(
+	tb testing.TB, svr *Server, db *ent.Client, id uint64, status user.Status,
+) {
+	qc := context.Background()
+	db.User.UpdateOneID(id).SetStatus(status).ExecX(qc)
+	if user.StatusLocked == status {
+		require.Nil(
+			tb, svr.loginAttempts.Lock(
+				qc, userAttemptKey(id), time.Now().Add(time.Hour),
+			),
+		)
+	}
+}
+
+// Creates the user "test" with password "Test_123".
+func createTestUser(tb testing.TB, svr *Server, db *ent.Client) *ent.User {
+	u, err := svr.createUser(
+		context.Background(), db.User.Create(),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(tb, err)
+	return u
+}
+
+func Test_Login_by_account_state(t *testing.T) {
+	expected := map[user.Status]int{
+		user.StatusActive:   http.StatusOK,
+		user.StatusDisabled: http.StatusUnauthorized,
+		user.StatusDeleted:  http.StatusUnauthorized,
+		user.StatusLocked:   http.StatusTooManyRequests,
+	}
+	for _, status := range accountStates {
+		t.Run(
+			string(status), func(t *testing.T) {
+				svr, engine, db, _ := setupTestCase(t, true)
+				u := createTestUser(t, svr, db)
+				setAccountState(t, svr, db, u.ID, status)
+				res := tryLogin(t, svr, engine, "test", "Test_123")
+				require.Equal(t, expected[status], res.Code)
+			},
+		)
+	}
+}
+
+func Test_checkToken_by_account_state(t *testing.T) {
+	accepted := map[user.Status]bool{
+		user.StatusActive:   true,
+		user.StatusDisabled: false,
+		user.StatusDeleted:  false,
+		// failed logins of others mustn't sign the user out
+		user.StatusLocked: true,
+	}
+	for _, status := range accountStates {
+		t.Run(
+			string(status), func(t *testing.T) {
+				svr, engine, db, _ := setupTestCase(t, true)
+				grantPermissions(t, db, 2, "auth:ListUser")
+				u := getUserById(t, db, 2)
+				at, rt := startTestSession(t, svr, u, "test agent")
+				jti, pt, err := svr.issuePersonalToken(
+					u, []string{"auth:ListUser"}, time.Hour,
+				)
+				require.Nil(t, err)
+				bin, err := jti.MarshalBinary()
+				require.Nil(t, err)
+				db.PersonalToken.Create().SetToken(bin).SetUserID(2).
+					SetDescription("test").ExecX(context.Background())
+				setAccountState(t, svr, db, u.ID, status)
+				ok, refreshed := http.StatusOK, http.StatusNoContent
+				if !accepted[status] {
+					ok = http.StatusUnauthorized
+					refreshed = http.StatusUnauthorized
+				}
+				require.Equal(t, ok, getUsersWithCookie(t, engine, at).Code)
+				require.Equal(
+					t, ok, getUsersWithToken(t, svr, engine, pt).Code,
+				)
+				require.Equal(
+					t, refreshed, refreshWithCookie(t, engine, rt).Code,
+				)
+			},
+		)
+	}
+}
+
+func Test_operationAllowed_by_account_state(t *testing.T) {
+	expected := map[user.Status]error{
+		user.StatusActive:   nil,
+		user.StatusDisabled: errAccessDenied,
+		user.StatusDeleted:  errAccessDenied,
+		user.StatusLocked:   nil,
+	}
+	for _, status := range accountStates {
+		t.Run(
+			string(status), func(t *testing.T) {
+				svr, _, db, _ := setupTestCase(t, true)
+				setAccountState(t, svr, db, 1, status)
+				err := svr.operationAllowed(
+					getUserById(t, db, 1), "auth:ListUser",
+				)
+				require.Equal(t, expected[status], err)
+			},
+		)
+	}
+}
+
+func Test_VerifyTotp_by_account_state(t *testing.T) {
+	expected := map[user.Status]int{
+		user.StatusActive:   http.StatusOK,
+		user.StatusDisabled: http.StatusUnauthorized,
+		user.StatusDeleted:  http.StatusUnauthorized,
+		user.StatusLocked:   http.StatusTooManyRequests,
+	}
+	for _, status := range accountStates {
+		t.Run(
+			string(status), func(t *testing.T) {
+				svr, engine, db, _ := setupTestCase(t, true)
+				u, secret, challenge := loginWithTotp(t, svr, engine, db)
+				setAccountState(t, svr, db, u.ID, status)
+				res := tryTotp(
+					t, svr, engine, challenge, currentTotp(t, secret),
+				)
+				require.Equal(t, expected[status], res.Code)
+			},
+		)
+	}
+}
+
+func Test_FinishWebauthnLogin_by_account_state(t *testing.T) {
+	expected := map[user.Status]int{
+		user.StatusActive:   http.StatusOK,
+		user.StatusDisabled: http.StatusUnauthorized,
+		user.StatusDeleted:  http.StatusUnauthorized,
+		user.StatusLocked:   http.StatusTooManyRequests,
+	}
+	for _, status := range accountStates {
+		t.Run(
+			string(status), func(t *testing.T) {
+				svr, engine, db, _ := setupTestCase(t, true)
+				u, a := createPasskeyUser(t, svr, engine, db)
+				setAccountState(t, svr, db, u.ID, status)
+				res := tryPasskey(t, svr, engine, a)
+				require.Equal(t, expected[status], res.Code)
+			},
+		)
+	}
+}
+
+func Test_FinishOidcLogin_by_account_state(t *testing.T) {
+	expected := map[user.Status]int{
+		user.StatusActive:   http.StatusOK,
+		user.StatusDisabled: http.StatusUnauthorized,
+		user.StatusDeleted:  http.StatusUnauthorized,
+		// the provider authenticates, password lockouts don't apply
+		user.StatusLocked: http.StatusOK,
+	}
+	for _, status := range accountStates {
+		t.Run(
+			string(status), func(t *testing.T) {
+				svr, engine, db, _ := setupTestCase(t, true)
+				idp := useTestOidcIdp(t, svr)
+				res := tryOidcLogin(t, svr, engine, idp, aliceClaims())
+				require.Equal(t, http.StatusOK, res.Code)
+				u := getUserByName(t, db, "alice")
+				setAccountState(t, svr, db, u.ID, status)
+				res = tryOidcLogin(t, svr, engine, idp, aliceClaims())
+				require.Equal(t, expected[status], res.Code)
+			},
+		)
+	}
+}
+
+func Test_OauthToken_by_account_state(t *testing.T) {
+	expected := map[user.Status]int{
+		user.StatusActive:   http.StatusOK,
+		user.StatusDisabled: http.StatusBadRequest,
+		user.StatusDeleted:  http.StatusBadRequest,
+		user.StatusLocked:   http.StatusOK,
+	}
+	for _, status := range accountStates {
+		t.Run(
+			string(status), func(t *testing.T) {
+				svr, engine, db, _ := setupTestCase(t, true)
+				id := uint64(2)
+				c, secret := seedOauthClient(
+					t, db, false, &id, api.OauthGrantClientCredentials,
+				)
+				setAccountState(t, svr, db, id, status)
+				res := tryOauthToken(
+					t, engine, c, secret, url.Values{
+						"grant_type": {api.OauthGrantClientCredentials},
+					},
+				)
+				require.Equal(t, expected[status], res.Code)
+			},
+		)
+	}
+}
+
+func Test_Login_sets_locked_user_active_after_lockout(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u := createTestUser(t, svr, db)
+	db.User.UpdateOneID(u.ID).SetStatus(user.StatusLocked).
+		ExecX(context.Background())
+	res := tryLogin(t, svr, engine, "test", "Test_123")
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, user.StatusActive, getUserById(t, db, u.ID).Status)
+}
+
+func Test_loginFailed_sets_user_locked(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u := createTestUser(t, svr, db)
+	for i := 0; i < svr.loginMaxAttempts; i++ {
+		tryLogin(t, svr, engine, "test", "wrong")
+	}
+	require.Equal(t, user.StatusLocked, getUserById(t, db, u.ID).Status)
+}
+
+func Test_loginFailed_keeps_disabled_user_disabled(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	u := createTestUser(t, svr, db)
+	db.User.UpdateOneID(u.ID).SetStatus(user.StatusDisabled).
+		ExecX(context.Background())
+	for i := 0; i < svr.loginMaxAttempts; i++ {
+		require.Nil(
+			t, svr.loginFailed(context.Background(), "192.0.2.1", u),
+		)
+	}
+	require.Equal(t, user.StatusDisabled, getUserById(t, db, u.ID).Status)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+)
+
+// AddGroupMembers adds users to a group.
+//
+// Endpoint: POST /group/{id}/users
+func (s Server) AddGroupMembers(
+	ctx context.Context, request AddGroupMembersRequestObject,
+) (AddGroupMembersResponseObject, error) {
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("AddGroupMembers error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			g, err := tx.Group.Query().Where(group.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = checkTenantUsers(qc, tx, g.OrganizationID, *request.Body)
+			if err != nil {
+				return nil, err
+			}
+			err = tx.Group.UpdateOne(g).AddUserIDs(*request.Body...).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = checkSeparationOfDuties(qc, tx, *request.Body...)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range *request.Body {
+				if err = userRolesChanged(qc, tx, id); err != nil {
+					return nil, err
+				}
+			}
+			return nil, nil
+		},
+	)
+	if err != nil {
+		var sv *sodViolation
+		if errors.As(err, &sv) {
+			return AddGroupMembers409JSONResponse{N409JSONResponse: sv.response()}, nil
+		}
+		if ent.IsNotFound(err) {
+			return AddGroupMembers404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsConstraintError(err) || errors.Is(err, errCrossTenant) {
+			return AddGroupMembers400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("AddGroupMembers error: %v", err)
+		return nil, err
+	}
+	return AddGroupMembers204Response{}, nil
+}
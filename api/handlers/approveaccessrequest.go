@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+)
+
+// ApproveAccessRequest approves a pending access request, and assigns the
+// requested role to the requester.
+//
+// Endpoint: POST /access-request/{id}/approve
+func (s Server) ApproveAccessRequest(
+	ctx context.Context, request ApproveAccessRequestRequestObject,
+) (ApproveAccessRequestResponseObject, error) {
+	ar, err := s.reviewAccessRequest(
+		ctx, request.Id, accessrequest.StatusApproved, request.Body.Comment,
+	)
+	if err != nil {
+		var sv *sodViolation
+		if errors.As(err, &sv) {
+			return ApproveAccessRequest409JSONResponse{
+				N409JSONResponse: sv.response(),
+			}, nil
+		}
+		if errors.Is(err, errNotPending) {
+			return ApproveAccessRequest409JSONResponse{
+				N409JSONResponse: N409JSONResponse{
+					Code:   http.StatusConflict,
+					Errors: &msgNotPending,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if errors.Is(err, errAccessDenied) {
+			return ApproveAccessRequest403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsNotFound(err) {
+			return ApproveAccessRequest404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ApproveAccessRequest error: %v", err)
+		return nil, err
+	}
+	return ApproveAccessRequest200JSONResponse(accessRequestRead(ar)), nil
+}
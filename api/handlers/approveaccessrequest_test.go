@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+func Test_ApproveAccessRequest_approves_and_assigns_the_role(t *testing.T) {
+	comment := "approved"
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	ar := seedAccessRequest(t, db, 3, 5)
+	db.Role.UpdateOneID(5).AddApproverIDs(1).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/approve", ar.ID),
+		ApproveAccessRequestJSONBody{Comment: &comment},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ApproveAccessRequest200JSONResponse{}, res)
+	require.Equal(t, AccessRequestReadStatus("approved"), actual.Status)
+	require.Equal(t, uint64(1), *actual.ReviewerId)
+	require.Equal(t, comment, *actual.ReviewComment)
+	require.NotNil(t, actual.ReviewedAt)
+	row := db.UserRole.Query().
+		Where(userrole.UserIDEQ(3), userrole.RoleIDEQ(5)).OnlyX(qc)
+	require.Nil(t, row.ExpiresAt)
+	logs := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditAccessRequestApproved)).AllX(qc)
+	require.Len(t, logs, 1)
+	require.Equal(t, uint64(1), *logs[0].ActorID)
+	require.Equal(t, float64(ar.ID), logs[0].Details["access_request_id"])
+	require.Equal(t, float64(3), logs[0].Details["requester_id"])
+	require.Equal(t, comment, logs[0].Details["comment"])
+}
+
+func Test_ApproveAccessRequest_limits_assignment_to_requested_duration(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	ar := db.AccessRequest.Create().SetOrganizationID(1).SetRequesterID(3).
+		SetRoleID(5).SetJustification("justification").SetDuration(3600).
+		SaveX(qc)
+	db.Role.UpdateOneID(5).AddApproverIDs(1).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/approve", ar.ID),
+		ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	row := db.UserRole.Query().
+		Where(userrole.UserIDEQ(3), userrole.RoleIDEQ(5)).OnlyX(qc)
+	require.WithinDuration(
+		t, time.Now().Add(time.Hour), *row.ExpiresAt, time.Minute,
+	)
+}
+
+func Test_ApproveAccessRequest_reports_403_if_not_approver(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ar := seedAccessRequest(t, db, 3, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/approve", ar.ID),
+		ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.Equal(
+		t, accessrequest.StatusPending,
+		db.AccessRequest.GetX(context.Background(), ar.ID).Status,
+	)
+}
+
+func Test_ApproveAccessRequest_reports_403_if_own_request(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ar := seedAccessRequest(t, db, 1, 5)
+	db.Role.UpdateOneID(5).AddApproverIDs(1).ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/approve", ar.ID),
+		ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ApproveAccessRequest_reports_403_if_impersonated(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	grantPermissions(t, db, 2, "auth:ApproveAccessRequest")
+	db.Role.UpdateOneID(5).AddApproverIDs(2).ExecX(qc)
+	// the impersonator's own request, approved as the approver
+	ar := seedAccessRequest(t, db, 1, 5)
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.post(
+		fmt.Sprintf("/access-request/%d/approve", ar.ID),
+		ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.Equal(
+		t, accessrequest.StatusPending,
+		db.AccessRequest.GetX(qc, ar.ID).Status,
+	)
+}
+
+func Test_ApproveAccessRequest_reports_409_if_not_pending(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	ar := seedAccessRequest(t, db, 3, 5)
+	db.AccessRequest.UpdateOne(ar).SetStatus(accessrequest.StatusDenied).
+		ExecX(qc)
+	db.Role.UpdateOneID(5).AddApproverIDs(1).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/approve", ar.ID),
+		ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusConflict,
+		"errors": msgNotPending,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+	require.False(
+		t, db.UserRole.Query().
+			Where(userrole.UserIDEQ(3), userrole.RoleIDEQ(5)).ExistX(qc),
+	)
+}
+
+func Test_ApproveAccessRequest_reports_409_if_violates_sod_constraint(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	seedSodConstraint(t, db, 2, 5)
+	ar := seedAccessRequest(t, db, 2, 5)
+	db.Role.UpdateOneID(5).AddApproverIDs(1).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/approve", ar.ID),
+		ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	requireSodConflict(t, res, "role 0", "role 3")
+	require.Equal(
+		t, accessrequest.StatusPending, db.AccessRequest.GetX(qc, ar.ID).Status,
+	)
+	require.False(
+		t, db.UserRole.Query().
+			Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(5)).ExistX(qc),
+	)
+}
+
+func Test_ApproveAccessRequest_reports_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, "/access-request/12345/approve", ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ApproveAccessRequest_reports_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ar := seedAccessRequest(t, db, 3, 5)
+	_, u, _ := seedOrganization(t, db, "auth:ApproveAccessRequest")
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/approve", ar.ID),
+		ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ApproveAccessRequest_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post(
+		"/access-request/1/approve", ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ApproveAccessRequest_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.postAs(
+		u, "/access-request/1/approve", ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ApproveAccessRequest_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, "/access-request/1/approve", ApproveAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
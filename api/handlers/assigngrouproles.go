@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+)
+
+// AssignGroupRoles assigns roles to a group, and so to all of its members.
+//
+// Endpoint: POST /group/{id}/roles
+func (s Server) AssignGroupRoles(
+	ctx context.Context, request AssignGroupRolesRequestObject,
+) (AssignGroupRolesResponseObject, error) {
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("AssignGroupRoles error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			g, err := tx.Group.Query().Where(group.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = checkTenantRoles(qc, tx, g.OrganizationID, *request.Body)
+			if err != nil {
+				return nil, err
+			}
+			err = tx.Group.UpdateOne(g).AddRoleIDs(*request.Body...).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			users, err := g.QueryUsers().IDs(qc)
+			if err != nil {
+				return nil, err
+			}
+			if err = checkSeparationOfDuties(qc, tx, users...); err != nil {
+				return nil, err
+			}
+			for _, id := range users {
+				if err = userRolesChanged(qc, tx, id); err != nil {
+					return nil, err
+				}
+			}
+			return nil, nil
+		},
+	)
+	if err != nil {
+		var sv *sodViolation
+		if errors.As(err, &sv) {
+			return AssignGroupRoles409JSONResponse{N409JSONResponse: sv.response()}, nil
+		}
+		if ent.IsNotFound(err) {
+			return AssignGroupRoles404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsConstraintError(err) || errors.Is(err, errCrossTenant) {
+			return AssignGroupRoles400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("AssignGroupRoles error: %v", err)
+		return nil, err
+	}
+	return AssignGroupRoles204Response{}, nil
+}
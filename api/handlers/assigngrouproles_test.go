@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+)
+
+func Test_AssignGroupRoles_attaches_roles_to_group(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, []uint32{2})
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, fmt.Sprintf("/group/%d/roles", g.ID), []uint32{3, 4})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Equal(
+		t, []uint32{2, 3, 4},
+		db.Group.Query().Where(group.IDEQ(g.ID)).QueryRoles().
+			Order(role.ByID()).IDsX(context.Background()),
+	)
+}
+
+func Test_AssignGroupRoles_reports_409_if_violates_sod_constraint(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 5)
+	g := seedGroup(t, db, []uint64{2}, nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, fmt.Sprintf("/group/%d/roles", g.ID), []uint32{5})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	requireSodConflict(t, res, "role 0", "role 3")
+}
+
+func Test_AssignGroupRoles_reports_400_if_role_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, fmt.Sprintf("/group/%d/roles", g.ID), []uint32{12345})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_AssignGroupRoles_reports_400_if_role_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	_, _, r := seedOrganization(t, db)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, fmt.Sprintf("/group/%d/roles", g.ID), []uint32{r.ID})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_AssignGroupRoles_reports_404_if_group_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/group/12345/roles", []uint32{2})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_AssignGroupRoles_reports_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/group/1/roles", []uint32{2})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_AssignGroupRoles_reports_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.postAs(u, "/group/1/roles", []uint32{2})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_AssignGroupRoles_reports_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/group/1/roles", []uint32{2})
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+// number of expired assignments to remove in one sweep transaction
+const assignmentSweepBatchSize = 100
+
+// Returns the predicate matching role assignments in effect at the moment.
+func activeAssignment() predicate.UserRole {
+	return userrole.And(
+		userrole.Or(userrole.StartsAtIsNil(), userrole.StartsAtLTE(time.Now())),
+		unexpiredAssignment(),
+	)
+}
+
+// Returns the predicate matching role assignments that have not expired,
+// including those not yet in effect.
+func unexpiredAssignment() predicate.UserRole {
+	return userrole.Or(
+		userrole.ExpiresAtIsNil(), userrole.ExpiresAtGT(time.Now()),
+	)
+}
+
+// Assigns the roles to the user within the given time window. Existing
+// assignments of the same roles are replaced, so that their windows are
+// updated. Nil `startsAt` and `expiresAt` mean the assignment is in effect
+// immediately and indefinitely, respectively.
+func assignRoles(
+	qc context.Context, tx *ent.Tx, id uint64, roles []uint32,
+	startsAt, expiresAt *time.Time,
+) error {
+	_, err := tx.UserRole.Delete().
+		Where(userrole.UserIDEQ(id), userrole.RoleIDIn(roles...)).Exec(qc)
+	if err != nil {
+		return err
+	}
+	var creates []*ent.UserRoleCreate
+	var seen []uint32
+	for _, r := range roles {
+		if slices.Contains(seen, r) {
+			continue
+		}
+		seen = append(seen, r)
+		creates = append(
+			creates, tx.UserRole.Create().SetUserID(id).SetRoleID(r).
+				SetNillableStartsAt(startsAt).SetNillableExpiresAt(expiresAt),
+		)
+	}
+	return tx.UserRole.CreateBulk(creates...).Exec(qc)
+}
+
+// SweepExpiredAssignments removes expired role assignments every `interval`,
+// until the context is cancelled. It is meant to be run in its own goroutine.
+func (s Server) SweepExpiredAssignments(
+	ctx context.Context, interval time.Duration,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.sweepExpiredAssignments(ctx); err != nil {
+			api.Log.Errorf("assignment sweep error: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweepExpiredAssignments removes all expired role assignments. Each removal
+// is recorded in the audit log, and affected users' roles changed events are
+// emitted.
+func (s Server) sweepExpiredAssignments(ctx context.Context) error {
+	for {
+		n, err := s.sweepExpiredAssignmentBatch(ctx)
+		if err != nil {
+			return err
+		}
+		if n < assignmentSweepBatchSize {
+			return nil
+		}
+	}
+}
+
+// sweepExpiredAssignmentBatch removes a batch of expired role assignments.
+// Returns the number of assignments removed.
+func (s Server) sweepExpiredAssignmentBatch(ctx context.Context) (int, error) {
+	n, err := s.db.Transaction(
+		ctx, func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			rows, err := tx.UserRole.Query().WithRole().
+				Where(userrole.ExpiresAtLTE(time.Now())).
+				Order(userrole.ByID()).Limit(assignmentSweepBatchSize).
+				All(qc)
+			if err != nil {
+				return 0, err
+			}
+			var users []uint64
+			for _, row := range rows {
+				err = tx.UserRole.DeleteOne(row).Exec(qc)
+				if err != nil {
+					return 0, err
+				}
+				err = recordAudit(
+					qc, tx, api.AuditRoleAssignmentExpired,
+					row.Edges.Role.OrganizationID, nil,
+					map[string]interface{}{
+						"user_id":    row.UserID,
+						"role_id":    row.RoleID,
+						"role":       row.Edges.Role.Name,
+						"starts_at":  row.StartsAt,
+						"expires_at": row.ExpiresAt,
+					},
+				)
+				if err != nil {
+					return 0, err
+				}
+				if !slices.Contains(users, row.UserID) {
+					users = append(users, row.UserID)
+				}
+			}
+			for _, id := range users {
+				if err = userRolesChanged(qc, tx, id); err != nil {
+					return 0, err
+				}
+			}
+			return len(rows), nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return n.(int), nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eidng8/go-utils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+	"github.com/eidng8/go-attr-rbac/ent/webhookdelivery"
+)
+
+func Test_loadRoles_excludes_inactive_assignments(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(2)).
+		SetStartsAt(time.Now().Add(time.Hour)).ExecX(qc)
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(3)).
+		SetExpiresAt(time.Now().Add(-time.Second)).ExecX(qc)
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(4)).
+		SetStartsAt(time.Now().Add(-time.Hour)).
+		SetExpiresAt(time.Now().Add(time.Hour)).ExecX(qc)
+	u := getUserById(t, db, 2)
+	require.Nil(t, svr.loadRoles(u))
+	require.Equal(t, []uint32{4}, utils.Pluck(u.Edges.Roles, pluckRoleId))
+}
+
+func Test_sweepExpiredAssignments_removes_expired_assignments(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(3)).
+		SetExpiresAt(time.Now().Add(-time.Second)).ExecX(qc)
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(4)).
+		SetExpiresAt(time.Now().Add(time.Hour)).ExecX(qc)
+	seedWebhook(t, db, "https://example.com/hook", api.EventUserRolesChanged)
+	require.Nil(t, svr.sweepExpiredAssignments(qc))
+	rows := db.UserRole.Query().Where(userrole.UserIDEQ(2)).
+		Order(userrole.ByRoleID()).AllX(qc)
+	require.Equal(
+		t, []uint32{2, 4},
+		utils.Pluck(rows, func(r *ent.UserRole) uint32 { return r.RoleID }),
+	)
+	logs := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditRoleAssignmentExpired)).AllX(qc)
+	require.Len(t, logs, 1)
+	require.Nil(t, logs[0].ActorID)
+	require.Equal(t, float64(2), logs[0].Details["user_id"])
+	require.Equal(t, float64(3), logs[0].Details["role_id"])
+	require.True(
+		t, db.WebhookDelivery.Query().
+			Where(webhookdelivery.EventEQ(api.EventUserRolesChanged)).
+			ExistX(qc),
+	)
+}
+
+func Test_sweepExpiredAssignments_returns_error_if_db_error(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	svr.db = useEmptyDb(t)
+	require.NotNil(t, svr.sweepExpiredAssignments(context.Background()))
+}
@@ -2,27 +2,62 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/role"
 )
 
 // AssignPermissions assigns permissions to a role.
 //
 // Endpoint: POST /role/{id}/permissions
 func (s Server) AssignPermissions(
-	_ context.Context, request AssignPermissionsRequestObject,
+	ctx context.Context, request AssignPermissionsRequestObject,
 ) (AssignPermissionsResponseObject, error) {
-	_, err := s.db.Transaction(
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("AssignPermissions error: %v", err)
+		return nil, err
+	}
+	scope, err := s.roleScope(ctx)
+	if err != nil {
+		api.Log.Debugf("AssignPermissions error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
-			return nil, tx.Role.UpdateOneID(request.Id).
+			_, err := tx.Role.Query().Where(role.IDEQ(request.Id), scope).
+				OnlyID(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = checkTenantPermissions(qc, tx, tenant, *request.Body)
+			if err != nil {
+				return nil, err
+			}
+			err = tx.Role.UpdateOneID(request.Id).
 				AddPermissionIDs(*request.Body...).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, emitRolePermissionsChanged(qc, tx, request.Id)
 		},
 	)
 	if err != nil {
-		if ent.IsForeignKeyError(err) {
+		if errors.Is(err, errAccessDenied) {
+			return AssignPermissions403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		// roles of other organizations are reported the same as non-existing
+		if ent.IsForeignKeyError(err) || ent.IsNotFound(err) {
 			return AssignPermissions400JSONResponse{
 				N400JSONResponse: N400JSONResponse{
 					Code:   http.StatusBadRequest,
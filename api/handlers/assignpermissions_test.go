@@ -2,12 +2,15 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/eidng8/go-utils"
 	"github.com/stretchr/testify/require"
 
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/permission"
 	"github.com/eidng8/go-attr-rbac/ent/role"
 )
@@ -32,6 +35,51 @@ func Test_AssignPermissions_attaches_permission_to_role(t *testing.T) {
 	require.Equal(t, ids, utils.Pluck(rows, pluckPermissionId))
 }
 
+// Returns the ID of the permission of the name.
+func getPermissionId(tb testing.TB, db *ent.Client, name string) uint32 {
+	id, err := db.Permission.Query().Where(permission.NameEQ(name)).
+		OnlyID(context.Background())
+	require.Nil(tb, err)
+	return id
+}
+
+func Test_AssignPermissions_attaches_platform_permission_if_cross_tenant(
+	t *testing.T,
+) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, _, r := seedOrganization(t, db)
+	id := getPermissionId(t, db, api.PermissionCrossTenant)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), fmt.Sprintf("/role/%d/permissions", r.ID),
+		[]uint32{id},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+}
+
+func Test_AssignPermissions_returns_403_if_tenant_grants_platform_permission(
+	t *testing.T,
+) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, r := seedOrganization(t, db, "auth:AssignPermissions")
+	id := getPermissionId(t, db, api.PermissionCrossTenant)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/role/%d/permissions", r.ID), []uint32{id},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.False(
+		t, db.Role.Query().Where(role.IDEQ(r.ID)).QueryPermissions().
+			Where(permission.IDEQ(id)).ExistX(context.Background()),
+	)
+	u.Edges.Roles = nil
+	require.ErrorIs(
+		t, svr.operationAllowed(u, api.PermissionCrossTenant), errAccessDenied,
+	)
+}
+
 func Test_AssignPermissions_reports_422_if_permission_is_empty(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	rows, err := db.Role.Query().Where(role.IDEQ(2)).QueryPermissions().
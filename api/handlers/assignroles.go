@@ -2,25 +2,68 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"time"
 
+	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
 )
 
-// AssignRoles assigns roles to a user.
+// AssignRoles assigns roles to a user, optionally limited to the time window
+// given by the `starts_at` and `expires_at` parameters. Assigning a role that
+// is already assigned replaces its time window.
 //
 // Endpoint: POST /users/{id}/roles
 func (s Server) AssignRoles(
-	_ context.Context, request AssignRolesRequestObject,
+	ctx context.Context, request AssignRolesRequestObject,
 ) (AssignRolesResponseObject, error) {
-	_, err := s.db.Transaction(
+	startsAt, expiresAt := request.Params.StartsAt, request.Params.ExpiresAt
+	if nil != expiresAt && (!expiresAt.After(time.Now()) ||
+		nil != startsAt && !expiresAt.After(*startsAt)) {
+		return AssignRoles400JSONResponse{
+			N400JSONResponse: N400JSONResponse{
+				Code:   http.StatusBadRequest,
+				Errors: &msgInvalidPeriod,
+				Status: msgError,
+			},
+		}, nil
+	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("AssignRoles error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
-			return nil, tx.User.UpdateOneID(request.Id).
-				AddRoleIDs(*request.Body...).Exec(qc)
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = checkTenantRoles(qc, tx, u.OrganizationID, *request.Body)
+			if err != nil {
+				return nil, err
+			}
+			err = assignRoles(
+				qc, tx, u.ID, *request.Body, startsAt, expiresAt,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if err = checkSeparationOfDuties(qc, tx, u.ID); err != nil {
+				return nil, err
+			}
+			return nil, userRolesChanged(qc, tx, request.Id)
 		},
 	)
 	if err != nil {
+		var sv *sodViolation
+		if errors.As(err, &sv) {
+			return AssignRoles409JSONResponse{N409JSONResponse: sv.response()}, nil
+		}
 		// with soft delete, there's an extra select query to check if the user
 		// was soft-deleted, so there will be an 404 error if the user was
 		// soft-deleted or does not exist.
@@ -33,7 +76,7 @@ func (s Server) AssignRoles(
 				},
 			}, nil
 		}
-		if ent.IsForeignKeyError(err) {
+		if ent.IsForeignKeyError(err) || errors.Is(err, errCrossTenant) {
 			return AssignRoles400JSONResponse{
 				N400JSONResponse: N400JSONResponse{
 					Code:   http.StatusBadRequest,
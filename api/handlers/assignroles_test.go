@@ -2,14 +2,18 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/eidng8/go-utils"
 	"github.com/stretchr/testify/require"
 
 	"github.com/eidng8/go-attr-rbac/ent/role"
 	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
 )
 
 func Test_AssignRoles_attaches_role_to_user(t *testing.T) {
@@ -31,6 +35,85 @@ func Test_AssignRoles_attaches_role_to_user(t *testing.T) {
 	require.Equal(t, ids, utils.Pluck(rows, pluckRoleId))
 }
 
+func Test_AssignRoles_limits_assignment_to_period(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	startsAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	expiresAt := startsAt.Add(time.Hour)
+	usr := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		usr, "/user/2/roles?"+url.Values{
+			"starts_at":  {startsAt.Format(time.RFC3339)},
+			"expires_at": {expiresAt.Format(time.RFC3339)},
+		}.Encode(), []uint32{2, 5},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	rows := db.UserRole.Query().
+		Where(userrole.UserIDEQ(2), userrole.RoleIDIn(2, 5)).
+		AllX(context.Background())
+	require.Len(t, rows, 2)
+	for _, row := range rows {
+		require.True(t, startsAt.Equal(*row.StartsAt))
+		require.True(t, expiresAt.Equal(*row.ExpiresAt))
+	}
+	u := getUserById(t, db, 2)
+	require.Nil(t, svr.loadRoles(u))
+	require.Equal(t, []uint32{3, 4}, utils.Pluck(u.Edges.Roles, pluckRoleId))
+}
+
+func Test_AssignRoles_reports_400_if_period_is_invalid(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	tests := []struct {
+		name   string
+		params url.Values
+	}{
+		{
+			"expired",
+			url.Values{
+				"expires_at": {now.Add(-time.Hour).Format(time.RFC3339)},
+			},
+		},
+		{
+			"expires before start",
+			url.Values{
+				"starts_at":  {now.Add(2 * time.Hour).Format(time.RFC3339)},
+				"expires_at": {now.Add(time.Hour).Format(time.RFC3339)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				svr, engine, db, res := setupTestCase(t, false)
+				usr := getUserById(t, db, 1)
+				req, err := svr.postAs(
+					usr, "/user/3/roles?"+tt.params.Encode(), []uint32{2},
+				)
+				require.Nil(t, err)
+				engine.ServeHTTP(res, req)
+				require.Equal(t, http.StatusBadRequest, res.Code)
+				require.Contains(t, res.Body.String(), "invalid_period")
+			},
+		)
+	}
+}
+
+func Test_AssignRoles_reports_409_if_violates_sod_constraint(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 5)
+	usr := getUserById(t, db, 1)
+	req, err := svr.postAs(usr, "/user/2/roles", []uint32{5})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	requireSodConflict(t, res, "role 0", "role 3")
+	require.False(
+		t, db.UserRole.Query().
+			Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(5)).
+			ExistX(context.Background()),
+	)
+}
+
 func Test_AssignRoles_reports_422_if_role_is_empty(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	usr := getUserById(t, db, 1)
@@ -49,6 +132,21 @@ func Test_AssignRoles_reports_404_if_user_not_found(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, res.Code)
 }
 
+func Test_AssignRoles_reports_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	roles := db.UserRole.Query().Where(userrole.UserIDEQ(a.UserID))
+	before := roles.Clone().CountX(context.Background())
+	req, err := svr.postAs(
+		getUserById(t, db, 1), fmt.Sprintf("/user/%d/roles", a.UserID),
+		[]int{1},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Equal(t, before, roles.CountX(context.Background()))
+}
+
 func Test_AssignRoles_reports_400_if_role_not_found(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	usr := getUserById(t, db, 1)
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// recordAudit appends an entry to the audit log within the transaction.
+// `token` is the access token of the request making the change, nil for
+// system changes. Both identities are recorded if the token was issued by
+// impersonation.
+func recordAudit(
+	qc context.Context, tx *ent.Tx, action string, organization uint32,
+	token *jwtToken, details map[string]interface{},
+) error {
+	create := tx.AuditLog.Create().SetAction(action).
+		SetOrganizationID(organization).SetDetails(details)
+	if nil != token {
+		create.SetActorID(token.user.ID)
+		if nil != token.actor {
+			create.SetImpersonatorID(token.actor.ID)
+		}
+	}
+	return create.Exec(qc)
+}
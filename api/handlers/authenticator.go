@@ -0,0 +1,309 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/eidng8/go-utils"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+// Authenticator verifies login credentials with an identity provider other
+// than the local accounts, such as a directory. Users it authenticates are
+// created locally on their first login, and their passwords are verified by
+// it from then on. Each authenticator serves one organization, logins to
+// other organizations never reach it.
+type Authenticator interface {
+	// Name identifies the provider. It is stored with the users provisioned
+	// by the authenticator, so it must not change.
+	Name() string
+	// Tenant is the ID of the organization the users of the provider belong
+	// to.
+	Tenant() uint32
+	// Authenticate verifies the password of the user identified by
+	// `identifier`. It returns errUnknownIdentity if the provider doesn't
+	// know the user, and errInvalidCredential if the password is wrong.
+	Authenticate(
+		ctx context.Context, identifier, password string,
+	) (*Identity, error)
+}
+
+// Identity is a user authenticated by an Authenticator.
+type Identity struct {
+	// Username of the local user.
+	Username string
+	// Email address, optional.
+	Email string
+	// Groups the user belongs to, mapped to roles by GroupRoleRule.
+	Groups []string
+	// Attributes replacing the same attributes of the local user.
+	Attr map[string]interface{}
+}
+
+// GroupRoleRule grants roles to external users belonging to a group.
+type GroupRoleRule struct {
+	// Group as reported by the authenticator, compared case-insensitively.
+	Group string `json:"group"`
+	// Names of the roles, in the organization of the user.
+	Roles []string `json:"roles"`
+}
+
+var (
+	errUnknownIdentity   = errors.New("unknown_identity")
+	errInvalidCredential = errors.New("invalid_credential")
+)
+
+// UseAuthenticators replaces the external authenticators of the server. Those
+// of the login organization are tried in order when the login identifier
+// matches no local user.
+func (s *Server) UseAuthenticators(authenticators ...Authenticator) {
+	s.authenticators = authenticators
+}
+
+// UseGroupRoleRules replaces the rules mapping groups of external users to
+// roles.
+func (s *Server) UseGroupRoleRules(rules ...GroupRoleRule) {
+	s.groupRoleRules = rules
+}
+
+// Authenticates the credentials with the external authenticators of the
+// organization in order, and creates the local user authenticated. Returns
+// errUnknownIdentity if no authenticator knows the identifier.
+func (s Server) loginExternal(
+	qc context.Context, organization uint32, identifier, password string,
+) (*ent.User, error) {
+	for _, a := range s.authenticators {
+		if a.Tenant() != organization {
+			continue
+		}
+		id, err := a.Authenticate(qc, identifier, password)
+		if errors.Is(err, errUnknownIdentity) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.provisionUser(qc, a.Tenant(), a.Name(), nil, id)
+	}
+	return nil, errUnknownIdentity
+}
+
+// Verifies the password of the external user with its authenticator, then
+// updates the user with the identity.
+func (s Server) verifyExternal(
+	qc context.Context, u *ent.User, identifier, password string,
+) (*ent.User, error) {
+	i := slices.IndexFunc(
+		s.authenticators, func(a Authenticator) bool {
+			return a.Name() == *u.AuthProvider &&
+				a.Tenant() == u.OrganizationID
+		},
+	)
+	if i < 0 {
+		api.Log.Debugf("authenticator %s not configured", *u.AuthProvider)
+		return nil, errInvalidCredential
+	}
+	id, err := s.authenticators[i].Authenticate(qc, identifier, password)
+	if errors.Is(err, errUnknownIdentity) {
+		return nil, errInvalidCredential
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the identifier may resolve to another user of the provider
+	if !strings.EqualFold(id.Username, u.Username) {
+		return nil, errInvalidCredential
+	}
+	return s.provisionUser(qc, u.OrganizationID, *u.AuthProvider, u, id)
+}
+
+// Creates the user authenticated by the provider if `u` is nil, otherwise
+// updates it. The email and attributes are taken from the identity, and the
+// roles are synchronized with the groups.
+func (s Server) provisionUser(
+	qc context.Context, organization uint32, provider string, u *ent.User,
+	id *Identity,
+) (*ent.User, error) {
+	res, err := s.db.Transaction(
+		qc, func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			return s.provision(qc, tx, organization, provider, u, id)
+		},
+	)
+	if err != nil {
+		return nil, provisionError(err)
+	}
+	return res.(*ent.User), nil
+}
+
+// Does the work of provisionUser within the transaction.
+func (s Server) provision(
+	qc context.Context, tx *ent.Tx, organization uint32, provider string,
+	u *ent.User, id *Identity,
+) (*ent.User, error) {
+	var err error
+	if nil == u {
+		u, err = s.createExternalUser(qc, tx, organization, provider, id)
+	} else {
+		u, err = updateExternalUser(qc, tx, u, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	changed, err := s.syncGroupRoles(qc, tx, u, id.Groups)
+	if err != nil || !changed {
+		return u, err
+	}
+	if err = checkSeparationOfDuties(qc, tx, u.ID); err != nil {
+		return nil, err
+	}
+	return u, userRolesChanged(qc, tx, u.ID)
+}
+
+// Maps the error of provisioning users.
+func provisionError(err error) error {
+	// the username is taken by another user
+	if ent.IsConstraintError(err) {
+		api.Log.Debugf("provisionUser error: %v", err)
+		return errInvalidCredential
+	}
+	return err
+}
+
+// Creates the external user with a random password, which is never used.
+func (s Server) createExternalUser(
+	qc context.Context, tx *ent.Tx, organization uint32, provider string,
+	id *Identity,
+) (*ent.User, error) {
+	password, err := utils.RandomPrintable(32)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := s.hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	create := tx.User.Create().SetOrganizationID(organization).
+		SetUsername(id.Username).SetPassword(hash).SetAuthProvider(provider)
+	if "" != id.Email {
+		create.SetEmail(id.Email)
+	}
+	if len(id.Attr) > 0 {
+		create.SetAttr(&id.Attr)
+	}
+	u, err := create.Save(qc)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{"user_id": u.ID, "username": u.Username}
+	err = emitEvent(qc, tx, organization, api.EventUserCreated, data)
+	if err != nil {
+		return nil, err
+	}
+	data["provider"] = provider
+	err = recordAudit(qc, tx, api.AuditUserProvisioned, organization, nil, data)
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// Updates the email and attributes of the external user, if they changed.
+func updateExternalUser(
+	qc context.Context, tx *ent.Tx, u *ent.User, id *Identity,
+) (*ent.User, error) {
+	update := tx.User.UpdateOne(u)
+	changed := false
+	if "" != id.Email && (nil == u.Email || *u.Email != id.Email) {
+		update.SetEmail(id.Email)
+		changed = true
+	}
+	attr := map[string]interface{}{}
+	if nil != u.Attr {
+		maps.Copy(attr, *u.Attr)
+	}
+	maps.Copy(attr, id.Attr)
+	if nil == u.Attr && len(attr) > 0 ||
+		nil != u.Attr && !maps.Equal(attr, *u.Attr) {
+		update.SetAttr(&attr)
+		changed = true
+	}
+	if !changed {
+		return u, nil
+	}
+	return update.Save(qc)
+}
+
+// Grants the roles of the rules matching the groups, and revokes the other
+// roles named in the rules. Roles not named in any rule are left alone.
+// Returns whether the assignments changed.
+func (s Server) syncGroupRoles(
+	qc context.Context, tx *ent.Tx, u *ent.User, groups []string,
+) (bool, error) {
+	var managed, granted []string
+	for _, rule := range s.groupRoleRules {
+		managed = append(managed, rule.Roles...)
+		if slices.ContainsFunc(
+			groups, func(g string) bool {
+				return strings.EqualFold(g, rule.Group)
+			},
+		) {
+			granted = append(granted, rule.Roles...)
+		}
+	}
+	if 0 == len(managed) {
+		return false, nil
+	}
+	roles, err := tx.Role.Query().Where(
+		role.OrganizationIDEQ(u.OrganizationID), role.NameIn(managed...),
+	).All(qc)
+	if err != nil {
+		return false, err
+	}
+	var grant, revoke []uint32
+	for _, r := range roles {
+		if slices.Contains(granted, r.Name) {
+			grant = append(grant, r.ID)
+		} else {
+			revoke = append(revoke, r.ID)
+		}
+	}
+	changed := false
+	if len(revoke) > 0 {
+		n, err := tx.UserRole.Delete().Where(
+			userrole.UserIDEQ(u.ID), userrole.RoleIDIn(revoke...),
+		).Exec(qc)
+		if err != nil {
+			return false, err
+		}
+		changed = n > 0
+	}
+	if 0 == len(grant) {
+		return changed, nil
+	}
+	active, err := tx.UserRole.Query().Where(
+		userrole.UserIDEQ(u.ID), userrole.RoleIDIn(grant...),
+		activeAssignment(),
+	).All(qc)
+	if err != nil {
+		return false, err
+	}
+	grant = slices.DeleteFunc(
+		grant, func(id uint32) bool {
+			return slices.ContainsFunc(
+				active, func(a *ent.UserRole) bool { return a.RoleID == id },
+			)
+		},
+	)
+	if 0 == len(grant) {
+		return changed, nil
+	}
+	// replaces assignments that are expired or not yet in effect
+	return true, assignRoles(qc, tx, u.ID, grant, nil, nil)
+}
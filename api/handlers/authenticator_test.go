@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+// Configures the server to authenticate with the directory, granting `role 1`
+// to admins and `role 2` to staff.
+func useTestDirectory(svr *Server, d *testDirectory) {
+	svr.UseAuthenticators(d.authenticator())
+	svr.UseGroupRoleRules(
+		GroupRoleRule{Group: testLdapAdminsGroup, Roles: []string{"role 1"}},
+		GroupRoleRule{Group: testLdapStaffGroup, Roles: []string{"role 2"}},
+	)
+}
+
+func getUserByName(tb testing.TB, db *ent.Client, username string) *ent.User {
+	u, err := db.User.Query().Where(user.UsernameEQ(username)).
+		Only(context.Background())
+	require.Nil(tb, err)
+	return u
+}
+
+// Returns the names of the roles assigned to the user.
+func assignedRoleNames(tb testing.TB, db *ent.Client, id uint64) []string {
+	names, err := db.Role.Query().
+		Where(role.HasAssignmentsWith(userrole.UserIDEQ(id))).
+		Order(role.ByName()).Select(role.FieldName).Strings(context.Background())
+	require.Nil(tb, err)
+	return names
+}
+
+func Test_Login_provisions_external_user(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	d := newTestDirectory(
+		t, newTestLdapUser("alice", "Alice_123", "7", testLdapAdminsGroup),
+	)
+	useTestDirectory(svr, d)
+	res := tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	at, rt := getTokensFromSetCookieHeaders(t, res)
+	require.NotNil(t, at)
+	require.NotNil(t, rt)
+	u := getUserByName(t, db, "alice")
+	require.Equal(t, "ldap", *u.AuthProvider)
+	require.Equal(t, "alice@example.com", *u.Email)
+	require.Equal(t, uint32(api.DefaultOrganizationId), u.OrganizationID)
+	require.Equal(t, map[string]interface{}{"dept": float64(7)}, *u.Attr)
+	require.Equal(t, []string{"role 1"}, assignedRoleNames(t, db, u.ID))
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditUserProvisioned)).
+		OnlyX(context.Background())
+	require.Nil(t, log.ActorID)
+	require.Equal(t, "ldap", log.Details["provider"])
+	require.Equal(t, float64(u.ID), log.Details["user_id"])
+	// the random local password is never accepted
+	m, _, err := svr.verifyPassword("Alice_123", u.Password)
+	require.Nil(t, err)
+	require.False(t, m)
+}
+
+func Test_Login_synchronizes_external_user(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	d := newTestDirectory(
+		t, newTestLdapUser("alice", "Alice_123", "7", testLdapAdminsGroup),
+	)
+	useTestDirectory(svr, d)
+	res := tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	u := getUserByName(t, db, "alice")
+	// roles not named in the rules are left alone
+	other := db.Role.Query().Where(role.NameEQ("role 3")).
+		OnlyX(context.Background())
+	db.UserRole.Create().SetUserID(u.ID).SetRoleID(other.ID).
+		ExecX(context.Background())
+	d.setEntries(
+		newTestLdapUser("alice", "Alice_456", "8", testLdapStaffGroup),
+	)
+	res = tryLogin(t, svr, engine, "alice", "Alice_456")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	u = getUserByName(t, db, "alice")
+	require.Equal(t, map[string]interface{}{"dept": float64(8)}, *u.Attr)
+	require.Equal(
+		t, []string{"role 2", "role 3"}, assignedRoleNames(t, db, u.ID),
+	)
+	require.Equal(
+		t, 1, db.AuditLog.Query().
+			Where(auditlog.ActionEQ(api.AuditUserProvisioned)).
+			CountX(context.Background()),
+	)
+}
+
+func Test_Login_returns_401_if_external_password_wrong(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	useTestDirectory(svr, d)
+	res := tryLogin(t, svr, engine, "alice", "Alice_456")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.False(
+		t, db.User.Query().Where(user.UsernameEQ("alice")).
+			ExistX(context.Background()),
+	)
+	res = tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	for i := 0; i < svr.loginMaxAttempts; i++ {
+		res = tryLogin(t, svr, engine, "alice", "Alice_456")
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+	res = tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusTooManyRequests, res.Code)
+}
+
+func Test_Login_prefers_local_user(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u := getUserById(t, db, 2)
+	setPassword(t, db, u.ID, "Local_123")
+	d := newTestDirectory(t, newTestLdapUser(u.Username, "Remote_123", "7"))
+	useTestDirectory(svr, d)
+	res := tryLogin(t, svr, engine, u.Username, "Remote_123")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	res = tryLogin(t, svr, engine, u.Username, "Local_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	require.Nil(t, getUserById(t, db, 2).AuthProvider)
+}
+
+func Test_Login_returns_401_if_external_user_removed(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, true)
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	useTestDirectory(svr, d)
+	res := tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	d.setEntries()
+	res = tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_Login_returns_401_if_authenticator_removed(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, true)
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	useTestDirectory(svr, d)
+	res := tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	svr.UseAuthenticators()
+	res = tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_Login_returns_401_if_username_taken(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u := getUserById(t, db, 2)
+	d := newTestDirectory(t, newTestLdapUser(u.Username, "Remote_123", "7"))
+	a := d.authenticator()
+	a.UserFilter = "(mail={username})"
+	svr.UseAuthenticators(a)
+	// the email doesn't match the local user, the username does
+	res := tryLogin(
+		t, svr, engine, u.Username+"@example.com", "Remote_123",
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Nil(t, getUserById(t, db, 2).AuthProvider)
+}
+
+func Test_Login_provisions_external_user_in_organization_of_authenticator(
+	t *testing.T,
+) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	o, _, _ := seedOrganization(t, db)
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	a := d.authenticator()
+	a.Organization = o.ID
+	svr.UseAuthenticators(a)
+	// logins to other organizations never reach the directory
+	res := tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.False(
+		t, db.User.Query().Where(user.UsernameEQ("alice")).
+			ExistX(context.Background()),
+	)
+	res = tryLoginTo(t, svr, engine, o.ID, "alice", "Alice_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	require.Equal(t, o.ID, getUserByName(t, db, "alice").OrganizationID)
+}
+
+func Test_Login_returns_401_if_authenticator_of_other_organization(
+	t *testing.T,
+) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	o, _, _ := seedOrganization(t, db)
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	useTestDirectory(svr, d)
+	res := tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	// the same directory serving another organization can't verify the user
+	a := d.authenticator()
+	a.Organization = o.ID
+	svr.UseAuthenticators(a)
+	res = tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_Login_returns_500_if_directory_unreachable(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	d := newTestDirectory(t)
+	a := d.authenticator()
+	a.Url = "ldap://127.0.0.1:1"
+	svr.UseAuthenticators(a)
+	res := tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func Test_ForgotPassword_skips_external_user(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, true)
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	useTestDirectory(svr, d)
+	res := tryLogin(t, svr, engine, "alice", "Alice_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	mails := recordMails(svr)
+	res = tryForgotPassword(t, svr, engine, "alice@example.com")
+	require.Equal(t, http.StatusAccepted, res.Code)
+	mails.none(t)
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/eidng8/go-utils"
+	"golang.org/x/oauth2"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// BeginOidcLogin starts signing in with an OpenID Connect provider. The user
+// is sent to the authorization URL, and the session token is kept by the
+// client until the provider redirects back to it.
+//
+// Endpoint: POST /login/oidc/{provider}
+func (s Server) BeginOidcLogin(
+	_ context.Context, request BeginOidcLoginRequestObject,
+) (BeginOidcLoginResponseObject, error) {
+	p := s.oidcProvider(request.Provider)
+	if nil == p {
+		return BeginOidcLogin404JSONResponse{
+			N404JSONResponse: N404JSONResponse{
+				Code:   http.StatusNotFound,
+				Errors: &msgNotFound,
+				Status: msgError,
+			},
+		}, nil
+	}
+	d, err := p.provider()
+	if err != nil {
+		api.Log.Debugf("BeginOidcLogin error: %v", err)
+		return nil, err
+	}
+	state, err := utils.RandomAlphaNum(32)
+	if err != nil {
+		api.Log.Debugf("BeginOidcLogin error: %v", err)
+		return nil, err
+	}
+	nonce, err := utils.RandomAlphaNum(32)
+	if err != nil {
+		api.Log.Debugf("BeginOidcLogin error: %v", err)
+		return nil, err
+	}
+	verifier := oauth2.GenerateVerifier()
+	st, err := sealState(
+		"oidc", oidcSession{
+			Provider: p.Name,
+			State:    state,
+			Nonce:    nonce,
+			Verifier: verifier,
+			Expires:  time.Now().Add(oidcTimeout),
+		},
+	)
+	if err != nil {
+		api.Log.Debugf("BeginOidcLogin error: %v", err)
+		return nil, err
+	}
+	return BeginOidcLogin200JSONResponse{
+		AuthorizationUrl: p.oauth2Config(d).AuthCodeURL(
+			state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier),
+		),
+		SessionToken: st,
+	}, nil
+}
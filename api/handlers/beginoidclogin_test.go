@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Starts signing in with the provider, returns the response.
+func tryBeginOidcLogin(
+	tb testing.TB, svr *Server, engine http.Handler, provider string,
+) *httptest.ResponseRecorder {
+	req, err := svr.post("/login/oidc/"+provider, nil)
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+// Starts signing in with the provider, requires success.
+func beginOidcLogin(
+	tb testing.TB, svr *Server, engine http.Handler, provider string,
+) BeginOidcLogin200JSONResponse {
+	res := tryBeginOidcLogin(tb, svr, engine, provider)
+	require.Equal(tb, http.StatusOK, res.Code, res.Body.String())
+	var body BeginOidcLogin200JSONResponse
+	require.Nil(tb, json.Unmarshal(res.Body.Bytes(), &body))
+	return body
+}
+
+func Test_BeginOidcLogin_returns_authorization_url(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := newTestOidcIdp(t)
+	svr.UseOidcProviders(idp.provider())
+	body := beginOidcLogin(t, svr, engine, "idp")
+	u, err := url.Parse(body.AuthorizationUrl)
+	require.Nil(t, err)
+	q := u.Query()
+	require.Equal(t, idp.server.URL+"/authorize", strings.Split(u.String(), "?")[0])
+	require.Equal(t, "openid profile email", q.Get("scope"))
+	require.Equal(t, "S256", q.Get("code_challenge_method"))
+	require.NotEmpty(t, q.Get("code_challenge"))
+	require.NotEmpty(t, q.Get("state"))
+	require.NotEmpty(t, q.Get("nonce"))
+	var session oidcSession
+	require.Nil(t, openState("oidc", body.SessionToken, &session))
+	require.Equal(t, "idp", session.Provider)
+	require.Equal(t, q.Get("state"), session.State)
+	require.Equal(t, q.Get("nonce"), session.Nonce)
+	// the verifier is never disclosed
+	require.NotContains(t, body.AuthorizationUrl, session.Verifier)
+	// each sign in has its own state
+	other := beginOidcLogin(t, svr, engine, "idp")
+	require.NotEqual(t, body.AuthorizationUrl, other.AuthorizationUrl)
+}
+
+func Test_BeginOidcLogin_returns_404_if_provider_unknown(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	svr.UseOidcProviders(newTestOidcIdp(t).provider())
+	res := tryBeginOidcLogin(t, svr, engine, "other")
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_BeginOidcLogin_returns_500_if_discovery_fails(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	p := newTestOidcIdp(t).provider()
+	p.Issuer = "http://127.0.0.1:1"
+	svr.UseOidcProviders(p)
+	res := tryBeginOidcLogin(t, svr, engine, "idp")
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
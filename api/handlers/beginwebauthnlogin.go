@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// BeginWebauthnLogin starts signing in with a passkey. The options are passed
+// to `navigator.credentials.get()`, the user is identified by the passkey the
+// user picks, so no username is needed.
+//
+// Endpoint: POST /login/webauthn
+func (s Server) BeginWebauthnLogin(
+	_ context.Context, _ BeginWebauthnLoginRequestObject,
+) (BeginWebauthnLoginResponseObject, error) {
+	wa, err := s.relyingParty()
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	assertion, session, err := wa.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	st, err := sealWebauthnSession(session)
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	options, err := webauthnOptions(assertion)
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	return BeginWebauthnLogin200JSONResponse{
+		SessionToken: st,
+		Options:      options,
+	}, nil
+}
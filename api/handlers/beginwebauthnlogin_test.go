@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BeginWebauthnLogin_returns_request_options(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	ceremony := beginPasskeyLogin(t, svr, engine)
+	require.NotEmpty(t, ceremony.SessionToken)
+	challenge, pk := ceremonyOptions(t, ceremony.Options)
+	require.NotEmpty(t, challenge)
+	require.Equal(t, "localhost", pk["rpId"])
+	require.Equal(t, "required", pk["userVerification"])
+	// the user is discovered from the passkey
+	require.Nil(t, pk["allowCredentials"])
+}
+
+func Test_BeginWebauthnLogin_returns_500_if_base_url_invalid(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/login/webauthn", nil)
+	require.Nil(t, err)
+	svr.baseUrl = "/"
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// BeginWebauthnRegistration starts registering a passkey for the current user.
+// The options are passed to `navigator.credentials.create()`, and the result
+// is sent to FinishWebauthnRegistration along with the session token.
+//
+// Endpoint: POST /me/webauthn/register
+func (s Server) BeginWebauthnRegistration(
+	ctx context.Context, _ BeginWebauthnRegistrationRequestObject,
+) (BeginWebauthnRegistrationResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	// passkeys belong to the user, not to an impersonator
+	if nil != token.actor {
+		return BeginWebauthnRegistration403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	wa, err := s.relyingParty()
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	u, err := loadWebauthnUser(context.Background(), s.db, token.user)
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	exclusions := make([]protocol.CredentialDescriptor, len(u.credentials))
+	for i, c := range u.WebAuthnCredentials() {
+		exclusions[i] = c.Descriptor()
+	}
+	creation, session, err := wa.BeginRegistration(
+		u,
+		// discoverable credentials, so that login doesn't need the username
+		webauthn.WithAuthenticatorSelection(
+			protocol.AuthenticatorSelection{
+				RequireResidentKey: protocol.ResidentKeyRequired(),
+				ResidentKey:        protocol.ResidentKeyRequirementRequired,
+				UserVerification:   protocol.VerificationRequired,
+			},
+		),
+		webauthn.WithExclusions(exclusions),
+	)
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	st, err := sealWebauthnSession(session)
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	options, err := webauthnOptions(creation)
+	if err != nil {
+		api.Log.Debugf("BeginWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	return BeginWebauthnRegistration200JSONResponse{
+		SessionToken: st,
+		Options:      options,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BeginWebauthnRegistration_returns_creation_options(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a := registerPasskey(t, svr, engine, db, 1)
+	req, err := svr.postAs(getUserById(t, db, 1), "/me/webauthn/register", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, BeginWebauthnRegistration200JSONResponse{}, res,
+	)
+	require.NotEmpty(t, actual.SessionToken)
+	_, pk := ceremonyOptions(t, actual.Options)
+	require.Equal(t, "localhost", pk["rp"].(map[string]interface{})["id"])
+	usr := pk["user"].(map[string]interface{})
+	require.Equal(
+		t, base64.RawURLEncoding.EncodeToString(webauthnUserHandle(1)),
+		usr["id"],
+	)
+	require.Equal(t, "root", usr["name"])
+	selection := pk["authenticatorSelection"].(map[string]interface{})
+	require.Equal(t, "required", selection["residentKey"])
+	require.Equal(t, "required", selection["userVerification"])
+	// the registered passkey can't be registered again
+	excluded := pk["excludeCredentials"].([]interface{})
+	require.Len(t, excluded, 1)
+	require.Equal(
+		t, base64.RawURLEncoding.EncodeToString(a.id),
+		excluded[0].(map[string]interface{})["id"],
+	)
+}
+
+func Test_BeginWebauthnRegistration_returns_403_if_impersonated(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:BeginWebauthnRegistration")
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.post("/me/webauthn/register", nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_BeginWebauthnRegistration_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/me/webauthn/register", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_BeginWebauthnRegistration_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 2), "/me/webauthn/register", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_BeginWebauthnRegistration_returns_500_if_base_url_invalid(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 1), "/me/webauthn/register", nil)
+	require.Nil(t, err)
+	svr.baseUrl = "/"
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
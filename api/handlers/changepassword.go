@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// ChangePassword changes the password of the current user, who has to enter
+// the current one. Sessions started before the change are rejected from then
+// on, the current session is issued new tokens. Wrong current passwords count
+// as failed logins towards the lockout.
+//
+// Endpoint: POST /me/password
+func (s Server) ChangePassword(
+	ctx context.Context, request ChangePasswordRequestObject,
+) (ChangePasswordResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("ChangePassword error: %v", err)
+		return nil, err
+	}
+	// the password belongs to the user, not to an impersonator
+	if nil != token.actor {
+		return ChangePassword403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	qc := context.Background()
+	u := token.user
+	wait, err := s.loginLockedOut(qc, userAttemptKey(u.ID))
+	if err != nil {
+		api.Log.Debugf("ChangePassword error: %v", err)
+		return nil, err
+	}
+	if wait > 0 {
+		return ChangePassword429JSONResponse{lockedOut(wait)}, nil
+	}
+	m, _, e := s.verifyPassword(request.Body.CurrentPassword, u.Password)
+	if e != nil {
+		api.Log.Debugf("ChangePassword error: %v", e)
+	}
+	if e != nil || !m {
+		if err = s.loginFailed(qc, gc.ClientIP(), u); err != nil {
+			api.Log.Debugf("ChangePassword error: %v", err)
+			return nil, err
+		}
+		return ChangePassword400JSONResponse{
+			N400JSONResponse: N400JSONResponse{
+				Code:   http.StatusBadRequest,
+				Errors: &api.ResponseMessageCredentialsInvalid,
+				Status: msgError,
+			},
+		}, nil
+	}
+	hash, err := s.hashNewPassword(request.Body.Password)
+	if err != nil {
+		var pe *passwordPolicyError
+		if errors.As(err, &pe) {
+			return ChangePassword400JSONResponse{pe.response()}, nil
+		}
+		api.Log.Debugf("ChangePassword error: %v", err)
+		return nil, err
+	}
+	updated, err := s.db.Transaction(
+		qc, func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			err := s.rotatePassword(qc, tx, u, request.Body.Password)
+			if err != nil {
+				return nil, err
+			}
+			if err = revokeUserTokens(qc, tx, u.ID); err != nil {
+				return nil, err
+			}
+			updated, err := tx.User.UpdateOneID(u.ID).SetPassword(hash).
+				SetMustChangePassword(false).Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = recordAudit(
+				qc, tx, api.AuditPasswordChanged, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			return updated, nil
+		},
+	)
+	if err != nil {
+		var pe *passwordPolicyError
+		if errors.As(err, &pe) {
+			return ChangePassword400JSONResponse{pe.response()}, nil
+		}
+		api.Log.Debugf("ChangePassword error: %v", err)
+		return nil, err
+	}
+	// keep the current session, other sessions are signed out
+	if _, err = s.signIn(gc, updated.(*ent.User)); err != nil {
+		api.Log.Debugf("ChangePassword error: %v", err)
+		return nil, err
+	}
+	return ChangePassword204Response{}, nil
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eidng8/go-utils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+)
+
+// Changes the password with the bearer token, returns the response.
+func tryChangePassword(
+	tb testing.TB, svr *Server, engine http.Handler, token, current,
+	password string,
+) *httptest.ResponseRecorder {
+	req, err := svr.post(
+		"/me/password",
+		ChangePasswordJSONRequestBody{
+			CurrentPassword: current, Password: password,
+		},
+	)
+	require.Nil(tb, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.RemoteAddr = "192.0.2.1:1234"
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+func Test_ChangePassword_signs_out_other_sessions(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_123")
+	u := getUserById(t, db, 1)
+	current := issueTokenAt(t, svr, u, time.Now().Add(-time.Minute))
+	other := issueTokenAt(t, svr, u, time.Now().Add(-time.Minute))
+	res := tryChangePassword(t, svr, engine, current, "Test_123", "Test_456")
+	require.Equal(t, http.StatusNoContent, res.Code)
+	u = getUserById(t, db, 1)
+	m, err := utils.ComparePassword("Test_456", u.Password)
+	require.Nil(t, err)
+	require.True(t, m)
+	require.NotNil(t, u.TokensValidAfter)
+	// the current session continues with the new tokens
+	cat, crt := getTokensFromSetCookieHeaders(t, res)
+	require.NotNil(t, cat)
+	require.NotNil(t, crt)
+	res = getUsersWithToken(t, svr, engine, cat.Value)
+	require.Equal(t, http.StatusOK, res.Code)
+	res = getUsersWithToken(t, svr, engine, other)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	res = getUsersWithToken(t, svr, engine, current)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditPasswordChanged)).
+		OnlyX(context.Background())
+	require.Equal(t, uint64(1), *log.ActorID)
+}
+
+func Test_ChangePassword_forgets_other_sessions(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_123")
+	u := getUserById(t, db, 1)
+	startTestSession(t, svr, u, "other agent")
+	at, err := svr.issueAccessToken(u)
+	require.Nil(t, err)
+	res := tryChangePassword(t, svr, engine, at, "Test_123", "Test_456")
+	require.Equal(t, http.StatusNoContent, res.Code)
+	ss := db.Session.Query().OnlyX(context.Background())
+	require.NotEqual(t, "other agent", ss.UserAgent)
+}
+
+func Test_ChangePassword_clears_temporary_password(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_123")
+	db.User.UpdateOneID(1).SetMustChangePassword(true).
+		ExecX(context.Background())
+	at, err := svr.issueAccessToken(getUserById(t, db, 1))
+	require.Nil(t, err)
+	res := tryChangePassword(t, svr, engine, at, "Test_123", "Test_456")
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.False(t, getUserById(t, db, 1).MustChangePassword)
+	cat, _ := getTokensFromSetCookieHeaders(t, res)
+	res = getUsersWithToken(t, svr, engine, cat.Value)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_ChangePassword_reports_400_if_current_password_wrong(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_123")
+	at, err := svr.issueAccessToken(getUserById(t, db, 1))
+	require.Nil(t, err)
+	res := tryChangePassword(t, svr, engine, at, "Test_000", "Test_456")
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	requireJsonEqualsString(
+		t, map[string]interface{}{
+			"code":   http.StatusBadRequest,
+			"errors": api.ResponseMessageCredentialsInvalid,
+			"status": msgError,
+		}, res.Body.String(),
+	)
+	m, err := utils.ComparePassword("Test_123", getUserById(t, db, 1).Password)
+	require.Nil(t, err)
+	require.True(t, m)
+}
+
+func Test_ChangePassword_locks_out_after_too_many_failures(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_123")
+	at, err := svr.issueAccessToken(getUserById(t, db, 1))
+	require.Nil(t, err)
+	for i := 0; i < svr.loginMaxAttempts; i++ {
+		res := tryChangePassword(t, svr, engine, at, "Test_000", "Test_456")
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	}
+	res := tryChangePassword(t, svr, engine, at, "Test_123", "Test_456")
+	require.Equal(t, http.StatusTooManyRequests, res.Code)
+	require.NotEmpty(t, res.Header().Get("Retry-After"))
+}
+
+func Test_ChangePassword_reports_400_if_password_too_simple(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_123")
+	at, err := svr.issueAccessToken(getUserById(t, db, 1))
+	require.Nil(t, err)
+	res := tryChangePassword(t, svr, engine, at, "Test_123", "abcdefgh")
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	requireJsonEqualsString(
+		t, map[string]interface{}{
+			"code":   http.StatusBadRequest,
+			"errors": DefaultPasswordPolicy().requirement(),
+			"status": msgError,
+		}, res.Body.String(),
+	)
+}
+
+func Test_ChangePassword_returns_403_if_impersonated(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:ChangePassword")
+	at := impersonate(t, svr, engine, db, 2)
+	res := tryChangePassword(t, svr, engine, at, "password2", "Test_456")
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ChangePassword_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post(
+		"/me/password",
+		ChangePasswordJSONRequestBody{
+			CurrentPassword: "Test_123", Password: "Test_456",
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ChangePassword_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 2), "/me/password",
+		ChangePasswordJSONRequestBody{
+			CurrentPassword: "Test_123", Password: "Test_456",
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ChangePassword_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_123")
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/password",
+		ChangePasswordJSONRequestBody{
+			CurrentPassword: "Test_123", Password: "Test_456",
+		},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
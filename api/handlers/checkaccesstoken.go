@@ -11,11 +11,13 @@ import (
 	"github.com/eidng8/go-attr-rbac/ent"
 )
 
-// CheckAccessToken checks whether current access token is valid.
+// CheckAccessToken checks whether current access token is valid. If the
+// `operation` parameter is given, also checks whether the user is allowed the
+// operation, so that downstream services can authorize requests.
 //
 // Endpoint: GET /access-token
 func (s Server) CheckAccessToken(
-	ctx context.Context, _ CheckAccessTokenRequestObject,
+	ctx context.Context, request CheckAccessTokenRequestObject,
 ) (CheckAccessTokenResponseObject, error) {
 	gc, ok := ctx.(*gin.Context)
 	if !ok {
@@ -32,8 +34,15 @@ func (s Server) CheckAccessToken(
 		return CheckAccessToken401JSONResponse{}, nil
 	}
 	r := utils.Pluck(token.user.Edges.Roles, ent.PluckRoleName)
-	if slices.Equal(r, *roles) {
-		return CheckAccessToken204Response{}, nil
+	if !slices.Equal(r, *roles) {
+		return CheckAccessToken401JSONResponse{}, nil
+	}
+	if nil != request.Params.Operation {
+		op := qualifyOperation(*request.Params.Operation)
+		if err = s.operationAllowed(token.user, op); err != nil {
+			api.Log.Debugf("check access token operation %s: %v", op, err)
+			return CheckAccessToken403JSONResponse{}, nil
+		}
 	}
-	return CheckAccessToken401JSONResponse{}, nil
+	return CheckAccessToken204Response{}, nil
 }
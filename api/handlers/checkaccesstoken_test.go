@@ -3,6 +3,8 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -11,7 +13,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/rbac"
 )
 
 func Test_CheckAccessToken_returns_204_for_valid_token(t *testing.T) {
@@ -229,3 +233,179 @@ func Test_CheckAccessToken_returns_401_if_invalid_context_token(t *testing.T) {
 	require.Nil(t, err)
 	require.IsType(t, r, CheckAccessToken401JSONResponse{})
 }
+
+// Returns user 2 with roles, granted the permissions.
+func userWithPermissions(
+	tb testing.TB, db *ent.Client, perms ...string,
+) *ent.User {
+	grantPermissions(tb, db, 2, perms...)
+	return db.User.Query().WithRoles().Where(user.IDEQ(2)).
+		OnlyX(context.Background())
+}
+
+func Test_CheckAccessToken_returns_204_if_operation_allowed(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	usr := userWithPermissions(
+		t, db, "auth:CheckAccessToken", "auth:ListUser",
+	)
+	req, err := svr.getAs(usr, "/access-token?operation=ListUser")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+}
+
+func Test_CheckAccessToken_returns_403_if_operation_not_allowed(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	usr := userWithPermissions(t, db, "auth:CheckAccessToken")
+	req, err := svr.getAs(
+		usr, "/access-token?"+url.Values{
+			"operation": {"auth:DeleteUser"},
+		}.Encode(),
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_CheckAccessToken_returns_403_if_operation_unknown(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	usr, err := db.User.Query().WithRoles().Where(user.IDEQ(1)).
+		Only(context.Background())
+	require.Nil(t, err)
+	req, err := svr.getAs(usr, "/access-token?operation=billing:Unknown")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+// Creates an SDK verifier of the tokens issued by the server.
+func newRbacVerifier(tb testing.TB, svr *Server) *rbac.Verifier {
+	key, err := getSecret()
+	require.Nil(tb, err)
+	verifier, err := rbac.NewVerifier(
+		rbac.VerifierConfig{
+			Key: key, Issuer: svr.Domain(), Audience: svr.Domain(),
+		},
+	)
+	require.Nil(tb, err)
+	return verifier
+}
+
+func Test_rbac_Guard_requires_operations_of_the_service(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	db.Permission.Create().SetName("billing:CreateInvoice").ExecX(qc)
+	db.Permission.Create().SetName("billing:DeleteInvoice").ExecX(qc)
+	usr := userWithPermissions(
+		t, db, "auth:CheckAccessToken", "billing:CreateInvoice",
+	)
+	at, err := svr.issueAccessToken(usr)
+	require.Nil(t, err)
+	ts := httptest.NewServer(engine)
+	defer ts.Close()
+	guard := rbac.NewGuard(
+		newRbacVerifier(t, svr), rbac.NewChecker(ts.URL, 0), "billing",
+	)
+	serve := func(op string) int {
+		res := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", rbac.SchemeBearer+" "+at)
+		guard.Require(op)(
+			http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					claims, ok := rbac.ClaimsFromContext(r.Context())
+					require.True(t, ok)
+					id, err := claims.UserId()
+					require.Nil(t, err)
+					require.Equal(t, usr.ID, id)
+					w.WriteHeader(http.StatusOK)
+				},
+			),
+		).ServeHTTP(res, req)
+		return res.Code
+	}
+	require.Equal(t, http.StatusOK, serve("CreateInvoice"))
+	require.Equal(t, http.StatusForbidden, serve("DeleteInvoice"))
+	require.Equal(t, http.StatusForbidden, serve("auth:DeleteUser"))
+	// tokens issued within the second of revocation are still accepted
+	db.User.UpdateOneID(usr.ID).SetTokensValidAfter(time.Now().Add(time.Second)).
+		ExecX(qc)
+	require.Equal(t, http.StatusUnauthorized, serve("CreateInvoice"))
+}
+
+func Test_rbac_Verifier_accepts_tokens_issued_by_the_service(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	verifier := newRbacVerifier(t, svr)
+	usr := getUserById(t, db, 2)
+	at, err := svr.issueAccessToken(usr)
+	require.Nil(t, err)
+	_, pt, err := svr.issuePersonalToken(usr, []string{"auth:Ping"}, time.Hour)
+	require.Nil(t, err)
+	it, _, err := svr.issueImpersonationToken(usr, getUserById(t, db, 1))
+	require.Nil(t, err)
+	for _, token := range []string{at, pt, it} {
+		claims, err := verifier.Verify(context.Background(), token)
+		require.Nil(t, err)
+		id, err := claims.UserId()
+		require.Nil(t, err)
+		require.Equal(t, usr.ID, id)
+		require.Equal(t, usr.OrganizationID, claims.Tenant)
+		require.False(t, claims.IsServiceAccount())
+	}
+	sa, client, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	ot, err := svr.issueOauthToken(
+		getUserById(t, db, sa.UserID), client, nil, time.Hour,
+	)
+	require.Nil(t, err)
+	claims, err := verifier.Verify(context.Background(), ot)
+	require.Nil(t, err)
+	require.True(t, claims.IsServiceAccount())
+	id, err := claims.UserId()
+	require.Nil(t, err)
+	require.Equal(t, sa.UserID, id)
+	require.Equal(t, client.ClientID, claims.ClientId)
+}
+
+func Test_rbac_ServiceSubjectPrefix_matches_the_service(t *testing.T) {
+	require.Equal(t, api.ServiceSubjectPrefix, rbac.ServiceSubjectPrefix)
+}
+
+func Test_rbac_Verifier_rejects_id_tokens(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	client, _ := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	idt, err := svr.issueIdToken(
+		getUserById(t, db, 2), client, []string{"openid"}, "nonce",
+	)
+	require.Nil(t, err)
+	key, err := getSecret()
+	require.Nil(t, err)
+	// without issuer and audience, so only the signing method can reject it
+	verifier, err := rbac.NewVerifier(rbac.VerifierConfig{Key: key})
+	require.Nil(t, err)
+	_, err = verifier.Verify(context.Background(), idt)
+	require.ErrorIs(t, err, rbac.ErrInvalidToken)
+	require.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+}
+
+func Test_rbac_Verifier_rejects_id_tokens_verified_by_jwks(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	client, _ := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	idt, err := svr.issueIdToken(
+		getUserById(t, db, 2), client, []string{"openid"}, "nonce",
+	)
+	require.Nil(t, err)
+	ts := httptest.NewServer(engine)
+	defer ts.Close()
+	// the key set of the service verifies the signature of ID tokens
+	verifier, err := rbac.NewVerifier(
+		rbac.VerifierConfig{JwksUrl: ts.URL + "/oauth/jwks"},
+	)
+	require.Nil(t, err)
+	_, err = verifier.Verify(context.Background(), idt)
+	require.ErrorIs(t, err, rbac.ErrInvalidToken)
+	require.ErrorContains(t, err, "not an access token")
+}
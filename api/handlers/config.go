@@ -1,15 +1,21 @@
 package handlers
 
 import (
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/eidng8/go-utils"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/eidng8/go-attr-rbac/api"
 )
@@ -25,6 +31,376 @@ func getHintSize(defaultValue int64) int {
 	return int(hintSize)
 }
 
+// Retrieves the maximum number of webhook delivery attempts from the
+// environment variable.
+func getWebhookMaxAttempts(defaultValue uint32) uint32 {
+	attempts, err := strconv.ParseUint(
+		utils.GetEnvWithDefaultNE(
+			api.WebhookMaxAttemptsName,
+			strconv.FormatUint(uint64(defaultValue), 10),
+		), 10, 32,
+	)
+	utils.PanicIfError(err)
+	if attempts < 1 {
+		return defaultValue
+	}
+	return uint32(attempts)
+}
+
+// Retrieves the webhook request timeout, in seconds, from the environment
+// variable.
+func getWebhookTimeout(defaultValue int64) time.Duration {
+	timeout, err := strconv.ParseInt(
+		utils.GetEnvWithDefaultNE(
+			api.WebhookTimeoutName, strconv.FormatInt(defaultValue, 10),
+		), 10, 32,
+	)
+	utils.PanicIfError(err)
+	if timeout < 1 {
+		timeout = defaultValue
+	}
+	return time.Duration(timeout) * time.Second
+}
+
+// Retrieves the lifetime of impersonation tokens, in seconds, from the
+// environment variable.
+func getImpersonationTtl(defaultValue int64) time.Duration {
+	ttl, err := strconv.ParseInt(
+		utils.GetEnvWithDefaultNE(
+			api.ImpersonationTtlName, strconv.FormatInt(defaultValue, 10),
+		), 10, 32,
+	)
+	utils.PanicIfError(err)
+	if ttl < 1 {
+		ttl = defaultValue
+	}
+	return time.Duration(ttl) * time.Second
+}
+
+// Builds the password policy from the environment variables, on top of
+// DefaultPasswordPolicy.
+func getPasswordPolicy() *PasswordPolicy {
+	p := DefaultPasswordPolicy()
+	length := func(name string, defaultValue int) int {
+		n, err := strconv.ParseInt(
+			utils.GetEnvWithDefaultNE(name, strconv.Itoa(defaultValue)), 10, 32,
+		)
+		utils.PanicIfError(err)
+		if n < 0 {
+			return defaultValue
+		}
+		return int(n)
+	}
+	p.MinLength = length(api.PasswordMinLengthName, p.MinLength)
+	p.MaxLength = length(api.PasswordMaxLengthName, p.MaxLength)
+	if p.MaxLength > 0 && p.MaxLength < p.MinLength {
+		utils.PanicIfError(
+			fmt.Errorf(
+				"%s must not be less than %s",
+				api.PasswordMaxLengthName, api.PasswordMinLengthName,
+			),
+		)
+	}
+	if classes := os.Getenv(api.PasswordClassesName); "" != classes {
+		p.Classes = nil
+		for _, class := range strings.Split(classes, ",") {
+			class = strings.TrimSpace(class)
+			if "none" == class {
+				continue
+			}
+			if !slices.Contains(
+				[]string{
+					api.PasswordClassUpper, api.PasswordClassLower,
+					api.PasswordClassDigit, api.PasswordClassSpecial,
+				}, class,
+			) {
+				utils.PanicIfError(
+					fmt.Errorf(
+						"invalid %s environment variable: %s",
+						api.PasswordClassesName, class,
+					),
+				)
+			}
+			p.Classes = append(p.Classes, class)
+		}
+	}
+	p.History = length(api.PasswordHistoryName, 0)
+	if path := os.Getenv(api.PasswordRejectListName); "" != path {
+		f, err := os.Open(path)
+		utils.PanicIfError(err)
+		defer f.Close()
+		utils.PanicIfError(p.LoadRejectList(f))
+	}
+	return p
+}
+
+// Builds the password hashers from the environment variables, the one
+// hashing new passwords comes first.
+func getPasswordHashers(params utils.PasswordHashParams) []PasswordHasher {
+	bcryptCost, err := strconv.Atoi(
+		utils.GetEnvWithDefaultNE(
+			api.BcryptCostName, strconv.Itoa(bcrypt.DefaultCost),
+		),
+	)
+	utils.PanicIfError(err)
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		bcryptCost = bcrypt.DefaultCost
+	}
+	scryptCost, err := strconv.ParseUint(
+		utils.GetEnvWithDefaultNE(api.ScryptCostName, "15"), 10, 8,
+	)
+	utils.PanicIfError(err)
+	if scryptCost < 10 || scryptCost > 30 {
+		scryptCost = 15
+	}
+	hashers := map[string]PasswordHasher{
+		api.PasswordHasherArgon2id: Argon2idHasher{Params: params},
+		api.PasswordHasherBcrypt:   BcryptHasher{Cost: bcryptCost},
+		api.PasswordHasherScrypt: ScryptHasher{
+			LogN: uint8(scryptCost), R: 8, P: 1, KeyLen: 32, SaltLen: 16,
+		},
+	}
+	name := utils.GetEnvWithDefaultNE(
+		api.PasswordHasherName, api.PasswordHasherArgon2id,
+	)
+	current, ok := hashers[name]
+	if !ok {
+		utils.PanicIfError(
+			fmt.Errorf(
+				"invalid %s environment variable: %s",
+				api.PasswordHasherName, name,
+			),
+		)
+	}
+	res := []PasswordHasher{current}
+	for _, other := range []string{
+		api.PasswordHasherArgon2id, api.PasswordHasherBcrypt,
+		api.PasswordHasherScrypt,
+	} {
+		if other != name {
+			res = append(res, hashers[other])
+		}
+	}
+	return res
+}
+
+// Retrieves the lifetime of password reset tokens, in seconds, from the
+// environment variable.
+func getPasswordResetTtl(defaultValue int64) time.Duration {
+	ttl, err := strconv.ParseInt(
+		utils.GetEnvWithDefaultNE(
+			api.PasswordResetTtlName, strconv.FormatInt(defaultValue, 10),
+		), 10, 32,
+	)
+	utils.PanicIfError(err)
+	if ttl < 1 {
+		ttl = defaultValue
+	}
+	return time.Duration(ttl) * time.Second
+}
+
+// Builds the mailer from the environment variables. Emails are only logged if
+// no SMTP server is set.
+func getMailer() Mailer {
+	host := os.Getenv(api.SmtpHostName)
+	if "" == host {
+		return LogMailer{}
+	}
+	port, err := strconv.ParseUint(
+		utils.GetEnvWithDefaultNE(api.SmtpPortName, "587"), 10, 16,
+	)
+	utils.PanicIfError(err)
+	mailer, err := NewSmtpMailer(
+		host, int(port), os.Getenv(api.SmtpUsernameName),
+		os.Getenv(api.SmtpPasswordName), os.Getenv(api.MailFromName),
+	)
+	utils.PanicIfError(err)
+	return mailer
+}
+
+// Retrieves the number of failed logins, from the environment variable
+// `name`, that locks out an account or a source address. Zero disables the
+// lockout.
+func getLoginMaxAttempts(name string, defaultValue int64) int {
+	attempts, err := strconv.ParseInt(
+		utils.GetEnvWithDefaultNE(name, strconv.FormatInt(defaultValue, 10)),
+		10, 32,
+	)
+	utils.PanicIfError(err)
+	if attempts < 0 {
+		attempts = defaultValue
+	}
+	return int(attempts)
+}
+
+// Retrieves a duration in seconds of the login lockout from the environment
+// variable `name`.
+func getLoginLockoutDuration(name string, defaultValue int64) time.Duration {
+	seconds, err := strconv.ParseInt(
+		utils.GetEnvWithDefaultNE(name, strconv.FormatInt(defaultValue, 10)),
+		10, 32,
+	)
+	utils.PanicIfError(err)
+	if seconds < 1 {
+		seconds = defaultValue
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// Retrieves the identifiers accepted by login from the environment variable.
+func getLoginIdentifier() string {
+	identifier := utils.GetEnvWithDefaultNE(
+		api.LoginIdentifierName, api.LoginIdentifierAny,
+	)
+	if !slices.Contains(
+		[]string{
+			api.LoginIdentifierUsername, api.LoginIdentifierEmail,
+			api.LoginIdentifierAny,
+		}, identifier,
+	) {
+		utils.PanicIfError(
+			fmt.Errorf(
+				"invalid %s environment variable: %s",
+				api.LoginIdentifierName, identifier,
+			),
+		)
+	}
+	return identifier
+}
+
+// Retrieves the LDAP authenticator from the environment variables, nil if
+// LDAP is disabled.
+func getLdapAuthenticator() *LdapAuthenticator {
+	url := os.Getenv(api.LdapUrlName)
+	if "" == url {
+		return nil
+	}
+	a := NewLdapAuthenticator(url, os.Getenv(api.LdapBaseDnName))
+	startTls, err := strconv.ParseBool(
+		utils.GetEnvWithDefaultNE(api.LdapStartTlsName, "false"),
+	)
+	utils.PanicIfError(err)
+	a.StartTls = startTls
+	a.BindDn = os.Getenv(api.LdapBindDnName)
+	a.BindPassword = os.Getenv(api.LdapBindPasswordName)
+	a.UserFilter = utils.GetEnvWithDefaultNE(
+		api.LdapUserFilterName, a.UserFilter,
+	)
+	a.UsernameAttribute = utils.GetEnvWithDefaultNE(
+		api.LdapUsernameAttributeName, a.UsernameAttribute,
+	)
+	a.EmailAttribute = utils.GetEnvWithDefaultNE(
+		api.LdapEmailAttributeName, a.EmailAttribute,
+	)
+	a.GroupAttribute = utils.GetEnvWithDefaultNE(
+		api.LdapGroupAttributeName, a.GroupAttribute,
+	)
+	if attributes := os.Getenv(api.LdapAttributesName); "" != attributes {
+		err := json.Unmarshal([]byte(attributes), &a.Attributes)
+		if err != nil {
+			utils.PanicIfError(
+				fmt.Errorf(
+					"invalid %s environment variable: %w",
+					api.LdapAttributesName, err,
+				),
+			)
+		}
+	}
+	timeout, err := strconv.ParseInt(
+		utils.GetEnvWithDefaultNE(api.LdapTimeoutName, "10"), 10, 64,
+	)
+	utils.PanicIfError(err)
+	if timeout > 0 {
+		a.Timeout = time.Duration(timeout) * time.Second
+	}
+	organization, err := strconv.ParseUint(
+		utils.GetEnvWithDefaultNE(
+			api.LdapOrganizationName,
+			strconv.Itoa(api.DefaultOrganizationId),
+		), 10, 32,
+	)
+	utils.PanicIfError(err)
+	a.Organization = uint32(organization)
+	return &a
+}
+
+// Retrieves the rules mapping groups of external users to roles from the
+// environment variable.
+func getGroupRoleRules() []GroupRoleRule {
+	value := os.Getenv(api.GroupRoleRulesName)
+	if "" == value {
+		return nil
+	}
+	var rules []GroupRoleRule
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		utils.PanicIfError(
+			fmt.Errorf(
+				"invalid %s environment variable: %w",
+				api.GroupRoleRulesName, err,
+			),
+		)
+	}
+	return rules
+}
+
+// Retrieves the OpenID Connect providers from the environment variable.
+func getOidcProviders() []*OidcProvider {
+	value := os.Getenv(api.OidcProvidersName)
+	if "" == value {
+		return nil
+	}
+	var providers []*OidcProvider
+	err := json.Unmarshal([]byte(value), &providers)
+	if nil == err {
+		for _, p := range providers {
+			if "" == p.Name || "" == p.Issuer || "" == p.ClientId {
+				err = errors.New("name, issuer and client_id are required")
+				break
+			}
+		}
+	}
+	if err != nil {
+		utils.PanicIfError(
+			fmt.Errorf(
+				"invalid %s environment variable: %w",
+				api.OidcProvidersName, err,
+			),
+		)
+	}
+	return providers
+}
+
+// Parses the PEM encoded RSA key signing ID tokens, in PKCS #1 or PKCS #8.
+// Returns nil if it isn't set, a key is generated when needed.
+func getOauthSigningKey() *rsa.PrivateKey {
+	value := os.Getenv(api.OauthSigningKeyName)
+	if "" == value {
+		return nil
+	}
+	block, _ := pem.Decode([]byte(value))
+	if nil == block {
+		utils.PanicIfError(
+			fmt.Errorf("invalid %s environment variable", api.OauthSigningKeyName),
+		)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); nil == err {
+		return key
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if nil == err {
+		if k, ok := key.(*rsa.PrivateKey); ok {
+			return k
+		}
+		err = errors.New("not an RSA key")
+	}
+	utils.PanicIfError(
+		fmt.Errorf(
+			"invalid %s environment variable: %w", api.OauthSigningKeyName, err,
+		),
+	)
+	return nil
+}
+
 // Retrieves the list of public operations from the environment variable,
 // separated by comma, removes any whitespace-only strings.
 // Adds `auth:login` and `auth:refreshAccessToken` to the list if not present.
@@ -41,6 +417,18 @@ func getPublicOperations() []string {
 	if !slices.Contains(ops, api.OperationRefreshToken) {
 		ops = append(ops, api.OperationRefreshToken)
 	}
+	for _, op := range []string{
+		api.OperationVerifyTotp, api.OperationBeginWebauthnLogin,
+		api.OperationFinishWebauthnLogin, api.OperationForgotPassword,
+		api.OperationResetPassword, api.OperationBeginOidcLogin,
+		api.OperationFinishOidcLogin, api.OperationOauthAuthorize,
+		api.OperationOauthToken, api.OperationOauthUserinfo,
+		api.OperationOauthJwks, api.OperationOpenidConfiguration,
+	} {
+		if !slices.Contains(ops, op) {
+			ops = append(ops, op)
+		}
+	}
 	return ops
 }
 
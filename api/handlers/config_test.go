@@ -1,9 +1,17 @@
 package handlers
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/eidng8/go-utils"
 	"github.com/stretchr/testify/require"
 
 	"github.com/eidng8/go-attr-rbac/api"
@@ -14,6 +22,155 @@ func Test_getHintSize_handles_invalid_hint_size(t *testing.T) {
 	require.Equal(t, 5, getHintSize(5))
 }
 
+func Test_getImpersonationTtl_handles_invalid_ttl(t *testing.T) {
+	require.Nil(t, os.Setenv(api.ImpersonationTtlName, "0"))
+	require.Equal(t, 900*time.Second, getImpersonationTtl(900))
+	require.Nil(t, os.Setenv(api.ImpersonationTtlName, "60"))
+	require.Equal(t, time.Minute, getImpersonationTtl(900))
+	require.Nil(t, os.Unsetenv(api.ImpersonationTtlName))
+}
+
+func Test_getLoginMaxAttempts_returns_default_if_negative(t *testing.T) {
+	require.Nil(t, os.Setenv(api.LoginMaxAttemptsName, "-1"))
+	defer func() { require.Nil(t, os.Unsetenv(api.LoginMaxAttemptsName)) }()
+	require.Equal(t, 5, getLoginMaxAttempts(api.LoginMaxAttemptsName, 5))
+	require.Nil(t, os.Setenv(api.LoginMaxAttemptsName, "0"))
+	require.Equal(t, 0, getLoginMaxAttempts(api.LoginMaxAttemptsName, 5))
+}
+
+func Test_getLoginLockoutDuration_returns_default_if_invalid(t *testing.T) {
+	require.Nil(t, os.Setenv(api.LoginLockoutName, "0"))
+	defer func() { require.Nil(t, os.Unsetenv(api.LoginLockoutName)) }()
+	require.Equal(
+		t, 300*time.Second, getLoginLockoutDuration(api.LoginLockoutName, 300),
+	)
+	require.Nil(t, os.Setenv(api.LoginLockoutName, "60"))
+	require.Equal(
+		t, time.Minute, getLoginLockoutDuration(api.LoginLockoutName, 300),
+	)
+}
+
+func Test_getLoginIdentifier_defaults_to_any(t *testing.T) {
+	require.Nil(t, os.Unsetenv(api.LoginIdentifierName))
+	require.Equal(t, api.LoginIdentifierAny, getLoginIdentifier())
+	require.Nil(t, os.Setenv(api.LoginIdentifierName, api.LoginIdentifierEmail))
+	require.Equal(t, api.LoginIdentifierEmail, getLoginIdentifier())
+	require.Nil(t, os.Unsetenv(api.LoginIdentifierName))
+}
+
+func Test_getLoginIdentifier_panics_if_invalid(t *testing.T) {
+	require.Nil(t, os.Setenv(api.LoginIdentifierName, "phone"))
+	defer func() { require.Nil(t, os.Unsetenv(api.LoginIdentifierName)) }()
+	require.Panics(t, func() { getLoginIdentifier() })
+}
+
+func Test_getLdapAuthenticator_disabled_without_url(t *testing.T) {
+	require.Nil(t, os.Unsetenv(api.LdapUrlName))
+	require.Nil(t, getLdapAuthenticator())
+}
+
+func Test_getLdapAuthenticator_reads_environment(t *testing.T) {
+	env := map[string]string{
+		api.LdapUrlName:               "ldap://ldap.example.com",
+		api.LdapStartTlsName:          "true",
+		api.LdapBindDnName:            testLdapBindDn,
+		api.LdapBindPasswordName:      testLdapBindPassword,
+		api.LdapBaseDnName:            testLdapBaseDn,
+		api.LdapUserFilterName:        "(sAMAccountName={username})",
+		api.LdapUsernameAttributeName: "sAMAccountName",
+		api.LdapEmailAttributeName:    "userPrincipalName",
+		api.LdapGroupAttributeName:    "groups",
+		api.LdapAttributesName:        `{"departmentNumber": "dept"}`,
+		api.LdapTimeoutName:           "3",
+		api.LdapOrganizationName:      "2",
+	}
+	for k, v := range env {
+		require.Nil(t, os.Setenv(k, v))
+	}
+	defer func() {
+		for k := range env {
+			require.Nil(t, os.Unsetenv(k))
+		}
+	}()
+	require.Equal(
+		t, &LdapAuthenticator{
+			Url:               "ldap://ldap.example.com",
+			StartTls:          true,
+			BindDn:            testLdapBindDn,
+			BindPassword:      testLdapBindPassword,
+			BaseDn:            testLdapBaseDn,
+			UserFilter:        "(sAMAccountName={username})",
+			UsernameAttribute: "sAMAccountName",
+			EmailAttribute:    "userPrincipalName",
+			GroupAttribute:    "groups",
+			Attributes:        map[string]string{"departmentNumber": "dept"},
+			Timeout:           3 * time.Second,
+			Organization:      2,
+		}, getLdapAuthenticator(),
+	)
+}
+
+func Test_getLdapAuthenticator_panics_if_attributes_invalid(t *testing.T) {
+	require.Nil(t, os.Setenv(api.LdapUrlName, "ldap://ldap.example.com"))
+	require.Nil(t, os.Setenv(api.LdapAttributesName, "departmentNumber"))
+	defer func() {
+		require.Nil(t, os.Unsetenv(api.LdapUrlName))
+		require.Nil(t, os.Unsetenv(api.LdapAttributesName))
+	}()
+	require.Panics(t, func() { getLdapAuthenticator() })
+}
+
+func Test_getGroupRoleRules_reads_environment(t *testing.T) {
+	require.Nil(t, os.Unsetenv(api.GroupRoleRulesName))
+	require.Nil(t, getGroupRoleRules())
+	require.Nil(
+		t, os.Setenv(
+			api.GroupRoleRulesName,
+			`[{"group": "admins", "roles": ["role 1", "role 2"]}]`,
+		),
+	)
+	defer func() { require.Nil(t, os.Unsetenv(api.GroupRoleRulesName)) }()
+	require.Equal(
+		t, []GroupRoleRule{{Group: "admins", Roles: []string{"role 1", "role 2"}}},
+		getGroupRoleRules(),
+	)
+	require.Nil(t, os.Setenv(api.GroupRoleRulesName, `{"admins": "role 1"}`))
+	require.Panics(t, func() { getGroupRoleRules() })
+}
+
+func Test_getOidcProviders_reads_environment(t *testing.T) {
+	require.Nil(t, os.Unsetenv(api.OidcProvidersName))
+	require.Nil(t, getOidcProviders())
+	require.Nil(
+		t, os.Setenv(
+			api.OidcProvidersName,
+			`[{"name": "idp", "issuer": "https://idp.example.com",
+			"client_id": "rbac", "client_secret": "secret",
+			"redirect_url": "https://app.example.com/login/oidc",
+			"groups_claim": "roles", "attributes": {"dept": "dept"},
+			"link_by_email": true}]`,
+		),
+	)
+	defer func() { require.Nil(t, os.Unsetenv(api.OidcProvidersName)) }()
+	providers := getOidcProviders()
+	require.Len(t, providers, 1)
+	p := providers[0]
+	require.Equal(t, "idp", p.Name)
+	require.Equal(t, "https://idp.example.com", p.Issuer)
+	require.Equal(t, "rbac", p.ClientId)
+	require.Equal(t, "secret", p.ClientSecret)
+	require.Equal(t, "https://app.example.com/login/oidc", p.RedirectUrl)
+	require.Equal(t, "roles", p.GroupsClaim)
+	require.Equal(t, map[string]string{"dept": "dept"}, p.Attributes)
+	require.True(t, p.LinkByEmail)
+	require.Nil(
+		t, os.Setenv(api.OidcProvidersName, `[{"name": "idp", "issuer": "x"}]`),
+	)
+	require.Panics(t, func() { getOidcProviders() })
+	require.Nil(t, os.Setenv(api.OidcProvidersName, `{"name": "idp"}`))
+	require.Panics(t, func() { getOidcProviders() })
+}
+
 func Test_getSecret_returns_error_if_secret_empty(t *testing.T) {
 	require.Nil(t, os.Setenv(api.PrivateKeyName, ""))
 	_, err := getSecret()
@@ -25,3 +182,165 @@ func Test_getSecret_returns_error_if_secret_invalid(t *testing.T) {
 	_, err := getSecret()
 	require.NotNil(t, err)
 }
+
+func Test_getMailer_logs_without_smtp_host(t *testing.T) {
+	require.Nil(t, os.Unsetenv(api.SmtpHostName))
+	require.IsType(t, LogMailer{}, getMailer())
+}
+
+func Test_getMailer_uses_smtp_host(t *testing.T) {
+	require.Nil(t, os.Setenv(api.SmtpHostName, "mail.example.com"))
+	require.Nil(t, os.Setenv(api.MailFromName, "noreply@example.com"))
+	defer func() {
+		require.Nil(t, os.Unsetenv(api.SmtpHostName))
+		require.Nil(t, os.Unsetenv(api.MailFromName))
+	}()
+	mailer, ok := getMailer().(*SmtpMailer)
+	require.True(t, ok)
+	require.Equal(t, "mail.example.com", mailer.host)
+	require.Equal(t, 587, mailer.port)
+}
+
+func Test_getMailer_panics_if_sender_invalid(t *testing.T) {
+	require.Nil(t, os.Setenv(api.SmtpHostName, "mail.example.com"))
+	require.Nil(t, os.Setenv(api.MailFromName, "not an address"))
+	defer func() {
+		require.Nil(t, os.Unsetenv(api.SmtpHostName))
+		require.Nil(t, os.Unsetenv(api.MailFromName))
+	}()
+	require.Panics(t, func() { getMailer() })
+}
+
+func Test_getPasswordResetTtl_handles_invalid_ttl(t *testing.T) {
+	require.Nil(t, os.Setenv(api.PasswordResetTtlName, "0"))
+	require.Equal(t, time.Hour, getPasswordResetTtl(3600))
+	require.Nil(t, os.Setenv(api.PasswordResetTtlName, "60"))
+	require.Equal(t, time.Minute, getPasswordResetTtl(3600))
+	require.Nil(t, os.Unsetenv(api.PasswordResetTtlName))
+}
+
+func Test_getPasswordPolicy_defaults(t *testing.T) {
+	require.Equal(t, DefaultPasswordPolicy(), getPasswordPolicy())
+}
+
+func Test_getPasswordPolicy_reads_environment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reject.txt")
+	require.Nil(t, os.WriteFile(path, []byte("Summer_2024\n"), 0o600))
+	env := map[string]string{
+		api.PasswordMinLengthName:  "12",
+		api.PasswordMaxLengthName:  "0",
+		api.PasswordClassesName:    "lower, digit",
+		api.PasswordHistoryName:    "5",
+		api.PasswordRejectListName: path,
+	}
+	for name, value := range env {
+		require.Nil(t, os.Setenv(name, value))
+	}
+	defer func() {
+		for name := range env {
+			require.Nil(t, os.Unsetenv(name))
+		}
+	}()
+	p := getPasswordPolicy()
+	require.Equal(t, 12, p.MinLength)
+	require.Equal(t, 0, p.MaxLength)
+	require.Equal(
+		t, []string{api.PasswordClassLower, api.PasswordClassDigit}, p.Classes,
+	)
+	require.Equal(t, 5, p.History)
+	require.NotNil(t, p.Validate("summer_2024"))
+	require.Nil(t, p.Validate("summer_20255"))
+}
+
+func Test_getPasswordPolicy_accepts_no_classes(t *testing.T) {
+	require.Nil(t, os.Setenv(api.PasswordClassesName, "none"))
+	defer func() { require.Nil(t, os.Unsetenv(api.PasswordClassesName)) }()
+	require.Empty(t, getPasswordPolicy().Classes)
+}
+
+func Test_getPasswordPolicy_panics_if_invalid(t *testing.T) {
+	for name, value := range map[string]string{
+		api.PasswordClassesName:    "emoji",
+		api.PasswordMaxLengthName:  "6",
+		api.PasswordRejectListName: filepath.Join(t.TempDir(), "missing"),
+	} {
+		require.Nil(t, os.Setenv(name, value))
+		require.Panics(t, func() { getPasswordPolicy() }, name)
+		require.Nil(t, os.Unsetenv(name))
+	}
+}
+
+func Test_getPasswordHashers_defaults_to_argon2id(t *testing.T) {
+	require.Nil(t, os.Unsetenv(api.PasswordHasherName))
+	params := utils.PasswordHashParams{Times: 1}
+	hashers := getPasswordHashers(params)
+	require.Len(t, hashers, 3)
+	require.Equal(t, Argon2idHasher{Params: params}, hashers[0])
+}
+
+func Test_getPasswordHashers_puts_selected_hasher_first(t *testing.T) {
+	require.Nil(t, os.Setenv(api.PasswordHasherName, api.PasswordHasherBcrypt))
+	require.Nil(t, os.Setenv(api.BcryptCostName, "12"))
+	defer func() {
+		require.Nil(t, os.Unsetenv(api.PasswordHasherName))
+		require.Nil(t, os.Unsetenv(api.BcryptCostName))
+	}()
+	hashers := getPasswordHashers(utils.PasswordHashParams{})
+	require.Equal(t, BcryptHasher{Cost: 12}, hashers[0])
+	require.Len(t, hashers, 3)
+}
+
+func Test_getPasswordHashers_handles_invalid_cost(t *testing.T) {
+	require.Nil(t, os.Setenv(api.PasswordHasherName, api.PasswordHasherScrypt))
+	require.Nil(t, os.Setenv(api.ScryptCostName, "2"))
+	defer func() {
+		require.Nil(t, os.Unsetenv(api.PasswordHasherName))
+		require.Nil(t, os.Unsetenv(api.ScryptCostName))
+	}()
+	hasher, ok := getPasswordHashers(utils.PasswordHashParams{})[0].(ScryptHasher)
+	require.True(t, ok)
+	require.Equal(t, uint8(15), hasher.LogN)
+}
+
+func Test_getPasswordHashers_panics_if_hasher_invalid(t *testing.T) {
+	require.Nil(t, os.Setenv(api.PasswordHasherName, "md5"))
+	defer func() { require.Nil(t, os.Unsetenv(api.PasswordHasherName)) }()
+	require.Panics(t, func() { getPasswordHashers(utils.PasswordHashParams{}) })
+}
+
+func Test_getOauthSigningKey_reads_environment(t *testing.T) {
+	require.Nil(t, os.Unsetenv(api.OauthSigningKeyName))
+	require.Nil(t, getOauthSigningKey())
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	defer func() { require.Nil(t, os.Unsetenv(api.OauthSigningKeyName)) }()
+	pkcs1 := pem.EncodeToMemory(
+		&pem.Block{
+			Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key),
+		},
+	)
+	require.Nil(t, os.Setenv(api.OauthSigningKeyName, string(pkcs1)))
+	require.True(t, key.Equal(getOauthSigningKey()))
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.Nil(t, err)
+	pkcs8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	require.Nil(t, os.Setenv(api.OauthSigningKeyName, string(pkcs8)))
+	require.True(t, key.Equal(getOauthSigningKey()))
+}
+
+func Test_getOauthSigningKey_panics_if_key_invalid(t *testing.T) {
+	defer func() { require.Nil(t, os.Unsetenv(api.OauthSigningKeyName)) }()
+	require.Nil(t, os.Setenv(api.OauthSigningKeyName, "not a key"))
+	require.Panics(t, func() { getOauthSigningKey() })
+	_, ec, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(ec)
+	require.Nil(t, err)
+	require.Nil(
+		t, os.Setenv(
+			api.OauthSigningKeyName,
+			string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		),
+	)
+	require.Panics(t, func() { getOauthSigningKey() })
+}
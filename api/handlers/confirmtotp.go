@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// ConfirmTotp enables TOTP of the current user with the first code of the
+// authenticator app. Returns recovery codes, each usable once in place of a
+// TOTP code. Only their hashes are kept, so they are shown this time only.
+//
+// Endpoint: POST /me/totp/confirm
+func (s Server) ConfirmTotp(
+	ctx context.Context, request ConfirmTotpRequestObject,
+) (ConfirmTotpResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("ConfirmTotp error: %v", err)
+		return nil, err
+	}
+	if nil != token.actor {
+		return ConfirmTotp403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		api.Log.Debugf("ConfirmTotp error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			u, err := tx.User.Query().Where(user.IDEQ(token.user.ID)).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			if nil == u.TotpSecret || nil != u.TotpConfirmedAt {
+				return nil, errNotPending
+			}
+			step := matchTotp(*u.TotpSecret, request.Body.Code, time.Now())
+			if step < 0 {
+				return nil, errInvalidArgument
+			}
+			err = tx.User.UpdateOne(u).SetTotpConfirmedAt(time.Now()).
+				SetTotpLastStep(step).SetRecoveryCodes(hashes).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditTotpEnabled, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errNotPending) {
+			return ConfirmTotp400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgNotPending,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if errors.Is(err, errInvalidArgument) {
+			return ConfirmTotp400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidCode,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ConfirmTotp error: %v", err)
+		return nil, err
+	}
+	return ConfirmTotp200JSONResponse{RecoveryCodes: codes}, nil
+}
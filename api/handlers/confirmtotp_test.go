@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+)
+
+// Starts TOTP enrollment of the user, returns the secret.
+func startTotpEnrollment(tb testing.TB, db *ent.Client, id uint64) string {
+	secret, err := newTotpSecret()
+	require.Nil(tb, err)
+	db.User.UpdateOneID(id).SetTotpSecret(secret).
+		ExecX(context.Background())
+	return secret
+}
+
+func Test_ConfirmTotp_enables_totp(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	secret := startTotpEnrollment(t, db, 1)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/confirm",
+		ConfirmTotpJSONRequestBody{Code: currentTotp(t, secret)},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ConfirmTotp200JSONResponse{}, res)
+	require.Len(t, actual.RecoveryCodes, recoveryCodeCount)
+	u := getUserById(t, db, 1)
+	require.NotNil(t, u.TotpConfirmedAt)
+	require.Len(t, u.RecoveryCodes, recoveryCodeCount)
+	require.Equal(
+		t, hashRecoveryCode(actual.RecoveryCodes[0]), u.RecoveryCodes[0],
+	)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditTotpEnabled)).
+		OnlyX(context.Background())
+	require.Equal(t, uint64(1), *log.ActorID)
+}
+
+func Test_ConfirmTotp_reports_400_if_code_invalid(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	startTotpEnrollment(t, db, 1)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/confirm",
+		ConfirmTotpJSONRequestBody{Code: "abcdef"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusBadRequest,
+		"errors": msgInvalidCode,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+	require.Nil(t, getUserById(t, db, 1).TotpConfirmedAt)
+}
+
+func Test_ConfirmTotp_reports_400_if_not_enrolling(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	secret := enableTotp(t, db, 1)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/confirm",
+		ConfirmTotpJSONRequestBody{Code: currentTotp(t, secret)},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusBadRequest,
+		"errors": msgNotPending,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_ConfirmTotp_reports_403_if_impersonating(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:ConfirmTotp")
+	secret := startTotpEnrollment(t, db, 2)
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.post(
+		"/me/totp/confirm",
+		ConfirmTotpJSONRequestBody{Code: currentTotp(t, secret)},
+	)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ConfirmTotp_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post(
+		"/me/totp/confirm", ConfirmTotpJSONRequestBody{Code: "123456"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ConfirmTotp_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 2), "/me/totp/confirm",
+		ConfirmTotpJSONRequestBody{Code: "123456"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ConfirmTotp_returns_422_if_code_too_short(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/confirm",
+		ConfirmTotpJSONRequestBody{Code: "123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_ConfirmTotp_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/confirm",
+		ConfirmTotpJSONRequestBody{Code: "123456"},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
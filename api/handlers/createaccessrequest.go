@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+)
+
+// CreateAccessRequest requests a role for the current user. The request
+// stays pending until an approver of the role reviews it.
+//
+// Endpoint: POST /access-requests
+func (s Server) CreateAccessRequest(
+	ctx context.Context, request CreateAccessRequestRequestObject,
+) (CreateAccessRequestResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("CreateAccessRequest error: %v", err)
+		return nil, err
+	}
+	me := token.user
+	ar, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			err := checkTenantRoles(
+				qc, tx, me.OrganizationID, []uint32{request.Body.RoleId},
+			)
+			if err != nil {
+				return nil, err
+			}
+			pending, err := tx.AccessRequest.Query().Where(
+				accessrequest.RequesterIDEQ(me.ID),
+				accessrequest.RoleIDEQ(request.Body.RoleId),
+				accessrequest.StatusEQ(accessrequest.StatusPending),
+			).Exist(qc)
+			if err != nil {
+				return nil, err
+			}
+			if pending {
+				return nil, errPendingRequest
+			}
+			// checkTenantRoles passes on non-existing roles
+			ro, err := tx.Role.Query().
+				Where(role.IDEQ(request.Body.RoleId)).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			return tx.AccessRequest.Create().
+				SetOrganizationID(ro.OrganizationID).SetRequesterID(me.ID).
+				SetRoleID(ro.ID).SetJustification(request.Body.Justification).
+				SetNillableDuration(request.Body.Duration).Save(qc)
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errPendingRequest) {
+			return CreateAccessRequest400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgExists,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsNotFound(err) || errors.Is(err, errCrossTenant) {
+			return CreateAccessRequest400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateAccessRequest error: %v", err)
+		return nil, err
+	}
+	return CreateAccessRequest201JSONResponse(
+		accessRequestRead(ar.(*ent.AccessRequest)),
+	), nil
+}
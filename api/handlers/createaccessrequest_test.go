@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+)
+
+func Test_CreateAccessRequest_creates_a_pending_request(t *testing.T) {
+	duration := uint32(3600)
+	body := CreateAccessRequestJSONBody{
+		RoleId: 5, Justification: "need it", Duration: &duration,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/access-requests", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, CreateAccessRequest201JSONResponse{}, res)
+	require.Equal(t, uint64(1), actual.RequesterId)
+	require.Equal(t, uint32(5), actual.RoleId)
+	require.Equal(t, uint32(1), actual.OrganizationId)
+	require.Equal(t, "need it", actual.Justification)
+	require.Equal(t, duration, *actual.Duration)
+	require.Equal(t, AccessRequestReadStatus("pending"), actual.Status)
+	require.Nil(t, actual.ReviewerId)
+	require.Nil(t, actual.ReviewedAt)
+	row := db.AccessRequest.GetX(context.Background(), actual.Id)
+	require.Equal(t, accessrequest.StatusPending, row.Status)
+}
+
+func Test_CreateAccessRequest_creates_an_indefinite_request(t *testing.T) {
+	body := CreateAccessRequestJSONBody{RoleId: 5, Justification: "need it"}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/access-requests", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, CreateAccessRequest201JSONResponse{}, res)
+	require.Nil(t, actual.Duration)
+}
+
+func Test_CreateAccessRequest_reports_400_if_already_pending(t *testing.T) {
+	body := CreateAccessRequestJSONBody{RoleId: 5, Justification: "need it"}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedAccessRequest(t, db, 1, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/access-requests", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusBadRequest,
+		"errors": msgExists,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+	require.Equal(t, 1, db.AccessRequest.Query().CountX(context.Background()))
+}
+
+func Test_CreateAccessRequest_allows_request_after_review(t *testing.T) {
+	body := CreateAccessRequestJSONBody{RoleId: 5, Justification: "need it"}
+	svr, engine, db, res := setupTestCase(t, true)
+	ar := seedAccessRequest(t, db, 1, 5)
+	db.AccessRequest.UpdateOne(ar).SetStatus(accessrequest.StatusDenied).
+		ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/access-requests", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	require.Equal(t, 2, db.AccessRequest.Query().CountX(context.Background()))
+}
+
+func Test_CreateAccessRequest_reports_400_if_role_not_exists(t *testing.T) {
+	body := CreateAccessRequestJSONBody{
+		RoleId: 12345, Justification: "need it",
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/access-requests", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusBadRequest,
+		"errors": msgInvalidAssignment,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_CreateAccessRequest_reports_400_if_role_of_other_organization(t *testing.T) {
+	body := CreateAccessRequestJSONBody{RoleId: 5, Justification: "need it"}
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:CreateAccessRequest")
+	req, err := svr.postAs(u, "/access-requests", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Equal(t, 0, db.AccessRequest.Query().CountX(context.Background()))
+}
+
+func Test_CreateAccessRequest_reports_422_if_no_justification(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, "/access-requests", map[string]interface{}{"role_id": 5},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_CreateAccessRequest_returns_401_if_non_user(t *testing.T) {
+	body := CreateAccessRequestJSONBody{RoleId: 5, Justification: "need it"}
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/access-requests", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_CreateAccessRequest_returns_403_if_user_without_permission(t *testing.T) {
+	body := CreateAccessRequestJSONBody{RoleId: 5, Justification: "need it"}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.postAs(u, "/access-requests", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_CreateAccessRequest_returns_500_if_db_error_unhandled(t *testing.T) {
+	body := CreateAccessRequestJSONBody{RoleId: 5, Justification: "need it"}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/access-requests", body)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
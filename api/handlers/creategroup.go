@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// CreateGroup creates a group, optionally with members and roles.
+//
+// Endpoint: POST /groups
+func (s Server) CreateGroup(
+	ctx context.Context, request CreateGroupRequestObject,
+) (CreateGroupResponseObject, error) {
+	organization, err := s.creationTenant(ctx, request.Body.OrganizationId)
+	if err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return CreateGroup403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateGroup error: %v", err)
+		return nil, err
+	}
+	g, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			create := tx.Group.Create().SetOrganizationID(organization).
+				SetName(request.Body.Name)
+			if request.Body.Description != nil {
+				create.SetDescription(*request.Body.Description)
+			}
+			if request.Body.Users != nil && len(*request.Body.Users) > 0 {
+				err := checkTenantUsers(
+					qc, tx, organization, *request.Body.Users,
+				)
+				if err != nil {
+					return nil, err
+				}
+				create.AddUserIDs(*request.Body.Users...)
+			}
+			if request.Body.Roles != nil && len(*request.Body.Roles) > 0 {
+				err := checkTenantRoles(
+					qc, tx, organization, *request.Body.Roles,
+				)
+				if err != nil {
+					return nil, err
+				}
+				create.AddRoleIDs(*request.Body.Roles...)
+			}
+			g, err := create.Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			// members gain the group's roles
+			if request.Body.Roles != nil && len(*request.Body.Roles) > 0 &&
+				request.Body.Users != nil {
+				err = checkSeparationOfDuties(qc, tx, *request.Body.Users...)
+				if err != nil {
+					return nil, err
+				}
+				for _, id := range *request.Body.Users {
+					if err = userRolesChanged(qc, tx, id); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return g, nil
+		},
+	)
+	if err != nil {
+		var sv *sodViolation
+		if errors.As(err, &sv) {
+			return CreateGroup409JSONResponse{N409JSONResponse: sv.response()}, nil
+		}
+		if ent.IsUniqueKeyError(err) {
+			return CreateGroup400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgExists,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsForeignKeyError(err) || errors.Is(err, errCrossTenant) {
+			return CreateGroup400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateGroup error: %v", err)
+		return nil, err
+	}
+	gr := g.(*ent.Group)
+	return CreateGroup201JSONResponse{
+		Id:             gr.ID,
+		OrganizationId: gr.OrganizationID,
+		Name:           gr.Name,
+		Description:    &gr.Description,
+		CreatedAt:      gr.CreatedAt,
+		UpdatedAt:      gr.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_CreateGroup_creates_a_group(t *testing.T) {
+	desc := "test description"
+	body := CreateGroupJSONBody{Name: "test group", Description: &desc}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/groups", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, Group{}, res)
+	require.Equal(t, body.Name, actual.Name)
+	require.Equal(t, body.Description, actual.Description)
+	require.Equal(t, uint32(1), actual.OrganizationId)
+	require.GreaterOrEqual(t, *actual.CreatedAt, startTime)
+	require.True(
+		t, db.Group.Query().Where(group.NameEQ(body.Name)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_CreateGroup_creates_a_group_with_members_and_roles(t *testing.T) {
+	users := []uint64{3, 4}
+	roles := []uint32{2, 3}
+	body := CreateGroupJSONBody{Name: "test group", Users: &users, Roles: &roles}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/groups", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, Group{}, res)
+	qc := context.Background()
+	require.Equal(
+		t, users, db.Group.Query().Where(group.IDEQ(actual.Id)).
+			QueryUsers().Order(user.ByID()).IDsX(qc),
+	)
+	require.Equal(
+		t, roles, db.Group.Query().Where(group.IDEQ(actual.Id)).
+			QueryRoles().Order(role.ByID()).IDsX(qc),
+	)
+}
+
+func Test_CreateGroup_returns_400_if_name_exists(t *testing.T) {
+	body := CreateGroupJSONBody{Name: "group 0"}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedGroup(t, db, nil, nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/groups", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_CreateGroup_returns_400_if_role_not_found(t *testing.T) {
+	roles := []uint32{12345}
+	body := CreateGroupJSONBody{Name: "test group", Roles: &roles}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/groups", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.False(
+		t, db.Group.Query().Where(group.NameEQ(body.Name)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_CreateGroup_returns_400_if_member_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, tu, _ := seedOrganization(t, db)
+	users := []uint64{tu.ID}
+	body := CreateGroupJSONBody{Name: "test group", Users: &users}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/groups", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_CreateGroup_returns_409_if_violates_sod_constraint(t *testing.T) {
+	users := []uint64{2}
+	roles := []uint32{5}
+	body := CreateGroupJSONBody{Name: "test group", Users: &users, Roles: &roles}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/groups", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	requireSodConflict(t, res, "role 0", "role 3")
+	require.False(
+		t, db.Group.Query().Where(group.NameEQ(body.Name)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_CreateGroup_returns_401_if_non_user(t *testing.T) {
+	body := CreateGroupJSONBody{Name: "test group"}
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/groups", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_CreateGroup_returns_403_if_user_without_permission(t *testing.T) {
+	body := CreateGroupJSONBody{Name: "test group"}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.postAs(u, "/groups", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_CreateGroup_returns_500_if_db_error_unhandled(t *testing.T) {
+	body := CreateGroupJSONBody{Name: "test group"}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/groups", body)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/eidng8/go-utils"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Denotes that a redirect URI is not an absolute URL, or has fragment.
+var msgInvalidRedirectUri interface{} = "invalid_redirect_uri"
+
+// Denotes that the grant types don't suit the client, such as the
+// authorization code grant without redirect URI, or the client credentials
+// grant without user or by a public client.
+var msgInvalidGrantTypes interface{} = "invalid_grant_types"
+
+// Denotes that the user of the client doesn't exist, or belongs to another
+// organization.
+var msgInvalidUser interface{} = "invalid_user"
+
+// Denotes that the public key isn't a PEM encoded RSA or EC public key, or is
+// given to a public client.
+var msgInvalidPublicKey interface{} = "invalid_public_key"
+
+// CreateOauthClient registers an OAuth client of an organization. The secret
+// of confidential clients is only returned by this endpoint. Clients
+// registering a public key authenticate with JWT assertions instead, and get
+// no secret.
+//
+// Endpoint: POST /oauth-clients
+func (s Server) CreateOauthClient(
+	ctx context.Context, request CreateOauthClientRequestObject,
+) (CreateOauthClientResponseObject, error) {
+	body := request.Body
+	public := nil != body.Public && *body.Public
+	grants := stringsOf(body.GrantTypes)
+	var uris []string
+	if nil != body.RedirectUris {
+		uris = *body.RedirectUris
+	}
+	msg := validateOauthClient(public, grants, uris, body.UserId)
+	if nil == msg && nil != body.PublicKey {
+		msg = validatePublicKey(public, *body.PublicKey)
+	}
+	if nil != msg {
+		return CreateOauthClient400JSONResponse{
+			N400JSONResponse: N400JSONResponse{
+				Code:   http.StatusBadRequest,
+				Errors: &msg,
+				Status: msgError,
+			},
+		}, nil
+	}
+	organization, err := s.creationTenant(ctx, body.OrganizationId)
+	if err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return CreateOauthClient403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateOauthClient error: %v", err)
+		return nil, err
+	}
+	clientId, err := utils.RandomAlphaNum(24)
+	if err != nil {
+		api.Log.Debugf("CreateOauthClient error: %v", err)
+		return nil, err
+	}
+	var secret *string
+	if !public && nil == body.PublicKey {
+		ss, err := utils.RandomAlphaNum(40)
+		if err != nil {
+			api.Log.Debugf("CreateOauthClient error: %v", err)
+			return nil, err
+		}
+		secret = &ss
+	}
+	c, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			if nil != body.UserId {
+				exists, err := tx.User.Query().
+					Where(
+						user.IDEQ(*body.UserId),
+						user.OrganizationIDEQ(organization),
+					).Exist(qc)
+				if err != nil {
+					return nil, err
+				}
+				if !exists {
+					return nil, errInvalidArgument
+				}
+			}
+			create := tx.OauthClient.Create().
+				SetOrganizationID(organization).SetName(body.Name).
+				SetClientID(clientId).SetPublic(public).
+				SetGrantTypes(grants).SetNillableUserID(body.UserId).
+				SetNillablePublicKey(body.PublicKey)
+			if nil != uris {
+				create.SetRedirectUris(uris)
+			}
+			if nil != secret {
+				create.SetSecret(hashClientSecret(*secret))
+			}
+			return create.Save(qc)
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errInvalidArgument) {
+			return CreateOauthClient400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidUser,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsForeignKeyError(err) {
+			return CreateOauthClient400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateOauthClient error: %v", err)
+		return nil, err
+	}
+	client := c.(*ent.OauthClient)
+	res := CreateOauthClient201JSONResponse{
+		Id:             client.ID,
+		OrganizationId: client.OrganizationID,
+		Name:           client.Name,
+		ClientId:       client.ClientID,
+		ClientSecret:   secret,
+		Public:         client.Public,
+		GrantTypes: stringsTo[OauthClientCreateGrantTypes](
+			client.GrantTypes,
+		),
+		PublicKey: client.PublicKey,
+		UserId:    client.UserID,
+		CreatedAt: client.CreatedAt,
+		UpdatedAt: client.UpdatedAt,
+	}
+	if nil != client.RedirectUris {
+		res.RedirectUris = &client.RedirectUris
+	}
+	return res, nil
+}
+
+// Checks the registration of the client, returns the error message if it's
+// invalid.
+func validateOauthClient(
+	public bool, grants, uris []string, userId *uint64,
+) interface{} {
+	for _, u := range uris {
+		p, err := url.Parse(u)
+		if err != nil || !p.IsAbs() || "" == p.Host || "" != p.Fragment {
+			return msgInvalidRedirectUri
+		}
+	}
+	if slices.Contains(grants, api.OauthGrantAuthorizationCode) &&
+		0 == len(uris) {
+		return msgInvalidGrantTypes
+	}
+	if slices.Contains(grants, api.OauthGrantClientCredentials) &&
+		(public || nil == userId) {
+		return msgInvalidGrantTypes
+	}
+	return nil
+}
+
+// Checks the public key of the client, returns the error message if it's
+// invalid. Public clients can't hold keys.
+func validatePublicKey(public bool, key string) interface{} {
+	if public {
+		return msgInvalidPublicKey
+	}
+	if _, err := parseOauthPublicKey(key); err != nil {
+		return msgInvalidPublicKey
+	}
+	return nil
+}
@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+)
+
+func Test_CreateOauthClient_creates_a_confidential_client(t *testing.T) {
+	uris := []string{testOauthRedirectUri}
+	body := CreateOauthClientJSONBody{
+		Name: "app",
+		GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+			api.OauthGrantAuthorizationCode, api.OauthGrantRefreshToken,
+		},
+		RedirectUris: &uris,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/oauth-clients", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, OauthClientCreate{}, res)
+	require.Equal(t, "app", actual.Name)
+	require.Len(t, actual.ClientId, 24)
+	require.NotNil(t, actual.ClientSecret)
+	require.Len(t, *actual.ClientSecret, 40)
+	require.False(t, actual.Public)
+	require.Equal(t, uris, *actual.RedirectUris)
+	require.Equal(
+		t, []OauthClientCreateGrantTypes{
+			api.OauthGrantAuthorizationCode, api.OauthGrantRefreshToken,
+		}, actual.GrantTypes,
+	)
+	row, err := db.OauthClient.Query().Where(oauthclient.IDEQ(actual.Id)).
+		Only(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, actual.ClientId, row.ClientID)
+	// only the hash of the secret is stored
+	require.Equal(t, hashClientSecret(*actual.ClientSecret), *row.Secret)
+}
+
+func Test_CreateOauthClient_creates_a_public_client_without_secret(t *testing.T) {
+	uris := []string{testOauthRedirectUri}
+	public := true
+	body := CreateOauthClientJSONBody{
+		Name: "cli",
+		GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+			api.OauthGrantAuthorizationCode,
+		},
+		RedirectUris: &uris,
+		Public:       &public,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/oauth-clients", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, OauthClientCreate{}, res)
+	require.True(t, actual.Public)
+	require.Nil(t, actual.ClientSecret)
+	row := db.OauthClient.Query().Where(oauthclient.IDEQ(actual.Id)).
+		OnlyX(context.Background())
+	require.Nil(t, row.Secret)
+}
+
+func Test_CreateOauthClient_creates_a_client_acting_as_user(t *testing.T) {
+	uid := uint64(2)
+	body := CreateOauthClientJSONBody{
+		Name: "service",
+		GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+			api.OauthGrantClientCredentials,
+		},
+		UserId: &uid,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/oauth-clients", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, OauthClientCreate{}, res)
+	require.Equal(t, uid, *actual.UserId)
+	require.Nil(t, actual.RedirectUris)
+}
+
+func Test_CreateOauthClient_returns_400_if_invalid(t *testing.T) {
+	uid := uint64(2)
+	missing := uint64(12345)
+	public := true
+	tests := []struct {
+		name     string
+		grant    CreateOauthClientJSONBodyGrantTypes
+		uris     *[]string
+		public   *bool
+		user     *uint64
+		expected string
+	}{
+		{
+			"relative redirect uri", api.OauthGrantAuthorizationCode,
+			&[]string{"/callback"}, nil, nil, "invalid_redirect_uri",
+		},
+		{
+			"redirect uri with fragment", api.OauthGrantAuthorizationCode,
+			&[]string{testOauthRedirectUri + "#x"}, nil, nil,
+			"invalid_redirect_uri",
+		},
+		{
+			"code grant without redirect uri",
+			api.OauthGrantAuthorizationCode, nil, nil, nil,
+			"invalid_grant_types",
+		},
+		{
+			"client credentials without user",
+			api.OauthGrantClientCredentials, nil, nil, nil,
+			"invalid_grant_types",
+		},
+		{
+			"client credentials of public client",
+			api.OauthGrantClientCredentials, nil, &public, &uid,
+			"invalid_grant_types",
+		},
+		{
+			"user not found", api.OauthGrantClientCredentials, nil, nil,
+			&missing, "invalid_user",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				body := CreateOauthClientJSONBody{
+					Name: "app",
+					GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+						tt.grant,
+					},
+					RedirectUris: tt.uris,
+					Public:       tt.public,
+					UserId:       tt.user,
+				}
+				svr, engine, db, res := setupTestCase(t, false)
+				u := getUserById(t, db, 1)
+				req, err := svr.postAs(u, "/oauth-clients", body)
+				require.Nil(t, err)
+				engine.ServeHTTP(res, req)
+				require.Equal(t, http.StatusBadRequest, res.Code)
+				require.JSONEq(
+					t,
+					`{"code":400,"errors":"`+tt.expected+`","status":"error"}`,
+					res.Body.String(),
+				)
+			},
+		)
+	}
+}
+
+func Test_CreateOauthClient_creates_a_client_with_public_key(t *testing.T) {
+	uid := uint64(2)
+	_, pub := newAssertionKey(t)
+	body := CreateOauthClientJSONBody{
+		Name: "service",
+		GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+			api.OauthGrantClientCredentials,
+		},
+		UserId:    &uid,
+		PublicKey: &pub,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/oauth-clients", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	actual := unmarshalResponse(t, OauthClientCreate{}, res)
+	// clients with public key authenticate with assertions only
+	require.Nil(t, actual.ClientSecret)
+	require.Equal(t, pub, *actual.PublicKey)
+	row := db.OauthClient.Query().Where(oauthclient.IDEQ(actual.Id)).
+		OnlyX(context.Background())
+	require.Nil(t, row.Secret)
+}
+
+func Test_CreateOauthClient_returns_400_if_public_key_invalid(t *testing.T) {
+	uris := []string{testOauthRedirectUri}
+	_, pub := newAssertionKey(t)
+	public := true
+	invalid := "not a key"
+	tests := []struct {
+		name   string
+		key    *string
+		public *bool
+	}{
+		{"not a key", &invalid, nil},
+		{"public client", &pub, &public},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				body := CreateOauthClientJSONBody{
+					Name: "app",
+					GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+						api.OauthGrantAuthorizationCode,
+					},
+					RedirectUris: &uris,
+					Public:       tt.public,
+					PublicKey:    tt.key,
+				}
+				svr, engine, db, res := setupTestCase(t, false)
+				u := getUserById(t, db, 1)
+				req, err := svr.postAs(u, "/oauth-clients", body)
+				require.Nil(t, err)
+				engine.ServeHTTP(res, req)
+				require.Equal(t, http.StatusBadRequest, res.Code)
+				require.JSONEq(
+					t,
+					`{"code":400,"errors":"invalid_public_key","status":"error"}`,
+					res.Body.String(),
+				)
+			},
+		)
+	}
+}
+
+func Test_CreateOauthClient_returns_422_if_unknown_grant_type(t *testing.T) {
+	body := CreateOauthClientJSONBody{
+		Name:       "app",
+		GrantTypes: []CreateOauthClientJSONBodyGrantTypes{"password"},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/oauth-clients", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_CreateOauthClient_returns_403_if_user_without_permission(t *testing.T) {
+	body := CreateOauthClientJSONBody{
+		Name: "app",
+		GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+			api.OauthGrantRefreshToken,
+		},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 3)
+	req, err := svr.postAs(u, "/oauth-clients", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_CreateOauthClient_creates_in_own_organization(t *testing.T) {
+	uris := []string{testOauthRedirectUri}
+	body := CreateOauthClientJSONBody{
+		Name: "app",
+		GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+			api.OauthGrantAuthorizationCode,
+		},
+		RedirectUris: &uris,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	o, u, _ := seedOrganization(t, db, "auth:CreateOauthClient")
+	req, err := svr.postAs(u, "/oauth-clients", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, OauthClientCreate{}, res)
+	require.Equal(t, o.ID, actual.OrganizationId)
+}
+
+func Test_CreateOauthClient_returns_400_if_user_of_other_organization(t *testing.T) {
+	uid := uint64(2)
+	body := CreateOauthClientJSONBody{
+		Name: "service",
+		GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+			api.OauthGrantClientCredentials,
+		},
+		UserId: &uid,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:CreateOauthClient")
+	req, err := svr.postAs(u, "/oauth-clients", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.JSONEq(
+		t, `{"code":400,"errors":"invalid_user","status":"error"}`,
+		res.Body.String(),
+	)
+}
+
+func Test_CreateOauthClient_returns_403_if_other_organization(t *testing.T) {
+	org := uint32(api.DefaultOrganizationId)
+	uris := []string{testOauthRedirectUri}
+	body := CreateOauthClientJSONBody{
+		Name: "app",
+		GrantTypes: []CreateOauthClientJSONBodyGrantTypes{
+			api.OauthGrantAuthorizationCode,
+		},
+		RedirectUris:   &uris,
+		OrganizationId: &org,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:CreateOauthClient")
+	req, err := svr.postAs(u, "/oauth-clients", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.Zero(t, db.OauthClient.Query().CountX(context.Background()))
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// CreateOrganization creates an organization. Only platform administrators
+// can create organizations.
+//
+// Endpoint: POST /organizations
+func (s Server) CreateOrganization(
+	ctx context.Context, request CreateOrganizationRequestObject,
+) (CreateOrganizationResponseObject, error) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("CreateOrganization error: %v", err)
+		return nil, err
+	}
+	if nil != tenant {
+		return CreateOrganization403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	o, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			create := tx.Organization.Create().SetName(request.Body.Name)
+			if request.Body.Description != nil {
+				create.SetDescription(*request.Body.Description)
+			}
+			return create.Save(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsUniqueKeyError(err) {
+			return CreateOrganization400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgExists,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateOrganization error: %v", err)
+		return nil, err
+	}
+	org := o.(*ent.Organization)
+	return CreateOrganization201JSONResponse{
+		Id:          org.ID,
+		Name:        org.Name,
+		Description: &org.Description,
+		CreatedAt:   org.CreatedAt,
+		UpdatedAt:   org.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+)
+
+func Test_CreateOrganization_creates_an_organization(t *testing.T) {
+	desc := "acme description"
+	body := CreateOrganizationJSONBody{Name: "acme", Description: &desc}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/organizations", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, Organization{}, res)
+	require.Equal(t, body.Name, actual.Name)
+	require.Equal(t, body.Description, actual.Description)
+	require.GreaterOrEqual(t, *actual.CreatedAt, startTime)
+	require.True(
+		t, db.Organization.Query().Where(organization.NameEQ(body.Name)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_CreateOrganization_returns_400_if_name_exists(t *testing.T) {
+	body := CreateOrganizationJSONBody{Name: "default"}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/organizations", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_CreateOrganization_returns_401_if_non_user(t *testing.T) {
+	body := CreateOrganizationJSONBody{Name: "acme"}
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/organizations", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_CreateOrganization_returns_403_if_user_without_permission(t *testing.T) {
+	body := CreateOrganizationJSONBody{Name: "acme"}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.postAs(u, "/organizations", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_CreateOrganization_returns_403_if_not_platform_admin(t *testing.T) {
+	body := CreateOrganizationJSONBody{Name: "acme"}
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:CreateOrganization")
+	req, err := svr.postAs(u, "/organizations", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.False(
+		t, db.Organization.Query().Where(organization.NameEQ(body.Name)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_CreateOrganization_returns_500_if_db_error_unhandled(t *testing.T) {
+	body := CreateOrganizationJSONBody{Name: "acme"}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/organizations", body)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
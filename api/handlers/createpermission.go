@@ -2,17 +2,33 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
 )
 
-// CreatePermission creates a permission.
+// CreatePermission creates a permission. Permissions are shared by all
+// organizations, only platform administrators can create them.
 //
 // Endpoint: POST /permissions
 func (s Server) CreatePermission(
-	_ context.Context, request CreatePermissionRequestObject,
+	ctx context.Context, request CreatePermissionRequestObject,
 ) (CreatePermissionResponseObject, error) {
+	if err := s.requirePlatform(ctx); err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return CreatePermission403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreatePermission error: %v", err)
+		return nil, err
+	}
 	p, err := s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
@@ -87,6 +87,20 @@ func Test_CreatePermission_returns_403_if_user_without_permission(t *testing.T)
 	)
 }
 
+func Test_CreatePermission_returns_403_if_tenant_admin(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:CreatePermission")
+	body := CreatePermissionJSONBody{Name: "test:Tenant"}
+	req, err := svr.postAs(u, "/permissions", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.False(
+		t, db.Permission.Query().Where(permission.NameEQ(body.Name)).
+			ExistX(context.Background()),
+	)
+}
+
 func Test_CreatePermission_reports_422_if_no_name(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	count := db.Permission.Query().CountX(context.Background())
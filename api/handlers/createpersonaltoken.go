@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -25,6 +26,16 @@ func (s Server) CreatePersonalToken(
 		api.Log.Debugf("create personal token failed: %v", err)
 		return CreatePersonalToken401JSONResponse{}, nil
 	}
+	// long-lived tokens must not be issued to impersonators
+	if nil != at.actor {
+		return CreatePersonalToken403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
 	uuid7, pt, err := s.issuePersonalToken(
 		at.user, request.Body.Scopes,
 		time.Second*time.Duration(request.Body.Ttl),
@@ -90,6 +90,24 @@ func Test_CreatePersonalToken_returns_403_if_user_without_permission(t *testing.
 	)
 }
 
+func Test_CreatePersonalToken_returns_403_if_impersonating(t *testing.T) {
+	body := CreatePersonalTokenJSONBody{
+		Description: "test_perm", Scopes: []string{"read", "write"}, Ttl: 3600,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:CreatePersonalToken")
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.post("/personal-tokens", body)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.False(
+		t, db.PersonalToken.Query().
+			Where(personaltoken.UserIDEQ(2)).ExistX(context.Background()),
+	)
+}
+
 func Test_CreatePersonalToken_reports_422_if_no_description(t *testing.T) {
 	body := CreatePersonalTokenJSONBody{
 		Scopes: []string{"read", "write"}, Ttl: 3600,
@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
+	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
 )
 
@@ -11,19 +13,69 @@ import (
 //
 // Endpoint: POST /roles
 func (s Server) CreateRole(
-	_ context.Context, request CreateRoleRequestObject,
+	ctx context.Context, request CreateRoleRequestObject,
 ) (CreateRoleResponseObject, error) {
+	organization, err := s.creationTenant(ctx, request.Body.OrganizationId)
+	if err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return CreateRole403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateRole error: %v", err)
+		return nil, err
+	}
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("CreateRole error: %v", err)
+		return nil, err
+	}
 	p, err := s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
-			create := tx.Role.Create().SetName(request.Body.Name)
+			create := tx.Role.Create().SetOrganizationID(organization).
+				SetName(request.Body.Name)
 			if request.Body.Description != nil {
 				create.SetDescription(*request.Body.Description)
 			}
+			if request.Body.RequireMfa != nil {
+				create.SetRequireMfa(*request.Body.RequireMfa)
+			}
+			if request.Body.Approvers != nil {
+				err := checkTenantUsers(
+					qc, tx, organization, *request.Body.Approvers,
+				)
+				if err != nil {
+					return nil, err
+				}
+				create.AddApproverIDs(*request.Body.Approvers...)
+			}
+			if request.Body.Permissions != nil {
+				err := checkTenantPermissions(
+					qc, tx, tenant, *request.Body.Permissions,
+				)
+				if err != nil {
+					return nil, err
+				}
+				create.AddPermissionIDs(*request.Body.Permissions...)
+			}
 			return create.Save(qc)
 		},
 	)
 	if err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return CreateRole403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
 		if ent.IsUniqueKeyError(err) {
 			return CreateRole400JSONResponse{
 				N400JSONResponse: N400JSONResponse{
@@ -33,6 +85,15 @@ func (s Server) CreateRole(
 				},
 			}, nil
 		}
+		if ent.IsForeignKeyError(err) || errors.Is(err, errCrossTenant) {
+			return CreateRole400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
 		return nil, err
 	}
 	perm := p.(*ent.Role)
@@ -40,6 +101,7 @@ func (s Server) CreateRole(
 		Id:          perm.ID,
 		Name:        perm.Name,
 		Description: &perm.Description,
+		RequireMfa:  perm.RequireMfa,
 		CreatedAt:   perm.CreatedAt,
 	}, nil
 }
@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/permission"
 	"github.com/eidng8/go-attr-rbac/ent/role"
 )
 
@@ -54,6 +56,96 @@ func Test_CreateRole_creates_a_role_with_description(t *testing.T) {
 	require.Equal(t, actual.Id, row.ID)
 }
 
+func Test_CreateRole_creates_a_role_with_approvers(t *testing.T) {
+	body := CreateRoleJSONBody{Name: "test_role", Approvers: &[]uint64{2, 3}}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/roles", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, Role{}, res)
+	require.Equal(
+		t, []uint64{2, 3},
+		db.Role.Query().Where(role.IDEQ(actual.Id)).QueryApprovers().
+			IDsX(context.Background()),
+	)
+}
+
+func Test_CreateRole_creates_a_role_requiring_mfa(t *testing.T) {
+	mfa := true
+	body := CreateRoleJSONBody{Name: "test_role", RequireMfa: &mfa}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/roles", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, CreateRole201JSONResponse{}, res)
+	require.True(t, actual.RequireMfa)
+	require.True(
+		t, db.Role.GetX(context.Background(), actual.Id).RequireMfa,
+	)
+}
+
+func Test_CreateRole_reports_400_if_approver_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, other, _ := seedOrganization(t, db)
+	body := CreateRoleJSONBody{
+		Name: "test_role", Approvers: &[]uint64{other.ID},
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/roles", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusBadRequest,
+		"errors": msgInvalidAssignment,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+	require.False(
+		t, db.Role.Query().Where(role.NameEQ(body.Name)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_CreateRole_creates_a_role_with_permissions(t *testing.T) {
+	body := CreateRoleJSONBody{Name: "test_role", Permissions: &[]uint32{5, 6}}
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.postAs(getUserById(t, db, 1), "/roles", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	require.Equal(
+		t, []uint32{5, 6},
+		db.Role.Query().Where(role.NameEQ(body.Name)).QueryPermissions().
+			Order(permission.ByID()).IDsX(context.Background()),
+	)
+}
+
+func Test_CreateRole_returns_403_if_tenant_grants_platform_permission(
+	t *testing.T,
+) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:CreateRole")
+	body := CreateRoleJSONBody{
+		Name: "test_role",
+		Permissions: &[]uint32{
+			getPermissionId(t, db, api.PermissionCrossTenant),
+		},
+	}
+	req, err := svr.postAs(u, "/roles", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.False(
+		t, db.Role.Query().Where(role.NameEQ(body.Name)).
+			ExistX(context.Background()),
+	)
+}
+
 func Test_CreateRole_returns_401_if_non_user(t *testing.T) {
 	desc := "test descriptions"
 	body := CreateRoleJSONBody{Name: "test_role", Description: &desc}
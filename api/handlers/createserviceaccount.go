@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eidng8/go-utils"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+)
+
+// Denotes that the owner group of the service account doesn't exist.
+var msgInvalidOwner interface{} = "invalid_owner"
+
+// CreateServiceAccount creates a service account in the organization of the
+// owner group. The account is backed by a user without password, which can't
+// log in, and gets its roles through the role assignments of the user. It
+// authenticates with the client credentials grant of the OAuth token
+// endpoint, using the returned client ID and either the secret, which is only
+// returned by this endpoint, or JWT assertions signed with the private key of
+// the given public key.
+//
+// Endpoint: POST /service-accounts
+func (s Server) CreateServiceAccount(
+	ctx context.Context, request CreateServiceAccountRequestObject,
+) (CreateServiceAccountResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	body := request.Body
+	if nil != body.PublicKey {
+		if msg := validatePublicKey(false, *body.PublicKey); nil != msg {
+			return CreateServiceAccount400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msg,
+					Status: msgError,
+				},
+			}, nil
+		}
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("CreateServiceAccount error: %v", err)
+		return nil, err
+	}
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("CreateServiceAccount error: %v", err)
+		return nil, err
+	}
+	clientId, err := utils.RandomAlphaNum(24)
+	if err != nil {
+		api.Log.Debugf("CreateServiceAccount error: %v", err)
+		return nil, err
+	}
+	var secret *string
+	if nil == body.PublicKey {
+		ss, err := utils.RandomAlphaNum(40)
+		if err != nil {
+			api.Log.Debugf("CreateServiceAccount error: %v", err)
+			return nil, err
+		}
+		secret = &ss
+	}
+	a, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			g, err := tx.Group.Query().
+				Where(group.IDEQ(body.OwnerId), scope).Only(qc)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					return nil, errInvalidArgument
+				}
+				return nil, err
+			}
+			u, err := tx.User.Create().SetOrganizationID(g.OrganizationID).
+				SetUsername(body.Name).SetIsServiceAccount(true).Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			create := tx.OauthClient.Create().
+				SetOrganizationID(g.OrganizationID).SetName(body.Name).
+				SetClientID(clientId).
+				SetGrantTypes([]string{api.OauthGrantClientCredentials}).
+				SetUserID(u.ID).SetNillablePublicKey(body.PublicKey)
+			if nil != secret {
+				create.SetSecret(hashClientSecret(*secret))
+			}
+			if err = create.Exec(qc); err != nil {
+				return nil, err
+			}
+			sa, err := tx.ServiceAccount.Create().SetName(body.Name).
+				SetNillableDescription(body.Description).SetOwnerID(g.ID).
+				SetUserID(u.ID).SetClientID(clientId).Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = recordAudit(
+				qc, tx, api.AuditServiceAccountCreated, g.OrganizationID,
+				token, map[string]interface{}{
+					"service_account_id": sa.ID,
+					"name":               sa.Name,
+					"owner_id":           sa.OwnerID,
+					"user_id":            sa.UserID,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			return sa, nil
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errInvalidArgument) {
+			return CreateServiceAccount400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidOwner,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsUniqueKeyError(err) {
+			return CreateServiceAccount400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgExists,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateServiceAccount error: %v", err)
+		return nil, err
+	}
+	sa := a.(*ent.ServiceAccount)
+	return CreateServiceAccount201JSONResponse{
+		Id:           sa.ID,
+		Name:         sa.Name,
+		Description:  &sa.Description,
+		OwnerId:      sa.OwnerID,
+		UserId:       sa.UserID,
+		ClientId:     sa.ClientID,
+		ClientSecret: secret,
+		CreatedAt:    sa.CreatedAt,
+		UpdatedAt:    sa.UpdatedAt,
+	}, nil
+}
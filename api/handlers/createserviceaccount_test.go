@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_CreateServiceAccount_creates_an_account_with_secret(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	g := seedGroup(t, db, nil, nil)
+	desc := "deploys builds"
+	body := CreateServiceAccountJSONBody{
+		Name: "ci", Description: &desc, OwnerId: g.ID,
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/service-accounts", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	actual := unmarshalResponse(t, ServiceAccountCreate{}, res)
+	require.Equal(t, "ci", actual.Name)
+	require.Equal(t, desc, *actual.Description)
+	require.Equal(t, g.ID, actual.OwnerId)
+	require.Len(t, actual.ClientId, 24)
+	require.NotNil(t, actual.ClientSecret)
+	require.Len(t, *actual.ClientSecret, 40)
+	// the account is backed by a user without password
+	su := db.User.Query().Where(user.IDEQ(actual.UserId)).OnlyX(qc)
+	require.True(t, su.IsServiceAccount)
+	require.Equal(t, "ci", su.Username)
+	require.Empty(t, su.Password)
+	require.Equal(t, g.OrganizationID, su.OrganizationID)
+	c := db.OauthClient.Query().
+		Where(oauthclient.ClientIDEQ(actual.ClientId)).OnlyX(qc)
+	require.Equal(t, actual.UserId, *c.UserID)
+	require.Equal(t, []string{api.OauthGrantClientCredentials}, c.GrantTypes)
+	require.Equal(t, hashClientSecret(*actual.ClientSecret), *c.Secret)
+	logs := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditServiceAccountCreated)).AllX(qc)
+	require.Len(t, logs, 1)
+	require.Equal(t, u.ID, *logs[0].ActorID)
+	require.Equal(t, float64(actual.Id), logs[0].Details["service_account_id"])
+}
+
+func Test_CreateServiceAccount_creates_an_account_with_public_key(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	_, pub := newAssertionKey(t)
+	body := CreateServiceAccountJSONBody{
+		Name: "ci", OwnerId: g.ID, PublicKey: &pub,
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/service-accounts", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	actual := unmarshalResponse(t, ServiceAccountCreate{}, res)
+	require.Nil(t, actual.ClientSecret)
+	c := db.OauthClient.Query().
+		Where(oauthclient.ClientIDEQ(actual.ClientId)).
+		OnlyX(context.Background())
+	require.Nil(t, c.Secret)
+	require.Equal(t, pub, *c.PublicKey)
+}
+
+func Test_CreateServiceAccount_returns_400_if_public_key_invalid(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	key := "not a key"
+	body := CreateServiceAccountJSONBody{
+		Name: "ci", OwnerId: g.ID, PublicKey: &key,
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/service-accounts", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Contains(t, res.Body.String(), "invalid_public_key")
+}
+
+func Test_CreateServiceAccount_returns_400_if_owner_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	body := CreateServiceAccountJSONBody{Name: "ci", OwnerId: 12345}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/service-accounts", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Contains(t, res.Body.String(), "invalid_owner")
+	require.False(
+		t, db.User.Query().Where(user.UsernameEQ("ci")).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_CreateServiceAccount_returns_400_if_owner_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	_, u, _ := seedOrganization(t, db, "auth:CreateServiceAccount")
+	body := CreateServiceAccountJSONBody{Name: "ci", OwnerId: g.ID}
+	req, err := svr.postAs(u, "/service-accounts", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Contains(t, res.Body.String(), "invalid_owner")
+}
+
+func Test_CreateServiceAccount_returns_400_if_name_exists(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	a, _, _ := seedServiceAccount(t, db, g, nil)
+	body := CreateServiceAccountJSONBody{Name: a.Name, OwnerId: g.ID}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/service-accounts", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Contains(t, res.Body.String(), "already_exists")
+	require.Equal(t, 1, db.ServiceAccount.Query().CountX(context.Background()))
+}
+
+func Test_CreateServiceAccount_takes_name_of_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	// service accounts have a username namespace of their own
+	body := CreateServiceAccountJSONBody{Name: "user0", OwnerId: g.ID}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/service-accounts", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	id := db.User.Query().
+		Where(user.UsernameEQ("user0"), user.IsServiceAccountEQ(false)).
+		OnlyIDX(context.Background())
+	setPassword(t, db, id, "Local_123")
+	res = tryLogin(t, svr, engine, "user0", "Local_123")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+}
+
+func Test_CreateServiceAccount_returns_401_if_non_user(t *testing.T) {
+	body := CreateServiceAccountJSONBody{Name: "ci", OwnerId: 1}
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/service-accounts", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_CreateServiceAccount_returns_403_if_user_without_permission(t *testing.T) {
+	body := CreateServiceAccountJSONBody{Name: "ci", OwnerId: 1}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 3)
+	req, err := svr.postAs(u, "/service-accounts", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_CreateServiceAccount_returns_500_if_db_error_unhandled(t *testing.T) {
+	body := CreateServiceAccountJSONBody{Name: "ci", OwnerId: 1}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/service-accounts", body)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
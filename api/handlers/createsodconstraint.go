@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// CreateSodConstraint creates a separation-of-duties constraint. Users
+// already holding more than one of the roles are not affected, they can be
+// found with ListSodViolations.
+//
+// Endpoint: POST /sod-constraints
+func (s Server) CreateSodConstraint(
+	ctx context.Context, request CreateSodConstraintRequestObject,
+) (CreateSodConstraintResponseObject, error) {
+	organization, err := s.creationTenant(ctx, request.Body.OrganizationId)
+	if err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return CreateSodConstraint403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateSodConstraint error: %v", err)
+		return nil, err
+	}
+	c, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			err := checkTenantRoles(qc, tx, organization, request.Body.Roles)
+			if err != nil {
+				return nil, err
+			}
+			create := tx.SodConstraint.Create().
+				SetOrganizationID(organization).SetName(request.Body.Name).
+				AddRoleIDs(request.Body.Roles...)
+			if request.Body.Description != nil {
+				create.SetDescription(*request.Body.Description)
+			}
+			return create.Save(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsUniqueKeyError(err) {
+			return CreateSodConstraint400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgExists,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsForeignKeyError(err) || errors.Is(err, errCrossTenant) {
+			return CreateSodConstraint400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateSodConstraint error: %v", err)
+		return nil, err
+	}
+	sc := c.(*ent.SodConstraint)
+	return CreateSodConstraint201JSONResponse{
+		Id:             sc.ID,
+		OrganizationId: sc.OrganizationID,
+		Name:           sc.Name,
+		Description:    &sc.Description,
+		CreatedAt:      sc.CreatedAt,
+		UpdatedAt:      sc.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+func Test_CreateSodConstraint_creates_a_constraint(t *testing.T) {
+	desc := "payments"
+	body := CreateSodConstraintJSONBody{
+		Name: "test constraint", Description: &desc, Roles: []uint32{2, 5},
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/sod-constraints", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, SodConstraint{}, res)
+	require.Equal(t, body.Name, actual.Name)
+	require.Equal(t, body.Description, actual.Description)
+	require.Equal(t, uint32(1), actual.OrganizationId)
+	require.Equal(
+		t, body.Roles,
+		db.SodConstraint.Query().Where(sodconstraint.IDEQ(actual.Id)).
+			QueryRoles().Order(role.ByID()).IDsX(context.Background()),
+	)
+}
+
+func Test_CreateSodConstraint_returns_422_if_less_than_2_roles(t *testing.T) {
+	body := CreateSodConstraintJSONBody{
+		Name: "test constraint", Roles: []uint32{2},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/sod-constraints", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_CreateSodConstraint_returns_400_if_name_exists(t *testing.T) {
+	body := CreateSodConstraintJSONBody{
+		Name: "constraint 0", Roles: []uint32{2, 5},
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedSodConstraint(t, db, 3, 4)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/sod-constraints", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_CreateSodConstraint_returns_400_if_role_not_found(t *testing.T) {
+	body := CreateSodConstraintJSONBody{
+		Name: "test constraint", Roles: []uint32{2, 12345},
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/sod-constraints", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.False(
+		t, db.SodConstraint.Query().Where(sodconstraint.NameEQ(body.Name)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_CreateSodConstraint_returns_400_if_role_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, _, tr := seedOrganization(t, db)
+	body := CreateSodConstraintJSONBody{
+		Name: "test constraint", Roles: []uint32{2, tr.ID},
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/sod-constraints", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_CreateSodConstraint_returns_401_if_non_user(t *testing.T) {
+	body := CreateSodConstraintJSONBody{
+		Name: "test constraint", Roles: []uint32{2, 5},
+	}
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/sod-constraints", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_CreateSodConstraint_returns_403_if_user_without_permission(t *testing.T) {
+	body := CreateSodConstraintJSONBody{
+		Name: "test constraint", Roles: []uint32{2, 5},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.postAs(u, "/sod-constraints", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_CreateSodConstraint_returns_500_if_db_error_unhandled(t *testing.T) {
+	body := CreateSodConstraintJSONBody{
+		Name: "test constraint", Roles: []uint32{2, 5},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/sod-constraints", body)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
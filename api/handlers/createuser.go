@@ -5,7 +5,6 @@ import (
 	"errors"
 	"net/http"
 
-	"github.com/eidng8/go-utils"
 	"github.com/oapi-codegen/runtime/types"
 
 	"github.com/eidng8/go-attr-rbac/api"
@@ -16,17 +15,59 @@ import (
 //
 // Endpoint: POST /users
 func (s Server) CreateUser(
-	_ context.Context, request CreateUserRequestObject,
+	ctx context.Context, request CreateUserRequestObject,
 ) (CreateUserResponseObject, error) {
+	organization, err := s.creationTenant(ctx, request.Body.OrganizationId)
+	if err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return CreateUser403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateUser error: %v", err)
+		return nil, err
+	}
 	u, err := s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
-			return createUser(
-				qc, tx.User.Create(), CreateUserJSONBody(*request.Body),
+			roles := request.Body.Roles
+			if nil != roles && len(*roles) > 0 {
+				err := checkTenantRoles(qc, tx, organization, *roles)
+				if err != nil {
+					return nil, err
+				}
+			}
+			u, err := s.createUser(
+				qc, tx.User.Create().SetOrganizationID(organization),
+				CreateUserJSONBody(*request.Body),
+			)
+			if err != nil {
+				return nil, err
+			}
+			if err = checkSeparationOfDuties(qc, tx, u.ID); err != nil {
+				return nil, err
+			}
+			err = emitEvent(
+				qc, tx, u.OrganizationID, api.EventUserCreated,
+				map[string]interface{}{
+					"user_id": u.ID, "username": u.Username,
+				},
 			)
+			if err != nil {
+				return nil, err
+			}
+			return u, nil
 		},
 	)
 	if err != nil {
+		var sv *sodViolation
+		if errors.As(err, &sv) {
+			return CreateUser409JSONResponse{N409JSONResponse: sv.response()}, nil
+		}
 		if ent.IsUniqueKeyError(err) {
 			return CreateUser400JSONResponse{
 				N400JSONResponse: N400JSONResponse{
@@ -35,16 +76,20 @@ func (s Server) CreateUser(
 					Status: msgError,
 				},
 			}, nil
-		} else if errors.Is(err, errPasswordToSimple) {
-			var s interface{} = err.Error()
+		} else if ent.IsForeignKeyError(err) ||
+			errors.Is(err, errCrossTenant) {
 			return CreateUser400JSONResponse{
 				N400JSONResponse: N400JSONResponse{
 					Code:   http.StatusBadRequest,
-					Errors: &s,
+					Errors: &msgInvalidAssignment,
 					Status: msgError,
 				},
 			}, nil
 		}
+		var pe *passwordPolicyError
+		if errors.As(err, &pe) {
+			return CreateUser400JSONResponse{pe.response()}, nil
+		}
 		return nil, err
 	}
 	user := u.(*ent.User)
@@ -61,15 +106,11 @@ func (s Server) CreateUser(
 	return res, nil
 }
 
-func createUser(
+func (s Server) createUser(
 	qc context.Context, tx *ent.UserCreate, data CreateUserJSONBody,
 ) (*ent.User, error) {
 	create := tx.SetUsername(data.Username)
-	if err := validatePassword(data.Password); err != nil {
-		return nil, err
-	}
-	// TODO use a hasher predicate function config instead of hardcoding
-	hash, err := utils.HashPassword(data.Password)
+	hash, err := s.hashNewPassword(data.Password)
 	if err != nil {
 		api.Log.Debugf("createUser error: %v", err)
 		return nil, err
@@ -86,13 +127,3 @@ func createUser(
 	}
 	return create.Save(qc)
 }
-
-func validatePassword(password string) error {
-	if !(numChecker.MatchString(password) &&
-		lowercaseChecker.MatchString(password) &&
-		uppercaseChecker.MatchString(password) &&
-		specialChecker.MatchString(password)) {
-		return errPasswordToSimple
-	}
-	return nil
-}
@@ -16,50 +16,6 @@ import (
 	"github.com/eidng8/go-attr-rbac/ent/user"
 )
 
-func Test_validatePassword_rejects_numeric_only_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("12345678"))
-}
-
-func Test_validatePassword_rejects_uppercase_only_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("ABCDEFGH"))
-}
-
-func Test_validatePassword_rejects_lowercase_only_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("abcdefgh"))
-}
-
-func Test_validatePassword_rejects_special_only_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("#?!@$%^&*-_"))
-}
-
-func Test_validatePassword_rejects_numeric_and_uppercase_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("1234ABCD"))
-}
-
-func Test_validatePassword_rejects_numeric_and_lowercase_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("1234abcd"))
-}
-
-func Test_validatePassword_rejects_numeric_and_special_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("1234#?!@"))
-}
-
-func Test_validatePassword_rejects_uppercase_and_lowercase_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("ABCDabcd"))
-}
-
-func Test_validatePassword_rejects_uppercase_and_special_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("ABCD#?!@"))
-}
-
-func Test_validatePassword_rejects_lowercase_and_special_password(t *testing.T) {
-	require.Equal(t, errPasswordToSimple, validatePassword("abcd#?!@"))
-}
-
-func Test_validatePassword_accepts_complex_password(t *testing.T) {
-	require.Nil(t, validatePassword("Abcd_1234"))
-}
-
 func Test_CreateUser_creates_a_user(t *testing.T) {
 	body := CreateUserJSONBody{Username: "test_user", Password: "Abcd_1234"}
 	svr, engine, db, res := setupTestCase(t, true)
@@ -296,6 +252,23 @@ func Test_CreateUser_reports_400_if_user_exists(t *testing.T) {
 	require.Equal(t, count, db.User.Query().CountX(context.Background()))
 }
 
+func Test_CreateUser_reports_409_if_violates_sod_constraint(t *testing.T) {
+	body := CreateUserJSONBody{
+		Username: "test_user", Password: "Abcd_1234", Roles: &[]uint32{2, 5},
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/users", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	requireSodConflict(t, res, "role 0", "role 3")
+	require.False(
+		t, db.User.Query().Where(user.UsernameEQ(body.Username)).
+			ExistX(context.Background()),
+	)
+}
+
 func Test_CreateUser_returns_500_if_db_error_unhandled(t *testing.T) {
 	body := CreateUserJSONBody{Username: "root", Password: "Abcd_1234"}
 	svr, engine, db, res := setupTestCase(t, false)
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// Denotes that the webhook URL is not an absolute http(s) URL.
+var msgInvalidWebhookUrl interface{} = "invalid_url"
+
+// CreateWebhook creates a webhook subscription to the events of an
+// organization. Only platform administrators can subscribe to other
+// organizations.
+//
+// Endpoint: POST /webhooks
+func (s Server) CreateWebhook(
+	ctx context.Context, request CreateWebhookRequestObject,
+) (CreateWebhookResponseObject, error) {
+	if !validWebhookUrl(request.Body.Url) {
+		return CreateWebhook400JSONResponse{
+			N400JSONResponse: N400JSONResponse{
+				Code:   http.StatusBadRequest,
+				Errors: &msgInvalidWebhookUrl,
+				Status: msgError,
+			},
+		}, nil
+	}
+	organization, err := s.creationTenant(ctx, request.Body.OrganizationId)
+	if err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return CreateWebhook403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateWebhook error: %v", err)
+		return nil, err
+	}
+	w, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			create := tx.Webhook.Create().SetOrganizationID(organization).
+				SetURL(request.Body.Url).
+				SetSecret(request.Body.Secret).
+				SetEvents(stringsOf(request.Body.Events))
+			if request.Body.Active != nil {
+				create.SetActive(*request.Body.Active)
+			}
+			return create.Save(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsForeignKeyError(err) {
+			return CreateWebhook400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("CreateWebhook error: %v", err)
+		return nil, err
+	}
+	wh := w.(*ent.Webhook)
+	return CreateWebhook201JSONResponse{
+		Id:             wh.ID,
+		OrganizationId: wh.OrganizationID,
+		Url:            wh.URL,
+		Events:         stringsTo[WebhookCreateEvents](wh.Events),
+		Active:         wh.Active,
+		CreatedAt:      wh.CreatedAt,
+		UpdatedAt:      wh.UpdatedAt,
+	}, nil
+}
+
+// Checks that the webhook URL is an absolute http or https URL.
+func validWebhookUrl(u string) bool {
+	p, err := url.Parse(u)
+	if err != nil {
+		return false
+	}
+	return ("http" == p.Scheme || "https" == p.Scheme) && "" != p.Host
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+)
+
+func Test_CreateWebhook_creates_a_webhook(t *testing.T) {
+	body := CreateWebhookJSONBody{
+		Url:    "https://example.com/hook",
+		Secret: "0123456789abcdef",
+		Events: []CreateWebhookJSONBodyEvents{
+			CreateWebhookJSONBodyEvents(api.EventUserCreated),
+		},
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/webhooks", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	require.NotContains(t, res.Body.String(), body.Secret)
+	actual := unmarshalResponse(t, WebhookCreate{}, res)
+	require.Equal(t, body.Url, actual.Url)
+	require.Equal(t, []WebhookCreateEvents{"user.created"}, actual.Events)
+	require.True(t, actual.Active)
+	row, err := db.Webhook.Query().Where(webhook.IDEQ(actual.Id)).
+		Only(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, body.Secret, row.Secret)
+	require.Equal(t, []string{api.EventUserCreated}, row.Events)
+}
+
+func Test_CreateWebhook_returns_400_if_url_not_http(t *testing.T) {
+	body := CreateWebhookJSONBody{
+		Url:    "ftp://example.com/hook",
+		Secret: "0123456789abcdef",
+		Events: []CreateWebhookJSONBodyEvents{
+			CreateWebhookJSONBodyEvents(api.EventUserCreated),
+		},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/webhooks", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.JSONEq(
+		t, `{"code":400,"errors":"invalid_url","status":"error"}`,
+		res.Body.String(),
+	)
+}
+
+func Test_CreateWebhook_returns_422_if_unknown_event(t *testing.T) {
+	body := CreateWebhookJSONBody{
+		Url:    "https://example.com/hook",
+		Secret: "0123456789abcdef",
+		Events: []CreateWebhookJSONBodyEvents{"user.unknown"},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/webhooks", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_CreateWebhook_returns_422_if_secret_too_short(t *testing.T) {
+	body := CreateWebhookJSONBody{
+		Url:    "https://example.com/hook",
+		Secret: "short",
+		Events: []CreateWebhookJSONBodyEvents{
+			CreateWebhookJSONBodyEvents(api.EventUserCreated),
+		},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/webhooks", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_CreateWebhook_returns_401_if_non_user(t *testing.T) {
+	body := CreateWebhookJSONBody{
+		Url:    "https://example.com/hook",
+		Secret: "0123456789abcdef",
+		Events: []CreateWebhookJSONBodyEvents{
+			CreateWebhookJSONBodyEvents(api.EventUserCreated),
+		},
+	}
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/webhooks", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_CreateWebhook_returns_403_if_user_without_permission(t *testing.T) {
+	body := CreateWebhookJSONBody{
+		Url:    "https://example.com/hook",
+		Secret: "0123456789abcdef",
+		Events: []CreateWebhookJSONBodyEvents{
+			CreateWebhookJSONBodyEvents(api.EventUserCreated),
+		},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.postAs(u, "/webhooks", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_CreateWebhook_returns_500_if_db_error_unhandled(t *testing.T) {
+	body := CreateWebhookJSONBody{
+		Url:    "https://example.com/hook",
+		Secret: "0123456789abcdef",
+		Events: []CreateWebhookJSONBodyEvents{
+			CreateWebhookJSONBodyEvents(api.EventUserCreated),
+		},
+	}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/webhooks", body)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func Test_CreateWebhook_creates_in_own_organization(t *testing.T) {
+	body := CreateWebhookJSONBody{
+		Url:    "https://example.com/hook",
+		Secret: "0123456789abcdef",
+		Events: []CreateWebhookJSONBodyEvents{
+			CreateWebhookJSONBodyEvents(api.EventUserCreated),
+		},
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	o, u, _ := seedOrganization(t, db, "auth:CreateWebhook")
+	req, err := svr.postAs(u, "/webhooks", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(t, WebhookCreate{}, res)
+	require.Equal(t, o.ID, actual.OrganizationId)
+}
+
+func Test_CreateWebhook_returns_403_if_other_organization(t *testing.T) {
+	org := uint32(api.DefaultOrganizationId)
+	body := CreateWebhookJSONBody{
+		Url:    "https://example.com/hook",
+		Secret: "0123456789abcdef",
+		Events: []CreateWebhookJSONBodyEvents{
+			CreateWebhookJSONBodyEvents(api.EventUserCreated),
+		},
+		OrganizationId: &org,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:CreateWebhook")
+	req, err := svr.postAs(u, "/webhooks", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.Zero(t, db.Webhook.Query().CountX(context.Background()))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/migrate"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
 	"github.com/eidng8/go-attr-rbac/ent/permission"
 	"github.com/eidng8/go-attr-rbac/ent/role"
 	"github.com/eidng8/go-attr-rbac/ent/user"
@@ -64,6 +65,67 @@ func dbSetup(c *ent.Client, params utils.PasswordHashParams) {
 		"auth:AssignRoles",
 		"auth:ListUser",
 		"auth:CreateUser",
+		"auth:DeleteWebhook",
+		"auth:ReadWebhook",
+		"auth:UpdateWebhook",
+		"auth:ListWebhookDeliveries",
+		"auth:ListWebhook",
+		"auth:CreateWebhook",
+		"auth:DeleteOrganization",
+		"auth:ReadOrganization",
+		"auth:UpdateOrganization",
+		"auth:ListOrganization",
+		"auth:CreateOrganization",
+		"auth:IssueScimToken",
+		"auth:RevokeScimToken",
+		"auth:DeleteGroup",
+		"auth:ReadGroup",
+		"auth:UpdateGroup",
+		"auth:ListGroupRoles",
+		"auth:AssignGroupRoles",
+		"auth:RemoveGroupMember",
+		"auth:ListGroupUsers",
+		"auth:AddGroupMembers",
+		"auth:ListGroup",
+		"auth:CreateGroup",
+		"auth:ListAuditLog",
+		"auth:DeleteSodConstraint",
+		"auth:ReadSodConstraint",
+		"auth:UpdateSodConstraint",
+		"auth:ListSodConstraintRoles",
+		"auth:ListSodViolations",
+		"auth:ListSodConstraint",
+		"auth:CreateSodConstraint",
+		"auth:ListRoleApprovers",
+		"auth:ReadAccessRequest",
+		"auth:ApproveAccessRequest",
+		"auth:DenyAccessRequest",
+		"auth:ListAccessRequest",
+		"auth:CreateAccessRequest",
+		"auth:Impersonate",
+		"auth:UnlockUser",
+		"auth:DisableUser",
+		"auth:EnableUser",
+		"auth:EnrollTotp",
+		"auth:ConfirmTotp",
+		"auth:DisableTotp",
+		"auth:BeginWebauthnRegistration",
+		"auth:FinishWebauthnRegistration",
+		"auth:ChangePassword",
+		"auth:SetUserPassword",
+		"auth:DeleteOauthClient",
+		"auth:ReadOauthClient",
+		"auth:ListOauthClient",
+		"auth:CreateOauthClient",
+		"auth:DeleteServiceAccount",
+		"auth:ReadServiceAccount",
+		"auth:ListServiceAccount",
+		"auth:CreateServiceAccount",
+		"auth:ListMySessions",
+		"auth:DeleteMySession",
+		"auth:RevokeUserSessions",
+		"auth:RevokeUserTokens",
+		api.PermissionCrossTenant,
 	}
 	rows := c.Permission.Query().Where(permission.NameIn(perms...)).
 		Select(permission.FieldName).AllX(qc)
@@ -82,6 +144,13 @@ func dbSetup(c *ent.Client, params utils.PasswordHashParams) {
 		c.Permission.CreateBulk(p...).ExecX(qc)
 	}
 
+	// make sure the default organization exists
+	if !c.Organization.Query().
+		Where(organization.IDEQ(api.DefaultOrganizationId)).ExistX(qc) {
+		c.Organization.Create().SetID(api.DefaultOrganizationId).
+			SetName("default").ExecX(qc)
+	}
+
 	// make root role exists, don't do anything if it exists
 	r := c.Role.Query().Where(role.IDEQ(1)).FirstX(qc)
 	if nil == r {
@@ -110,7 +179,7 @@ func dbSetup(c *ent.Client, params utils.PasswordHashParams) {
 		pass := make([]byte, 32)
 		_, err := rand.Read(pass)
 		utils.PanicIfError(err)
-		hash, err := utils.HashPassword(string(pass))
+		hash, err := utils.HashPasswordWithParams(string(pass), params)
 		utils.PanicIfError(err)
 		u = c.User.Create().SetID(1).SetUsername("root").
 			SetPassword(hash).
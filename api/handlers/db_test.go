@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/eidng8/go-utils"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/migrate"
+	"github.com/eidng8/go-attr-rbac/ent/permission"
 	"github.com/eidng8/go-attr-rbac/ent/role"
 	"github.com/eidng8/go-attr-rbac/ent/user"
 )
@@ -53,6 +56,118 @@ func seedPersonalTokens(tb testing.TB, db *ent.Client, user uint64) {
 	db.PersonalToken.CreateBulk(tokens...).ExecX(qc)
 }
 
+func seedWebhook(
+	tb testing.TB, db *ent.Client, url string, events ...string,
+) *ent.Webhook {
+	w, err := db.Webhook.Create().SetURL(url).SetSecret(randomSecret(32)).
+		SetEvents(events).Save(context.Background())
+	require.Nil(tb, err)
+	return w
+}
+
+// Registers an OAuth client redirecting to testOauthRedirectUri, returns the
+// client and its secret, which is empty for public clients.
+func seedOauthClient(
+	tb testing.TB, db *ent.Client, public bool, user *uint64,
+	grants ...string,
+) (*ent.OauthClient, string) {
+	id, err := utils.RandomAlphaNum(24)
+	require.Nil(tb, err)
+	create := db.OauthClient.Create().SetName("app").SetClientID(id).
+		SetPublic(public).SetGrantTypes(grants).SetNillableUserID(user).
+		SetRedirectUris([]string{testOauthRedirectUri})
+	secret := ""
+	if !public {
+		secret, err = utils.RandomAlphaNum(40)
+		require.Nil(tb, err)
+		create.SetSecret(hashClientSecret(secret))
+	}
+	c, err := create.Save(context.Background())
+	require.Nil(tb, err)
+	return c, secret
+}
+
+// Creates a service account owned by the group, with its user and OAuth
+// client. The client authenticates with the public key if given, otherwise
+// with the returned secret.
+func seedServiceAccount(
+	tb testing.TB, db *ent.Client, owner *ent.Group, publicKey *string,
+) (*ent.ServiceAccount, *ent.OauthClient, string) {
+	qc := context.Background()
+	u, err := db.User.Create().SetOrganizationID(owner.OrganizationID).
+		SetUsername("service 0").SetIsServiceAccount(true).Save(qc)
+	require.Nil(tb, err)
+	c, secret := seedOauthClient(
+		tb, db, false, &u.ID, api.OauthGrantClientCredentials,
+	)
+	if nil != publicKey {
+		c, err = db.OauthClient.UpdateOne(c).SetPublicKey(*publicKey).
+			ClearSecret().Save(qc)
+		require.Nil(tb, err)
+		secret = ""
+	}
+	a, err := db.ServiceAccount.Create().SetName(u.Username).
+		SetDescription("service 0 description").SetOwnerID(owner.ID).
+		SetUserID(u.ID).SetClientID(c.ClientID).Save(qc)
+	require.Nil(tb, err)
+	return a, c, secret
+}
+
+// Creates an organization with a user and a role. The role is assigned to the
+// user and granted the given permissions.
+func seedOrganization(tb testing.TB, db *ent.Client, perms ...string) (
+	*ent.Organization, *ent.User, *ent.Role,
+) {
+	qc := context.Background()
+	o, err := db.Organization.Create().SetName("tenant").
+		SetDescription("tenant description").Save(qc)
+	require.Nil(tb, err)
+	r, err := db.Role.Create().SetOrganizationID(o.ID).SetName("role 0").
+		AddPermissions(
+			db.Permission.Query().Where(permission.NameIn(perms...)).AllX(qc)...,
+		).Save(qc)
+	require.Nil(tb, err)
+	u, err := db.User.Create().SetOrganizationID(o.ID).SetUsername("user0").
+		SetEmail("email0@test.com").SetPassword("password0").AddRoles(r).
+		Save(qc)
+	require.Nil(tb, err)
+	return o, u, r
+}
+
+// Creates a group in the default organization with the given members and
+// roles.
+func seedGroup(
+	tb testing.TB, db *ent.Client, users []uint64, roles []uint32,
+) *ent.Group {
+	g, err := db.Group.Create().SetName("group 0").
+		SetDescription("group 0 description").AddUserIDs(users...).
+		AddRoleIDs(roles...).Save(context.Background())
+	require.Nil(tb, err)
+	return g
+}
+
+func seedSodConstraint(
+	tb testing.TB, db *ent.Client, roles ...uint32,
+) *ent.SodConstraint {
+	c, err := db.SodConstraint.Create().SetName("constraint 0").
+		SetDescription("constraint 0 description").AddRoleIDs(roles...).
+		Save(context.Background())
+	require.Nil(tb, err)
+	return c
+}
+
+// Creates a pending request of the role by the user in the default
+// organization.
+func seedAccessRequest(
+	tb testing.TB, db *ent.Client, requester uint64, role uint32,
+) *ent.AccessRequest {
+	ar, err := db.AccessRequest.Create().SetOrganizationID(1).
+		SetRequesterID(requester).SetRoleID(role).
+		SetJustification("justification 0").Save(context.Background())
+	require.Nil(tb, err)
+	return ar
+}
+
 // Gets the user from database. Does NOT eagerly load anything.
 func getUserById(tb testing.TB, db *ent.Client, id uint64) *ent.User {
 	u, err := db.User.Query().Where(user.IDEQ(id)).Only(context.Background())
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+)
+
+// DeleteGroup deletes a group. Members lose the roles granted by the group.
+// Groups owning service accounts can't be deleted.
+//
+// Endpoint: DELETE /group/{id}
+func (s Server) DeleteGroup(
+	ctx context.Context, request DeleteGroupRequestObject,
+) (DeleteGroupResponseObject, error) {
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("DeleteGroup error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			g, err := tx.Group.Query().Where(group.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			users, err := g.QueryUsers().IDs(qc)
+			if err != nil {
+				return nil, err
+			}
+			if err = tx.Group.DeleteOne(g).Exec(qc); err != nil {
+				return nil, err
+			}
+			for _, id := range users {
+				if err = userRolesChanged(qc, tx, id); err != nil {
+					return nil, err
+				}
+			}
+			return nil, nil
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DeleteGroup404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		if ent.IsConstraintError(err) {
+			return DeleteGroup409JSONResponse{
+				N409JSONResponse: N409JSONResponse{
+					Code:   http.StatusConflict,
+					Status: msgError,
+					Errors: &msgInUse,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DeleteGroup error: %v", err)
+		return nil, err
+	}
+	return DeleteGroup204Response{}, nil
+}
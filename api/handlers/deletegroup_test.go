@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_DeleteGroup_deletes_a_group_with_members(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, []uint64{3, 4}, []uint32{2})
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, fmt.Sprintf("/group/%d", g.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	qc := context.Background()
+	require.False(t, db.Group.Query().Where(group.IDEQ(g.ID)).ExistX(qc))
+	require.True(t, db.User.Query().Where(user.IDEQ(3)).ExistX(qc))
+}
+
+func Test_DeleteGroup_returns_409_if_owning_service_accounts(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	seedServiceAccount(t, db, g, nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, fmt.Sprintf("/group/%d", g.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	require.True(
+		t, db.Group.Query().Where(group.IDEQ(g.ID)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_DeleteGroup_returns_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/group/12345")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_DeleteGroup_returns_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	_, u, _ := seedOrganization(t, db, "auth:DeleteGroup")
+	req, err := svr.deleteAs(u, fmt.Sprintf("/group/%d", g.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.True(
+		t, db.Group.Query().Where(group.IDEQ(g.ID)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_DeleteGroup_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.delete("/group/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_DeleteGroup_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.deleteAs(u, "/group/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DeleteGroup_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/group/1")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/session"
+)
+
+// DeleteMySession signs out a session of the current user, revoking its
+// tokens. The current session can be signed out too.
+//
+// Endpoint: DELETE /me/sessions/{id}
+func (s Server) DeleteMySession(
+	ctx context.Context, request DeleteMySessionRequestObject,
+) (DeleteMySessionResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("DeleteMySession error: %v", err)
+		return nil, err
+	}
+	// sessions belong to the user, not to an impersonator
+	if nil != token.actor {
+		return DeleteMySession403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			ss, err := tx.Session.Query().Where(
+				session.IDEQ(request.Id), session.UserIDEQ(token.user.ID),
+			).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, revokeSessions(qc, tx, []*ent.Session{ss})
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DeleteMySession404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DeleteMySession error: %v", err)
+		return nil, err
+	}
+	return DeleteMySession204Response{}, nil
+}
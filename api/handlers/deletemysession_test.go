@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/session"
+)
+
+// Signs out the session with the access token cookie, returns the response.
+func deleteSessionWithCookie(
+	tb testing.TB, engine http.Handler, at *http.Cookie, id uint64,
+) int {
+	req, err := http.NewRequest(
+		http.MethodDelete, fmt.Sprintf("/me/sessions/%d", id), nil,
+	)
+	require.Nil(tb, err)
+	return serveWithCookies(engine, req, at).Code
+}
+
+func Test_DeleteMySession_signs_out_other_device(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	u := getUserById(t, db, 1)
+	oat, ort := startTestSession(t, svr, u, "other agent")
+	at, _ := startTestSession(t, svr, u, "current agent")
+	other := db.Session.Query().Where(session.UserAgentEQ("other agent")).
+		OnlyX(qc)
+	require.Equal(
+		t, http.StatusNoContent, deleteSessionWithCookie(t, engine, at, other.ID),
+	)
+	require.Equal(
+		t, http.StatusUnauthorized, getUsersWithCookie(t, engine, oat).Code,
+	)
+	require.Equal(
+		t, http.StatusUnauthorized, refreshWithCookie(t, engine, ort).Code,
+	)
+	require.Equal(t, http.StatusOK, getUsersWithCookie(t, engine, at).Code)
+	require.False(t, db.Session.Query().Where(session.IDEQ(other.ID)).ExistX(qc))
+}
+
+func Test_DeleteMySession_signs_out_current_session(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	at, rt := startTestSession(t, svr, getUserById(t, db, 1), "test agent")
+	ss := db.Session.Query().OnlyX(context.Background())
+	require.Equal(
+		t, http.StatusNoContent, deleteSessionWithCookie(t, engine, at, ss.ID),
+	)
+	require.Equal(
+		t, http.StatusUnauthorized, getUsersWithCookie(t, engine, at).Code,
+	)
+	require.Equal(
+		t, http.StatusUnauthorized, refreshWithCookie(t, engine, rt).Code,
+	)
+}
+
+func Test_DeleteMySession_reports_404_if_session_of_other_user(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	oat, _ := startTestSession(t, svr, getUserById(t, db, 2), "other agent")
+	at, _ := startTestSession(t, svr, getUserById(t, db, 1), "test agent")
+	other := db.Session.Query().Where(session.UserIDEQ(2)).
+		OnlyX(context.Background())
+	require.Equal(
+		t, http.StatusNotFound, deleteSessionWithCookie(t, engine, at, other.ID),
+	)
+	require.Equal(t, http.StatusForbidden, getUsersWithCookie(t, engine, oat).Code)
+}
+
+func Test_DeleteMySession_returns_403_if_impersonated(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:DeleteMySession")
+	startTestSession(t, svr, getUserById(t, db, 2), "test agent")
+	ss := db.Session.Query().OnlyX(context.Background())
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.request(
+		nil, http.MethodDelete, fmt.Sprintf("/me/sessions/%d", ss.ID), nil,
+	)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.Equal(t, 1, db.Session.Query().CountX(context.Background()))
+}
+
+func Test_DeleteMySession_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.delete("/me/sessions/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_DeleteMySession_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.deleteAs(getUserById(t, db, 3), "/me/sessions/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+)
+
+// DeleteOauthClient deletes an OAuth client and its pending authorization
+// codes. Tokens already issued to the client stay valid until they expire.
+//
+// Endpoint: DELETE /oauth-client/{id}
+func (s Server) DeleteOauthClient(
+	ctx context.Context, request DeleteOauthClientRequestObject,
+) (DeleteOauthClientResponseObject, error) {
+	scope, err := s.oauthClientScope(ctx)
+	if err != nil {
+		api.Log.Debugf("DeleteOauthClient error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			_, err := tx.OauthClient.Query().
+				Where(oauthclient.IDEQ(request.Id), scope).OnlyID(qc)
+			if err != nil {
+				return nil, err
+			}
+			_, err = tx.OauthCode.Delete().
+				Where(oauthcode.ClientIDEQ(request.Id)).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, tx.OauthClient.DeleteOneID(request.Id).Exec(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DeleteOauthClient404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DeleteOauthClient error: %v", err)
+		return nil, err
+	}
+	return DeleteOauthClient204Response{}, nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+)
+
+func Test_DeleteOauthClient_deletes_a_client_and_its_codes(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	u := getUserById(t, db, 1)
+	tryOauthAuthorize(t, svr, engine, u, c, nil)
+	require.True(
+		t, db.OauthCode.Query().Where(oauthcode.ClientIDEQ(c.ID)).ExistX(qc),
+	)
+	req, err := svr.deleteAs(u, fmt.Sprintf("/oauth-client/%d", c.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.False(
+		t, db.OauthClient.Query().Where(oauthclient.IDEQ(c.ID)).ExistX(qc),
+	)
+	require.False(
+		t, db.OauthCode.Query().Where(oauthcode.ClientIDEQ(c.ID)).ExistX(qc),
+	)
+}
+
+func Test_DeleteOauthClient_returns_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/oauth-client/12345")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_DeleteOauthClient_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 3)
+	req, err := svr.deleteAs(u, "/oauth-client/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DeleteOauthClient_returns_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	_, u, _ := seedOrganization(t, db, "auth:DeleteOauthClient")
+	req, err := svr.deleteAs(u, fmt.Sprintf("/oauth-client/%d", c.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.True(
+		t, db.OauthClient.Query().Where(oauthclient.IDEQ(c.ID)).
+			ExistX(context.Background()),
+	)
+}
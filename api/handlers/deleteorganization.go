@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// DeleteOrganization deletes an organization. Only platform administrators can
+// delete organizations, and only those without users or roles.
+//
+// Endpoint: DELETE /organization/{id}
+func (s Server) DeleteOrganization(
+	ctx context.Context, request DeleteOrganizationRequestObject,
+) (DeleteOrganizationResponseObject, error) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("DeleteOrganization error: %v", err)
+		return nil, err
+	}
+	if nil != tenant {
+		return DeleteOrganization403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			return nil, tx.Organization.DeleteOneID(request.Id).Exec(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DeleteOrganization404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		if ent.IsConstraintError(err) {
+			return DeleteOrganization409JSONResponse{
+				N409JSONResponse: N409JSONResponse{
+					Code:   http.StatusConflict,
+					Status: msgError,
+					Errors: &msgInUse,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DeleteOrganization error: %v", err)
+		return nil, err
+	}
+	return DeleteOrganization204Response{}, nil
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+)
+
+func Test_DeleteOrganization_deletes_an_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o := db.Organization.Create().SetName("acme").
+		SaveX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, fmt.Sprintf("/organization/%d", o.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.False(
+		t, db.Organization.Query().Where(organization.IDEQ(o.ID)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_DeleteOrganization_returns_409_if_in_use(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o, _, _ := seedOrganization(t, db)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, fmt.Sprintf("/organization/%d", o.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	require.True(
+		t, db.Organization.Query().Where(organization.IDEQ(o.ID)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_DeleteOrganization_returns_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/organization/12345")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_DeleteOrganization_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.delete("/organization/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_DeleteOrganization_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.deleteAs(u, "/organization/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DeleteOrganization_returns_403_if_not_platform_admin(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o, u, _ := seedOrganization(t, db, "auth:DeleteOrganization")
+	req, err := svr.deleteAs(u, fmt.Sprintf("/organization/%d", o.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DeleteOrganization_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/organization/1")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -2,18 +2,33 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
 )
 
-// DeletePermission deletes a permission.
+// DeletePermission deletes a permission. Permissions are shared by all
+// organizations, only platform administrators can delete them.
 //
 // Endpoint: DELETE /permission/{id}
 func (s Server) DeletePermission(
 	ctx context.Context, request DeletePermissionRequestObject,
 ) (DeletePermissionResponseObject, error) {
+	if err := s.requirePlatform(ctx); err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return DeletePermission403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DeletePermission error: %v", err)
+		return nil, err
+	}
 	_, err := s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
@@ -48,6 +48,19 @@ func Test_DeletePermission_returns_403_if_user_without_permission(t *testing.T)
 	)
 }
 
+func Test_DeletePermission_returns_403_if_tenant_admin(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:DeletePermission")
+	req, err := svr.deleteAs(u, "/permission/2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.True(
+		t, db.Permission.Query().Where(permission.IDEQ(2)).
+			ExistX(context.Background()),
+	)
+}
+
 func Test_DeletePermission_reports_404_if_user_exists(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	u := getUserById(t, db, 1)
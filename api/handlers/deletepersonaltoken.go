@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/google/uuid"
+
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
 )
@@ -17,7 +19,24 @@ func (s Server) DeletePersonalToken(
 	_, err := s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
-			return nil, tx.PersonalToken.DeleteOneID(request.Id).Exec(qc)
+			pt, err := tx.PersonalToken.Get(qc, request.Id)
+			if err != nil {
+				return nil, err
+			}
+			if err = tx.PersonalToken.DeleteOne(pt).Exec(qc); err != nil {
+				return nil, err
+			}
+			jti, err := uuid.FromBytes(pt.Token)
+			if err != nil {
+				return nil, err
+			}
+			return nil, emitUserEvent(
+				qc, tx, pt.UserID, api.EventTokenRevoked,
+				map[string]interface{}{
+					"user_id": pt.UserID, "jti": jti.String(),
+					"type": "personal_token",
+				},
+			)
 		},
 	)
 	if err != nil {
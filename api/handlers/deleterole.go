@@ -6,17 +6,28 @@ import (
 
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/role"
 )
 
 // DeleteRole deletes a role.
 //
 // Endpoint: DELETE /role/{id}
 func (s Server) DeleteRole(
-	_ context.Context, request DeleteRoleRequestObject,
+	ctx context.Context, request DeleteRoleRequestObject,
 ) (DeleteRoleResponseObject, error) {
-	_, err := s.db.Transaction(
+	scope, err := s.roleScope(ctx)
+	if err != nil {
+		api.Log.Debugf("DeleteRole error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			_, err := tx.Role.Query().Where(role.IDEQ(request.Id), scope).
+				OnlyID(qc)
+			if err != nil {
+				return nil, err
+			}
 			return nil, tx.Role.DeleteOneID(request.Id).Exec(qc)
 		},
 	)
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+)
+
+// DeleteServiceAccount deletes a service account along with its OAuth clients,
+// so it can't get tokens anymore. Its user is deleted too, which invalidates
+// the tokens already issued.
+//
+// Endpoint: DELETE /service-account/{id}
+func (s Server) DeleteServiceAccount(
+	ctx context.Context, request DeleteServiceAccountRequestObject,
+) (DeleteServiceAccountResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("DeleteServiceAccount error: %v", err)
+		return nil, err
+	}
+	scope, err := s.serviceAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("DeleteServiceAccount error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			sa, err := tx.ServiceAccount.Query().
+				Where(serviceaccount.IDEQ(request.Id), scope).
+				WithOwner().Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			if err = tx.ServiceAccount.DeleteOne(sa).Exec(qc); err != nil {
+				return nil, err
+			}
+			_, err = tx.OauthClient.Delete().
+				Where(oauthclient.UserIDEQ(sa.UserID)).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			if err = deleteUser(qc, tx, sa.UserID); err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditServiceAccountDeleted,
+				sa.Edges.Owner.OrganizationID, token,
+				map[string]interface{}{
+					"service_account_id": sa.ID,
+					"name":               sa.Name,
+					"user_id":            sa.UserID,
+				},
+			)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DeleteServiceAccount404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DeleteServiceAccount error: %v", err)
+		return nil, err
+	}
+	return DeleteServiceAccount204Response{}, nil
+}
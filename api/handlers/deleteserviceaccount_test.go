@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/eidng8/go-ent/softdelete"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_DeleteServiceAccount_deletes_account_with_its_user_and_client(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	a, c, secret := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	token := tryOauthToken(
+		t, engine, c, secret,
+		url.Values{"grant_type": {api.OauthGrantClientCredentials}},
+	)
+	require.Equal(t, http.StatusOK, token.Code)
+	at := unmarshalResponse(t, OauthToken200JSONResponse{}, token).AccessToken
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, fmt.Sprintf("/service-account/%d", a.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.False(
+		t, db.ServiceAccount.Query().Where(serviceaccount.IDEQ(a.ID)).
+			ExistX(qc),
+	)
+	require.False(
+		t, db.OauthClient.Query().Where(oauthclient.IDEQ(c.ID)).ExistX(qc),
+	)
+	require.False(t, db.User.Query().Where(user.IDEQ(a.UserID)).ExistX(qc))
+	// the user is soft deleted
+	require.True(
+		t, db.User.Query().Where(user.IDEQ(a.UserID)).
+			ExistX(softdelete.IncludeTrashed(qc)),
+	)
+	// tokens already issued are rejected
+	tk, err := svr.jwtTokenFromString(at)
+	require.Nil(t, err)
+	require.NotNil(t, tk.checkAccessToken())
+	logs := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditServiceAccountDeleted)).AllX(qc)
+	require.Len(t, logs, 1)
+	require.Equal(t, float64(a.ID), logs[0].Details["service_account_id"])
+}
+
+func Test_DeleteServiceAccount_returns_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/service-account/12345")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_DeleteServiceAccount_returns_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	_, u, _ := seedOrganization(t, db, "auth:DeleteServiceAccount")
+	req, err := svr.deleteAs(u, fmt.Sprintf("/service-account/%d", a.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.True(
+		t, db.ServiceAccount.Query().Where(serviceaccount.IDEQ(a.ID)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_DeleteServiceAccount_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.delete("/service-account/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_DeleteServiceAccount_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 3)
+	req, err := svr.deleteAs(u, "/service-account/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DeleteServiceAccount_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/service-account/1")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+// DeleteSodConstraint deletes a separation-of-duties constraint.
+//
+// Endpoint: DELETE /sod-constraint/{id}
+func (s Server) DeleteSodConstraint(
+	ctx context.Context, request DeleteSodConstraintRequestObject,
+) (DeleteSodConstraintResponseObject, error) {
+	scope, err := s.sodConstraintScope(ctx)
+	if err != nil {
+		api.Log.Debugf("DeleteSodConstraint error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			c, err := tx.SodConstraint.Query().
+				Where(sodconstraint.IDEQ(request.Id), scope).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, tx.SodConstraint.DeleteOne(c).Exec(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DeleteSodConstraint404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DeleteSodConstraint error: %v", err)
+		return nil, err
+	}
+	return DeleteSodConstraint204Response{}, nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+func Test_DeleteSodConstraint_deletes_a_constraint(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c := seedSodConstraint(t, db, 2, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, fmt.Sprintf("/sod-constraint/%d", c.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	qc := context.Background()
+	require.False(
+		t, db.SodConstraint.Query().Where(sodconstraint.IDEQ(c.ID)).
+			ExistX(qc),
+	)
+	require.True(t, db.Role.Query().Where(role.IDIn(2, 5)).ExistX(qc))
+}
+
+func Test_DeleteSodConstraint_returns_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/sod-constraint/12345")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_DeleteSodConstraint_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.delete("/sod-constraint/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_DeleteSodConstraint_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.deleteAs(u, "/sod-constraint/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DeleteSodConstraint_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/sod-constraint/1")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -8,20 +8,38 @@ import (
 
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
 )
 
-// DeleteUser deletes a user.
+// DeleteUser deletes a user, marking it deleted. Tokens issued to the user are
+// revoked, and stay so if the user is restored.
 //
 // Endpoint: DELETE /user/{id}
 func (s Server) DeleteUser(
-	_ context.Context, request DeleteUserRequestObject,
+	ctx context.Context, request DeleteUserRequestObject,
 ) (DeleteUserResponseObject, error) {
-	_, err := s.db.Transaction(
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("DeleteUser error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
 		softdelete.NewSoftDeleteQueryContext(
 			request.Params.Trashed, context.Background(),
 		),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
-			return nil, tx.User.DeleteOneID(request.Id).Exec(qc)
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			if err = deleteUser(qc, tx, request.Id); err != nil {
+				return nil, err
+			}
+			return nil, emitEvent(
+				qc, tx, u.OrganizationID, api.EventUserDeleted,
+				map[string]interface{}{"user_id": request.Id},
+			)
 		},
 	)
 	if err != nil {
@@ -2,8 +2,11 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/eidng8/go-ent/softdelete"
 	"github.com/stretchr/testify/require"
@@ -31,6 +34,54 @@ func Test_DeleteUser_soft_deletes_a_user(t *testing.T) {
 	)
 }
 
+func Test_DeleteUser_marks_user_deleted_and_rejects_its_tokens(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	grantPermissions(t, db, 2, "auth:ListUser")
+	jti, pt, err := svr.issuePersonalToken(
+		getUserById(t, db, 2), []string{"auth:ListUser"}, time.Hour,
+	)
+	require.Nil(t, err)
+	bin, err := jti.MarshalBinary()
+	require.Nil(t, err)
+	db.PersonalToken.Create().SetToken(bin).SetUserID(2).SetDescription("test").
+		ExecX(qc)
+	require.Equal(
+		t, http.StatusOK, getUsersWithToken(t, svr, engine, pt).Code,
+	)
+	req, err := svr.deleteAs(getUserById(t, db, 1), "/user/2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	tt := true
+	u := db.User.GetX(softdelete.NewSoftDeleteQueryContext(&tt, qc), 2)
+	require.Equal(t, user.StatusDeleted, u.Status)
+	require.Equal(
+		t, http.StatusUnauthorized,
+		getUsersWithToken(t, svr, engine, pt).Code,
+	)
+}
+
+func Test_DeleteUser_revokes_tokens_even_if_restored(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	old := issueTokenAt(
+		t, svr, getUserById(t, db, 2), time.Now().Add(-time.Minute),
+	)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/user/2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	req, err = svr.postAs(u, "/user/2/restore", nil)
+	require.Nil(t, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.NotNil(t, getUserById(t, db, 2).TokensValidAfter)
+	res = getUsersWithToken(t, svr, engine, old)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
 func Test_DeleteUser_physically_deletes_a_user(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, true)
 	db.User.DeleteOneID(2).ExecX(context.Background())
@@ -81,8 +132,22 @@ func Test_DeleteUser_reports_404_if_user_not_exists(t *testing.T) {
 	require.Equal(t, 404, res.Code)
 }
 
+func Test_DeleteUser_reports_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, fmt.Sprintf("/user/%d", a.UserID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, 404, res.Code)
+	require.True(
+		t, db.User.Query().Where(user.IDEQ(a.UserID)).
+			ExistX(context.Background()),
+	)
+}
+
 func Test_DeleteUser_reports_404_if_user_was_soft_deleted(t *testing.T) {
-	svr, engine, db, res := setupTestCase(t, false)
+	svr, engine, db, res := setupTestCase(t, true)
 	db.User.DeleteOneID(2).ExecX(context.Background())
 	u := getUserById(t, db, 1)
 	req, err := svr.deleteAs(u, "/user/2")
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+	"github.com/eidng8/go-attr-rbac/ent/webhookdelivery"
+)
+
+// DeleteWebhook deletes a webhook and its delivery history.
+//
+// Endpoint: DELETE /webhook/{id}
+func (s Server) DeleteWebhook(
+	ctx context.Context, request DeleteWebhookRequestObject,
+) (DeleteWebhookResponseObject, error) {
+	scope, err := s.webhookScope(ctx)
+	if err != nil {
+		api.Log.Debugf("DeleteWebhook error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			_, err := tx.Webhook.Query().
+				Where(webhook.IDEQ(request.Id), scope).OnlyID(qc)
+			if err != nil {
+				return nil, err
+			}
+			_, err = tx.WebhookDelivery.Delete().
+				Where(webhookdelivery.WebhookIDEQ(request.Id)).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, tx.Webhook.DeleteOneID(request.Id).Exec(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DeleteWebhook404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DeleteWebhook error: %v", err)
+		return nil, err
+	}
+	return DeleteWebhook204Response{}, nil
+}
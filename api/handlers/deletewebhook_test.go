@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+	"github.com/eidng8/go-attr-rbac/ent/webhookdelivery"
+)
+
+func Test_DeleteWebhook_deletes_a_webhook_and_its_deliveries(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	w := seedWebhook(t, db, "https://example.com/hook", api.EventUserDeleted)
+	db.WebhookDelivery.Create().SetWebhookID(w.ID).
+		SetEvent(api.EventUserDeleted).
+		SetPayload(map[string]interface{}{}).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, fmt.Sprintf("/webhook/%d", w.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.False(t, db.Webhook.Query().Where(webhook.IDEQ(w.ID)).ExistX(qc))
+	require.False(
+		t, db.WebhookDelivery.Query().
+			Where(webhookdelivery.WebhookIDEQ(w.ID)).ExistX(qc),
+	)
+}
+
+func Test_DeleteWebhook_returns_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/webhook/12345")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_DeleteWebhook_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.delete("/webhook/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_DeleteWebhook_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.deleteAs(u, "/webhook/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DeleteWebhook_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/webhook/1")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func Test_DeleteWebhook_returns_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	w := seedWebhook(t, db, "https://example.com/hook", api.EventUserDeleted)
+	_, u, _ := seedOrganization(t, db, "auth:DeleteWebhook")
+	req, err := svr.deleteAs(u, fmt.Sprintf("/webhook/%d", w.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.True(
+		t, db.Webhook.Query().Where(webhook.IDEQ(w.ID)).
+			ExistX(context.Background()),
+	)
+}
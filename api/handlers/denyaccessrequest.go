@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+)
+
+// DenyAccessRequest denies a pending access request.
+//
+// Endpoint: POST /access-request/{id}/deny
+func (s Server) DenyAccessRequest(
+	ctx context.Context, request DenyAccessRequestRequestObject,
+) (DenyAccessRequestResponseObject, error) {
+	ar, err := s.reviewAccessRequest(
+		ctx, request.Id, accessrequest.StatusDenied, request.Body.Comment,
+	)
+	if err != nil {
+		if errors.Is(err, errNotPending) {
+			return DenyAccessRequest409JSONResponse{
+				N409JSONResponse: N409JSONResponse{
+					Code:   http.StatusConflict,
+					Errors: &msgNotPending,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if errors.Is(err, errAccessDenied) {
+			return DenyAccessRequest403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsNotFound(err) {
+			return DenyAccessRequest404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DenyAccessRequest error: %v", err)
+		return nil, err
+	}
+	return DenyAccessRequest200JSONResponse(accessRequestRead(ar)), nil
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+func Test_DenyAccessRequest_denies_the_request(t *testing.T) {
+	comment := "not needed"
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	ar := seedAccessRequest(t, db, 3, 5)
+	db.Role.UpdateOneID(5).AddApproverIDs(1).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/deny", ar.ID),
+		DenyAccessRequestJSONBody{Comment: &comment},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, DenyAccessRequest200JSONResponse{}, res)
+	require.Equal(t, AccessRequestReadStatus("denied"), actual.Status)
+	require.Equal(t, uint64(1), *actual.ReviewerId)
+	require.Equal(t, comment, *actual.ReviewComment)
+	require.False(
+		t, db.UserRole.Query().
+			Where(userrole.UserIDEQ(3), userrole.RoleIDEQ(5)).ExistX(qc),
+	)
+	logs := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditAccessRequestDenied)).AllX(qc)
+	require.Len(t, logs, 1)
+	require.Equal(t, uint64(1), *logs[0].ActorID)
+	require.Equal(t, float64(ar.ID), logs[0].Details["access_request_id"])
+}
+
+func Test_DenyAccessRequest_reports_403_if_not_approver(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ar := seedAccessRequest(t, db, 3, 5)
+	db.Role.UpdateOneID(6).AddApproverIDs(1).ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/deny", ar.ID),
+		DenyAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.Equal(
+		t, accessrequest.StatusPending,
+		db.AccessRequest.GetX(context.Background(), ar.ID).Status,
+	)
+}
+
+func Test_DenyAccessRequest_reports_409_if_not_pending(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	ar := seedAccessRequest(t, db, 3, 5)
+	db.AccessRequest.UpdateOne(ar).SetStatus(accessrequest.StatusApproved).
+		ExecX(qc)
+	db.Role.UpdateOneID(5).AddApproverIDs(1).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/access-request/%d/deny", ar.ID),
+		DenyAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	require.Equal(
+		t, accessrequest.StatusApproved, db.AccessRequest.GetX(qc, ar.ID).Status,
+	)
+}
+
+func Test_DenyAccessRequest_reports_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, "/access-request/12345/deny", DenyAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_DenyAccessRequest_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/access-request/1/deny", DenyAccessRequestJSONBody{})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_DenyAccessRequest_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.postAs(
+		u, "/access-request/1/deny", DenyAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DenyAccessRequest_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, "/access-request/1/deny", DenyAccessRequestJSONBody{},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
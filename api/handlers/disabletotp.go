@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// DisableTotp turns TOTP of the current user off, or cancels a pending
+// enrollment. It takes a TOTP code or a recovery code, so that a stolen
+// session alone can't remove the second factor. Users holding a role that
+// requires a second factor have to enroll again before doing anything else.
+//
+// Endpoint: POST /me/totp/disable
+func (s Server) DisableTotp(
+	ctx context.Context, request DisableTotpRequestObject,
+) (DisableTotpResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("DisableTotp error: %v", err)
+		return nil, err
+	}
+	if nil != token.actor {
+		return DisableTotp403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			u, err := tx.User.Query().Where(user.IDEQ(token.user.ID)).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			ok, _, err := verifySecondFactor(qc, tx, u, request.Body.Code)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, errInvalidArgument
+			}
+			err = tx.User.UpdateOne(u).ClearTotpSecret().
+				ClearTotpConfirmedAt().SetTotpLastStep(0).ClearRecoveryCodes().
+				Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditTotpDisabled, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errInvalidArgument) {
+			return DisableTotp400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidCode,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DisableTotp error: %v", err)
+		return nil, err
+	}
+	return DisableTotp204Response{}, nil
+}
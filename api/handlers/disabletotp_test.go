@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+)
+
+func Test_DisableTotp_disables_totp(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	secret := enableTotp(t, db, 1)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/disable",
+		DisableTotpJSONRequestBody{Code: currentTotp(t, secret)},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	u := getUserById(t, db, 1)
+	require.Nil(t, u.TotpSecret)
+	require.Nil(t, u.TotpConfirmedAt)
+	require.Empty(t, u.RecoveryCodes)
+	require.True(
+		t, db.AuditLog.Query().
+			Where(auditlog.ActionEQ(api.AuditTotpDisabled)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_DisableTotp_accepts_recovery_code(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	enableTotp(t, db, 1)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/disable",
+		DisableTotpJSONRequestBody{Code: recoveryCode(t, db, 1)},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Nil(t, getUserById(t, db, 1).TotpSecret)
+}
+
+func Test_DisableTotp_reports_400_if_code_invalid(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	enableTotp(t, db, 1)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/disable",
+		DisableTotpJSONRequestBody{Code: "abcdef"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusBadRequest,
+		"errors": msgInvalidCode,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+	require.NotNil(t, getUserById(t, db, 1).TotpConfirmedAt)
+}
+
+func Test_DisableTotp_reports_400_if_not_enabled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/disable",
+		DisableTotpJSONRequestBody{Code: "123456"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_DisableTotp_reports_403_if_impersonating(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:DisableTotp")
+	secret := enableTotp(t, db, 2)
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.post(
+		"/me/totp/disable",
+		DisableTotpJSONRequestBody{Code: currentTotp(t, secret)},
+	)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.NotNil(t, getUserById(t, db, 2).TotpConfirmedAt)
+}
+
+func Test_DisableTotp_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post(
+		"/me/totp/disable", DisableTotpJSONRequestBody{Code: "123456"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_DisableTotp_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 2), "/me/totp/disable",
+		DisableTotpJSONRequestBody{Code: "123456"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DisableTotp_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/totp/disable",
+		DisableTotpJSONRequestBody{Code: "123456"},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
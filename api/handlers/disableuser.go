@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// DisableUser disables an active or locked user, and revokes its tokens.
+// Disabled users can't sign in until enabled again by EnableUser.
+//
+// Endpoint: POST /user/{id}/disable
+func (s Server) DisableUser(
+	ctx context.Context, request DisableUserRequestObject,
+) (DisableUserResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("DisableUser error: %v", err)
+		return nil, err
+	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("DisableUser error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = setAccountStatus(
+				qc, tx, u, user.StatusDisabled, user.StatusActive,
+				user.StatusLocked,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if err = revokeUserTokens(qc, tx, u.ID); err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditUserDisabled, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errInvalidState) {
+			return DisableUser409JSONResponse{
+				N409JSONResponse: N409JSONResponse{
+					Code:   http.StatusConflict,
+					Errors: &msgInvalidState,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsNotFound(err) {
+			return DisableUser404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("DisableUser error: %v", err)
+		return nil, err
+	}
+	return DisableUser204Response{}, nil
+}
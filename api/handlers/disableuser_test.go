@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_DisableUser_disables_user_and_revokes_tokens(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	setPassword(t, db, 2, "Test_123")
+	at, rt := startTestSession(t, svr, getUserById(t, db, 2), "test agent")
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/2/disable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	u := getUserById(t, db, 2)
+	require.Equal(t, user.StatusDisabled, u.Status)
+	require.NotNil(t, u.TokensValidAfter)
+	require.Zero(t, db.Session.Query().CountX(qc))
+	require.Equal(
+		t, http.StatusUnauthorized, getUsersWithCookie(t, engine, at).Code,
+	)
+	require.Equal(
+		t, http.StatusUnauthorized, refreshWithCookie(t, engine, rt).Code,
+	)
+	require.Equal(
+		t, http.StatusUnauthorized,
+		tryLogin(t, svr, engine, "user0", "Test_123").Code,
+	)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditUserDisabled)).OnlyX(qc)
+	require.Equal(t, uint64(1), *log.ActorID)
+	require.Equal(t, float64(2), log.Details["user_id"])
+	require.Equal(t, "user0", log.Details["username"])
+}
+
+func Test_DisableUser_disables_locked_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	db.User.UpdateOneID(3).SetStatus(user.StatusLocked).ExecX(qc)
+	require.Nil(
+		t, svr.loginAttempts.Lock(
+			qc, userAttemptKey(3), time.Now().Add(time.Hour),
+		),
+	)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/3/disable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Equal(t, user.StatusDisabled, getUserById(t, db, 3).Status)
+}
+
+func Test_DisableUser_reports_409_if_already_disabled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.User.UpdateOneID(3).SetStatus(user.StatusDisabled).
+		ExecX(context.Background())
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/3/disable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	actual := unmarshalResponse(t, DisableUser409JSONResponse{}, res)
+	require.Equal(t, msgInvalidState, *actual.Errors)
+	require.False(
+		t, db.AuditLog.Query().
+			Where(auditlog.ActionEQ(api.AuditUserDisabled)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_DisableUser_reports_404_if_user_not_exists(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/12345/disable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_DisableUser_reports_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:DisableUser")
+	req, err := svr.postAs(u, "/user/2/disable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Equal(t, user.StatusActive, getUserById(t, db, 2).Status)
+}
+
+func Test_DisableUser_reports_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), fmt.Sprintf("/user/%d/disable", a.UserID), nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Equal(t, user.StatusActive, getUserById(t, db, a.UserID).Status)
+}
+
+func Test_DisableUser_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/user/2/disable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_DisableUser_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 2), "/user/3/disable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_DisableUser_returns_500_if_invalid_context(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	_, err := svr.DisableUser(
+		context.Background(), DisableUserRequestObject{Id: 2},
+	)
+	require.ErrorIs(t, err, errInvalidContext)
+}
+
+func Test_DisableUser_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/2/disable", nil)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// EnableUser sets a disabled user active again. Tokens revoked when the user
+// was disabled stay so.
+//
+// Endpoint: POST /user/{id}/enable
+func (s Server) EnableUser(
+	ctx context.Context, request EnableUserRequestObject,
+) (EnableUserResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("EnableUser error: %v", err)
+		return nil, err
+	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("EnableUser error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = setAccountStatus(
+				qc, tx, u, user.StatusActive, user.StatusDisabled,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditUserEnabled, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errInvalidState) {
+			return EnableUser409JSONResponse{
+				N409JSONResponse: N409JSONResponse{
+					Code:   http.StatusConflict,
+					Errors: &msgInvalidState,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsNotFound(err) {
+			return EnableUser404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("EnableUser error: %v", err)
+		return nil, err
+	}
+	return EnableUser204Response{}, nil
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_EnableUser_enables_disabled_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	setPassword(t, db, 2, "Test_123")
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/user/2/disable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	req, err = svr.postAs(u, "/user/2/enable", nil)
+	require.Nil(t, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Equal(t, user.StatusActive, getUserById(t, db, 2).Status)
+	require.Equal(
+		t, http.StatusOK, tryLogin(t, svr, engine, "user0", "Test_123").Code,
+	)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditUserEnabled)).OnlyX(qc)
+	require.Equal(t, uint64(1), *log.ActorID)
+	require.Equal(t, float64(2), log.Details["user_id"])
+	require.Equal(t, "user0", log.Details["username"])
+}
+
+func Test_EnableUser_reports_409_if_not_disabled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/2/enable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	actual := unmarshalResponse(t, EnableUser409JSONResponse{}, res)
+	require.Equal(t, msgInvalidState, *actual.Errors)
+}
+
+func Test_EnableUser_reports_409_if_locked(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.User.UpdateOneID(3).SetStatus(user.StatusLocked).
+		ExecX(context.Background())
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/3/enable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	require.Equal(t, user.StatusLocked, getUserById(t, db, 3).Status)
+}
+
+func Test_EnableUser_reports_404_if_user_not_exists(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/12345/enable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_EnableUser_reports_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.User.UpdateOneID(2).SetStatus(user.StatusDisabled).
+		ExecX(context.Background())
+	_, u, _ := seedOrganization(t, db, "auth:EnableUser")
+	req, err := svr.postAs(u, "/user/2/enable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Equal(t, user.StatusDisabled, getUserById(t, db, 2).Status)
+}
+
+func Test_EnableUser_reports_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	db.User.UpdateOneID(a.UserID).SetStatus(user.StatusDisabled).
+		ExecX(context.Background())
+	req, err := svr.postAs(
+		getUserById(t, db, 1), fmt.Sprintf("/user/%d/enable", a.UserID), nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Equal(t, user.StatusDisabled, getUserById(t, db, a.UserID).Status)
+}
+
+func Test_EnableUser_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/user/2/enable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_EnableUser_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 2), "/user/3/enable", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_EnableUser_returns_500_if_invalid_context(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	_, err := svr.EnableUser(
+		context.Background(), EnableUserRequestObject{Id: 2},
+	)
+	require.ErrorIs(t, err, errInvalidContext)
+}
+
+func Test_EnableUser_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/2/enable", nil)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// EnrollTotp generates a new TOTP secret for the current user. TOTP isn't
+// enabled until the user confirms it with a code from the authenticator app.
+// Enrolling again before confirmation replaces the secret.
+//
+// Endpoint: POST /me/totp
+func (s Server) EnrollTotp(
+	ctx context.Context, _ EnrollTotpRequestObject,
+) (EnrollTotpResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("EnrollTotp error: %v", err)
+		return nil, err
+	}
+	// the second factor belongs to the user, not to an impersonator
+	if nil != token.actor {
+		return EnrollTotp403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	if nil != token.user.TotpConfirmedAt {
+		return EnrollTotp409JSONResponse{
+			N409JSONResponse: N409JSONResponse{
+				Code:   http.StatusConflict,
+				Errors: &msgExists,
+				Status: msgError,
+			},
+		}, nil
+	}
+	secret, err := newTotpSecret()
+	if err != nil {
+		api.Log.Debugf("EnrollTotp error: %v", err)
+		return nil, err
+	}
+	err = s.db.User.UpdateOneID(token.user.ID).SetTotpSecret(secret).
+		SetTotpLastStep(0).Exec(context.Background())
+	if err != nil {
+		api.Log.Debugf("EnrollTotp error: %v", err)
+		return nil, err
+	}
+	return EnrollTotp200JSONResponse{
+		Secret: secret,
+		ProvisioningUri: totpProvisioningUri(
+			s.getTotpIssuer(), token.user.Username, secret,
+		),
+	}, nil
+}
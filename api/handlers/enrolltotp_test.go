@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EnrollTotp_generates_secret(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.postAs(getUserById(t, db, 1), "/me/totp", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, EnrollTotp200JSONResponse{}, res)
+	require.Len(t, actual.Secret, 32)
+	require.True(
+		t, strings.HasPrefix(actual.ProvisioningUri, "otpauth://totp/"),
+	)
+	require.Contains(t, actual.ProvisioningUri, "secret="+actual.Secret)
+	u := getUserById(t, db, 1)
+	require.Equal(t, actual.Secret, *u.TotpSecret)
+	require.Nil(t, u.TotpConfirmedAt)
+}
+
+func Test_EnrollTotp_reports_409_if_enabled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	secret := enableTotp(t, db, 1)
+	req, err := svr.postAs(getUserById(t, db, 1), "/me/totp", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	require.Equal(t, secret, *getUserById(t, db, 1).TotpSecret)
+}
+
+func Test_EnrollTotp_reports_403_if_impersonating(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:EnrollTotp")
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.post("/me/totp", nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.Nil(t, getUserById(t, db, 2).TotpSecret)
+}
+
+func Test_EnrollTotp_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/me/totp", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_EnrollTotp_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 2), "/me/totp", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_EnrollTotp_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 1), "/me/totp", nil)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"crypto/hmac"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/oauth2"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// FinishOidcLogin exchanges the authorization code for the ID token, and sets
+// the access and refresh token cookies like Login does. The user linked to the
+// subject of the token is signed in, and is created on the first sign in. The
+// provider is responsible for multi-factor authentication, users with TOTP
+// enabled aren't challenged again.
+//
+// Endpoint: POST /login/oidc/{provider}/finish
+func (s Server) FinishOidcLogin(
+	ctx context.Context, request FinishOidcLoginRequestObject,
+) (FinishOidcLoginResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	p := s.oidcProvider(request.Provider)
+	if nil == p {
+		return FinishOidcLogin404JSONResponse{
+			N404JSONResponse: N404JSONResponse{
+				Code:   http.StatusNotFound,
+				Errors: &msgNotFound,
+				Status: msgError,
+			},
+		}, nil
+	}
+	invalid := FinishOidcLogin401JSONResponse{
+		N401JSONResponse: N401JSONResponse{
+			Code:   http.StatusUnauthorized,
+			Errors: &msgInvalidCredential,
+			Status: msgError,
+		},
+	}
+	var session oidcSession
+	err := openState("oidc", request.Body.SessionToken, &session)
+	if err != nil {
+		api.Log.Debugf("FinishOidcLogin error: %v", err)
+		if errors.Is(err, errInvalidSession) {
+			return invalid, nil
+		}
+		return nil, err
+	}
+	if session.Provider != p.Name || time.Now().After(session.Expires) ||
+		!hmac.Equal([]byte(session.State), []byte(request.Body.State)) {
+		return invalid, nil
+	}
+	d, err := p.provider()
+	if err != nil {
+		api.Log.Debugf("FinishOidcLogin error: %v", err)
+		return nil, err
+	}
+	token, err := p.oauth2Config(d).Exchange(
+		p.context(), request.Body.Code, oauth2.VerifierOption(session.Verifier),
+	)
+	if err != nil {
+		api.Log.Debugf("FinishOidcLogin error: %v", err)
+		return invalid, nil
+	}
+	raw, ok := token.Extra("id_token").(string)
+	if !ok {
+		api.Log.Debugf("FinishOidcLogin error: no ID token")
+		return invalid, nil
+	}
+	idToken, err := d.Verifier(&oidc.Config{ClientID: p.ClientId}).
+		Verify(p.context(), raw)
+	if err != nil {
+		api.Log.Debugf("FinishOidcLogin error: %v", err)
+		return invalid, nil
+	}
+	if !hmac.Equal([]byte(idToken.Nonce), []byte(session.Nonce)) {
+		api.Log.Debugf("FinishOidcLogin error: nonce mismatch")
+		return invalid, nil
+	}
+	var claims map[string]interface{}
+	if err = idToken.Claims(&claims); err != nil {
+		api.Log.Debugf("FinishOidcLogin error: %v", err)
+		return invalid, nil
+	}
+	qc := context.Background()
+	u, err := s.linkOidcUser(qc, p, idToken.Subject, claims)
+	if err != nil {
+		if errors.Is(err, errInvalidCredential) {
+			return invalid, nil
+		}
+		api.Log.Debugf("FinishOidcLogin error: %v", err)
+		return nil, err
+	}
+	if accountClosed(u) {
+		return invalid, nil
+	}
+	res, err := s.signIn(gc, u)
+	if err != nil {
+		api.Log.Debugf("FinishOidcLogin error: %v", err)
+		return nil, err
+	}
+	return FinishOidcLogin200JSONResponse(*res), nil
+}
@@ -0,0 +1,333 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/linkedidentity"
+)
+
+// Submits the code returned by the provider, returns the response.
+func tryFinishOidcLogin(
+	tb testing.TB, svr *Server, engine http.Handler, provider string,
+	body FinishOidcLoginJSONRequestBody,
+) *httptest.ResponseRecorder {
+	req, err := svr.post("/login/oidc/"+provider+"/finish", body)
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+// Signs in with the provider as the user of the claims, returns the response.
+func tryOidcLogin(
+	tb testing.TB, svr *Server, engine http.Handler, idp *testOidcIdp,
+	claims jwt.MapClaims,
+) *httptest.ResponseRecorder {
+	begin := beginOidcLogin(tb, svr, engine, "idp")
+	code, state := idp.authorize(tb, begin.AuthorizationUrl, claims)
+	return tryFinishOidcLogin(
+		tb, svr, engine, "idp", FinishOidcLoginJSONRequestBody{
+			SessionToken: begin.SessionToken, Code: code, State: state,
+		},
+	)
+}
+
+// Configures the server to sign in with the provider, granting `role 1` to
+// admins and `role 2` to staff.
+func useTestOidcIdp(tb testing.TB, svr *Server) *testOidcIdp {
+	idp := newTestOidcIdp(tb)
+	svr.UseOidcProviders(idp.provider())
+	svr.UseGroupRoleRules(
+		GroupRoleRule{Group: "admins", Roles: []string{"role 1"}},
+		GroupRoleRule{Group: "staff", Roles: []string{"role 2"}},
+	)
+	return idp
+}
+
+func aliceClaims(groups ...string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":                "alice-sub",
+		"preferred_username": "alice",
+		"email":              "alice@example.com",
+		"email_verified":     true,
+		"groups":             groups,
+		"dept":               7,
+	}
+}
+
+func Test_FinishOidcLogin_provisions_user(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	idp := useTestOidcIdp(t, svr)
+	res := tryOidcLogin(t, svr, engine, idp, aliceClaims("admins"))
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	at, rt := getTokensFromSetCookieHeaders(t, res)
+	require.NotNil(t, at)
+	require.NotNil(t, rt)
+	u := getUserByName(t, db, "alice")
+	require.Equal(t, "oidc:idp", *u.AuthProvider)
+	require.Equal(t, "alice@example.com", *u.Email)
+	require.Equal(t, map[string]interface{}{"dept": float64(7)}, *u.Attr)
+	require.Equal(t, []string{"role 1"}, assignedRoleNames(t, db, u.ID))
+	li := db.LinkedIdentity.Query().OnlyX(context.Background())
+	require.Equal(t, u.ID, li.UserID)
+	require.Equal(t, "idp", li.Provider)
+	require.Equal(t, "alice-sub", li.Subject)
+	require.NotNil(t, li.LastLoginAt)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditUserProvisioned)).
+		OnlyX(context.Background())
+	require.Equal(t, "oidc:idp", log.Details["provider"])
+	// the provider's users can't sign in with passwords
+	res = tryLogin(t, svr, engine, "alice", "")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_synchronizes_linked_user(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	idp := useTestOidcIdp(t, svr)
+	res := tryOidcLogin(t, svr, engine, idp, aliceClaims("admins"))
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	claims := aliceClaims("staff")
+	// the subject identifies the user, not the username
+	claims["preferred_username"] = "alice2"
+	claims["email"] = "alice2@example.com"
+	res = tryOidcLogin(t, svr, engine, idp, claims)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	u := getUserByName(t, db, "alice")
+	require.Equal(t, "alice2@example.com", *u.Email)
+	require.Equal(t, []string{"role 2"}, assignedRoleNames(t, db, u.ID))
+	require.Equal(
+		t, 1, db.LinkedIdentity.Query().CountX(context.Background()),
+	)
+}
+
+func Test_FinishOidcLogin_links_user_by_verified_email(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	idp := useTestOidcIdp(t, svr)
+	svr.oidcProviders[0].LinkByEmail = true
+	u := getUserById(t, db, 2)
+	claims := aliceClaims()
+	claims["email"] = *u.Email
+	res := tryOidcLogin(t, svr, engine, idp, claims)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	li := db.LinkedIdentity.Query().OnlyX(context.Background())
+	require.Equal(t, u.ID, li.UserID)
+	// the local password still works
+	require.Nil(t, getUserById(t, db, 2).AuthProvider)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditIdentityLinked)).
+		OnlyX(context.Background())
+	require.Nil(t, log.ActorID)
+	require.Equal(t, float64(u.ID), log.Details["user_id"])
+	require.Equal(t, "alice-sub", log.Details["subject"])
+}
+
+func Test_FinishOidcLogin_doesnt_link_unverified_email(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	idp := useTestOidcIdp(t, svr)
+	svr.oidcProviders[0].LinkByEmail = true
+	u := getUserById(t, db, 2)
+	claims := aliceClaims()
+	claims["email"] = *u.Email
+	claims["email_verified"] = false
+	res := tryOidcLogin(t, svr, engine, idp, claims)
+	// a new user is created with the email, which is taken
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.False(
+		t, db.LinkedIdentity.Query().Where(linkedidentity.UserIDEQ(u.ID)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_FinishOidcLogin_returns_401_if_username_taken(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	idp := useTestOidcIdp(t, svr)
+	claims := aliceClaims()
+	claims["preferred_username"] = getUserById(t, db, 2).Username
+	res := tryOidcLogin(t, svr, engine, idp, claims)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Nil(t, getUserById(t, db, 2).AuthProvider)
+	require.Zero(t, db.LinkedIdentity.Query().CountX(context.Background()))
+}
+
+func Test_FinishOidcLogin_returns_401_if_linked_user_deleted(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	idp := useTestOidcIdp(t, svr)
+	res := tryOidcLogin(t, svr, engine, idp, aliceClaims())
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	u := getUserByName(t, db, "alice")
+	require.Nil(t, db.User.DeleteOneID(u.ID).Exec(context.Background()))
+	res = tryOidcLogin(t, svr, engine, idp, aliceClaims())
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_401_if_state_mismatch(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := useTestOidcIdp(t, svr)
+	begin := beginOidcLogin(t, svr, engine, "idp")
+	code, _ := idp.authorize(t, begin.AuthorizationUrl, aliceClaims())
+	res := tryFinishOidcLogin(
+		t, svr, engine, "idp", FinishOidcLoginJSONRequestBody{
+			SessionToken: begin.SessionToken, Code: code, State: "other",
+		},
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_401_if_session_tampered(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := useTestOidcIdp(t, svr)
+	begin := beginOidcLogin(t, svr, engine, "idp")
+	code, state := idp.authorize(t, begin.AuthorizationUrl, aliceClaims())
+	res := tryFinishOidcLogin(
+		t, svr, engine, "idp", FinishOidcLoginJSONRequestBody{
+			SessionToken: begin.SessionToken + "x", Code: code, State: state,
+		},
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_401_if_session_expired(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := useTestOidcIdp(t, svr)
+	begin := beginOidcLogin(t, svr, engine, "idp")
+	code, state := idp.authorize(t, begin.AuthorizationUrl, aliceClaims())
+	var session oidcSession
+	require.Nil(t, openState("oidc", begin.SessionToken, &session))
+	session.Expires = time.Now().Add(-time.Second)
+	st, err := sealState("oidc", session)
+	require.Nil(t, err)
+	res := tryFinishOidcLogin(
+		t, svr, engine, "idp", FinishOidcLoginJSONRequestBody{
+			SessionToken: st, Code: code, State: state,
+		},
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_401_if_session_of_other_provider(
+	t *testing.T,
+) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := newTestOidcIdp(t)
+	other := idp.provider()
+	other.Name = "other"
+	svr.UseOidcProviders(idp.provider(), other)
+	begin := beginOidcLogin(t, svr, engine, "other")
+	code, state := idp.authorize(t, begin.AuthorizationUrl, aliceClaims())
+	res := tryFinishOidcLogin(
+		t, svr, engine, "idp", FinishOidcLoginJSONRequestBody{
+			SessionToken: begin.SessionToken, Code: code, State: state,
+		},
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_401_if_code_invalid(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, true)
+	idp := useTestOidcIdp(t, svr)
+	begin := beginOidcLogin(t, svr, engine, "idp")
+	code, state := idp.authorize(t, begin.AuthorizationUrl, aliceClaims())
+	body := FinishOidcLoginJSONRequestBody{
+		SessionToken: begin.SessionToken, Code: code, State: state,
+	}
+	res := tryFinishOidcLogin(t, svr, engine, "idp", body)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	// codes can't be replayed
+	res = tryFinishOidcLogin(t, svr, engine, "idp", body)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_401_if_verifier_mismatch(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := useTestOidcIdp(t, svr)
+	begin := beginOidcLogin(t, svr, engine, "idp")
+	other := beginOidcLogin(t, svr, engine, "idp")
+	// the code was requested with the challenge of another sign in
+	code, _ := idp.authorize(t, other.AuthorizationUrl, aliceClaims())
+	var session oidcSession
+	require.Nil(t, openState("oidc", begin.SessionToken, &session))
+	res := tryFinishOidcLogin(
+		t, svr, engine, "idp", FinishOidcLoginJSONRequestBody{
+			SessionToken: begin.SessionToken, Code: code,
+			State: session.State,
+		},
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_401_if_nonce_mismatch(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := useTestOidcIdp(t, svr)
+	claims := aliceClaims()
+	claims["nonce"] = "other"
+	res := tryOidcLogin(t, svr, engine, idp, claims)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_401_if_signature_invalid(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	idp := useTestOidcIdp(t, svr)
+	begin := beginOidcLogin(t, svr, engine, "idp")
+	code, state := idp.authorize(t, begin.AuthorizationUrl, aliceClaims())
+	idp.forge(t, code)
+	res := tryFinishOidcLogin(
+		t, svr, engine, "idp", FinishOidcLoginJSONRequestBody{
+			SessionToken: begin.SessionToken, Code: code, State: state,
+		},
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Zero(t, db.LinkedIdentity.Query().CountX(context.Background()))
+}
+
+func Test_FinishOidcLogin_returns_401_if_audience_mismatch(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := useTestOidcIdp(t, svr)
+	claims := aliceClaims()
+	claims["aud"] = "other"
+	res := tryOidcLogin(t, svr, engine, idp, claims)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_401_if_token_expired(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := useTestOidcIdp(t, svr)
+	claims := aliceClaims()
+	claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	res := tryOidcLogin(t, svr, engine, idp, claims)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_404_if_provider_unknown(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	useTestOidcIdp(t, svr)
+	res := tryFinishOidcLogin(
+		t, svr, engine, "other", FinishOidcLoginJSONRequestBody{
+			SessionToken: "token", Code: "code", State: "state",
+		},
+	)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_FinishOidcLogin_returns_500_if_db_error(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	idp := useTestOidcIdp(t, svr)
+	begin := beginOidcLogin(t, svr, engine, "idp")
+	code, state := idp.authorize(t, begin.AuthorizationUrl, aliceClaims())
+	svr.db = useEmptyDb(t)
+	res := tryFinishOidcLogin(
+		t, svr, engine, "idp", FinishOidcLoginJSONRequestBody{
+			SessionToken: begin.SessionToken, Code: code, State: state,
+		},
+	)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
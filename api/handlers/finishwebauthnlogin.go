@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// FinishWebauthnLogin verifies the assertion of the authenticator, and sets
+// the access and refresh token cookies like Login does. Passkeys require user
+// verification, so they already are a second factor, users with TOTP enabled
+// aren't challenged again. Failed assertions count towards the lockout, and
+// each ceremony can only sign in once.
+//
+// Endpoint: POST /login/webauthn/finish
+func (s Server) FinishWebauthnLogin(
+	ctx context.Context, request FinishWebauthnLoginRequestObject,
+) (FinishWebauthnLoginResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	qc := context.Background()
+	ip := gc.ClientIP()
+	wait, err := s.loginLockedOut(qc, ipAttemptKey(ip))
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	if wait > 0 {
+		return FinishWebauthnLogin429JSONResponse{lockedOut(wait)}, nil
+	}
+	wa, err := s.relyingParty()
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	invalid := FinishWebauthnLogin401JSONResponse{
+		N401JSONResponse: N401JSONResponse{
+			Code:   http.StatusUnauthorized,
+			Errors: &msgInvalidCredential,
+			Status: msgError,
+		},
+	}
+	var u *webauthnUser
+	fail := func() (FinishWebauthnLoginResponseObject, error) {
+		var eu *ent.User
+		if nil != u {
+			eu = u.User
+		}
+		if err := s.loginFailed(qc, ip, eu); err != nil {
+			api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+			return nil, err
+		}
+		return invalid, nil
+	}
+	session, err := openWebauthnSession(request.Body.SessionToken)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return invalid, nil
+	}
+	data, err := json.Marshal(request.Body.Credential)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(data)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return fail()
+	}
+	handle := parsed.Response.UserHandle
+	if 8 != len(handle) {
+		return fail()
+	}
+	eu, err := s.db.User.Get(qc, binary.BigEndian.Uint64(handle))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fail()
+		}
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	if accountClosed(eu) {
+		return fail()
+	}
+	u, err = loadWebauthnUser(qc, s.db, eu)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	wait, err = s.loginLockedOut(qc, userAttemptKey(u.ID))
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	if wait > 0 {
+		return FinishWebauthnLogin429JSONResponse{lockedOut(wait)}, nil
+	}
+	cred, err := wa.ValidateDiscoverableLogin(
+		func(_, _ []byte) (webauthn.User, error) { return u, nil },
+		*session, parsed,
+	)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return fail()
+	}
+	// the signature counter went backwards, the key may have been cloned
+	if cred.Authenticator.CloneWarning {
+		api.Log.Debugf("FinishWebauthnLogin error: cloned authenticator")
+		return fail()
+	}
+	// the assertion of a ceremony signs in once, even before it expires
+	err = consumeWebauthnChallenge(qc, s.db, session)
+	if err != nil {
+		if errors.Is(err, errInvalidSession) {
+			return fail()
+		}
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	stored := u.credential(cred.ID)
+	err = s.db.Credential.UpdateOne(stored).
+		SetSignCount(cred.Authenticator.SignCount).
+		SetBackupState(cred.Flags.BackupState).
+		SetLastUsedAt(time.Now()).Exec(qc)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	res, err := s.signIn(gc, u.User)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnLogin error: %v", err)
+		return nil, err
+	}
+	return FinishWebauthnLogin200JSONResponse(*res), nil
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/credential"
+)
+
+// Signs in with the passkey, returns the response.
+func tryPasskey(
+	tb testing.TB, svr *Server, engine http.Handler, a *softAuthenticator,
+) *httptest.ResponseRecorder {
+	ceremony := beginPasskeyLogin(tb, svr, engine)
+	return finishPasskeyLogin(
+		tb, svr, engine, ceremony.SessionToken,
+		a.get(tb, ceremony.Options, webauthnOrigin(tb, svr)),
+	)
+}
+
+// Submits the assertion, returns the response.
+func finishPasskeyLogin(
+	tb testing.TB, svr *Server, engine http.Handler, session string,
+	assertion map[string]interface{},
+) *httptest.ResponseRecorder {
+	req, err := svr.post(
+		"/login/webauthn/finish",
+		FinishWebauthnLoginJSONRequestBody{
+			SessionToken: session,
+			Credential:   assertion,
+		},
+	)
+	require.Nil(tb, err)
+	req.RemoteAddr = "192.0.2.1:1234"
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+// Creates a user with a passkey, returns the user and its authenticator.
+func createPasskeyUser(
+	tb testing.TB, svr *Server, engine http.Handler, db *ent.Client,
+) (*ent.User, *softAuthenticator) {
+	u, err := svr.createUser(
+		context.Background(), db.User.Create(),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(tb, err)
+	grantPermissions(
+		tb, db, 2, "auth:BeginWebauthnRegistration",
+		"auth:FinishWebauthnRegistration",
+	)
+	db.User.UpdateOneID(u.ID).AddRoleIDs(2).ExecX(context.Background())
+	return u, registerPasskey(tb, svr, engine, db, u.ID)
+}
+
+func Test_FinishWebauthnLogin_sets_cookies(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u, a := createPasskeyUser(t, svr, engine, db)
+	res := tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusOK, res.Code)
+	cat, crt := getTokensFromSetCookieHeaders(t, res)
+	require.NotNil(t, cat)
+	require.NotNil(t, crt)
+	tk, err := svr.jwtTokenFromString(cat.Value)
+	require.Nil(t, err)
+	require.Nil(t, tk.checkAccessToken())
+	actual := unmarshalResponse(t, FinishWebauthnLogin200JSONResponse{}, res)
+	require.Equal(t, u.ID, actual.Id)
+	require.Equal(t, "test", actual.Username)
+	c := db.Credential.Query().Where(credential.UserIDEQ(u.ID)).
+		OnlyX(context.Background())
+	require.Equal(t, a.counter, c.SignCount)
+	require.NotNil(t, c.LastUsedAt)
+	require.WithinDuration(t, time.Now(), *c.LastUsedAt, time.Minute)
+}
+
+func Test_FinishWebauthnLogin_skips_totp_challenge(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u, a := createPasskeyUser(t, svr, engine, db)
+	enableTotp(t, db, u.ID)
+	res := tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusOK, res.Code)
+	cat, _ := getTokensFromSetCookieHeaders(t, res)
+	require.NotNil(t, cat)
+}
+
+func Test_FinishWebauthnLogin_reports_401_if_signature_invalid(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, a := createPasskeyUser(t, svr, engine, db)
+	// same credential ID, different key
+	forged := newSoftAuthenticator(t)
+	forged.id = a.id
+	forged.userHandle = a.userHandle
+	res := tryPasskey(t, svr, engine, forged)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Empty(t, res.Result().Cookies())
+	requireJsonEqualsString(
+		t, map[string]interface{}{
+			"code":   http.StatusUnauthorized,
+			"errors": msgInvalidCredential,
+			"status": msgError,
+		}, res.Body.String(),
+	)
+}
+
+func Test_FinishWebauthnLogin_reports_401_if_counter_goes_back(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, a := createPasskeyUser(t, svr, engine, db)
+	res := tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusOK, res.Code)
+	// a clone of the authenticator made before the last login
+	a.counter -= 2
+	res = tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishWebauthnLogin_reports_401_if_assertion_replayed(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, a := createPasskeyUser(t, svr, engine, db)
+	// without a counter, only the challenge tells replays apart
+	a.uncounted = true
+	a.counter = 0
+	db.Credential.Update().SetSignCount(0).ExecX(context.Background())
+	ceremony := beginPasskeyLogin(t, svr, engine)
+	assertion := a.get(t, ceremony.Options, webauthnOrigin(t, svr))
+	res := finishPasskeyLogin(t, svr, engine, ceremony.SessionToken, assertion)
+	require.Equal(t, http.StatusOK, res.Code)
+	res = finishPasskeyLogin(t, svr, engine, ceremony.SessionToken, assertion)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Empty(t, res.Result().Cookies())
+	// another ceremony still signs in
+	res = tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_FinishWebauthnLogin_reports_401_if_user_unknown(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, a := createPasskeyUser(t, svr, engine, db)
+	a.userHandle = webauthnUserHandle(999)
+	res := tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	a.userHandle = []byte{1}
+	res = tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishWebauthnLogin_reports_401_if_passkey_of_other_user(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, a := createPasskeyUser(t, svr, engine, db)
+	a.userHandle = webauthnUserHandle(1)
+	res := tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishWebauthnLogin_reports_401_if_session_invalid(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, a := createPasskeyUser(t, svr, engine, db)
+	ceremony := beginPasskeyLogin(t, svr, engine)
+	res := finishPasskeyLogin(
+		t, svr, engine, "abc",
+		a.get(t, ceremony.Options, webauthnOrigin(t, svr)),
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishWebauthnLogin_reports_401_if_registration_session(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u, a := createPasskeyUser(t, svr, engine, db)
+	req, err := svr.postAs(u, "/me/webauthn/register", nil)
+	require.Nil(t, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	ceremony := unmarshalResponse(
+		t, BeginWebauthnRegistration200JSONResponse{}, res,
+	)
+	options := beginPasskeyLogin(t, svr, engine).Options
+	res = finishPasskeyLogin(
+		t, svr, engine, ceremony.SessionToken,
+		a.get(t, options, webauthnOrigin(t, svr)),
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_FinishWebauthnLogin_locks_out_after_too_many_failures(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, a := createPasskeyUser(t, svr, engine, db)
+	forged := newSoftAuthenticator(t)
+	forged.id = a.id
+	forged.userHandle = a.userHandle
+	for i := 0; i < svr.loginMaxAttempts; i++ {
+		res := tryPasskey(t, svr, engine, forged)
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+	res := tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusTooManyRequests, res.Code)
+	require.NotEmpty(t, res.Header().Get("Retry-After"))
+}
+
+func Test_FinishWebauthnLogin_returns_500_if_attempt_store_error(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, a := createPasskeyUser(t, svr, engine, db)
+	svr.UseLoginAttemptStore(failingLoginAttemptStore{})
+	res := tryPasskey(t, svr, engine, a)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
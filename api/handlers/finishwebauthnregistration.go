@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// FinishWebauthnRegistration verifies the credential created by the
+// authenticator, and stores it as a passkey of the current user.
+//
+// Endpoint: POST /me/webauthn/register/finish
+func (s Server) FinishWebauthnRegistration(
+	ctx context.Context, request FinishWebauthnRegistrationRequestObject,
+) (FinishWebauthnRegistrationResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	if nil != token.actor {
+		return FinishWebauthnRegistration403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	wa, err := s.relyingParty()
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	invalid := FinishWebauthnRegistration400JSONResponse{
+		N400JSONResponse: N400JSONResponse{
+			Code:   http.StatusBadRequest,
+			Errors: &msgInvalidCredential,
+			Status: msgError,
+		},
+	}
+	session, err := openWebauthnSession(request.Body.SessionToken)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnRegistration error: %v", err)
+		return invalid, nil
+	}
+	data, err := json.Marshal(request.Body.Credential)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(data)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnRegistration error: %v", err)
+		return invalid, nil
+	}
+	qc := context.Background()
+	u, err := loadWebauthnUser(qc, s.db, token.user)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	cred, err := wa.CreateCredential(u, *session, parsed)
+	if err != nil {
+		api.Log.Debugf("FinishWebauthnRegistration error: %v", err)
+		return invalid, nil
+	}
+	transports := make([]string, len(cred.Transport))
+	for i, t := range cred.Transport {
+		transports[i] = string(t)
+	}
+	c, err := s.db.Transaction(
+		qc, func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			create := tx.Credential.Create().
+				SetUserID(token.user.ID).
+				SetCredentialID(cred.ID).
+				SetPublicKey(cred.PublicKey).
+				SetAttestationType(cred.AttestationType).
+				SetTransports(transports).
+				SetAaguid(cred.Authenticator.AAGUID).
+				SetSignCount(cred.Authenticator.SignCount).
+				SetBackupEligible(cred.Flags.BackupEligible).
+				SetBackupState(cred.Flags.BackupState)
+			if nil != request.Body.Name {
+				create.SetName(*request.Body.Name)
+			}
+			c, err := create.Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = recordAudit(
+				qc, tx, api.AuditWebauthnRegistered,
+				token.user.OrganizationID, token, map[string]interface{}{
+					"user_id":       token.user.ID,
+					"username":      token.user.Username,
+					"credential_id": c.ID,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			return c, nil
+		},
+	)
+	if err != nil {
+		// the same authenticator registered twice
+		if ent.IsConstraintError(err) {
+			return invalid, nil
+		}
+		api.Log.Debugf("FinishWebauthnRegistration error: %v", err)
+		return nil, err
+	}
+	res := c.(*ent.Credential)
+	return FinishWebauthnRegistration201JSONResponse{
+		Id:         res.ID,
+		UserId:     res.UserID,
+		Name:       res.Name,
+		LastUsedAt: res.LastUsedAt,
+		CreatedAt:  res.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/credential"
+)
+
+// Starts passkey registration of user 1, returns the ceremony.
+func beginPasskeyRegistration(
+	tb testing.TB, svr *Server, engine http.Handler, db *ent.Client,
+) BeginWebauthnRegistration200JSONResponse {
+	req, err := svr.postAs(
+		getUserById(tb, db, 1), "/me/webauthn/register", nil,
+	)
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(tb, http.StatusOK, res.Code)
+	return unmarshalResponse(
+		tb, BeginWebauthnRegistration200JSONResponse{}, res,
+	)
+}
+
+func Test_FinishWebauthnRegistration_stores_credential(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ceremony := beginPasskeyRegistration(t, svr, engine, db)
+	a := newSoftAuthenticator(t)
+	name := "laptop"
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: ceremony.SessionToken,
+			Name:         &name,
+			Credential:   a.create(t, ceremony.Options, webauthnOrigin(t, svr)),
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(
+		t, FinishWebauthnRegistration201JSONResponse{}, res,
+	)
+	require.Equal(t, uint64(1), actual.UserId)
+	require.Equal(t, name, actual.Name)
+	require.Nil(t, actual.LastUsedAt)
+	qc := context.Background()
+	c := db.Credential.Query().Where(credential.IDEQ(actual.Id)).OnlyX(qc)
+	require.Equal(t, a.id, c.CredentialID)
+	require.Equal(t, "none", c.AttestationType)
+	require.Equal(t, a.counter, c.SignCount)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditWebauthnRegistered)).OnlyX(qc)
+	require.Equal(t, uint64(1), *log.ActorID)
+	require.Equal(t, float64(actual.Id), log.Details["credential_id"])
+}
+
+func Test_FinishWebauthnRegistration_reports_400_if_registered_twice(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a := registerPasskey(t, svr, engine, db, 1)
+	ceremony := beginPasskeyRegistration(t, svr, engine, db)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: ceremony.SessionToken,
+			Credential:   a.create(t, ceremony.Options, webauthnOrigin(t, svr)),
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Equal(t, 1, db.Credential.Query().CountX(context.Background()))
+}
+
+func Test_FinishWebauthnRegistration_reports_400_if_origin_mismatch(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ceremony := beginPasskeyRegistration(t, svr, engine, db)
+	a := newSoftAuthenticator(t)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: ceremony.SessionToken,
+			Credential: a.create(
+				t, ceremony.Options, "https://evil.example.com",
+			),
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	requireJsonEqualsString(
+		t, map[string]interface{}{
+			"code":   http.StatusBadRequest,
+			"errors": msgInvalidCredential,
+			"status": msgError,
+		}, res.Body.String(),
+	)
+	require.Equal(t, 0, db.Credential.Query().CountX(context.Background()))
+}
+
+func Test_FinishWebauthnRegistration_reports_400_if_session_invalid(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ceremony := beginPasskeyRegistration(t, svr, engine, db)
+	a := newSoftAuthenticator(t)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: ceremony.SessionToken + "x",
+			Credential:   a.create(t, ceremony.Options, webauthnOrigin(t, svr)),
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_FinishWebauthnRegistration_reports_400_if_session_of_other_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:FinishWebauthnRegistration")
+	ceremony := beginPasskeyRegistration(t, svr, engine, db)
+	a := newSoftAuthenticator(t)
+	req, err := svr.postAs(
+		getUserById(t, db, 2), "/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: ceremony.SessionToken,
+			Credential:   a.create(t, ceremony.Options, webauthnOrigin(t, svr)),
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_FinishWebauthnRegistration_reports_400_if_credential_malformed(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ceremony := beginPasskeyRegistration(t, svr, engine, db)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: ceremony.SessionToken,
+			Credential:   map[string]interface{}{"id": "abc"},
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_FinishWebauthnRegistration_returns_403_if_impersonated(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:FinishWebauthnRegistration")
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.post(
+		"/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: "abc",
+			Credential:   map[string]interface{}{},
+		},
+	)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_FinishWebauthnRegistration_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 2), "/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: "abc",
+			Credential:   map[string]interface{}{},
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_FinishWebauthnRegistration_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ceremony := beginPasskeyRegistration(t, svr, engine, db)
+	a := newSoftAuthenticator(t)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: ceremony.SessionToken,
+			Credential:   a.create(t, ceremony.Options, webauthnOrigin(t, svr)),
+		},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/passwordreset"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// ForgotPassword emails a password reset token to the user with the given
+// email address. Earlier tokens of the user are revoked. The response is the
+// same whether the account exists or not, and the email is sent in background.
+// Passwords of external users are managed by their provider, so they don't
+// get any token.
+//
+// Endpoint: POST /password/forgot
+func (s Server) ForgotPassword(
+	_ context.Context, request ForgotPasswordRequestObject,
+) (ForgotPasswordResponseObject, error) {
+	qc := context.Background()
+	org := uint32(api.DefaultOrganizationId)
+	if nil != request.Body.Organization {
+		org = *request.Body.Organization
+	}
+	u, err := s.db.User.Query().Where(
+		user.EmailEqualFold(string(request.Body.Email)),
+		user.OrganizationIDEQ(org), user.AuthProviderIsNil(),
+	).Only(qc)
+	if err != nil {
+		if ent.IsNotFound(err) || ent.IsNotSingular(err) {
+			return ForgotPassword202Response{}, nil
+		}
+		api.Log.Debugf("ForgotPassword error: %v", err)
+		return nil, err
+	}
+	token, hash, err := newPasswordResetToken()
+	if err != nil {
+		api.Log.Debugf("ForgotPassword error: %v", err)
+		return nil, err
+	}
+	expiresAt := time.Now().Add(s.passwordResetTtl)
+	_, err = s.db.Transaction(
+		qc, func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			err := tx.PasswordReset.Update().Where(
+				passwordreset.UserIDEQ(u.ID), passwordreset.UsedAtIsNil(),
+			).SetUsedAt(time.Now()).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = tx.PasswordReset.Create().SetUserID(u.ID).SetToken(hash).
+				SetExpiresAt(expiresAt).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditPasswordResetSent, u.OrganizationID, nil,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+		},
+	)
+	if err != nil {
+		api.Log.Debugf("ForgotPassword error: %v", err)
+		return nil, err
+	}
+	s.sendMail(
+		Mail{
+			To:      *u.Email,
+			Subject: "Password reset",
+			Body:    s.passwordResetMail(u, token, expiresAt),
+		},
+	)
+	return ForgotPassword202Response{}, nil
+}
+
+// Returns a random token to hand to the user, and its hash to store.
+func newPasswordResetToken() (string, []byte, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashPasswordResetToken(token), nil
+}
+
+// Tokens are random enough that a plain hash can't be brute forced.
+func hashPasswordResetToken(token string) []byte {
+	hash := sha256.Sum256([]byte(token))
+	return hash[:]
+}
+
+func (s Server) passwordResetMail(
+	u *ent.User, token string, expiresAt time.Time,
+) string {
+	link := token
+	if r, err := url.Parse(s.passwordResetUrl); "" != s.passwordResetUrl &&
+		nil == err {
+		q := r.Query()
+		q.Set("token", token)
+		r.RawQuery = q.Encode()
+		link = r.String()
+	}
+	return fmt.Sprintf(
+		"Hello %s,\n\n"+
+			"We received a request to reset the password of your account. "+
+			"Use the following to choose a new password before %s:\n\n"+
+			"%s\n\n"+
+			"If you didn't request it, you can ignore this email, "+
+			"your password won't change.\n",
+		u.Username, expiresAt.UTC().Format(time.RFC1123), link,
+	)
+}
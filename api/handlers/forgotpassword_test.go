@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/passwordreset"
+)
+
+var resetTokenPattern = regexp.MustCompile(`[A-Za-z0-9_-]{43}`)
+
+// Requests a password reset, returns the response.
+func tryForgotPassword(
+	tb testing.TB, svr *Server, engine http.Handler, email string,
+) *httptest.ResponseRecorder {
+	req, err := svr.post(
+		"/password/forgot",
+		ForgotPasswordJSONRequestBody{Email: openapi_types.Email(email)},
+	)
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+// Requests a password reset of the user, returns the token from the email.
+func requestPasswordReset(
+	tb testing.TB, svr *Server, engine http.Handler, email string,
+) string {
+	mails := recordMails(svr)
+	res := tryForgotPassword(tb, svr, engine, email)
+	require.Equal(tb, http.StatusAccepted, res.Code)
+	token := resetTokenPattern.FindString(mails.next(tb).Body)
+	require.NotEmpty(tb, token)
+	return token
+}
+
+func Test_ForgotPassword_sends_reset_token(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	mails := recordMails(svr)
+	u := getUserById(t, db, 2)
+	res := tryForgotPassword(t, svr, engine, *u.Email)
+	require.Equal(t, http.StatusAccepted, res.Code)
+	require.Empty(t, res.Body.String())
+	mail := mails.next(t)
+	require.Equal(t, *u.Email, mail.To)
+	require.Contains(t, mail.Body, u.Username)
+	token := resetTokenPattern.FindString(mail.Body)
+	require.NotEmpty(t, token)
+	qc := context.Background()
+	r := db.PasswordReset.Query().Where(passwordreset.UserIDEQ(u.ID)).
+		OnlyX(qc)
+	// only the hash is stored
+	require.Equal(t, hashPasswordResetToken(token), r.Token)
+	require.Nil(t, r.UsedAt)
+	require.WithinDuration(
+		t, time.Now().Add(time.Hour), r.ExpiresAt, time.Minute,
+	)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditPasswordResetSent)).OnlyX(qc)
+	require.Nil(t, log.ActorID)
+	require.Equal(t, float64(u.ID), log.Details["user_id"])
+}
+
+func Test_ForgotPassword_matches_email_case_insensitively(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	mails := recordMails(svr)
+	u := getUserById(t, db, 2)
+	res := tryForgotPassword(t, svr, engine, strings.ToUpper(*u.Email))
+	require.Equal(t, http.StatusAccepted, res.Code)
+	require.Equal(t, *u.Email, mails.next(t).To)
+}
+
+func Test_ForgotPassword_revokes_earlier_tokens(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u := getUserById(t, db, 2)
+	first := requestPasswordReset(t, svr, engine, *u.Email)
+	second := requestPasswordReset(t, svr, engine, *u.Email)
+	require.NotEqual(t, first, second)
+	qc := context.Background()
+	r := db.PasswordReset.Query().
+		Where(passwordreset.TokenEQ(hashPasswordResetToken(first))).OnlyX(qc)
+	require.NotNil(t, r.UsedAt)
+	r = db.PasswordReset.Query().
+		Where(passwordreset.TokenEQ(hashPasswordResetToken(second))).OnlyX(qc)
+	require.Nil(t, r.UsedAt)
+}
+
+func Test_ForgotPassword_links_to_reset_page(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	svr.passwordResetUrl = "https://app.example.com/reset?lang=en"
+	mails := recordMails(svr)
+	res := tryForgotPassword(t, svr, engine, *getUserById(t, db, 2).Email)
+	require.Equal(t, http.StatusAccepted, res.Code)
+	link := regexp.MustCompile(`https://\S+`).FindString(mails.next(t).Body)
+	u, err := url.Parse(link)
+	require.Nil(t, err)
+	require.Equal(t, "app.example.com", u.Host)
+	require.Equal(t, "/reset", u.Path)
+	require.Equal(t, "en", u.Query().Get("lang"))
+	require.Regexp(t, `^[A-Za-z0-9_-]{43}$`, u.Query().Get("token"))
+}
+
+func Test_ForgotPassword_hides_unknown_email(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	mails := recordMails(svr)
+	known := tryForgotPassword(t, svr, engine, *getUserById(t, db, 2).Email)
+	mails.next(t)
+	unknown := tryForgotPassword(t, svr, engine, "nobody@test.com")
+	require.Equal(t, known.Code, unknown.Code)
+	require.Equal(t, known.Body.String(), unknown.Body.String())
+	mails.none(t)
+	require.Equal(t, 1, db.PasswordReset.Query().CountX(context.Background()))
+}
+
+func Test_ForgotPassword_searches_given_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	mails := recordMails(svr)
+	org := uint32(2)
+	req, err := svr.post(
+		"/password/forgot",
+		ForgotPasswordJSONRequestBody{
+			Email:        openapi_types.Email(*getUserById(t, db, 2).Email),
+			Organization: &org,
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusAccepted, res.Code)
+	mails.none(t)
+}
+
+func Test_ForgotPassword_returns_422_if_email_invalid(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post(
+		"/password/forgot", map[string]interface{}{"email": "not an email"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_ForgotPassword_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post(
+		"/password/forgot",
+		ForgotPasswordJSONRequestBody{Email: "email1@test.com"},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
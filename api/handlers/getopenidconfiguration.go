@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// GetOpenidConfiguration returns the OpenID Connect discovery document of
+// the authorization server.
+//
+// Endpoint: GET /.well-known/openid-configuration
+func (s Server) GetOpenidConfiguration(
+	_ context.Context, _ GetOpenidConfigurationRequestObject,
+) (GetOpenidConfigurationResponseObject, error) {
+	issuer := s.oauthIssuer()
+	return GetOpenidConfiguration200JSONResponse{
+		Issuer:                           issuer,
+		AuthorizationEndpoint:            issuer + "/oauth/authorize",
+		TokenEndpoint:                    issuer + "/oauth/token",
+		UserinfoEndpoint:                 issuer + "/userinfo",
+		JwksUri:                          issuer + "/oauth/jwks",
+		ResponseTypesSupported:           []string{"code"},
+		SubjectTypesSupported:            []string{"public"},
+		IdTokenSigningAlgValuesSupported: []string{"RS256"},
+		ScopesSupported:                  oauthScopes,
+		GrantTypesSupported:              api.OauthGrantTypes,
+		CodeChallengeMethodsSupported:    []string{"S256"},
+		TokenEndpointAuthMethodsSupported: []string{
+			"client_secret_basic", "client_secret_post", "private_key_jwt",
+			"none",
+		},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "nonce", "preferred_username",
+			"email",
+		},
+	}, nil
+}
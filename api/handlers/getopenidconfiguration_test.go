@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetOpenidConfiguration_returns_discovery_document(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/.well-known/openid-configuration")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, GetOpenidConfiguration200JSONResponse{}, res)
+	require.Equal(t, "http://localhost", actual.Issuer)
+	require.Equal(
+		t, "http://localhost/oauth/authorize", actual.AuthorizationEndpoint,
+	)
+	require.Equal(t, "http://localhost/oauth/token", actual.TokenEndpoint)
+	require.Equal(t, "http://localhost/userinfo", actual.UserinfoEndpoint)
+	require.Equal(t, "http://localhost/oauth/jwks", actual.JwksUri)
+	require.Equal(t, []string{"code"}, actual.ResponseTypesSupported)
+	require.Equal(t, []string{"S256"}, actual.CodeChallengeMethodsSupported)
+	require.Equal(
+		t, []string{"RS256"}, actual.IdTokenSigningAlgValuesSupported,
+	)
+	require.Equal(
+		t, []string{"openid", "profile", "email"}, actual.ScopesSupported,
+	)
+	require.Equal(
+		t, []string{
+			"authorization_code", "refresh_token", "client_credentials",
+		}, actual.GrantTypesSupported,
+	)
+	require.Contains(
+		t, actual.TokenEndpointAuthMethodsSupported, "private_key_jwt",
+	)
+}
@@ -11,6 +11,8 @@ import (
 const (
 	accessTokenName  = "access_token"
 	refreshTokenName = "refresh_token"
+	// organization of the authenticated SCIM client
+	scimTenantName = "scim_tenant"
 )
 
 // drop in replacement for encoding/json
@@ -21,30 +23,58 @@ var (
 	numChecker       = regexp.MustCompile(`[0-9]+`)
 	uppercaseChecker = regexp.MustCompile(`[A-Z]+`)
 	lowercaseChecker = regexp.MustCompile(`[a-z]+`)
-	specialChecker   = regexp.MustCompile(`[#?!@$%^&*-_]+`)
-
-	errPasswordToSimple = errors.New(
-		"password must contain at least 8 characters, " +
-			"including uppercase, lowercase, number, and special characters" +
-			" (#?!@$%^&*-_)",
-	)
+	specialChecker   = regexp.MustCompile(`[#?!@$%^&*_-]+`)
 )
 
 // error messages
 var (
-	errAccessDenied    = errors.New("access_denied")
-	errEmptyToken      = errors.New("empty_token")
-	errInvalidArgument = errors.New("invalid_argument")
-	errInvalidContext  = errors.New("invalid_context")
-	errInvalidHeader   = errors.New("invalid_header")
-	errInvalidToken    = errors.New("invalid_token")
+	errAccessDenied           = errors.New("access_denied")
+	errCrossTenant            = errors.New("cross_tenant")
+	errEmptyToken             = errors.New("empty_token")
+	errInvalidArgument        = errors.New("invalid_argument")
+	errInvalidContext         = errors.New("invalid_context")
+	errInvalidSession         = errors.New("invalid_session")
+	errInvalidState           = errors.New("invalid_state")
+	errInvalidHeader          = errors.New("invalid_header")
+	errInvalidToken           = errors.New("invalid_token")
+	errMfaRequired            = errors.New("mfa_required")
+	errNotPending             = errors.New("not_pending")
+	errPasswordChangeRequired = errors.New("password_change_required")
+	errPendingRequest         = errors.New("pending_request")
 
 	// Denotes that either part of an assignment request is
 	// invalid (e.g. not found). For example, when assigning a non-existing
 	// role to a user; or a role to non-existing user.
 	msgInvalidAssignment interface{} = "invalid_assignment"
+	msgAccessDenied      interface{} = "access_denied"
 	msgEmptyRequest      interface{} = "empty_request"
 	msgError                         = "error"
 	msgExists            interface{} = "already_exists"
+	msgInUse             interface{} = "in_use"
 	msgNotFound          interface{} = "not_found"
+	// Denotes that the time window of an assignment is invalid, e.g. it
+	// expires before it starts, or has already expired.
+	msgInvalidPeriod interface{} = "invalid_period"
+	// Denotes that the login request has no identifier accepted by the
+	// deployment.
+	msgInvalidIdentifier interface{} = "invalid_identifier"
+	// Denotes that an access request has already been reviewed.
+	msgNotPending interface{} = "not_pending"
+	// Denotes that the account or source address is temporarily locked out
+	// after too many failed logins.
+	msgLockedOut interface{} = "locked_out"
+	// Denotes that the one-time code is wrong or has been used.
+	msgInvalidCode interface{} = "invalid_code"
+	// Denotes that a role of the user requires a second factor, which the user
+	// hasn't enrolled yet.
+	msgMfaRequired interface{} = "mfa_required"
+	// Denotes that the WebAuthn response of the authenticator can't be
+	// verified, or the ceremony has expired.
+	msgInvalidCredential interface{} = "invalid_credential"
+	// Denotes that the user has a temporary password, which must be changed
+	// before anything else.
+	msgPasswordChangeRequired interface{} = "password_change_required"
+	// Denotes that the account isn't in the state the action applies to, such
+	// as enabling an account that isn't disabled.
+	msgInvalidState interface{} = "invalid_state"
 )
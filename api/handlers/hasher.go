@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/eidng8/go-utils"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// PasswordHasher hashes passwords with one algorithm. Hashes are encoded with
+// a prefix naming the algorithm, so that the hasher of a stored hash can be
+// told by the hash itself.
+type PasswordHasher interface {
+	// Hash returns the encoded hash of the password.
+	Hash(password string) (string, error)
+	// Verify reports whether the password matches the encoded hash.
+	Verify(password, hash string) (bool, error)
+	// Handles reports whether the encoded hash belongs to the algorithm.
+	Handles(hash string) bool
+	// NeedsRehash reports whether the encoded hash was produced with
+	// parameters other than the current ones.
+	NeedsRehash(hash string) bool
+}
+
+var errUnknownHash = errors.New("unknown password hash algorithm")
+
+// Argon2idHasher produces `$argon2id$v=19$m=…,t=…,p=…$salt$hash` hashes.
+type Argon2idHasher struct {
+	Params utils.PasswordHashParams
+}
+
+var _ PasswordHasher = Argon2idHasher{}
+
+func (h Argon2idHasher) Hash(password string) (string, error) {
+	return utils.HashPasswordWithParams(password, h.Params)
+}
+
+func (h Argon2idHasher) Verify(password, hash string) (bool, error) {
+	return utils.ComparePassword(password, hash)
+}
+
+func (h Argon2idHasher) Handles(hash string) bool {
+	return strings.HasPrefix(hash, "$argon2id$")
+}
+
+func (h Argon2idHasher) NeedsRehash(hash string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return true
+	}
+	var version, memory, times uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return true
+	}
+	_, err := fmt.Sscanf(
+		parts[3], "m=%d,t=%d,p=%d", &memory, &times, &threads,
+	)
+	if err != nil {
+		return true
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return true
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return true
+	}
+	return argon2.Version != version || h.Params.Memory != memory ||
+		h.Params.Times != times || h.Params.Threads != threads ||
+		h.Params.SaltLen != uint32(len(salt)) ||
+		h.Params.KeyLen != uint32(len(key))
+}
+
+// BcryptHasher produces `$2a$` hashes, and verifies the `$2b$` and `$2y$`
+// variants as well. Bcrypt only uses the first 72 bytes of passwords.
+type BcryptHasher struct {
+	Cost int
+}
+
+var _ PasswordHasher = BcryptHasher{}
+
+func (h BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h BcryptHasher) Verify(password, hash string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return nil == err, err
+}
+
+func (h BcryptHasher) Handles(hash string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h BcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.Cost
+}
+
+// ScryptHasher produces `$scrypt$ln=…,r=…,p=…$salt$hash` hashes, where `ln` is
+// the base 2 logarithm of the CPU/memory cost.
+type ScryptHasher struct {
+	LogN    uint8
+	R       int
+	P       int
+	KeyLen  int
+	SaltLen int
+}
+
+var _ PasswordHasher = ScryptHasher{}
+
+func (h ScryptHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key, err := scrypt.Key(
+		[]byte(password), salt, 1<<h.LogN, h.R, h.P, h.KeyLen,
+	)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(
+		"$scrypt$ln=%d,r=%d,p=%d$%s$%s", h.LogN, h.R, h.P,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h ScryptHasher) Verify(password, hash string) (bool, error) {
+	params, salt, key, err := parseScryptHash(hash)
+	if err != nil {
+		return false, err
+	}
+	derived, err := scrypt.Key(
+		[]byte(password), salt, 1<<params.LogN, params.R, params.P, len(key),
+	)
+	if err != nil {
+		return false, err
+	}
+	return 1 == subtle.ConstantTimeCompare(key, derived), nil
+}
+
+func (h ScryptHasher) Handles(hash string) bool {
+	return strings.HasPrefix(hash, "$scrypt$")
+}
+
+func (h ScryptHasher) NeedsRehash(hash string) bool {
+	params, salt, key, err := parseScryptHash(hash)
+	return err != nil || params.LogN != h.LogN || params.R != h.R ||
+		params.P != h.P || len(salt) != h.SaltLen || len(key) != h.KeyLen
+}
+
+func parseScryptHash(hash string) (*ScryptHasher, []byte, []byte, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 5 || "scrypt" != parts[1] {
+		return nil, nil, nil, utils.ErrInvalidHashFormat
+	}
+	var params ScryptHasher
+	_, err := fmt.Sscanf(
+		parts[2], "ln=%d,r=%d,p=%d", &params.LogN, &params.R, &params.P,
+	)
+	if err != nil || params.LogN < 1 || params.LogN > 30 {
+		return nil, nil, nil, utils.ErrInvalidHashFormat
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || 0 == len(key) {
+		return nil, nil, nil, utils.ErrInvalidHashFormat
+	}
+	return &params, salt, key, nil
+}
+
+// UsePasswordHashers replaces the password hashers of the server. The first
+// one hashes new passwords, the others only verify existing hashes, which are
+// replaced by hashes of the first one on the next login.
+func (s *Server) UsePasswordHashers(hashers ...PasswordHasher) {
+	s.passwordHashers = hashers
+	s.dummyHash = &dummyPasswordHash{hasher: hashers[0]}
+}
+
+// Hashes the password with the current hasher.
+func (s Server) hashPassword(password string) (string, error) {
+	return s.passwordHashers[0].Hash(password)
+}
+
+// Verifies the password against the hash, with the hasher handling the hash.
+// `rehash` reports whether a matching hash should be replaced by a hash of the
+// current hasher.
+func (s Server) verifyPassword(password, hash string) (
+	match bool, rehash bool, err error,
+) {
+	for i, h := range s.passwordHashers {
+		if !h.Handles(hash) {
+			continue
+		}
+		match, err = h.Verify(password, hash)
+		if err != nil || !match {
+			return false, false, err
+		}
+		return true, i > 0 || h.NeedsRehash(hash), nil
+	}
+	return false, false, errUnknownHash
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eidng8/go-utils"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Cheap parameters, so that tests don't spend time hashing.
+var (
+	testArgon2idHasher = Argon2idHasher{
+		Params: utils.PasswordHashParams{
+			Times: 1, Memory: 1024, Threads: 1, KeyLen: 32, SaltLen: 16,
+		},
+	}
+	testBcryptHasher = BcryptHasher{Cost: bcrypt.MinCost}
+	testScryptHasher = ScryptHasher{
+		LogN: 10, R: 8, P: 1, KeyLen: 32, SaltLen: 16,
+	}
+)
+
+// Asserts that the hasher verifies its own hashes, and nothing else.
+func requireHasherRoundTrip(t *testing.T, h PasswordHasher, prefix string) {
+	hash, err := h.Hash("Test_123")
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(hash, prefix), hash)
+	require.True(t, h.Handles(hash))
+	require.False(t, h.NeedsRehash(hash))
+	m, err := h.Verify("Test_123", hash)
+	require.Nil(t, err)
+	require.True(t, m)
+	m, err = h.Verify("Test_456", hash)
+	require.Nil(t, err)
+	require.False(t, m)
+	other, err := h.Hash("Test_123")
+	require.Nil(t, err)
+	// salted
+	require.NotEqual(t, hash, other)
+}
+
+func Test_Argon2idHasher_hashes_and_verifies(t *testing.T) {
+	requireHasherRoundTrip(t, testArgon2idHasher, "$argon2id$v=19$")
+}
+
+func Test_Argon2idHasher_NeedsRehash_if_parameters_changed(t *testing.T) {
+	hash, err := testArgon2idHasher.Hash("Test_123")
+	require.Nil(t, err)
+	h := testArgon2idHasher
+	h.Params.Times = 2
+	require.True(t, h.NeedsRehash(hash))
+	h = testArgon2idHasher
+	h.Params.KeyLen = 16
+	require.True(t, h.NeedsRehash(hash))
+	require.True(t, testArgon2idHasher.NeedsRehash("$argon2id$v=19$"))
+}
+
+func Test_BcryptHasher_hashes_and_verifies(t *testing.T) {
+	requireHasherRoundTrip(t, testBcryptHasher, "$2a$")
+}
+
+func Test_BcryptHasher_handles_other_variants(t *testing.T) {
+	hash, err := testBcryptHasher.Hash("Test_123")
+	require.Nil(t, err)
+	for _, variant := range []string{"$2b$", "$2y$"} {
+		legacy := variant + strings.TrimPrefix(hash, "$2a$")
+		require.True(t, testBcryptHasher.Handles(legacy))
+		m, err := testBcryptHasher.Verify("Test_123", legacy)
+		require.Nil(t, err)
+		require.True(t, m)
+	}
+	require.False(t, testBcryptHasher.Handles("$argon2id$v=19$"))
+}
+
+func Test_BcryptHasher_NeedsRehash_if_cost_changed(t *testing.T) {
+	hash, err := testBcryptHasher.Hash("Test_123")
+	require.Nil(t, err)
+	require.True(t, BcryptHasher{Cost: bcrypt.MinCost + 1}.NeedsRehash(hash))
+	require.True(t, testBcryptHasher.NeedsRehash("$2a$"))
+}
+
+func Test_ScryptHasher_hashes_and_verifies(t *testing.T) {
+	requireHasherRoundTrip(t, testScryptHasher, "$scrypt$ln=10,r=8,p=1$")
+}
+
+func Test_ScryptHasher_NeedsRehash_if_parameters_changed(t *testing.T) {
+	hash, err := testScryptHasher.Hash("Test_123")
+	require.Nil(t, err)
+	h := testScryptHasher
+	h.LogN = 11
+	require.True(t, h.NeedsRehash(hash))
+	h = testScryptHasher
+	h.SaltLen = 8
+	require.True(t, h.NeedsRehash(hash))
+}
+
+func Test_ScryptHasher_rejects_malformed_hash(t *testing.T) {
+	for _, hash := range []string{
+		"$scrypt$",
+		"$scrypt$ln=10,r=8,p=1$c2FsdA$",
+		"$scrypt$ln=99,r=8,p=1$c2FsdA$aGFzaA",
+		"$scrypt$n=10$c2FsdA$aGFzaA",
+		"$scrypt$ln=10,r=8,p=1$*$aGFzaA",
+	} {
+		_, err := testScryptHasher.Verify("Test_123", hash)
+		require.NotNil(t, err, hash)
+		require.True(t, testScryptHasher.NeedsRehash(hash), hash)
+	}
+}
+
+func Test_verifyPassword_picks_hasher_by_prefix(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	svr.UsePasswordHashers(
+		testArgon2idHasher, testBcryptHasher, testScryptHasher,
+	)
+	for _, h := range []PasswordHasher{
+		testArgon2idHasher, testBcryptHasher, testScryptHasher,
+	} {
+		hash, err := h.Hash("Test_123")
+		require.Nil(t, err)
+		m, rehash, err := svr.verifyPassword("Test_123", hash)
+		require.Nil(t, err)
+		require.True(t, m)
+		// only hashes of the first hasher are kept
+		require.Equal(t, h != testArgon2idHasher, rehash)
+		m, rehash, err = svr.verifyPassword("Test_456", hash)
+		require.Nil(t, err)
+		require.False(t, m)
+		require.False(t, rehash)
+	}
+}
+
+func Test_verifyPassword_rejects_unknown_hash(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	m, _, err := svr.verifyPassword("password1", "password1")
+	require.ErrorIs(t, err, errUnknownHash)
+	require.False(t, m)
+}
+
+func Test_hashPassword_uses_first_hasher(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	svr.UsePasswordHashers(testScryptHasher, testArgon2idHasher)
+	hash, err := svr.hashPassword("Test_123")
+	require.Nil(t, err)
+	require.True(t, testScryptHasher.Handles(hash))
+	require.True(t, testScryptHasher.Handles(svr.dummyHash.get()))
+}
@@ -11,11 +11,16 @@ import (
 //
 // Endpoint: GET /q/roles
 func (s Server) HintRoles(
-	_ context.Context, request HintRolesRequestObject,
+	ctx context.Context, request HintRolesRequestObject,
 ) (HintRolesResponseObject, error) {
+	scope, err := s.roleScope(ctx)
+	if err != nil {
+		api.Log.Debugf("HintRoles error: %v", err)
+		return nil, err
+	}
 	rows, err := s.db.Role.Query().Limit(s.hintSize).
 		Select(role.FieldID, role.FieldName).
-		Where(role.NameHasPrefix(request.Params.Q)).
+		Where(scope, role.NameHasPrefix(request.Params.Q)).
 		All(context.Background())
 	if err != nil {
 		api.Log.Debugf("HintRoles error: %v", err)
@@ -13,11 +13,17 @@ import (
 //
 // Endpoint: GET /q/users
 func (s Server) HintUsers(
-	_ context.Context, request HintUsersRequestObject,
+	ctx context.Context, request HintUsersRequestObject,
 ) (HintUsersResponseObject, error) {
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("HintUsers error: %v", err)
+		return nil, err
+	}
 	rows, err := s.db.User.Query().Limit(s.hintSize).
 		Select(user.FieldID, user.FieldUsername, user.FieldEmail).
 		Where(
+			scope,
 			user.Or(
 				user.UsernameHasPrefix(request.Params.Q),
 				user.EmailHasPrefix(request.Params.Q),
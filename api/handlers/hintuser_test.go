@@ -33,6 +33,18 @@ func Test_HintUsers_searches_by_email(t *testing.T) {
 	require.Len(t, users, 5)
 }
 
+func Test_HintUsers_omits_service_accounts(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/q/users?q=service")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	users := unmarshalResponse(t, []ent.User{}, res)
+	require.Empty(t, users)
+}
+
 func Test_HintUsers_returns_401_if_non_user(t *testing.T) {
 	svr, engine, _, res := setupTestCase(t, false)
 	req, err := svr.get("/q/users?q=u")
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Impersonate issues a short-lived access token of the user, so that support
+// staff can reproduce what the user sees. The token carries the current user
+// in its `act` claim, and audit entries made with it record both identities.
+// Holders of the root role cannot be impersonated. Impersonation tokens can
+// neither be refreshed nor used to impersonate again.
+//
+// Endpoint: POST /user/{id}/impersonate
+func (s Server) Impersonate(
+	ctx context.Context, request ImpersonateRequestObject,
+) (ImpersonateResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("Impersonate error: %v", err)
+		return nil, err
+	}
+	if nil != token.actor {
+		return Impersonate403JSONResponse{
+			N403JSONResponse: N403JSONResponse{
+				Code:   http.StatusForbidden,
+				Errors: &msgAccessDenied,
+				Status: msgError,
+			},
+		}, nil
+	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("Impersonate error: %v", err)
+		return nil, err
+	}
+	u, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			root, err := tx.Role.Query().
+				Where(role.IDEQ(api.RootRoleId), effectiveRoles(u.ID)).
+				Exist(qc)
+			if err != nil {
+				return nil, err
+			}
+			if root {
+				return nil, errAccessDenied
+			}
+			err = recordAudit(
+				qc, tx, api.AuditUserImpersonated, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			return u, nil
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return Impersonate403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsNotFound(err) {
+			return Impersonate404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("Impersonate error: %v", err)
+		return nil, err
+	}
+	target := u.(*ent.User)
+	if err = s.loadRoles(target); err != nil {
+		api.Log.Debugf("Impersonate error: %v", err)
+		return nil, err
+	}
+	at, exp, err := s.issueImpersonationToken(target, token.user)
+	if err != nil {
+		api.Log.Debugf("Impersonate error: %v", err)
+		return nil, err
+	}
+	return Impersonate200JSONResponse{
+		AccessToken: at,
+		TokenType:   Bearer,
+		ExpiresAt:   *exp,
+	}, nil
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/permission"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Impersonates the user as root, returns the impersonation token.
+func impersonate(
+	tb testing.TB, svr *Server, engine http.Handler, db *ent.Client, id uint64,
+) string {
+	req, err := svr.postAs(
+		getUserById(tb, db, 1), fmt.Sprintf("/user/%d/impersonate", id), nil,
+	)
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(tb, http.StatusOK, res.Code)
+	return unmarshalResponse(tb, Impersonate200JSONResponse{}, res).AccessToken
+}
+
+// Grants the permissions to the role.
+func grantPermissions(
+	tb testing.TB, db *ent.Client, role uint32, perms ...string,
+) {
+	qc := context.Background()
+	require.Nil(
+		tb, db.Role.UpdateOneID(role).AddPermissions(
+			db.Permission.Query().Where(permission.NameIn(perms...)).AllX(qc)...,
+		).Exec(qc),
+	)
+}
+
+func Test_Impersonate_issues_a_token_with_act_claim(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/user/2/impersonate", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, Impersonate200JSONResponse{}, res)
+	require.Equal(t, Bearer, actual.TokenType)
+	require.WithinDuration(
+		t, time.Now().Add(svr.impersonationTtl), actual.ExpiresAt, time.Minute,
+	)
+	tk, err := svr.jwtTokenFromString(actual.AccessToken)
+	require.Nil(t, err)
+	require.Nil(t, tk.checkAccessToken())
+	require.Equal(t, uint64(2), tk.user.ID)
+	require.Equal(t, uint64(1), tk.actor.ID)
+	logs := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditUserImpersonated)).
+		AllX(context.Background())
+	require.Len(t, logs, 1)
+	require.Equal(t, uint64(1), *logs[0].ActorID)
+	require.Nil(t, logs[0].ImpersonatorID)
+	require.Equal(t, float64(2), logs[0].Details["user_id"])
+}
+
+func Test_Impersonate_token_applies_target_permissions(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := http.NewRequest(http.MethodGet, "/users", nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_Impersonate_token_records_both_identities_in_audit(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	grantPermissions(t, db, 2, "auth:DisableUser")
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.post("/user/3/disable", nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditUserDisabled)).OnlyX(qc)
+	require.Equal(t, uint64(2), *log.ActorID)
+	require.Equal(t, uint64(1), *log.ImpersonatorID)
+}
+
+func Test_Impersonate_token_rejected_if_impersonator_closed(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	at := impersonate(t, svr, engine, db, 2)
+	db.User.UpdateOneID(1).SetStatus(user.StatusDisabled).
+		ExecX(context.Background())
+	tk, err := svr.jwtTokenFromString(at)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkAccessToken(), errInvalidToken)
+}
+
+func Test_Impersonate_token_rejected_if_impersonator_tokens_revoked(
+	t *testing.T,
+) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	at := impersonate(t, svr, engine, db, 2)
+	db.User.UpdateOneID(1).SetTokensValidAfter(time.Now().Add(2 * time.Second)).
+		ExecX(context.Background())
+	tk, err := svr.jwtTokenFromString(at)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkAccessToken(), errInvalidToken)
+	// the target's own tokens are unaffected
+	own, err := svr.issueAccessToken(getUserById(t, db, 2))
+	require.Nil(t, err)
+	tk, err = svr.jwtTokenFromString(own)
+	require.Nil(t, err)
+	require.Nil(t, tk.checkAccessToken())
+}
+
+func Test_Impersonate_reports_403_if_target_holds_root_role(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedGroup(t, db, []uint64{3}, []uint32{api.RootRoleId})
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/user/3/impersonate", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.False(
+		t, db.AuditLog.Query().
+			Where(auditlog.ActionEQ(api.AuditUserImpersonated)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_Impersonate_reports_403_if_already_impersonating(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:Impersonate")
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := svr.post("/user/3/impersonate", nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_Impersonate_reports_404_if_user_not_exists(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/user/12345/impersonate", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_Impersonate_reports_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:Impersonate")
+	req, err := svr.postAs(u, "/user/2/impersonate", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_Impersonate_reports_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/user/%d/impersonate", a.UserID), nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_Impersonate_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/user/2/impersonate", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_Impersonate_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.postAs(u, "/user/3/impersonate", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_Impersonate_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/user/2/impersonate", nil)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/http"
+
+	"github.com/eidng8/go-utils"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// IssueScimToken issues the bearer token of the organization's SCIM client,
+// replacing the previous one. The token is only returned by this endpoint.
+// Users other than platform administrators can only issue the token of their
+// own organization.
+//
+// Endpoint: POST /organization/{id}/scim-token
+func (s Server) IssueScimToken(
+	ctx context.Context, request IssueScimTokenRequestObject,
+) (IssueScimTokenResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("IssueScimToken error: %v", err)
+		return nil, err
+	}
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("IssueScimToken error: %v", err)
+		return nil, err
+	}
+	notFound := IssueScimToken404JSONResponse{
+		N404JSONResponse: N404JSONResponse{
+			Code:   http.StatusNotFound,
+			Status: msgError,
+			Errors: &msgNotFound,
+		},
+	}
+	if nil != tenant && *tenant != request.Id {
+		return notFound, nil
+	}
+	secret, err := utils.RandomAlphaNum(48)
+	if err != nil {
+		api.Log.Debugf("IssueScimToken error: %v", err)
+		return nil, err
+	}
+	hash := sha256.Sum256([]byte(secret))
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			err := tx.Organization.UpdateOneID(request.Id).
+				SetScimToken(hash[:]).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditScimTokenIssued, request.Id, token, nil,
+			)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return notFound, nil
+		}
+		api.Log.Debugf("IssueScimToken error: %v", err)
+		return nil, err
+	}
+	return IssueScimToken200JSONResponse{Token: secret}, nil
+}
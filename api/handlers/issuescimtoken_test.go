@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Issues the SCIM token of the organization as root.
+func issueScimToken(
+	t *testing.T, svr *Server, engine *gin.Engine, db *ent.Client, id uint32,
+) string {
+	res := httptest.NewRecorder()
+	req, err := svr.postAs(
+		getUserById(t, db, 1), fmt.Sprintf("/organization/%d/scim-token", id),
+		nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, IssueScimToken200JSONResponse{}, res)
+	require.NotEmpty(t, actual.Token)
+	return actual.Token
+}
+
+// Builds a SCIM request authenticated with the given token.
+func (s Server) scimRequestWithToken(
+	token, method, url, fixture string,
+) (*http.Request, error) {
+	req, err := s.scimRequest(method, url, fixture)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req, nil
+}
+
+func Test_IssueScimToken_confines_scim_to_the_organization(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	o, u, r := seedOrganization(t, db)
+	token := issueScimToken(t, svr, engine, db, o.ID)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditScimTokenIssued)).OnlyX(qc)
+	require.Equal(t, o.ID, log.OrganizationID)
+	require.Equal(t, uint64(1), *log.ActorID)
+
+	res := httptest.NewRecorder()
+	req, err := svr.scimRequestWithToken(
+		token, http.MethodGet, "/scim/v2/Users", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	users := unmarshalResponse(
+		t, ScimListUsers200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, 1, users.TotalResults)
+	require.Equal(t, strconv.FormatUint(u.ID, 10), *users.Resources[0].Id)
+
+	res = httptest.NewRecorder()
+	req, err = svr.scimRequestWithToken(
+		token, http.MethodGet, "/scim/v2/Groups", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	groups := unmarshalResponse(
+		t, ScimListGroups200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, 1, groups.TotalResults)
+	require.Equal(
+		t, strconv.FormatUint(uint64(r.ID), 10), *groups.Resources[0].Id,
+	)
+
+	for _, url := range []string{"/scim/v2/Users/2", "/scim/v2/Groups/2"} {
+		res = httptest.NewRecorder()
+		req, err = svr.scimRequestWithToken(token, http.MethodGet, url, "")
+		require.Nil(t, err)
+		engine.ServeHTTP(res, req)
+		require.Equal(t, http.StatusNotFound, res.Code)
+	}
+
+	res = httptest.NewRecorder()
+	req, err = svr.scimRequestWithToken(
+		token, http.MethodDelete, "/scim/v2/Users/2", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Nil(t, getUserById(t, db, 2).DeletedAt)
+
+	res = httptest.NewRecorder()
+	req, err = svr.scimRequestWithToken(
+		token, http.MethodPost, "/scim/v2/Users", "okta_create_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	created := db.User.Query().
+		Where(user.UsernameEQ("okta.user@example.com")).OnlyX(qc)
+	require.Equal(t, o.ID, created.OrganizationID)
+}
+
+func Test_IssueScimToken_hides_users_of_other_organizations_from_groups(
+	t *testing.T,
+) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o, _, _ := seedOrganization(t, db)
+	token := issueScimToken(t, svr, engine, db, o.ID)
+	req, err := svr.scimRequestWithToken(
+		token, http.MethodPost, "/scim/v2/Groups", "okta_create_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Contains(t, res.Body.String(), "members contain unknown users")
+}
+
+func Test_IssueScimToken_replaces_previous_token(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	o, _, _ := seedOrganization(t, db)
+	previous := issueScimToken(t, svr, engine, db, o.ID)
+	token := issueScimToken(t, svr, engine, db, o.ID)
+	require.NotEqual(t, previous, token)
+	for expected, tk := range map[int]string{
+		http.StatusUnauthorized: previous, http.StatusOK: token,
+	} {
+		res := httptest.NewRecorder()
+		req, err := svr.scimRequestWithToken(
+			tk, http.MethodGet, "/scim/v2/Users", "",
+		)
+		require.Nil(t, err)
+		engine.ServeHTTP(res, req)
+		require.Equal(t, expected, res.Code)
+	}
+}
+
+func Test_IssueScimToken_reports_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:IssueScimToken")
+	req, err := svr.postAs(u, "/organization/1/scim-token", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.False(
+		t, db.Organization.Query().
+			Where(organization.ScimTokenNotNil()).ExistX(context.Background()),
+	)
+}
+
+func Test_IssueScimToken_issues_token_of_own_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o, u, _ := seedOrganization(t, db, "auth:IssueScimToken")
+	req, err := svr.postAs(
+		u, fmt.Sprintf("/organization/%d/scim-token", o.ID), nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_IssueScimToken_reports_404_if_not_exists(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/organization/12345/scim-token", nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_IssueScimToken_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/organization/1/scim-token", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_IssueScimToken_returns_403_if_user_without_permission(
+	t *testing.T,
+) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 2), "/organization/1/scim-token", nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_IssueScimToken_returns_500_if_invalid_context(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	_, err := svr.IssueScimToken(
+		context.Background(), IssueScimTokenRequestObject{Id: 1},
+	)
+	require.ErrorIs(t, err, errInvalidContext)
+}
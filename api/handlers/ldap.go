@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// LdapAuthenticator authenticates users against an LDAP directory, such as
+// Active Directory. The user entry is found by a search bound as the service
+// account, or anonymously if BindDn is empty, then the password is verified by
+// binding as the user entry.
+type LdapAuthenticator struct {
+	// URL of the directory, such as `ldaps://ldap.example.com`.
+	Url string
+	// Organization the users of the directory belong to, the default
+	// organization if zero.
+	Organization uint32
+	// Upgrades `ldap://` connections with StartTLS.
+	StartTls bool
+	// TLS settings of `ldaps://` and StartTLS connections, optional.
+	TlsConfig *tls.Config
+	// Service account searching for users.
+	BindDn       string
+	BindPassword string
+	// Base DN of the user search.
+	BaseDn string
+	// Search filter, where `{username}` is replaced by the login identifier.
+	UserFilter string
+	// Attributes holding the username, email and groups of users.
+	UsernameAttribute string
+	EmailAttribute    string
+	GroupAttribute    string
+	// Maps directory attributes to user attributes. Only integer values are
+	// mapped, as user attributes are numbers.
+	Attributes map[string]string
+	// Timeout of connecting and of each request.
+	Timeout time.Duration
+}
+
+var _ Authenticator = LdapAuthenticator{}
+
+// NewLdapAuthenticator returns the authenticator of the directory at `url`
+// with OpenLDAP defaults: users are searched by `uid` below `baseDn`, and
+// their groups are read from `memberOf`.
+func NewLdapAuthenticator(url, baseDn string) LdapAuthenticator {
+	return LdapAuthenticator{
+		Url:               url,
+		BaseDn:            baseDn,
+		UserFilter:        "(uid={username})",
+		UsernameAttribute: "uid",
+		EmailAttribute:    "mail",
+		GroupAttribute:    "memberOf",
+		Timeout:           10 * time.Second,
+	}
+}
+
+func (a LdapAuthenticator) Name() string {
+	return "ldap"
+}
+
+func (a LdapAuthenticator) Tenant() uint32 {
+	if 0 == a.Organization {
+		return api.DefaultOrganizationId
+	}
+	return a.Organization
+}
+
+func (a LdapAuthenticator) Authenticate(
+	_ context.Context, identifier, password string,
+) (*Identity, error) {
+	// binding without password is an anonymous bind, which always succeeds
+	if "" == password {
+		return nil, errInvalidCredential
+	}
+	conn, err := a.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if "" != a.BindDn {
+		if err = conn.Bind(a.BindDn, a.BindPassword); err != nil {
+			return nil, err
+		}
+	}
+	entry, err := a.search(conn, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if err = conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, errInvalidCredential
+		}
+		return nil, err
+	}
+	id := &Identity{
+		Username: entry.GetAttributeValue(a.UsernameAttribute),
+		Email:    entry.GetAttributeValue(a.EmailAttribute),
+		Groups:   entry.GetAttributeValues(a.GroupAttribute),
+	}
+	if "" == id.Username {
+		id.Username = identifier
+	}
+	for from, to := range a.Attributes {
+		n, err := strconv.ParseInt(entry.GetAttributeValue(from), 10, 64)
+		if err != nil {
+			continue
+		}
+		if nil == id.Attr {
+			id.Attr = map[string]interface{}{}
+		}
+		id.Attr[to] = float64(n)
+	}
+	return id, nil
+}
+
+func (a LdapAuthenticator) dial() (*ldap.Conn, error) {
+	u, err := url.Parse(a.Url)
+	if err != nil {
+		return nil, err
+	}
+	config := a.TlsConfig
+	if nil == config {
+		config = &tls.Config{ServerName: u.Hostname()}
+	}
+	conn, err := ldap.DialURL(
+		a.Url, ldap.DialWithDialer(&net.Dialer{Timeout: a.Timeout}),
+		ldap.DialWithTLSConfig(config),
+	)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(a.Timeout)
+	if a.StartTls {
+		if err = conn.StartTLS(config); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// Returns the only entry matching the identifier, errUnknownIdentity if none
+// or several match.
+func (a LdapAuthenticator) search(
+	conn *ldap.Conn, identifier string,
+) (*ldap.Entry, error) {
+	attributes := []string{
+		a.UsernameAttribute, a.EmailAttribute, a.GroupAttribute,
+	}
+	for from := range a.Attributes {
+		attributes = append(attributes, from)
+	}
+	res, err := conn.Search(
+		ldap.NewSearchRequest(
+			a.BaseDn, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2,
+			int(a.Timeout.Seconds()), false,
+			strings.ReplaceAll(
+				a.UserFilter, "{username}", ldap.EscapeFilter(identifier),
+			),
+			attributes, nil,
+		),
+	)
+	if err != nil &&
+		!ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, err
+	}
+	if 1 != len(res.Entries) {
+		return nil, errUnknownIdentity
+	}
+	return res.Entries[0], nil
+}
@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jimlambrt/gldap"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLdapBaseDn       = "dc=example,dc=com"
+	testLdapBindDn       = "cn=admin,dc=example,dc=com"
+	testLdapBindPassword = "admin secret"
+	testLdapAdminsGroup  = "cn=admins,ou=groups,dc=example,dc=com"
+	testLdapStaffGroup   = "cn=staff,ou=groups,dc=example,dc=com"
+)
+
+var ldapEqualityFilter = regexp.MustCompile(`^\((\w+)=([^()]*)\)$`)
+
+// In-process LDAP directory. Only the service account can search, and the
+// passwords of entries are kept in their `userPassword` attribute. Filters
+// are limited to a single equality.
+type testDirectory struct {
+	mu      sync.Mutex
+	port    int
+	entries []*gldap.Entry
+	// connections bound as the service account
+	bound map[int]bool
+}
+
+// Starts a directory serving the entries, stopped when the test ends.
+func newTestDirectory(tb testing.TB, entries ...*gldap.Entry) *testDirectory {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(tb, err)
+	d := &testDirectory{
+		port:    l.Addr().(*net.TCPAddr).Port,
+		entries: entries,
+		bound:   map[int]bool{},
+	}
+	require.Nil(tb, l.Close())
+	svr, err := gldap.NewServer()
+	require.Nil(tb, err)
+	mux, err := gldap.NewMux()
+	require.Nil(tb, err)
+	require.Nil(tb, mux.Bind(d.bind))
+	require.Nil(tb, mux.Search(d.search))
+	require.Nil(tb, svr.Router(mux))
+	go func() { _ = svr.Run(fmt.Sprintf("127.0.0.1:%d", d.port)) }()
+	tb.Cleanup(func() { _ = svr.Stop() })
+	require.Eventually(tb, svr.Ready, time.Second, 10*time.Millisecond)
+	return d
+}
+
+// Returns the directory entry of a user, in the given groups.
+func newTestLdapUser(
+	username, password string, dept string, groups ...string,
+) *gldap.Entry {
+	return gldap.NewEntry(
+		fmt.Sprintf("uid=%s,ou=people,%s", username, testLdapBaseDn),
+		map[string][]string{
+			"uid":              {username},
+			"mail":             {username + "@example.com"},
+			"departmentNumber": {dept},
+			"memberOf":         groups,
+			"userPassword":     {password},
+		},
+	)
+}
+
+// Returns the authenticator of the directory, bound as the service account.
+func (d *testDirectory) authenticator() LdapAuthenticator {
+	a := NewLdapAuthenticator(
+		fmt.Sprintf("ldap://127.0.0.1:%d", d.port), testLdapBaseDn,
+	)
+	a.BindDn = testLdapBindDn
+	a.BindPassword = testLdapBindPassword
+	a.Attributes = map[string]string{"departmentNumber": "dept"}
+	a.Timeout = time.Second
+	return a
+}
+
+// Replaces the entries of the directory.
+func (d *testDirectory) setEntries(entries ...*gldap.Entry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = entries
+}
+
+func (d *testDirectory) bind(w *gldap.ResponseWriter, r *gldap.Request) {
+	res := r.NewBindResponse(
+		gldap.WithResponseCode(gldap.ResultInvalidCredentials),
+	)
+	defer func() { _ = w.Write(res) }()
+	m, err := r.GetSimpleBindMessage()
+	if err != nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.bound[r.ConnectionID()] = false
+	if testLdapBindDn == m.UserName &&
+		testLdapBindPassword == string(m.Password) {
+		d.bound[r.ConnectionID()] = true
+		res.SetResultCode(gldap.ResultSuccess)
+		return
+	}
+	for _, e := range d.entries {
+		if e.DN == m.UserName && "" != m.Password &&
+			e.GetAttributeValues("userPassword")[0] == string(m.Password) {
+			res.SetResultCode(gldap.ResultSuccess)
+			return
+		}
+	}
+}
+
+func (d *testDirectory) search(w *gldap.ResponseWriter, r *gldap.Request) {
+	res := r.NewSearchDoneResponse(
+		gldap.WithResponseCode(gldap.ResultSuccess),
+	)
+	defer func() { _ = w.Write(res) }()
+	m, err := r.GetSearchMessage()
+	if err != nil {
+		res.SetResultCode(gldap.ResultProtocolError)
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.bound[r.ConnectionID()] {
+		res.SetResultCode(gldap.ResultInsufficientAccessRights)
+		return
+	}
+	f := ldapEqualityFilter.FindStringSubmatch(m.Filter)
+	if nil == f {
+		res.SetResultCode(gldap.ResultUnwillingToPerform)
+		return
+	}
+	var found int64
+	for _, e := range d.entries {
+		if !strings.HasSuffix(e.DN, ","+m.BaseDN) ||
+			!containsFold(e.GetAttributeValues(f[1]), f[2]) {
+			continue
+		}
+		if m.SizeLimit > 0 && found == m.SizeLimit {
+			res.SetResultCode(gldap.ResultSizeLimitExceeded)
+			return
+		}
+		found++
+		entry := r.NewSearchResponseEntry(e.DN)
+		for _, a := range m.Attributes {
+			if values := e.GetAttributeValues(a); len(values) > 0 {
+				entry.AddAttribute(a, values)
+			}
+		}
+		if err = w.Write(entry); err != nil {
+			return
+		}
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func Test_LdapAuthenticator_returns_identity(t *testing.T) {
+	d := newTestDirectory(
+		t, newTestLdapUser(
+			"alice", "Alice_123", "7", testLdapAdminsGroup,
+			testLdapStaffGroup,
+		),
+	)
+	id, err := d.authenticator().
+		Authenticate(context.Background(), "ALICE", "Alice_123")
+	require.Nil(t, err)
+	require.Equal(
+		t, &Identity{
+			Username: "alice",
+			Email:    "alice@example.com",
+			Groups:   []string{testLdapAdminsGroup, testLdapStaffGroup},
+			Attr:     map[string]interface{}{"dept": float64(7)},
+		}, id,
+	)
+}
+
+func Test_LdapAuthenticator_skips_non_integer_attributes(t *testing.T) {
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "sales"))
+	id, err := d.authenticator().
+		Authenticate(context.Background(), "alice", "Alice_123")
+	require.Nil(t, err)
+	require.Nil(t, id.Attr)
+	require.Empty(t, id.Groups)
+}
+
+func Test_LdapAuthenticator_rejects_wrong_password(t *testing.T) {
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	_, err := d.authenticator().
+		Authenticate(context.Background(), "alice", "Alice_456")
+	require.ErrorIs(t, err, errInvalidCredential)
+}
+
+func Test_LdapAuthenticator_rejects_empty_password(t *testing.T) {
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	_, err := d.authenticator().
+		Authenticate(context.Background(), "alice", "")
+	require.ErrorIs(t, err, errInvalidCredential)
+}
+
+func Test_LdapAuthenticator_reports_unknown_user(t *testing.T) {
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	_, err := d.authenticator().
+		Authenticate(context.Background(), "bob", "Alice_123")
+	require.ErrorIs(t, err, errUnknownIdentity)
+}
+
+func Test_LdapAuthenticator_escapes_identifier(t *testing.T) {
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	a := d.authenticator()
+	a.UserFilter = "(mail={username})"
+	_, err := a.Authenticate(context.Background(), "*", "Alice_123")
+	require.ErrorIs(t, err, errUnknownIdentity)
+	id, err := a.Authenticate(
+		context.Background(), "alice@example.com", "Alice_123",
+	)
+	require.Nil(t, err)
+	require.Equal(t, "alice", id.Username)
+}
+
+func Test_LdapAuthenticator_treats_ambiguous_user_as_unknown(t *testing.T) {
+	alice := newTestLdapUser("alice", "Alice_123", "7")
+	other := newTestLdapUser("alice", "Alice_123", "7")
+	other.DN = "uid=alice,ou=contractors," + testLdapBaseDn
+	d := newTestDirectory(t, alice, other)
+	_, err := d.authenticator().
+		Authenticate(context.Background(), "alice", "Alice_123")
+	require.ErrorIs(t, err, errUnknownIdentity)
+}
+
+func Test_LdapAuthenticator_returns_error_if_service_bind_fails(t *testing.T) {
+	d := newTestDirectory(t, newTestLdapUser("alice", "Alice_123", "7"))
+	a := d.authenticator()
+	a.BindPassword = "wrong"
+	_, err := a.Authenticate(context.Background(), "alice", "Alice_123")
+	require.NotNil(t, err)
+	require.NotErrorIs(t, err, errInvalidCredential)
+	require.NotErrorIs(t, err, errUnknownIdentity)
+}
+
+func Test_LdapAuthenticator_returns_error_if_unreachable(t *testing.T) {
+	d := newTestDirectory(t)
+	a := d.authenticator()
+	a.Url = "ldap://127.0.0.1:1"
+	_, err := a.Authenticate(context.Background(), "alice", "Alice_123")
+	require.NotNil(t, err)
+}
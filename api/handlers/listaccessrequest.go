@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+)
+
+type ListAccessRequestPaginateResponse struct {
+	*paginate.PaginatedList[ent.AccessRequest]
+}
+
+func (response ListAccessRequestPaginateResponse) VisitListAccessRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListAccessRequest lists access requests, newest first. Reviewed requests
+// are kept, so this is also the history of access requests.
+//
+// Endpoint: GET /access-requests
+func (s Server) ListAccessRequest(
+	ctx context.Context, request ListAccessRequestRequestObject,
+) (ListAccessRequestResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.accessRequestScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListAccessRequest error: %v", err)
+		return nil, err
+	}
+	query := s.db.AccessRequest.Query().Where(scope).
+		Order(accessrequest.ByID(sql.OrderDesc()))
+	if request.Params.Status != nil {
+		query.Where(
+			accessrequest.StatusEQ(
+				accessrequest.Status(*request.Params.Status),
+			),
+		)
+	}
+	if request.Params.RoleId != nil {
+		query.Where(accessrequest.RoleIDEQ(*request.Params.RoleId))
+	}
+	if request.Params.RequesterId != nil {
+		query.Where(accessrequest.RequesterIDEQ(*request.Params.RequesterId))
+	}
+	paginator := paginate.Paginator[ent.AccessRequest, ent.AccessRequestQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListAccessRequest error: %v", err)
+		return nil, err
+	}
+	return ListAccessRequestPaginateResponse{PaginatedList: page}, nil
+}
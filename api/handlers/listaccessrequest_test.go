@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+)
+
+func Test_ListAccessRequest_returns_requests_newest_first(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a := seedAccessRequest(t, db, 3, 5)
+	b := seedAccessRequest(t, db, 4, 6)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/access-requests")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListAccessRequestPaginateResponse{}, res)
+	require.Equal(t, 2, actual.Total)
+	require.Equal(t, b.ID, actual.Data[0].ID)
+	require.Equal(t, a.ID, actual.Data[1].ID)
+}
+
+func Test_ListAccessRequest_filters_by_status(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a := seedAccessRequest(t, db, 3, 5)
+	b := seedAccessRequest(t, db, 4, 6)
+	db.AccessRequest.UpdateOne(b).SetStatus(accessrequest.StatusDenied).
+		ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/access-requests?status=pending")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListAccessRequestPaginateResponse{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, a.ID, actual.Data[0].ID)
+}
+
+func Test_ListAccessRequest_filters_by_role_and_requester(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a := seedAccessRequest(t, db, 3, 5)
+	seedAccessRequest(t, db, 3, 6)
+	seedAccessRequest(t, db, 4, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/access-requests?role_id=5&requester_id=3")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListAccessRequestPaginateResponse{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, a.ID, actual.Data[0].ID)
+}
+
+func Test_ListAccessRequest_reports_422_if_invalid_status(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/access-requests?status=bogus")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_ListAccessRequest_returns_requests_of_own_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedAccessRequest(t, db, 3, 5)
+	_, u, _ := seedOrganization(t, db, "auth:ListAccessRequest")
+	req, err := svr.getAs(u, "/access-requests")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListAccessRequestPaginateResponse{}, res)
+	require.Equal(t, 0, actual.Total)
+}
+
+func Test_ListAccessRequest_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/access-requests")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListAccessRequest_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(u, "/access-requests")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListAccessRequest_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/access-requests")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
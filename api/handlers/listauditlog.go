@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+)
+
+type ListAuditLogPaginateResponse struct {
+	*paginate.PaginatedList[ent.AuditLog]
+}
+
+func (response ListAuditLogPaginateResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListAuditLog lists audit log entries, newest first. Users other than
+// platform administrators only see entries of their own organization.
+//
+// Endpoint: GET /audit-logs
+func (s Server) ListAuditLog(
+	ctx context.Context, request ListAuditLogRequestObject,
+) (ListAuditLogResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ListAuditLog error: %v", err)
+		return nil, err
+	}
+	query := s.db.AuditLog.Query().Order(auditlog.ByID(sql.OrderDesc()))
+	if nil != tenant {
+		query = query.Where(auditlog.OrganizationIDEQ(*tenant))
+	}
+	if nil != request.Params.Action && "" != *request.Params.Action {
+		query = query.Where(auditlog.ActionEQ(*request.Params.Action))
+	}
+	paginator := paginate.Paginator[ent.AuditLog, ent.AuditLogQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListAuditLog error: %v", err)
+		return nil, err
+	}
+	return ListAuditLogPaginateResponse{PaginatedList: page}, nil
+}
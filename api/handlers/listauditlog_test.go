@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+)
+
+func Test_ListAuditLog_returns_latest_first(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	for range 3 {
+		db.AuditLog.Create().SetAction(api.AuditRoleAssignmentExpired).
+			ExecX(qc)
+	}
+	expected := ListAuditLogPaginateResponse{
+		PaginatedList: &paginate.PaginatedList[ent.AuditLog]{
+			Total:        3,
+			PerPage:      2,
+			CurrentPage:  1,
+			LastPage:     2,
+			FirstPageUrl: svr.baseUrl + "/audit-logs?page=1&per_page=2",
+			LastPageUrl:  svr.baseUrl + "/audit-logs?page=2&per_page=2",
+			NextPageUrl:  svr.baseUrl + "/audit-logs?page=2&per_page=2",
+			PrevPageUrl:  "",
+			Path:         svr.baseUrl + "/audit-logs",
+			From:         1,
+			To:           2,
+			Data: db.AuditLog.Query().Order(auditlog.ByID(sql.OrderDesc())).
+				Limit(2).AllX(qc),
+		},
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/audit-logs?per_page=2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_ListAuditLog_filters_by_action(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	db.AuditLog.Create().SetAction(api.AuditRoleAssignmentExpired).ExecX(qc)
+	db.AuditLog.Create().SetAction("other.action").ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/audit-logs?action=other.action")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, paginate.PaginatedList[ent.AuditLog]{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, "other.action", actual.Data[0].Action)
+}
+
+func Test_ListAuditLog_lists_only_own_tenant(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	o, u, _ := seedOrganization(t, db, "auth:ListAuditLog")
+	db.AuditLog.Create().SetAction(api.AuditRoleAssignmentExpired).ExecX(qc)
+	db.AuditLog.Create().SetAction(api.AuditRoleAssignmentExpired).
+		SetOrganizationID(o.ID).ExecX(qc)
+	req, err := svr.getAs(u, "/audit-logs")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, paginate.PaginatedList[ent.AuditLog]{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, o.ID, actual.Data[0].OrganizationID)
+}
+
+func Test_ListAuditLog_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/audit-logs")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListAuditLog_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 3)
+	req, err := svr.getAs(u, "/audit-logs")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListAuditLog_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/audit-logs")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+)
+
+type ListGroupPaginateResponse struct {
+	*paginate.PaginatedList[ent.Group]
+}
+
+func (response ListGroupPaginateResponse) VisitListGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListGroup lists groups.
+//
+// Endpoint: GET /groups
+func (s Server) ListGroup(
+	ctx context.Context, request ListGroupRequestObject,
+) (ListGroupResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListGroup error: %v", err)
+		return nil, err
+	}
+	query := s.db.Group.Query().Where(scope).Order(group.ByID())
+	if request.Params.Name != nil {
+		query = query.Where(group.NameHasPrefix(*request.Params.Name))
+	}
+	paginator := paginate.Paginator[ent.Group, ent.GroupQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListGroup error: %v", err)
+		return nil, err
+	}
+	return ListGroupPaginateResponse{PaginatedList: page}, nil
+}
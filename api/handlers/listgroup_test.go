@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListGroup_returns_groups(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	db.Group.Create().SetName("other").SaveX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/groups")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListGroupPaginateResponse{}, res)
+	require.Equal(t, 2, actual.Total)
+	require.Equal(t, g.ID, actual.Data[0].ID)
+}
+
+func Test_ListGroup_filters_by_name(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	db.Group.Create().SetName("other").SaveX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/groups?name=gr")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListGroupPaginateResponse{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, g.ID, actual.Data[0].ID)
+}
+
+func Test_ListGroup_returns_groups_of_own_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedGroup(t, db, nil, nil)
+	_, u, _ := seedOrganization(t, db, "auth:ListGroup")
+	req, err := svr.getAs(u, "/groups")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListGroupPaginateResponse{}, res)
+	require.Equal(t, 0, actual.Total)
+}
+
+func Test_ListGroup_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/groups")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListGroup_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(u, "/groups")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListGroup_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/groups")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+)
+
+type ListGroupRolesPaginateResponse struct {
+	*paginate.PaginatedList[ent.Role]
+}
+
+func (response ListGroupRolesPaginateResponse) VisitListGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListGroupRoles lists roles of a group
+//
+// Endpoint: GET /group/{id}/roles
+func (s Server) ListGroupRoles(
+	ctx context.Context, request ListGroupRolesRequestObject,
+) (ListGroupRolesResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListGroupRoles error: %v", err)
+		return nil, err
+	}
+	query := s.db.Group.Query().Where(group.IDEQ(request.Id), scope).
+		QueryRoles().Order(role.ByID())
+	if request.Params.Name != nil {
+		query.Where(role.NameHasPrefix(*request.Params.Name))
+	}
+	paginator := paginate.Paginator[ent.Role, ent.RoleQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListGroupRoles error: %v", err)
+		return nil, err
+	}
+	return ListGroupRolesPaginateResponse{PaginatedList: page}, nil
+}
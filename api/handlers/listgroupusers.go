@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+type ListGroupUsersPaginateResponse struct {
+	*paginate.PaginatedList[ent.User]
+}
+
+func (response ListGroupUsersPaginateResponse) VisitListGroupUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListGroupUsers lists members of a group
+//
+// Endpoint: GET /group/{id}/users
+func (s Server) ListGroupUsers(
+	ctx context.Context, request ListGroupUsersRequestObject,
+) (ListGroupUsersResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListGroupUsers error: %v", err)
+		return nil, err
+	}
+	query := s.db.Group.Query().Where(group.IDEQ(request.Id), scope).
+		QueryUsers().Order(user.ByID())
+	if request.Params.Name != nil {
+		query.Where(
+			user.Or(
+				user.UsernameHasPrefix(*request.Params.Name),
+				user.EmailHasPrefix(*request.Params.Name),
+			),
+		)
+	}
+	paginator := paginate.Paginator[ent.User, ent.UserQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListGroupUsers error: %v", err)
+		return nil, err
+	}
+	return ListGroupUsersPaginateResponse{PaginatedList: page}, nil
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListGroupUsers_returns_members(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, []uint64{3, 4}, nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, fmt.Sprintf("/group/%d/users", g.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListGroupUsersPaginateResponse{}, res)
+	require.Equal(t, 2, actual.Total)
+	require.Equal(t, uint64(3), actual.Data[0].ID)
+	require.Equal(t, uint64(4), actual.Data[1].ID)
+}
+
+func Test_ListGroupUsers_filters_by_name(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, []uint64{3, 4}, nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, fmt.Sprintf("/group/%d/users?name=user2", g.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListGroupUsersPaginateResponse{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, uint64(4), actual.Data[0].ID)
+}
+
+func Test_ListGroupUsers_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/group/1/users")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListGroupUsers_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(u, "/group/1/users")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListGroupUsers_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/group/1/users")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
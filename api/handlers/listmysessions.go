@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/session"
+)
+
+// ListMySessions lists the sessions of the current user that can still be
+// refreshed, most recently active first.
+//
+// Endpoint: GET /me/sessions
+func (s Server) ListMySessions(
+	ctx context.Context, _ ListMySessionsRequestObject,
+) (ListMySessionsResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("ListMySessions error: %v", err)
+		return nil, err
+	}
+	// personal tokens don't belong to any session
+	jti, _ := token.getJtiBinary()
+	rows, err := s.db.Session.Query().Where(
+		session.UserIDEQ(token.user.ID), session.ExpiresAtGT(time.Now()),
+	).All(context.Background())
+	if err != nil {
+		api.Log.Debugf("ListMySessions error: %v", err)
+		return nil, err
+	}
+	slices.SortFunc(
+		rows, func(a, b *ent.Session) int {
+			return lastActive(b).Compare(lastActive(a))
+		},
+	)
+	res := make(ListMySessions200JSONResponse, len(rows))
+	for i, r := range rows {
+		res[i] = Session{
+			Id:          r.ID,
+			UserId:      r.UserID,
+			UserAgent:   r.UserAgent,
+			Ip:          r.IP,
+			CreatedAt:   r.CreatedAt,
+			RefreshedAt: r.RefreshedAt,
+			Current:     nil != jti && bytes.Equal(jti, r.AccessToken),
+		}
+	}
+	return res, nil
+}
+
+// Returns the last time the session was signed in or refreshed.
+func lastActive(s *ent.Session) time.Time {
+	if nil != s.RefreshedAt {
+		return *s.RefreshedAt
+	}
+	return s.CreatedAt
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/session"
+)
+
+func Test_ListMySessions_lists_sessions_of_current_user(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	u := getUserById(t, db, 1)
+	startTestSession(t, svr, u, "old agent")
+	at, rt := startTestSession(t, svr, u, "current agent")
+	expired, _ := startTestSession(t, svr, u, "expired agent")
+	startTestSession(t, svr, getUserById(t, db, 2), "other agent")
+	tk := &jwtToken{svr: svr}
+	require.Nil(t, tk.parse(expired.Value))
+	jti, err := tk.getJtiBinary()
+	require.Nil(t, err)
+	db.Session.Update().Where(session.AccessTokenEQ(jti)).
+		SetExpiresAt(time.Now().Add(-time.Minute)).ExecX(qc)
+	// the old session was refreshed after the current one started
+	req, err := http.NewRequest(http.MethodPost, "/access-token/refresh", nil)
+	require.Nil(t, err)
+	req.Header.Set("User-Agent", "current agent")
+	req.RemoteAddr = "198.51.100.1:1234"
+	res := serveWithCookies(engine, req, rt)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	at, _ = getTokensFromSetCookieHeaders(t, res)
+	req, err = http.NewRequest(http.MethodGet, "/me/sessions", nil)
+	require.Nil(t, err)
+	res = serveWithCookies(engine, req, at)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListMySessions200JSONResponse{}, res)
+	require.Len(t, actual, 2)
+	require.Equal(t, "current agent", actual[0].UserAgent)
+	require.True(t, actual[0].Current)
+	require.NotNil(t, actual[0].RefreshedAt)
+	require.Equal(t, "198.51.100.1", actual[0].Ip)
+	require.Equal(t, "old agent", actual[1].UserAgent)
+	require.False(t, actual[1].Current)
+	require.Nil(t, actual[1].RefreshedAt)
+}
+
+func Test_ListMySessions_marks_no_session_current_for_bearer_token(
+	t *testing.T,
+) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	startTestSession(t, svr, u, "test agent")
+	at, err := svr.issueAccessToken(u)
+	require.Nil(t, err)
+	req, err := svr.get("/me/sessions")
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+at)
+	res := serveWithCookies(engine, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListMySessions200JSONResponse{}, res)
+	require.Len(t, actual, 1)
+	require.False(t, actual[0].Current)
+}
+
+func Test_ListMySessions_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/me/sessions")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListMySessions_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.getAs(getUserById(t, db, 3), "/me/sessions")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+)
+
+type ListOauthClientPaginateResponse struct {
+	*paginate.PaginatedList[ent.OauthClient]
+}
+
+func (response ListOauthClientPaginateResponse) VisitListOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListOauthClient lists OAuth clients of the request's organization.
+//
+// Endpoint: GET /oauth-clients
+func (s Server) ListOauthClient(
+	ctx context.Context, _ ListOauthClientRequestObject,
+) (ListOauthClientResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.oauthClientScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListOauthClient error: %v", err)
+		return nil, err
+	}
+	query := s.db.OauthClient.Query().Where(scope).Order(oauthclient.ByID())
+	paginator := paginate.Paginator[ent.OauthClient, ent.OauthClientQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListOauthClient error: %v", err)
+		return nil, err
+	}
+	return ListOauthClientPaginateResponse{PaginatedList: page}, nil
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+)
+
+func Test_ListOauthClient_returns_client_list(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	seedOauthClient(t, db, true, nil, api.OauthGrantAuthorizationCode)
+	expected := ListOauthClientPaginateResponse{
+		PaginatedList: &paginate.PaginatedList[ent.OauthClient]{
+			Total:        2,
+			PerPage:      1,
+			CurrentPage:  1,
+			LastPage:     2,
+			FirstPageUrl: svr.baseUrl + "/oauth-clients?page=1&per_page=1",
+			LastPageUrl:  svr.baseUrl + "/oauth-clients?page=2&per_page=1",
+			NextPageUrl:  svr.baseUrl + "/oauth-clients?page=2&per_page=1",
+			PrevPageUrl:  "",
+			Path:         svr.baseUrl + "/oauth-clients",
+			From:         1,
+			To:           1,
+			Data: db.OauthClient.Query().Where(oauthclient.IDEQ(c.ID)).
+				AllX(context.Background()),
+		},
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/oauth-clients?per_page=1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.NotContains(t, res.Body.String(), *c.Secret)
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_ListOauthClient_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 3)
+	req, err := svr.getAs(u, "/oauth-clients")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListOauthClient_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/oauth-clients")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func Test_ListOauthClient_returns_clients_of_own_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	_, u, _ := seedOrganization(t, db, "auth:ListOauthClient")
+	req, err := svr.getAs(u, "/oauth-clients")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListOauthClientPaginateResponse{}, res)
+	require.Equal(t, 0, actual.Total)
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+)
+
+type ListOrganizationPaginateResponse struct {
+	*paginate.PaginatedList[ent.Organization]
+}
+
+func (response ListOrganizationPaginateResponse) VisitListOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListOrganization lists organizations. Users other than platform
+// administrators only see their own organization.
+//
+// Endpoint: GET /organizations
+func (s Server) ListOrganization(
+	ctx context.Context, _ ListOrganizationRequestObject,
+) (ListOrganizationResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ListOrganization error: %v", err)
+		return nil, err
+	}
+	query := s.db.Organization.Query().Order(organization.ByID())
+	if nil != tenant {
+		query = query.Where(organization.IDEQ(*tenant))
+	}
+	paginator := paginate.Paginator[ent.Organization, ent.OrganizationQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListOrganization error: %v", err)
+		return nil, err
+	}
+	return ListOrganizationPaginateResponse{PaginatedList: page}, nil
+}
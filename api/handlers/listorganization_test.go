@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+)
+
+func Test_ListOrganization_returns_all_organizations(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedOrganization(t, db)
+	expected := ListOrganizationPaginateResponse{
+		PaginatedList: &paginate.PaginatedList[ent.Organization]{
+			Total:        2,
+			PerPage:      10,
+			CurrentPage:  1,
+			LastPage:     1,
+			FirstPageUrl: svr.baseUrl + "/organizations?page=1&per_page=10",
+			LastPageUrl:  "",
+			NextPageUrl:  "",
+			PrevPageUrl:  "",
+			Path:         svr.baseUrl + "/organizations",
+			From:         1,
+			To:           2,
+			Data: db.Organization.Query().Order(organization.ByID()).
+				AllX(context.Background()),
+		},
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/organizations")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_ListOrganization_returns_own_organization_of_tenant_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o, u, _ := seedOrganization(t, db, "auth:ListOrganization")
+	req, err := svr.getAs(u, "/organizations")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListOrganizationPaginateResponse{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Len(t, actual.Data, 1)
+	require.Equal(t, o.ID, actual.Data[0].ID)
+}
+
+func Test_ListOrganization_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/organizations")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListOrganization_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(u, "/organizations")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListOrganization_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/organizations")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -16,12 +16,12 @@ func Test_ListPermission_returns_permission_list(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	expected := ListPermissionPaginateResponse{
 		PaginatedList: &paginate.PaginatedList[ent.Permission]{
-			Total:        34,
+			Total:        95,
 			PerPage:      10,
 			CurrentPage:  1,
-			LastPage:     4,
+			LastPage:     10,
 			FirstPageUrl: svr.baseUrl + "/permissions?page=1&per_page=10",
-			LastPageUrl:  svr.baseUrl + "/permissions?page=4&per_page=10",
+			LastPageUrl:  svr.baseUrl + "/permissions?page=10&per_page=10",
 			NextPageUrl:  svr.baseUrl + "/permissions?page=2&per_page=10",
 			PrevPageUrl:  "",
 			Path:         svr.baseUrl + "/permissions",
@@ -44,12 +44,12 @@ func Test_ListPermission_returns_permission_list_5_per_page(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	expected := ListPermissionPaginateResponse{
 		PaginatedList: &paginate.PaginatedList[ent.Permission]{
-			Total:        34,
+			Total:        95,
 			PerPage:      5,
 			CurrentPage:  1,
-			LastPage:     7,
+			LastPage:     19,
 			FirstPageUrl: svr.baseUrl + "/permissions?page=1&per_page=5",
-			LastPageUrl:  svr.baseUrl + "/permissions?page=7&per_page=5",
+			LastPageUrl:  svr.baseUrl + "/permissions?page=19&per_page=5",
 			NextPageUrl:  svr.baseUrl + "/permissions?page=2&per_page=5",
 			PrevPageUrl:  "",
 			Path:         svr.baseUrl + "/permissions",
@@ -72,12 +72,12 @@ func Test_ListPermission_returns_2nd_page(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	expected := ListPermissionPaginateResponse{
 		PaginatedList: &paginate.PaginatedList[ent.Permission]{
-			Total:        34,
+			Total:        95,
 			PerPage:      5,
 			CurrentPage:  2,
-			LastPage:     7,
+			LastPage:     19,
 			FirstPageUrl: svr.baseUrl + "/permissions?page=1&per_page=5",
-			LastPageUrl:  svr.baseUrl + "/permissions?page=7&per_page=5",
+			LastPageUrl:  svr.baseUrl + "/permissions?page=19&per_page=5",
 			NextPageUrl:  svr.baseUrl + "/permissions?page=3&per_page=5",
 			PrevPageUrl:  svr.baseUrl + "/permissions?page=1&per_page=5",
 			Path:         svr.baseUrl + "/permissions",
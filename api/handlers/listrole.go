@@ -32,7 +32,12 @@ func (s Server) ListRole(
 	if !ok {
 		return nil, errInvalidContext
 	}
-	query := s.db.Role.Query().Order(role.ByID())
+	scope, err := s.roleScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListRole error: %v", err)
+		return nil, err
+	}
+	query := s.db.Role.Query().Where(scope).Order(role.ByID())
 	if request.Params.Name != nil {
 		query = query.Where(role.NameHasPrefix(*request.Params.Name))
 	}
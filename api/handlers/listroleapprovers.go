@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+type ListRoleApproversPaginateResponse struct {
+	*paginate.PaginatedList[ent.User]
+}
+
+func (response ListRoleApproversPaginateResponse) VisitListRoleApproversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListRoleApprovers lists users who can approve access requests of a role.
+//
+// Endpoint: GET /role/{id}/approvers
+func (s Server) ListRoleApprovers(
+	ctx context.Context, request ListRoleApproversRequestObject,
+) (ListRoleApproversResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.roleScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListRoleApprovers error: %v", err)
+		return nil, err
+	}
+	query := s.db.Role.Query().Where(role.IDEQ(request.Id), scope).
+		QueryApprovers().Order(user.ByID())
+	if request.Params.Name != nil {
+		query.Where(
+			user.Or(
+				user.UsernameHasPrefix(*request.Params.Name),
+				user.EmailHasPrefix(*request.Params.Name),
+			),
+		)
+	}
+	paginator := paginate.Paginator[ent.User, ent.UserQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListRoleApprovers error: %v", err)
+		return nil, err
+	}
+	return ListRoleApproversPaginateResponse{PaginatedList: page}, nil
+}
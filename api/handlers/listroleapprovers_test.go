@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRoleApprovers_returns_approvers(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.Role.UpdateOneID(5).AddApproverIDs(3, 4).ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/role/5/approvers")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListRoleApproversPaginateResponse{}, res)
+	require.Equal(t, 2, actual.Total)
+	require.Equal(t, uint64(3), actual.Data[0].ID)
+	require.Equal(t, uint64(4), actual.Data[1].ID)
+}
+
+func Test_ListRoleApprovers_filters_by_name(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.Role.UpdateOneID(5).AddApproverIDs(3, 4).ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/role/5/approvers?name=user2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListRoleApproversPaginateResponse{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, uint64(4), actual.Data[0].ID)
+}
+
+func Test_ListRoleApprovers_returns_empty_list_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.Role.UpdateOneID(5).AddApproverIDs(3).ExecX(context.Background())
+	_, u, _ := seedOrganization(t, db, "auth:ListRoleApprovers")
+	req, err := svr.getAs(u, "/role/5/approvers")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListRoleApproversPaginateResponse{}, res)
+	require.Equal(t, 0, actual.Total)
+}
+
+func Test_ListRoleApprovers_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/role/5/approvers")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListRoleApprovers_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(u, "/role/5/approvers")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListRoleApprovers_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/role/5/approvers")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -33,8 +33,13 @@ func (s Server) ListRolePermissions(
 	if !ok {
 		return nil, errInvalidContext
 	}
-	query := s.db.Role.Query().Where(role.IDEQ(request.Id)).QueryPermissions().
-		Order(permission.ByID())
+	scope, err := s.roleScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListRolePermissions error: %v", err)
+		return nil, err
+	}
+	query := s.db.Role.Query().Where(role.IDEQ(request.Id), scope).
+		QueryPermissions().Order(permission.ByID())
 	if request.Params.Name != nil {
 		query.Where(permission.NameHasPrefix(*request.Params.Name))
 	}
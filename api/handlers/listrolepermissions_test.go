@@ -45,12 +45,12 @@ func Test_ListRolePermissions_returns_5_per_page(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	expected := ListRolePermissionsPaginateResponse{
 		PaginatedList: &paginate.PaginatedList[ent.Permission]{
-			Total:        34,
+			Total:        95,
 			PerPage:      5,
 			CurrentPage:  1,
-			LastPage:     7,
+			LastPage:     19,
 			FirstPageUrl: svr.baseUrl + "/role/1/permissions?page=1&per_page=5",
-			LastPageUrl:  svr.baseUrl + "/role/1/permissions?page=7&per_page=5",
+			LastPageUrl:  svr.baseUrl + "/role/1/permissions?page=19&per_page=5",
 			NextPageUrl:  svr.baseUrl + "/role/1/permissions?page=2&per_page=5",
 			PrevPageUrl:  "",
 			Path:         svr.baseUrl + "/role/1/permissions",
@@ -73,12 +73,12 @@ func Test_ListRolePermissions_returns_2nd_page(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	expected := ListRolePermissionsPaginateResponse{
 		PaginatedList: &paginate.PaginatedList[ent.Permission]{
-			Total:        34,
+			Total:        95,
 			PerPage:      5,
 			CurrentPage:  2,
-			LastPage:     7,
+			LastPage:     19,
 			FirstPageUrl: svr.baseUrl + "/role/1/permissions?page=1&per_page=5",
-			LastPageUrl:  svr.baseUrl + "/role/1/permissions?page=7&per_page=5",
+			LastPageUrl:  svr.baseUrl + "/role/1/permissions?page=19&per_page=5",
 			NextPageUrl:  svr.baseUrl + "/role/1/permissions?page=3&per_page=5",
 			PrevPageUrl:  svr.baseUrl + "/role/1/permissions?page=1&per_page=5",
 			Path:         svr.baseUrl + "/role/1/permissions",
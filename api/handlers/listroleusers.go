@@ -33,8 +33,13 @@ func (s Server) ListRoleUsers(
 	if !ok {
 		return nil, errInvalidContext
 	}
-	query := s.db.Role.Query().Where(role.IDEQ(request.Id)).QueryUsers().
-		Order(user.ByID())
+	scope, err := s.roleScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListRoleUsers error: %v", err)
+		return nil, err
+	}
+	query := s.db.Role.Query().Where(role.IDEQ(request.Id), scope).
+		QueryUsers().Order(user.ByID())
 	if request.Params.Name != nil {
 		query.Where(
 			user.Or(
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+)
+
+type ListServiceAccountPaginateResponse struct {
+	*paginate.PaginatedList[ent.ServiceAccount]
+}
+
+func (response ListServiceAccountPaginateResponse) VisitListServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListServiceAccount lists service accounts.
+//
+// Endpoint: GET /service-accounts
+func (s Server) ListServiceAccount(
+	ctx context.Context, _ ListServiceAccountRequestObject,
+) (ListServiceAccountResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.serviceAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListServiceAccount error: %v", err)
+		return nil, err
+	}
+	paginator := paginate.Paginator[ent.ServiceAccount, ent.ServiceAccountQuery]{
+		BaseUrl: s.baseUrl,
+		Query: s.db.ServiceAccount.Query().Where(scope).
+			Order(serviceaccount.ByID()),
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListServiceAccount error: %v", err)
+		return nil, err
+	}
+	return ListServiceAccountPaginateResponse{PaginatedList: page}, nil
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+)
+
+func Test_ListServiceAccount_returns_account_list(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, c, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	expected := ListServiceAccountPaginateResponse{
+		PaginatedList: &paginate.PaginatedList[ent.ServiceAccount]{
+			Total:        1,
+			PerPage:      10,
+			CurrentPage:  1,
+			LastPage:     1,
+			FirstPageUrl: svr.baseUrl + "/service-accounts?page=1&per_page=10",
+			LastPageUrl:  "",
+			NextPageUrl:  "",
+			PrevPageUrl:  "",
+			Path:         svr.baseUrl + "/service-accounts",
+			From:         1,
+			To:           1,
+			Data: db.ServiceAccount.Query().
+				Where(serviceaccount.IDEQ(a.ID)).AllX(context.Background()),
+		},
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/service-accounts")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.NotContains(t, res.Body.String(), *c.Secret)
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_ListServiceAccount_returns_accounts_of_own_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	_, u, _ := seedOrganization(t, db, "auth:ListServiceAccount")
+	req, err := svr.getAs(u, "/service-accounts")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListServiceAccountPaginateResponse{}, res)
+	require.Equal(t, 0, actual.Total)
+}
+
+func Test_ListServiceAccount_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/service-accounts")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListServiceAccount_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 3)
+	req, err := svr.getAs(u, "/service-accounts")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListServiceAccount_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/service-accounts")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
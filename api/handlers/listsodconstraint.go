@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+type ListSodConstraintPaginateResponse struct {
+	*paginate.PaginatedList[ent.SodConstraint]
+}
+
+func (response ListSodConstraintPaginateResponse) VisitListSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListSodConstraint lists separation-of-duties constraints.
+//
+// Endpoint: GET /sod-constraints
+func (s Server) ListSodConstraint(
+	ctx context.Context, request ListSodConstraintRequestObject,
+) (ListSodConstraintResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.sodConstraintScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListSodConstraint error: %v", err)
+		return nil, err
+	}
+	query := s.db.SodConstraint.Query().Where(scope).
+		Order(sodconstraint.ByID())
+	if request.Params.Name != nil {
+		query = query.Where(
+			sodconstraint.NameHasPrefix(*request.Params.Name),
+		)
+	}
+	paginator := paginate.Paginator[ent.SodConstraint, ent.SodConstraintQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListSodConstraint error: %v", err)
+		return nil, err
+	}
+	return ListSodConstraintPaginateResponse{PaginatedList: page}, nil
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListSodConstraint_returns_constraints(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c := seedSodConstraint(t, db, 2, 3)
+	db.SodConstraint.Create().SetName("other").AddRoleIDs(4, 5).
+		SaveX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/sod-constraints")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListSodConstraintPaginateResponse{}, res)
+	require.Equal(t, 2, actual.Total)
+	require.Equal(t, c.ID, actual.Data[0].ID)
+}
+
+func Test_ListSodConstraint_filters_by_name(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c := seedSodConstraint(t, db, 2, 3)
+	db.SodConstraint.Create().SetName("other").AddRoleIDs(4, 5).
+		SaveX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/sod-constraints?name=co")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListSodConstraintPaginateResponse{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, c.ID, actual.Data[0].ID)
+}
+
+func Test_ListSodConstraint_returns_constraints_of_own_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 3)
+	_, u, _ := seedOrganization(t, db, "auth:ListSodConstraint")
+	req, err := svr.getAs(u, "/sod-constraints")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListSodConstraintPaginateResponse{}, res)
+	require.Equal(t, 0, actual.Total)
+}
+
+func Test_ListSodConstraint_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/sod-constraints")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListSodConstraint_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(u, "/sod-constraints")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListSodConstraint_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/sod-constraints")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+type ListSodConstraintRolesPaginateResponse struct {
+	*paginate.PaginatedList[ent.Role]
+}
+
+func (response ListSodConstraintRolesPaginateResponse) VisitListSodConstraintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListSodConstraintRoles lists mutually exclusive roles of a
+// separation-of-duties constraint.
+//
+// Endpoint: GET /sod-constraint/{id}/roles
+func (s Server) ListSodConstraintRoles(
+	ctx context.Context, request ListSodConstraintRolesRequestObject,
+) (ListSodConstraintRolesResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.sodConstraintScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListSodConstraintRoles error: %v", err)
+		return nil, err
+	}
+	query := s.db.SodConstraint.Query().
+		Where(sodconstraint.IDEQ(request.Id), scope).
+		QueryRoles().Order(role.ByID())
+	if request.Params.Name != nil {
+		query.Where(role.NameHasPrefix(*request.Params.Name))
+	}
+	paginator := paginate.Paginator[ent.Role, ent.RoleQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListSodConstraintRoles error: %v", err)
+		return nil, err
+	}
+	return ListSodConstraintRolesPaginateResponse{PaginatedList: page}, nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListSodConstraintRoles_returns_roles(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c := seedSodConstraint(t, db, 2, 3)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, fmt.Sprintf("/sod-constraint/%d/roles", c.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListSodConstraintRolesPaginateResponse{}, res)
+	require.Equal(t, 2, actual.Total)
+	require.Equal(t, uint32(2), actual.Data[0].ID)
+	require.Equal(t, uint32(3), actual.Data[1].ID)
+}
+
+func Test_ListSodConstraintRoles_filters_by_name(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c := seedSodConstraint(t, db, 2, 3)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(
+		u, fmt.Sprintf("/sod-constraint/%d/roles?name=role+1", c.ID),
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListSodConstraintRolesPaginateResponse{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, uint32(3), actual.Data[0].ID)
+}
+
+func Test_ListSodConstraintRoles_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/sod-constraint/1/roles")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListSodConstraintRoles_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(u, "/sod-constraint/1/roles")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListSodConstraintRoles_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/sod-constraint/1/roles")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
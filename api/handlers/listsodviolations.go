@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+// ListSodViolations lists users holding more than one role of a
+// separation-of-duties constraint, e.g. those who had held the roles before
+// the constraint was added.
+//
+// Endpoint: GET /sod-constraint/{id}/violations
+func (s Server) ListSodViolations(
+	ctx context.Context, request ListSodViolationsRequestObject,
+) (ListSodViolationsResponseObject, error) {
+	scope, err := s.sodConstraintScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListSodViolations error: %v", err)
+		return nil, err
+	}
+	qc := context.Background()
+	c, err := s.db.SodConstraint.Query().
+		Where(sodconstraint.IDEQ(request.Id), scope).Only(qc)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ListSodViolations404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ListSodViolations error: %v", err)
+		return nil, err
+	}
+	violations, err := findSodViolations(qc, s.db, c)
+	if err != nil {
+		api.Log.Debugf("ListSodViolations error: %v", err)
+		return nil, err
+	}
+	return ListSodViolations200JSONResponse(violations), nil
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+func Test_ListSodViolations_lists_users_holding_excluded_roles(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	// user 2 holds role 2 & 3 directly, user 3 holds role 2 directly and
+	// role 3 through a group
+	db.User.UpdateOneID(3).AddRoleIDs(2).ExecX(context.Background())
+	seedGroup(t, db, []uint64{3}, []uint32{3})
+	c := seedSodConstraint(t, db, 2, 3)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(
+		u, fmt.Sprintf("/sod-constraint/%d/violations", c.ID),
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListSodViolations200JSONResponse{}, res)
+	require.Equal(
+		t, ListSodViolations200JSONResponse{
+			{UserId: 2, Username: "user0", Roles: []string{"role 0", "role 1"}},
+			{UserId: 3, Username: "user1", Roles: []string{"role 0", "role 1"}},
+		}, actual,
+	)
+}
+
+func Test_ListSodViolations_excludes_expired_assignments(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(3)).
+		SetExpiresAt(time.Now().Add(-time.Second)).ExecX(context.Background())
+	c := seedSodConstraint(t, db, 2, 3)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(
+		u, fmt.Sprintf("/sod-constraint/%d/violations", c.ID),
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, "[]\n", res.Body.String())
+}
+
+func Test_ListSodViolations_returns_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/sod-constraint/12345/violations")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ListSodViolations_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/sod-constraint/1/violations")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListSodViolations_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(u, "/sod-constraint/1/violations")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListSodViolations_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/sod-constraint/1/violations")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
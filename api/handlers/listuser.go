@@ -32,7 +32,12 @@ func (s Server) ListUser(
 	if !ok {
 		return nil, errInvalidContext
 	}
-	query := s.db.User.Query().Order(user.ByID())
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListUser error: %v", err)
+		return nil, err
+	}
+	query := s.db.User.Query().Where(scope).Order(user.ByID())
 	if request.Params.Name != nil {
 		query = query.Where(
 			user.Or(
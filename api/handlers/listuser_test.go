@@ -154,6 +154,19 @@ func Test_ListUser_filters_by_email(t *testing.T) {
 	requireJsonEqualsString(t, expected, res.Body.String())
 }
 
+func Test_ListUser_omits_service_accounts(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/users?name=service")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListUserPaginateResponse{}, res)
+	require.Equal(t, 0, actual.Total)
+	require.Empty(t, actual.Data)
+}
+
 func Test_ListUser_returns_500_if_invalid_context(t *testing.T) {
 	svr, _, _, _ := setupTestCase(t, false)
 	_, err := svr.ListUser(context.Background(), ListUserRequestObject{})
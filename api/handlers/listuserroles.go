@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/eidng8/go-ent/paginate"
 	"github.com/gin-gonic/gin"
@@ -11,10 +12,19 @@ import (
 	"github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/role"
 	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
 )
 
+// UserRoleListItem is a role assigned to the user, along with the time window
+// of the assignment.
+type UserRoleListItem struct {
+	*ent.Role
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
 type ListUserRolesPaginateResponse struct {
-	*paginate.PaginatedList[ent.Role]
+	*paginate.PaginatedList[UserRoleListItem]
 }
 
 func (response ListUserRolesPaginateResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
@@ -23,7 +33,8 @@ func (response ListUserRolesPaginateResponse) VisitListUserRolesResponse(w http.
 	return json.NewEncoder(w).Encode(response)
 }
 
-// ListUserRoles lists roles of a user
+// ListUserRoles lists roles assigned to a user, including the time window of
+// each assignment. Roles granted through groups are not included.
 //
 // Endpoint: GET /user/{id}/roles
 func (s Server) ListUserRoles(
@@ -33,8 +44,13 @@ func (s Server) ListUserRoles(
 	if !ok {
 		return nil, errInvalidContext
 	}
-	query := s.db.User.Query().Where(user.IDEQ(request.Id)).QueryRoles().
-		Order(role.ByID())
+	scope, err := s.userScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListUserRoles error: %v", err)
+		return nil, err
+	}
+	query := s.db.User.Query().Where(user.IDEQ(request.Id), scope).
+		QueryRoles().Order(role.ByID())
 	if request.Params.Name != nil {
 		query.Where(role.NameHasPrefix(*request.Params.Name))
 	}
@@ -49,5 +65,51 @@ func (s Server) ListUserRoles(
 		api.Log.Debugf("ListUserRoles error: %v", err)
 		return nil, err
 	}
-	return ListUserRolesPaginateResponse{PaginatedList: page}, nil
+	list, err := s.withAssignmentPeriods(request.Id, page)
+	if err != nil {
+		api.Log.Debugf("ListUserRoles error: %v", err)
+		return nil, err
+	}
+	return ListUserRolesPaginateResponse{PaginatedList: list}, nil
+}
+
+// Attaches the time window of the user's assignments to the page of roles.
+func (s Server) withAssignmentPeriods(
+	id uint64, page *paginate.PaginatedList[ent.Role],
+) (*paginate.PaginatedList[UserRoleListItem], error) {
+	ids := make([]uint32, len(page.Data))
+	for i, r := range page.Data {
+		ids[i] = r.ID
+	}
+	rows, err := s.db.UserRole.Query().
+		Where(userrole.UserIDEQ(id), userrole.RoleIDIn(ids...)).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*UserRoleListItem, len(page.Data))
+	for i, r := range page.Data {
+		data[i] = &UserRoleListItem{Role: r}
+		for _, row := range rows {
+			if row.RoleID == r.ID {
+				data[i].StartsAt = row.StartsAt
+				data[i].ExpiresAt = row.ExpiresAt
+				break
+			}
+		}
+	}
+	return &paginate.PaginatedList[UserRoleListItem]{
+		Total:        page.Total,
+		PerPage:      page.PerPage,
+		CurrentPage:  page.CurrentPage,
+		LastPage:     page.LastPage,
+		FirstPageUrl: page.FirstPageUrl,
+		LastPageUrl:  page.LastPageUrl,
+		NextPageUrl:  page.NextPageUrl,
+		PrevPageUrl:  page.PrevPageUrl,
+		Path:         page.Path,
+		From:         page.From,
+		To:           page.To,
+		Data:         data,
+	}, nil
 }
@@ -4,18 +4,20 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/eidng8/go-ent/paginate"
 	"github.com/stretchr/testify/require"
 
 	"github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
 )
 
 func Test_ListUserRoles_returns_1st_page(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	expected := ListUserRolesPaginateResponse{
-		PaginatedList: &paginate.PaginatedList[ent.Role]{
+		PaginatedList: &paginate.PaginatedList[UserRoleListItem]{
 			Total:        1,
 			PerPage:      10,
 			CurrentPage:  1,
@@ -27,8 +29,9 @@ func Test_ListUserRoles_returns_1st_page(t *testing.T) {
 			Path:         svr.baseUrl + "/user/1/roles",
 			From:         1,
 			To:           1,
-			Data: db.Role.Query().Where(role.IDEQ(1)).
-				AllX(context.Background()),
+			Data: userRoleListItems(
+				db.Role.Query().Where(role.IDEQ(1)).AllX(context.Background()),
+			),
 		},
 	}
 	require.Len(t, expected.PaginatedList.Data, 1)
@@ -43,7 +46,7 @@ func Test_ListUserRoles_returns_1st_page(t *testing.T) {
 func Test_ListUserRoles_filters_by_name(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	expected := ListUserRolesPaginateResponse{
-		PaginatedList: &paginate.PaginatedList[ent.Role]{
+		PaginatedList: &paginate.PaginatedList[UserRoleListItem]{
 			Total:        1,
 			PerPage:      5,
 			CurrentPage:  1,
@@ -55,8 +58,10 @@ func Test_ListUserRoles_filters_by_name(t *testing.T) {
 			Path:         svr.baseUrl + "/user/2/roles",
 			From:         1,
 			To:           1,
-			Data: db.Role.Query().Where(role.NameEQ("role 0")).
-				AllX(context.Background()),
+			Data: userRoleListItems(
+				db.Role.Query().Where(role.NameEQ("role 0")).
+					AllX(context.Background()),
+			),
 		},
 	}
 	u := getUserById(t, db, 1)
@@ -67,6 +72,36 @@ func Test_ListUserRoles_filters_by_name(t *testing.T) {
 	requireJsonEqualsString(t, expected, res.Body.String())
 }
 
+func Test_ListUserRoles_includes_assignment_period(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	startsAt := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	expiresAt := startsAt.Add(time.Hour)
+	db.UserRole.Update().
+		Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(3)).
+		SetStartsAt(startsAt).SetExpiresAt(expiresAt).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/user/2/roles?name=role+1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, paginate.PaginatedList[UserRoleListItem]{}, res,
+	)
+	require.Len(t, actual.Data, 1)
+	require.Equal(t, "role 1", actual.Data[0].Name)
+	require.True(t, startsAt.Equal(*actual.Data[0].StartsAt))
+	require.True(t, expiresAt.Equal(*actual.Data[0].ExpiresAt))
+}
+
+func userRoleListItems(roles []*ent.Role) []*UserRoleListItem {
+	items := make([]*UserRoleListItem, len(roles))
+	for i, r := range roles {
+		items[i] = &UserRoleListItem{Role: r}
+	}
+	return items
+}
+
 func Test_ListUserRoles_returns_500_if_invalid_context(t *testing.T) {
 	svr, _, _, _ := setupTestCase(t, false)
 	_, err := svr.ListUserRoles(
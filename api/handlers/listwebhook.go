@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+)
+
+type ListWebhookPaginateResponse struct {
+	*paginate.PaginatedList[ent.Webhook]
+}
+
+func (response ListWebhookPaginateResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListWebhook lists webhooks of the request's organization.
+//
+// Endpoint: GET /webhooks
+func (s Server) ListWebhook(
+	ctx context.Context, _ ListWebhookRequestObject,
+) (ListWebhookResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.webhookScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListWebhook error: %v", err)
+		return nil, err
+	}
+	paginator := paginate.Paginator[ent.Webhook, ent.WebhookQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    s.db.Webhook.Query().Where(scope).Order(webhook.ByID()),
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListWebhook error: %v", err)
+		return nil, err
+	}
+	return ListWebhookPaginateResponse{PaginatedList: page}, nil
+}
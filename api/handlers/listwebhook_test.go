@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+)
+
+func Test_ListWebhook_returns_webhook_list(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	w := seedWebhook(t, db, "https://example.com/hook", api.EventUserCreated)
+	seedWebhook(t, db, "https://example.com/hook2", api.EventUserDeleted)
+	expected := ListWebhookPaginateResponse{
+		PaginatedList: &paginate.PaginatedList[ent.Webhook]{
+			Total:        2,
+			PerPage:      1,
+			CurrentPage:  1,
+			LastPage:     2,
+			FirstPageUrl: svr.baseUrl + "/webhooks?page=1&per_page=1",
+			LastPageUrl:  svr.baseUrl + "/webhooks?page=2&per_page=1",
+			NextPageUrl:  svr.baseUrl + "/webhooks?page=2&per_page=1",
+			PrevPageUrl:  "",
+			Path:         svr.baseUrl + "/webhooks",
+			From:         1,
+			To:           1,
+			Data: db.Webhook.Query().Where(webhook.IDEQ(w.ID)).
+				AllX(context.Background()),
+		},
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/webhooks?per_page=1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.NotContains(t, res.Body.String(), w.Secret)
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_ListWebhook_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/webhooks")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListWebhook_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 3)
+	req, err := svr.getAs(u, "/webhooks")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListWebhook_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/webhooks")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func Test_ListWebhook_returns_webhooks_of_own_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedWebhook(t, db, "https://example.com/hook", api.EventUserCreated)
+	_, u, _ := seedOrganization(t, db, "auth:ListWebhook")
+	req, err := svr.getAs(u, "/webhooks")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListWebhookPaginateResponse{}, res)
+	require.Equal(t, 0, actual.Total)
+}
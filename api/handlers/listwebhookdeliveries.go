@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+	"github.com/eidng8/go-attr-rbac/ent/webhookdelivery"
+)
+
+type ListWebhookDeliveriesPaginateResponse struct {
+	*paginate.PaginatedList[ent.WebhookDelivery]
+}
+
+func (response ListWebhookDeliveriesPaginateResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// ListWebhookDeliveries lists delivery attempts of a webhook, latest first.
+//
+// Endpoint: GET /webhook/{id}/deliveries
+func (s Server) ListWebhookDeliveries(
+	ctx context.Context, request ListWebhookDeliveriesRequestObject,
+) (ListWebhookDeliveriesResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	scope, err := s.webhookScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ListWebhookDeliveries error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Webhook.Query().Where(webhook.IDEQ(request.Id), scope).
+		OnlyID(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ListWebhookDeliveries404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ListWebhookDeliveries error: %v", err)
+		return nil, err
+	}
+	query := s.db.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookIDEQ(request.Id)).
+		Order(webhookdelivery.ByID(sql.OrderDesc()))
+	paginator := paginate.Paginator[ent.WebhookDelivery, ent.WebhookDeliveryQuery]{
+		BaseUrl:  s.baseUrl,
+		Query:    query,
+		GinCtx:   gc,
+		QueryCtx: context.Background(),
+	}
+	page, err := paginator.GetPage()
+	if err != nil {
+		api.Log.Debugf("ListWebhookDeliveries error: %v", err)
+		return nil, err
+	}
+	return ListWebhookDeliveriesPaginateResponse{PaginatedList: page}, nil
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-ent/paginate"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/webhookdelivery"
+)
+
+func Test_ListWebhookDeliveries_returns_latest_first(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	w := seedWebhook(t, db, "https://example.com/hook", api.EventUserCreated)
+	for range 3 {
+		db.WebhookDelivery.Create().SetWebhookID(w.ID).
+			SetEvent(api.EventUserCreated).
+			SetPayload(map[string]interface{}{}).ExecX(qc)
+	}
+	path := fmt.Sprintf("/webhook/%d/deliveries", w.ID)
+	expected := ListWebhookDeliveriesPaginateResponse{
+		PaginatedList: &paginate.PaginatedList[ent.WebhookDelivery]{
+			Total:        3,
+			PerPage:      2,
+			CurrentPage:  1,
+			LastPage:     2,
+			FirstPageUrl: svr.baseUrl + path + "?page=1&per_page=2",
+			LastPageUrl:  svr.baseUrl + path + "?page=2&per_page=2",
+			NextPageUrl:  svr.baseUrl + path + "?page=2&per_page=2",
+			PrevPageUrl:  "",
+			Path:         svr.baseUrl + path,
+			From:         1,
+			To:           2,
+			Data: db.WebhookDelivery.Query().
+				Order(webhookdelivery.ByID(sql.OrderDesc())).Limit(2).
+				AllX(qc),
+		},
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, path+"?per_page=2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_ListWebhookDeliveries_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/webhook/1/deliveries")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ListWebhookDeliveries_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 3)
+	req, err := svr.getAs(u, "/webhook/1/deliveries")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ListWebhookDeliveries_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/webhook/1/deliveries")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func Test_ListWebhookDeliveries_returns_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	w := seedWebhook(t, db, "https://example.com/hook", api.EventUserDeleted)
+	_, u, _ := seedOrganization(t, db, "auth:ListWebhookDeliveries")
+	req, err := svr.getAs(u, fmt.Sprintf("/webhook/%d/deliveries", w.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
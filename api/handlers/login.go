@@ -2,7 +2,11 @@ package handlers
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/eidng8/go-utils"
 	"github.com/gin-gonic/gin"
@@ -10,10 +14,16 @@ import (
 
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
 	"github.com/eidng8/go-attr-rbac/ent/user"
 )
 
 // Login authenticates the user and sets the access and refresh token cookie.
+// Failed logins are counted per account and per source address, either is
+// temporarily locked out after too many failures. Identifiers without user
+// are counted and locked out alike, so that the lockout doesn't reveal which
+// accounts exist. Identifiers without local user are tried with the external
+// authenticators, users they authenticate are created on their first login.
 //
 // TODO login using personal token?
 //
@@ -28,10 +38,65 @@ func (s Server) Login(
 
 	// check user credentials
 	qc := context.Background()
-	u, err := s.db.User.Query().Where(user.UsernameEQ(req.Body.Username)).
-		Only(qc)
+	organization := uint32(api.DefaultOrganizationId)
+	if nil != req.Body.Organization {
+		organization = *req.Body.Organization
+	}
+	ip := gc.ClientIP()
+	wait, err := s.loginLockedOut(qc, ipAttemptKey(ip))
+	if err != nil {
+		api.Log.Debugf("login failed: %v", err)
+		return nil, err
+	}
+	if wait > 0 {
+		return Login429JSONResponse{lockedOut(wait)}, nil
+	}
+	name, identifier := s.loginIdentifierPredicate(req.Body)
+	if nil == identifier {
+		return Login400JSONResponse{
+			N400JSONResponse: N400JSONResponse{
+				Code:   http.StatusBadRequest,
+				Errors: &msgInvalidIdentifier,
+				Status: msgError,
+			},
+		}, nil
+	}
+	wait, err = s.loginLockedOut(qc, identifierAttemptKey(organization, name))
 	if err != nil {
-		if ent.IsNotFound(err) {
+		api.Log.Debugf("login failed: %v", err)
+		return nil, err
+	}
+	if wait > 0 {
+		return Login429JSONResponse{lockedOut(wait)}, nil
+	}
+	// service accounts can't log in, they have a username namespace of their
+	// own, so that logins never match them
+	u, err := s.db.User.Query().Where(
+		identifier, user.OrganizationIDEQ(organization),
+		user.IsServiceAccountEQ(false),
+	).Only(qc)
+	// neither can disabled or deleted users, they are treated as unknown
+	if nil == err && accountClosed(u) {
+		err = errUnknownIdentity
+	}
+	// external users are verified on their first login
+	verified := false
+	if ent.IsNotFound(err) && len(s.authenticators) > 0 {
+		u, err = s.loginExternal(qc, organization, name, req.Body.Password)
+		verified = nil == err
+	}
+	if err != nil {
+		// an identifier matching several users is treated as unknown
+		if ent.IsNotFound(err) || ent.IsNotSingular(err) ||
+			errors.Is(err, errUnknownIdentity) {
+			// spend the same time as checking a password of an existing
+			// user, so that valid identifiers can't be told by timing.
+			_, _, _ = s.verifyPassword(req.Body.Password, s.dummyHash.get())
+			err = s.unknownLoginFailed(qc, ip, organization, name)
+			if err != nil {
+				api.Log.Debugf("login failed: %v", err)
+				return nil, err
+			}
 			return Login401JSONResponse{
 				N401JSONResponse: N401JSONResponse{
 					Code:   http.StatusUnauthorized,
@@ -40,42 +105,98 @@ func (s Server) Login(
 				},
 			}, nil
 		}
+		if errors.Is(err, errInvalidCredential) {
+			err = s.unknownLoginFailed(qc, ip, organization, name)
+			if err != nil {
+				api.Log.Debugf("login failed: %v", err)
+				return nil, err
+			}
+			return Login401JSONResponse{
+				N401JSONResponse: N401JSONResponse{
+					Code:   http.StatusUnauthorized,
+					Errors: &api.ResponseMessageCredentialsInvalid,
+					Status: msgError,
+				},
+			}, nil
+		}
 		api.Log.Debugf("login failed: %v", err)
 		return nil, err
 	}
-	m, e := utils.ComparePassword(req.Body.Password, u.Password)
-	if e != nil {
-		api.Log.Debugf("login failed: %v", e)
-		return Login401JSONResponse{
-			N401JSONResponse: N401JSONResponse{
-				Code:   http.StatusUnauthorized,
-				Errors: &api.ResponseMessageCredentialsInvalid,
-				Status: msgError,
-			},
-		}, nil
+	wait, err = s.loginLockedOut(qc, userAttemptKey(u.ID))
+	if err != nil {
+		api.Log.Debugf("login failed: %v", err)
+		return nil, err
 	}
-	if !m {
-		return Login401JSONResponse{
-			N401JSONResponse: N401JSONResponse{
-				Code:   http.StatusUnauthorized,
-				Errors: &api.ResponseMessageCredentialsInvalid,
-				Status: msgError,
-			},
-		}, nil
+	if wait > 0 {
+		return Login429JSONResponse{lockedOut(wait)}, nil
+	}
+	if nil != u.AuthProvider && !verified {
+		eu, err := s.verifyExternal(qc, u, name, req.Body.Password)
+		if err != nil {
+			if errors.Is(err, errInvalidCredential) {
+				return s.loginRejected(qc, ip, u)
+			}
+			api.Log.Debugf("login failed: %v", err)
+			return nil, err
+		}
+		u = eu
+	} else if nil == u.AuthProvider {
+		m, rehash, e := s.verifyPassword(req.Body.Password, u.Password)
+		if e != nil {
+			api.Log.Debugf("login failed: %v", e)
+		}
+		if e != nil || !m {
+			return s.loginRejected(qc, ip, u)
+		}
+		if rehash {
+			u, err = s.rehashPassword(qc, u, req.Body.Password)
+			if err != nil {
+				api.Log.Debugf("login failed: %v", err)
+				return nil, err
+			}
+		}
 	}
 
-	// generate access token and refresh token
-	at, err := s.issueAccessToken(u)
-	if err != nil || "" == at {
+	if nil != u.TotpConfirmedAt {
+		ct, exp, err := s.issueMfaChallenge(u)
+		if err != nil {
+			api.Log.Debugf("login failed: %v", err)
+			return nil, err
+		}
+		return Login202JSONResponse{ChallengeToken: ct, ExpiresAt: *exp}, nil
+	}
+	res, err := s.signIn(gc, u)
+	if err != nil {
+		api.Log.Debugf("login failed: %v", err)
 		return nil, err
 	}
-	rt, err := s.issueRefreshToken(u)
-	if err != nil || "" == rt {
+	return Login200JSONResponse(*res), nil
+}
+
+// Completes login of the authenticated user: forgets its failed logins, sets
+// it active if it was locked, and starts a session setting the access and
+// refresh token cookies. Returns the user to respond with.
+func (s Server) signIn(gc *gin.Context, u *ent.User) (*UserRead, error) {
+	qc := context.Background()
+	err := s.loginAttempts.Reset(qc, userAttemptKey(u.ID))
+	if err != nil {
+		return nil, err
+	}
+	if user.StatusLocked == u.Status {
+		u, err = s.db.User.UpdateOne(u).SetStatus(user.StatusActive).Save(qc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = s.loadRoles(u); err != nil {
 		return nil, err
 	}
-	s.setToken(gc, at, rt)
 
-	res := Login200JSONResponse{
+	if err = s.startSession(gc, u); err != nil {
+		return nil, err
+	}
+
+	res := UserRead{
 		Id:        u.ID,
 		Username:  u.Username,
 		CreatedAt: u.CreatedAt,
@@ -85,5 +206,98 @@ func (s Server) Login(
 		email := types.Email(*u.Email)
 		res.Email = &email
 	}
-	return res, nil
+	return &res, nil
+}
+
+// Counts the failed login of the user, and responds that the credentials are
+// invalid.
+func (s Server) loginRejected(
+	qc context.Context, ip string, u *ent.User,
+) (LoginResponseObject, error) {
+	if err := s.loginFailed(qc, ip, u); err != nil {
+		api.Log.Debugf("login failed: %v", err)
+		return nil, err
+	}
+	return Login401JSONResponse{
+		N401JSONResponse: N401JSONResponse{
+			Code:   http.StatusUnauthorized,
+			Errors: &api.ResponseMessageCredentialsInvalid,
+			Status: msgError,
+		},
+	}, nil
+}
+
+func lockedOut(wait time.Duration) N429JSONResponse {
+	res := N429JSONResponse{}
+	res.Headers.RetryAfter = retryAfter(wait)
+	res.Body.Code = http.StatusTooManyRequests
+	res.Body.Errors = &msgLockedOut
+	res.Body.Status = msgError
+	return res
+}
+
+// Returns the login identifier of the request, and the predicate matching the
+// user by it, case-insensitively. Returns nil predicate if the request has no
+// identifier accepted by the deployment.
+func (s Server) loginIdentifierPredicate(
+	body *LoginJSONRequestBody,
+) (string, predicate.User) {
+	switch s.loginIdentifier {
+	case api.LoginIdentifierUsername:
+		if nil != body.Username {
+			return *body.Username, user.UsernameEqualFold(*body.Username)
+		}
+	case api.LoginIdentifierEmail:
+		if nil != body.Email {
+			id := string(*body.Email)
+			return id, user.EmailEqualFold(id)
+		}
+	default:
+		var id string
+		if nil != body.Username {
+			id = *body.Username
+		} else if nil != body.Email {
+			id = string(*body.Email)
+		} else {
+			return "", nil
+		}
+		return id, user.Or(
+			user.UsernameEqualFold(id), user.EmailEqualFold(id),
+		)
+	}
+	return "", nil
+}
+
+// Replaces the password hash of the user by a hash of the current hasher,
+// after the password has been verified.
+func (s Server) rehashPassword(
+	qc context.Context, u *ent.User, password string,
+) (*ent.User, error) {
+	hash, err := s.hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.User.UpdateOne(u).SetPassword(hash).Save(qc)
+}
+
+// Hash of a random password, compared against when the login user doesn't
+// exist, so that checking it takes the same time as checking a password of an
+// existing user. It is computed on first use.
+type dummyPasswordHash struct {
+	once   sync.Once
+	hasher PasswordHasher
+	hash   string
+}
+
+func (d *dummyPasswordHash) get() string {
+	d.once.Do(
+		func() {
+			pass := make([]byte, 32)
+			_, err := rand.Read(pass)
+			utils.PanicIfError(err)
+			d.hash, err = d.hasher.Hash(string(pass))
+			utils.PanicIfError(err)
+		},
+	)
+	return d.hash
 }
@@ -2,19 +2,25 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
 )
 
 func Test_Login_sets_cookies(t *testing.T) {
+	username := "test"
 	svr, engine, db, res := setupTestCase(t, true)
 	email := types.Email("test@sample.com")
-	u, err := createUser(
+	u, err := svr.createUser(
 		context.Background(), db.User.Create(), CreateUserJSONBody{
 			Username: "test",
 			Password: "Test_123",
@@ -23,7 +29,7 @@ func Test_Login_sets_cookies(t *testing.T) {
 	)
 	require.Nil(t, err)
 	req, err := svr.post(
-		"/login", LoginJSONRequestBody{Username: "test", Password: "Test_123"},
+		"/login", LoginJSONRequestBody{Username: &username, Password: "Test_123"},
 	)
 	require.Nil(t, err)
 	engine.ServeHTTP(res, req)
@@ -49,9 +55,10 @@ func Test_Login_sets_cookies(t *testing.T) {
 }
 
 func Test_Login_returns_401_if_password_wrong(t *testing.T) {
+	username := "test"
 	svr, engine, db, res := setupTestCase(t, true)
 	email := types.Email("test@sample.com")
-	_, err := createUser(
+	_, err := svr.createUser(
 		context.Background(), db.User.Create(), CreateUserJSONBody{
 			Username: "test",
 			Password: "Test_123",
@@ -60,7 +67,7 @@ func Test_Login_returns_401_if_password_wrong(t *testing.T) {
 	)
 	require.Nil(t, err)
 	req, err := svr.post(
-		"/login", LoginJSONRequestBody{Username: "test", Password: "test"},
+		"/login", LoginJSONRequestBody{Username: &username, Password: "test"},
 	)
 	require.Nil(t, err)
 	engine.ServeHTTP(res, req)
@@ -68,17 +75,31 @@ func Test_Login_returns_401_if_password_wrong(t *testing.T) {
 }
 
 func Test_Login_returns_401_if_user_not_exist(t *testing.T) {
+	username := "not exist"
 	svr, engine, _, res := setupTestCase(t, false)
 	req, err := svr.post(
 		"/login",
-		LoginJSONRequestBody{Username: "not exist", Password: "test"},
+		LoginJSONRequestBody{Username: &username, Password: "test"},
 	)
 	require.Nil(t, err)
 	engine.ServeHTTP(res, req)
 	require.Equal(t, http.StatusUnauthorized, res.Code)
 }
 
+func Test_Login_returns_401_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	req, err := svr.post(
+		"/login", LoginJSONRequestBody{Username: &a.Name, Password: "test"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Empty(t, res.Result().Cookies())
+}
+
 func Test_Login_returns_401_if_invalid_password(t *testing.T) {
+	username := "test"
 	svr, engine, db, res := setupTestCase(t, true)
 	_, err := db.ExecContext(
 		context.Background(),
@@ -87,7 +108,7 @@ func Test_Login_returns_401_if_invalid_password(t *testing.T) {
 	require.Nil(t, err)
 	req, err := svr.post(
 		"/login",
-		LoginJSONRequestBody{Username: "test", Password: "test"},
+		LoginJSONRequestBody{Username: &username, Password: "test"},
 	)
 	require.Nil(t, err)
 	engine.ServeHTTP(res, req)
@@ -103,12 +124,420 @@ func Test_Login_returns_500_if_invalid_context(t *testing.T) {
 }
 
 func Test_Login_returns_500_if_db_error_unhandled(t *testing.T) {
+	username := "test"
 	svr, engine, _, res := setupTestCase(t, false)
 	req, err := svr.post(
-		"/login", LoginJSONRequestBody{Username: "test", Password: "Test_123"},
+		"/login", LoginJSONRequestBody{Username: &username, Password: "Test_123"},
 	)
 	require.Nil(t, err)
 	svr.db = useEmptyDb(t)
 	engine.ServeHTTP(res, req)
 	require.Equal(t, http.StatusInternalServerError, res.Code)
 }
+
+func Test_Login_signs_in_to_organization(t *testing.T) {
+	username := "test"
+	svr, engine, db, res := setupTestCase(t, true)
+	o, _, _ := seedOrganization(t, db)
+	u, err := svr.createUser(
+		context.Background(), db.User.Create().SetOrganizationID(o.ID),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	req, err := svr.post(
+		"/login", LoginJSONRequestBody{
+			Username: &username, Password: "Test_123", Organization: &o.ID,
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, Login200JSONResponse{}, res)
+	require.Equal(t, u.ID, actual.Id)
+}
+
+func Test_Login_returns_401_if_user_in_other_organization(t *testing.T) {
+	username := "test"
+	svr, engine, db, res := setupTestCase(t, true)
+	o, _, _ := seedOrganization(t, db)
+	_, err := svr.createUser(
+		context.Background(), db.User.Create().SetOrganizationID(o.ID),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	req, err := svr.post(
+		"/login", LoginJSONRequestBody{Username: &username, Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_Login_signs_in_with_email(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	email := types.Email("test@sample.com")
+	u, err := svr.createUser(
+		context.Background(), db.User.Create(), CreateUserJSONBody{
+			Username: "test", Password: "Test_123", Email: &email,
+		},
+	)
+	require.Nil(t, err)
+	login := types.Email("Test@Sample.com")
+	req, err := svr.post(
+		"/login", LoginJSONRequestBody{Email: &login, Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, Login200JSONResponse{}, res)
+	require.Equal(t, u.ID, actual.Id)
+}
+
+func Test_Login_resolves_email_given_as_username(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	email := types.Email("test@sample.com")
+	u, err := svr.createUser(
+		context.Background(), db.User.Create(), CreateUserJSONBody{
+			Username: "test", Password: "Test_123", Email: &email,
+		},
+	)
+	require.Nil(t, err)
+	username := "test@sample.com"
+	req, err := svr.post(
+		"/login",
+		LoginJSONRequestBody{Username: &username, Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, Login200JSONResponse{}, res)
+	require.Equal(t, u.ID, actual.Id)
+}
+
+func Test_Login_resolves_username_case_insensitively(t *testing.T) {
+	username := "TeSt"
+	svr, engine, db, res := setupTestCase(t, true)
+	u, err := svr.createUser(
+		context.Background(), db.User.Create(),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	req, err := svr.post(
+		"/login",
+		LoginJSONRequestBody{Username: &username, Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, Login200JSONResponse{}, res)
+	require.Equal(t, u.ID, actual.Id)
+}
+
+func Test_Login_accepts_only_username_if_configured(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	svr.loginIdentifier = api.LoginIdentifierUsername
+	email := types.Email("test@sample.com")
+	_, err := svr.createUser(
+		context.Background(), db.User.Create(), CreateUserJSONBody{
+			Username: "test", Password: "Test_123", Email: &email,
+		},
+	)
+	require.Nil(t, err)
+	username := "test@sample.com"
+	req, err := svr.post(
+		"/login",
+		LoginJSONRequestBody{Username: &username, Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	res = httptest.NewRecorder()
+	req, err = svr.post(
+		"/login", LoginJSONRequestBody{Email: &email, Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusBadRequest,
+		"errors": msgInvalidIdentifier,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_Login_accepts_only_email_if_configured(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	svr.loginIdentifier = api.LoginIdentifierEmail
+	email := types.Email("test@sample.com")
+	_, err := svr.createUser(
+		context.Background(), db.User.Create(), CreateUserJSONBody{
+			Username: "test", Password: "Test_123", Email: &email,
+		},
+	)
+	require.Nil(t, err)
+	username := "test"
+	req, err := svr.post(
+		"/login",
+		LoginJSONRequestBody{Username: &username, Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	res = httptest.NewRecorder()
+	req, err = svr.post(
+		"/login", LoginJSONRequestBody{Email: &email, Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_Login_reports_400_if_no_identifier(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/login", LoginJSONRequestBody{Password: "Test_123"})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_dummyPasswordHash_never_matches(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	m, _, err := svr.verifyPassword("", svr.dummyHash.get())
+	require.Nil(t, err)
+	require.False(t, m)
+	require.Equal(t, svr.dummyHash.get(), svr.dummyHash.get())
+}
+
+// Tries to log in as the user, returns the response.
+func Test_Login_starts_session(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 2, "Test_123")
+	res := tryLogin(t, svr, engine, "user0", "Test_123")
+	require.Equal(t, http.StatusOK, res.Code)
+	ss := db.Session.Query().OnlyX(context.Background())
+	require.Equal(t, uint64(2), ss.UserID)
+	require.Equal(t, "192.0.2.1", ss.IP)
+}
+
+func tryLogin(
+	tb testing.TB, svr *Server, engine http.Handler, username, password string,
+) *httptest.ResponseRecorder {
+	req, err := svr.post(
+		"/login",
+		LoginJSONRequestBody{Username: &username, Password: password},
+	)
+	require.Nil(tb, err)
+	req.RemoteAddr = "192.0.2.1:1234"
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+// Logs in to the organization from a fixed address.
+func tryLoginTo(
+	tb testing.TB, svr *Server, engine http.Handler, organization uint32,
+	username, password string,
+) *httptest.ResponseRecorder {
+	req, err := svr.post(
+		"/login",
+		LoginJSONRequestBody{
+			Organization: &organization, Username: &username,
+			Password: password,
+		},
+	)
+	require.Nil(tb, err)
+	req.RemoteAddr = "192.0.2.1:1234"
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+func Test_Login_locks_out_user_after_too_many_failures(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	u, err := svr.createUser(
+		qc, db.User.Create(),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	for i := 0; i < svr.loginMaxAttempts; i++ {
+		res := tryLogin(t, svr, engine, "test", "wrong")
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+	res := tryLogin(t, svr, engine, "test", "Test_123")
+	require.Equal(t, http.StatusTooManyRequests, res.Code)
+	require.Equal(t, "300", res.Header().Get("Retry-After"))
+	expected := map[string]interface{}{
+		"code":   http.StatusTooManyRequests,
+		"errors": msgLockedOut,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditUserLocked)).OnlyX(qc)
+	require.Nil(t, log.ActorID)
+	require.Equal(t, float64(u.ID), log.Details["user_id"])
+}
+
+func Test_Login_locks_out_unknown_identifier_alike(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, err := svr.createUser(
+		context.Background(), db.User.Create(),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	for i := 0; i < svr.loginMaxAttempts; i++ {
+		res := tryLogin(t, svr, engine, "test", "wrong")
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+		// identifiers are matched case-insensitively, so are their failures
+		res = tryLogin(t, svr, engine, []string{"nobody", "NoBody"}[i%2], "x")
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+	known := tryLogin(t, svr, engine, "test", "wrong")
+	unknown := tryLogin(t, svr, engine, "nobody", "wrong")
+	require.Equal(t, http.StatusTooManyRequests, known.Code)
+	require.Equal(t, known.Code, unknown.Code)
+	require.Equal(
+		t, known.Header().Get("Retry-After"),
+		unknown.Header().Get("Retry-After"),
+	)
+	require.Equal(t, known.Body.String(), unknown.Body.String())
+	// other identifiers aren't affected
+	res := tryLogin(t, svr, engine, "someone", "wrong")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_Login_resets_failures_on_success(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, err := svr.createUser(
+		context.Background(), db.User.Create(),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	for i := 1; i < svr.loginMaxAttempts; i++ {
+		res := tryLogin(t, svr, engine, "test", "wrong")
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+	res := tryLogin(t, svr, engine, "test", "Test_123")
+	require.Equal(t, http.StatusOK, res.Code)
+	res = tryLogin(t, svr, engine, "test", "wrong")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	res = tryLogin(t, svr, engine, "test", "Test_123")
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_Login_locks_out_address_after_too_many_failures(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	_, err := svr.createUser(
+		qc, db.User.Create(),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	svr.loginIpMaxAttempts = 3
+	for i := 0; i < svr.loginIpMaxAttempts; i++ {
+		res := tryLogin(t, svr, engine, fmt.Sprintf("nobody%d", i), "wrong")
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+	res := tryLogin(t, svr, engine, "test", "Test_123")
+	require.Equal(t, http.StatusTooManyRequests, res.Code)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditLoginIpLocked)).OnlyX(qc)
+	require.Equal(t, uint32(api.DefaultOrganizationId), log.OrganizationID)
+	require.Equal(t, "192.0.2.1", log.Details["ip"])
+}
+
+func Test_Login_returns_500_if_attempt_store_error(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	svr.UseLoginAttemptStore(failingLoginAttemptStore{})
+	res := tryLogin(t, svr, engine, "test", "Test_123")
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+type failingLoginAttemptStore struct{}
+
+func (failingLoginAttemptStore) Fail(
+	context.Context, string, time.Duration,
+) (int, error) {
+	return 0, errors.New("store error")
+}
+
+func (failingLoginAttemptStore) Lock(context.Context, string, time.Time) error {
+	return errors.New("store error")
+}
+
+func (failingLoginAttemptStore) LockedUntil(
+	context.Context, string,
+) (time.Time, error) {
+	return time.Time{}, errors.New("store error")
+}
+
+func (failingLoginAttemptStore) Reset(context.Context, string) error {
+	return errors.New("store error")
+}
+
+func Test_Login_returns_challenge_if_totp_enabled(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u, err := svr.createUser(
+		context.Background(), db.User.Create(),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(t, err)
+	enableTotp(t, db, u.ID)
+	res := tryLogin(t, svr, engine, "test", "Test_123")
+	require.Equal(t, http.StatusAccepted, res.Code)
+	require.Empty(t, res.Result().Cookies())
+	actual := unmarshalResponse(t, Login202JSONResponse{}, res)
+	require.WithinDuration(
+		t, time.Now().Add(mfaChallengeTtl), actual.ExpiresAt, time.Minute,
+	)
+	// the challenge doesn't grant access by itself
+	req, err := http.NewRequest(http.MethodGet, "/users", nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+actual.ChallengeToken)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_Login_rehashes_legacy_password(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	// imported from a system storing bcrypt hashes
+	hash, err := testBcryptHasher.Hash("Test_123")
+	require.Nil(t, err)
+	db.User.UpdateOneID(1).SetPassword(hash).ExecX(context.Background())
+	res := tryLogin(t, svr, engine, "root", "Test_123")
+	require.Equal(t, http.StatusOK, res.Code)
+	rehashed := getUserById(t, db, 1).Password
+	require.True(t, svr.passwordHashers[0].Handles(rehashed))
+	m, rehash, err := svr.verifyPassword("Test_123", rehashed)
+	require.Nil(t, err)
+	require.True(t, m)
+	require.False(t, rehash)
+}
+
+func Test_Login_rehashes_password_with_old_parameters(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	hash, err := testArgon2idHasher.Hash("Test_123")
+	require.Nil(t, err)
+	db.User.UpdateOneID(1).SetPassword(hash).ExecX(context.Background())
+	current := testArgon2idHasher
+	current.Params.Times = 2
+	svr.UsePasswordHashers(current)
+	res := tryLogin(t, svr, engine, "root", "Test_123")
+	require.Equal(t, http.StatusOK, res.Code)
+	rehashed := getUserById(t, db, 1).Password
+	require.NotEqual(t, hash, rehashed)
+	require.False(t, current.NeedsRehash(rehashed))
+}
+
+func Test_Login_keeps_hash_if_password_wrong(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	hash, err := testBcryptHasher.Hash("Test_123")
+	require.Nil(t, err)
+	db.User.UpdateOneID(1).SetPassword(hash).ExecX(context.Background())
+	res := tryLogin(t, svr, engine, "root", "Test_456")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Equal(t, hash, getUserById(t, db, 1).Password)
+}
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// LoginAttemptStore keeps the counters of failed logins and the lockouts.
+// Keys identify either an account or a source address. Deployments running
+// several replicas must share one store among them.
+type LoginAttemptStore interface {
+	// Fail counts a failed login of the key and returns the number of
+	// failures so far. Failures are forgotten once no further failure occurs
+	// within `window`.
+	Fail(ctx context.Context, key string, window time.Duration) (int, error)
+	// Lock locks the key out until the given time.
+	Lock(ctx context.Context, key string, until time.Time) error
+	// LockedUntil returns the end of the lockout of the key, the zero time if
+	// the key isn't locked out.
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+	// Reset forgets the failures and the lockout of the key.
+	Reset(ctx context.Context, key string) error
+}
+
+// MemoryLoginAttemptStore is a LoginAttemptStore for single-node deployments.
+type MemoryLoginAttemptStore struct {
+	mu      sync.Mutex
+	entries map[string]*loginAttempts
+}
+
+type loginAttempts struct {
+	failures    int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+var _ LoginAttemptStore = (*MemoryLoginAttemptStore)(nil)
+
+func NewMemoryLoginAttemptStore() *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{entries: map[string]*loginAttempts{}}
+}
+
+func (m *MemoryLoginAttemptStore) Fail(
+	_ context.Context, key string, window time.Duration,
+) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	m.prune(now, window)
+	e, ok := m.entries[key]
+	if !ok {
+		e = &loginAttempts{}
+		m.entries[key] = e
+	}
+	e.failures++
+	e.lastFailure = now
+	return e.failures, nil
+}
+
+func (m *MemoryLoginAttemptStore) Lock(
+	_ context.Context, key string, until time.Time,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok {
+		e = &loginAttempts{lastFailure: time.Now()}
+		m.entries[key] = e
+	}
+	e.lockedUntil = until
+	return nil
+}
+
+func (m *MemoryLoginAttemptStore) LockedUntil(
+	_ context.Context, key string,
+) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok || !e.lockedUntil.After(time.Now()) {
+		return time.Time{}, nil
+	}
+	return e.lockedUntil, nil
+}
+
+func (m *MemoryLoginAttemptStore) Reset(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+	return nil
+}
+
+// Drops entries neither locked out nor failed within the window, so that
+// addresses trying once don't pile up.
+func (m *MemoryLoginAttemptStore) prune(now time.Time, window time.Duration) {
+	for key, e := range m.entries {
+		if now.Sub(e.lastFailure) > window && !e.lockedUntil.After(now) {
+			delete(m.entries, key)
+		}
+	}
+}
+
+// UseLoginAttemptStore replaces the in-memory store of failed logins, e.g.
+// with one shared by all replicas.
+func (s *Server) UseLoginAttemptStore(store LoginAttemptStore) {
+	s.loginAttempts = store
+}
+
+func userAttemptKey(id uint64) string {
+	return "user:" + strconv.FormatUint(id, 10)
+}
+
+func ipAttemptKey(ip string) string {
+	return "ip:" + ip
+}
+
+// Keys failed logins of identifiers without local user, so that they are
+// locked out the same as existing accounts, and can't be told from them by
+// the lockout. Identifiers are matched case-insensitively, same as users.
+func identifierAttemptKey(organization uint32, identifier string) string {
+	return "id:" + strconv.FormatUint(uint64(organization), 10) + ":" +
+		strings.ToLower(strings.TrimSpace(identifier))
+}
+
+// Returns the remaining lockout of the key, zero if it isn't locked out.
+func (s Server) loginLockedOut(qc context.Context, key string) (
+	time.Duration, error,
+) {
+	until, err := s.loginAttempts.LockedUntil(qc, key)
+	if err != nil || until.IsZero() {
+		return 0, err
+	}
+	return time.Until(until), nil
+}
+
+// Counts a failed login of the key and locks it out once `limit` failures
+// accrued. Each further failure doubles the lockout, up to 64 times of
+// `s.loginLockout`. Returns the end of the lockout, the zero time if the key
+// isn't locked out.
+func (s Server) countLoginFailure(
+	qc context.Context, key string, limit int,
+) (time.Time, error) {
+	if limit < 1 {
+		return time.Time{}, nil
+	}
+	failures, err := s.loginAttempts.Fail(qc, key, s.loginAttemptWindow)
+	if err != nil || failures < limit {
+		return time.Time{}, err
+	}
+	until := time.Now().Add(s.loginLockout << min(failures-limit, 6))
+	return until, s.loginAttempts.Lock(qc, key, until)
+}
+
+// Counts a failed login from the source address, and of the user if the
+// account exists. Failures of unknown identifiers are counted by
+// unknownLoginFailed instead. Locked out users are set locked. Lockouts are
+// recorded in the audit log, address lockouts in the default organization as
+// they span organizations.
+func (s Server) loginFailed(qc context.Context, ip string, u *ent.User) error {
+	until, err := s.countLoginFailure(
+		qc, ipAttemptKey(ip), s.loginIpMaxAttempts,
+	)
+	if err != nil {
+		return err
+	}
+	if !until.IsZero() {
+		err = s.recordLockout(
+			qc, api.AuditLoginIpLocked, api.DefaultOrganizationId,
+			map[string]interface{}{"ip": ip, "locked_until": until},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if nil == u {
+		return nil
+	}
+	until, err = s.countLoginFailure(
+		qc, userAttemptKey(u.ID), s.loginMaxAttempts,
+	)
+	if err != nil || until.IsZero() {
+		return err
+	}
+	err = s.db.User.Update().
+		Where(user.IDEQ(u.ID), user.StatusEQ(user.StatusActive)).
+		SetStatus(user.StatusLocked).Exec(qc)
+	if err != nil {
+		return err
+	}
+	return s.recordLockout(
+		qc, api.AuditUserLocked, u.OrganizationID,
+		map[string]interface{}{
+			"user_id":      u.ID,
+			"username":     u.Username,
+			"ip":           ip,
+			"locked_until": until,
+		},
+	)
+}
+
+// Counts a failed login from the source address of the identifier without
+// local user, which is locked out after as many failures as users are.
+// Lockouts of unknown identifiers aren't audited, there's no account to
+// record them against.
+func (s Server) unknownLoginFailed(
+	qc context.Context, ip string, organization uint32, identifier string,
+) error {
+	if err := s.loginFailed(qc, ip, nil); err != nil {
+		return err
+	}
+	_, err := s.countLoginFailure(
+		qc, identifierAttemptKey(organization, identifier), s.loginMaxAttempts,
+	)
+	return err
+}
+
+func (s Server) recordLockout(
+	qc context.Context, action string, organization uint32,
+	details map[string]interface{},
+) error {
+	_, err := s.db.Transaction(
+		qc, func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			return nil, recordAudit(qc, tx, action, organization, nil, details)
+		},
+	)
+	return err
+}
+
+// Returns the lockout in whole seconds for the `Retry-After` header.
+func retryAfter(wait time.Duration) int {
+	return int(math.Ceil(wait.Seconds()))
+}
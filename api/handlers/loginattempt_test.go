@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MemoryLoginAttemptStore_counts_failures(t *testing.T) {
+	qc := context.Background()
+	store := NewMemoryLoginAttemptStore()
+	for i := 1; i <= 3; i++ {
+		n, err := store.Fail(qc, "user:2", time.Hour)
+		require.Nil(t, err)
+		require.Equal(t, i, n)
+	}
+	n, err := store.Fail(qc, "user:3", time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, 1, n)
+}
+
+func Test_MemoryLoginAttemptStore_forgets_failures_out_of_window(t *testing.T) {
+	qc := context.Background()
+	store := NewMemoryLoginAttemptStore()
+	_, err := store.Fail(qc, "user:2", time.Hour)
+	require.Nil(t, err)
+	store.entries["user:2"].lastFailure = time.Now().Add(-2 * time.Hour)
+	n, err := store.Fail(qc, "user:2", time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, 1, n)
+}
+
+func Test_MemoryLoginAttemptStore_keeps_locked_keys(t *testing.T) {
+	qc := context.Background()
+	store := NewMemoryLoginAttemptStore()
+	_, err := store.Fail(qc, "ip:1", time.Hour)
+	require.Nil(t, err)
+	_, err = store.Fail(qc, "ip:2", time.Hour)
+	require.Nil(t, err)
+	until := time.Now().Add(time.Hour)
+	require.Nil(t, store.Lock(qc, "ip:2", until))
+	store.entries["ip:1"].lastFailure = time.Now().Add(-2 * time.Hour)
+	store.entries["ip:2"].lastFailure = time.Now().Add(-2 * time.Hour)
+	_, err = store.Fail(qc, "ip:3", time.Hour)
+	require.Nil(t, err)
+	require.NotContains(t, store.entries, "ip:1")
+	require.Contains(t, store.entries, "ip:2")
+	actual, err := store.LockedUntil(qc, "ip:2")
+	require.Nil(t, err)
+	require.Equal(t, until, actual)
+}
+
+func Test_MemoryLoginAttemptStore_lock_expires(t *testing.T) {
+	qc := context.Background()
+	store := NewMemoryLoginAttemptStore()
+	require.Nil(t, store.Lock(qc, "user:2", time.Now().Add(-time.Second)))
+	actual, err := store.LockedUntil(qc, "user:2")
+	require.Nil(t, err)
+	require.True(t, actual.IsZero())
+	actual, err = store.LockedUntil(qc, "user:3")
+	require.Nil(t, err)
+	require.True(t, actual.IsZero())
+}
+
+func Test_MemoryLoginAttemptStore_reset_clears_key(t *testing.T) {
+	qc := context.Background()
+	store := NewMemoryLoginAttemptStore()
+	_, err := store.Fail(qc, "user:2", time.Hour)
+	require.Nil(t, err)
+	require.Nil(t, store.Lock(qc, "user:2", time.Now().Add(time.Hour)))
+	require.Nil(t, store.Reset(qc, "user:2"))
+	actual, err := store.LockedUntil(qc, "user:2")
+	require.Nil(t, err)
+	require.True(t, actual.IsZero())
+	n, err := store.Fail(qc, "user:2", time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, 1, n)
+}
+
+func Test_countLoginFailure_doubles_lockout(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	qc := context.Background()
+	svr.loginLockout = time.Minute
+	for i := 0; i < 2; i++ {
+		until, err := svr.countLoginFailure(qc, "user:2", 3)
+		require.Nil(t, err)
+		require.True(t, until.IsZero())
+	}
+	until, err := svr.countLoginFailure(qc, "user:2", 3)
+	require.Nil(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Minute), until, time.Second)
+	until, err = svr.countLoginFailure(qc, "user:2", 3)
+	require.Nil(t, err)
+	require.WithinDuration(
+		t, time.Now().Add(2*time.Minute), until, time.Second,
+	)
+	for i := 0; i < 10; i++ {
+		until, err = svr.countLoginFailure(qc, "user:2", 3)
+		require.Nil(t, err)
+	}
+	require.WithinDuration(
+		t, time.Now().Add(64*time.Minute), until, time.Second,
+	)
+}
+
+func Test_countLoginFailure_disabled_if_no_limit(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	qc := context.Background()
+	for i := 0; i < 10; i++ {
+		until, err := svr.countLoginFailure(qc, "user:2", 0)
+		require.Nil(t, err)
+		require.True(t, until.IsZero())
+	}
+}
+
+func Test_retryAfter_rounds_up(t *testing.T) {
+	require.Equal(t, 1, retryAfter(time.Millisecond))
+	require.Equal(t, 60, retryAfter(time.Minute))
+}
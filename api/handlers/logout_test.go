@@ -121,3 +121,13 @@ func Test_Logout_returns_500_if_db_error_unhandled(t *testing.T) {
 	engine.ServeHTTP(res, req)
 	require.Equal(t, http.StatusInternalServerError, res.Code)
 }
+
+func Test_Logout_forgets_session(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	at, rt := startTestSession(t, svr, getUserById(t, db, 1), "test agent")
+	req, err := http.NewRequest(http.MethodPost, "/logout", nil)
+	require.Nil(t, err)
+	res := serveWithCookies(engine, req, at, rt)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Zero(t, db.Session.Query().CountX(context.Background()))
+}
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// How long the delivery of one email may take.
+const mailTimeout = 30 * time.Second
+
+// Mail is a plain text email.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers emails. Implementations must be safe for concurrent use.
+type Mailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
+
+// LogMailer writes emails to the log instead of delivering them. It is meant
+// for development only, as the log then contains secrets such as password
+// reset tokens.
+type LogMailer struct{}
+
+var _ Mailer = LogMailer{}
+
+func (LogMailer) Send(_ context.Context, mail Mail) error {
+	api.Log.Infof(
+		"mail to %s, subject: %s\n%s", mail.To, mail.Subject, mail.Body,
+	)
+	return nil
+}
+
+// SmtpMailer delivers emails through an SMTP server. STARTTLS is used if the
+// server offers it, and credentials are only sent over TLS.
+type SmtpMailer struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+}
+
+var _ Mailer = (*SmtpMailer)(nil)
+
+// NewSmtpMailer returns a mailer sending emails from `from` through the SMTP
+// server at `host:port`. Authentication is skipped if `username` is empty.
+func NewSmtpMailer(
+	host string, port int, username, password, from string,
+) (*SmtpMailer, error) {
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", from, err)
+	}
+	return &SmtpMailer{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}, nil
+}
+
+func (m *SmtpMailer) Send(ctx context.Context, msg Mail) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return err
+	}
+	data, err := msg.bytes(from, to)
+	if err != nil {
+		return err
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(
+		ctx, "tcp", net.JoinHostPort(m.host, strconv.Itoa(m.port)),
+	)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			_ = conn.Close()
+			return err
+		}
+	}
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err = c.StartTLS(&tls.Config{ServerName: m.host})
+		if err != nil {
+			return err
+		}
+	}
+	if "" != m.username {
+		// smtp.PlainAuth refuses to send credentials in clear text, except
+		// to localhost
+		err = c.Auth(smtp.PlainAuth("", m.username, m.password, m.host))
+		if err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(from.Address); err != nil {
+		return err
+	}
+	if err = c.Rcpt(to.Address); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(data); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// Composes the message with its headers, lines are terminated by CRLF.
+func (m Mail) bytes(from, to *mail.Address) ([]byte, error) {
+	if strings.ContainsAny(m.Subject, "\r\n") {
+		return nil, errors.New("mail subject must be a single line")
+	}
+	var b bytes.Buffer
+	header := func(name, value string) {
+		b.WriteString(name + ": " + value + "\r\n")
+	}
+	header("From", from.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "8bit")
+	b.WriteString("\r\n")
+	body := strings.ReplaceAll(m.Body, "\r\n", "\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return b.Bytes(), nil
+}
+
+// UseMailer replaces the mailer of the server.
+func (s *Server) UseMailer(m Mailer) {
+	s.mailer = m
+}
+
+// Delivers the email in background, so that the response time doesn't depend
+// on the mail server. Failures are only logged.
+func (s Server) sendMail(m Mail) {
+	mailer := s.mailer
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), mailTimeout)
+		defer cancel()
+		if err := mailer.Send(ctx, m); err != nil {
+			api.Log.Errorf("failed to send mail to %s: %v", m.To, err)
+		}
+	}()
+}
@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Captures the emails sent by the server.
+type mailRecorder struct {
+	mails chan Mail
+}
+
+func (m *mailRecorder) Send(_ context.Context, mail Mail) error {
+	m.mails <- mail
+	return nil
+}
+
+// Replaces the mailer of the server with a recorder.
+func recordMails(svr *Server) *mailRecorder {
+	m := &mailRecorder{mails: make(chan Mail, 10)}
+	svr.UseMailer(m)
+	return m
+}
+
+// Waits for the next email sent by the server.
+func (m *mailRecorder) next(tb testing.TB) Mail {
+	select {
+	case mail := <-m.mails:
+		return mail
+	case <-time.After(5 * time.Second):
+		require.FailNow(tb, "no mail sent")
+	}
+	return Mail{}
+}
+
+// Asserts that no email is sent.
+func (m *mailRecorder) none(tb testing.TB) {
+	select {
+	case mail := <-m.mails:
+		require.FailNow(tb, "unexpected mail", "to %s", mail.To)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// Transaction received by fakeSmtpServer.
+type smtpTransaction struct {
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// A minimal SMTP server, which accepts everything and records what it got.
+type fakeSmtpServer struct {
+	listener     net.Listener
+	transactions chan smtpTransaction
+}
+
+func newFakeSmtpServer(tb testing.TB) *fakeSmtpServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(tb, err)
+	s := &fakeSmtpServer{
+		listener:     l,
+		transactions: make(chan smtpTransaction, 10),
+	}
+	tb.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSmtpServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSmtpServer) serve(conn net.Conn) {
+	defer conn.Close()
+	c := textproto.NewConn(conn)
+	var tx smtpTransaction
+	reply := func(line string) { _ = c.PrintfLine("%s", line) }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(cmd) {
+		case "EHLO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			_, cred, _ := strings.Cut(arg, " ")
+			b, _ := base64.StdEncoding.DecodeString(cred)
+			tx.auth = string(b)
+			reply("235 authenticated")
+		case "MAIL":
+			tx.from = arg
+			reply("250 ok")
+		case "RCPT":
+			tx.to = append(tx.to, arg)
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			tx.data = string(data)
+			s.transactions <- tx
+			tx = smtpTransaction{}
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func (s *fakeSmtpServer) next(tb testing.TB) smtpTransaction {
+	select {
+	case tx := <-s.transactions:
+		return tx
+	case <-time.After(5 * time.Second):
+		require.FailNow(tb, "no mail received")
+	}
+	return smtpTransaction{}
+}
+
+func Test_SmtpMailer_delivers_mail(t *testing.T) {
+	srv := newFakeSmtpServer(t)
+	mailer, err := NewSmtpMailer(
+		"127.0.0.1", srv.port(), "", "", "Admin <noreply@example.com>",
+	)
+	require.Nil(t, err)
+	err = mailer.Send(
+		context.Background(),
+		Mail{
+			To:      "user@example.com",
+			Subject: "Hello",
+			Body:    "line 1\nline 2\n.\n",
+		},
+	)
+	require.Nil(t, err)
+	tx := srv.next(t)
+	require.Equal(t, "", tx.auth)
+	require.Equal(t, "FROM:<noreply@example.com>", tx.from)
+	require.Equal(t, []string{"TO:<user@example.com>"}, tx.to)
+	msg, err := textproto.NewReader(
+		bufio.NewReader(strings.NewReader(tx.data)),
+	).ReadMIMEHeader()
+	require.Nil(t, err)
+	require.Equal(t, `"Admin" <noreply@example.com>`, msg.Get("From"))
+	require.Equal(t, "<user@example.com>", msg.Get("To"))
+	require.Equal(t, "Hello", msg.Get("Subject"))
+	require.NotEmpty(t, msg.Get("Date"))
+	_, body, _ := strings.Cut(tx.data, "\n\n")
+	require.Equal(t, "line 1\nline 2\n.\n", body)
+}
+
+func Test_SmtpMailer_authenticates(t *testing.T) {
+	srv := newFakeSmtpServer(t)
+	mailer, err := NewSmtpMailer(
+		"127.0.0.1", srv.port(), "user", "secret", "noreply@example.com",
+	)
+	require.Nil(t, err)
+	err = mailer.Send(
+		context.Background(),
+		Mail{To: "user@example.com", Subject: "Hello", Body: "hi"},
+	)
+	require.Nil(t, err)
+	require.Equal(t, "\x00user\x00secret", srv.next(t).auth)
+}
+
+func Test_SmtpMailer_rejects_header_injection(t *testing.T) {
+	srv := newFakeSmtpServer(t)
+	mailer, err := NewSmtpMailer(
+		"127.0.0.1", srv.port(), "", "", "noreply@example.com",
+	)
+	require.Nil(t, err)
+	err = mailer.Send(
+		context.Background(),
+		Mail{
+			To:      "user@example.com\r\nBcc: other@example.com",
+			Subject: "Hello",
+		},
+	)
+	require.NotNil(t, err)
+	err = mailer.Send(
+		context.Background(),
+		Mail{
+			To:      "user@example.com",
+			Subject: "Hello\r\nBcc: other@example.com",
+		},
+	)
+	require.NotNil(t, err)
+}
+
+func Test_SmtpMailer_reports_connection_error(t *testing.T) {
+	srv := newFakeSmtpServer(t)
+	port := srv.port()
+	require.Nil(t, srv.listener.Close())
+	mailer, err := NewSmtpMailer(
+		"127.0.0.1", port, "", "", "noreply@example.com",
+	)
+	require.Nil(t, err)
+	err = mailer.Send(
+		context.Background(),
+		Mail{To: "user@example.com", Subject: "Hello", Body: "hi"},
+	)
+	require.NotNil(t, err)
+}
+
+func Test_NewSmtpMailer_rejects_invalid_sender(t *testing.T) {
+	_, err := NewSmtpMailer("127.0.0.1", 25, "", "", "noreply")
+	require.NotNil(t, err)
+}
+
+func Test_LogMailer_never_fails(t *testing.T) {
+	err := LogMailer{}.Send(
+		context.Background(),
+		Mail{To: "user@example.com", Subject: "Hello", Body: "hi"},
+	)
+	require.Nil(t, err)
+}
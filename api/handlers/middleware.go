@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"slices"
 	"strings"
@@ -9,7 +10,12 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
 	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+	"github.com/eidng8/go-attr-rbac/rbac"
 )
 
 // Authorizes the request and stores token's user with roles to
@@ -22,6 +28,13 @@ func (s Server) authMiddleware() StrictMiddlewareFunc {
 				// pass-through public paths
 				return f(gc, request)
 			}
+			if isScimOperation(operationID) {
+				if err := s.handleScimAuth(gc); err != nil {
+					gc.AbortWithStatus(http.StatusUnauthorized)
+					return nil, err
+				}
+				return f(gc, request)
+			}
 			var err error
 			var token *jwtToken
 			method, st, err := authHeader(gc)
@@ -40,8 +53,30 @@ func (s Server) authMiddleware() StrictMiddlewareFunc {
 					return nil, err
 				}
 			}
-			if err = loadRoles(token.user); err != nil {
-				gc.AbortWithStatus(http.StatusForbidden)
+			// checking the token has loaded the user's roles already
+			err = checkMustChangePassword(token.user, operationID)
+			if err != nil {
+				gc.AbortWithStatusJSON(
+					http.StatusForbidden, N403JSONResponse{
+						Code:   http.StatusForbidden,
+						Errors: &msgPasswordChangeRequired,
+						Status: msgError,
+					},
+				)
+				return nil, err
+			}
+			if err = s.checkMfaEnrollment(token.user, operationID); err != nil {
+				if errors.Is(err, errMfaRequired) {
+					gc.AbortWithStatusJSON(
+						http.StatusForbidden, N403JSONResponse{
+							Code:   http.StatusForbidden,
+							Errors: &msgMfaRequired,
+							Status: msgError,
+						},
+					)
+				} else {
+					gc.AbortWithStatus(http.StatusForbidden)
+				}
 				return nil, err
 			}
 			if err = s.operationAllowed(token.user, operationID); err != nil {
@@ -108,10 +143,7 @@ func (s Server) handleTokenAuth(token string) (*jwtToken, error) {
 // Checks if the operation has domain qualifier and add the default "auth:"
 // qualifier if not.
 func qualifyOperation(operation string) string {
-	if !strings.Contains(operation, ":") {
-		return "auth:" + operation
-	}
-	return operation
+	return rbac.QualifyOperation(rbac.AuthDomain, operation)
 }
 
 func authHeader(gc *gin.Context) (string, string, error) {
@@ -135,14 +167,31 @@ func authHeader(gc *gin.Context) (string, string, error) {
 	return method, token, nil
 }
 
-// Loads roles for the user and stores them in `Edges.Roles`.
+// Loads effective roles for the user and stores them in `Edges.Roles`.
 // TODO add user role caching
-func loadRoles(u *ent.User) error {
-	roles, err := u.QueryRoles().Select(role.FieldID, role.FieldName).
-		Order(role.ByID()).All(context.Background())
+func (s Server) loadRoles(u *ent.User) error {
+	roles, err := queryEffectiveRoles(s.db.Role, u.ID).
+		Select(role.FieldID, role.FieldName).All(context.Background())
 	if err != nil {
 		return err
 	}
 	u.Edges.Roles = roles
 	return nil
 }
+
+// Returns the query of the user's effective roles ordered by ID. Tokens and
+// the `user.roles_changed` event are both built from it, so that subscribers
+// see the roles the user's tokens carry.
+func queryEffectiveRoles(c *ent.RoleClient, id uint64) *ent.RoleQuery {
+	return c.Query().Where(effectiveRoles(id)).Order(role.ByID())
+}
+
+// Returns the predicate matching effective roles of the user, which are the
+// union of roles currently assigned to the user directly and roles of the
+// user's groups.
+func effectiveRoles(id uint64) predicate.Role {
+	return role.Or(
+		role.HasAssignmentsWith(userrole.UserIDEQ(id), activeAssignment()),
+		role.HasGroupsWith(group.HasUsersWith(user.IDEQ(id))),
+	)
+}
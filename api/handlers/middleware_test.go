@@ -1,10 +1,15 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/eidng8/go-utils"
 	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/permission"
 )
 
 // this test is here for future change on auth & validation middleware, if it
@@ -24,3 +29,33 @@ func Test_authMiddleware_runs_after_request_validation(t *testing.T) {
 	engine.ServeHTTP(res, req)
 	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
 }
+
+func Test_authMiddleware_allows_operation_granted_by_group_role(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	db.Role.UpdateOneID(5).AddPermissions(
+		db.Permission.Query().Where(permission.NameEQ("auth:ListUser")).
+			OnlyX(qc),
+	).ExecX(qc)
+	u := getUserById(t, db, 3)
+	req, err := svr.getAs(u, "/users")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	seedGroup(t, db, []uint64{3}, []uint32{5})
+	res = httptest.NewRecorder()
+	req, err = svr.getAs(u, "/users")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_loadRoles_loads_direct_and_group_roles(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	seedGroup(t, db, []uint64{2}, []uint32{4, 5, 6})
+	u := getUserById(t, db, 2)
+	require.Nil(t, svr.loadRoles(u))
+	require.Equal(
+		t, []uint32{2, 3, 4, 5, 6}, utils.Pluck(u.Edges.Roles, pluckRoleId),
+	)
+}
@@ -0,0 +1,333 @@
+package handlers
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+)
+
+// How long the client has to exchange an authorization code.
+const oauthCodeTtl = time.Minute
+
+// How long client assertions can be valid. Assertions aren't recorded, so the
+// short lifetime limits their replay.
+const oauthAssertionMaxTtl = 5 * time.Minute
+
+// Scopes clients can request. Access tokens carry the roles and attributes of
+// the user regardless of the scopes, which only select the claims of ID tokens
+// and the userinfo endpoint.
+var oauthScopes = []string{"openid", "profile", "email"}
+
+// OAuth 2.0 error codes of RFC 6749 and OpenID Connect.
+const (
+	oauthInvalidRequest          = "invalid_request"
+	oauthInvalidClient           = "invalid_client"
+	oauthInvalidGrant            = "invalid_grant"
+	oauthInvalidScope            = "invalid_scope"
+	oauthUnauthorizedClient      = "unauthorized_client"
+	oauthUnsupportedGrantType    = "unsupported_grant_type"
+	oauthUnsupportedResponseType = "unsupported_response_type"
+	oauthAccessDenied            = "access_denied"
+	oauthLoginRequired           = "login_required"
+)
+
+// Claims of ID tokens.
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Email             string `json:"email,omitempty"`
+}
+
+// Signs ID tokens when OAUTH_SIGNING_KEY isn't set. It is shared by the
+// servers of the process, so that restarting the server in tests doesn't
+// generate another key.
+var ephemeralOauthKey = sync.OnceValues(
+	func() (*rsa.PrivateKey, error) {
+		api.Log.Infof(
+			"%s is not set, ID tokens are signed with a generated key",
+			api.OauthSigningKeyName,
+		)
+		return rsa.GenerateKey(rand.Reader, 2048)
+	},
+)
+
+// Returns the key signing ID tokens.
+func (s Server) oauthKey() (*rsa.PrivateKey, error) {
+	if nil != s.oauthSigningKey {
+		return s.oauthSigningKey, nil
+	}
+	return ephemeralOauthKey()
+}
+
+// Returns the RFC 7638 thumbprint of the public key, used as its key ID.
+func oauthKeyId(key *rsa.PublicKey) string {
+	sum := sha256.Sum256(
+		[]byte(
+			fmt.Sprintf(
+				`{"e":"%s","kty":"RSA","n":"%s"}`,
+				base64.RawURLEncoding.EncodeToString(
+					big.NewInt(int64(key.E)).Bytes(),
+				),
+				base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			),
+		),
+	)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Returns the issuer of ID tokens, which is the base URL.
+func (s Server) oauthIssuer() string {
+	return strings.TrimSuffix(s.baseUrl, "/")
+}
+
+// Returns the SHA-256 hash of the client secret, as stored.
+func hashClientSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Authenticates the client of the token request, with HTTP basic
+// authentication or the form parameters. Public clients only send their ID.
+// Returns errInvalidCredential if the client is unknown or the secret is
+// wrong.
+func (s Server) authenticateOauthClient(
+	qc context.Context, gc *gin.Context, body *OauthTokenFormdataRequestBody,
+) (*ent.OauthClient, error) {
+	id, secret, basic := gc.Request.BasicAuth()
+	if nil != body.ClientAssertionType || nil != body.ClientAssertion {
+		// clients must use only one authentication method
+		if basic || nil != body.ClientSecret {
+			return nil, errInvalidCredential
+		}
+		return s.authenticateOauthAssertion(qc, body)
+	}
+	if basic {
+		// RFC 6749 2.3.1 form-encodes the credentials before encoding them
+		var err error
+		if id, err = url.QueryUnescape(id); err != nil {
+			return nil, errInvalidCredential
+		}
+		if secret, err = url.QueryUnescape(secret); err != nil {
+			return nil, errInvalidCredential
+		}
+	} else {
+		id = deref(body.ClientId)
+		secret = deref(body.ClientSecret)
+	}
+	if "" == id {
+		return nil, errInvalidCredential
+	}
+	client, err := s.db.OauthClient.Query().
+		Where(oauthclient.ClientIDEQ(id)).Only(qc)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errInvalidCredential
+		}
+		return nil, err
+	}
+	if client.Public {
+		if "" != secret {
+			return nil, errInvalidCredential
+		}
+		return client, nil
+	}
+	if nil == client.Secret || !hmac.Equal(
+		[]byte(hashClientSecret(secret)), []byte(*client.Secret),
+	) {
+		return nil, errInvalidCredential
+	}
+	return client, nil
+}
+
+// Authenticates the client with the RFC 7523 JWT assertion, signed with the
+// key of the client. Its issuer and subject must be the client ID, and its
+// audience the token endpoint or the issuer. Returns errInvalidCredential if
+// the assertion isn't valid.
+func (s Server) authenticateOauthAssertion(
+	qc context.Context, body *OauthTokenFormdataRequestBody,
+) (*ent.OauthClient, error) {
+	if api.OauthClientAssertionJwtBearer != deref(body.ClientAssertionType) {
+		return nil, errInvalidCredential
+	}
+	var client *ent.OauthClient
+	var dbErr error
+	claims := jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(
+		deref(body.ClientAssertion), &claims,
+		func(t *jwt.Token) (interface{}, error) {
+			id := claims.Subject
+			if "" == id || claims.Issuer != id ||
+				(nil != body.ClientId && *body.ClientId != id) {
+				return nil, errInvalidCredential
+			}
+			c, err := s.db.OauthClient.Query().
+				Where(oauthclient.ClientIDEQ(id)).Only(qc)
+			if err != nil {
+				if !ent.IsNotFound(err) {
+					dbErr = err
+				}
+				return nil, err
+			}
+			if c.Public || nil == c.PublicKey {
+				return nil, errInvalidCredential
+			}
+			key, err := parseOauthPublicKey(*c.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+			// the algorithm must suit the key, or HS256 assertions would be
+			// verified with the public key as secret
+			switch key.(type) {
+			case *rsa.PublicKey:
+				_, rs := t.Method.(*jwt.SigningMethodRSA)
+				_, ps := t.Method.(*jwt.SigningMethodRSAPSS)
+				if !rs && !ps {
+					return nil, errInvalidCredential
+				}
+			case *ecdsa.PublicKey:
+				if _, ok := t.Method.(*jwt.SigningMethodECDSA); !ok {
+					return nil, errInvalidCredential
+				}
+			}
+			client = c
+			return key, nil
+		},
+		jwt.WithExpirationRequired(),
+	)
+	if nil != dbErr {
+		return nil, dbErr
+	}
+	if err != nil {
+		return nil, errInvalidCredential
+	}
+	endpoint := s.oauthIssuer() + "/oauth/token"
+	if !slices.ContainsFunc(
+		claims.Audience, func(aud string) bool {
+			return endpoint == aud || s.oauthIssuer() == aud
+		},
+	) || time.Until(claims.ExpiresAt.Time) > oauthAssertionMaxTtl {
+		return nil, errInvalidCredential
+	}
+	return client, nil
+}
+
+// Parses the PEM encoded RSA or EC public key of the client.
+func parseOauthPublicKey(key string) (crypto.PublicKey, error) {
+	rk, err := jwt.ParseRSAPublicKeyFromPEM([]byte(key))
+	if nil == err {
+		return rk, nil
+	}
+	return jwt.ParseECPublicKeyFromPEM([]byte(key))
+}
+
+// Splits the space separated scopes, returns false if any isn't supported.
+func parseOauthScopes(scope string) ([]string, bool) {
+	scopes := strings.Fields(scope)
+	for _, sc := range scopes {
+		if !slices.Contains(oauthScopes, sc) {
+			return nil, false
+		}
+	}
+	slices.Sort(scopes)
+	return slices.Compact(scopes), true
+}
+
+// Checks the PKCE code verifier against the S256 challenge.
+func verifyCodeChallenge(verifier, challenge string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	return hmac.Equal(
+		[]byte(base64.RawURLEncoding.EncodeToString(sum[:])),
+		[]byte(challenge),
+	)
+}
+
+// Issues a token of the client for the user. It is built like the other
+// tokens, so it carries the roles and attributes of the user, and is accepted
+// as bearer token by the API.
+func (s Server) issueOauthToken(
+	user *ent.User, client *ent.OauthClient, scopes []string,
+	ttl time.Duration,
+) (string, error) {
+	_, claims, err := s.buildTokenClaims(user, ttl)
+	if err != nil {
+		return "", err
+	}
+	claims.ClientId = client.ClientID
+	if len(scopes) > 0 {
+		claims.Scopes = &scopes
+	}
+	return s.issueJwtTokenWithClaims(jwt.SigningMethodHS256, claims)
+}
+
+// Issues the ID token of the user to the client, with the claims of the
+// scopes. Signed with the RSA key, so clients can verify it with the JWKS.
+func (s Server) issueIdToken(
+	user *ent.User, client *ent.OauthClient, scopes []string, nonce string,
+) (string, error) {
+	key, err := s.oauthKey()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	claims := idTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.oauthIssuer(),
+			Subject:   tokenSubject(user),
+			Audience:  []string{client.ClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		Nonce: nonce,
+	}
+	if slices.Contains(scopes, "profile") {
+		claims.PreferredUsername = user.Username
+	}
+	if slices.Contains(scopes, "email") && nil != user.Email {
+		claims.Email = *user.Email
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	t.Header["kid"] = oauthKeyId(&key.PublicKey)
+	return t.SignedString(key)
+}
+
+// Appends the parameters to the query of the redirect URI, keeping its own.
+func oauthRedirectUrl(uri string, params url.Values) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	q := u.Query()
+	for k, v := range params {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func deref(s *string) string {
+	if nil == s {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+const (
+	testOauthRedirectUri = "https://app.example.com/callback"
+	testOauthVerifier    = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFG"
+)
+
+// Returns the S256 challenge of testOauthVerifier.
+func testOauthChallenge() string {
+	sum := sha256.Sum256([]byte(testOauthVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Returns the authorization request of the client with PKCE, the params
+// override the defaults, nil values remove them.
+func oauthAuthorizeUrl(client *ent.OauthClient, params url.Values) string {
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {client.ClientID},
+		"redirect_uri":          {testOauthRedirectUri},
+		"scope":                 {"openid profile email"},
+		"state":                 {"xyz"},
+		"nonce":                 {"n-0S6"},
+		"code_challenge":        {testOauthChallenge()},
+		"code_challenge_method": {"S256"},
+	}
+	for k, v := range params {
+		if nil == v {
+			q.Del(k)
+		} else {
+			q[k] = v
+		}
+	}
+	return "/oauth/authorize?" + q.Encode()
+}
+
+// Authorizes the client as the user, returns the query of the redirect.
+func tryOauthAuthorize(
+	tb testing.TB, svr *Server, engine http.Handler, usr *ent.User,
+	client *ent.OauthClient, params url.Values,
+) url.Values {
+	req, err := svr.getAs(usr, oauthAuthorizeUrl(client, params))
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(tb, http.StatusFound, res.Code)
+	u, err := url.Parse(res.Header().Get("Location"))
+	require.Nil(tb, err)
+	require.Equal(tb, testOauthRedirectUri, u.Scheme+"://"+u.Host+u.Path)
+	return u.Query()
+}
+
+// Posts the form to the token endpoint, authenticating with HTTP basic
+// authentication if the secret isn't empty.
+func tryOauthToken(
+	tb testing.TB, engine http.Handler, client *ent.OauthClient, secret string,
+	form url.Values,
+) *httptest.ResponseRecorder {
+	if "" == secret {
+		form.Set("client_id", client.ClientID)
+	}
+	req, err := http.NewRequest(
+		http.MethodPost, "/oauth/token", strings.NewReader(form.Encode()),
+	)
+	require.Nil(tb, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if "" != secret {
+		req.SetBasicAuth(client.ClientID, secret)
+	}
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+// Authorizes the client as the user and exchanges the code for tokens.
+func oauthLogin(
+	tb testing.TB, svr *Server, engine http.Handler, usr *ent.User,
+	client *ent.OauthClient, secret string,
+) OauthToken200JSONResponse {
+	q := tryOauthAuthorize(tb, svr, engine, usr, client, nil)
+	res := tryOauthToken(
+		tb, engine, client, secret, url.Values{
+			"grant_type":    {api.OauthGrantAuthorizationCode},
+			"code":          {q.Get("code")},
+			"redirect_uri":  {testOauthRedirectUri},
+			"code_verifier": {testOauthVerifier},
+		},
+	)
+	require.Equal(tb, http.StatusOK, res.Code, res.Body.String())
+	return unmarshalResponse(tb, OauthToken200JSONResponse{}, res)
+}
+
+// Generates the P-256 key of client assertions, returns it with the PEM
+// encoded public key.
+func newAssertionKey(tb testing.TB) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(tb, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.Nil(tb, err)
+	return key, string(
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}),
+	)
+}
+
+// Returns the claims of a valid assertion of the client.
+func assertionClaims(clientId string) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Issuer:    clientId,
+		Subject:   clientId,
+		Audience:  []string{"http://localhost/oauth/token"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		ID:        uuid.NewString(),
+	}
+}
+
+func signAssertion(
+	tb testing.TB, key interface{}, method jwt.SigningMethod,
+	claims jwt.RegisteredClaims,
+) string {
+	assertion, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	require.Nil(tb, err)
+	return assertion
+}
+
+// Posts the form to the token endpoint, authenticating with the assertion.
+// The form can override the assertion type.
+func tryOauthAssertion(
+	tb testing.TB, engine http.Handler, assertion string, form url.Values,
+) *httptest.ResponseRecorder {
+	if !form.Has("client_assertion_type") {
+		form.Set("client_assertion_type", api.OauthClientAssertionJwtBearer)
+	}
+	form.Set("client_assertion", assertion)
+	req, err := http.NewRequest(
+		http.MethodPost, "/oauth/token", strings.NewReader(form.Encode()),
+	)
+	require.Nil(tb, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+// Verifies the ID token with the key of the JWKS endpoint.
+func parseIdToken(
+	tb testing.TB, svr *Server, engine http.Handler, token string,
+) jwt.MapClaims {
+	req, err := svr.get("/oauth/jwks")
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(tb, http.StatusOK, res.Code)
+	jwk := unmarshalResponse(tb, OauthJwks200JSONResponse{}, res).Keys[0]
+	n, err := base64.RawURLEncoding.DecodeString(jwk["n"])
+	require.Nil(tb, err)
+	e, err := base64.RawURLEncoding.DecodeString(jwk["e"])
+	require.Nil(tb, err)
+	key := &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64()),
+	}
+	claims := jwt.MapClaims{}
+	t, err := jwt.ParseWithClaims(
+		token, claims, func(t *jwt.Token) (interface{}, error) {
+			require.Equal(tb, jwk["kid"], t.Header["kid"])
+			return key, nil
+		}, jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer("http://localhost"), jwt.WithExpirationRequired(),
+	)
+	require.Nil(tb, err)
+	require.True(tb, t.Valid)
+	return claims
+}
+
+func Test_parseOauthScopes_rejects_unsupported_scopes(t *testing.T) {
+	scopes, ok := parseOauthScopes(" profile openid  profile ")
+	require.True(t, ok)
+	require.Equal(t, []string{"openid", "profile"}, scopes)
+	scopes, ok = parseOauthScopes("")
+	require.True(t, ok)
+	require.Empty(t, scopes)
+	_, ok = parseOauthScopes("openid admin")
+	require.False(t, ok)
+}
+
+func Test_verifyCodeChallenge(t *testing.T) {
+	// example of RFC 7636 appendix B
+	require.True(
+		t, verifyCodeChallenge(
+			"dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+			"E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		),
+	)
+	require.False(t, verifyCodeChallenge("other", testOauthChallenge()))
+}
+
+func Test_oauthRedirectUrl_keeps_query_of_uri(t *testing.T) {
+	require.Equal(
+		t, "https://app.example.com/cb?code=abc&tab=1",
+		oauthRedirectUrl(
+			"https://app.example.com/cb?tab=1", url.Values{"code": {"abc"}},
+		),
+	)
+}
+
+func Test_oauthKeyId_returns_rfc7638_thumbprint(t *testing.T) {
+	// example of RFC 7638 section 3.1
+	n, err := base64.RawURLEncoding.DecodeString(
+		"0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+	)
+	require.Nil(t, err)
+	key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: 65537}
+	require.Equal(
+		t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", oauthKeyId(key),
+	)
+}
+
+func Test_oauthKey_prefers_configured_key(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	actual, err := Server{oauthSigningKey: key}.oauthKey()
+	require.Nil(t, err)
+	require.Same(t, key, actual)
+	generated, err := Server{}.oauthKey()
+	require.Nil(t, err)
+	require.NotSame(t, key, generated)
+	again, err := Server{}.oauthKey()
+	require.Nil(t, err)
+	require.Same(t, generated, again)
+}
+
+func Test_parseOauthPublicKey_accepts_rsa_and_ec_keys(t *testing.T) {
+	_, ec := newAssertionKey(t)
+	key, err := parseOauthPublicKey(ec)
+	require.Nil(t, err)
+	require.IsType(t, &ecdsa.PublicKey{}, key)
+	rk, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	key, err = parseOauthPublicKey(
+		string(
+			pem.EncodeToMemory(
+				&pem.Block{
+					Type:  "RSA PUBLIC KEY",
+					Bytes: x509.MarshalPKCS1PublicKey(&rk.PublicKey),
+				},
+			),
+		),
+	)
+	require.Nil(t, err)
+	require.IsType(t, &rsa.PublicKey{}, key)
+	_, err = parseOauthPublicKey("not a key")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eidng8/go-utils"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+)
+
+// Denotes that the OAuth client isn't registered.
+var msgInvalidClient interface{} = oauthInvalidClient
+
+// OauthAuthorize issues an authorization code to the client for the signed in
+// user, using the authorization code flow with PKCE. Errors are redirected to
+// the client, unless the client or the redirect URI is invalid. Users who
+// aren't signed in are sent to the login page of the frontend, which returns
+// them to `return_to` after login.
+//
+// Endpoint: GET /oauth/authorize
+func (s Server) OauthAuthorize(
+	ctx context.Context, request OauthAuthorizeRequestObject,
+) (OauthAuthorizeResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	p := request.Params
+	client, err := s.db.OauthClient.Query().
+		Where(oauthclient.ClientIDEQ(deref(p.ClientId))).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return OauthAuthorize400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidClient,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("OauthAuthorize error: %v", err)
+		return nil, err
+	}
+	// never redirect to unregistered URIs
+	redirect := deref(p.RedirectUri)
+	if !slices.Contains(client.RedirectUris, redirect) {
+		return OauthAuthorize400JSONResponse{
+			N400JSONResponse: N400JSONResponse{
+				Code:   http.StatusBadRequest,
+				Errors: &msgInvalidRedirectUri,
+				Status: msgError,
+			},
+		}, nil
+	}
+	fail := func(code string) OauthAuthorizeResponseObject {
+		params := url.Values{"error": {code}}
+		if nil != p.State {
+			params.Set("state", *p.State)
+		}
+		return OauthAuthorize302Response{
+			Headers: OauthAuthorize302ResponseHeaders{
+				Location: oauthRedirectUrl(redirect, params),
+			},
+		}
+	}
+	if !slices.Contains(client.GrantTypes, api.OauthGrantAuthorizationCode) {
+		return fail(oauthUnauthorizedClient), nil
+	}
+	if "code" != deref(p.ResponseType) {
+		return fail(oauthUnsupportedResponseType), nil
+	}
+	if "" == deref(p.CodeChallenge) || "S256" != deref(p.CodeChallengeMethod) {
+		return fail(oauthInvalidRequest), nil
+	}
+	scopes, ok := parseOauthScopes(deref(p.Scope))
+	if !ok {
+		return fail(oauthInvalidScope), nil
+	}
+	token, err := s.getAccessToken(gc)
+	if err != nil {
+		if "" == s.oauthLoginUrl {
+			return fail(oauthLoginRequired), nil
+		}
+		returnTo := strings.TrimSuffix(s.baseUrl, "/") +
+			gc.Request.URL.RequestURI()
+		return OauthAuthorize302Response{
+			Headers: OauthAuthorize302ResponseHeaders{
+				Location: oauthRedirectUrl(
+					s.oauthLoginUrl, url.Values{"return_to": {returnTo}},
+				),
+			},
+		}, nil
+	}
+	// impersonators must not hand the session over to other apps
+	if nil != token.actor {
+		return fail(oauthAccessDenied), nil
+	}
+	// clients only sign in users of their organization
+	if token.user.OrganizationID != client.OrganizationID {
+		return fail(oauthAccessDenied), nil
+	}
+	code, err := utils.RandomAlphaNum(43)
+	if err != nil {
+		api.Log.Debugf("OauthAuthorize error: %v", err)
+		return nil, err
+	}
+	hash := sha256.Sum256([]byte(code))
+	err = s.db.OauthCode.Create().SetCode(hash[:]).SetClientID(client.ID).
+		SetUserID(token.user.ID).SetRedirectURI(redirect).
+		SetScopes(scopes).SetNillableNonce(p.Nonce).
+		SetCodeChallenge(*p.CodeChallenge).
+		SetExpiresAt(time.Now().Add(oauthCodeTtl)).
+		Exec(context.Background())
+	if err != nil {
+		api.Log.Debugf("OauthAuthorize error: %v", err)
+		return nil, err
+	}
+	params := url.Values{"code": {code}}
+	if nil != p.State {
+		params.Set("state", *p.State)
+	}
+	return OauthAuthorize302Response{
+		Headers: OauthAuthorize302ResponseHeaders{
+			Location: oauthRedirectUrl(redirect, params),
+		},
+	}, nil
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+)
+
+func Test_OauthAuthorize_redirects_with_code(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	u := getUserById(t, db, 2)
+	q := tryOauthAuthorize(t, svr, engine, u, c, nil)
+	require.Equal(t, "xyz", q.Get("state"))
+	require.Len(t, q.Get("code"), 43)
+	hash := sha256.Sum256([]byte(q.Get("code")))
+	row, err := db.OauthCode.Query().Where(oauthcode.CodeEQ(hash[:])).
+		Only(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, c.ID, row.ClientID)
+	require.Equal(t, u.ID, row.UserID)
+	require.Equal(t, testOauthRedirectUri, row.RedirectURI)
+	require.Equal(t, []string{"email", "openid", "profile"}, row.Scopes)
+	require.Equal(t, "n-0S6", *row.Nonce)
+	require.Equal(t, testOauthChallenge(), row.CodeChallenge)
+	require.WithinDuration(
+		t, time.Now().Add(oauthCodeTtl), row.ExpiresAt, 5*time.Second,
+	)
+}
+
+func Test_OauthAuthorize_returns_400_if_client_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(
+		u, "/oauth/authorize?client_id=unknown&redirect_uri="+
+			url.QueryEscape(testOauthRedirectUri),
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.JSONEq(
+		t, `{"code":400,"errors":"invalid_client","status":"error"}`,
+		res.Body.String(),
+	)
+}
+
+func Test_OauthAuthorize_returns_400_if_redirect_uri_not_registered(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(
+		u, oauthAuthorizeUrl(
+			c, url.Values{"redirect_uri": {"https://evil.example.com/cb"}},
+		),
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.Empty(t, res.Header().Get("Location"))
+	require.JSONEq(
+		t, `{"code":400,"errors":"invalid_redirect_uri","status":"error"}`,
+		res.Body.String(),
+	)
+}
+
+func Test_OauthAuthorize_redirects_errors_to_client(t *testing.T) {
+	tests := []struct {
+		name     string
+		grant    string
+		params   url.Values
+		expected string
+	}{
+		{
+			"client without code grant", api.OauthGrantRefreshToken, nil,
+			"unauthorized_client",
+		},
+		{
+			"response type not code", api.OauthGrantAuthorizationCode,
+			url.Values{"response_type": {"token"}},
+			"unsupported_response_type",
+		},
+		{
+			"code challenge missing", api.OauthGrantAuthorizationCode,
+			url.Values{"code_challenge": nil}, "invalid_request",
+		},
+		{
+			"plain code challenge", api.OauthGrantAuthorizationCode,
+			url.Values{"code_challenge_method": {"plain"}}, "invalid_request",
+		},
+		{
+			"unsupported scope", api.OauthGrantAuthorizationCode,
+			url.Values{"scope": {"openid admin"}}, "invalid_scope",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				svr, engine, db, _ := setupTestCase(t, true)
+				c, _ := seedOauthClient(t, db, false, nil, tt.grant)
+				u := getUserById(t, db, 2)
+				q := tryOauthAuthorize(t, svr, engine, u, c, tt.params)
+				require.Equal(t, tt.expected, q.Get("error"))
+				require.Equal(t, "xyz", q.Get("state"))
+				require.Empty(t, q.Get("code"))
+				require.Zero(
+					t, db.OauthCode.Query().CountX(context.Background()),
+				)
+			},
+		)
+	}
+}
+
+func Test_OauthAuthorize_redirects_login_required_if_not_signed_in(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	q := tryOauthAuthorize(t, svr, engine, nil, c, nil)
+	require.Equal(t, "login_required", q.Get("error"))
+	require.Equal(t, "xyz", q.Get("state"))
+}
+
+func Test_OauthAuthorize_redirects_to_login_page_if_not_signed_in(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	svr.oauthLoginUrl = "https://app.example.com/login?lang=en"
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	authorize := oauthAuthorizeUrl(c, nil)
+	req, err := svr.get(authorize)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusFound, res.Code)
+	u, err := url.Parse(res.Header().Get("Location"))
+	require.Nil(t, err)
+	require.Equal(
+		t, "https://app.example.com/login", u.Scheme+"://"+u.Host+u.Path,
+	)
+	require.Equal(t, "en", u.Query().Get("lang"))
+	require.Equal(t, "http://localhost"+authorize, u.Query().Get("return_to"))
+}
+
+func Test_OauthAuthorize_redirects_access_denied_if_impersonating(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	token, _, err := svr.issueImpersonationToken(
+		getUserById(t, db, 2), getUserById(t, db, 1),
+	)
+	require.Nil(t, err)
+	req, err := svr.get(oauthAuthorizeUrl(c, nil))
+	require.Nil(t, err)
+	req.AddCookie(&http.Cookie{Name: accessTokenName, Value: token})
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusFound, res.Code)
+	u, err := url.Parse(res.Header().Get("Location"))
+	require.Nil(t, err)
+	require.Equal(t, "access_denied", u.Query().Get("error"))
+}
+
+func Test_OauthAuthorize_redirects_access_denied_if_user_of_other_organization(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	_, u, _ := seedOrganization(t, db)
+	q := tryOauthAuthorize(t, svr, engine, u, c, nil)
+	require.Equal(t, "access_denied", q.Get("error"))
+	require.Empty(t, q.Get("code"))
+	require.Zero(t, db.OauthCode.Query().CountX(context.Background()))
+}
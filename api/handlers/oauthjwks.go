@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// OauthJwks returns the public key ID tokens are signed with, as JSON web key
+// set.
+//
+// Endpoint: GET /oauth/jwks
+func (s Server) OauthJwks(
+	_ context.Context, _ OauthJwksRequestObject,
+) (OauthJwksResponseObject, error) {
+	key, err := s.oauthKey()
+	if err != nil {
+		api.Log.Debugf("OauthJwks error: %v", err)
+		return nil, err
+	}
+	pub := key.PublicKey
+	return OauthJwks200JSONResponse{
+		Keys: []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": oauthKeyId(&pub),
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				"e": base64.RawURLEncoding.EncodeToString(
+					big.NewInt(int64(pub.E)).Bytes(),
+				),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OauthJwks_returns_public_signing_key(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	svr.oauthSigningKey = key
+	req, err := svr.get("/oauth/jwks")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, OauthJwks200JSONResponse{}, res)
+	require.Len(t, actual.Keys, 1)
+	jwk := actual.Keys[0]
+	require.Equal(t, "RSA", jwk["kty"])
+	require.Equal(t, "RS256", jwk["alg"])
+	require.Equal(t, "sig", jwk["use"])
+	require.Equal(t, oauthKeyId(&key.PublicKey), jwk["kid"])
+	require.Equal(
+		t, base64.RawURLEncoding.EncodeToString(key.N.Bytes()), jwk["n"],
+	)
+	require.Equal(t, "AQAB", jwk["e"])
+	require.NotContains(t, jwk, "d")
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// OauthToken issues tokens to OAuth clients, for the authorization code,
+// refresh token and client credentials grants. Access tokens are built like
+// the tokens of logins, so they carry the roles and attributes of the user.
+// ID tokens are issued if the `openid` scope was granted.
+//
+// Endpoint: POST /oauth/token
+func (s Server) OauthToken(
+	ctx context.Context, request OauthTokenRequestObject,
+) (OauthTokenResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	gc.Header("Cache-Control", "no-store")
+	body := request.Body
+	if nil == body {
+		return OauthToken400JSONResponse{Error: oauthInvalidRequest}, nil
+	}
+	client, err := s.authenticateOauthClient(context.Background(), gc, body)
+	if err != nil {
+		if errors.Is(err, errInvalidCredential) {
+			return OauthToken401JSONResponse{Error: oauthInvalidClient}, nil
+		}
+		api.Log.Debugf("OauthToken error: %v", err)
+		return nil, err
+	}
+	if !slices.Contains(api.OauthGrantTypes, body.GrantType) {
+		return OauthToken400JSONResponse{Error: oauthUnsupportedGrantType}, nil
+	}
+	if !slices.Contains(client.GrantTypes, body.GrantType) {
+		return OauthToken400JSONResponse{Error: oauthUnauthorizedClient}, nil
+	}
+	var res OauthTokenResponseObject
+	switch body.GrantType {
+	case api.OauthGrantAuthorizationCode:
+		res, err = s.oauthCodeGrant(client, body)
+	case api.OauthGrantRefreshToken:
+		res, err = s.oauthRefreshGrant(client, body)
+	default:
+		res, err = s.oauthClientCredentialsGrant(client, body)
+	}
+	if err != nil {
+		api.Log.Debugf("OauthToken error: %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// Exchanges the authorization code. The code is deleted before it is checked,
+// so it can only be used once.
+func (s Server) oauthCodeGrant(
+	client *ent.OauthClient, body *OauthTokenFormdataRequestBody,
+) (OauthTokenResponseObject, error) {
+	hash := sha256.Sum256([]byte(deref(body.Code)))
+	c, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			c, err := tx.OauthCode.Query().
+				Where(oauthcode.CodeEQ(hash[:])).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			return c, tx.OauthCode.DeleteOne(c).Exec(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return OauthToken400JSONResponse{Error: oauthInvalidGrant}, nil
+		}
+		return nil, err
+	}
+	code := c.(*ent.OauthCode)
+	if code.ClientID != client.ID || code.ExpiresAt.Before(time.Now()) ||
+		code.RedirectURI != deref(body.RedirectUri) ||
+		!verifyCodeChallenge(deref(body.CodeVerifier), code.CodeChallenge) {
+		return OauthToken400JSONResponse{Error: oauthInvalidGrant}, nil
+	}
+	u, err := s.db.User.Query().Where(user.IDEQ(code.UserID)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return OauthToken400JSONResponse{Error: oauthInvalidGrant}, nil
+		}
+		return nil, err
+	}
+	return s.oauthTokenResponse(u, client, code.Scopes, deref(code.Nonce), true)
+}
+
+// Issues new tokens for the refresh token. Like RefreshAccessToken, the
+// refresh token isn't rotated. The scopes can be narrowed, but not widened.
+func (s Server) oauthRefreshGrant(
+	client *ent.OauthClient, body *OauthTokenFormdataRequestBody,
+) (OauthTokenResponseObject, error) {
+	token, err := s.jwtTokenFromString(deref(body.RefreshToken))
+	if err != nil {
+		api.Log.Debugf("parse refresh token error: %v", err)
+		return OauthToken400JSONResponse{Error: oauthInvalidGrant}, nil
+	}
+	if nil != token.expired() || nil != token.checkRefreshToken() ||
+		token.getClientId() != client.ClientID {
+		return OauthToken400JSONResponse{Error: oauthInvalidGrant}, nil
+	}
+	var scopes []string
+	if granted, err := token.getScopes(); nil == err && nil != granted {
+		scopes = *granted
+	}
+	if nil != body.Scope {
+		requested, ok := parseOauthScopes(*body.Scope)
+		if !ok || slices.ContainsFunc(
+			requested, func(sc string) bool {
+				return !slices.Contains(scopes, sc)
+			},
+		) {
+			return OauthToken400JSONResponse{Error: oauthInvalidScope}, nil
+		}
+		scopes = requested
+	}
+	return s.oauthTokenResponse(token.user, client, scopes, "", true)
+}
+
+// Issues an access token of the user of the client, without refresh token.
+func (s Server) oauthClientCredentialsGrant(
+	client *ent.OauthClient, body *OauthTokenFormdataRequestBody,
+) (OauthTokenResponseObject, error) {
+	if client.Public || nil == client.UserID {
+		return OauthToken400JSONResponse{Error: oauthUnauthorizedClient}, nil
+	}
+	scopes, ok := parseOauthScopes(deref(body.Scope))
+	if !ok {
+		return OauthToken400JSONResponse{Error: oauthInvalidScope}, nil
+	}
+	u, err := s.db.User.Query().Where(user.IDEQ(*client.UserID)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return OauthToken400JSONResponse{Error: oauthInvalidGrant}, nil
+		}
+		return nil, err
+	}
+	return s.oauthTokenResponse(u, client, scopes, "", false)
+}
+
+// Issues the access token of the user, and the refresh token if the client
+// has the refresh token grant. The ID token is issued to clients acting for
+// users, if the `openid` scope was granted.
+func (s Server) oauthTokenResponse(
+	u *ent.User, client *ent.OauthClient, scopes []string, nonce string,
+	forUser bool,
+) (OauthTokenResponseObject, error) {
+	if accountClosed(u) {
+		return OauthToken400JSONResponse{Error: oauthInvalidGrant}, nil
+	}
+	if err := s.loadRoles(u); err != nil {
+		return nil, err
+	}
+	at, err := s.issueOauthToken(u, client, scopes, time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	res := OauthToken200JSONResponse{
+		AccessToken: at,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(time.Hour.Seconds()),
+	}
+	if len(scopes) > 0 {
+		scope := strings.Join(scopes, " ")
+		res.Scope = &scope
+	}
+	if !forUser {
+		return res, nil
+	}
+	if slices.Contains(client.GrantTypes, api.OauthGrantRefreshToken) {
+		rt, err := s.issueOauthToken(u, client, scopes, 7*24*time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		res.RefreshToken = &rt
+	}
+	if slices.Contains(scopes, "openid") {
+		it, err := s.issueIdToken(u, client, scopes, nonce)
+		if err != nil {
+			return nil, err
+		}
+		res.IdToken = &it
+	}
+	return res, nil
+}
@@ -0,0 +1,586 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/permission"
+)
+
+func Test_OauthToken_exchanges_code_for_tokens(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+		api.OauthGrantRefreshToken,
+	)
+	u := getUserById(t, db, 2)
+	actual := oauthLogin(t, svr, engine, u, c, secret)
+	require.Equal(t, "Bearer", actual.TokenType)
+	require.Equal(t, 3600, actual.ExpiresIn)
+	require.Equal(t, "email openid profile", *actual.Scope)
+	require.NotNil(t, actual.RefreshToken)
+	// access tokens are built like login tokens, with roles and attributes
+	tk, err := svr.jwtTokenFromString(actual.AccessToken)
+	require.Nil(t, err)
+	require.Nil(t, tk.checkAccessToken())
+	require.Equal(t, u.ID, tk.user.ID)
+	require.Equal(t, c.ClientID, tk.getClientId())
+	roles, err := tk.getRoles()
+	require.Nil(t, err)
+	require.Equal(t, []string{"role 0", "role 1", "role 2"}, *roles)
+	attr, err := tk.getAttr()
+	require.Nil(t, err)
+	require.Contains(t, *attr, "dept")
+	rt, err := svr.jwtTokenFromString(*actual.RefreshToken)
+	require.Nil(t, err)
+	require.Nil(t, rt.checkRefreshToken())
+	claims := parseIdToken(t, svr, engine, *actual.IdToken)
+	require.Equal(t, "2", claims["sub"])
+	require.Equal(t, []interface{}{c.ClientID}, claims["aud"])
+	require.Equal(t, "n-0S6", claims["nonce"])
+	require.Equal(t, u.Username, claims["preferred_username"])
+	require.Equal(t, *u.Email, claims["email"])
+}
+
+func Test_OauthToken_issues_id_token_claims_of_scopes(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	u := getUserById(t, db, 2)
+	q := tryOauthAuthorize(
+		t, svr, engine, u, c, url.Values{"scope": {"openid"}, "nonce": nil},
+	)
+	res := tryOauthToken(
+		t, engine, c, secret, url.Values{
+			"grant_type":    {api.OauthGrantAuthorizationCode},
+			"code":          {q.Get("code")},
+			"redirect_uri":  {testOauthRedirectUri},
+			"code_verifier": {testOauthVerifier},
+		},
+	)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, "no-store", res.Header().Get("Cache-Control"))
+	actual := unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	require.Nil(t, actual.RefreshToken)
+	claims := parseIdToken(t, svr, engine, *actual.IdToken)
+	require.NotContains(t, claims, "nonce")
+	require.NotContains(t, claims, "preferred_username")
+	require.NotContains(t, claims, "email")
+}
+
+func Test_OauthToken_issues_no_id_token_without_openid_scope(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, true, nil, api.OauthGrantAuthorizationCode)
+	u := getUserById(t, db, 2)
+	q := tryOauthAuthorize(t, svr, engine, u, c, url.Values{"scope": nil})
+	res := tryOauthToken(
+		t, engine, c, "", url.Values{
+			"grant_type":    {api.OauthGrantAuthorizationCode},
+			"code":          {q.Get("code")},
+			"redirect_uri":  {testOauthRedirectUri},
+			"code_verifier": {testOauthVerifier},
+		},
+	)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	require.Nil(t, actual.IdToken)
+	require.Nil(t, actual.Scope)
+}
+
+func Test_OauthToken_accepts_client_secret_in_form(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	q := tryOauthAuthorize(t, svr, engine, getUserById(t, db, 2), c, nil)
+	res := tryOauthToken(
+		t, engine, c, "", url.Values{
+			"grant_type":    {api.OauthGrantAuthorizationCode},
+			"code":          {q.Get("code")},
+			"redirect_uri":  {testOauthRedirectUri},
+			"code_verifier": {testOauthVerifier},
+			"client_secret": {secret},
+		},
+	)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_OauthToken_returns_401_if_client_invalid(t *testing.T) {
+	_, engine, db, _ := setupTestCase(t, true)
+	c, _ := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	public, _ := seedOauthClient(
+		t, db, true, nil, api.OauthGrantAuthorizationCode,
+	)
+	form := func() url.Values {
+		return url.Values{"grant_type": {api.OauthGrantAuthorizationCode}}
+	}
+	// wrong secret
+	res := tryOauthToken(t, engine, c, "wrong", form())
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.JSONEq(t, `{"error":"invalid_client"}`, res.Body.String())
+	// confidential client without secret
+	res = tryOauthToken(t, engine, c, "", form())
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	// public client with secret
+	res = tryOauthToken(t, engine, public, "secret", form())
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	// unknown client
+	res = tryOauthToken(
+		t, engine, c, "", url.Values{
+			"grant_type": {api.OauthGrantAuthorizationCode},
+			"client_id":  {"unknown"},
+		},
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_OauthToken_returns_400_if_grant_not_allowed(t *testing.T) {
+	_, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	res := tryOauthToken(
+		t, engine, c, secret,
+		url.Values{"grant_type": {api.OauthGrantClientCredentials}},
+	)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.JSONEq(t, `{"error":"unauthorized_client"}`, res.Body.String())
+	res = tryOauthToken(
+		t, engine, c, secret, url.Values{"grant_type": {"password"}},
+	)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.JSONEq(t, `{"error":"unsupported_grant_type"}`, res.Body.String())
+}
+
+func Test_OauthToken_returns_400_if_code_invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		form   url.Values
+		expire bool
+	}{
+		{"wrong verifier", url.Values{"code_verifier": {"wrong"}}, false},
+		{
+			"wrong redirect uri",
+			url.Values{"redirect_uri": {testOauthRedirectUri + "/other"}},
+			false,
+		},
+		{"expired", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				svr, engine, db, _ := setupTestCase(t, true)
+				c, secret := seedOauthClient(
+					t, db, false, nil, api.OauthGrantAuthorizationCode,
+				)
+				u := getUserById(t, db, 2)
+				code := tryOauthAuthorize(t, svr, engine, u, c, nil).Get("code")
+				if tt.expire {
+					// expiry is immutable, replace the code with expired one
+					code = "expired"
+					hash := sha256.Sum256([]byte(code))
+					db.OauthCode.Delete().ExecX(context.Background())
+					db.OauthCode.Create().SetCode(hash[:]).SetClientID(c.ID).
+						SetUserID(u.ID).SetRedirectURI(testOauthRedirectUri).
+						SetScopes([]string{}).
+						SetCodeChallenge(testOauthChallenge()).
+						SetExpiresAt(time.Now().Add(-time.Second)).
+						ExecX(context.Background())
+				}
+				form := url.Values{
+					"grant_type":    {api.OauthGrantAuthorizationCode},
+					"code":          {code},
+					"redirect_uri":  {testOauthRedirectUri},
+					"code_verifier": {testOauthVerifier},
+				}
+				for k, v := range tt.form {
+					form[k] = v
+				}
+				res := tryOauthToken(t, engine, c, secret, form)
+				require.Equal(t, http.StatusBadRequest, res.Code)
+				require.JSONEq(
+					t, `{"error":"invalid_grant"}`, res.Body.String(),
+				)
+				// failed attempts use up the code too
+				require.Zero(
+					t, db.OauthCode.Query().CountX(context.Background()),
+				)
+			},
+		)
+	}
+}
+
+func Test_OauthToken_returns_400_if_code_reused(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	q := tryOauthAuthorize(t, svr, engine, getUserById(t, db, 2), c, nil)
+	form := url.Values{
+		"grant_type":    {api.OauthGrantAuthorizationCode},
+		"code":          {q.Get("code")},
+		"redirect_uri":  {testOauthRedirectUri},
+		"code_verifier": {testOauthVerifier},
+	}
+	res := tryOauthToken(t, engine, c, secret, form)
+	require.Equal(t, http.StatusOK, res.Code)
+	res = tryOauthToken(t, engine, c, secret, form)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.JSONEq(t, `{"error":"invalid_grant"}`, res.Body.String())
+}
+
+func Test_OauthToken_returns_400_if_code_of_other_client(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	other, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	q := tryOauthAuthorize(t, svr, engine, getUserById(t, db, 2), c, nil)
+	res := tryOauthToken(
+		t, engine, other, secret, url.Values{
+			"grant_type":    {api.OauthGrantAuthorizationCode},
+			"code":          {q.Get("code")},
+			"redirect_uri":  {testOauthRedirectUri},
+			"code_verifier": {testOauthVerifier},
+		},
+	)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.False(
+		t, db.OauthCode.Query().Where(oauthcode.ClientIDEQ(c.ID)).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_OauthToken_refreshes_tokens(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+		api.OauthGrantRefreshToken,
+	)
+	u := getUserById(t, db, 2)
+	login := oauthLogin(t, svr, engine, u, c, secret)
+	res := tryOauthToken(
+		t, engine, c, secret, url.Values{
+			"grant_type":    {api.OauthGrantRefreshToken},
+			"refresh_token": {*login.RefreshToken},
+			"scope":         {"openid"},
+		},
+	)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	actual := unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	require.Equal(t, "openid", *actual.Scope)
+	tk, err := svr.jwtTokenFromString(actual.AccessToken)
+	require.Nil(t, err)
+	require.Nil(t, tk.checkAccessToken())
+	require.Equal(t, u.ID, tk.user.ID)
+	require.NotNil(t, actual.RefreshToken)
+	claims := parseIdToken(t, svr, engine, *actual.IdToken)
+	require.Equal(t, "2", claims["sub"])
+	require.NotContains(t, claims, "email")
+}
+
+func Test_OauthToken_returns_400_if_refresh_token_invalid(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+		api.OauthGrantRefreshToken,
+	)
+	other, otherSecret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantRefreshToken,
+	)
+	u := getUserById(t, db, 2)
+	login := oauthLogin(t, svr, engine, u, c, secret)
+	// refresh token of another client
+	res := tryOauthToken(
+		t, engine, other, otherSecret, url.Values{
+			"grant_type":    {api.OauthGrantRefreshToken},
+			"refresh_token": {*login.RefreshToken},
+		},
+	)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.JSONEq(t, `{"error":"invalid_grant"}`, res.Body.String())
+	// refresh token of login
+	rt, err := svr.issueRefreshToken(u)
+	require.Nil(t, err)
+	res = tryOauthToken(
+		t, engine, c, secret, url.Values{
+			"grant_type":    {api.OauthGrantRefreshToken},
+			"refresh_token": {rt},
+		},
+	)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	// malformed
+	res = tryOauthToken(
+		t, engine, c, secret, url.Values{
+			"grant_type":    {api.OauthGrantRefreshToken},
+			"refresh_token": {"invalid"},
+		},
+	)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	// scopes can't be widened
+	q := tryOauthAuthorize(
+		t, svr, engine, u, c, url.Values{"scope": {"openid"}},
+	)
+	res = tryOauthToken(
+		t, engine, c, secret, url.Values{
+			"grant_type":    {api.OauthGrantAuthorizationCode},
+			"code":          {q.Get("code")},
+			"redirect_uri":  {testOauthRedirectUri},
+			"code_verifier": {testOauthVerifier},
+		},
+	)
+	require.Equal(t, http.StatusOK, res.Code)
+	narrow := unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	res = tryOauthToken(
+		t, engine, c, secret, url.Values{
+			"grant_type":    {api.OauthGrantRefreshToken},
+			"refresh_token": {*narrow.RefreshToken},
+			"scope":         {"openid email"},
+		},
+	)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.JSONEq(t, `{"error":"invalid_scope"}`, res.Body.String())
+}
+
+func Test_OauthToken_issues_token_of_client_user(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	uid := uint64(2)
+	c, secret := seedOauthClient(
+		t, db, false, &uid, api.OauthGrantClientCredentials,
+	)
+	res := tryOauthToken(
+		t, engine, c, secret,
+		url.Values{"grant_type": {api.OauthGrantClientCredentials}},
+	)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	actual := unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	require.Nil(t, actual.RefreshToken)
+	require.Nil(t, actual.IdToken)
+	tk, err := svr.jwtTokenFromString(actual.AccessToken)
+	require.Nil(t, err)
+	require.Nil(t, tk.checkAccessToken())
+	require.Equal(t, uid, tk.user.ID)
+	roles, err := tk.getRoles()
+	require.Nil(t, err)
+	require.Equal(t, []string{"role 0", "role 1", "role 2"}, *roles)
+	// the token is accepted by the API
+	req, err := http.NewRequest(http.MethodGet, "/user/2", nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+actual.AccessToken)
+	grantPermissions(t, db, 2, "auth:ReadUser")
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_OauthToken_returns_422_without_grant_type(t *testing.T) {
+	_, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	res := tryOauthToken(t, engine, c, secret, url.Values{})
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_OauthToken_issues_token_of_service_account(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	a, c, secret := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	res := tryOauthToken(
+		t, engine, c, secret,
+		url.Values{"grant_type": {api.OauthGrantClientCredentials}},
+	)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	actual := unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	require.Nil(t, actual.RefreshToken)
+	tk, err := svr.jwtTokenFromString(actual.AccessToken)
+	require.Nil(t, err)
+	sub, err := tk.token.Claims.GetSubject()
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf("svc:%d", a.UserID), sub)
+	require.Nil(t, tk.checkAccessToken())
+	require.Equal(t, a.UserID, tk.user.ID)
+	// roles are assigned to the user of the account
+	req, err := http.NewRequest(http.MethodGet, "/user/2", nil)
+	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+actual.AccessToken)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	r := db.Role.Create().SetName("service role").
+		AddPermissions(
+			db.Permission.Query().Where(permission.NameEQ("auth:ReadUser")).
+				OnlyX(context.Background()),
+		).SaveX(context.Background())
+	db.User.UpdateOneID(a.UserID).AddRoles(r).ExecX(context.Background())
+	res = tryOauthToken(
+		t, engine, c, secret,
+		url.Values{"grant_type": {api.OauthGrantClientCredentials}},
+	)
+	actual = unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	req.Header.Set("Authorization", "Bearer "+actual.AccessToken)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_OauthToken_authenticates_client_with_assertion(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	key, pub := newAssertionKey(t)
+	a, c, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), &pub)
+	res := tryOauthAssertion(
+		t, engine,
+		signAssertion(t, key, jwt.SigningMethodES256, assertionClaims(c.ClientID)),
+		url.Values{"grant_type": {api.OauthGrantClientCredentials}},
+	)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	actual := unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	tk, err := svr.jwtTokenFromString(actual.AccessToken)
+	require.Nil(t, err)
+	require.Nil(t, tk.checkAccessToken())
+	require.Equal(t, a.UserID, tk.user.ID)
+	// the issuer is accepted as audience too
+	claims := assertionClaims(c.ClientID)
+	claims.Audience = []string{"http://localhost"}
+	res = tryOauthAssertion(
+		t, engine, signAssertion(t, key, jwt.SigningMethodES256, claims),
+		url.Values{
+			"grant_type": {api.OauthGrantClientCredentials},
+			"client_id":  {c.ClientID},
+		},
+	)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+}
+
+func Test_OauthToken_returns_401_if_assertion_invalid(t *testing.T) {
+	key, pub := newAssertionKey(t)
+	other, _ := newAssertionKey(t)
+	tests := []struct {
+		name   string
+		key    interface{}
+		method jwt.SigningMethod
+		edit   func(*jwt.RegisteredClaims)
+		form   url.Values
+	}{
+		{"other key", other, jwt.SigningMethodES256, nil, nil},
+		{
+			"public key as hmac secret", []byte(pub), jwt.SigningMethodHS256,
+			nil, nil,
+		},
+		{
+			"wrong audience", key, jwt.SigningMethodES256,
+			func(c *jwt.RegisteredClaims) {
+				c.Audience = []string{"http://example.com/oauth/token"}
+			}, nil,
+		},
+		{
+			"issuer not client", key, jwt.SigningMethodES256,
+			func(c *jwt.RegisteredClaims) { c.Issuer = "other" }, nil,
+		},
+		{
+			"without expiry", key, jwt.SigningMethodES256,
+			func(c *jwt.RegisteredClaims) { c.ExpiresAt = nil }, nil,
+		},
+		{
+			"expired", key, jwt.SigningMethodES256,
+			func(c *jwt.RegisteredClaims) {
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+			}, nil,
+		},
+		{
+			"valid too long", key, jwt.SigningMethodES256,
+			func(c *jwt.RegisteredClaims) {
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+			}, nil,
+		},
+		{
+			"other client id", key, jwt.SigningMethodES256, nil,
+			url.Values{"client_id": {"other"}},
+		},
+		{
+			"with secret", key, jwt.SigningMethodES256, nil,
+			url.Values{"client_secret": {"secret"}},
+		},
+		{
+			"wrong assertion type", key, jwt.SigningMethodES256, nil,
+			url.Values{"client_assertion_type": {"jwt"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, engine, db, _ := setupTestCase(t, true)
+				_, c, _ := seedServiceAccount(
+					t, db, seedGroup(t, db, nil, nil), &pub,
+				)
+				claims := assertionClaims(c.ClientID)
+				if nil != tt.edit {
+					tt.edit(&claims)
+				}
+				form := url.Values{
+					"grant_type": {api.OauthGrantClientCredentials},
+				}
+				for k, v := range tt.form {
+					form[k] = v
+				}
+				res := tryOauthAssertion(
+					t, engine, signAssertion(t, tt.key, tt.method, claims),
+					form,
+				)
+				require.Equal(t, http.StatusUnauthorized, res.Code)
+				require.JSONEq(
+					t, `{"error":"invalid_client"}`, res.Body.String(),
+				)
+			},
+		)
+	}
+}
+
+func Test_OauthToken_returns_401_if_client_has_no_public_key(t *testing.T) {
+	_, engine, db, _ := setupTestCase(t, true)
+	key, _ := newAssertionKey(t)
+	uid := uint64(2)
+	c, _ := seedOauthClient(t, db, false, &uid, api.OauthGrantClientCredentials)
+	res := tryOauthAssertion(
+		t, engine,
+		signAssertion(t, key, jwt.SigningMethodES256, assertionClaims(c.ClientID)),
+		url.Values{"grant_type": {api.OauthGrantClientCredentials}},
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_OauthToken_returns_401_if_service_account_uses_secret_of_key_client(t *testing.T) {
+	_, engine, db, _ := setupTestCase(t, true)
+	_, pub := newAssertionKey(t)
+	_, c, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), &pub)
+	res := tryOauthToken(
+		t, engine, c, "secret",
+		url.Values{"grant_type": {api.OauthGrantClientCredentials}},
+	)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_OauthToken_returns_400_if_service_account_deleted(t *testing.T) {
+	_, engine, db, _ := setupTestCase(t, true)
+	a, c, secret := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	db.User.DeleteOneID(a.UserID).ExecX(context.Background())
+	res := tryOauthToken(
+		t, engine, c, secret,
+		url.Values{"grant_type": {api.OauthGrantClientCredentials}},
+	)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.JSONEq(t, `{"error":"invalid_grant"}`, res.Body.String())
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// OauthUserinfo returns the claims of the user of the OAuth access token, by
+// the granted scopes. The token must be granted the `openid` scope.
+//
+// Endpoint: GET /userinfo
+func (s Server) OauthUserinfo(
+	ctx context.Context, _ OauthUserinfoRequestObject,
+) (OauthUserinfoResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	method, st, err := authHeader(gc)
+	if err != nil || "bearer" != method {
+		return OauthUserinfo401JSONResponse{}, nil
+	}
+	token, err := s.handleBearerAuth(st)
+	if err != nil || "" == token.getClientId() {
+		return OauthUserinfo401JSONResponse{}, nil
+	}
+	scopes, err := token.getScopes()
+	if err != nil || !slices.Contains(*scopes, "openid") {
+		return OauthUserinfo401JSONResponse{}, nil
+	}
+	u := token.user
+	res := OauthUserinfo200JSONResponse{Sub: tokenSubject(u)}
+	if slices.Contains(*scopes, "profile") {
+		res.PreferredUsername = &u.Username
+	}
+	if slices.Contains(*scopes, "email") && nil != u.Email {
+		email := openapi_types.Email(*u.Email)
+		res.Email = &email
+	}
+	return res, nil
+}
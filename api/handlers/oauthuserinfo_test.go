@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// Requests the userinfo endpoint with the bearer token.
+func tryOauthUserinfo(
+	tb testing.TB, engine http.Handler, token string,
+) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(http.MethodGet, "/userinfo", nil)
+	require.Nil(tb, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+func Test_OauthUserinfo_returns_claims_of_scopes(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	u := getUserById(t, db, 2)
+	login := oauthLogin(t, svr, engine, u, c, secret)
+	res := tryOauthUserinfo(t, engine, login.AccessToken)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.JSONEq(
+		t,
+		`{"sub":"2","preferred_username":"user0","email":"email0@test.com"}`,
+		res.Body.String(),
+	)
+	q := tryOauthAuthorize(
+		t, svr, engine, u, c, url.Values{"scope": {"openid"}},
+	)
+	res = tryOauthToken(
+		t, engine, c, secret, url.Values{
+			"grant_type":    {api.OauthGrantAuthorizationCode},
+			"code":          {q.Get("code")},
+			"redirect_uri":  {testOauthRedirectUri},
+			"code_verifier": {testOauthVerifier},
+		},
+	)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	res = tryOauthUserinfo(t, engine, actual.AccessToken)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.JSONEq(t, `{"sub":"2"}`, res.Body.String())
+}
+
+func Test_OauthUserinfo_returns_401_without_openid_scope(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	q := tryOauthAuthorize(
+		t, svr, engine, getUserById(t, db, 2), c,
+		url.Values{"scope": {"profile"}},
+	)
+	res := tryOauthToken(
+		t, engine, c, secret, url.Values{
+			"grant_type":    {api.OauthGrantAuthorizationCode},
+			"code":          {q.Get("code")},
+			"redirect_uri":  {testOauthRedirectUri},
+			"code_verifier": {testOauthVerifier},
+		},
+	)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, OauthToken200JSONResponse{}, res)
+	res = tryOauthUserinfo(t, engine, actual.AccessToken)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_OauthUserinfo_returns_401_if_not_oauth_token(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, false)
+	at, err := svr.issueAccessToken(getUserById(t, db, 2))
+	require.Nil(t, err)
+	res := tryOauthUserinfo(t, engine, at)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	res = tryOauthUserinfo(t, engine, "invalid")
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	req, err := svr.getAs(getUserById(t, db, 2), "/userinfo")
+	require.Nil(t, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
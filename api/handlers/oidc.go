@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/linkedidentity"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// How long the user has to sign in with the OpenID Connect provider.
+const oidcTimeout = 10 * time.Minute
+
+// OidcProvider is an OpenID Connect provider users can sign in with, using the
+// authorization code flow with PKCE. Its endpoints and keys are discovered
+// from the issuer on first use. Users are linked to the subject of the ID
+// token, and are created on their first sign in.
+type OidcProvider struct {
+	// Name of the provider in the login URL. It is stored with the linked
+	// identities, so it must not change.
+	Name string `json:"name"`
+	// Issuer URL, the discovery document is fetched from
+	// `{issuer}/.well-known/openid-configuration`.
+	Issuer string `json:"issuer"`
+	// Client credentials registered with the provider.
+	ClientId     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	// Page of the frontend the provider redirects back to. It passes the
+	// `code` and `state` query parameters to FinishOidcLogin.
+	RedirectUrl string `json:"redirect_url"`
+	// Scopes requested besides `openid`, `profile` and `email` by default.
+	Scopes []string `json:"scopes,omitempty"`
+	// Organization new users are created in.
+	Organization uint32 `json:"organization,omitempty"`
+	// Claims holding the username and groups of users, `preferred_username`
+	// and `groups` by default. The username falls back to the email, then to
+	// the subject.
+	UsernameClaim string `json:"username_claim,omitempty"`
+	GroupsClaim   string `json:"groups_claim,omitempty"`
+	// Maps claims to user attributes. Only integer values are mapped, as user
+	// attributes are numbers.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Links existing users by their verified email on first sign in,
+	// otherwise a new user is always created.
+	LinkByEmail bool `json:"link_by_email,omitempty"`
+	// HTTP client talking to the provider, optional.
+	Client *http.Client `json:"-"`
+
+	mu         sync.Mutex
+	discovered *oidc.Provider
+}
+
+// State of the sign in kept by the client between BeginOidcLogin and
+// FinishOidcLogin.
+type oidcSession struct {
+	Provider string    `json:"provider"`
+	State    string    `json:"state"`
+	Nonce    string    `json:"nonce"`
+	Verifier string    `json:"verifier"`
+	Expires  time.Time `json:"expires"`
+}
+
+// UseOidcProviders replaces the OpenID Connect providers of the server. Empty
+// optional fields of the providers are set to their defaults.
+func (s *Server) UseOidcProviders(providers ...*OidcProvider) {
+	for _, p := range providers {
+		if nil == p.Scopes {
+			p.Scopes = []string{"profile", "email"}
+		}
+		if 0 == p.Organization {
+			p.Organization = api.DefaultOrganizationId
+		}
+		if "" == p.UsernameClaim {
+			p.UsernameClaim = "preferred_username"
+		}
+		if "" == p.GroupsClaim {
+			p.GroupsClaim = "groups"
+		}
+	}
+	s.oidcProviders = providers
+}
+
+// Returns the provider of the name, nil if it isn't configured.
+func (s Server) oidcProvider(name string) *OidcProvider {
+	for _, p := range s.oidcProviders {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Returns the context of requests to the provider. Keys are fetched lazily
+// with the context passed to discovery, so it must outlive the request.
+func (p *OidcProvider) context() context.Context {
+	if nil == p.Client {
+		return context.Background()
+	}
+	return oidc.ClientContext(context.Background(), p.Client)
+}
+
+// Returns the discovered provider, discovering it on first use.
+func (p *OidcProvider) provider() (*oidc.Provider, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if nil == p.discovered {
+		d, err := oidc.NewProvider(p.context(), p.Issuer)
+		if err != nil {
+			return nil, err
+		}
+		p.discovered = d
+	}
+	return p.discovered, nil
+}
+
+func (p *OidcProvider) oauth2Config(d *oidc.Provider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.ClientId,
+		ClientSecret: p.ClientSecret,
+		Endpoint:     d.Endpoint(),
+		RedirectURL:  p.RedirectUrl,
+		Scopes:       append([]string{oidc.ScopeOpenID}, p.Scopes...),
+	}
+}
+
+// Name of the provider stored with the users it created.
+func (p *OidcProvider) authProvider() string {
+	return "oidc:" + p.Name
+}
+
+// Maps the claims of the ID token to the identity of the user.
+func (p *OidcProvider) identity(
+	subject string, claims map[string]interface{},
+) *Identity {
+	id := &Identity{}
+	id.Email, _ = claims["email"].(string)
+	id.Username, _ = claims[p.UsernameClaim].(string)
+	if "" == id.Username {
+		id.Username = id.Email
+	}
+	if "" == id.Username {
+		id.Username = subject
+	}
+	switch groups := claims[p.GroupsClaim].(type) {
+	case string:
+		id.Groups = []string{groups}
+	case []interface{}:
+		for _, g := range groups {
+			if s, ok := g.(string); ok {
+				id.Groups = append(id.Groups, s)
+			}
+		}
+	}
+	for claim, name := range p.Attributes {
+		var v float64
+		switch value := claims[claim].(type) {
+		case float64:
+			if value != math.Trunc(value) {
+				continue
+			}
+			v = value
+		case string:
+			i, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			v = float64(i)
+		default:
+			continue
+		}
+		if nil == id.Attr {
+			id.Attr = map[string]interface{}{}
+		}
+		id.Attr[name] = v
+	}
+	return id
+}
+
+// Returns the user linked to the subject, updated with the claims. On first
+// sign in, the user with the verified email is linked if the provider links
+// by email, otherwise a new user is created. Returns errInvalidCredential if
+// the linked user was deleted, or the username is taken.
+func (s Server) linkOidcUser(
+	qc context.Context, p *OidcProvider, subject string,
+	claims map[string]interface{},
+) (*ent.User, error) {
+	id := p.identity(subject, claims)
+	res, err := s.db.Transaction(
+		qc, func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			li, err := tx.LinkedIdentity.Query().Where(
+				linkedidentity.ProviderEQ(p.Name),
+				linkedidentity.SubjectEQ(subject),
+			).WithUser().Only(qc)
+			if err == nil {
+				if nil == li.Edges.User {
+					return nil, errInvalidCredential
+				}
+				err = tx.LinkedIdentity.UpdateOne(li).
+					SetLastLoginAt(time.Now()).Exec(qc)
+				if err != nil {
+					return nil, err
+				}
+				return s.provision(
+					qc, tx, li.Edges.User.OrganizationID, p.authProvider(),
+					li.Edges.User, id,
+				)
+			}
+			if !ent.IsNotFound(err) {
+				return nil, err
+			}
+			var u *ent.User
+			verified, _ := claims["email_verified"].(bool)
+			if p.LinkByEmail && verified && "" != id.Email {
+				u, err = tx.User.Query().Where(
+					user.OrganizationIDEQ(p.Organization),
+					user.EmailEqualFold(id.Email),
+				).Only(qc)
+				if err != nil && !ent.IsNotFound(err) {
+					return nil, err
+				}
+			}
+			linked := nil != u
+			u, err = s.provision(
+				qc, tx, p.Organization, p.authProvider(), u, id,
+			)
+			if err != nil {
+				return nil, err
+			}
+			err = tx.LinkedIdentity.Create().SetUserID(u.ID).
+				SetProvider(p.Name).SetSubject(subject).
+				SetLastLoginAt(time.Now()).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			if !linked {
+				return u, nil
+			}
+			return u, recordAudit(
+				qc, tx, api.AuditIdentityLinked, u.OrganizationID, nil,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"provider": p.Name,
+					"subject":  subject,
+				},
+			)
+		},
+	)
+	if err != nil {
+		return nil, provisionError(err)
+	}
+	return res.(*ent.User), nil
+}
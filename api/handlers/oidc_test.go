@@ -0,0 +1,273 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+const (
+	testOidcClientId     = "rbac"
+	testOidcClientSecret = "client-secret"
+	testOidcRedirectUrl  = "https://app.example.com/login/oidc"
+)
+
+// In-process OpenID Connect provider. Codes are issued by authorize(), and
+// exchanged at the token endpoint for ID tokens signed with the provider key.
+type testOidcIdp struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	mu     sync.Mutex
+	grants map[string]*testOidcGrant
+	issued int
+}
+
+// Authorization code issued by the provider.
+type testOidcGrant struct {
+	challenge string
+	claims    jwt.MapClaims
+	// signs the ID token instead of the provider key
+	key *rsa.PrivateKey
+}
+
+// Starts a provider, stopped when the test ends.
+func newTestOidcIdp(tb testing.TB) *testOidcIdp {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(tb, err)
+	idp := &testOidcIdp{key: key, grants: map[string]*testOidcGrant{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("/jwks", idp.jwks)
+	mux.HandleFunc("/token", idp.token)
+	idp.server = httptest.NewServer(mux)
+	tb.Cleanup(idp.server.Close)
+	return idp
+}
+
+// Returns the provider configuration of the server, named `idp`.
+func (idp *testOidcIdp) provider() *OidcProvider {
+	return &OidcProvider{
+		Name:         "idp",
+		Issuer:       idp.server.URL,
+		ClientId:     testOidcClientId,
+		ClientSecret: testOidcClientSecret,
+		RedirectUrl:  testOidcRedirectUrl,
+		Attributes:   map[string]string{"dept": "dept"},
+	}
+}
+
+// Authorizes the request of the authorization URL, returns the code and the
+// state to pass to the redirect URL. The ID token has the registered claims,
+// the nonce of the request, and the given claims, which take precedence.
+func (idp *testOidcIdp) authorize(
+	tb testing.TB, authorizationUrl string, claims jwt.MapClaims,
+) (code, state string) {
+	u, err := url.Parse(authorizationUrl)
+	require.Nil(tb, err)
+	require.Equal(tb, idp.server.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	q := u.Query()
+	require.Equal(tb, "code", q.Get("response_type"))
+	require.Equal(tb, testOidcClientId, q.Get("client_id"))
+	require.Equal(tb, testOidcRedirectUrl, q.Get("redirect_uri"))
+	require.Equal(tb, "S256", q.Get("code_challenge_method"))
+	require.Contains(tb, q.Get("scope"), "openid")
+	now := time.Now()
+	c := jwt.MapClaims{
+		"iss":   idp.server.URL,
+		"aud":   testOidcClientId,
+		"iat":   now.Unix(),
+		"exp":   now.Add(5 * time.Minute).Unix(),
+		"nonce": q.Get("nonce"),
+	}
+	for k, v := range claims {
+		c[k] = v
+	}
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.issued++
+	code = "code-" + strconv.Itoa(idp.issued)
+	idp.grants[code] = &testOidcGrant{
+		challenge: q.Get("code_challenge"),
+		claims:    c,
+	}
+	return code, q.Get("state")
+}
+
+// Signs the ID token of the code with another key.
+func (idp *testOidcIdp) forge(tb testing.TB, code string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(tb, err)
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.grants[code].key = key
+}
+
+func (idp *testOidcIdp) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeTestJson(
+		w, http.StatusOK, map[string]interface{}{
+			"issuer":                                idp.server.URL,
+			"authorization_endpoint":                idp.server.URL + "/authorize",
+			"token_endpoint":                        idp.server.URL + "/token",
+			"jwks_uri":                              idp.server.URL + "/jwks",
+			"response_types_supported":              []string{"code"},
+			"subject_types_supported":               []string{"public"},
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		},
+	)
+}
+
+func (idp *testOidcIdp) jwks(w http.ResponseWriter, _ *http.Request) {
+	pub := idp.key.PublicKey
+	writeTestJson(
+		w, http.StatusOK, map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "test",
+					"alg": "RS256",
+					"use": "sig",
+					"n": base64.RawURLEncoding.EncodeToString(
+						pub.N.Bytes(),
+					),
+					"e": base64.RawURLEncoding.EncodeToString(
+						big.NewInt(int64(pub.E)).Bytes(),
+					),
+				},
+			},
+		},
+	)
+}
+
+func (idp *testOidcIdp) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil ||
+		"authorization_code" != r.PostForm.Get("grant_type") {
+		writeTestJson(
+			w, http.StatusBadRequest,
+			map[string]string{"error": "invalid_request"},
+		)
+		return
+	}
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		id = r.PostForm.Get("client_id")
+		secret = r.PostForm.Get("client_secret")
+	}
+	if testOidcClientId != id || testOidcClientSecret != secret {
+		writeTestJson(
+			w, http.StatusUnauthorized,
+			map[string]string{"error": "invalid_client"},
+		)
+		return
+	}
+	idp.mu.Lock()
+	grant := idp.grants[r.PostForm.Get("code")]
+	// codes can only be used once
+	delete(idp.grants, r.PostForm.Get("code"))
+	idp.mu.Unlock()
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if nil == grant ||
+		grant.challenge != base64.RawURLEncoding.EncodeToString(sum[:]) {
+		writeTestJson(
+			w, http.StatusBadRequest,
+			map[string]string{"error": "invalid_grant"},
+		)
+		return
+	}
+	key := idp.key
+	if nil != grant.key {
+		key = grant.key
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, grant.claims)
+	t.Header["kid"] = "test"
+	signed, err := t.SignedString(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeTestJson(
+		w, http.StatusOK, map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   300,
+			"id_token":     signed,
+		},
+	)
+}
+
+func writeTestJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func Test_UseOidcProviders_sets_defaults(t *testing.T) {
+	svr := &Server{}
+	p := &OidcProvider{Name: "idp", Scopes: []string{}, GroupsClaim: "roles"}
+	svr.UseOidcProviders(p)
+	require.Equal(t, []string{}, p.Scopes)
+	require.Equal(t, uint32(api.DefaultOrganizationId), p.Organization)
+	require.Equal(t, "preferred_username", p.UsernameClaim)
+	require.Equal(t, "roles", p.GroupsClaim)
+	require.Same(t, p, svr.oidcProvider("idp"))
+	require.Nil(t, svr.oidcProvider("other"))
+	p = &OidcProvider{Name: "idp"}
+	svr.UseOidcProviders(p)
+	require.Equal(t, []string{"profile", "email"}, p.Scopes)
+}
+
+func Test_OidcProvider_identity_maps_claims(t *testing.T) {
+	p := &OidcProvider{
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "groups",
+		Attributes: map[string]string{
+			"dept": "dept", "level": "level", "team": "team", "ratio": "ratio",
+		},
+	}
+	id := p.identity(
+		"sub", map[string]interface{}{
+			"preferred_username": "alice",
+			"email":              "alice@example.com",
+			"groups":             []interface{}{"admins", 1, "staff"},
+			"dept":               float64(7),
+			"level":              "3",
+			"team":               "sales",
+			"ratio":              1.5,
+		},
+	)
+	require.Equal(
+		t, &Identity{
+			Username: "alice",
+			Email:    "alice@example.com",
+			Groups:   []string{"admins", "staff"},
+			Attr: map[string]interface{}{
+				"dept": float64(7), "level": float64(3),
+			},
+		}, id,
+	)
+}
+
+func Test_OidcProvider_identity_falls_back_to_email_and_subject(t *testing.T) {
+	p := &OidcProvider{UsernameClaim: "preferred_username", GroupsClaim: "g"}
+	id := p.identity(
+		"sub", map[string]interface{}{"email": "a@example.com", "g": "admins"},
+	)
+	require.Equal(t, "a@example.com", id.Username)
+	require.Equal(t, []string{"admins"}, id.Groups)
+	require.Nil(t, id.Attr)
+	id = p.identity("sub", map[string]interface{}{})
+	require.Equal(t, &Identity{Username: "sub"}, id)
+}
@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/passwordhistory"
+)
+
+// Operations available to users who must replace a temporary password before
+// anything else.
+var passwordChangeOperations = []string{"auth:ChangePassword", "auth:Logout"}
+
+// Character classes a password policy can require, in the order they are
+// described to users.
+var passwordClasses = []struct {
+	name    string
+	label   string
+	checker *regexp.Regexp
+}{
+	{api.PasswordClassUpper, "uppercase", uppercaseChecker},
+	{api.PasswordClassLower, "lowercase", lowercaseChecker},
+	{api.PasswordClassDigit, "number", numChecker},
+	{api.PasswordClassSpecial, "special characters (#?!@$%^&*-_)",
+		specialChecker},
+}
+
+// PasswordPolicy decides which passwords users can choose.
+type PasswordPolicy struct {
+	// Minimum number of characters.
+	MinLength int
+	// Maximum number of characters, zero for no limit.
+	MaxLength int
+	// Character classes required, of `api.PasswordClass*`.
+	Classes []string
+	// Number of recent passwords, including the current one, that can't be
+	// chosen again. Zero disables the history.
+	History int
+	// lower cased passwords that are never accepted
+	rejected map[string]struct{}
+}
+
+// passwordPolicyError is the error of a new password violating the policy.
+type passwordPolicyError struct {
+	reason string
+}
+
+func (e *passwordPolicyError) Error() string {
+	return e.reason
+}
+
+// response returns the 400 response body explaining the violation.
+func (e *passwordPolicyError) response() N400JSONResponse {
+	var reason interface{} = e.reason
+	return N400JSONResponse{
+		Code:   http.StatusBadRequest,
+		Errors: &reason,
+		Status: msgError,
+	}
+}
+
+// DefaultPasswordPolicy returns the policy requiring 8 to 72 characters from
+// all character classes, without history or reject list.
+func DefaultPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength: 8,
+		MaxLength: 72,
+		Classes: []string{
+			api.PasswordClassUpper, api.PasswordClassLower,
+			api.PasswordClassDigit, api.PasswordClassSpecial,
+		},
+	}
+}
+
+// LoadRejectList adds the passwords read from `r`, one per line, to the
+// passwords that are never accepted. They are compared case-insensitively.
+func (p *PasswordPolicy) LoadRejectList(r io.Reader) error {
+	if nil == p.rejected {
+		p.rejected = map[string]struct{}{}
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		password := strings.TrimSpace(scanner.Text())
+		if "" != password {
+			p.rejected[strings.ToLower(password)] = struct{}{}
+		}
+	}
+	return scanner.Err()
+}
+
+// Validate checks the length, the character classes and the reject list.
+// The history is checked by Server.rotatePassword.
+func (p *PasswordPolicy) Validate(password string) error {
+	n := utf8.RuneCountInString(password)
+	if n < p.MinLength || (p.MaxLength > 0 && n > p.MaxLength) {
+		return &passwordPolicyError{p.requirement()}
+	}
+	for _, class := range passwordClasses {
+		if slices.Contains(p.Classes, class.name) &&
+			!class.checker.MatchString(password) {
+			return &passwordPolicyError{p.requirement()}
+		}
+	}
+	if _, ok := p.rejected[strings.ToLower(password)]; ok {
+		return &passwordPolicyError{"password is too common"}
+	}
+	return nil
+}
+
+// Describes the length and character classes required.
+func (p *PasswordPolicy) requirement() string {
+	var b strings.Builder
+	if p.MaxLength > 0 {
+		fmt.Fprintf(
+			&b, "password must contain %d to %d characters",
+			p.MinLength, p.MaxLength,
+		)
+	} else {
+		fmt.Fprintf(
+			&b, "password must contain at least %d characters", p.MinLength,
+		)
+	}
+	var labels []string
+	for _, class := range passwordClasses {
+		if slices.Contains(p.Classes, class.name) {
+			labels = append(labels, class.label)
+		}
+	}
+	switch len(labels) {
+	case 0:
+	case 1:
+		b.WriteString(", including " + labels[0])
+	default:
+		b.WriteString(
+			", including " + strings.Join(labels[:len(labels)-1], ", ") +
+				", and " + labels[len(labels)-1],
+		)
+	}
+	return b.String()
+}
+
+// UsePasswordPolicy replaces the password policy of the server.
+func (s *Server) UsePasswordPolicy(p *PasswordPolicy) {
+	s.passwordPolicy = p
+}
+
+// Returns errPasswordChangeRequired if the user has a temporary password,
+// unless the operation changes it.
+func checkMustChangePassword(u *ent.User, operation string) error {
+	if !u.MustChangePassword ||
+		slices.Contains(passwordChangeOperations, operation) {
+		return nil
+	}
+	return errPasswordChangeRequired
+}
+
+// Validates the new password, and returns its hash to store.
+func (s Server) hashNewPassword(password string) (string, error) {
+	if err := s.passwordPolicy.Validate(password); err != nil {
+		return "", err
+	}
+	return s.hashPassword(password)
+}
+
+// Checks the new password against the recent passwords of the user, then
+// moves the current password to the history. It must be called in the
+// transaction replacing the password, with the user loaded before the change.
+func (s Server) rotatePassword(
+	qc context.Context, tx *ent.Tx, u *ent.User, password string,
+) error {
+	n := s.passwordPolicy.History
+	if n < 1 {
+		return nil
+	}
+	hashes := []string{u.Password}
+	if n > 1 {
+		recent, err := tx.PasswordHistory.Query().
+			Where(passwordhistory.UserIDEQ(u.ID)).
+			Order(
+				ent.Desc(passwordhistory.FieldCreatedAt),
+				ent.Desc(passwordhistory.FieldID),
+			).Limit(n - 1).All(qc)
+		if err != nil {
+			return err
+		}
+		for _, h := range recent {
+			hashes = append(hashes, h.Password)
+		}
+	}
+	for _, hash := range hashes {
+		// hashes that can't be parsed never match
+		if m, _, err := s.verifyPassword(password, hash); nil == err && m {
+			return &passwordPolicyError{
+				fmt.Sprintf(
+					"password must differ from the last %d passwords", n,
+				),
+			}
+		}
+	}
+	if n < 2 {
+		return nil
+	}
+	err := tx.PasswordHistory.Create().SetUserID(u.ID).
+		SetPassword(u.Password).Exec(qc)
+	if err != nil {
+		return err
+	}
+	// only the most recent n-1 previous passwords are needed
+	stale, err := tx.PasswordHistory.Query().
+		Where(passwordhistory.UserIDEQ(u.ID)).
+		Order(
+			ent.Desc(passwordhistory.FieldCreatedAt),
+			ent.Desc(passwordhistory.FieldID),
+		).Offset(n - 1).IDs(qc)
+	if err != nil || 0 == len(stale) {
+		return err
+	}
+	_, err = tx.PasswordHistory.Delete().
+		Where(passwordhistory.IDIn(stale...)).Exec(qc)
+	return err
+}
@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eidng8/go-utils"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/passwordhistory"
+)
+
+// Sets the password of the user, stored hashed like createUser does.
+func setPassword(tb testing.TB, db *ent.Client, id uint64, password string) {
+	hash, err := utils.HashPassword(password)
+	require.Nil(tb, err)
+	db.User.UpdateOneID(id).SetPassword(hash).ExecX(context.Background())
+}
+
+// Issues an access token of the user as if it was issued at the given time.
+func issueTokenAt(
+	tb testing.TB, svr *Server, u *ent.User, iat time.Time,
+) string {
+	_, claims, err := svr.buildTokenClaims(u, time.Hour)
+	require.Nil(tb, err)
+	claims.IssuedAt = jwt.NewNumericDate(iat)
+	token, err := svr.issueJwtTokenWithClaims(jwt.SigningMethodHS256, claims)
+	require.Nil(tb, err)
+	return token
+}
+
+// Lists users with the bearer token, returns the response.
+func getUsersWithToken(
+	tb testing.TB, svr *Server, engine http.Handler, token string,
+) *httptest.ResponseRecorder {
+	req, err := svr.get("/users")
+	require.Nil(tb, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+// Asserts that the default policy rejects the password for its length or
+// character classes.
+func requirePasswordTooSimple(t *testing.T, password string) {
+	p := DefaultPasswordPolicy()
+	require.Equal(t, &passwordPolicyError{p.requirement()}, p.Validate(password))
+}
+
+func Test_PasswordPolicy_Validate_rejects_numeric_only_password(t *testing.T) {
+	requirePasswordTooSimple(t, "12345678")
+}
+
+func Test_PasswordPolicy_Validate_rejects_uppercase_only_password(t *testing.T) {
+	requirePasswordTooSimple(t, "ABCDEFGH")
+}
+
+func Test_PasswordPolicy_Validate_rejects_lowercase_only_password(t *testing.T) {
+	requirePasswordTooSimple(t, "abcdefgh")
+}
+
+func Test_PasswordPolicy_Validate_rejects_special_only_password(t *testing.T) {
+	requirePasswordTooSimple(t, "#?!@$%^&*-_")
+}
+
+func Test_PasswordPolicy_Validate_rejects_numeric_and_uppercase_password(t *testing.T) {
+	requirePasswordTooSimple(t, "1234ABCD")
+}
+
+func Test_PasswordPolicy_Validate_rejects_numeric_and_lowercase_password(t *testing.T) {
+	requirePasswordTooSimple(t, "1234abcd")
+}
+
+func Test_PasswordPolicy_Validate_rejects_numeric_and_special_password(t *testing.T) {
+	requirePasswordTooSimple(t, "1234#?!@")
+}
+
+func Test_PasswordPolicy_Validate_rejects_uppercase_and_lowercase_password(t *testing.T) {
+	requirePasswordTooSimple(t, "ABCDabcd")
+}
+
+func Test_PasswordPolicy_Validate_rejects_uppercase_and_special_password(t *testing.T) {
+	requirePasswordTooSimple(t, "ABCD#?!@")
+}
+
+func Test_PasswordPolicy_Validate_rejects_lowercase_and_special_password(t *testing.T) {
+	requirePasswordTooSimple(t, "abcd#?!@")
+}
+
+func Test_PasswordPolicy_Validate_accepts_complex_password(t *testing.T) {
+	require.Nil(t, DefaultPasswordPolicy().Validate("Abcd_1234"))
+}
+
+func Test_checkValidAfter_rejects_tokens_issued_before_password_change(
+	t *testing.T,
+) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_123")
+	old := issueTokenAt(
+		t, svr, getUserById(t, db, 1), time.Now().Add(-time.Minute),
+	)
+	res := tryChangePassword(t, svr, engine, old, "Test_123", "Test_456")
+	require.Equal(t, http.StatusNoContent, res.Code)
+	cat, _ := getTokensFromSetCookieHeaders(t, res)
+	res = getUsersWithToken(t, svr, engine, old)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	res = getUsersWithToken(t, svr, engine, cat.Value)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_checkValidAfter_rejects_refresh_tokens_issued_before_password_change(
+	t *testing.T,
+) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_123")
+	old := issueTokenAt(
+		t, svr, getUserById(t, db, 1), time.Now().Add(-time.Minute),
+	)
+	res := tryChangePassword(t, svr, engine, old, "Test_123", "Test_456")
+	require.Equal(t, http.StatusNoContent, res.Code)
+	tk, err := svr.jwtTokenFromString(old)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkRefreshToken(), errInvalidToken)
+}
+
+func Test_checkMustChangePassword_allows_only_password_change(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	db.User.UpdateOneID(1).SetMustChangePassword(true).
+		ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	at, err := svr.issueAccessToken(u)
+	require.Nil(t, err)
+	res := getUsersWithToken(t, svr, engine, at)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	requireJsonEqualsString(
+		t, map[string]interface{}{
+			"code":   http.StatusForbidden,
+			"errors": msgPasswordChangeRequired,
+			"status": msgError,
+		}, res.Body.String(),
+	)
+	require.Nil(t, checkMustChangePassword(u, "auth:ChangePassword"))
+	require.Nil(t, checkMustChangePassword(u, "auth:Logout"))
+}
+
+func Test_checkMustChangePassword_precedes_mfa_enrollment(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	db.Role.UpdateOneID(1).SetRequireMfa(true).ExecX(qc)
+	db.User.UpdateOneID(1).SetMustChangePassword(true).ExecX(qc)
+	setPassword(t, db, 1, "Test_123")
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/me/password",
+		ChangePasswordJSONRequestBody{
+			CurrentPassword: "Test_123", Password: "Test_456",
+		},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+}
+
+func Test_PasswordPolicy_Validate_rejects_short_password(t *testing.T) {
+	requirePasswordTooSimple(t, "Ab_1")
+}
+
+func Test_PasswordPolicy_Validate_rejects_long_password(t *testing.T) {
+	requirePasswordTooSimple(t, "Ab_1"+strings.Repeat("x", 69))
+	require.Nil(
+		t, DefaultPasswordPolicy().Validate("Ab_1"+strings.Repeat("x", 68)),
+	)
+}
+
+func Test_PasswordPolicy_Validate_counts_only_listed_special_characters(t *testing.T) {
+	requirePasswordTooSimple(t, "Abcd1234")
+	requirePasswordTooSimple(t, "Abcd1234+")
+	requirePasswordTooSimple(t, "Abcd1234[")
+	require.Nil(t, DefaultPasswordPolicy().Validate("Abcd1234-"))
+}
+
+func Test_PasswordPolicy_Validate_requires_configured_classes_only(t *testing.T) {
+	p := &PasswordPolicy{MinLength: 12, Classes: []string{api.PasswordClassDigit}}
+	require.Nil(t, p.Validate("abcdefghijk1"))
+	require.Equal(
+		t, &passwordPolicyError{
+			"password must contain at least 12 characters, including number",
+		}, p.Validate("abcdefghijkl"),
+	)
+	p.Classes = nil
+	require.Nil(t, p.Validate("abcdefghijkl"))
+	require.Equal(
+		t, "password must contain at least 12 characters", p.requirement(),
+	)
+}
+
+func Test_PasswordPolicy_requirement_describes_default_policy(t *testing.T) {
+	require.Equal(
+		t,
+		"password must contain 8 to 72 characters, including uppercase, "+
+			"lowercase, number, and special characters (#?!@$%^&*-_)",
+		DefaultPasswordPolicy().requirement(),
+	)
+}
+
+func Test_PasswordPolicy_Validate_rejects_listed_passwords(t *testing.T) {
+	p := DefaultPasswordPolicy()
+	err := p.LoadRejectList(strings.NewReader("Password_1\n\n  Summer_2024 \n"))
+	require.Nil(t, err)
+	expected := &passwordPolicyError{"password is too common"}
+	require.Equal(t, expected, p.Validate("Password_1"))
+	require.Equal(t, expected, p.Validate("pASSWORd_1"))
+	require.Equal(t, expected, p.Validate("Summer_2024"))
+	require.Nil(t, p.Validate("Summer_2025"))
+}
+
+func Test_rotatePassword_rejects_recent_passwords(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	svr.passwordPolicy.History = 3
+	setPassword(t, db, 1, "Test_001")
+	change := func(current, password string) *httptest.ResponseRecorder {
+		at, err := svr.issueAccessToken(getUserById(t, db, 1))
+		require.Nil(t, err)
+		return tryChangePassword(t, svr, engine, at, current, password)
+	}
+	res := change("Test_001", "Test_001")
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	requireJsonEqualsString(
+		t, map[string]interface{}{
+			"code":   http.StatusBadRequest,
+			"errors": "password must differ from the last 3 passwords",
+			"status": msgError,
+		}, res.Body.String(),
+	)
+	require.Equal(t, http.StatusNoContent, change("Test_001", "Test_002").Code)
+	require.Equal(t, http.StatusNoContent, change("Test_002", "Test_003").Code)
+	require.Equal(t, http.StatusBadRequest, change("Test_003", "Test_001").Code)
+	require.Equal(t, http.StatusNoContent, change("Test_003", "Test_004").Code)
+	// only the last 3 passwords are remembered
+	require.Equal(t, http.StatusNoContent, change("Test_004", "Test_001").Code)
+	require.Equal(
+		t, 2, db.PasswordHistory.Query().
+			Where(passwordhistory.UserIDEQ(1)).CountX(context.Background()),
+	)
+}
+
+func Test_rotatePassword_allows_reuse_without_history(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	setPassword(t, db, 1, "Test_001")
+	at, err := svr.issueAccessToken(getUserById(t, db, 1))
+	require.Nil(t, err)
+	res := tryChangePassword(t, svr, engine, at, "Test_001", "Test_001")
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Zero(t, db.PasswordHistory.Query().CountX(context.Background()))
+}
+
+func Test_rotatePassword_applies_to_password_reset(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	svr.passwordPolicy.History = 2
+	setPassword(t, db, 2, "Test_001")
+	token := requestPasswordReset(t, svr, engine, *getUserById(t, db, 2).Email)
+	res := tryResetPassword(t, svr, engine, token, "Test_001")
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	// the token is kept for another try
+	res = tryResetPassword(t, svr, engine, token, "Test_002")
+	require.Equal(t, http.StatusNoContent, res.Code)
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+)
+
+// ReadAccessRequest reads an access request.
+//
+// Endpoint: GET /access-request/{id}
+func (s Server) ReadAccessRequest(
+	ctx context.Context, request ReadAccessRequestRequestObject,
+) (ReadAccessRequestResponseObject, error) {
+	scope, err := s.accessRequestScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ReadAccessRequest error: %v", err)
+		return nil, err
+	}
+	ar, err := s.db.AccessRequest.Query().
+		Where(accessrequest.IDEQ(request.Id), scope).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ReadAccessRequest404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ReadAccessRequest error: %v", err)
+		return nil, err
+	}
+	return ReadAccessRequest200JSONResponse(accessRequestRead(ar)), nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadAccessRequest_returns_a_request(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ar := seedAccessRequest(t, db, 3, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, fmt.Sprintf("/access-request/%d", ar.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ReadAccessRequest200JSONResponse{}, res)
+	require.Equal(t, ar.ID, actual.Id)
+	require.Equal(t, uint64(3), actual.RequesterId)
+	require.Equal(t, uint32(5), actual.RoleId)
+	require.Equal(t, "justification 0", actual.Justification)
+	require.Equal(t, AccessRequestReadStatus("pending"), actual.Status)
+}
+
+func Test_ReadAccessRequest_returns_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/access-request/12345")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ReadAccessRequest_returns_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	ar := seedAccessRequest(t, db, 3, 5)
+	_, u, _ := seedOrganization(t, db, "auth:ReadAccessRequest")
+	req, err := svr.getAs(u, fmt.Sprintf("/access-request/%d", ar.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ReadAccessRequest_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/access-request/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ReadAccessRequest_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.getAs(u, "/access-request/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_ReadAccessRequest_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/access-request/1")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+)
+
+// ReadGroup reads a group.
+//
+// Endpoint: GET /group/{id}
+func (s Server) ReadGroup(
+	ctx context.Context, request ReadGroupRequestObject,
+) (ReadGroupResponseObject, error) {
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ReadGroup error: %v", err)
+		return nil, err
+	}
+	g, err := s.db.Group.Query().Where(group.IDEQ(request.Id), scope).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ReadGroup404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ReadGroup error: %v", err)
+		return nil, err
+	}
+	return ReadGroup200JSONResponse{
+		Id:             g.ID,
+		OrganizationId: g.OrganizationID,
+		Name:           g.Name,
+		Description:    &g.Description,
+		CreatedAt:      g.CreatedAt,
+		UpdatedAt:      g.UpdatedAt,
+	}, nil
+}
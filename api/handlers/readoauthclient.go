@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+)
+
+// ReadOauthClient reads an OAuth client, without its secret.
+//
+// Endpoint: GET /oauth-client/{id}
+func (s Server) ReadOauthClient(
+	ctx context.Context, request ReadOauthClientRequestObject,
+) (ReadOauthClientResponseObject, error) {
+	scope, err := s.oauthClientScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ReadOauthClient error: %v", err)
+		return nil, err
+	}
+	c, err := s.db.OauthClient.Query().
+		Where(oauthclient.ID(request.Id), scope).Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ReadOauthClient404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ReadOauthClient error: %v", err)
+		return nil, err
+	}
+	res := ReadOauthClient200JSONResponse{
+		Id:             c.ID,
+		OrganizationId: c.OrganizationID,
+		Name:           c.Name,
+		ClientId:       c.ClientID,
+		Public:         c.Public,
+		GrantTypes:     stringsTo[OauthClientReadGrantTypes](c.GrantTypes),
+		PublicKey:      c.PublicKey,
+		UserId:         c.UserID,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+	}
+	if nil != c.RedirectUris {
+		res.RedirectUris = &c.RedirectUris
+	}
+	return res, nil
+}
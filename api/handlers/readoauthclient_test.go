@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+func Test_ReadOauthClient_returns_client_without_secret(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c, secret := seedOauthClient(
+		t, db, false, nil, api.OauthGrantAuthorizationCode,
+	)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, fmt.Sprintf("/oauth-client/%d", c.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.NotContains(t, res.Body.String(), secret)
+	require.NotContains(t, res.Body.String(), *c.Secret)
+	actual := unmarshalResponse(t, OauthClientRead{}, res)
+	require.Equal(t, c.ID, actual.Id)
+	require.Equal(t, c.ClientID, actual.ClientId)
+	require.Equal(t, []string{testOauthRedirectUri}, *actual.RedirectUris)
+	require.Equal(
+		t, []OauthClientReadGrantTypes{api.OauthGrantAuthorizationCode},
+		actual.GrantTypes,
+	)
+}
+
+func Test_ReadOauthClient_returns_404_if_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, "/oauth-client/12345")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ReadOauthClient_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.get("/oauth-client/1")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_ReadOauthClient_returns_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	c, _ := seedOauthClient(t, db, false, nil, api.OauthGrantAuthorizationCode)
+	_, u, _ := seedOrganization(t, db, "auth:ReadOauthClient")
+	req, err := svr.getAs(u, fmt.Sprintf("/oauth-client/%d", c.ID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+)
+
+// ReadOrganization reads an organization. Users other than platform
+// administrators can only read their own organization.
+//
+// Endpoint: GET /organization/{id}
+func (s Server) ReadOrganization(
+	ctx context.Context, request ReadOrganizationRequestObject,
+) (ReadOrganizationResponseObject, error) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ReadOrganization error: %v", err)
+		return nil, err
+	}
+	if nil != tenant && *tenant != request.Id {
+		return ReadOrganization404JSONResponse{}, nil
+	}
+	o, err := s.db.Organization.Query().
+		Where(organization.ID(request.Id)).Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ReadOrganization404JSONResponse{}, nil
+		}
+		api.Log.Debugf("ReadOrganization error: %v", err)
+		return nil, err
+	}
+	return ReadOrganization200JSONResponse{
+		Id:          o.ID,
+		Name:        o.Name,
+		Description: &o.Description,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+	}, nil
+}
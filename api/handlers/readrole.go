@@ -14,7 +14,12 @@ import (
 func (s Server) ReadRole(
 	ctx context.Context, request ReadRoleRequestObject,
 ) (ReadRoleResponseObject, error) {
-	r, err := s.db.Role.Query().Where(role.ID(request.Id)).Only(ctx)
+	scope, err := s.roleScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ReadRole error: %v", err)
+		return nil, err
+	}
+	r, err := s.db.Role.Query().Where(role.ID(request.Id), scope).Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return ReadRole404JSONResponse{}, nil
@@ -26,6 +31,7 @@ func (s Server) ReadRole(
 		Id:          r.ID,
 		Name:        r.Name,
 		Description: &r.Description,
+		RequireMfa:  r.RequireMfa,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
 	}, nil
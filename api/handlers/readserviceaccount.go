@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+)
+
+// ReadServiceAccount reads a service account, without its credentials.
+//
+// Endpoint: GET /service-account/{id}
+func (s Server) ReadServiceAccount(
+	ctx context.Context, request ReadServiceAccountRequestObject,
+) (ReadServiceAccountResponseObject, error) {
+	scope, err := s.serviceAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ReadServiceAccount error: %v", err)
+		return nil, err
+	}
+	sa, err := s.db.ServiceAccount.Query().
+		Where(serviceaccount.IDEQ(request.Id), scope).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ReadServiceAccount404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ReadServiceAccount error: %v", err)
+		return nil, err
+	}
+	return ReadServiceAccount200JSONResponse{
+		Id:          sa.ID,
+		Name:        sa.Name,
+		Description: &sa.Description,
+		OwnerId:     sa.OwnerID,
+		UserId:      sa.UserID,
+		ClientId:    sa.ClientID,
+		CreatedAt:   sa.CreatedAt,
+		UpdatedAt:   sa.UpdatedAt,
+	}, nil
+}
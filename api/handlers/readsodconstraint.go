@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+// ReadSodConstraint reads a separation-of-duties constraint.
+//
+// Endpoint: GET /sod-constraint/{id}
+func (s Server) ReadSodConstraint(
+	ctx context.Context, request ReadSodConstraintRequestObject,
+) (ReadSodConstraintResponseObject, error) {
+	scope, err := s.sodConstraintScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ReadSodConstraint error: %v", err)
+		return nil, err
+	}
+	c, err := s.db.SodConstraint.Query().
+		Where(sodconstraint.IDEQ(request.Id), scope).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ReadSodConstraint404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ReadSodConstraint error: %v", err)
+		return nil, err
+	}
+	return ReadSodConstraint200JSONResponse{
+		Id:             c.ID,
+		OrganizationId: c.OrganizationID,
+		Name:           c.Name,
+		Description:    &c.Description,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+	}, nil
+}
@@ -17,7 +17,12 @@ import (
 func (s Server) ReadUser(
 	ctx context.Context, request ReadUserRequestObject,
 ) (ReadUserResponseObject, error) {
-	u, err := s.db.User.Query().Where(user.ID(request.Id)).Only(
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ReadUser error: %v", err)
+		return nil, err
+	}
+	u, err := s.db.User.Query().Where(user.ID(request.Id), scope).Only(
 		softdelete.NewSoftDeleteQueryContext(
 			request.Params.Trashed, context.Background(),
 		),
@@ -29,12 +34,14 @@ func (s Server) ReadUser(
 		api.Log.Debugf("ReadUser error: %v", err)
 		return nil, err
 	}
+	status := UserReadStatus(u.Status)
 	res := ReadUser200JSONResponse{
 		Id:        u.ID,
 		Username:  u.Username,
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: u.UpdatedAt,
 		DeletedAt: u.DeletedAt,
+		Status:    &status,
 	}
 	if nil != u.Email && "" != *u.Email {
 		email := types.Email(*u.Email)
@@ -20,6 +20,7 @@ func Test_ReadUser_returns_a_user(t *testing.T) {
 	require.Equal(t, uint64(2), actual.Id)
 	require.Equal(t, "user0", actual.Username)
 	require.NotNil(t, actual.Email)
+	require.Equal(t, Active, *actual.Status)
 }
 
 func Test_ReadUser_returns_trashed_user(t *testing.T) {
@@ -55,6 +56,16 @@ func Test_ReadUser_returns_404_if_soft_deleted(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, res.Code)
 }
 
+func Test_ReadUser_returns_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.getAs(u, fmt.Sprintf("/user/%d", a.UserID))
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
 func Test_ReadUser_returns_a_user_without_email(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, true)
 	n := db.User.Create().SetUsername("test user").SetPassword("test password").
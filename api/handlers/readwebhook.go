@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+)
+
+// ReadWebhook reads a webhook.
+//
+// Endpoint: GET /webhook/{id}
+func (s Server) ReadWebhook(
+	ctx context.Context, request ReadWebhookRequestObject,
+) (ReadWebhookResponseObject, error) {
+	scope, err := s.webhookScope(ctx)
+	if err != nil {
+		api.Log.Debugf("ReadWebhook error: %v", err)
+		return nil, err
+	}
+	w, err := s.db.Webhook.Query().Where(webhook.ID(request.Id), scope).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ReadWebhook404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ReadWebhook error: %v", err)
+		return nil, err
+	}
+	return ReadWebhook200JSONResponse{
+		Id:             w.ID,
+		OrganizationId: w.OrganizationID,
+		Url:            w.URL,
+		Events:         stringsTo[WebhookReadEvents](w.Events),
+		Active:         w.Active,
+		CreatedAt:      w.CreatedAt,
+		UpdatedAt:      w.UpdatedAt,
+	}, nil
+}
@@ -8,13 +8,13 @@ import (
 	"github.com/eidng8/go-attr-rbac/api"
 )
 
-// RefreshAccessToken refreshes the current access token.
+// RefreshAccessToken refreshes the current access token. The current access
+// and refresh tokens are revoked, and the session continues with new ones.
 //
 // Endpoint: POST /access-token/refresh
 func (s Server) RefreshAccessToken(
 	ctx context.Context, _ RefreshAccessTokenRequestObject,
 ) (RefreshAccessTokenResponseObject, error) {
-	// TODO revoke current tokens but don't set cookies
 	gc, ok := ctx.(*gin.Context)
 	if !ok {
 		return nil, errInvalidContext
@@ -23,16 +23,9 @@ func (s Server) RefreshAccessToken(
 	if err != nil {
 		return RefreshAccessToken401JSONResponse{}, nil
 	}
-	at, err := s.issueAccessToken(token.user)
-	if err != nil {
-		api.Log.Debugf("failed to issue access token: %v", err)
-		return RefreshAccessToken401JSONResponse{}, nil
-	}
-	rt, err := s.issueRefreshToken(token.user)
-	if err != nil {
-		api.Log.Debugf("failed to issue refresh token: %v", err)
+	if err = s.refreshSession(gc, token); err != nil {
+		api.Log.Debugf("failed to refresh session: %v", err)
 		return RefreshAccessToken401JSONResponse{}, nil
 	}
-	s.setToken(gc, at, rt)
 	return RefreshAccessToken204Response{}, nil
 }
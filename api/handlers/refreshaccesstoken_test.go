@@ -11,7 +11,7 @@ import (
 )
 
 func Test_RefreshAccessToken_sets_token_cookies(t *testing.T) {
-	svr, engine, db, res := setupTestCase(t, false)
+	svr, engine, db, res := setupTestCase(t, true)
 	u := getUserById(t, db, 1)
 	req, err := svr.postAs(u, "/access-token/refresh", nil)
 	require.Nil(t, err)
@@ -75,3 +75,25 @@ func Test_RefreshAccessToken_returns_500_if_invalid_context(t *testing.T) {
 	)
 	require.ErrorIs(t, err, errInvalidContext)
 }
+
+func Test_RefreshAccessToken_returns_401_if_impersonation_token(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	at := impersonate(t, svr, engine, db, 2)
+	req, err := http.NewRequest(http.MethodPost, "/access-token/refresh", nil)
+	require.Nil(t, err)
+	req.AddCookie(
+		&http.Cookie{
+			Name:     refreshTokenName,
+			Value:    at,
+			Path:     api.RefreshTokenPath,
+			Domain:   svr.Domain(),
+			MaxAge:   86400,
+			Secure:   true,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+		},
+	)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Empty(t, res.Result().Cookies())
+}
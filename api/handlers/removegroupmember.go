@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// RemoveGroupMember removes a user from a group.
+//
+// Endpoint: DELETE /group/{id}/user/{user_id}
+func (s Server) RemoveGroupMember(
+	ctx context.Context, request RemoveGroupMemberRequestObject,
+) (RemoveGroupMemberResponseObject, error) {
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("RemoveGroupMember error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			// 404 if the user is not a member of the group
+			g, err := tx.Group.Query().Where(
+				group.IDEQ(request.Id), scope,
+				group.HasUsersWith(user.IDEQ(request.UserId)),
+			).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = tx.Group.UpdateOne(g).RemoveUserIDs(request.UserId).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, userRolesChanged(qc, tx, request.UserId)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return RemoveGroupMember404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("RemoveGroupMember error: %v", err)
+		return nil, err
+	}
+	return RemoveGroupMember204Response{}, nil
+}
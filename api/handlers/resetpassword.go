@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/passwordreset"
+)
+
+// ResetPassword sets a new password with a token sent by ForgotPassword. The
+// token can only be used once. Sessions started before the reset are
+// rejected, and the lockout of the account is lifted.
+//
+// Endpoint: POST /password/reset
+func (s Server) ResetPassword(
+	_ context.Context, request ResetPasswordRequestObject,
+) (ResetPasswordResponseObject, error) {
+	hash, err := s.hashNewPassword(request.Body.Password)
+	if err != nil {
+		var pe *passwordPolicyError
+		if errors.As(err, &pe) {
+			return ResetPassword400JSONResponse{pe.response()}, nil
+		}
+		api.Log.Debugf("ResetPassword error: %v", err)
+		return nil, err
+	}
+	token := hashPasswordResetToken(request.Body.Token)
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			now := time.Now()
+			r, err := tx.PasswordReset.Query().Where(
+				passwordreset.TokenEQ(token), passwordreset.UsedAtIsNil(),
+				passwordreset.ExpiresAtGT(now),
+			).Only(qc)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					return nil, errInvalidToken
+				}
+				return nil, err
+			}
+			// consumes the token, unless a concurrent request was faster
+			n, err := tx.PasswordReset.Update().Where(
+				passwordreset.IDEQ(r.ID), passwordreset.UsedAtIsNil(),
+			).SetUsedAt(now).Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			if n < 1 {
+				return nil, errInvalidToken
+			}
+			u, err := tx.User.Get(qc, r.UserID)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					return nil, errInvalidToken
+				}
+				return nil, err
+			}
+			err = s.rotatePassword(qc, tx, u, request.Body.Password)
+			if err != nil {
+				return nil, err
+			}
+			if err = revokeUserTokens(qc, tx, u.ID); err != nil {
+				return nil, err
+			}
+			err = tx.User.UpdateOne(u).SetPassword(hash).
+				SetMustChangePassword(false).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = s.loginAttempts.Reset(qc, userAttemptKey(u.ID))
+			if err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditPasswordReset, u.OrganizationID, nil,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+		},
+	)
+	if err != nil {
+		var pe *passwordPolicyError
+		if errors.As(err, &pe) {
+			return ResetPassword400JSONResponse{pe.response()}, nil
+		}
+		if errors.Is(err, errInvalidToken) {
+			var msg interface{} = errInvalidToken.Error()
+			return ResetPassword400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msg,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("ResetPassword error: %v", err)
+		return nil, err
+	}
+	return ResetPassword204Response{}, nil
+}
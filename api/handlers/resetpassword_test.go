@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eidng8/go-utils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/passwordreset"
+)
+
+// Resets the password with the token, returns the response.
+func tryResetPassword(
+	tb testing.TB, svr *Server, engine http.Handler, token, password string,
+) *httptest.ResponseRecorder {
+	req, err := svr.post(
+		"/password/reset",
+		ResetPasswordJSONRequestBody{Token: token, Password: password},
+	)
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+func requireInvalidResetToken(tb *testing.T, res *httptest.ResponseRecorder) {
+	require.Equal(tb, http.StatusBadRequest, res.Code)
+	requireJsonEqualsString(
+		tb, map[string]interface{}{
+			"code":   http.StatusBadRequest,
+			"errors": errInvalidToken.Error(),
+			"status": msgError,
+		}, res.Body.String(),
+	)
+}
+
+func Test_ResetPassword_sets_new_password(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u := getUserById(t, db, 2)
+	old := issueTokenAt(t, svr, u, time.Now().Add(-time.Minute))
+	token := requestPasswordReset(t, svr, engine, *u.Email)
+	res := tryResetPassword(t, svr, engine, token, "Test_456")
+	require.Equal(t, http.StatusNoContent, res.Code)
+	u = getUserById(t, db, 2)
+	m, err := utils.ComparePassword("Test_456", u.Password)
+	require.Nil(t, err)
+	require.True(t, m)
+	require.NotNil(t, u.TokensValidAfter)
+	// earlier sessions are signed out
+	res = getUsersWithToken(t, svr, engine, old)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	res = tryLogin(t, svr, engine, u.Username, "Test_456")
+	require.Equal(t, http.StatusOK, res.Code)
+	qc := context.Background()
+	r := db.PasswordReset.Query().Where(passwordreset.UserIDEQ(u.ID)).
+		OnlyX(qc)
+	require.NotNil(t, r.UsedAt)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditPasswordReset)).OnlyX(qc)
+	require.Equal(t, float64(u.ID), log.Details["user_id"])
+}
+
+func Test_ResetPassword_clears_temporary_password_and_lockout(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	db.User.UpdateOneID(2).SetMustChangePassword(true).ExecX(qc)
+	u := getUserById(t, db, 2)
+	for i := 0; i < svr.loginMaxAttempts; i++ {
+		tryLogin(t, svr, engine, u.Username, "Test_000")
+	}
+	token := requestPasswordReset(t, svr, engine, *u.Email)
+	res := tryResetPassword(t, svr, engine, token, "Test_456")
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.False(t, getUserById(t, db, 2).MustChangePassword)
+	res = tryLogin(t, svr, engine, u.Username, "Test_456")
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_ResetPassword_accepts_token_once(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	token := requestPasswordReset(t, svr, engine, *getUserById(t, db, 2).Email)
+	res := tryResetPassword(t, svr, engine, token, "Test_456")
+	require.Equal(t, http.StatusNoContent, res.Code)
+	res = tryResetPassword(t, svr, engine, token, "Test_789")
+	requireInvalidResetToken(t, res)
+	m, err := utils.ComparePassword("Test_456", getUserById(t, db, 2).Password)
+	require.Nil(t, err)
+	require.True(t, m)
+}
+
+func Test_ResetPassword_rejects_expired_token(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	token := requestPasswordReset(t, svr, engine, *getUserById(t, db, 2).Email)
+	db.PasswordReset.Delete().ExecX(context.Background())
+	db.PasswordReset.Create().SetUserID(2).
+		SetToken(hashPasswordResetToken(token)).
+		SetExpiresAt(time.Now().Add(-time.Second)).
+		ExecX(context.Background())
+	res := tryResetPassword(t, svr, engine, token, "Test_456")
+	requireInvalidResetToken(t, res)
+}
+
+func Test_ResetPassword_rejects_superseded_token(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	email := *getUserById(t, db, 2).Email
+	first := requestPasswordReset(t, svr, engine, email)
+	requestPasswordReset(t, svr, engine, email)
+	res := tryResetPassword(t, svr, engine, first, "Test_456")
+	requireInvalidResetToken(t, res)
+}
+
+func Test_ResetPassword_rejects_unknown_token(t *testing.T) {
+	svr, engine, _, _ := setupTestCase(t, false)
+	token, _, err := newPasswordResetToken()
+	require.Nil(t, err)
+	res := tryResetPassword(t, svr, engine, token, "Test_456")
+	requireInvalidResetToken(t, res)
+}
+
+func Test_ResetPassword_keeps_token_if_password_too_simple(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	token := requestPasswordReset(t, svr, engine, *getUserById(t, db, 2).Email)
+	res := tryResetPassword(t, svr, engine, token, "abcdefgh")
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	requireJsonEqualsString(
+		t, map[string]interface{}{
+			"code":   http.StatusBadRequest,
+			"errors": DefaultPasswordPolicy().requirement(),
+			"status": msgError,
+		}, res.Body.String(),
+	)
+	res = tryResetPassword(t, svr, engine, token, "Test_456")
+	require.Equal(t, http.StatusNoContent, res.Code)
+}
+
+func Test_ResetPassword_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post(
+		"/password/reset",
+		ResetPasswordJSONRequestBody{Token: "abc", Password: "Test_456"},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
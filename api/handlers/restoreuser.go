@@ -5,24 +5,53 @@ import (
 	"net/http"
 
 	"github.com/eidng8/go-ent/softdelete"
+	"github.com/gin-gonic/gin"
 
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/user"
 )
 
-// RestoreUser restores a user.
+// RestoreUser restores a deleted user and sets it active. Tokens issued before
+// the deletion stay revoked.
 //
 // Endpoint: POST /user/{id}/restore
 func (s Server) RestoreUser(
-	_ context.Context, request RestoreUserRequestObject,
+	ctx context.Context, request RestoreUserRequestObject,
 ) (RestoreUserResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("RestoreUser error: %v", err)
+		return nil, err
+	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("RestoreUser error: %v", err)
+		return nil, err
+	}
 	t := true
-	_, err := s.db.Transaction(
+	_, err = s.db.Transaction(
 		softdelete.NewSoftDeleteQueryContext(&t, context.Background()),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
-			return nil, tx.User.UpdateOneID(request.Id).
-				Where(user.DeletedAtNotNil()).ClearDeletedAt().Exec(qc)
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			if err = restoreUser(qc, tx, u.ID); err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditUserRestored, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
 		},
 	)
 	if err != nil {
@@ -2,11 +2,16 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
 	"github.com/eidng8/go-attr-rbac/ent/user"
 )
 
@@ -23,6 +28,63 @@ func Test_RestoreUser_soft_restores_a_user(t *testing.T) {
 	)
 }
 
+func Test_RestoreUser_sets_user_active_and_records_audit(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/user/2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	req, err = svr.postAs(u, "/user/2/restore", nil)
+	require.Nil(t, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Equal(t, user.StatusActive, getUserById(t, db, 2).Status)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditUserRestored)).OnlyX(qc)
+	require.Equal(t, uint64(1), *log.ActorID)
+	require.Equal(t, float64(2), log.Details["user_id"])
+	require.Equal(t, "user0", log.Details["username"])
+}
+
+func Test_RestoreUser_lets_user_authenticate_again(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:ListUser")
+	setPassword(t, db, 2, "Test_123")
+	u := getUserById(t, db, 1)
+	req, err := svr.deleteAs(u, "/user/2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Equal(
+		t, http.StatusUnauthorized,
+		tryLogin(t, svr, engine, "user0", "Test_123").Code,
+	)
+	req, err = svr.postAs(u, "/user/2/restore", nil)
+	require.Nil(t, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	res = tryLogin(t, svr, engine, "user0", "Test_123")
+	require.Equal(t, http.StatusOK, res.Code)
+	at, _ := getTokensFromSetCookieHeaders(t, res)
+	require.Equal(t, http.StatusOK, getUsersWithCookie(t, engine, at).Code)
+	restored := getUserById(t, db, 2)
+	jti, pt, err := svr.issuePersonalToken(
+		restored, []string{"auth:ListUser"}, time.Hour,
+	)
+	require.Nil(t, err)
+	bin, err := jti.MarshalBinary()
+	require.Nil(t, err)
+	db.PersonalToken.Create().SetToken(bin).SetUserID(2).SetDescription("test").
+		ExecX(context.Background())
+	require.Equal(
+		t, http.StatusOK, getUsersWithToken(t, svr, engine, pt).Code,
+	)
+}
+
 func Test_RestoreUser_returns_401_if_non_user(t *testing.T) {
 	svr, engine, _, res := setupTestCase(t, false)
 	req, err := svr.post("/user/2/restore", nil)
@@ -58,6 +120,21 @@ func Test_RestoreUser_reports_404_if_user_not_soft_deleted(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, res.Code)
 }
 
+func Test_RestoreUser_reports_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	db.User.DeleteOneID(a.UserID).ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, fmt.Sprintf("/user/%d/restore", a.UserID), nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.False(
+		t, db.User.Query().Where(user.IDEQ(a.UserID)).
+			ExistX(context.Background()),
+	)
+}
+
 func Test_RestoreUser_reports_422_if_invalid_id(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	u := getUserById(t, db, 1)
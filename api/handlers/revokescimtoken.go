@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// RevokeScimToken revokes the SCIM token of the organization, disabling SCIM
+// for it. Users other than platform administrators can only revoke the token
+// of their own organization.
+//
+// Endpoint: DELETE /organization/{id}/scim-token
+func (s Server) RevokeScimToken(
+	ctx context.Context, request RevokeScimTokenRequestObject,
+) (RevokeScimTokenResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("RevokeScimToken error: %v", err)
+		return nil, err
+	}
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("RevokeScimToken error: %v", err)
+		return nil, err
+	}
+	notFound := RevokeScimToken404JSONResponse{
+		N404JSONResponse: N404JSONResponse{
+			Code:   http.StatusNotFound,
+			Status: msgError,
+			Errors: &msgNotFound,
+		},
+	}
+	if nil != tenant && *tenant != request.Id {
+		return notFound, nil
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			err := tx.Organization.UpdateOneID(request.Id).ClearScimToken().
+				Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditScimTokenRevoked, request.Id, token, nil,
+			)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return notFound, nil
+		}
+		api.Log.Debugf("RevokeScimToken error: %v", err)
+		return nil, err
+	}
+	return RevokeScimToken204Response{}, nil
+}
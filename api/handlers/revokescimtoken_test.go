@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+)
+
+func Test_RevokeScimToken_disables_scim_of_the_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o, u, _ := seedOrganization(t, db, "auth:RevokeScimToken")
+	token := issueScimToken(t, svr, engine, db, o.ID)
+	req, err := svr.deleteAs(
+		u, fmt.Sprintf("/organization/%d/scim-token", o.ID),
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditScimTokenRevoked)).
+		OnlyX(context.Background())
+	require.Equal(t, o.ID, log.OrganizationID)
+	res = httptest.NewRecorder()
+	req, err = svr.scimRequestWithToken(
+		token, http.MethodGet, "/scim/v2/Users", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_RevokeScimToken_reports_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	token := issueScimToken(t, svr, engine, db, api.DefaultOrganizationId)
+	_, u, _ := seedOrganization(t, db, "auth:RevokeScimToken")
+	req, err := svr.deleteAs(u, "/organization/1/scim-token")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	res = httptest.NewRecorder()
+	req, err = svr.scimRequestWithToken(
+		token, http.MethodGet, "/scim/v2/Users", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_RevokeScimToken_reports_404_if_not_exists(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.deleteAs(
+		getUserById(t, db, 1), "/organization/12345/scim-token",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_RevokeScimToken_returns_500_if_invalid_context(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	_, err := svr.RevokeScimToken(
+		context.Background(), RevokeScimTokenRequestObject{Id: 1},
+	)
+	require.ErrorIs(t, err, errInvalidContext)
+}
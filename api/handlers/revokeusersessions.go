@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// RevokeUserSessions signs out all sessions of the user, revoking their
+// tokens. Personal tokens of the user are left alone.
+//
+// Endpoint: POST /user/{id}/sessions/revoke-all
+func (s Server) RevokeUserSessions(
+	ctx context.Context, request RevokeUserSessionsRequestObject,
+) (RevokeUserSessionsResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("RevokeUserSessions error: %v", err)
+		return nil, err
+	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("RevokeUserSessions error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			ss, err := u.QuerySessions().All(qc)
+			if err != nil {
+				return nil, err
+			}
+			if err = revokeSessions(qc, tx, ss); err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditSessionsRevoked, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+					"sessions": len(ss),
+				},
+			)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return RevokeUserSessions404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("RevokeUserSessions error: %v", err)
+		return nil, err
+	}
+	return RevokeUserSessions204Response{}, nil
+}
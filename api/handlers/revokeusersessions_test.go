@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/session"
+)
+
+func Test_RevokeUserSessions_signs_out_all_sessions(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	u := getUserById(t, db, 2)
+	at1, rt1 := startTestSession(t, svr, u, "agent 1")
+	at2, rt2 := startTestSession(t, svr, u, "agent 2")
+	oat, _ := startTestSession(t, svr, getUserById(t, db, 1), "other agent")
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/user/2/sessions/revoke-all", nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	for _, at := range []*http.Cookie{at1, at2} {
+		require.Equal(
+			t, http.StatusUnauthorized, getUsersWithCookie(t, engine, at).Code,
+		)
+	}
+	for _, rt := range []*http.Cookie{rt1, rt2} {
+		require.Equal(
+			t, http.StatusUnauthorized, refreshWithCookie(t, engine, rt).Code,
+		)
+	}
+	require.False(t, db.Session.Query().Where(session.UserIDEQ(2)).ExistX(qc))
+	require.Equal(t, http.StatusOK, getUsersWithCookie(t, engine, oat).Code)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditSessionsRevoked)).OnlyX(qc)
+	require.Equal(t, uint64(1), *log.ActorID)
+	require.Equal(t, float64(2), log.Details["user_id"])
+	require.Equal(t, float64(2), log.Details["sessions"])
+}
+
+func Test_RevokeUserSessions_reports_404_if_user_not_exists(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/user/12345/sessions/revoke-all", nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_RevokeUserSessions_reports_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:RevokeUserSessions")
+	startTestSession(t, svr, getUserById(t, db, 2), "test agent")
+	req, err := svr.postAs(u, "/user/2/sessions/revoke-all", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Equal(t, 1, db.Session.Query().CountX(context.Background()))
+}
+
+func Test_RevokeUserSessions_reports_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	req, err := svr.postAs(
+		getUserById(t, db, 1),
+		fmt.Sprintf("/user/%d/sessions/revoke-all", a.UserID), nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_RevokeUserSessions_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/user/2/sessions/revoke-all", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_RevokeUserSessions_returns_403_if_user_without_permission(
+	t *testing.T,
+) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 2), "/user/3/sessions/revoke-all", nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_RevokeUserSessions_returns_500_if_invalid_context(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	_, err := svr.RevokeUserSessions(
+		context.Background(), RevokeUserSessionsRequestObject{Id: 2},
+	)
+	require.ErrorIs(t, err, errInvalidContext)
+}
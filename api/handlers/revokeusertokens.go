@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// RevokeUserTokens revokes all tokens issued to the user so far, including
+// personal tokens, and signs out all its sessions.
+//
+// Endpoint: POST /user/{id}/revoke-tokens
+func (s Server) RevokeUserTokens(
+	ctx context.Context, request RevokeUserTokensRequestObject,
+) (RevokeUserTokensResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("RevokeUserTokens error: %v", err)
+		return nil, err
+	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("RevokeUserTokens error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			if err = revokeUserTokens(qc, tx, u.ID); err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditTokensRevoked, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return RevokeUserTokens404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("RevokeUserTokens error: %v", err)
+		return nil, err
+	}
+	return RevokeUserTokens204Response{}, nil
+}
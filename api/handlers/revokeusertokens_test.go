@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+)
+
+func Test_RevokeUserTokens_revokes_all_tokens(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	u := getUserById(t, db, 2)
+	at, rt := startTestSession(t, svr, u, "test agent")
+	jti, pt, err := svr.issuePersonalToken(u, []string{"auth:Ping"}, time.Hour)
+	require.Nil(t, err)
+	bin, err := jti.MarshalBinary()
+	require.Nil(t, err)
+	db.PersonalToken.Create().SetToken(bin).SetUserID(2).SetDescription("test").
+		ExecX(qc)
+	// tokens issued within the second of the revocation are accepted
+	time.Sleep(time.Second)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/2/revoke-tokens", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Equal(
+		t, http.StatusUnauthorized, getUsersWithCookie(t, engine, at).Code,
+	)
+	require.Equal(
+		t, http.StatusUnauthorized, refreshWithCookie(t, engine, rt).Code,
+	)
+	res = getUsersWithToken(t, svr, engine, pt)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Zero(t, db.Session.Query().CountX(qc))
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditTokensRevoked)).OnlyX(qc)
+	require.Equal(t, uint64(1), *log.ActorID)
+	require.Equal(t, float64(2), log.Details["user_id"])
+}
+
+func Test_RevokeUserTokens_reports_404_if_user_not_exists(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/user/12345/revoke-tokens", nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_RevokeUserTokens_reports_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:RevokeUserTokens")
+	req, err := svr.postAs(u, "/user/2/revoke-tokens", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Nil(t, getUserById(t, db, 2).TokensValidAfter)
+}
+
+func Test_RevokeUserTokens_reports_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	req, err := svr.postAs(
+		getUserById(t, db, 1),
+		fmt.Sprintf("/user/%d/revoke-tokens", a.UserID), nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Nil(t, getUserById(t, db, a.UserID).TokensValidAfter)
+}
+
+func Test_RevokeUserTokens_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/user/2/revoke-tokens", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_RevokeUserTokens_returns_403_if_user_without_permission(
+	t *testing.T,
+) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 2), "/user/3/revoke-tokens", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_RevokeUserTokens_returns_500_if_invalid_context(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	_, err := svr.RevokeUserTokens(
+		context.Background(), RevokeUserTokensRequestObject{Id: 2},
+	)
+	require.ErrorIs(t, err, errInvalidContext)
+}
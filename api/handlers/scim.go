@@ -0,0 +1,555 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/eidng8/go-ent/softdelete"
+	"github.com/eidng8/go-utils"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// SCIM schema URNs
+const (
+	scimSchemaUser   = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup  = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimSchemaList   = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaError  = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// SCIM resource types and their endpoints
+const (
+	scimResourceUser  = "User"
+	scimResourceGroup = "Group"
+	scimPathUsers     = "/scim/v2/Users/"
+	scimPathGroups    = "/scim/v2/Groups/"
+)
+
+// prefix of qualified SCIM operation names
+const scimOperationPrefix = "auth:Scim"
+
+// SCIM error types, RFC 7644 section 3.12
+const (
+	scimInvalidFilter = "invalidFilter"
+	scimInvalidSyntax = "invalidSyntax"
+	scimInvalidPath   = "invalidPath"
+	scimInvalidValue  = "invalidValue"
+	scimMutability    = "mutability"
+	scimNoTarget      = "noTarget"
+	scimUniqueness    = "uniqueness"
+)
+
+// maximum number of resources returned in one list response
+const scimMaxResults = 100
+
+// scimError is an error reported to SCIM clients in the SCIM error format.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+// response returns the SCIM error response body.
+func (e *scimError) response() ScimError {
+	res := ScimError{
+		Schemas: []string{scimSchemaError},
+		Status:  strconv.Itoa(e.status),
+		Detail:  &e.detail,
+	}
+	if "" != e.scimType {
+		res.ScimType = &e.scimType
+	}
+	return res
+}
+
+func newScimError(status int, scimType, detail string) *scimError {
+	return &scimError{status: status, scimType: scimType, detail: detail}
+}
+
+// Converts errors of SCIM operations to scimError, returns nil if the error
+// should be reported as internal server error.
+func asScimError(err error) *scimError {
+	var se *scimError
+	var sv *sodViolation
+	var pe *passwordPolicyError
+	switch {
+	case errors.As(err, &se):
+		return se
+	case errors.As(err, &sv):
+		return newScimError(http.StatusConflict, "", sv.Error())
+	case ent.IsNotFound(err):
+		return newScimError(http.StatusNotFound, "", "resource not found")
+	case ent.IsConstraintError(err):
+		return newScimError(
+			http.StatusConflict, scimUniqueness, "resource already exists",
+		)
+	case errors.Is(err, errScimInvalidFilter):
+		return newScimError(
+			http.StatusBadRequest, scimInvalidFilter, err.Error(),
+		)
+	case errors.As(err, &pe):
+		return newScimError(
+			http.StatusBadRequest, scimInvalidValue, pe.Error(),
+		)
+	}
+	return nil
+}
+
+// Whether the operation is a SCIM operation, which is authenticated with the
+// dedicated SCIM bearer token instead of user tokens.
+func isScimOperation(operationID string) bool {
+	return strings.HasPrefix(operationID, scimOperationPrefix)
+}
+
+// Authenticates the SCIM client with the request bearer token, and stores the
+// organization the token is issued to in the gin context. The configured SCIM
+// token belongs to the default organization, SCIM is disabled for
+// organizations without token.
+func (s Server) handleScimAuth(gc *gin.Context) error {
+	method, token, err := authHeader(gc)
+	if err != nil {
+		return err
+	}
+	if "bearer" != method || "" == token {
+		return errInvalidToken
+	}
+	actual := sha256.Sum256([]byte(token))
+	if "" != s.scimToken {
+		// compare digests so that the comparison doesn't leak the token length
+		expected := sha256.Sum256([]byte(s.scimToken))
+		if 1 == subtle.ConstantTimeCompare(expected[:], actual[:]) {
+			gc.Set(scimTenantName, uint32(api.DefaultOrganizationId))
+			return nil
+		}
+	}
+	// issued tokens are random enough that their hashes can be looked up
+	id, err := s.db.Organization.Query().
+		Where(organization.ScimTokenEQ(actual[:])).OnlyID(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errInvalidToken
+		}
+		return err
+	}
+	gc.Set(scimTenantName, id)
+	return nil
+}
+
+// Returns the organization of the authenticated SCIM client, all SCIM
+// operations are confined to it.
+func scimTenant(ctx context.Context) (uint32, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return 0, errInvalidContext
+	}
+	id, ok := gc.Value(scimTenantName).(uint32)
+	if !ok {
+		return 0, errInvalidContext
+	}
+	return id, nil
+}
+
+// Returns the context that SCIM queries use. Soft deleted users are exposed
+// to SCIM clients as inactive users.
+func scimQueryContext() context.Context {
+	return softdelete.IncludeTrashed(context.Background())
+}
+
+// Returns the start index and count of the list request.
+func scimPage(startIndex, count *int) (int, int) {
+	start, n := 1, scimMaxResults
+	if nil != startIndex && *startIndex > 1 {
+		start = *startIndex
+	}
+	if nil != count && *count < scimMaxResults {
+		n = max(*count, 0)
+	}
+	return start, n
+}
+
+// scimUser converts the user to SCIM user resource.
+func (s Server) scimUser(u *ent.User) ScimUser {
+	id := strconv.FormatUint(u.ID, 10)
+	active := nil == u.DeletedAt
+	res := ScimUser{
+		Schemas:  &[]string{scimSchemaUser},
+		Id:       &id,
+		UserName: u.Username,
+		Active:   &active,
+		Meta:     s.scimMeta(scimResourceUser, scimPathUsers+id),
+	}
+	res.Meta.Created = u.CreatedAt
+	res.Meta.LastModified = u.UpdatedAt
+	if nil == u.UpdatedAt {
+		res.Meta.LastModified = u.CreatedAt
+	}
+	if nil != u.Email && "" != *u.Email {
+		t, primary := "work", true
+		res.Emails = &[]ScimEmail{
+			{Value: *u.Email, Type: &t, Primary: &primary},
+		}
+	}
+	return res
+}
+
+// scimGroup converts the role to SCIM group resource. Members are taken from
+// the eager loaded users of the role.
+func (s Server) scimGroup(r *ent.Role) ScimGroup {
+	id := strconv.FormatUint(uint64(r.ID), 10)
+	members := make([]ScimMember, len(r.Edges.Users))
+	for i, u := range r.Edges.Users {
+		uid := strconv.FormatUint(u.ID, 10)
+		ref := s.baseUrl + scimPathUsers + uid
+		members[i] = ScimMember{Value: uid, Display: &u.Username, Ref: &ref}
+	}
+	res := ScimGroup{
+		Schemas:     &[]string{scimSchemaGroup},
+		Id:          &id,
+		DisplayName: r.Name,
+		Members:     &members,
+		Meta:        s.scimMeta(scimResourceGroup, scimPathGroups+id),
+	}
+	res.Meta.Created = r.CreatedAt
+	res.Meta.LastModified = r.UpdatedAt
+	if nil == r.UpdatedAt {
+		res.Meta.LastModified = r.CreatedAt
+	}
+	return res
+}
+
+func (s Server) scimMeta(resourceType, path string) *ScimMeta {
+	location := s.baseUrl + path
+	return &ScimMeta{ResourceType: &resourceType, Location: &location}
+}
+
+// Returns the body of a request that accepts both `application/json` and
+// `application/scim+json` content.
+func scimBody[T any](jsonBody, scimJsonBody *T) (*T, error) {
+	if nil != scimJsonBody {
+		return scimJsonBody, nil
+	}
+	if nil != jsonBody {
+		return jsonBody, nil
+	}
+	return nil, newScimError(
+		http.StatusBadRequest, scimInvalidSyntax, "request body is required",
+	)
+}
+
+// Queries the role of the organization with its members, the users sorted by
+// ID.
+func scimQueryGroup(
+	qc context.Context, client *ent.RoleClient, tenant, id uint32,
+) (*ent.Role, error) {
+	return client.Query().
+		Where(role.IDEQ(id), role.OrganizationIDEQ(tenant)).
+		WithUsers(scimMembers).Only(qc)
+}
+
+// scimUserChanges holds the changes of a SCIM user replace or patch request.
+type scimUserChanges struct {
+	userName *string
+	// empty string removes the email
+	email    *string
+	password *string
+	active   *bool
+}
+
+// Applies the changes to the user of the organization, in the given
+// transaction. Deactivating a user soft deletes it, and reactivating restores
+// it.
+func (s Server) applyScimUserChanges(
+	tx *ent.Tx, tenant uint32, id uint64, c scimUserChanges,
+) (*ent.User, error) {
+	qc := scimQueryContext()
+	u, err := scimQueryUser(qc, tx.User, tenant, id)
+	if err != nil {
+		return nil, err
+	}
+	if nil != c.userName && !strings.EqualFold(*c.userName, u.Username) {
+		return nil, newScimError(
+			http.StatusBadRequest, scimMutability, "userName is immutable",
+		)
+	}
+	update := tx.User.UpdateOneID(id)
+	changed := false
+	if nil != c.email {
+		if "" == *c.email {
+			update.ClearEmail()
+		} else {
+			update.SetEmail(*c.email)
+		}
+		changed = true
+	}
+	if nil != c.password {
+		hash, err := s.hashNewPassword(*c.password)
+		if err != nil {
+			return nil, err
+		}
+		if err = s.rotatePassword(qc, tx, u, *c.password); err != nil {
+			return nil, err
+		}
+		update.SetPassword(hash)
+		changed = true
+	}
+	if changed {
+		if err = update.Exec(qc); err != nil {
+			return nil, err
+		}
+	}
+	if nil != c.active {
+		if *c.active && nil != u.DeletedAt {
+			err = restoreUser(qc, tx, id)
+		} else if !*c.active && nil == u.DeletedAt {
+			err = scimDeactivateUser(tx, tenant, id)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx.User.Get(qc, id)
+}
+
+// Queries the user of the organization, including deactivated ones.
+func scimQueryUser(
+	qc context.Context, client *ent.UserClient, tenant uint32, id uint64,
+) (*ent.User, error) {
+	return client.Query().Where(scimUsers(tenant), user.IDEQ(id)).Only(qc)
+}
+
+// Returns the predicate of the users of the organization visible to SCIM
+// clients. Users backing service accounts aren't provisioned by them.
+func scimUsers(tenant uint32) predicate.User {
+	return user.And(
+		user.OrganizationIDEQ(tenant), user.IsServiceAccountEQ(false),
+	)
+}
+
+// Loads the members of SCIM groups sorted by ID, leaving out service
+// accounts, which keep their roles when members are replaced.
+func scimMembers(q *ent.UserQuery) {
+	q.Where(user.IsServiceAccountEQ(false)).Order(user.ByID())
+}
+
+// Soft deletes the user of the organization, revoking its tokens, and emits
+// the `user.deleted` event.
+func scimDeactivateUser(tx *ent.Tx, tenant uint32, id uint64) error {
+	qc := context.Background()
+	_, err := tx.User.Query().Where(scimUsers(tenant), user.IDEQ(id)).
+		OnlyID(qc)
+	if err != nil {
+		return err
+	}
+	if err = deleteUser(qc, tx, id); err != nil {
+		return err
+	}
+	return emitEvent(
+		qc, tx, tenant, api.EventUserDeleted, map[string]interface{}{"user_id": id},
+	)
+}
+
+// Applies the changes to the role, in the given transaction. The `members`
+// function receives current member IDs, and returns the new ones.
+func applyScimGroupChanges(
+	qc context.Context, tx *ent.Tx, tenant, id uint32, name *string,
+	members func([]uint64) ([]uint64, error),
+) (*ent.Role, error) {
+	r, err := scimQueryGroup(qc, tx.Role, tenant, id)
+	if err != nil {
+		return nil, err
+	}
+	if nil != name && *name != r.Name {
+		if "" == *name || len(*name) > 255 {
+			return nil, scimInvalidValueError("displayName")
+		}
+		if err = tx.Role.UpdateOneID(id).SetName(*name).Exec(qc); err != nil {
+			return nil, err
+		}
+	}
+	ids, err := members(
+		utils.Pluck(r.Edges.Users, func(u *ent.User) uint64 { return u.ID }),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = setScimGroupMembers(qc, tx, r, ids); err != nil {
+		return nil, err
+	}
+	return scimQueryGroup(qc, tx.Role, tenant, id)
+}
+
+// Sets the members of the role to exactly the given users of its
+// organization, and emits the
+// `user.roles_changed` event for users who joined or left.
+func setScimGroupMembers(
+	qc context.Context, tx *ent.Tx, r *ent.Role, members []uint64,
+) error {
+	before := utils.Pluck(r.Edges.Users, func(u *ent.User) uint64 { return u.ID })
+	var add, remove []uint64
+	for _, id := range members {
+		if !slices.Contains(before, id) && !slices.Contains(add, id) {
+			add = append(add, id)
+		}
+	}
+	for _, id := range before {
+		if !slices.Contains(members, id) {
+			remove = append(remove, id)
+		}
+	}
+	if 0 == len(add) && 0 == len(remove) {
+		return nil
+	}
+	if len(add) > 0 {
+		// users of other organizations are unknown to the SCIM client
+		n, err := tx.User.Query().
+			Where(scimUsers(r.OrganizationID), user.IDIn(add...)).Count(qc)
+		if err != nil {
+			return err
+		}
+		if n != len(add) {
+			return newScimError(
+				http.StatusBadRequest, scimInvalidValue,
+				"members contain unknown users",
+			)
+		}
+	}
+	err := tx.Role.UpdateOneID(r.ID).AddUserIDs(add...).
+		RemoveUserIDs(remove...).Exec(qc)
+	if err != nil {
+		return err
+	}
+	if err = checkSeparationOfDuties(qc, tx, add...); err != nil {
+		return err
+	}
+	for _, id := range append(add, remove...) {
+		if err = userRolesChanged(qc, tx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parses user IDs of the group members.
+func scimMemberIds(members []ScimMember) ([]uint64, error) {
+	ids := make([]uint64, len(members))
+	for i, m := range members {
+		id, err := strconv.ParseUint(m.Value, 10, 64)
+		if err != nil {
+			return nil, newScimError(
+				http.StatusBadRequest, scimInvalidValue,
+				fmt.Sprintf("invalid member %q", m.Value),
+			)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// Returns the email of the user resource, preferring the primary one.
+// Returns empty string if the resource has no email.
+func scimPrimaryEmail(emails *[]ScimEmail) string {
+	if nil == emails || 0 == len(*emails) {
+		return ""
+	}
+	for _, e := range *emails {
+		if nil != e.Primary && *e.Primary {
+			return e.Value
+		}
+	}
+	return (*emails)[0].Value
+}
+
+// Returns the lower-cased operation of the PATCH request, which must be one
+// of "add", "remove" or "replace".
+func scimPatchOperation(op ScimPatchOperation) (string, error) {
+	o := strings.ToLower(op.Op)
+	if "add" != o && "remove" != o && "replace" != o {
+		return "", newScimError(
+			http.StatusBadRequest, scimInvalidSyntax,
+			fmt.Sprintf("unknown operation %q", op.Op),
+		)
+	}
+	return o, nil
+}
+
+// Returns the value of the PATCH operation, nil if not given.
+func scimPatchValue(op ScimPatchOperation) interface{} {
+	if nil == op.Value {
+		return nil
+	}
+	return *op.Value
+}
+
+// Splits the PATCH path to the lower-cased attribute path and the value
+// filter. For example `emails[type eq "work"].value` gives `emails.value`
+// and `type eq "work"`.
+func scimPatchPath(op ScimPatchOperation) (string, string, error) {
+	if nil == op.Path {
+		return "", "", nil
+	}
+	path := strings.TrimSpace(*op.Path)
+	start := strings.IndexByte(path, '[')
+	if start < 0 {
+		return scimAttributePath(path), "", nil
+	}
+	end := strings.LastIndexByte(path, ']')
+	if end < start {
+		return "", "", newScimError(
+			http.StatusBadRequest, scimInvalidPath,
+			fmt.Sprintf("invalid path %q", path),
+		)
+	}
+	return scimAttributePath(path[:start] + path[end+1:]),
+		path[start+1 : end], nil
+}
+
+// Converts a PATCH value to boolean. Some providers send booleans as strings,
+// such as "False".
+func scimBool(value interface{}) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(strings.ToLower(v))
+		return b, nil == err
+	}
+	return false, false
+}
+
+// Converts a PATCH value to string.
+func scimString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// Returns the error of an invalid PATCH value of the attribute.
+func scimInvalidValueError(path string) error {
+	return newScimError(
+		http.StatusBadRequest, scimInvalidValue,
+		fmt.Sprintf("invalid value of %q", path),
+	)
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// ScimCreateGroup creates a role from SCIM group resource.
+//
+// Endpoint: POST /scim/v2/Groups
+func (s Server) ScimCreateGroup(
+	ctx context.Context, request ScimCreateGroupRequestObject,
+) (ScimCreateGroupResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimCreateGroup error: %v", err)
+		return nil, err
+	}
+	r, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			body, err := scimBody(
+				request.JSONBody, request.ApplicationScimPlusJSONBody,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if "" == body.DisplayName || len(body.DisplayName) > 255 {
+				return nil, scimInvalidValueError("displayName")
+			}
+			var members []uint64
+			if nil != body.Members {
+				if members, err = scimMemberIds(*body.Members); err != nil {
+					return nil, err
+				}
+			}
+			r, err := tx.Role.Create().SetName(body.DisplayName).
+				SetOrganizationID(tenant).Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			if err = setScimGroupMembers(qc, tx, r, members); err != nil {
+				return nil, err
+			}
+			return scimQueryGroup(qc, tx.Role, tenant, r.ID)
+		},
+	)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			if http.StatusConflict == se.status {
+				return ScimCreateGroup409ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			}
+			return ScimCreateGroup400ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimCreateGroup error: %v", err)
+		return nil, err
+	}
+	return ScimCreateGroup201ApplicationScimPlusJSONResponse(
+		s.scimGroup(r.(*ent.Role)),
+	), nil
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/role"
+)
+
+func Test_ScimCreateGroup_creates_okta_group(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPost, "/scim/v2/Groups", "okta_create_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(
+		t, ScimCreateGroup201ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "Okta Engineers", actual.DisplayName)
+	require.Equal(t, "3", (*actual.Members)[0].Value)
+	require.Equal(t, "user1", *(*actual.Members)[0].Display)
+	r, err := db.Role.Query().Where(role.NameEQ("Okta Engineers")).
+		Only(context.Background())
+	require.Nil(t, err)
+	ids, err := r.QueryUsers().IDs(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, []uint64{3}, ids)
+}
+
+func Test_ScimCreateGroup_creates_entra_group(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPost, "/scim/v2/Groups", "entra_create_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(
+		t, ScimCreateGroup201ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "Entra Group", actual.DisplayName)
+	exists, err := db.Role.Query().Where(role.NameEQ("Entra Group")).
+		Exist(context.Background())
+	require.Nil(t, err)
+	require.True(t, exists)
+}
+
+func Test_ScimCreateGroup_returns_409_if_name_exists(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPost, "/scim/v2/Groups", "entra_create_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	req, err = svr.scimRequest(
+		http.MethodPost, "/scim/v2/Groups", "entra_create_group",
+	)
+	require.Nil(t, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-utils"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// ScimCreateUser provisions a user from SCIM user resource. Users provisioned
+// without password get a random one, they can't sign in with password until
+// it is changed. Users provisioned as inactive are soft deleted.
+//
+// Endpoint: POST /scim/v2/Users
+func (s Server) ScimCreateUser(
+	ctx context.Context, request ScimCreateUserRequestObject,
+) (ScimCreateUserResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimCreateUser error: %v", err)
+		return nil, err
+	}
+	u, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			body, err := scimBody(
+				request.JSONBody, request.ApplicationScimPlusJSONBody,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if len(body.UserName) < 2 || len(body.UserName) > 255 {
+				return nil, scimInvalidValueError("userName")
+			}
+			password := ""
+			if nil != body.Password {
+				password = *body.Password
+				if err = s.passwordPolicy.Validate(password); err != nil {
+					return nil, err
+				}
+			} else if password, err = utils.RandomPrintable(32); err != nil {
+				return nil, err
+			}
+			hash, err := s.hashPassword(password)
+			if err != nil {
+				return nil, err
+			}
+			create := tx.User.Create().SetUsername(body.UserName).
+				SetPassword(hash).SetOrganizationID(tenant)
+			if email := scimPrimaryEmail(body.Emails); "" != email {
+				create.SetEmail(email)
+			}
+			u, err := create.Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = emitEvent(
+				qc, tx, tenant, api.EventUserCreated, map[string]interface{}{
+					"user_id": u.ID, "username": u.Username,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			if nil != body.Active && !*body.Active {
+				return s.applyScimUserChanges(
+					tx, tenant, u.ID, scimUserChanges{active: body.Active},
+				)
+			}
+			return u, nil
+		},
+	)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			if http.StatusConflict == se.status {
+				return ScimCreateUser409ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			}
+			return ScimCreateUser400ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimCreateUser error: %v", err)
+		return nil, err
+	}
+	return ScimCreateUser201ApplicationScimPlusJSONResponse(
+		s.scimUser(u.(*ent.User)),
+	), nil
+}
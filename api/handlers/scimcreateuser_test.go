@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/eidng8/go-utils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_ScimCreateUser_creates_okta_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPost, "/scim/v2/Users", "okta_create_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	actual := unmarshalResponse(
+		t, ScimCreateUser201ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "okta.user@example.com", actual.UserName)
+	require.True(t, *actual.Active)
+	require.Equal(t, "okta.user@example.com", (*actual.Emails)[0].Value)
+	require.Nil(t, actual.Password)
+	require.Equal(t, "User", *actual.Meta.ResourceType)
+	row, err := db.User.Query().
+		Where(user.UsernameEQ("okta.user@example.com")).
+		Only(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, *actual.Id, strconv.FormatUint(row.ID, 10))
+	require.Equal(t, "okta.user@example.com", *row.Email)
+	eq, err := utils.ComparePassword("Okta_Pass1", row.Password)
+	require.Nil(t, err)
+	require.True(t, eq)
+}
+
+func Test_ScimCreateUser_creates_entra_user_without_password(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPost, "/scim/v2/Users", "entra_create_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	row, err := db.User.Query().
+		Where(user.UsernameEQ("entra.user@contoso.com")).
+		Only(context.Background())
+	require.Nil(t, err)
+	require.NotEmpty(t, row.Password)
+	require.Equal(t, "entra.user@contoso.com", *row.Email)
+}
+
+func Test_ScimCreateUser_returns_409_if_username_exists(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPost, "/scim/v2/Users", "okta_create_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	req, err = svr.scimRequest(
+		http.MethodPost, "/scim/v2/Users", "okta_create_user",
+	)
+	require.Nil(t, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	actual := unmarshalResponse(
+		t, ScimCreateUser409ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, scimUniqueness, *actual.ScimType)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// ScimDeleteGroup deletes a role, and emits the `user.roles_changed` event
+// for its members.
+//
+// Endpoint: DELETE /scim/v2/Groups/{id}
+func (s Server) ScimDeleteGroup(
+	ctx context.Context, request ScimDeleteGroupRequestObject,
+) (ScimDeleteGroupResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimDeleteGroup error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			r, err := scimQueryGroup(qc, tx.Role, tenant, request.Id)
+			if err != nil {
+				return nil, err
+			}
+			if err = tx.Role.DeleteOne(r).Exec(qc); err != nil {
+				return nil, err
+			}
+			for _, u := range r.Edges.Users {
+				if err = userRolesChanged(qc, tx, u.ID); err != nil {
+					return nil, err
+				}
+			}
+			return nil, nil
+		},
+	)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			return ScimDeleteGroup404ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimDeleteGroup error: %v", err)
+		return nil, err
+	}
+	return ScimDeleteGroup204Response{}, nil
+}
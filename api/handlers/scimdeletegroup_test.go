@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+func Test_ScimDeleteGroup_deletes_a_group(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(http.MethodDelete, "/scim/v2/Groups/2", "")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	_, err = db.Role.Get(context.Background(), 2)
+	require.True(t, ent.IsNotFound(err))
+	ids, err := getUserById(t, db, 2).QueryRoles().IDs(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, []uint32{3, 4}, ids)
+}
+
+func Test_ScimDeleteGroup_returns_404_if_not_found(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodDelete, "/scim/v2/Groups/12345", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// ScimDeleteUser soft deletes a user, the same as deactivating it.
+//
+// Endpoint: DELETE /scim/v2/Users/{id}
+func (s Server) ScimDeleteUser(
+	ctx context.Context, request ScimDeleteUserRequestObject,
+) (ScimDeleteUserResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimDeleteUser error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(_ context.Context, tx *ent.Tx) (interface{}, error) {
+			return nil, scimDeactivateUser(tx, tenant, request.Id)
+		},
+	)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			return ScimDeleteUser404ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimDeleteUser error: %v", err)
+		return nil, err
+	}
+	return ScimDeleteUser204Response{}, nil
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+func Test_ScimDeleteUser_soft_deletes_a_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(http.MethodDelete, "/scim/v2/Users/4", "")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	_, err = db.User.Get(context.Background(), 4)
+	require.True(t, ent.IsNotFound(err))
+	u, err := db.User.Get(scimQueryContext(), 4)
+	require.Nil(t, err)
+	require.NotNil(t, u.DeletedAt)
+}
+
+func Test_ScimDeleteUser_returns_404_if_not_found(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodDelete, "/scim/v2/Users/12345", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
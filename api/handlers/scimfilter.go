@@ -0,0 +1,377 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var errScimInvalidFilter = errors.New("invalid filter")
+
+// scimFilter is a parsed SCIM filter expression (RFC 7644 section 3.4.2.2).
+// Logical expressions have `op` and `children` set, attribute expressions
+// have `path`, `compare` and `value` set.
+type scimFilter struct {
+	// logical operator, one of "and", "or", "not"
+	op       string
+	children []*scimFilter
+	// lower-cased attribute path without schema URN, e.g. "emails.value"
+	path string
+	// lower-cased comparison operator, e.g. "eq", "co", "pr"
+	compare string
+	// string, bool, float64 or nil
+	value interface{}
+}
+
+// scimAttribute maps an attribute expression to a predicate of the queried
+// entity.
+type scimAttribute func(f *scimFilter) (func(*sql.Selector), error)
+
+// parseScimFilter parses the filter expression. Value paths, such as
+// `emails[type eq "work"]`, are flattened to `emails.type eq "work"`.
+func parseScimFilter(filter string) (*scimFilter, error) {
+	tokens, err := scimFilterTokens(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &scimFilterParser{tokens: tokens}
+	f, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf(
+			"%w: unexpected %q", errScimInvalidFilter, p.tokens[p.pos],
+		)
+	}
+	return f, nil
+}
+
+// predicate compiles the filter to a predicate, mapping attribute expressions
+// with the given function.
+func (f *scimFilter) predicate(attr scimAttribute) (
+	func(*sql.Selector), error,
+) {
+	if "" == f.op {
+		return attr(f)
+	}
+	ps := make([]func(*sql.Selector), len(f.children))
+	for i, c := range f.children {
+		p, err := c.predicate(attr)
+		if err != nil {
+			return nil, err
+		}
+		ps[i] = p
+	}
+	switch f.op {
+	case "and":
+		return sql.AndPredicates(ps...), nil
+	case "or":
+		return sql.OrPredicates(ps...), nil
+	default:
+		return sql.NotPredicates(ps...), nil
+	}
+}
+
+// unsupported returns the error of filtering on an unsupported attribute.
+func (f *scimFilter) unsupported() error {
+	return fmt.Errorf(
+		"%w: unsupported attribute %q or operator %q", errScimInvalidFilter,
+		f.path, f.compare,
+	)
+}
+
+// Compares a case-insensitive string column.
+func scimCompareString(column string, f *scimFilter) (
+	func(*sql.Selector), error,
+) {
+	if "pr" == f.compare {
+		return sql.FieldNotNull(column), nil
+	}
+	v, ok := f.value.(string)
+	if !ok {
+		return nil, f.unsupported()
+	}
+	switch f.compare {
+	case "eq":
+		return sql.FieldEqualFold(column, v), nil
+	case "ne":
+		return sql.NotPredicates(sql.FieldEqualFold(column, v)), nil
+	case "co":
+		return sql.FieldContainsFold(column, v), nil
+	case "sw":
+		return sql.FieldHasPrefix(column, v), nil
+	case "ew":
+		return sql.FieldHasSuffix(column, v), nil
+	}
+	return scimCompareOrdered(column, f, v)
+}
+
+// Compares a numeric ID column. SCIM IDs are strings, so the value is parsed.
+func scimCompareId(column string, f *scimFilter) (
+	func(*sql.Selector), error,
+) {
+	if "pr" == f.compare {
+		return sql.FieldNotNull(column), nil
+	}
+	v, ok := f.value.(string)
+	if !ok {
+		return nil, f.unsupported()
+	}
+	id, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		// no resource can have a non-numeric ID
+		return sql.FieldIsNull(column), nil
+	}
+	return scimCompareOrdered(column, f, id)
+}
+
+// Compares a date-time column.
+func scimCompareTime(column string, f *scimFilter) (
+	func(*sql.Selector), error,
+) {
+	if "pr" == f.compare {
+		return sql.FieldNotNull(column), nil
+	}
+	v, ok := f.value.(string)
+	if !ok {
+		return nil, f.unsupported()
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errScimInvalidFilter, err)
+	}
+	return scimCompareOrdered(column, f, t)
+}
+
+func scimCompareOrdered(column string, f *scimFilter, v interface{}) (
+	func(*sql.Selector), error,
+) {
+	switch f.compare {
+	case "eq":
+		return sql.FieldEQ(column, v), nil
+	case "ne":
+		return sql.FieldNEQ(column, v), nil
+	case "gt":
+		return sql.FieldGT(column, v), nil
+	case "ge":
+		return sql.FieldGTE(column, v), nil
+	case "lt":
+		return sql.FieldLT(column, v), nil
+	case "le":
+		return sql.FieldLTE(column, v), nil
+	}
+	return nil, f.unsupported()
+}
+
+type scimFilterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *scimFilterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *scimFilterParser) next() string {
+	t := p.peek()
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+	return t
+}
+
+func (p *scimFilterParser) expect(token string) error {
+	if t := p.next(); t != token {
+		return fmt.Errorf(
+			"%w: expected %q, got %q", errScimInvalidFilter, token, t,
+		)
+	}
+	return nil
+}
+
+func (p *scimFilterParser) or() (*scimFilter, error) {
+	f, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold("or", p.peek()) {
+		p.next()
+		r, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		f = &scimFilter{op: "or", children: []*scimFilter{f, r}}
+	}
+	return f, nil
+}
+
+func (p *scimFilterParser) and() (*scimFilter, error) {
+	f, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold("and", p.peek()) {
+		p.next()
+		r, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		f = &scimFilter{op: "and", children: []*scimFilter{f, r}}
+	}
+	return f, nil
+}
+
+func (p *scimFilterParser) not() (*scimFilter, error) {
+	negate := strings.EqualFold("not", p.peek())
+	if negate {
+		p.next()
+	}
+	if negate || "(" == p.peek() {
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		f, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(")"); err != nil {
+			return nil, err
+		}
+		if negate {
+			return &scimFilter{op: "not", children: []*scimFilter{f}}, nil
+		}
+		return f, nil
+	}
+	return p.attribute()
+}
+
+func (p *scimFilterParser) attribute() (*scimFilter, error) {
+	path := p.next()
+	if "" == path || strings.ContainsAny(path[:1], `()[]"`) {
+		return nil, fmt.Errorf(
+			"%w: expected attribute, got %q", errScimInvalidFilter, path,
+		)
+	}
+	path = scimAttributePath(path)
+	if "[" == p.peek() {
+		p.next()
+		f, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect("]"); err != nil {
+			return nil, err
+		}
+		f.prefix(path)
+		return f, nil
+	}
+	compare := strings.ToLower(p.next())
+	switch compare {
+	case "pr":
+		return &scimFilter{path: path, compare: compare}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf(
+			"%w: unknown operator %q", errScimInvalidFilter, compare,
+		)
+	}
+	value, err := scimFilterValue(p.next())
+	if err != nil {
+		return nil, err
+	}
+	return &scimFilter{path: path, compare: compare, value: value}, nil
+}
+
+// Prefixes attribute paths of a value filter with the parent attribute.
+func (f *scimFilter) prefix(path string) {
+	if "" == f.op {
+		f.path = path + "." + f.path
+		return
+	}
+	for _, c := range f.children {
+		c.prefix(path)
+	}
+}
+
+// Strips the schema URN from the attribute path and lower-cases it, since
+// attribute names are case-insensitive.
+func scimAttributePath(path string) string {
+	if strings.HasPrefix(strings.ToLower(path), "urn:") {
+		path = path[strings.LastIndex(path, ":")+1:]
+	}
+	return strings.ToLower(path)
+}
+
+func scimFilterValue(token string) (interface{}, error) {
+	if strings.HasPrefix(token, `"`) {
+		var s string
+		if err := json.Unmarshal([]byte(token), &s); err != nil {
+			return nil, fmt.Errorf("%w: %v", errScimInvalidFilter, err)
+		}
+		return s, nil
+	}
+	switch strings.ToLower(token) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w: invalid value %q", errScimInvalidFilter, token,
+		)
+	}
+	return n, nil
+}
+
+// Splits the filter into tokens: parentheses, brackets, quoted strings and
+// words.
+func scimFilterTokens(filter string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case ' ' == c || '\t' == c:
+			i++
+		case strings.IndexByte("()[]", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		case '"' == c:
+			j := i + 1
+			for ; j < len(filter) && '"' != filter[j]; j++ {
+				if '\\' == filter[j] {
+					j++
+				}
+			}
+			if j >= len(filter) {
+				return nil, fmt.Errorf(
+					"%w: unterminated string", errScimInvalidFilter,
+				)
+			}
+			tokens = append(tokens, filter[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(filter) &&
+				strings.IndexByte(" \t()[]\"", filter[j]) < 0; j++ {
+			}
+			tokens = append(tokens, filter[i:j])
+			i = j
+		}
+	}
+	if 0 == len(tokens) {
+		return nil, fmt.Errorf("%w: empty filter", errScimInvalidFilter)
+	}
+	return tokens, nil
+}
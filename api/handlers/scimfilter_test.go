@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseScimFilter_parses_attribute_expression(t *testing.T) {
+	f, err := parseScimFilter(`userName eq "bjensen@example.com"`)
+	require.Nil(t, err)
+	require.Equal(
+		t, &scimFilter{
+			path: "username", compare: "eq", value: "bjensen@example.com",
+		}, f,
+	)
+}
+
+func Test_parseScimFilter_parses_logical_expression(t *testing.T) {
+	f, err := parseScimFilter(
+		`userName sw "j" and (emails co "example.com" or ` +
+			`not (active eq false)) OR meta.created pr`,
+	)
+	require.Nil(t, err)
+	require.Equal(
+		t, &scimFilter{
+			op: "or",
+			children: []*scimFilter{
+				{
+					op: "and",
+					children: []*scimFilter{
+						{path: "username", compare: "sw", value: "j"},
+						{
+							op: "or",
+							children: []*scimFilter{
+								{
+									path: "emails", compare: "co",
+									value: "example.com",
+								},
+								{
+									op: "not",
+									children: []*scimFilter{
+										{
+											path:    "active",
+											compare: "eq",
+											value:   false,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{path: "meta.created", compare: "pr"},
+			},
+		}, f,
+	)
+}
+
+func Test_parseScimFilter_flattens_value_path(t *testing.T) {
+	f, err := parseScimFilter(
+		`emails[type eq "work" and value co "@example.com"]`,
+	)
+	require.Nil(t, err)
+	require.Equal(
+		t, &scimFilter{
+			op: "and",
+			children: []*scimFilter{
+				{path: "emails.type", compare: "eq", value: "work"},
+				{path: "emails.value", compare: "co", value: "@example.com"},
+			},
+		}, f,
+	)
+}
+
+func Test_parseScimFilter_strips_schema_urn(t *testing.T) {
+	f, err := parseScimFilter(
+		`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "a\"b"`,
+	)
+	require.Nil(t, err)
+	require.Equal(
+		t, &scimFilter{path: "username", compare: "eq", value: `a"b`}, f,
+	)
+}
+
+func Test_parseScimFilter_parses_literals(t *testing.T) {
+	for filter, expected := range map[string]interface{}{
+		"a eq true":  true,
+		"a eq FALSE": false,
+		"a eq null":  nil,
+		"a gt 1.5":   1.5,
+	} {
+		f, err := parseScimFilter(filter)
+		require.Nil(t, err)
+		require.Equal(t, expected, f.value)
+	}
+}
+
+func Test_parseScimFilter_returns_error_if_invalid(t *testing.T) {
+	for _, filter := range []string{
+		"", "userName", `userName xx "a"`, `userName eq "a`, "userName eq a",
+		`(userName eq "a"`, `userName eq "a" and`, `userName eq "a")`,
+		`emails[type eq "work"`, `"a" eq "a"`, `not userName eq "a"`,
+	} {
+		_, err := parseScimFilter(filter)
+		require.ErrorIs(t, err, errScimInvalidFilter, filter)
+	}
+}
+
+func Test_scimFilter_predicate_returns_error_if_unsupported(t *testing.T) {
+	for _, filter := range []string{
+		`unknown eq "a"`, `userName eq true`, `active co "a"`, `id eq 1`,
+		`userName gt "a" and unknown pr`, `meta.created gt "yesterday"`,
+	} {
+		f, err := parseScimFilter(filter)
+		require.Nil(t, err, filter)
+		_, err = f.predicate(scimUserAttribute)
+		require.ErrorIs(t, err, errScimInvalidFilter, filter)
+	}
+}
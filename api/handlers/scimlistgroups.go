@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// ScimListGroups lists roles as SCIM group resources.
+//
+// Endpoint: GET /scim/v2/Groups
+func (s Server) ScimListGroups(
+	ctx context.Context, request ScimListGroupsRequestObject,
+) (ScimListGroupsResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimListGroups error: %v", err)
+		return nil, err
+	}
+	qc := context.Background()
+	query := s.db.Role.Query().Where(role.OrganizationIDEQ(tenant))
+	if nil != request.Params.Filter {
+		f, err := parseScimFilter(*request.Params.Filter)
+		if err == nil {
+			var p func(*sql.Selector)
+			if p, err = f.predicate(scimGroupAttribute); err == nil {
+				query.Where(predicate.Role(p))
+			}
+		}
+		if err != nil {
+			return ScimListGroups400ApplicationScimPlusJSONResponse(
+				asScimError(err).response(),
+			), nil
+		}
+	}
+	total, err := query.Clone().Count(qc)
+	if err != nil {
+		api.Log.Debugf("ScimListGroups error: %v", err)
+		return nil, err
+	}
+	start, count := scimPage(request.Params.StartIndex, request.Params.Count)
+	rows, err := query.Order(role.ByID()).Offset(start - 1).Limit(count).
+		WithUsers(scimMembers).All(qc)
+	if err != nil {
+		api.Log.Debugf("ScimListGroups error: %v", err)
+		return nil, err
+	}
+	res := ScimListGroups200ApplicationScimPlusJSONResponse{
+		Schemas:      []string{scimSchemaList},
+		TotalResults: total,
+		StartIndex:   start,
+		ItemsPerPage: len(rows),
+		Resources:    make([]ScimGroup, len(rows)),
+	}
+	for i, row := range rows {
+		res.Resources[i] = s.scimGroup(row)
+	}
+	return res, nil
+}
+
+// Maps filter attributes of SCIM groups to role fields.
+func scimGroupAttribute(f *scimFilter) (func(*sql.Selector), error) {
+	switch f.path {
+	case "id":
+		return scimCompareId(role.FieldID, f)
+	case "displayname":
+		return scimCompareString(role.FieldName, f)
+	case "meta.created":
+		return scimCompareTime(role.FieldCreatedAt, f)
+	case "meta.lastmodified":
+		return scimCompareTime(role.FieldUpdatedAt, f)
+	case "members", "members.value":
+		v, ok := f.value.(string)
+		if !ok || "eq" != f.compare {
+			return nil, f.unsupported()
+		}
+		id, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return sql.FieldIsNull(role.FieldID), nil
+		}
+		return role.HasUsersWith(user.IDEQ(id)), nil
+	}
+	return nil, f.unsupported()
+}
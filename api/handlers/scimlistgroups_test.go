@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScimListGroups_returns_a_page(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/Groups?startIndex=2&count=3", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimListGroups200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, numFixtures+1, actual.TotalResults)
+	require.Equal(t, 2, actual.StartIndex)
+	require.Equal(t, 3, actual.ItemsPerPage)
+	require.Equal(t, "2", *actual.Resources[0].Id)
+	require.Equal(t, "4", *actual.Resources[2].Id)
+}
+
+func Test_ScimListGroups_filters_by_member(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/Groups?filter="+url.QueryEscape(
+			`members[value eq "2"] and displayName ne "role 2"`,
+		), "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimListGroups200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, 2, actual.TotalResults)
+	require.Equal(t, "role 0", actual.Resources[0].DisplayName)
+	require.Equal(t, "role 1", actual.Resources[1].DisplayName)
+}
+
+func Test_ScimListGroups_returns_400_if_filter_invalid(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/Groups?filter="+
+			url.QueryEscape(`displayName eq`), "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// ScimListUsers lists users as SCIM resources, including deactivated ones.
+//
+// Endpoint: GET /scim/v2/Users
+func (s Server) ScimListUsers(
+	ctx context.Context, request ScimListUsersRequestObject,
+) (ScimListUsersResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimListUsers error: %v", err)
+		return nil, err
+	}
+	qc := scimQueryContext()
+	query := s.db.User.Query().Where(scimUsers(tenant))
+	if nil != request.Params.Filter {
+		f, err := parseScimFilter(*request.Params.Filter)
+		if err == nil {
+			var p func(*sql.Selector)
+			if p, err = f.predicate(scimUserAttribute); err == nil {
+				query.Where(predicate.User(p))
+			}
+		}
+		if err != nil {
+			return ScimListUsers400ApplicationScimPlusJSONResponse(
+				asScimError(err).response(),
+			), nil
+		}
+	}
+	total, err := query.Clone().Count(qc)
+	if err != nil {
+		api.Log.Debugf("ScimListUsers error: %v", err)
+		return nil, err
+	}
+	start, count := scimPage(request.Params.StartIndex, request.Params.Count)
+	rows, err := query.Order(user.ByID()).Offset(start - 1).Limit(count).
+		All(qc)
+	if err != nil {
+		api.Log.Debugf("ScimListUsers error: %v", err)
+		return nil, err
+	}
+	res := ScimListUsers200ApplicationScimPlusJSONResponse{
+		Schemas:      []string{scimSchemaList},
+		TotalResults: total,
+		StartIndex:   start,
+		ItemsPerPage: len(rows),
+		Resources:    make([]ScimUser, len(rows)),
+	}
+	for i, row := range rows {
+		res.Resources[i] = s.scimUser(row)
+	}
+	return res, nil
+}
+
+// Maps filter attributes of SCIM users to user fields.
+func scimUserAttribute(f *scimFilter) (func(*sql.Selector), error) {
+	switch f.path {
+	case "id":
+		return scimCompareId(user.FieldID, f)
+	case "username":
+		return scimCompareString(user.FieldUsername, f)
+	case "emails", "emails.value":
+		return scimCompareString(user.FieldEmail, f)
+	case "meta.created":
+		return scimCompareTime(user.FieldCreatedAt, f)
+	case "meta.lastmodified":
+		return scimCompareTime(user.FieldUpdatedAt, f)
+	case "active":
+		if "pr" == f.compare {
+			// every user has the attribute
+			return sql.FieldNotNull(user.FieldID), nil
+		}
+		active, ok := f.value.(bool)
+		if !ok || ("eq" != f.compare && "ne" != f.compare) {
+			return nil, f.unsupported()
+		}
+		if active == ("eq" == f.compare) {
+			return sql.FieldIsNull(user.FieldDeletedAt), nil
+		}
+		return sql.FieldNotNull(user.FieldDeletedAt), nil
+	}
+	return nil, f.unsupported()
+}
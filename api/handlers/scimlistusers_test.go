@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScimListUsers_returns_a_page(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/Users?startIndex=3&count=2", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimListUsers200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, []string{scimSchemaList}, actual.Schemas)
+	require.Equal(t, numFixtures+1, actual.TotalResults)
+	require.Equal(t, 3, actual.StartIndex)
+	require.Equal(t, 2, actual.ItemsPerPage)
+	require.Len(t, actual.Resources, 2)
+	require.Equal(t, "3", *actual.Resources[0].Id)
+	require.Equal(t, "4", *actual.Resources[1].Id)
+}
+
+func Test_ScimListUsers_filters_by_username(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/Users?filter="+
+			url.QueryEscape(`userName eq "USER3"`), "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimListUsers200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, 1, actual.TotalResults)
+	require.Equal(t, "user3", actual.Resources[0].UserName)
+}
+
+func Test_ScimListUsers_filters_by_logical_expression(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.User.DeleteOneID(3).ExecX(context.Background())
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/Users?filter="+url.QueryEscape(
+			`userName sw "user" and not (active eq true) or id eq "4"`,
+		), "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimListUsers200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, 2, actual.TotalResults)
+	require.Equal(t, "3", *actual.Resources[0].Id)
+	require.False(t, *actual.Resources[0].Active)
+	require.Equal(t, "4", *actual.Resources[1].Id)
+}
+
+func Test_ScimListUsers_returns_empty_list(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/Users?filter="+
+			url.QueryEscape(`userName eq "nobody"`), "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimListUsers200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, 0, actual.TotalResults)
+	require.Empty(t, actual.Resources)
+}
+
+func Test_ScimListUsers_omits_service_accounts(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/Users?filter="+
+			url.QueryEscape(`userName eq "service 0"`), "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimListUsers200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, 0, actual.TotalResults)
+	require.Empty(t, actual.Resources)
+}
+
+func Test_ScimListUsers_returns_400_if_filter_invalid(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/Users?filter="+
+			url.QueryEscape(`nickName eq "a"`), "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	actual := unmarshalResponse(
+		t, ScimListUsers400ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, scimInvalidFilter, *actual.ScimType)
+}
+
+func Test_ScimListUsers_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(http.MethodGet, "/scim/v2/Users", "")
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// ScimPatchGroup applies SCIM PATCH operations to a role. Supported attributes
+// are `displayName` and `members`, other attributes are ignored.
+//
+// Endpoint: PATCH /scim/v2/Groups/{id}
+func (s Server) ScimPatchGroup(
+	ctx context.Context, request ScimPatchGroupRequestObject,
+) (ScimPatchGroupResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimPatchGroup error: %v", err)
+		return nil, err
+	}
+	r, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			body, err := scimBody(
+				request.JSONBody, request.ApplicationScimPlusJSONBody,
+			)
+			if err != nil {
+				return nil, err
+			}
+			changes, err := scimGroupPatch(body.Operations)
+			if err != nil {
+				return nil, err
+			}
+			return applyScimGroupChanges(
+				qc, tx, tenant, request.Id, changes.displayName, changes.apply,
+			)
+		},
+	)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			switch se.status {
+			case http.StatusNotFound:
+				return ScimPatchGroup404ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			case http.StatusConflict:
+				return ScimPatchGroup409ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			}
+			return ScimPatchGroup400ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimPatchGroup error: %v", err)
+		return nil, err
+	}
+	return ScimPatchGroup200ApplicationScimPlusJSONResponse(
+		s.scimGroup(r.(*ent.Role)),
+	), nil
+}
+
+// scimGroupChanges holds the changes of a SCIM group patch request.
+type scimGroupChanges struct {
+	displayName *string
+	// member changes, applied in order to current member IDs
+	members []func([]uint64) []uint64
+}
+
+// Returns new member IDs after applying member changes to the given ones.
+func (c *scimGroupChanges) apply(members []uint64) ([]uint64, error) {
+	for _, f := range c.members {
+		members = f(members)
+	}
+	return members, nil
+}
+
+// Folds the PATCH operations into group changes, in order.
+func scimGroupPatch(ops []ScimPatchOperation) (*scimGroupChanges, error) {
+	c := &scimGroupChanges{}
+	for _, op := range ops {
+		o, err := scimPatchOperation(op)
+		if err != nil {
+			return nil, err
+		}
+		path, filter, err := scimPatchPath(op)
+		if err != nil {
+			return nil, err
+		}
+		value := scimPatchValue(op)
+		if "" != path {
+			if err = c.patch(o, path, filter, value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		values, ok := value.(map[string]interface{})
+		if "remove" == o || !ok {
+			return nil, newScimError(
+				http.StatusBadRequest, scimNoTarget,
+				"operation without path requires an object value",
+			)
+		}
+		for k, v := range values {
+			if err = c.patch(o, scimAttributePath(k), "", v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return c, nil
+}
+
+// Applies one PATCH operation on an attribute path to the changes.
+func (c *scimGroupChanges) patch(
+	op, path, filter string, value interface{},
+) error {
+	switch path {
+	case "displayname":
+		name, ok := scimString(value)
+		if "remove" == op || !ok {
+			return scimInvalidValueError("displayName")
+		}
+		c.displayName = &name
+	case "members":
+		var ids []uint64
+		var err error
+		if "" != filter {
+			if "remove" != op {
+				return newScimError(
+					http.StatusBadRequest, scimInvalidPath,
+					"member filter is only supported by remove operation",
+				)
+			}
+			ids, err = scimMemberFilterIds(filter)
+		} else if nil != value {
+			ids, err = scimMemberValues(value)
+		} else if "remove" != op {
+			return scimInvalidValueError("members")
+		}
+		if err != nil {
+			return err
+		}
+		c.members = append(c.members, scimMemberChange(op, filter, ids))
+	}
+	return nil
+}
+
+// Returns the function that applies the member change.
+func scimMemberChange(
+	op, filter string, ids []uint64,
+) func([]uint64) []uint64 {
+	return func(members []uint64) []uint64 {
+		switch op {
+		case "add":
+			for _, id := range ids {
+				if !slices.Contains(members, id) {
+					members = append(members, id)
+				}
+			}
+			return members
+		case "replace":
+			return ids
+		}
+		if "" == filter && nil == ids {
+			// removes all members
+			return nil
+		}
+		return slices.DeleteFunc(
+			slices.Clone(members),
+			func(id uint64) bool { return slices.Contains(ids, id) },
+		)
+	}
+}
+
+// Parses member IDs from PATCH value, which can be a member object or a list
+// of member objects.
+func scimMemberValues(value interface{}) ([]uint64, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		list = []interface{}{value}
+	}
+	members := make([]ScimMember, len(list))
+	for i, v := range list {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, scimInvalidValueError("members")
+		}
+		if members[i].Value, ok = scimString(m["value"]); !ok {
+			return nil, scimInvalidValueError("members")
+		}
+	}
+	return scimMemberIds(members)
+}
+
+// Parses member IDs from a value filter such as `value eq "2"`, multiple IDs
+// can be combined with `or`.
+func scimMemberFilterIds(filter string) ([]uint64, error) {
+	f, err := parseScimFilter(filter)
+	if err != nil {
+		return nil, newScimError(
+			http.StatusBadRequest, scimInvalidPath, err.Error(),
+		)
+	}
+	var ids []uint64
+	var walk func(f *scimFilter) error
+	walk = func(f *scimFilter) error {
+		if "or" == f.op {
+			for _, c := range f.children {
+				if err := walk(c); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		v, ok := f.value.(string)
+		if "" != f.op || "value" != f.path || "eq" != f.compare || !ok {
+			return newScimError(
+				http.StatusBadRequest, scimInvalidPath,
+				fmt.Sprintf("unsupported member filter %q", filter),
+			)
+		}
+		id, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return scimInvalidValueError("members")
+		}
+		ids = append(ids, id)
+		return nil
+	}
+	if err = walk(f); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
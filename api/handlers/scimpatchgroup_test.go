@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScimPatchGroup_patches_okta_members(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.Role.UpdateOneID(5).AddUserIDs(3, 4).ExecX(context.Background())
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Groups/5", "okta_patch_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimPatchGroup200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Len(t, *actual.Members, 2)
+	ids, err := db.Role.GetX(context.Background(), 5).QueryUsers().
+		IDs(context.Background())
+	require.Nil(t, err)
+	require.ElementsMatch(t, []uint64{4, 5}, ids)
+}
+
+func Test_ScimPatchGroup_returns_409_if_violates_sod_constraint(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	db.Role.UpdateOneID(5).AddUserIDs(3, 4).ExecX(qc)
+	db.User.UpdateOneID(5).AddRoleIDs(6).ExecX(qc)
+	seedSodConstraint(t, db, 5, 6)
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Groups/5", "okta_patch_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+	require.Contains(t, res.Body.String(), "constraint 0")
+}
+
+func Test_ScimPatchGroup_renames_okta_group(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Groups/2", "okta_rename_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimPatchGroup200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "Okta Renamed", actual.DisplayName)
+	require.Len(t, *actual.Members, 1)
+	require.Equal(t, "Okta Renamed", db.Role.GetX(context.Background(), 2).Name)
+}
+
+func Test_ScimPatchGroup_patches_entra_members(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Groups/6", "entra_patch_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	ids, err := db.Role.GetX(context.Background(), 6).QueryUsers().
+		IDs(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, []uint64{7}, ids)
+}
+
+func Test_ScimPatchGroup_removes_all_members(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(http.MethodPatch, "/scim/v2/Groups/2", "")
+	require.Nil(t, err)
+	req.Body = io.NopCloser(strings.NewReader(
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],` +
+			`"Operations":[{"op":"remove","path":"members"}]}`,
+	))
+	req.Header.Set("Content-Type", "application/scim+json")
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	ids, err := db.Role.GetX(context.Background(), 2).QueryUsers().
+		IDs(context.Background())
+	require.Nil(t, err)
+	require.Empty(t, ids)
+}
+
+func Test_ScimPatchGroup_returns_400_if_path_invalid(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(http.MethodPatch, "/scim/v2/Groups/2", "")
+	require.Nil(t, err)
+	req.Body = io.NopCloser(strings.NewReader(
+		`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],` +
+			`"Operations":[{"op":"remove","path":"members[value"}]}`,
+	))
+	req.Header.Set("Content-Type", "application/scim+json")
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	actual := unmarshalResponse(
+		t, ScimPatchGroup400ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, scimInvalidPath, *actual.ScimType)
+}
+
+func Test_ScimPatchGroup_returns_404_if_not_found(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Groups/12345", "okta_patch_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// ScimPatchUser applies SCIM PATCH operations to a user. Supported attributes
+// are `active`, `userName` (which can't be changed), `password` and `emails`.
+// Other attributes are not stored by this service, and are ignored.
+//
+// Endpoint: PATCH /scim/v2/Users/{id}
+func (s Server) ScimPatchUser(
+	ctx context.Context, request ScimPatchUserRequestObject,
+) (ScimPatchUserResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimPatchUser error: %v", err)
+		return nil, err
+	}
+	u, err := s.db.Transaction(
+		context.Background(),
+		func(_ context.Context, tx *ent.Tx) (interface{}, error) {
+			body, err := scimBody(
+				request.JSONBody, request.ApplicationScimPlusJSONBody,
+			)
+			if err != nil {
+				return nil, err
+			}
+			changes, err := scimUserPatch(body.Operations)
+			if err != nil {
+				return nil, err
+			}
+			return s.applyScimUserChanges(tx, tenant, request.Id, changes)
+		},
+	)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			switch se.status {
+			case http.StatusNotFound:
+				return ScimPatchUser404ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			case http.StatusConflict:
+				return ScimPatchUser409ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			}
+			return ScimPatchUser400ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimPatchUser error: %v", err)
+		return nil, err
+	}
+	return ScimPatchUser200ApplicationScimPlusJSONResponse(
+		s.scimUser(u.(*ent.User)),
+	), nil
+}
+
+// Folds the PATCH operations into user changes, in order.
+func scimUserPatch(ops []ScimPatchOperation) (scimUserChanges, error) {
+	var c scimUserChanges
+	for _, op := range ops {
+		o, err := scimPatchOperation(op)
+		if err != nil {
+			return c, err
+		}
+		path, _, err := scimPatchPath(op)
+		if err != nil {
+			return c, err
+		}
+		value := scimPatchValue(op)
+		if "" != path {
+			if err = c.patch(o, path, value); err != nil {
+				return c, err
+			}
+			continue
+		}
+		values, ok := value.(map[string]interface{})
+		if "remove" == o || !ok {
+			return c, newScimError(
+				http.StatusBadRequest, scimNoTarget,
+				"operation without path requires an object value",
+			)
+		}
+		for k, v := range values {
+			if err = c.patch(o, scimAttributePath(k), v); err != nil {
+				return c, err
+			}
+		}
+	}
+	return c, nil
+}
+
+// Applies one PATCH operation on an attribute path to the changes.
+func (c *scimUserChanges) patch(op, path string, value interface{}) error {
+	remove := "remove" == op
+	switch path {
+	case "active":
+		active, ok := scimBool(value)
+		if remove || !ok {
+			return scimInvalidValueError(path)
+		}
+		c.active = &active
+	case "username":
+		userName, ok := scimString(value)
+		if remove || !ok {
+			return newScimError(
+				http.StatusBadRequest, scimMutability, "userName is immutable",
+			)
+		}
+		c.userName = &userName
+	case "password":
+		password, ok := scimString(value)
+		if remove || !ok {
+			return scimInvalidValueError(path)
+		}
+		c.password = &password
+	case "emails", "emails.value":
+		email := ""
+		if !remove {
+			var ok bool
+			if email, ok = scimEmailValue(value); !ok {
+				return scimInvalidValueError(path)
+			}
+		}
+		c.email = &email
+	}
+	return nil
+}
+
+// Extracts the email from a PATCH value, which can be the address, an email
+// object, or a list of email objects.
+func scimEmailValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case map[string]interface{}:
+		return scimString(v["value"])
+	case []interface{}:
+		var emails []ScimEmail
+		for _, e := range v {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				return "", false
+			}
+			address, ok := scimString(m["value"])
+			if !ok {
+				return "", false
+			}
+			primary, _ := scimBool(m["primary"])
+			emails = append(emails, ScimEmail{Value: address, Primary: &primary})
+		}
+		return scimPrimaryEmail(&emails), true
+	}
+	return "", false
+}
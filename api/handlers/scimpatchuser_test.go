@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_ScimPatchUser_deactivates_okta_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Users/4", "okta_deactivate_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimPatchUser200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.False(t, *actual.Active)
+	_, err = db.User.Get(context.Background(), 4)
+	require.True(t, ent.IsNotFound(err))
+	u, err := db.User.Get(scimQueryContext(), 4)
+	require.Nil(t, err)
+	require.GreaterOrEqual(t, *u.DeletedAt, startTime)
+	require.Equal(t, user.StatusDeleted, u.Status)
+}
+
+func Test_ScimPatchUser_reactivates_okta_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.User.UpdateOneID(4).SetStatus(user.StatusDeleted).
+		ExecX(context.Background())
+	db.User.DeleteOneID(4).ExecX(context.Background())
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Users/4", "okta_reactivate_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimPatchUser200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.True(t, *actual.Active)
+	u := getUserById(t, db, 4)
+	require.Nil(t, u.DeletedAt)
+	require.Equal(t, user.StatusActive, u.Status)
+}
+
+func Test_ScimPatchUser_disables_entra_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Users/4", "entra_disable_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	_, err = db.User.Get(context.Background(), 4)
+	require.True(t, ent.IsNotFound(err))
+}
+
+func Test_ScimPatchUser_updates_entra_user_email(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Users/4", "entra_patch_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimPatchUser200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "updated@contoso.com", (*actual.Emails)[0].Value)
+	require.Equal(t, "updated@contoso.com", *getUserById(t, db, 4).Email)
+}
+
+func Test_ScimPatchUser_returns_404_if_not_found(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Users/12345", "okta_deactivate_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ScimPatchUser_returns_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	req, err := svr.scimRequest(
+		http.MethodPatch, fmt.Sprintf("/scim/v2/Users/%d", a.UserID),
+		"okta_deactivate_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Nil(t, getUserById(t, db, a.UserID).DeletedAt)
+}
+
+func Test_ScimPatchUser_returns_400_if_operation_invalid(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodPatch, "/scim/v2/Users/4", "okta_replace_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// ScimReadGroup reads a role as SCIM group resource.
+//
+// Endpoint: GET /scim/v2/Groups/{id}
+func (s Server) ScimReadGroup(
+	ctx context.Context, request ScimReadGroupRequestObject,
+) (ScimReadGroupResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimReadGroup error: %v", err)
+		return nil, err
+	}
+	r, err := scimQueryGroup(
+		context.Background(), s.db.Role, tenant, request.Id,
+	)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			return ScimReadGroup404ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimReadGroup error: %v", err)
+		return nil, err
+	}
+	return ScimReadGroup200ApplicationScimPlusJSONResponse(s.scimGroup(r)), nil
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScimReadGroup_returns_a_group(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(http.MethodGet, "/scim/v2/Groups/2", "")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimReadGroup200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "2", *actual.Id)
+	require.Equal(t, "role 0", actual.DisplayName)
+	require.Equal(t, []string{scimSchemaGroup}, *actual.Schemas)
+	require.Len(t, *actual.Members, 1)
+	require.Equal(t, "2", (*actual.Members)[0].Value)
+	require.Equal(t, "user0", *(*actual.Members)[0].Display)
+}
+
+func Test_ScimReadGroup_returns_404_if_not_found(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(http.MethodGet, "/scim/v2/Groups/12345", "")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
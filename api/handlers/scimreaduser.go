@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// ScimReadUser reads a user as SCIM resource, including deactivated ones.
+//
+// Endpoint: GET /scim/v2/Users/{id}
+func (s Server) ScimReadUser(
+	ctx context.Context, request ScimReadUserRequestObject,
+) (ScimReadUserResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimReadUser error: %v", err)
+		return nil, err
+	}
+	u, err := scimQueryUser(scimQueryContext(), s.db.User, tenant, request.Id)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			return ScimReadUser404ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimReadUser error: %v", err)
+		return nil, err
+	}
+	return ScimReadUser200ApplicationScimPlusJSONResponse(s.scimUser(u)), nil
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScimReadUser_returns_a_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(http.MethodGet, "/scim/v2/Users/2", "")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, "application/scim+json", res.Header().Get("Content-Type"))
+	actual := unmarshalResponse(
+		t, ScimReadUser200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "2", *actual.Id)
+	require.Equal(t, "user0", actual.UserName)
+	require.True(t, *actual.Active)
+	require.Equal(t, []string{scimSchemaUser}, *actual.Schemas)
+	require.Equal(t, "http://localhost/scim/v2/Users/2", *actual.Meta.Location)
+}
+
+func Test_ScimReadUser_returns_deactivated_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.User.DeleteOneID(2).ExecX(context.Background())
+	req, err := svr.scimRequest(http.MethodGet, "/scim/v2/Users/2", "")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimReadUser200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.False(t, *actual.Active)
+}
+
+func Test_ScimReadUser_returns_404_if_not_found(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(http.MethodGet, "/scim/v2/Users/12345", "")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	actual := unmarshalResponse(
+		t, ScimReadUser404ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "404", actual.Status)
+	require.Equal(t, []string{scimSchemaError}, actual.Schemas)
+}
+
+func Test_ScimReadUser_returns_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	req, err := svr.scimRequest(
+		http.MethodGet, fmt.Sprintf("/scim/v2/Users/%d", a.UserID), "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// ScimReplaceGroup replaces the name and members of a role with the SCIM group
+// resource.
+//
+// Endpoint: PUT /scim/v2/Groups/{id}
+func (s Server) ScimReplaceGroup(
+	ctx context.Context, request ScimReplaceGroupRequestObject,
+) (ScimReplaceGroupResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimReplaceGroup error: %v", err)
+		return nil, err
+	}
+	r, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			body, err := scimBody(
+				request.JSONBody, request.ApplicationScimPlusJSONBody,
+			)
+			if err != nil {
+				return nil, err
+			}
+			var members []uint64
+			if nil != body.Members {
+				if members, err = scimMemberIds(*body.Members); err != nil {
+					return nil, err
+				}
+			}
+			return applyScimGroupChanges(
+				qc, tx, tenant, request.Id, &body.DisplayName,
+				func([]uint64) ([]uint64, error) { return members, nil },
+			)
+		},
+	)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			switch se.status {
+			case http.StatusNotFound:
+				return ScimReplaceGroup404ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			case http.StatusConflict:
+				return ScimReplaceGroup409ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			}
+			return ScimReplaceGroup400ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimReplaceGroup error: %v", err)
+		return nil, err
+	}
+	return ScimReplaceGroup200ApplicationScimPlusJSONResponse(
+		s.scimGroup(r.(*ent.Role)),
+	), nil
+}
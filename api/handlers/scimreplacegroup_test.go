@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScimReplaceGroup_replaces_a_group(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPut, "/scim/v2/Groups/4", "okta_replace_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimReplaceGroup200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "role 2 renamed", actual.DisplayName)
+	require.Len(t, *actual.Members, 1)
+	require.Equal(t, "7", (*actual.Members)[0].Value)
+	r, err := db.Role.Get(context.Background(), 4)
+	require.Nil(t, err)
+	require.Equal(t, "role 2 renamed", r.Name)
+	ids, err := r.QueryUsers().IDs(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, []uint64{7}, ids)
+}
+
+func Test_ScimReplaceGroup_returns_400_if_member_not_found(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodPut, "/scim/v2/Groups/4", "",
+	)
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", "application/scim+json")
+	req.Body = io.NopCloser(strings.NewReader(
+		`{"displayName":"role 2","members":[{"value":"12345"}]}`,
+	))
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	actual := unmarshalResponse(
+		t, ScimReplaceGroup400ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, scimInvalidValue, *actual.ScimType)
+}
+
+func Test_ScimReplaceGroup_returns_409_if_name_exists(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodPut, "/scim/v2/Groups/4", "",
+	)
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", "application/scim+json")
+	req.Body = io.NopCloser(strings.NewReader(`{"displayName":"role 0"}`))
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusConflict, res.Code)
+}
+
+func Test_ScimReplaceGroup_returns_404_if_not_found(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodPut, "/scim/v2/Groups/12345", "okta_replace_group",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// ScimReplaceUser replaces attributes of a user with the SCIM user resource.
+// Emails are removed if the resource has none, the active state and password
+// are left unchanged if omitted.
+//
+// Endpoint: PUT /scim/v2/Users/{id}
+func (s Server) ScimReplaceUser(
+	ctx context.Context, request ScimReplaceUserRequestObject,
+) (ScimReplaceUserResponseObject, error) {
+	tenant, err := scimTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("ScimReplaceUser error: %v", err)
+		return nil, err
+	}
+	u, err := s.db.Transaction(
+		context.Background(),
+		func(_ context.Context, tx *ent.Tx) (interface{}, error) {
+			body, err := scimBody(
+				request.JSONBody, request.ApplicationScimPlusJSONBody,
+			)
+			if err != nil {
+				return nil, err
+			}
+			email := scimPrimaryEmail(body.Emails)
+			return s.applyScimUserChanges(
+				tx, tenant, request.Id, scimUserChanges{
+					userName: &body.UserName,
+					email:    &email,
+					password: body.Password,
+					active:   body.Active,
+				},
+			)
+		},
+	)
+	if err != nil {
+		if se := asScimError(err); nil != se {
+			switch se.status {
+			case http.StatusNotFound:
+				return ScimReplaceUser404ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			case http.StatusConflict:
+				return ScimReplaceUser409ApplicationScimPlusJSONResponse(
+					se.response(),
+				), nil
+			}
+			return ScimReplaceUser400ApplicationScimPlusJSONResponse(
+				se.response(),
+			), nil
+		}
+		api.Log.Debugf("ScimReplaceUser error: %v", err)
+		return nil, err
+	}
+	return ScimReplaceUser200ApplicationScimPlusJSONResponse(
+		s.scimUser(u.(*ent.User)),
+	), nil
+}
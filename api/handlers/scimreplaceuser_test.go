@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+func Test_ScimReplaceUser_replaces_a_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	before := getUserById(t, db, 5)
+	req, err := svr.scimRequest(
+		http.MethodPut, "/scim/v2/Users/5", "okta_replace_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimReplaceUser200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, "user3", actual.UserName)
+	require.Equal(t, "user3.new@example.com", (*actual.Emails)[0].Value)
+	after := getUserById(t, db, 5)
+	require.Equal(t, "user3.new@example.com", *after.Email)
+	require.Equal(t, before.Password, after.Password)
+}
+
+func Test_ScimReplaceUser_clears_email_if_omitted(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(http.MethodPut, "/scim/v2/Users/5", "")
+	require.Nil(t, err)
+	req.Body = io.NopCloser(strings.NewReader(`{"userName":"user3"}`))
+	req.Header.Set("Content-Type", "application/scim+json")
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Nil(t, getUserById(t, db, 5).Email)
+}
+
+func Test_ScimReplaceUser_returns_400_if_username_changed(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, true)
+	req, err := svr.scimRequest(
+		http.MethodPut, "/scim/v2/Users/4", "okta_replace_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	actual := unmarshalResponse(
+		t, ScimReplaceUser400ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, scimMutability, *actual.ScimType)
+}
+
+func Test_ScimReplaceUser_returns_404_if_not_found(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodPut, "/scim/v2/Users/12345", "okta_replace_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_ScimReplaceUser_reactivates_a_user(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.User.DeleteOneID(5).ExecX(context.Background())
+	req, err := svr.scimRequest(
+		http.MethodPut, "/scim/v2/Users/5", "okta_replace_user",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	_, err = db.User.Get(context.Background(), 5)
+	require.False(t, ent.IsNotFound(err))
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+)
+
+// ScimServiceProviderConfig describes the SCIM features this service supports.
+//
+// Endpoint: GET /scim/v2/ServiceProviderConfig
+func (s Server) ScimServiceProviderConfig(
+	_ context.Context, _ ScimServiceProviderConfigRequestObject,
+) (ScimServiceProviderConfigResponseObject, error) {
+	supported := func(b bool) map[string]interface{} {
+		return map[string]interface{}{"supported": b}
+	}
+	return ScimServiceProviderConfig200ApplicationScimPlusJSONResponse{
+		"schemas": []string{scimSchemaConfig},
+		"patch":   supported(true),
+		"bulk": map[string]interface{}{
+			"supported": false, "maxOperations": 0, "maxPayloadSize": 0,
+		},
+		"filter": map[string]interface{}{
+			"supported": true, "maxResults": scimMaxResults,
+		},
+		"changePassword": supported(true),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]interface{}{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "Bearer Token",
+				"description": "Authentication with the dedicated SCIM token",
+				"primary":     true,
+			},
+		},
+		"meta": map[string]interface{}{
+			"resourceType": "ServiceProviderConfig",
+			"location":     s.baseUrl + "/scim/v2/ServiceProviderConfig",
+		},
+	}, nil
+}
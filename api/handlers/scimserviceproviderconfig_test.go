@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScimServiceProviderConfig_returns_config(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.scimRequest(
+		http.MethodGet, "/scim/v2/ServiceProviderConfig", "",
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(
+		t, ScimServiceProviderConfig200ApplicationScimPlusJSONResponse{}, res,
+	)
+	require.Equal(t, []interface{}{scimSchemaConfig}, actual["schemas"])
+	require.Equal(t, true, actual["patch"].(map[string]interface{})["supported"])
+	require.Equal(t, true, actual["filter"].(map[string]interface{})["supported"])
+}
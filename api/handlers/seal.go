@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// Seals the state of a multistep flow into a token handed to the client, so
+// that no server side storage is needed. The token is the base64 encoded JSON
+// of the state and its HMAC, keyed by the server secret and the purpose, so
+// that tokens of one flow can't be used in another. It isn't a JWT, so it
+// can't be mistaken for an access token.
+func sealState(purpose string, state interface{}) (string, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	mac, err := stateMac(purpose, data)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data) + "." +
+		base64.RawURLEncoding.EncodeToString(mac), nil
+}
+
+// Verifies the token returned by sealState, and decodes the state into
+// `state`. Returns errInvalidSession if the token was tampered with.
+func openState(purpose, token string, state interface{}) error {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return errInvalidSession
+	}
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return errInvalidSession
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return errInvalidSession
+	}
+	expected, err := stateMac(purpose, data)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(mac, expected) {
+		return errInvalidSession
+	}
+	if err = json.Unmarshal(data, state); err != nil {
+		return errInvalidSession
+	}
+	return nil
+}
+
+func stateMac(purpose string, data []byte) ([]byte, error) {
+	secret, err := getSecret()
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, secret)
+	// domain separation from other uses of the secret
+	mac.Write([]byte(purpose + "\x00"))
+	mac.Write(data)
+	return mac.Sum(nil), nil
+}
@@ -2,13 +2,16 @@ package handlers
 
 import (
 	"context"
+	"crypto/rsa"
 	"net/http"
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/eidng8/go-utils"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	gmw "github.com/oapi-codegen/gin-middleware"
@@ -28,8 +31,53 @@ type Server struct {
 	hintSize int
 	// list of public operations
 	publicOperations []string
+	// rules of new passwords
+	passwordPolicy *PasswordPolicy
 	// password hash parameters, for `argon2id`
 	passwordHashParams utils.PasswordHashParams
+	// the first hashes new passwords, all verify existing hashes
+	passwordHashers []PasswordHasher
+	// hash compared against when the login user doesn't exist
+	dummyHash *dummyPasswordHash
+	// HTTP client used to deliver webhooks
+	webhookClient *http.Client
+	// maximum number of attempts to deliver a webhook event
+	webhookMaxAttempts uint32
+	// SCIM bearer token of the default organization, other organizations
+	// are issued their own tokens
+	scimToken string
+	// lifetime of impersonation tokens
+	impersonationTtl time.Duration
+	// identifiers accepted by login, one of `api.LoginIdentifier*`
+	loginIdentifier string
+	// counters of failed logins, shared by all replicas
+	loginAttempts LoginAttemptStore
+	// failed logins that lock out an account, zero to disable
+	loginMaxAttempts int
+	// failed logins that lock out a source address, zero to disable
+	loginIpMaxAttempts int
+	// failed logins older than this are forgotten
+	loginAttemptWindow time.Duration
+	// duration of the first lockout, doubled on each further failure
+	loginLockout time.Duration
+	// issuer shown in authenticator apps, defaults to the domain
+	totpIssuer string
+	// delivers emails such as password resets
+	mailer Mailer
+	// lifetime of password reset tokens
+	passwordResetTtl time.Duration
+	// frontend page linked in password reset emails, optional
+	passwordResetUrl string
+	// identity providers tried for logins without local user
+	authenticators []Authenticator
+	// map groups of external users to roles
+	groupRoleRules []GroupRoleRule
+	// OpenID Connect providers users can sign in with
+	oidcProviders []*OidcProvider
+	// signs ID tokens of OAuth clients, generated if nil
+	oauthSigningKey *rsa.PrivateKey
+	// frontend login page the authorization endpoint redirects to, optional
+	oauthLoginUrl string
 }
 
 func NewEngine(entClient *ent.Client) (*Server, *gin.Engine, error) {
@@ -48,12 +96,43 @@ func NewEngine(entClient *ent.Client) (*Server, *gin.Engine, error) {
 }
 
 func newApiServer(db *ent.Client) *Server {
-	return &Server{
-		db:               db,
-		baseUrl:          os.Getenv(api.BaseUrlName),
-		hintSize:         getHintSize(5),
-		publicOperations: getPublicOperations(),
+	params, err := utils.DefaultPasswordHashParams()
+	utils.PanicIfError(err)
+	server := &Server{
+		db:                 db,
+		baseUrl:            os.Getenv(api.BaseUrlName),
+		hintSize:           getHintSize(5),
+		publicOperations:   getPublicOperations(),
+		passwordPolicy:     getPasswordPolicy(),
+		passwordHashParams: *params,
+		webhookClient:      &http.Client{Timeout: getWebhookTimeout(10)},
+		webhookMaxAttempts: getWebhookMaxAttempts(8),
+		scimToken:          os.Getenv(api.ScimTokenName),
+		impersonationTtl:   getImpersonationTtl(900),
+		loginIdentifier:    getLoginIdentifier(),
+		loginAttempts:      NewMemoryLoginAttemptStore(),
+		loginMaxAttempts:   getLoginMaxAttempts(api.LoginMaxAttemptsName, 5),
+		loginIpMaxAttempts: getLoginMaxAttempts(
+			api.LoginIpMaxAttemptsName, 20,
+		),
+		loginAttemptWindow: getLoginLockoutDuration(
+			api.LoginAttemptWindowName, 3600,
+		),
+		loginLockout:     getLoginLockoutDuration(api.LoginLockoutName, 300),
+		totpIssuer:       os.Getenv(api.TotpIssuerName),
+		mailer:           getMailer(),
+		passwordResetTtl: getPasswordResetTtl(3600),
+		passwordResetUrl: os.Getenv(api.PasswordResetUrlName),
+		groupRoleRules:   getGroupRoleRules(),
+		oauthSigningKey:  getOauthSigningKey(),
+		oauthLoginUrl:    os.Getenv(api.OauthLoginUrlName),
+	}
+	server.UsePasswordHashers(getPasswordHashers(*params)...)
+	if a := getLdapAuthenticator(); nil != a {
+		server.UseAuthenticators(*a)
 	}
+	server.UseOidcProviders(getOidcProviders()...)
+	return server
 }
 
 func newApiHandler(server *Server, engine *gin.Engine) ServerInterface {
@@ -85,6 +164,9 @@ func newSwaggerServer(engine *gin.Engine) *openapi3.T {
 	swagger, err := GetSwagger()
 	utils.PanicIfError(err)
 	swagger.Servers = nil
+	openapi3filter.RegisterBodyDecoder(
+		"application/scim+json", openapi3filter.JSONBodyDecoder,
+	)
 	engine.Use(
 		gmw.OapiRequestValidatorWithOptions(
 			swagger, &gmw.Options{
@@ -152,10 +234,14 @@ func (s Server) getToken(gc *gin.Context) (*jwtToken, error) {
 }
 
 // Checks whether the given user has permission to perform the given operation.
+// Disabled or deleted users aren't allowed any operation.
 // TODO add role permission caching
 func (s Server) operationAllowed(user *ent.User, operation string) error {
+	if accountClosed(user) {
+		return errAccessDenied
+	}
 	if nil == user.Edges.Roles {
-		err := loadRoles(user)
+		err := s.loadRoles(user)
 		if err != nil {
 			return err
 		}
@@ -34,6 +34,7 @@ func setupTestEnv(tb testing.TB) {
 	require.Nil(tb, os.Setenv(api.BaseUrlName, "http://localhost"))
 	require.Nil(tb, os.Setenv(api.PrivateKeyName, randomSecret(32)))
 	require.Nil(tb, os.Setenv(api.PublicOpsName, "ping"))
+	require.Nil(tb, os.Setenv(api.ScimTokenName, scimTestToken))
 }
 
 func useEmptyDb(tb testing.TB) *ent.Client {
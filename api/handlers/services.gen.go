@@ -19,25 +19,164 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
 	strictgin "github.com/oapi-codegen/runtime/strictmiddleware/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// OpenID Connect discovery document
+	// (GET /.well-known/openid-configuration)
+	GetOpenidConfiguration(c *gin.Context)
+	// Find a AccessRequest by ID
+	// (GET /access-request/{id})
+	ReadAccessRequest(c *gin.Context, id uint64)
+	// Approve an access request
+	// (POST /access-request/{id}/approve)
+	ApproveAccessRequest(c *gin.Context, id uint64)
+	// Deny an access request
+	// (POST /access-request/{id}/deny)
+	DenyAccessRequest(c *gin.Context, id uint64)
+	// List AccessRequests
+	// (GET /access-requests)
+	ListAccessRequest(c *gin.Context, params ListAccessRequestParams)
+	// Request a role
+	// (POST /access-requests)
+	CreateAccessRequest(c *gin.Context)
 	// Revoke current access token
 	// (DELETE /access-token)
 	RevokeAccessToken(c *gin.Context)
 	// Validate current access token
 	// (GET /access-token)
-	CheckAccessToken(c *gin.Context)
+	CheckAccessToken(c *gin.Context, params CheckAccessTokenParams)
 	// Refresh current access token
 	// (POST /access-token/refresh)
 	RefreshAccessToken(c *gin.Context)
+	// List AuditLogs
+	// (GET /audit-logs)
+	ListAuditLog(c *gin.Context, params ListAuditLogParams)
+	// Deletes a Group by ID
+	// (DELETE /group/{id})
+	DeleteGroup(c *gin.Context, id uint32)
+	// Find a Group by ID
+	// (GET /group/{id})
+	ReadGroup(c *gin.Context, id uint32)
+	// Updates a Group
+	// (PATCH /group/{id})
+	UpdateGroup(c *gin.Context, id uint32)
+	// List attached Roles
+	// (GET /group/{id}/roles)
+	ListGroupRoles(c *gin.Context, id uint32, params ListGroupRolesParams)
+	// Assign roles to group
+	// (POST /group/{id}/roles)
+	AssignGroupRoles(c *gin.Context, id uint32)
+	// Remove member from group
+	// (DELETE /group/{id}/user/{user_id})
+	RemoveGroupMember(c *gin.Context, id uint32, userId uint64)
+	// List attached Users
+	// (GET /group/{id}/users)
+	ListGroupUsers(c *gin.Context, id uint32, params ListGroupUsersParams)
+	// Add members to group
+	// (POST /group/{id}/users)
+	AddGroupMembers(c *gin.Context, id uint32)
+	// List Groups
+	// (GET /groups)
+	ListGroup(c *gin.Context, params ListGroupParams)
+	// Create a new Group
+	// (POST /groups)
+	CreateGroup(c *gin.Context)
 	// Login
 	// (POST /login)
 	Login(c *gin.Context)
+	// Start signing in with an OpenID Connect provider
+	// (POST /login/oidc/{provider})
+	BeginOidcLogin(c *gin.Context, provider string)
+	// Sign in with the authorization code of the provider
+	// (POST /login/oidc/{provider}/finish)
+	FinishOidcLogin(c *gin.Context, provider string)
+	// Complete login with a one-time code
+	// (POST /login/totp)
+	VerifyTotp(c *gin.Context)
+	// Start signing in with a passkey
+	// (POST /login/webauthn)
+	BeginWebauthnLogin(c *gin.Context)
+	// Sign in with the assertion of the authenticator
+	// (POST /login/webauthn/finish)
+	FinishWebauthnLogin(c *gin.Context)
 	// Logout
 	// (POST /logout)
 	Logout(c *gin.Context)
+	// Change password of the current user
+	// (POST /me/password)
+	ChangePassword(c *gin.Context)
+	// List active sessions of the current user
+	// (GET /me/sessions)
+	ListMySessions(c *gin.Context)
+	// Sign out a session of the current user
+	// (DELETE /me/sessions/{id})
+	DeleteMySession(c *gin.Context, id uint64)
+	// Start TOTP enrollment of the current user
+	// (POST /me/totp)
+	EnrollTotp(c *gin.Context)
+	// Enable TOTP with the first code of the authenticator
+	// (POST /me/totp/confirm)
+	ConfirmTotp(c *gin.Context)
+	// Disable TOTP of the current user
+	// (POST /me/totp/disable)
+	DisableTotp(c *gin.Context)
+	// Start registering a passkey of the current user
+	// (POST /me/webauthn/register)
+	BeginWebauthnRegistration(c *gin.Context)
+	// Store the passkey created by the authenticator
+	// (POST /me/webauthn/register/finish)
+	FinishWebauthnRegistration(c *gin.Context)
+	// Deletes a OauthClient by ID
+	// (DELETE /oauth-client/{id})
+	DeleteOauthClient(c *gin.Context, id uint32)
+	// Find a OauthClient by ID
+	// (GET /oauth-client/{id})
+	ReadOauthClient(c *gin.Context, id uint32)
+	// List OauthClients
+	// (GET /oauth-clients)
+	ListOauthClient(c *gin.Context, params ListOauthClientParams)
+	// Create a new OauthClient
+	// (POST /oauth-clients)
+	CreateOauthClient(c *gin.Context)
+	// Authorize an OAuth client
+	// (GET /oauth/authorize)
+	OauthAuthorize(c *gin.Context, params OauthAuthorizeParams)
+	// Keys verifying ID tokens
+	// (GET /oauth/jwks)
+	OauthJwks(c *gin.Context)
+	// Issue tokens to an OAuth client
+	// (POST /oauth/token)
+	OauthToken(c *gin.Context)
+	// Deletes a Organization by ID
+	// (DELETE /organization/{id})
+	DeleteOrganization(c *gin.Context, id uint32)
+	// Find a Organization by ID
+	// (GET /organization/{id})
+	ReadOrganization(c *gin.Context, id uint32)
+	// Updates a Organization
+	// (PATCH /organization/{id})
+	UpdateOrganization(c *gin.Context, id uint32)
+	// Revoke the SCIM token of the organization
+	// (DELETE /organization/{id}/scim-token)
+	RevokeScimToken(c *gin.Context, id uint32)
+	// Issue the SCIM token of the organization
+	// (POST /organization/{id}/scim-token)
+	IssueScimToken(c *gin.Context, id uint32)
+	// List Organizations
+	// (GET /organizations)
+	ListOrganization(c *gin.Context, params ListOrganizationParams)
+	// Create a new Organization
+	// (POST /organizations)
+	CreateOrganization(c *gin.Context)
+	// Send a password reset token to the email of the user
+	// (POST /password/forgot)
+	ForgotPassword(c *gin.Context)
+	// Set a new password with a reset token
+	// (POST /password/reset)
+	ResetPassword(c *gin.Context)
 	// Deletes a Permission by ID
 	// (DELETE /permission/{id})
 	DeletePermission(c *gin.Context, id uint32)
@@ -86,6 +225,9 @@ type ServerInterface interface {
 	// Updates a Role
 	// (PATCH /role/{id})
 	UpdateRole(c *gin.Context, id uint32)
+	// List attached Approvers
+	// (GET /role/{id}/approvers)
+	ListRoleApprovers(c *gin.Context, id uint32, params ListRoleApproversParams)
 	// List attached Permissions
 	// (GET /role/{id}/permissions)
 	ListRolePermissions(c *gin.Context, id uint32, params ListRolePermissionsParams)
@@ -101,6 +243,78 @@ type ServerInterface interface {
 	// Create a new Role
 	// (POST /roles)
 	CreateRole(c *gin.Context)
+	// List SCIM groups
+	// (GET /scim/v2/Groups)
+	ScimListGroups(c *gin.Context, params ScimListGroupsParams)
+	// Create SCIM group
+	// (POST /scim/v2/Groups)
+	ScimCreateGroup(c *gin.Context)
+	// Delete SCIM group
+	// (DELETE /scim/v2/Groups/{id})
+	ScimDeleteGroup(c *gin.Context, id uint32)
+	// Read SCIM group
+	// (GET /scim/v2/Groups/{id})
+	ScimReadGroup(c *gin.Context, id uint32)
+	// Patch SCIM group
+	// (PATCH /scim/v2/Groups/{id})
+	ScimPatchGroup(c *gin.Context, id uint32)
+	// Replace SCIM group
+	// (PUT /scim/v2/Groups/{id})
+	ScimReplaceGroup(c *gin.Context, id uint32)
+	// SCIM service provider configuration
+	// (GET /scim/v2/ServiceProviderConfig)
+	ScimServiceProviderConfig(c *gin.Context)
+	// List SCIM users
+	// (GET /scim/v2/Users)
+	ScimListUsers(c *gin.Context, params ScimListUsersParams)
+	// Create SCIM user
+	// (POST /scim/v2/Users)
+	ScimCreateUser(c *gin.Context)
+	// Delete SCIM user
+	// (DELETE /scim/v2/Users/{id})
+	ScimDeleteUser(c *gin.Context, id uint64)
+	// Read SCIM user
+	// (GET /scim/v2/Users/{id})
+	ScimReadUser(c *gin.Context, id uint64)
+	// Patch SCIM user
+	// (PATCH /scim/v2/Users/{id})
+	ScimPatchUser(c *gin.Context, id uint64)
+	// Replace SCIM user
+	// (PUT /scim/v2/Users/{id})
+	ScimReplaceUser(c *gin.Context, id uint64)
+	// Deletes a ServiceAccount by ID
+	// (DELETE /service-account/{id})
+	DeleteServiceAccount(c *gin.Context, id uint32)
+	// Find a ServiceAccount by ID
+	// (GET /service-account/{id})
+	ReadServiceAccount(c *gin.Context, id uint32)
+	// List ServiceAccounts
+	// (GET /service-accounts)
+	ListServiceAccount(c *gin.Context, params ListServiceAccountParams)
+	// Create a new ServiceAccount
+	// (POST /service-accounts)
+	CreateServiceAccount(c *gin.Context)
+	// Deletes a SodConstraint by ID
+	// (DELETE /sod-constraint/{id})
+	DeleteSodConstraint(c *gin.Context, id uint32)
+	// Find a SodConstraint by ID
+	// (GET /sod-constraint/{id})
+	ReadSodConstraint(c *gin.Context, id uint32)
+	// Updates a SodConstraint
+	// (PATCH /sod-constraint/{id})
+	UpdateSodConstraint(c *gin.Context, id uint32)
+	// List attached Roles
+	// (GET /sod-constraint/{id}/roles)
+	ListSodConstraintRoles(c *gin.Context, id uint32, params ListSodConstraintRolesParams)
+	// List users violating the constraint
+	// (GET /sod-constraint/{id}/violations)
+	ListSodViolations(c *gin.Context, id uint32)
+	// List SodConstraints
+	// (GET /sod-constraints)
+	ListSodConstraint(c *gin.Context, params ListSodConstraintParams)
+	// Create a new SodConstraint
+	// (POST /sod-constraints)
+	CreateSodConstraint(c *gin.Context)
 	// Deletes a User by ID
 	// (DELETE /user/{id})
 	DeleteUser(c *gin.Context, id uint64, params DeleteUserParams)
@@ -110,21 +324,63 @@ type ServerInterface interface {
 	// Updates a User
 	// (PATCH /user/{id})
 	UpdateUser(c *gin.Context, id uint64)
+	// Disable the user
+	// (POST /user/{id}/disable)
+	DisableUser(c *gin.Context, id uint64)
+	// Enable the disabled user
+	// (POST /user/{id}/enable)
+	EnableUser(c *gin.Context, id uint64)
+	// Issue a short-lived access token to act as the user
+	// (POST /user/{id}/impersonate)
+	Impersonate(c *gin.Context, id uint64)
+	// Set a temporary password of the user
+	// (POST /user/{id}/password)
+	SetUserPassword(c *gin.Context, id uint64)
 	// Restore a trashed record
 	// (POST /user/{id}/restore)
 	RestoreUser(c *gin.Context, id uint64)
+	// Revoke all tokens of the user
+	// (POST /user/{id}/revoke-tokens)
+	RevokeUserTokens(c *gin.Context, id uint64)
 	// List attached Roles
 	// (GET /user/{id}/roles)
 	ListUserRoles(c *gin.Context, id uint64, params ListUserRolesParams)
 	// Assign roles to user
 	// (POST /user/{id}/roles)
-	AssignRoles(c *gin.Context, id uint64)
+	AssignRoles(c *gin.Context, id uint64, params AssignRolesParams)
+	// Sign out all sessions of the user
+	// (POST /user/{id}/sessions/revoke-all)
+	RevokeUserSessions(c *gin.Context, id uint64)
+	// Lift the login lockout of the user
+	// (POST /user/{id}/unlock)
+	UnlockUser(c *gin.Context, id uint64)
+	// Claims of the user of an OAuth access token
+	// (GET /userinfo)
+	OauthUserinfo(c *gin.Context)
 	// List Users
 	// (GET /users)
 	ListUser(c *gin.Context, params ListUserParams)
 	// Create a new User
 	// (POST /users)
 	CreateUser(c *gin.Context)
+	// Deletes a Webhook by ID
+	// (DELETE /webhook/{id})
+	DeleteWebhook(c *gin.Context, id uint32)
+	// Find a Webhook by ID
+	// (GET /webhook/{id})
+	ReadWebhook(c *gin.Context, id uint32)
+	// Updates a Webhook
+	// (PATCH /webhook/{id})
+	UpdateWebhook(c *gin.Context, id uint32)
+	// List attached Deliveries
+	// (GET /webhook/{id}/deliveries)
+	ListWebhookDeliveries(c *gin.Context, id uint32, params ListWebhookDeliveriesParams)
+	// List Webhooks
+	// (GET /webhooks)
+	ListWebhook(c *gin.Context, params ListWebhookParams)
+	// Create a new Webhook
+	// (POST /webhooks)
+	CreateWebhook(c *gin.Context)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -136,60 +392,8 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// RevokeAccessToken operation middleware
-func (siw *ServerInterfaceWrapper) RevokeAccessToken(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.RevokeAccessToken(c)
-}
-
-// CheckAccessToken operation middleware
-func (siw *ServerInterfaceWrapper) CheckAccessToken(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.CheckAccessToken(c)
-}
-
-// RefreshAccessToken operation middleware
-func (siw *ServerInterfaceWrapper) RefreshAccessToken(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.RefreshAccessToken(c)
-}
-
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.Login(c)
-}
-
-// Logout operation middleware
-func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
+// GetOpenidConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenidConfiguration(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -198,16 +402,16 @@ func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Logout(c)
+	siw.Handler.GetOpenidConfiguration(c)
 }
 
-// DeletePermission operation middleware
-func (siw *ServerInterfaceWrapper) DeletePermission(c *gin.Context) {
+// ReadAccessRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReadAccessRequest(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id uint32
+	var id uint64
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -222,16 +426,16 @@ func (siw *ServerInterfaceWrapper) DeletePermission(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeletePermission(c, id)
+	siw.Handler.ReadAccessRequest(c, id)
 }
 
-// ReadPermission operation middleware
-func (siw *ServerInterfaceWrapper) ReadPermission(c *gin.Context) {
+// ApproveAccessRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveAccessRequest(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id uint32
+	var id uint64
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -239,17 +443,6 @@ func (siw *ServerInterfaceWrapper) ReadPermission(c *gin.Context) {
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ReadPermissionParams
-
-	// ------------- Optional query parameter "username" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "username", c.Request.URL.Query(), &params.Username)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -257,16 +450,16 @@ func (siw *ServerInterfaceWrapper) ReadPermission(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ReadPermission(c, id, params)
+	siw.Handler.ApproveAccessRequest(c, id)
 }
 
-// UpdatePermission operation middleware
-func (siw *ServerInterfaceWrapper) UpdatePermission(c *gin.Context) {
+// DenyAccessRequest operation middleware
+func (siw *ServerInterfaceWrapper) DenyAccessRequest(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id uint32
+	var id uint64
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -281,16 +474,16 @@ func (siw *ServerInterfaceWrapper) UpdatePermission(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdatePermission(c, id)
+	siw.Handler.DenyAccessRequest(c, id)
 }
 
-// ListPermission operation middleware
-func (siw *ServerInterfaceWrapper) ListPermission(c *gin.Context) {
+// ListAccessRequest operation middleware
+func (siw *ServerInterfaceWrapper) ListAccessRequest(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPermissionParams
+	var params ListAccessRequestParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -308,11 +501,27 @@ func (siw *ServerInterfaceWrapper) ListPermission(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "name" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "role_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role_id", c.Request.URL.Query(), &params.RoleId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "requester_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requester_id", c.Request.URL.Query(), &params.RequesterId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requester_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -323,11 +532,11 @@ func (siw *ServerInterfaceWrapper) ListPermission(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListPermission(c, params)
+	siw.Handler.ListAccessRequest(c, params)
 }
 
-// CreatePermission operation middleware
-func (siw *ServerInterfaceWrapper) CreatePermission(c *gin.Context) {
+// CreateAccessRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccessRequest(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -336,22 +545,11 @@ func (siw *ServerInterfaceWrapper) CreatePermission(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreatePermission(c)
+	siw.Handler.CreateAccessRequest(c)
 }
 
-// DeletePersonalToken operation middleware
-func (siw *ServerInterfaceWrapper) DeletePersonalToken(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id uint64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// RevokeAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAccessToken(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -360,20 +558,22 @@ func (siw *ServerInterfaceWrapper) DeletePersonalToken(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeletePersonalToken(c, id)
+	siw.Handler.RevokeAccessToken(c)
 }
 
-// ReadPersonalToken operation middleware
-func (siw *ServerInterfaceWrapper) ReadPersonalToken(c *gin.Context) {
+// CheckAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) CheckAccessToken(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id uint64
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CheckAccessTokenParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "operation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation", c.Request.URL.Query(), &params.Operation)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operation: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -384,16 +584,29 @@ func (siw *ServerInterfaceWrapper) ReadPersonalToken(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ReadPersonalToken(c, id)
+	siw.Handler.CheckAccessToken(c, params)
 }
 
-// ListPersonalToken operation middleware
-func (siw *ServerInterfaceWrapper) ListPersonalToken(c *gin.Context) {
+// RefreshAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) RefreshAccessToken(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RefreshAccessToken(c)
+}
+
+// ListAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditLog(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPersonalTokenParams
+	var params ListAuditLogParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -411,6 +624,14 @@ func (siw *ServerInterfaceWrapper) ListPersonalToken(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -418,24 +639,22 @@ func (siw *ServerInterfaceWrapper) ListPersonalToken(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListPersonalToken(c, params)
+	siw.Handler.ListAuditLog(c, params)
 }
 
-// CreatePersonalToken operation middleware
-func (siw *ServerInterfaceWrapper) CreatePersonalToken(c *gin.Context) {
+// DeleteGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGroup(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	var err error
 
-	siw.Handler.CreatePersonalToken(c)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-// Ping operation middleware
-func (siw *ServerInterfaceWrapper) Ping(c *gin.Context) {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -444,29 +663,20 @@ func (siw *ServerInterfaceWrapper) Ping(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Ping(c)
+	siw.Handler.DeleteGroup(c, id)
 }
 
-// HintPermissions operation middleware
-func (siw *ServerInterfaceWrapper) HintPermissions(c *gin.Context) {
+// ReadGroup operation middleware
+func (siw *ServerInterfaceWrapper) ReadGroup(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HintPermissionsParams
-
-	// ------------- Required query parameter "q" -------------
-
-	if paramValue := c.Query("q"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -477,29 +687,20 @@ func (siw *ServerInterfaceWrapper) HintPermissions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.HintPermissions(c, params)
+	siw.Handler.ReadGroup(c, id)
 }
 
-// HintRoles operation middleware
-func (siw *ServerInterfaceWrapper) HintRoles(c *gin.Context) {
+// UpdateGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGroup(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HintRolesParams
-
-	// ------------- Required query parameter "q" -------------
-
-	if paramValue := c.Query("q"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -510,29 +711,47 @@ func (siw *ServerInterfaceWrapper) HintRoles(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.HintRoles(c, params)
+	siw.Handler.UpdateGroup(c, id)
 }
 
-// HintUsers operation middleware
-func (siw *ServerInterfaceWrapper) HintUsers(c *gin.Context) {
+// ListGroupRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListGroupRoles(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params HintUsersParams
+	var params ListGroupRolesParams
 
-	// ------------- Required query parameter "q" -------------
+	// ------------- Optional query parameter "page" -------------
 
-	if paramValue := c.Query("q"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -543,11 +762,11 @@ func (siw *ServerInterfaceWrapper) HintUsers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.HintUsers(c, params)
+	siw.Handler.ListGroupRoles(c, id, params)
 }
 
-// DeleteRole operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRole(c *gin.Context) {
+// AssignGroupRoles operation middleware
+func (siw *ServerInterfaceWrapper) AssignGroupRoles(c *gin.Context) {
 
 	var err error
 
@@ -567,11 +786,11 @@ func (siw *ServerInterfaceWrapper) DeleteRole(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteRole(c, id)
+	siw.Handler.AssignGroupRoles(c, id)
 }
 
-// ReadRole operation middleware
-func (siw *ServerInterfaceWrapper) ReadRole(c *gin.Context) {
+// RemoveGroupMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveGroupMember(c *gin.Context) {
 
 	var err error
 
@@ -584,27 +803,12 @@ func (siw *ServerInterfaceWrapper) ReadRole(c *gin.Context) {
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.ReadRole(c, id)
-}
-
-// UpdateRole operation middleware
-func (siw *ServerInterfaceWrapper) UpdateRole(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id uint32
+	// ------------- Path parameter "user_id" -------------
+	var userId uint64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -615,11 +819,11 @@ func (siw *ServerInterfaceWrapper) UpdateRole(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateRole(c, id)
+	siw.Handler.RemoveGroupMember(c, id, userId)
 }
 
-// ListRolePermissions operation middleware
-func (siw *ServerInterfaceWrapper) ListRolePermissions(c *gin.Context) {
+// ListGroupUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListGroupUsers(c *gin.Context) {
 
 	var err error
 
@@ -633,7 +837,7 @@ func (siw *ServerInterfaceWrapper) ListRolePermissions(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRolePermissionsParams
+	var params ListGroupUsersParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -666,11 +870,11 @@ func (siw *ServerInterfaceWrapper) ListRolePermissions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListRolePermissions(c, id, params)
+	siw.Handler.ListGroupUsers(c, id, params)
 }
 
-// AssignPermissions operation middleware
-func (siw *ServerInterfaceWrapper) AssignPermissions(c *gin.Context) {
+// AddGroupMembers operation middleware
+func (siw *ServerInterfaceWrapper) AddGroupMembers(c *gin.Context) {
 
 	var err error
 
@@ -690,25 +894,16 @@ func (siw *ServerInterfaceWrapper) AssignPermissions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AssignPermissions(c, id)
+	siw.Handler.AddGroupMembers(c, id)
 }
 
-// ListRoleUsers operation middleware
-func (siw *ServerInterfaceWrapper) ListRoleUsers(c *gin.Context) {
+// ListGroup operation middleware
+func (siw *ServerInterfaceWrapper) ListGroup(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id uint32
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRoleUsersParams
+	var params ListGroupParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -741,38 +936,70 @@ func (siw *ServerInterfaceWrapper) ListRoleUsers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListRoleUsers(c, id, params)
+	siw.Handler.ListGroup(c, params)
 }
 
-// ListRole operation middleware
-func (siw *ServerInterfaceWrapper) ListRole(c *gin.Context) {
+// CreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroup(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRoleParams
+	siw.Handler.CreateGroup(c)
+}
 
-	// ------------- Optional query parameter "page" -------------
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	siw.Handler.Login(c)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+// BeginOidcLogin operation middleware
+func (siw *ServerInterfaceWrapper) BeginOidcLogin(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "name" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	siw.Handler.BeginOidcLogin(c, provider)
+}
+
+// FinishOidcLogin operation middleware
+func (siw *ServerInterfaceWrapper) FinishOidcLogin(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -783,11 +1010,11 @@ func (siw *ServerInterfaceWrapper) ListRole(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListRole(c, params)
+	siw.Handler.FinishOidcLogin(c, provider)
 }
 
-// CreateRole operation middleware
-func (siw *ServerInterfaceWrapper) CreateRole(c *gin.Context) {
+// VerifyTotp operation middleware
+func (siw *ServerInterfaceWrapper) VerifyTotp(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -796,33 +1023,24 @@ func (siw *ServerInterfaceWrapper) CreateRole(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateRole(c)
+	siw.Handler.VerifyTotp(c)
 }
 
-// DeleteUser operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id uint64
+// BeginWebauthnLogin operation middleware
+func (siw *ServerInterfaceWrapper) BeginWebauthnLogin(c *gin.Context) {
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteUserParams
-
-	// ------------- Optional query parameter "trashed" -------------
+	siw.Handler.BeginWebauthnLogin(c)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
-		return
-	}
+// FinishWebauthnLogin operation middleware
+func (siw *ServerInterfaceWrapper) FinishWebauthnLogin(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -831,34 +1049,38 @@ func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteUser(c, id, params)
+	siw.Handler.FinishWebauthnLogin(c)
 }
 
-// ReadUser operation middleware
-func (siw *ServerInterfaceWrapper) ReadUser(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id uint64
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ReadUserParams
+	siw.Handler.Logout(c)
+}
 
-	// ------------- Optional query parameter "trashed" -------------
+// ChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangePassword(c *gin.Context) {
 
-	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
+	siw.Handler.ChangePassword(c)
+}
+
+// ListMySessions operation middleware
+func (siw *ServerInterfaceWrapper) ListMySessions(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -866,11 +1088,11 @@ func (siw *ServerInterfaceWrapper) ReadUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ReadUser(c, id, params)
+	siw.Handler.ListMySessions(c)
 }
 
-// UpdateUser operation middleware
-func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
+// DeleteMySession operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMySession(c *gin.Context) {
 
 	var err error
 
@@ -890,22 +1112,24 @@ func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateUser(c, id)
+	siw.Handler.DeleteMySession(c, id)
 }
 
-// RestoreUser operation middleware
-func (siw *ServerInterfaceWrapper) RestoreUser(c *gin.Context) {
+// EnrollTotp operation middleware
+func (siw *ServerInterfaceWrapper) EnrollTotp(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id uint64
+	siw.Handler.EnrollTotp(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// ConfirmTotp operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmTotp(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -914,47 +1138,59 @@ func (siw *ServerInterfaceWrapper) RestoreUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RestoreUser(c, id)
+	siw.Handler.ConfirmTotp(c)
 }
 
-// ListUserRoles operation middleware
-func (siw *ServerInterfaceWrapper) ListUserRoles(c *gin.Context) {
+// DisableTotp operation middleware
+func (siw *ServerInterfaceWrapper) DisableTotp(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id uint64
+	siw.Handler.DisableTotp(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
+// BeginWebauthnRegistration operation middleware
+func (siw *ServerInterfaceWrapper) BeginWebauthnRegistration(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListUserRolesParams
+	siw.Handler.BeginWebauthnRegistration(c)
+}
 
-	// ------------- Optional query parameter "page" -------------
+// FinishWebauthnRegistration operation middleware
+func (siw *ServerInterfaceWrapper) FinishWebauthnRegistration(c *gin.Context) {
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	siw.Handler.FinishWebauthnRegistration(c)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
-		return
-	}
+// DeleteOauthClient operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOauthClient(c *gin.Context) {
 
-	// ------------- Optional query parameter "name" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	// ------------- Path parameter "id" -------------
+	var id uint32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -965,16 +1201,16 @@ func (siw *ServerInterfaceWrapper) ListUserRoles(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListUserRoles(c, id, params)
+	siw.Handler.DeleteOauthClient(c, id)
 }
 
-// AssignRoles operation middleware
-func (siw *ServerInterfaceWrapper) AssignRoles(c *gin.Context) {
+// ReadOauthClient operation middleware
+func (siw *ServerInterfaceWrapper) ReadOauthClient(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id uint64
+	var id uint32
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -989,16 +1225,16 @@ func (siw *ServerInterfaceWrapper) AssignRoles(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AssignRoles(c, id)
+	siw.Handler.ReadOauthClient(c, id)
 }
 
-// ListUser operation middleware
-func (siw *ServerInterfaceWrapper) ListUser(c *gin.Context) {
+// ListOauthClient operation middleware
+func (siw *ServerInterfaceWrapper) ListOauthClient(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListUserParams
+	var params ListOauthClientParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -1016,14 +1252,6 @@ func (siw *ServerInterfaceWrapper) ListUser(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "name" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1031,11 +1259,11 @@ func (siw *ServerInterfaceWrapper) ListUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListUser(c, params)
+	siw.Handler.ListOauthClient(c, params)
 }
 
-// CreateUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
+// CreateOauthClient operation middleware
+func (siw *ServerInterfaceWrapper) CreateOauthClient(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1044,2394 +1272,2975 @@ func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateUser(c)
+	siw.Handler.CreateOauthClient(c)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// OauthAuthorize operation middleware
+func (siw *ServerInterfaceWrapper) OauthAuthorize(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OauthAuthorizeParams
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	// ------------- Optional query parameter "response_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "response_type", c.Request.URL.Query(), &params.ResponseType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter response_type: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.DELETE(options.BaseURL+"/access-token", wrapper.RevokeAccessToken)
-	router.GET(options.BaseURL+"/access-token", wrapper.CheckAccessToken)
-	router.POST(options.BaseURL+"/access-token/refresh", wrapper.RefreshAccessToken)
-	router.POST(options.BaseURL+"/login", wrapper.Login)
-	router.POST(options.BaseURL+"/logout", wrapper.Logout)
-	router.DELETE(options.BaseURL+"/permission/:id", wrapper.DeletePermission)
-	router.GET(options.BaseURL+"/permission/:id", wrapper.ReadPermission)
-	router.PATCH(options.BaseURL+"/permission/:id", wrapper.UpdatePermission)
-	router.GET(options.BaseURL+"/permissions", wrapper.ListPermission)
-	router.POST(options.BaseURL+"/permissions", wrapper.CreatePermission)
-	router.DELETE(options.BaseURL+"/personal-token/:id", wrapper.DeletePersonalToken)
-	router.GET(options.BaseURL+"/personal-token/:id", wrapper.ReadPersonalToken)
-	router.GET(options.BaseURL+"/personal-tokens", wrapper.ListPersonalToken)
-	router.POST(options.BaseURL+"/personal-tokens", wrapper.CreatePersonalToken)
-	router.GET(options.BaseURL+"/ping", wrapper.Ping)
-	router.GET(options.BaseURL+"/q/permissions", wrapper.HintPermissions)
-	router.GET(options.BaseURL+"/q/roles", wrapper.HintRoles)
-	router.GET(options.BaseURL+"/q/users", wrapper.HintUsers)
-	router.DELETE(options.BaseURL+"/role/:id", wrapper.DeleteRole)
-	router.GET(options.BaseURL+"/role/:id", wrapper.ReadRole)
-	router.PATCH(options.BaseURL+"/role/:id", wrapper.UpdateRole)
-	router.GET(options.BaseURL+"/role/:id/permissions", wrapper.ListRolePermissions)
-	router.POST(options.BaseURL+"/role/:id/permissions", wrapper.AssignPermissions)
-	router.GET(options.BaseURL+"/role/:id/users", wrapper.ListRoleUsers)
-	router.GET(options.BaseURL+"/roles", wrapper.ListRole)
-	router.POST(options.BaseURL+"/roles", wrapper.CreateRole)
-	router.DELETE(options.BaseURL+"/user/:id", wrapper.DeleteUser)
-	router.GET(options.BaseURL+"/user/:id", wrapper.ReadUser)
-	router.PATCH(options.BaseURL+"/user/:id", wrapper.UpdateUser)
-	router.POST(options.BaseURL+"/user/:id/restore", wrapper.RestoreUser)
-	router.GET(options.BaseURL+"/user/:id/roles", wrapper.ListUserRoles)
-	router.POST(options.BaseURL+"/user/:id/roles", wrapper.AssignRoles)
-	router.GET(options.BaseURL+"/users", wrapper.ListUser)
-	router.POST(options.BaseURL+"/users", wrapper.CreateUser)
-}
+	// ------------- Optional query parameter "client_id" -------------
 
-type N400JSONResponse struct {
-	Code   int          `json:"code"`
-	Errors *interface{} `json:"errors,omitempty"`
-	Status string       `json:"status"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "client_id", c.Request.URL.Query(), &params.ClientId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter client_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type N401JSONResponse struct {
-	Code   int          `json:"code"`
-	Errors *interface{} `json:"errors,omitempty"`
-	Status string       `json:"status"`
-}
+	// ------------- Optional query parameter "redirect_uri" -------------
 
-type N403JSONResponse struct {
-	Code   int          `json:"code"`
-	Errors *interface{} `json:"errors,omitempty"`
-	Status string       `json:"status"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "redirect_uri", c.Request.URL.Query(), &params.RedirectUri)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter redirect_uri: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type N404JSONResponse struct {
-	Code   int          `json:"code"`
-	Errors *interface{} `json:"errors,omitempty"`
-	Status string       `json:"status"`
-}
+	// ------------- Optional query parameter "scope" -------------
 
-type N409JSONResponse struct {
-	Code   int          `json:"code"`
-	Errors *interface{} `json:"errors,omitempty"`
-	Status string       `json:"status"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "scope", c.Request.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type N422JSONResponse struct {
-	Code   int          `json:"code"`
-	Errors *interface{} `json:"errors,omitempty"`
-	Status string       `json:"status"`
-}
+	// ------------- Optional query parameter "state" -------------
 
-type N500JSONResponse struct {
-	Code   int          `json:"code"`
-	Errors *interface{} `json:"errors,omitempty"`
-	Status string       `json:"status"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type RevokeAccessTokenRequestObject struct {
-}
+	// ------------- Optional query parameter "nonce" -------------
 
-type RevokeAccessTokenResponseObject interface {
-	VisitRevokeAccessTokenResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "nonce", c.Request.URL.Query(), &params.Nonce)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter nonce: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type RevokeAccessToken204Response struct {
-}
+	// ------------- Optional query parameter "code_challenge" -------------
 
-func (response RevokeAccessToken204Response) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "code_challenge", c.Request.URL.Query(), &params.CodeChallenge)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code_challenge: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type RevokeAccessToken400JSONResponse struct{ N400JSONResponse }
+	// ------------- Optional query parameter "code_challenge_method" -------------
 
-func (response RevokeAccessToken400JSONResponse) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "code_challenge_method", c.Request.URL.Query(), &params.CodeChallengeMethod)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code_challenge_method: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OauthAuthorize(c, params)
 }
 
-type RevokeAccessToken401JSONResponse struct{ N401JSONResponse }
+// OauthJwks operation middleware
+func (siw *ServerInterfaceWrapper) OauthJwks(c *gin.Context) {
 
-func (response RevokeAccessToken401JSONResponse) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.OauthJwks(c)
 }
 
-type RevokeAccessToken403JSONResponse struct{ N403JSONResponse }
+// OauthToken operation middleware
+func (siw *ServerInterfaceWrapper) OauthToken(c *gin.Context) {
 
-func (response RevokeAccessToken403JSONResponse) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.OauthToken(c)
 }
 
-type RevokeAccessToken500JSONResponse struct{ N500JSONResponse }
+// DeleteOrganization operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganization(c *gin.Context) {
 
-func (response RevokeAccessToken500JSONResponse) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type CheckAccessTokenRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CheckAccessTokenResponseObject interface {
-	VisitCheckAccessTokenResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CheckAccessToken204Response struct {
+	siw.Handler.DeleteOrganization(c, id)
 }
 
-func (response CheckAccessToken204Response) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// ReadOrganization operation middleware
+func (siw *ServerInterfaceWrapper) ReadOrganization(c *gin.Context) {
 
-type CheckAccessToken400JSONResponse struct{ N400JSONResponse }
+	var err error
 
-func (response CheckAccessToken400JSONResponse) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	return json.NewEncoder(w).Encode(response)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReadOrganization(c, id)
 }
 
-type CheckAccessToken401JSONResponse struct{ N401JSONResponse }
+// UpdateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganization(c *gin.Context) {
 
-func (response CheckAccessToken401JSONResponse) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type CheckAccessToken403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response CheckAccessToken403JSONResponse) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UpdateOrganization(c, id)
 }
 
-type CheckAccessToken500JSONResponse struct{ N500JSONResponse }
+// RevokeScimToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeScimToken(c *gin.Context) {
 
-func (response CheckAccessToken500JSONResponse) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type RefreshAccessTokenRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type RefreshAccessTokenResponseObject interface {
-	VisitRefreshAccessTokenResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type RefreshAccessToken204Response struct {
+	siw.Handler.RevokeScimToken(c, id)
 }
 
-func (response RefreshAccessToken204Response) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
-
-type RefreshAccessToken400JSONResponse struct{ N400JSONResponse }
+// IssueScimToken operation middleware
+func (siw *ServerInterfaceWrapper) IssueScimToken(c *gin.Context) {
 
-func (response RefreshAccessToken400JSONResponse) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type RefreshAccessToken401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response RefreshAccessToken401JSONResponse) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.IssueScimToken(c, id)
 }
 
-type RefreshAccessToken403JSONResponse struct{ N403JSONResponse }
+// ListOrganization operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganization(c *gin.Context) {
 
-func (response RefreshAccessToken403JSONResponse) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrganizationParams
 
-type RefreshAccessToken500JSONResponse struct{ N500JSONResponse }
+	// ------------- Optional query parameter "page" -------------
 
-func (response RefreshAccessToken500JSONResponse) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "per_page" -------------
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListOrganization(c, params)
 }
 
-type Login200JSONResponse UserRead
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(c *gin.Context) {
 
-func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreateOrganization(c)
 }
 
-type Login400JSONResponse struct{ N400JSONResponse }
+// ForgotPassword operation middleware
+func (siw *ServerInterfaceWrapper) ForgotPassword(c *gin.Context) {
 
-func (response Login400JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ForgotPassword(c)
 }
 
-type Login401JSONResponse struct{ N401JSONResponse }
+// ResetPassword operation middleware
+func (siw *ServerInterfaceWrapper) ResetPassword(c *gin.Context) {
 
-func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ResetPassword(c)
 }
 
-type Login403JSONResponse struct{ N403JSONResponse }
-
-func (response Login403JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// DeletePermission operation middleware
+func (siw *ServerInterfaceWrapper) DeletePermission(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type Login500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-func (response Login500JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type LogoutRequestObject struct {
+	siw.Handler.DeletePermission(c, id)
 }
 
-type LogoutResponseObject interface {
-	VisitLogoutResponse(w http.ResponseWriter) error
-}
+// ReadPermission operation middleware
+func (siw *ServerInterfaceWrapper) ReadPermission(c *gin.Context) {
 
-type Logout204Response struct {
-}
+	var err error
 
-func (response Logout204Response) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type Logout401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response Logout401JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReadPermissionParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "username" -------------
 
-type Logout403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "username", c.Request.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response Logout403JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ReadPermission(c, id, params)
 }
 
-type Logout500JSONResponse struct{ N500JSONResponse }
+// UpdatePermission operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePermission(c *gin.Context) {
 
-func (response Logout500JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type DeletePermissionRequestObject struct {
-	Id uint32 `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DeletePermissionResponseObject interface {
-	VisitDeletePermissionResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type DeletePermission204Response struct {
+	siw.Handler.UpdatePermission(c, id)
 }
 
-func (response DeletePermission204Response) VisitDeletePermissionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// ListPermission operation middleware
+func (siw *ServerInterfaceWrapper) ListPermission(c *gin.Context) {
 
-type DeletePermission400JSONResponse struct{ N400JSONResponse }
+	var err error
 
-func (response DeletePermission400JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPermissionParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "page" -------------
 
-type DeletePermission401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeletePermission401JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Optional query parameter "per_page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DeletePermission403JSONResponse struct{ N403JSONResponse }
+	// ------------- Optional query parameter "name" -------------
 
-func (response DeletePermission403JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListPermission(c, params)
 }
 
-type DeletePermission404JSONResponse struct{ N404JSONResponse }
+// CreatePermission operation middleware
+func (siw *ServerInterfaceWrapper) CreatePermission(c *gin.Context) {
 
-func (response DeletePermission404JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreatePermission(c)
 }
 
-type DeletePermission409JSONResponse struct{ N409JSONResponse }
-
-func (response DeletePermission409JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// DeletePersonalToken operation middleware
+func (siw *ServerInterfaceWrapper) DeletePersonalToken(c *gin.Context) {
 
-type DeletePermission500JSONResponse struct{ N500JSONResponse }
+	var err error
 
-func (response DeletePermission500JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ReadPermissionRequestObject struct {
-	Id     uint32 `json:"id"`
-	Params ReadPermissionParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ReadPermissionResponseObject interface {
-	VisitReadPermissionResponse(w http.ResponseWriter) error
+	siw.Handler.DeletePersonalToken(c, id)
 }
 
-type ReadPermission200JSONResponse PermissionRead
+// ReadPersonalToken operation middleware
+func (siw *ServerInterfaceWrapper) ReadPersonalToken(c *gin.Context) {
 
-func (response ReadPermission200JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type ReadPermission400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ReadPermission400JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ReadPersonalToken(c, id)
 }
 
-type ReadPermission401JSONResponse struct{ N401JSONResponse }
+// ListPersonalToken operation middleware
+func (siw *ServerInterfaceWrapper) ListPersonalToken(c *gin.Context) {
 
-func (response ReadPermission401JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPersonalTokenParams
 
-type ReadPermission403JSONResponse struct{ N403JSONResponse }
+	// ------------- Optional query parameter "page" -------------
 
-func (response ReadPermission403JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "per_page" -------------
 
-type ReadPermission404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ReadPermission404JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListPersonalToken(c, params)
 }
 
-type ReadPermission409JSONResponse struct{ N409JSONResponse }
+// CreatePersonalToken operation middleware
+func (siw *ServerInterfaceWrapper) CreatePersonalToken(c *gin.Context) {
 
-func (response ReadPermission409JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreatePersonalToken(c)
 }
 
-type ReadPermission500JSONResponse struct{ N500JSONResponse }
-
-func (response ReadPermission500JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// Ping operation middleware
+func (siw *ServerInterfaceWrapper) Ping(c *gin.Context) {
 
-type UpdatePermissionRequestObject struct {
-	Id   uint32 `json:"id"`
-	Body *UpdatePermissionJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type UpdatePermissionResponseObject interface {
-	VisitUpdatePermissionResponse(w http.ResponseWriter) error
+	siw.Handler.Ping(c)
 }
 
-type UpdatePermission200JSONResponse PermissionUpdate
+// HintPermissions operation middleware
+func (siw *ServerInterfaceWrapper) HintPermissions(c *gin.Context) {
 
-func (response UpdatePermission200JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HintPermissionsParams
 
-type UpdatePermission400JSONResponse struct{ N400JSONResponse }
+	// ------------- Required query parameter "q" -------------
 
-func (response UpdatePermission400JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	if paramValue := c.Query("q"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type UpdatePermission401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdatePermission401JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.HintPermissions(c, params)
 }
 
-type UpdatePermission403JSONResponse struct{ N403JSONResponse }
+// HintRoles operation middleware
+func (siw *ServerInterfaceWrapper) HintRoles(c *gin.Context) {
 
-func (response UpdatePermission403JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HintRolesParams
 
-type UpdatePermission404JSONResponse struct{ N404JSONResponse }
+	// ------------- Required query parameter "q" -------------
 
-func (response UpdatePermission404JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	if paramValue := c.Query("q"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type UpdatePermission409JSONResponse struct{ N409JSONResponse }
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdatePermission409JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.HintRoles(c, params)
 }
 
-type UpdatePermission422JSONResponse struct{ N422JSONResponse }
+// HintUsers operation middleware
+func (siw *ServerInterfaceWrapper) HintUsers(c *gin.Context) {
 
-func (response UpdatePermission422JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HintUsersParams
 
-type UpdatePermission500JSONResponse struct{ N500JSONResponse }
+	// ------------- Required query parameter "q" -------------
 
-func (response UpdatePermission500JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	if paramValue := c.Query("q"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListPermissionRequestObject struct {
-	Params ListPermissionParams
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type ListPermissionResponseObject interface {
-	VisitListPermissionResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ListPermission200JSONResponse struct {
-	// CurrentPage Page number (1-based)
-	CurrentPage int `json:"current_page"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// Data List of items
-	Data []PermissionList `json:"data"`
+	siw.Handler.HintUsers(c, params)
+}
 
-	// FirstPageUrl URL to the first page
-	FirstPageUrl string `json:"first_page_url"`
+// DeleteRole operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRole(c *gin.Context) {
 
-	// From Index (1-based) of the first item in the current page
-	From int `json:"from"`
+	var err error
 
-	// LastPage Last page number
-	LastPage int `json:"last_page"`
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	// LastPageUrl URL to the last page
-	LastPageUrl string `json:"last_page_url"`
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// NextPageUrl URL to the next page
-	NextPageUrl string `json:"next_page_url"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// Path Base path of the request
-	Path string `json:"path"`
+	siw.Handler.DeleteRole(c, id)
+}
 
-	// PerPage Number of items per page
-	PerPage int `json:"per_page"`
+// ReadRole operation middleware
+func (siw *ServerInterfaceWrapper) ReadRole(c *gin.Context) {
 
-	// PrevPageUrl URL to the previous page
-	PrevPageUrl string `json:"prev_page_url"`
+	var err error
 
-	// To Index (1-based) of the last item in the current page
-	To int `json:"to"`
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	// Total Total number of items
-	Total int `json:"total"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListPermission200JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ReadRole(c, id)
 }
 
-type ListPermission400JSONResponse struct{ N400JSONResponse }
+// UpdateRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRole(c *gin.Context) {
 
-func (response ListPermission400JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type ListPermission401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListPermission401JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UpdateRole(c, id)
 }
 
-type ListPermission403JSONResponse struct{ N403JSONResponse }
+// ListRoleApprovers operation middleware
+func (siw *ServerInterfaceWrapper) ListRoleApprovers(c *gin.Context) {
 
-func (response ListPermission403JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type ListPermission404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListPermission404JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRoleApproversParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "page" -------------
 
-type ListPermission409JSONResponse struct{ N409JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListPermission409JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// ------------- Optional query parameter "per_page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ListPermission500JSONResponse struct{ N500JSONResponse }
+	// ------------- Optional query parameter "name" -------------
 
-func (response ListPermission500JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CreatePermissionRequestObject struct {
-	Body *CreatePermissionJSONRequestBody
+	siw.Handler.ListRoleApprovers(c, id, params)
 }
 
-type CreatePermissionResponseObject interface {
-	VisitCreatePermissionResponse(w http.ResponseWriter) error
-}
+// ListRolePermissions operation middleware
+func (siw *ServerInterfaceWrapper) ListRolePermissions(c *gin.Context) {
 
-type CreatePermission201JSONResponse PermissionCreate
+	var err error
 
-func (response CreatePermission201JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreatePermission400JSONResponse struct{ N400JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRolePermissionsParams
 
-func (response CreatePermission400JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreatePermission401JSONResponse struct{ N401JSONResponse }
+	// ------------- Optional query parameter "per_page" -------------
 
-func (response CreatePermission401JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "name" -------------
 
-type CreatePermission403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response CreatePermission403JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListRolePermissions(c, id, params)
 }
 
-type CreatePermission409JSONResponse struct{ N409JSONResponse }
+// AssignPermissions operation middleware
+func (siw *ServerInterfaceWrapper) AssignPermissions(c *gin.Context) {
 
-func (response CreatePermission409JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type CreatePermission500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response CreatePermission500JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.AssignPermissions(c, id)
 }
 
-type DeletePersonalTokenRequestObject struct {
-	Id uint64 `json:"id"`
-}
+// ListRoleUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListRoleUsers(c *gin.Context) {
 
-type DeletePersonalTokenResponseObject interface {
-	VisitDeletePersonalTokenResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type DeletePersonalToken204Response struct {
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-func (response DeletePersonalToken204Response) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DeletePersonalToken400JSONResponse struct{ N400JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRoleUsersParams
 
-func (response DeletePersonalToken400JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DeletePersonalToken401JSONResponse struct{ N401JSONResponse }
+	// ------------- Optional query parameter "per_page" -------------
 
-func (response DeletePersonalToken401JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "name" -------------
 
-type DeletePersonalToken403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeletePersonalToken403JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListRoleUsers(c, id, params)
 }
 
-type DeletePersonalToken404JSONResponse struct{ N404JSONResponse }
+// ListRole operation middleware
+func (siw *ServerInterfaceWrapper) ListRole(c *gin.Context) {
 
-func (response DeletePersonalToken404JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRoleParams
 
-type DeletePersonalToken409JSONResponse struct{ N409JSONResponse }
+	// ------------- Optional query parameter "page" -------------
 
-func (response DeletePersonalToken409JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "per_page" -------------
 
-type DeletePersonalToken500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeletePersonalToken500JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "name" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ReadPersonalTokenRequestObject struct {
-	Id uint64 `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ReadPersonalTokenResponseObject interface {
-	VisitReadPersonalTokenResponse(w http.ResponseWriter) error
+	siw.Handler.ListRole(c, params)
 }
 
-type ReadPersonalToken200JSONResponse PersonalTokenRead
+// CreateRole operation middleware
+func (siw *ServerInterfaceWrapper) CreateRole(c *gin.Context) {
 
-func (response ReadPersonalToken200JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreateRole(c)
 }
 
-type ReadPersonalToken400JSONResponse struct{ N400JSONResponse }
-
-func (response ReadPersonalToken400JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ScimListGroups operation middleware
+func (siw *ServerInterfaceWrapper) ScimListGroups(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type ReadPersonalToken401JSONResponse struct{ N401JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScimListGroupsParams
 
-func (response ReadPersonalToken401JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Optional query parameter "filter" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ReadPersonalToken403JSONResponse struct{ N403JSONResponse }
+	// ------------- Optional query parameter "startIndex" -------------
 
-func (response ReadPersonalToken403JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindQueryParameter("form", true, false, "startIndex", c.Request.URL.Query(), &params.StartIndex)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startIndex: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "count" -------------
 
-type ReadPersonalToken404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ReadPersonalToken404JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ScimListGroups(c, params)
 }
 
-type ReadPersonalToken409JSONResponse struct{ N409JSONResponse }
+// ScimCreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) ScimCreateGroup(c *gin.Context) {
 
-func (response ReadPersonalToken409JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ScimCreateGroup(c)
 }
 
-type ReadPersonalToken500JSONResponse struct{ N500JSONResponse }
+// ScimDeleteGroup operation middleware
+func (siw *ServerInterfaceWrapper) ScimDeleteGroup(c *gin.Context) {
 
-func (response ReadPersonalToken500JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type ListPersonalTokenRequestObject struct {
-	Params ListPersonalTokenParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ListPersonalTokenResponseObject interface {
-	VisitListPersonalTokenResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ScimDeleteGroup(c, id)
 }
 
-type ListPersonalToken200JSONResponse struct {
-	// CurrentPage Page number (1-based)
-	CurrentPage int `json:"current_page"`
+// ScimReadGroup operation middleware
+func (siw *ServerInterfaceWrapper) ScimReadGroup(c *gin.Context) {
 
-	// Data List of items
-	Data []PersonalTokenList `json:"data"`
+	var err error
 
-	// FirstPageUrl URL to the first page
-	FirstPageUrl string `json:"first_page_url"`
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	// From Index (1-based) of the first item in the current page
-	From int `json:"from"`
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// LastPage Last page number
-	LastPage int `json:"last_page"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// LastPageUrl URL to the last page
-	LastPageUrl string `json:"last_page_url"`
+	siw.Handler.ScimReadGroup(c, id)
+}
 
-	// NextPageUrl URL to the next page
-	NextPageUrl string `json:"next_page_url"`
+// ScimPatchGroup operation middleware
+func (siw *ServerInterfaceWrapper) ScimPatchGroup(c *gin.Context) {
 
-	// Path Base path of the request
-	Path string `json:"path"`
+	var err error
 
-	// PerPage Number of items per page
-	PerPage int `json:"per_page"`
-
-	// PrevPageUrl URL to the previous page
-	PrevPageUrl string `json:"prev_page_url"`
-
-	// To Index (1-based) of the last item in the current page
-	To int `json:"to"`
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	// Total Total number of items
-	Total int `json:"total"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListPersonalToken200JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ScimPatchGroup(c, id)
 }
 
-type ListPersonalToken400JSONResponse struct{ N400JSONResponse }
+// ScimReplaceGroup operation middleware
+func (siw *ServerInterfaceWrapper) ScimReplaceGroup(c *gin.Context) {
 
-func (response ListPersonalToken400JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type ListPersonalToken401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListPersonalToken401JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ScimReplaceGroup(c, id)
 }
 
-type ListPersonalToken403JSONResponse struct{ N403JSONResponse }
+// ScimServiceProviderConfig operation middleware
+func (siw *ServerInterfaceWrapper) ScimServiceProviderConfig(c *gin.Context) {
 
-func (response ListPersonalToken403JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ScimServiceProviderConfig(c)
 }
 
-type ListPersonalToken404JSONResponse struct{ N404JSONResponse }
+// ScimListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ScimListUsers(c *gin.Context) {
 
-func (response ListPersonalToken404JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScimListUsersParams
 
-type ListPersonalToken409JSONResponse struct{ N409JSONResponse }
+	// ------------- Optional query parameter "filter" -------------
 
-func (response ListPersonalToken409JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "startIndex" -------------
 
-type ListPersonalToken500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "startIndex", c.Request.URL.Query(), &params.StartIndex)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startIndex: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListPersonalToken500JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "count" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreatePersonalTokenRequestObject struct {
-	Body *CreatePersonalTokenJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CreatePersonalTokenResponseObject interface {
-	VisitCreatePersonalTokenResponse(w http.ResponseWriter) error
+	siw.Handler.ScimListUsers(c, params)
 }
 
-type CreatePersonalToken201JSONResponse PersonalTokenCreate
+// ScimCreateUser operation middleware
+func (siw *ServerInterfaceWrapper) ScimCreateUser(c *gin.Context) {
 
-func (response CreatePersonalToken201JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ScimCreateUser(c)
 }
 
-type CreatePersonalToken400JSONResponse struct{ N400JSONResponse }
+// ScimDeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) ScimDeleteUser(c *gin.Context) {
 
-func (response CreatePersonalToken400JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type CreatePersonalToken401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response CreatePersonalToken401JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ScimDeleteUser(c, id)
 }
 
-type CreatePersonalToken403JSONResponse struct{ N403JSONResponse }
+// ScimReadUser operation middleware
+func (siw *ServerInterfaceWrapper) ScimReadUser(c *gin.Context) {
 
-func (response CreatePersonalToken403JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type CreatePersonalToken409JSONResponse struct{ N409JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response CreatePersonalToken409JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ScimReadUser(c, id)
 }
 
-type CreatePersonalToken500JSONResponse struct{ N500JSONResponse }
+// ScimPatchUser operation middleware
+func (siw *ServerInterfaceWrapper) ScimPatchUser(c *gin.Context) {
 
-func (response CreatePersonalToken500JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type PingRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PingResponseObject interface {
-	VisitPingResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type Ping204Response struct {
+	siw.Handler.ScimPatchUser(c, id)
 }
 
-func (response Ping204Response) VisitPingResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// ScimReplaceUser operation middleware
+func (siw *ServerInterfaceWrapper) ScimReplaceUser(c *gin.Context) {
 
-type Ping500JSONResponse struct{ N500JSONResponse }
+	var err error
 
-func (response Ping500JSONResponse) VisitPingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type HintPermissionsRequestObject struct {
-	Params HintPermissionsParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type HintPermissionsResponseObject interface {
-	VisitHintPermissionsResponse(w http.ResponseWriter) error
+	siw.Handler.ScimReplaceUser(c, id)
 }
 
-type HintPermissions200JSONResponse []PermissionList
+// DeleteServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceAccount(c *gin.Context) {
 
-func (response HintPermissions200JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type HintPermissions400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response HintPermissions400JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DeleteServiceAccount(c, id)
 }
 
-type HintPermissions401JSONResponse struct{ N401JSONResponse }
-
-func (response HintPermissions401JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ReadServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) ReadServiceAccount(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type HintPermissions403JSONResponse struct{ N403JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-func (response HintPermissions403JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type HintPermissions500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response HintPermissions500JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ReadServiceAccount(c, id)
 }
 
-type HintRolesRequestObject struct {
-	Params HintRolesParams
-}
+// ListServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceAccount(c *gin.Context) {
 
-type HintRolesResponseObject interface {
-	VisitHintRolesResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type HintRoles200JSONResponse []RoleList
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServiceAccountParams
 
-func (response HintRoles200JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type HintRoles400JSONResponse struct{ N400JSONResponse }
+	// ------------- Optional query parameter "per_page" -------------
 
-func (response HintRoles400JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListServiceAccount(c, params)
 }
 
-type HintRoles401JSONResponse struct{ N401JSONResponse }
+// CreateServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceAccount(c *gin.Context) {
 
-func (response HintRoles401JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreateServiceAccount(c)
 }
 
-type HintRoles403JSONResponse struct{ N403JSONResponse }
+// DeleteSodConstraint operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSodConstraint(c *gin.Context) {
 
-func (response HintRoles403JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type HintRoles500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response HintRoles500JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DeleteSodConstraint(c, id)
 }
 
-type HintUsersRequestObject struct {
-	Params HintUsersParams
-}
+// ReadSodConstraint operation middleware
+func (siw *ServerInterfaceWrapper) ReadSodConstraint(c *gin.Context) {
 
-type HintUsersResponseObject interface {
-	VisitHintUsersResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type HintUsers200JSONResponse []UserList
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-func (response HintUsers200JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReadSodConstraint(c, id)
 }
 
-type HintUsers400JSONResponse struct{ N400JSONResponse }
+// UpdateSodConstraint operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSodConstraint(c *gin.Context) {
 
-func (response HintUsers400JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type HintUsers401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response HintUsers401JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UpdateSodConstraint(c, id)
 }
 
-type HintUsers403JSONResponse struct{ N403JSONResponse }
+// ListSodConstraintRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListSodConstraintRoles(c *gin.Context) {
 
-func (response HintUsers403JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type HintUsers500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response HintUsers500JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSodConstraintRolesParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "page" -------------
 
-type DeleteRoleRequestObject struct {
-	Id uint32 `json:"id"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DeleteRoleResponseObject interface {
-	VisitDeleteRoleResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "per_page" -------------
 
-type DeleteRole204Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeleteRole204Response) VisitDeleteRoleResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Optional query parameter "name" -------------
 
-type DeleteRole400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeleteRole400JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListSodConstraintRoles(c, id, params)
 }
 
-type DeleteRole401JSONResponse struct{ N401JSONResponse }
+// ListSodViolations operation middleware
+func (siw *ServerInterfaceWrapper) ListSodViolations(c *gin.Context) {
 
-func (response DeleteRole401JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type DeleteRole403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeleteRole403JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListSodViolations(c, id)
 }
 
-type DeleteRole404JSONResponse struct{ N404JSONResponse }
+// ListSodConstraint operation middleware
+func (siw *ServerInterfaceWrapper) ListSodConstraint(c *gin.Context) {
 
-func (response DeleteRole404JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSodConstraintParams
 
-type DeleteRole409JSONResponse struct{ N409JSONResponse }
+	// ------------- Optional query parameter "page" -------------
 
-func (response DeleteRole409JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "per_page" -------------
 
-type DeleteRole500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeleteRole500JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "name" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ReadRoleRequestObject struct {
-	Id uint32 `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ReadRoleResponseObject interface {
-	VisitReadRoleResponse(w http.ResponseWriter) error
+	siw.Handler.ListSodConstraint(c, params)
 }
 
-type ReadRole200JSONResponse RoleRead
+// CreateSodConstraint operation middleware
+func (siw *ServerInterfaceWrapper) CreateSodConstraint(c *gin.Context) {
 
-func (response ReadRole200JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreateSodConstraint(c)
 }
 
-type ReadRole400JSONResponse struct{ N400JSONResponse }
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
 
-func (response ReadRole400JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type ReadRole401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ReadRole401JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUserParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "trashed" -------------
 
-type ReadRole403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ReadRole403JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DeleteUser(c, id, params)
 }
 
-type ReadRole404JSONResponse struct{ N404JSONResponse }
+// ReadUser operation middleware
+func (siw *ServerInterfaceWrapper) ReadUser(c *gin.Context) {
 
-func (response ReadRole404JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type ReadRole409JSONResponse struct{ N409JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ReadRole409JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReadUserParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "trashed" -------------
 
-type ReadRole500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "trashed", c.Request.URL.Query(), &params.Trashed)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter trashed: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ReadRole500JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ReadUser(c, id, params)
 }
 
-type UpdateRoleRequestObject struct {
-	Id   uint32 `json:"id"`
-	Body *UpdateRoleJSONRequestBody
-}
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
 
-type UpdateRoleResponseObject interface {
-	VisitUpdateRoleResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type UpdateRole200JSONResponse RoleUpdate
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-func (response UpdateRole200JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateUser(c, id)
 }
 
-type UpdateRole400JSONResponse struct{ N400JSONResponse }
+// DisableUser operation middleware
+func (siw *ServerInterfaceWrapper) DisableUser(c *gin.Context) {
 
-func (response UpdateRole400JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type UpdateRole401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdateRole401JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DisableUser(c, id)
 }
 
-type UpdateRole403JSONResponse struct{ N403JSONResponse }
+// EnableUser operation middleware
+func (siw *ServerInterfaceWrapper) EnableUser(c *gin.Context) {
 
-func (response UpdateRole403JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type UpdateRole404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdateRole404JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.EnableUser(c, id)
 }
 
-type UpdateRole409JSONResponse struct{ N409JSONResponse }
+// Impersonate operation middleware
+func (siw *ServerInterfaceWrapper) Impersonate(c *gin.Context) {
 
-func (response UpdateRole409JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type UpdateRole422JSONResponse struct{ N422JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdateRole422JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.Impersonate(c, id)
 }
 
-type UpdateRole500JSONResponse struct{ N500JSONResponse }
+// SetUserPassword operation middleware
+func (siw *ServerInterfaceWrapper) SetUserPassword(c *gin.Context) {
 
-func (response UpdateRole500JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type ListRolePermissionsRequestObject struct {
-	Id     uint32 `json:"id"`
-	Params ListRolePermissionsParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ListRolePermissionsResponseObject interface {
-	VisitListRolePermissionsResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetUserPassword(c, id)
 }
 
-type ListRolePermissions200JSONResponse struct {
-	// CurrentPage Page number (1-based)
-	CurrentPage int `json:"current_page"`
+// RestoreUser operation middleware
+func (siw *ServerInterfaceWrapper) RestoreUser(c *gin.Context) {
 
-	// Data List of items
-	Data []RolePermissionsList `json:"data"`
+	var err error
 
-	// FirstPageUrl URL to the first page
-	FirstPageUrl string `json:"first_page_url"`
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-	// From Index (1-based) of the first item in the current page
-	From int `json:"from"`
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// LastPage Last page number
-	LastPage int `json:"last_page"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// LastPageUrl URL to the last page
-	LastPageUrl string `json:"last_page_url"`
+	siw.Handler.RestoreUser(c, id)
+}
 
-	// NextPageUrl URL to the next page
-	NextPageUrl string `json:"next_page_url"`
+// RevokeUserTokens operation middleware
+func (siw *ServerInterfaceWrapper) RevokeUserTokens(c *gin.Context) {
 
-	// Path Base path of the request
-	Path string `json:"path"`
+	var err error
 
-	// PerPage Number of items per page
-	PerPage int `json:"per_page"`
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-	// PrevPageUrl URL to the previous page
-	PrevPageUrl string `json:"prev_page_url"`
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// To Index (1-based) of the last item in the current page
-	To int `json:"to"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// Total Total number of items
-	Total int `json:"total"`
+	siw.Handler.RevokeUserTokens(c, id)
 }
 
-func (response ListRolePermissions200JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// ListUserRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListUserRoles(c *gin.Context) {
 
-type ListRolePermissions400JSONResponse struct{ N400JSONResponse }
+	var err error
 
-func (response ListRolePermissions400JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ListRolePermissions401JSONResponse struct{ N401JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserRolesParams
 
-func (response ListRolePermissions401JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Optional query parameter "page" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ListRolePermissions403JSONResponse struct{ N403JSONResponse }
+	// ------------- Optional query parameter "per_page" -------------
 
-func (response ListRolePermissions403JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "name" -------------
 
-type ListRolePermissions404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListRolePermissions404JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListUserRoles(c, id, params)
 }
 
-type ListRolePermissions409JSONResponse struct{ N409JSONResponse }
+// AssignRoles operation middleware
+func (siw *ServerInterfaceWrapper) AssignRoles(c *gin.Context) {
 
-func (response ListRolePermissions409JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type ListRolePermissions500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListRolePermissions500JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AssignRolesParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "starts_at" -------------
 
-type AssignPermissionsRequestObject struct {
-	Id   uint32 `json:"id"`
-	Body *AssignPermissionsJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "starts_at", c.Request.URL.Query(), &params.StartsAt)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter starts_at: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AssignPermissionsResponseObject interface {
-	VisitAssignPermissionsResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "expires_at" -------------
 
-type AssignPermissions204Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "expires_at", c.Request.URL.Query(), &params.ExpiresAt)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter expires_at: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response AssignPermissions204Response) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AssignRoles(c, id, params)
 }
 
-type AssignPermissions400JSONResponse struct{ N400JSONResponse }
+// RevokeUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) RevokeUserSessions(c *gin.Context) {
 
-func (response AssignPermissions400JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type AssignPermissions401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response AssignPermissions401JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.RevokeUserSessions(c, id)
 }
 
-type AssignPermissions403JSONResponse struct{ N403JSONResponse }
+// UnlockUser operation middleware
+func (siw *ServerInterfaceWrapper) UnlockUser(c *gin.Context) {
 
-func (response AssignPermissions403JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint64
 
-type AssignPermissions404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response AssignPermissions404JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UnlockUser(c, id)
 }
 
-type AssignPermissions500JSONResponse struct{ N500JSONResponse }
+// OauthUserinfo operation middleware
+func (siw *ServerInterfaceWrapper) OauthUserinfo(c *gin.Context) {
 
-func (response AssignPermissions500JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.OauthUserinfo(c)
 }
 
-type ListRoleUsersRequestObject struct {
-	Id     uint32 `json:"id"`
-	Params ListRoleUsersParams
-}
+// ListUser operation middleware
+func (siw *ServerInterfaceWrapper) ListUser(c *gin.Context) {
 
-type ListRoleUsersResponseObject interface {
-	VisitListRoleUsersResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type ListRoleUsers200JSONResponse struct {
-	// CurrentPage Page number (1-based)
-	CurrentPage int `json:"current_page"`
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserParams
 
-	// Data List of items
-	Data []RoleUsersList `json:"data"`
+	// ------------- Optional query parameter "page" -------------
 
-	// FirstPageUrl URL to the first page
-	FirstPageUrl string `json:"first_page_url"`
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// From Index (1-based) of the first item in the current page
-	From int `json:"from"`
-
-	// LastPage Last page number
-	LastPage int `json:"last_page"`
-
-	// LastPageUrl URL to the last page
-	LastPageUrl string `json:"last_page_url"`
-
-	// NextPageUrl URL to the next page
-	NextPageUrl string `json:"next_page_url"`
-
-	// Path Base path of the request
-	Path string `json:"path"`
-
-	// PerPage Number of items per page
-	PerPage int `json:"per_page"`
+	// ------------- Optional query parameter "per_page" -------------
 
-	// PrevPageUrl URL to the previous page
-	PrevPageUrl string `json:"prev_page_url"`
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// To Index (1-based) of the last item in the current page
-	To int `json:"to"`
+	// ------------- Optional query parameter "name" -------------
 
-	// Total Total number of items
-	Total int `json:"total"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListRoleUsers200JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListUser(c, params)
 }
 
-type ListRoleUsers400JSONResponse struct{ N400JSONResponse }
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(c *gin.Context) {
 
-func (response ListRoleUsers400JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreateUser(c)
 }
 
-type ListRoleUsers401JSONResponse struct{ N401JSONResponse }
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(c *gin.Context) {
 
-func (response ListRoleUsers401JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type ListRoleUsers403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListRoleUsers403JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DeleteWebhook(c, id)
 }
 
-type ListRoleUsers404JSONResponse struct{ N404JSONResponse }
+// ReadWebhook operation middleware
+func (siw *ServerInterfaceWrapper) ReadWebhook(c *gin.Context) {
 
-func (response ListRoleUsers404JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type ListRoleUsers409JSONResponse struct{ N409JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListRoleUsers409JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ReadWebhook(c, id)
 }
 
-type ListRoleUsers500JSONResponse struct{ N500JSONResponse }
-
-func (response ListRoleUsers500JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(c *gin.Context) {
 
-type ListRoleRequestObject struct {
-	Params ListRoleParams
-}
+	var err error
 
-type ListRoleResponseObject interface {
-	VisitListRoleResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-type ListRole200JSONResponse struct {
-	// CurrentPage Page number (1-based)
-	CurrentPage int `json:"current_page"`
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// Data List of items
-	Data []RoleList `json:"data"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// FirstPageUrl URL to the first page
-	FirstPageUrl string `json:"first_page_url"`
+	siw.Handler.UpdateWebhook(c, id)
+}
 
-	// From Index (1-based) of the first item in the current page
-	From int `json:"from"`
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(c *gin.Context) {
 
-	// LastPage Last page number
-	LastPage int `json:"last_page"`
+	var err error
 
-	// LastPageUrl URL to the last page
-	LastPageUrl string `json:"last_page_url"`
+	// ------------- Path parameter "id" -------------
+	var id uint32
 
-	// NextPageUrl URL to the next page
-	NextPageUrl string `json:"next_page_url"`
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// Path Base path of the request
-	Path string `json:"path"`
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
 
-	// PerPage Number of items per page
-	PerPage int `json:"per_page"`
+	// ------------- Optional query parameter "page" -------------
 
-	// PrevPageUrl URL to the previous page
-	PrevPageUrl string `json:"prev_page_url"`
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// To Index (1-based) of the last item in the current page
-	To int `json:"to"`
+	// ------------- Optional query parameter "per_page" -------------
 
-	// Total Total number of items
-	Total int `json:"total"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListRole200JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListWebhookDeliveries(c, id, params)
 }
 
-type ListRole400JSONResponse struct{ N400JSONResponse }
+// ListWebhook operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhook(c *gin.Context) {
 
-func (response ListRole400JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookParams
 
-type ListRole401JSONResponse struct{ N401JSONResponse }
+	// ------------- Optional query parameter "page" -------------
 
-func (response ListRole401JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "per_page" -------------
 
-type ListRole403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListRole403JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListWebhook(c, params)
 }
 
-type ListRole404JSONResponse struct{ N404JSONResponse }
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(c *gin.Context) {
 
-func (response ListRole404JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreateWebhook(c)
 }
 
-type ListRole409JSONResponse struct{ N409JSONResponse }
-
-func (response ListRole409JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
 }
 
-type ListRole500JSONResponse struct{ N500JSONResponse }
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
 
-func (response ListRole500JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/.well-known/openid-configuration", wrapper.GetOpenidConfiguration)
+	router.GET(options.BaseURL+"/access-request/:id", wrapper.ReadAccessRequest)
+	router.POST(options.BaseURL+"/access-request/:id/approve", wrapper.ApproveAccessRequest)
+	router.POST(options.BaseURL+"/access-request/:id/deny", wrapper.DenyAccessRequest)
+	router.GET(options.BaseURL+"/access-requests", wrapper.ListAccessRequest)
+	router.POST(options.BaseURL+"/access-requests", wrapper.CreateAccessRequest)
+	router.DELETE(options.BaseURL+"/access-token", wrapper.RevokeAccessToken)
+	router.GET(options.BaseURL+"/access-token", wrapper.CheckAccessToken)
+	router.POST(options.BaseURL+"/access-token/refresh", wrapper.RefreshAccessToken)
+	router.GET(options.BaseURL+"/audit-logs", wrapper.ListAuditLog)
+	router.DELETE(options.BaseURL+"/group/:id", wrapper.DeleteGroup)
+	router.GET(options.BaseURL+"/group/:id", wrapper.ReadGroup)
+	router.PATCH(options.BaseURL+"/group/:id", wrapper.UpdateGroup)
+	router.GET(options.BaseURL+"/group/:id/roles", wrapper.ListGroupRoles)
+	router.POST(options.BaseURL+"/group/:id/roles", wrapper.AssignGroupRoles)
+	router.DELETE(options.BaseURL+"/group/:id/user/:user_id", wrapper.RemoveGroupMember)
+	router.GET(options.BaseURL+"/group/:id/users", wrapper.ListGroupUsers)
+	router.POST(options.BaseURL+"/group/:id/users", wrapper.AddGroupMembers)
+	router.GET(options.BaseURL+"/groups", wrapper.ListGroup)
+	router.POST(options.BaseURL+"/groups", wrapper.CreateGroup)
+	router.POST(options.BaseURL+"/login", wrapper.Login)
+	router.POST(options.BaseURL+"/login/oidc/:provider", wrapper.BeginOidcLogin)
+	router.POST(options.BaseURL+"/login/oidc/:provider/finish", wrapper.FinishOidcLogin)
+	router.POST(options.BaseURL+"/login/totp", wrapper.VerifyTotp)
+	router.POST(options.BaseURL+"/login/webauthn", wrapper.BeginWebauthnLogin)
+	router.POST(options.BaseURL+"/login/webauthn/finish", wrapper.FinishWebauthnLogin)
+	router.POST(options.BaseURL+"/logout", wrapper.Logout)
+	router.POST(options.BaseURL+"/me/password", wrapper.ChangePassword)
+	router.GET(options.BaseURL+"/me/sessions", wrapper.ListMySessions)
+	router.DELETE(options.BaseURL+"/me/sessions/:id", wrapper.DeleteMySession)
+	router.POST(options.BaseURL+"/me/totp", wrapper.EnrollTotp)
+	router.POST(options.BaseURL+"/me/totp/confirm", wrapper.ConfirmTotp)
+	router.POST(options.BaseURL+"/me/totp/disable", wrapper.DisableTotp)
+	router.POST(options.BaseURL+"/me/webauthn/register", wrapper.BeginWebauthnRegistration)
+	router.POST(options.BaseURL+"/me/webauthn/register/finish", wrapper.FinishWebauthnRegistration)
+	router.DELETE(options.BaseURL+"/oauth-client/:id", wrapper.DeleteOauthClient)
+	router.GET(options.BaseURL+"/oauth-client/:id", wrapper.ReadOauthClient)
+	router.GET(options.BaseURL+"/oauth-clients", wrapper.ListOauthClient)
+	router.POST(options.BaseURL+"/oauth-clients", wrapper.CreateOauthClient)
+	router.GET(options.BaseURL+"/oauth/authorize", wrapper.OauthAuthorize)
+	router.GET(options.BaseURL+"/oauth/jwks", wrapper.OauthJwks)
+	router.POST(options.BaseURL+"/oauth/token", wrapper.OauthToken)
+	router.DELETE(options.BaseURL+"/organization/:id", wrapper.DeleteOrganization)
+	router.GET(options.BaseURL+"/organization/:id", wrapper.ReadOrganization)
+	router.PATCH(options.BaseURL+"/organization/:id", wrapper.UpdateOrganization)
+	router.DELETE(options.BaseURL+"/organization/:id/scim-token", wrapper.RevokeScimToken)
+	router.POST(options.BaseURL+"/organization/:id/scim-token", wrapper.IssueScimToken)
+	router.GET(options.BaseURL+"/organizations", wrapper.ListOrganization)
+	router.POST(options.BaseURL+"/organizations", wrapper.CreateOrganization)
+	router.POST(options.BaseURL+"/password/forgot", wrapper.ForgotPassword)
+	router.POST(options.BaseURL+"/password/reset", wrapper.ResetPassword)
+	router.DELETE(options.BaseURL+"/permission/:id", wrapper.DeletePermission)
+	router.GET(options.BaseURL+"/permission/:id", wrapper.ReadPermission)
+	router.PATCH(options.BaseURL+"/permission/:id", wrapper.UpdatePermission)
+	router.GET(options.BaseURL+"/permissions", wrapper.ListPermission)
+	router.POST(options.BaseURL+"/permissions", wrapper.CreatePermission)
+	router.DELETE(options.BaseURL+"/personal-token/:id", wrapper.DeletePersonalToken)
+	router.GET(options.BaseURL+"/personal-token/:id", wrapper.ReadPersonalToken)
+	router.GET(options.BaseURL+"/personal-tokens", wrapper.ListPersonalToken)
+	router.POST(options.BaseURL+"/personal-tokens", wrapper.CreatePersonalToken)
+	router.GET(options.BaseURL+"/ping", wrapper.Ping)
+	router.GET(options.BaseURL+"/q/permissions", wrapper.HintPermissions)
+	router.GET(options.BaseURL+"/q/roles", wrapper.HintRoles)
+	router.GET(options.BaseURL+"/q/users", wrapper.HintUsers)
+	router.DELETE(options.BaseURL+"/role/:id", wrapper.DeleteRole)
+	router.GET(options.BaseURL+"/role/:id", wrapper.ReadRole)
+	router.PATCH(options.BaseURL+"/role/:id", wrapper.UpdateRole)
+	router.GET(options.BaseURL+"/role/:id/approvers", wrapper.ListRoleApprovers)
+	router.GET(options.BaseURL+"/role/:id/permissions", wrapper.ListRolePermissions)
+	router.POST(options.BaseURL+"/role/:id/permissions", wrapper.AssignPermissions)
+	router.GET(options.BaseURL+"/role/:id/users", wrapper.ListRoleUsers)
+	router.GET(options.BaseURL+"/roles", wrapper.ListRole)
+	router.POST(options.BaseURL+"/roles", wrapper.CreateRole)
+	router.GET(options.BaseURL+"/scim/v2/Groups", wrapper.ScimListGroups)
+	router.POST(options.BaseURL+"/scim/v2/Groups", wrapper.ScimCreateGroup)
+	router.DELETE(options.BaseURL+"/scim/v2/Groups/:id", wrapper.ScimDeleteGroup)
+	router.GET(options.BaseURL+"/scim/v2/Groups/:id", wrapper.ScimReadGroup)
+	router.PATCH(options.BaseURL+"/scim/v2/Groups/:id", wrapper.ScimPatchGroup)
+	router.PUT(options.BaseURL+"/scim/v2/Groups/:id", wrapper.ScimReplaceGroup)
+	router.GET(options.BaseURL+"/scim/v2/ServiceProviderConfig", wrapper.ScimServiceProviderConfig)
+	router.GET(options.BaseURL+"/scim/v2/Users", wrapper.ScimListUsers)
+	router.POST(options.BaseURL+"/scim/v2/Users", wrapper.ScimCreateUser)
+	router.DELETE(options.BaseURL+"/scim/v2/Users/:id", wrapper.ScimDeleteUser)
+	router.GET(options.BaseURL+"/scim/v2/Users/:id", wrapper.ScimReadUser)
+	router.PATCH(options.BaseURL+"/scim/v2/Users/:id", wrapper.ScimPatchUser)
+	router.PUT(options.BaseURL+"/scim/v2/Users/:id", wrapper.ScimReplaceUser)
+	router.DELETE(options.BaseURL+"/service-account/:id", wrapper.DeleteServiceAccount)
+	router.GET(options.BaseURL+"/service-account/:id", wrapper.ReadServiceAccount)
+	router.GET(options.BaseURL+"/service-accounts", wrapper.ListServiceAccount)
+	router.POST(options.BaseURL+"/service-accounts", wrapper.CreateServiceAccount)
+	router.DELETE(options.BaseURL+"/sod-constraint/:id", wrapper.DeleteSodConstraint)
+	router.GET(options.BaseURL+"/sod-constraint/:id", wrapper.ReadSodConstraint)
+	router.PATCH(options.BaseURL+"/sod-constraint/:id", wrapper.UpdateSodConstraint)
+	router.GET(options.BaseURL+"/sod-constraint/:id/roles", wrapper.ListSodConstraintRoles)
+	router.GET(options.BaseURL+"/sod-constraint/:id/violations", wrapper.ListSodViolations)
+	router.GET(options.BaseURL+"/sod-constraints", wrapper.ListSodConstraint)
+	router.POST(options.BaseURL+"/sod-constraints", wrapper.CreateSodConstraint)
+	router.DELETE(options.BaseURL+"/user/:id", wrapper.DeleteUser)
+	router.GET(options.BaseURL+"/user/:id", wrapper.ReadUser)
+	router.PATCH(options.BaseURL+"/user/:id", wrapper.UpdateUser)
+	router.POST(options.BaseURL+"/user/:id/disable", wrapper.DisableUser)
+	router.POST(options.BaseURL+"/user/:id/enable", wrapper.EnableUser)
+	router.POST(options.BaseURL+"/user/:id/impersonate", wrapper.Impersonate)
+	router.POST(options.BaseURL+"/user/:id/password", wrapper.SetUserPassword)
+	router.POST(options.BaseURL+"/user/:id/restore", wrapper.RestoreUser)
+	router.POST(options.BaseURL+"/user/:id/revoke-tokens", wrapper.RevokeUserTokens)
+	router.GET(options.BaseURL+"/user/:id/roles", wrapper.ListUserRoles)
+	router.POST(options.BaseURL+"/user/:id/roles", wrapper.AssignRoles)
+	router.POST(options.BaseURL+"/user/:id/sessions/revoke-all", wrapper.RevokeUserSessions)
+	router.POST(options.BaseURL+"/user/:id/unlock", wrapper.UnlockUser)
+	router.GET(options.BaseURL+"/userinfo", wrapper.OauthUserinfo)
+	router.GET(options.BaseURL+"/users", wrapper.ListUser)
+	router.POST(options.BaseURL+"/users", wrapper.CreateUser)
+	router.DELETE(options.BaseURL+"/webhook/:id", wrapper.DeleteWebhook)
+	router.GET(options.BaseURL+"/webhook/:id", wrapper.ReadWebhook)
+	router.PATCH(options.BaseURL+"/webhook/:id", wrapper.UpdateWebhook)
+	router.GET(options.BaseURL+"/webhook/:id/deliveries", wrapper.ListWebhookDeliveries)
+	router.GET(options.BaseURL+"/webhooks", wrapper.ListWebhook)
+	router.POST(options.BaseURL+"/webhooks", wrapper.CreateWebhook)
 }
 
-type CreateRoleRequestObject struct {
-	Body *CreateRoleJSONRequestBody
+type N400JSONResponse struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
 }
 
-type CreateRoleResponseObject interface {
-	VisitCreateRoleResponse(w http.ResponseWriter) error
+type N401JSONResponse struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
 }
 
-type CreateRole201JSONResponse RoleCreate
+type N403JSONResponse struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
 
-func (response CreateRole201JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+type N404JSONResponse struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+type N409JSONResponse struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
 }
 
-type CreateRole400JSONResponse struct{ N400JSONResponse }
+type N422JSONResponse struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
 
-func (response CreateRole400JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type N429ResponseHeaders struct {
+	RetryAfter int
+}
+type N429JSONResponse struct {
+	Body struct {
+		Code   int          `json:"code"`
+		Errors *interface{} `json:"errors,omitempty"`
+		Status string       `json:"status"`
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	Headers N429ResponseHeaders
 }
 
-type CreateRole401JSONResponse struct{ N401JSONResponse }
+type N500JSONResponse struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
 
-func (response CreateRole401JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+type GetOpenidConfigurationRequestObject struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+type GetOpenidConfigurationResponseObject interface {
+	VisitGetOpenidConfigurationResponse(w http.ResponseWriter) error
 }
 
-type CreateRole403JSONResponse struct{ N403JSONResponse }
+type GetOpenidConfiguration200JSONResponse struct {
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	IdTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	Issuer                            string   `json:"issuer"`
+	JwksUri                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+}
 
-func (response CreateRole403JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+func (response GetOpenidConfiguration200JSONResponse) VisitGetOpenidConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRole409JSONResponse struct{ N409JSONResponse }
+type GetOpenidConfiguration401JSONResponse struct{ N401JSONResponse }
 
-func (response CreateRole409JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+func (response GetOpenidConfiguration401JSONResponse) VisitGetOpenidConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRole500JSONResponse struct{ N500JSONResponse }
+type GetOpenidConfiguration403JSONResponse struct{ N403JSONResponse }
 
-func (response CreateRole500JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+func (response GetOpenidConfiguration403JSONResponse) VisitGetOpenidConfigurationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUserRequestObject struct {
-	Id     uint64 `json:"id"`
-	Params DeleteUserParams
+type ReadAccessRequestRequestObject struct {
+	Id uint64 `json:"id"`
 }
 
-type DeleteUserResponseObject interface {
-	VisitDeleteUserResponse(w http.ResponseWriter) error
+type ReadAccessRequestResponseObject interface {
+	VisitReadAccessRequestResponse(w http.ResponseWriter) error
 }
 
-type DeleteUser204Response struct {
-}
+type ReadAccessRequest200JSONResponse AccessRequestRead
 
-func (response DeleteUser204Response) VisitDeleteUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response ReadAccessRequest200JSONResponse) VisitReadAccessRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUser400JSONResponse struct{ N400JSONResponse }
+type ReadAccessRequest400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteUser400JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+func (response ReadAccessRequest400JSONResponse) VisitReadAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUser401JSONResponse struct{ N401JSONResponse }
+type ReadAccessRequest401JSONResponse struct{ N401JSONResponse }
 
-func (response DeleteUser401JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+func (response ReadAccessRequest401JSONResponse) VisitReadAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUser403JSONResponse struct{ N403JSONResponse }
+type ReadAccessRequest403JSONResponse struct{ N403JSONResponse }
 
-func (response DeleteUser403JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+func (response ReadAccessRequest403JSONResponse) VisitReadAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUser404JSONResponse struct{ N404JSONResponse }
+type ReadAccessRequest404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteUser404JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+func (response ReadAccessRequest404JSONResponse) VisitReadAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUser409JSONResponse struct{ N409JSONResponse }
+type ReadAccessRequest409JSONResponse struct{ N409JSONResponse }
 
-func (response DeleteUser409JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+func (response ReadAccessRequest409JSONResponse) VisitReadAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUser500JSONResponse struct{ N500JSONResponse }
+type ReadAccessRequest500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteUser500JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+func (response ReadAccessRequest500JSONResponse) VisitReadAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadUserRequestObject struct {
-	Id     uint64 `json:"id"`
-	Params ReadUserParams
+type ApproveAccessRequestRequestObject struct {
+	Id   uint64 `json:"id"`
+	Body *ApproveAccessRequestJSONRequestBody
 }
 
-type ReadUserResponseObject interface {
-	VisitReadUserResponse(w http.ResponseWriter) error
+type ApproveAccessRequestResponseObject interface {
+	VisitApproveAccessRequestResponse(w http.ResponseWriter) error
 }
 
-type ReadUser200JSONResponse UserRead
+type ApproveAccessRequest200JSONResponse AccessRequestRead
 
-func (response ReadUser200JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+func (response ApproveAccessRequest200JSONResponse) VisitApproveAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadUser400JSONResponse struct{ N400JSONResponse }
+type ApproveAccessRequest400JSONResponse struct{ N400JSONResponse }
 
-func (response ReadUser400JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+func (response ApproveAccessRequest400JSONResponse) VisitApproveAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadUser401JSONResponse struct{ N401JSONResponse }
+type ApproveAccessRequest401JSONResponse struct{ N401JSONResponse }
 
-func (response ReadUser401JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+func (response ApproveAccessRequest401JSONResponse) VisitApproveAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadUser403JSONResponse struct{ N403JSONResponse }
+type ApproveAccessRequest403JSONResponse struct{ N403JSONResponse }
 
-func (response ReadUser403JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+func (response ApproveAccessRequest403JSONResponse) VisitApproveAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadUser404JSONResponse struct{ N404JSONResponse }
+type ApproveAccessRequest404JSONResponse struct{ N404JSONResponse }
 
-func (response ReadUser404JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+func (response ApproveAccessRequest404JSONResponse) VisitApproveAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadUser409JSONResponse struct{ N409JSONResponse }
+type ApproveAccessRequest409JSONResponse struct{ N409JSONResponse }
 
-func (response ReadUser409JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+func (response ApproveAccessRequest409JSONResponse) VisitApproveAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadUser500JSONResponse struct{ N500JSONResponse }
+type ApproveAccessRequest500JSONResponse struct{ N500JSONResponse }
 
-func (response ReadUser500JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+func (response ApproveAccessRequest500JSONResponse) VisitApproveAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUserRequestObject struct {
+type DenyAccessRequestRequestObject struct {
 	Id   uint64 `json:"id"`
-	Body *UpdateUserJSONRequestBody
+	Body *DenyAccessRequestJSONRequestBody
 }
 
-type UpdateUserResponseObject interface {
-	VisitUpdateUserResponse(w http.ResponseWriter) error
+type DenyAccessRequestResponseObject interface {
+	VisitDenyAccessRequestResponse(w http.ResponseWriter) error
 }
 
-type UpdateUser200JSONResponse UserUpdate
+type DenyAccessRequest200JSONResponse AccessRequestRead
 
-func (response UpdateUser200JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+func (response DenyAccessRequest200JSONResponse) VisitDenyAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUser400JSONResponse struct{ N400JSONResponse }
+type DenyAccessRequest400JSONResponse struct{ N400JSONResponse }
 
-func (response UpdateUser400JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+func (response DenyAccessRequest400JSONResponse) VisitDenyAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUser401JSONResponse struct{ N401JSONResponse }
+type DenyAccessRequest401JSONResponse struct{ N401JSONResponse }
 
-func (response UpdateUser401JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+func (response DenyAccessRequest401JSONResponse) VisitDenyAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUser403JSONResponse struct{ N403JSONResponse }
+type DenyAccessRequest403JSONResponse struct{ N403JSONResponse }
 
-func (response UpdateUser403JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+func (response DenyAccessRequest403JSONResponse) VisitDenyAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUser404JSONResponse struct{ N404JSONResponse }
+type DenyAccessRequest404JSONResponse struct{ N404JSONResponse }
 
-func (response UpdateUser404JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+func (response DenyAccessRequest404JSONResponse) VisitDenyAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUser409JSONResponse struct{ N409JSONResponse }
+type DenyAccessRequest409JSONResponse struct{ N409JSONResponse }
 
-func (response UpdateUser409JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+func (response DenyAccessRequest409JSONResponse) VisitDenyAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUser422JSONResponse struct{ N422JSONResponse }
-
-func (response UpdateUser422JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UpdateUser500JSONResponse struct{ N500JSONResponse }
+type DenyAccessRequest500JSONResponse struct{ N500JSONResponse }
 
-func (response UpdateUser500JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+func (response DenyAccessRequest500JSONResponse) VisitDenyAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreUserRequestObject struct {
-	Id uint64 `json:"id"`
+type ListAccessRequestRequestObject struct {
+	Params ListAccessRequestParams
 }
 
-type RestoreUserResponseObject interface {
-	VisitRestoreUserResponse(w http.ResponseWriter) error
+type ListAccessRequestResponseObject interface {
+	VisitListAccessRequestResponse(w http.ResponseWriter) error
 }
 
-type RestoreUser204Response struct {
+type ListAccessRequest200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []AccessRequestList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
 }
 
-func (response RestoreUser204Response) VisitRestoreUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response ListAccessRequest200JSONResponse) VisitListAccessRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreUser400JSONResponse struct{ N400JSONResponse }
+type ListAccessRequest400JSONResponse struct{ N400JSONResponse }
 
-func (response RestoreUser400JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+func (response ListAccessRequest400JSONResponse) VisitListAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreUser401JSONResponse struct{ N401JSONResponse }
+type ListAccessRequest401JSONResponse struct{ N401JSONResponse }
 
-func (response RestoreUser401JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+func (response ListAccessRequest401JSONResponse) VisitListAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreUser403JSONResponse struct{ N403JSONResponse }
+type ListAccessRequest403JSONResponse struct{ N403JSONResponse }
 
-func (response RestoreUser403JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+func (response ListAccessRequest403JSONResponse) VisitListAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreUser404JSONResponse struct{ N404JSONResponse }
+type ListAccessRequest404JSONResponse struct{ N404JSONResponse }
 
-func (response RestoreUser404JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+func (response ListAccessRequest404JSONResponse) VisitListAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreUser409JSONResponse struct{ N409JSONResponse }
+type ListAccessRequest409JSONResponse struct{ N409JSONResponse }
 
-func (response RestoreUser409JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+func (response ListAccessRequest409JSONResponse) VisitListAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RestoreUser500JSONResponse struct{ N500JSONResponse }
+type ListAccessRequest500JSONResponse struct{ N500JSONResponse }
 
-func (response RestoreUser500JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+func (response ListAccessRequest500JSONResponse) VisitListAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUserRolesRequestObject struct {
-	Id     uint64 `json:"id"`
-	Params ListUserRolesParams
+type CreateAccessRequestRequestObject struct {
+	Body *CreateAccessRequestJSONRequestBody
 }
 
-type ListUserRolesResponseObject interface {
-	VisitListUserRolesResponse(w http.ResponseWriter) error
+type CreateAccessRequestResponseObject interface {
+	VisitCreateAccessRequestResponse(w http.ResponseWriter) error
 }
 
-type ListUserRoles200JSONResponse struct {
-	// CurrentPage Page number (1-based)
-	CurrentPage int `json:"current_page"`
+type CreateAccessRequest201JSONResponse AccessRequestRead
 
-	// Data List of items
-	Data []UserRolesList `json:"data"`
+func (response CreateAccessRequest201JSONResponse) VisitCreateAccessRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	// FirstPageUrl URL to the first page
-	FirstPageUrl string `json:"first_page_url"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// From Index (1-based) of the first item in the current page
-	From int `json:"from"`
+type CreateAccessRequest400JSONResponse struct{ N400JSONResponse }
 
-	// LastPage Last page number
-	LastPage int `json:"last_page"`
+func (response CreateAccessRequest400JSONResponse) VisitCreateAccessRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// LastPageUrl URL to the last page
-	LastPageUrl string `json:"last_page_url"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// NextPageUrl URL to the next page
-	NextPageUrl string `json:"next_page_url"`
+type CreateAccessRequest401JSONResponse struct{ N401JSONResponse }
 
-	// Path Base path of the request
-	Path string `json:"path"`
+func (response CreateAccessRequest401JSONResponse) VisitCreateAccessRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// PerPage Number of items per page
-	PerPage int `json:"per_page"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// PrevPageUrl URL to the previous page
-	PrevPageUrl string `json:"prev_page_url"`
+type CreateAccessRequest403JSONResponse struct{ N403JSONResponse }
 
-	// To Index (1-based) of the last item in the current page
-	To int `json:"to"`
+func (response CreateAccessRequest403JSONResponse) VisitCreateAccessRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	// Total Total number of items
-	Total int `json:"total"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ListUserRoles200JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+type CreateAccessRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateAccessRequest500JSONResponse) VisitCreateAccessRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUserRoles400JSONResponse struct{ N400JSONResponse }
+type RevokeAccessTokenRequestObject struct {
+}
 
-func (response ListUserRoles400JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+type RevokeAccessTokenResponseObject interface {
+	VisitRevokeAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type RevokeAccessToken204Response struct {
+}
+
+func (response RevokeAccessToken204Response) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeAccessToken400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeAccessToken400JSONResponse) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUserRoles401JSONResponse struct{ N401JSONResponse }
+type RevokeAccessToken401JSONResponse struct{ N401JSONResponse }
 
-func (response ListUserRoles401JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+func (response RevokeAccessToken401JSONResponse) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUserRoles403JSONResponse struct{ N403JSONResponse }
+type RevokeAccessToken403JSONResponse struct{ N403JSONResponse }
 
-func (response ListUserRoles403JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+func (response RevokeAccessToken403JSONResponse) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUserRoles404JSONResponse struct{ N404JSONResponse }
+type RevokeAccessToken500JSONResponse struct{ N500JSONResponse }
 
-func (response ListUserRoles404JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+func (response RevokeAccessToken500JSONResponse) VisitRevokeAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUserRoles409JSONResponse struct{ N409JSONResponse }
+type CheckAccessTokenRequestObject struct {
+	Params CheckAccessTokenParams
+}
 
-func (response ListUserRoles409JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+type CheckAccessTokenResponseObject interface {
+	VisitCheckAccessTokenResponse(w http.ResponseWriter) error
+}
+
+type CheckAccessToken204Response struct {
+}
+
+func (response CheckAccessToken204Response) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CheckAccessToken400JSONResponse struct{ N400JSONResponse }
+
+func (response CheckAccessToken400JSONResponse) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUserRoles500JSONResponse struct{ N500JSONResponse }
+type CheckAccessToken401JSONResponse struct{ N401JSONResponse }
 
-func (response ListUserRoles500JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+func (response CheckAccessToken401JSONResponse) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckAccessToken403JSONResponse struct{ N403JSONResponse }
+
+func (response CheckAccessToken403JSONResponse) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckAccessToken500JSONResponse struct{ N500JSONResponse }
+
+func (response CheckAccessToken500JSONResponse) VisitCheckAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AssignRolesRequestObject struct {
-	Id   uint64 `json:"id"`
-	Body *AssignRolesJSONRequestBody
+type RefreshAccessTokenRequestObject struct {
 }
 
-type AssignRolesResponseObject interface {
-	VisitAssignRolesResponse(w http.ResponseWriter) error
+type RefreshAccessTokenResponseObject interface {
+	VisitRefreshAccessTokenResponse(w http.ResponseWriter) error
 }
 
-type AssignRoles204Response struct {
+type RefreshAccessToken204Response struct {
 }
 
-func (response AssignRoles204Response) VisitAssignRolesResponse(w http.ResponseWriter) error {
+func (response RefreshAccessToken204Response) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type AssignRoles400JSONResponse struct{ N400JSONResponse }
+type RefreshAccessToken400JSONResponse struct{ N400JSONResponse }
 
-func (response AssignRoles400JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+func (response RefreshAccessToken400JSONResponse) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AssignRoles401JSONResponse struct{ N401JSONResponse }
+type RefreshAccessToken401JSONResponse struct{ N401JSONResponse }
 
-func (response AssignRoles401JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+func (response RefreshAccessToken401JSONResponse) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AssignRoles403JSONResponse struct{ N403JSONResponse }
+type RefreshAccessToken403JSONResponse struct{ N403JSONResponse }
 
-func (response AssignRoles403JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+func (response RefreshAccessToken403JSONResponse) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AssignRoles404JSONResponse struct{ N404JSONResponse }
-
-func (response AssignRoles404JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type AssignRoles500JSONResponse struct{ N500JSONResponse }
+type RefreshAccessToken500JSONResponse struct{ N500JSONResponse }
 
-func (response AssignRoles500JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+func (response RefreshAccessToken500JSONResponse) VisitRefreshAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUserRequestObject struct {
-	Params ListUserParams
+type ListAuditLogRequestObject struct {
+	Params ListAuditLogParams
 }
 
-type ListUserResponseObject interface {
-	VisitListUserResponse(w http.ResponseWriter) error
+type ListAuditLogResponseObject interface {
+	VisitListAuditLogResponse(w http.ResponseWriter) error
 }
 
-type ListUser200JSONResponse struct {
+type ListAuditLog200JSONResponse struct {
 	// CurrentPage Page number (1-based)
 	CurrentPage int `json:"current_page"`
 
 	// Data List of items
-	Data []UserList `json:"data"`
+	Data []AuditLogList `json:"data"`
 
 	// FirstPageUrl URL to the first page
 	FirstPageUrl string `json:"first_page_url"`
@@ -3464,131 +4273,7289 @@ type ListUser200JSONResponse struct {
 	Total int `json:"total"`
 }
 
-func (response ListUser200JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+func (response ListAuditLog200JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUser400JSONResponse struct{ N400JSONResponse }
+type ListAuditLog400JSONResponse struct{ N400JSONResponse }
 
-func (response ListUser400JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+func (response ListAuditLog400JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUser401JSONResponse struct{ N401JSONResponse }
+type ListAuditLog401JSONResponse struct{ N401JSONResponse }
 
-func (response ListUser401JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+func (response ListAuditLog401JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUser403JSONResponse struct{ N403JSONResponse }
+type ListAuditLog403JSONResponse struct{ N403JSONResponse }
 
-func (response ListUser403JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+func (response ListAuditLog403JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUser404JSONResponse struct{ N404JSONResponse }
+type ListAuditLog404JSONResponse struct{ N404JSONResponse }
 
-func (response ListUser404JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+func (response ListAuditLog404JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUser409JSONResponse struct{ N409JSONResponse }
+type ListAuditLog409JSONResponse struct{ N409JSONResponse }
 
-func (response ListUser409JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+func (response ListAuditLog409JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUser500JSONResponse struct{ N500JSONResponse }
+type ListAuditLog500JSONResponse struct{ N500JSONResponse }
 
-func (response ListUser500JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+func (response ListAuditLog500JSONResponse) VisitListAuditLogResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUserRequestObject struct {
-	Body *CreateUserJSONRequestBody
+type DeleteGroupRequestObject struct {
+	Id uint32 `json:"id"`
 }
 
-type CreateUserResponseObject interface {
-	VisitCreateUserResponse(w http.ResponseWriter) error
+type DeleteGroupResponseObject interface {
+	VisitDeleteGroupResponse(w http.ResponseWriter) error
 }
 
-type CreateUser201JSONResponse UserCreate
+type DeleteGroup204Response struct {
+}
 
-func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response DeleteGroup204Response) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteGroup400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteGroup400JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUser400JSONResponse struct{ N400JSONResponse }
+type DeleteGroup401JSONResponse struct{ N401JSONResponse }
 
-func (response CreateUser400JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response DeleteGroup401JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteGroup403JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteGroup404JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteGroup409JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteGroup500JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadGroupRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ReadGroupResponseObject interface {
+	VisitReadGroupResponse(w http.ResponseWriter) error
+}
+
+type ReadGroup200JSONResponse GroupRead
+
+func (response ReadGroup200JSONResponse) VisitReadGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadGroup400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadGroup400JSONResponse) VisitReadGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUser401JSONResponse struct{ N401JSONResponse }
+type ReadGroup401JSONResponse struct{ N401JSONResponse }
 
-func (response CreateUser401JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response ReadGroup401JSONResponse) VisitReadGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUser403JSONResponse struct{ N403JSONResponse }
+type ReadGroup403JSONResponse struct{ N403JSONResponse }
 
-func (response CreateUser403JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response ReadGroup403JSONResponse) VisitReadGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUser409JSONResponse struct{ N409JSONResponse }
+type ReadGroup404JSONResponse struct{ N404JSONResponse }
 
-func (response CreateUser409JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response ReadGroup404JSONResponse) VisitReadGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUser500JSONResponse struct{ N500JSONResponse }
+type ReadGroup409JSONResponse struct{ N409JSONResponse }
 
-func (response CreateUser500JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response ReadGroup409JSONResponse) VisitReadGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadGroup500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadGroup500JSONResponse) VisitReadGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroupRequestObject struct {
+	Id   uint32 `json:"id"`
+	Body *UpdateGroupJSONRequestBody
+}
+
+type UpdateGroupResponseObject interface {
+	VisitUpdateGroupResponse(w http.ResponseWriter) error
+}
+
+type UpdateGroup200JSONResponse GroupUpdate
+
+func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateGroup400JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateGroup401JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateGroup403JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateGroup404JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateGroup409JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup422JSONResponse struct{ N422JSONResponse }
+
+func (response UpdateGroup422JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateGroup500JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRolesRequestObject struct {
+	Id     uint32 `json:"id"`
+	Params ListGroupRolesParams
+}
+
+type ListGroupRolesResponseObject interface {
+	VisitListGroupRolesResponse(w http.ResponseWriter) error
+}
+
+type ListGroupRoles200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []GroupRolesList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListGroupRoles200JSONResponse) VisitListGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRoles400JSONResponse struct{ N400JSONResponse }
+
+func (response ListGroupRoles400JSONResponse) VisitListGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRoles401JSONResponse struct{ N401JSONResponse }
+
+func (response ListGroupRoles401JSONResponse) VisitListGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRoles403JSONResponse struct{ N403JSONResponse }
+
+func (response ListGroupRoles403JSONResponse) VisitListGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRoles404JSONResponse struct{ N404JSONResponse }
+
+func (response ListGroupRoles404JSONResponse) VisitListGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRoles409JSONResponse struct{ N409JSONResponse }
+
+func (response ListGroupRoles409JSONResponse) VisitListGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRoles500JSONResponse struct{ N500JSONResponse }
+
+func (response ListGroupRoles500JSONResponse) VisitListGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignGroupRolesRequestObject struct {
+	Id   uint32 `json:"id"`
+	Body *AssignGroupRolesJSONRequestBody
+}
+
+type AssignGroupRolesResponseObject interface {
+	VisitAssignGroupRolesResponse(w http.ResponseWriter) error
+}
+
+type AssignGroupRoles204Response struct {
+}
+
+func (response AssignGroupRoles204Response) VisitAssignGroupRolesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AssignGroupRoles400JSONResponse struct{ N400JSONResponse }
+
+func (response AssignGroupRoles400JSONResponse) VisitAssignGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignGroupRoles401JSONResponse struct{ N401JSONResponse }
+
+func (response AssignGroupRoles401JSONResponse) VisitAssignGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignGroupRoles403JSONResponse struct{ N403JSONResponse }
+
+func (response AssignGroupRoles403JSONResponse) VisitAssignGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignGroupRoles404JSONResponse struct{ N404JSONResponse }
+
+func (response AssignGroupRoles404JSONResponse) VisitAssignGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignGroupRoles409JSONResponse struct{ N409JSONResponse }
+
+func (response AssignGroupRoles409JSONResponse) VisitAssignGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignGroupRoles500JSONResponse struct{ N500JSONResponse }
+
+func (response AssignGroupRoles500JSONResponse) VisitAssignGroupRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMemberRequestObject struct {
+	Id     uint32 `json:"id"`
+	UserId uint64 `json:"user_id"`
+}
+
+type RemoveGroupMemberResponseObject interface {
+	VisitRemoveGroupMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveGroupMember204Response struct {
+}
+
+func (response RemoveGroupMember204Response) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveGroupMember401JSONResponse struct{ N401JSONResponse }
+
+func (response RemoveGroupMember401JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMember403JSONResponse struct{ N403JSONResponse }
+
+func (response RemoveGroupMember403JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMember404JSONResponse struct{ N404JSONResponse }
+
+func (response RemoveGroupMember404JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveGroupMember500JSONResponse struct{ N500JSONResponse }
+
+func (response RemoveGroupMember500JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupUsersRequestObject struct {
+	Id     uint32 `json:"id"`
+	Params ListGroupUsersParams
+}
+
+type ListGroupUsersResponseObject interface {
+	VisitListGroupUsersResponse(w http.ResponseWriter) error
+}
+
+type ListGroupUsers200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []GroupUsersList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListGroupUsers200JSONResponse) VisitListGroupUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupUsers400JSONResponse struct{ N400JSONResponse }
+
+func (response ListGroupUsers400JSONResponse) VisitListGroupUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupUsers401JSONResponse struct{ N401JSONResponse }
+
+func (response ListGroupUsers401JSONResponse) VisitListGroupUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupUsers403JSONResponse struct{ N403JSONResponse }
+
+func (response ListGroupUsers403JSONResponse) VisitListGroupUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupUsers404JSONResponse struct{ N404JSONResponse }
+
+func (response ListGroupUsers404JSONResponse) VisitListGroupUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupUsers409JSONResponse struct{ N409JSONResponse }
+
+func (response ListGroupUsers409JSONResponse) VisitListGroupUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupUsers500JSONResponse struct{ N500JSONResponse }
+
+func (response ListGroupUsers500JSONResponse) VisitListGroupUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddGroupMembersRequestObject struct {
+	Id   uint32 `json:"id"`
+	Body *AddGroupMembersJSONRequestBody
+}
+
+type AddGroupMembersResponseObject interface {
+	VisitAddGroupMembersResponse(w http.ResponseWriter) error
+}
+
+type AddGroupMembers204Response struct {
+}
+
+func (response AddGroupMembers204Response) VisitAddGroupMembersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AddGroupMembers400JSONResponse struct{ N400JSONResponse }
+
+func (response AddGroupMembers400JSONResponse) VisitAddGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddGroupMembers401JSONResponse struct{ N401JSONResponse }
+
+func (response AddGroupMembers401JSONResponse) VisitAddGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddGroupMembers403JSONResponse struct{ N403JSONResponse }
+
+func (response AddGroupMembers403JSONResponse) VisitAddGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddGroupMembers404JSONResponse struct{ N404JSONResponse }
+
+func (response AddGroupMembers404JSONResponse) VisitAddGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddGroupMembers409JSONResponse struct{ N409JSONResponse }
+
+func (response AddGroupMembers409JSONResponse) VisitAddGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddGroupMembers500JSONResponse struct{ N500JSONResponse }
+
+func (response AddGroupMembers500JSONResponse) VisitAddGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupRequestObject struct {
+	Params ListGroupParams
+}
+
+type ListGroupResponseObject interface {
+	VisitListGroupResponse(w http.ResponseWriter) error
+}
+
+type ListGroup200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []GroupList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListGroup200JSONResponse) VisitListGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroup400JSONResponse struct{ N400JSONResponse }
+
+func (response ListGroup400JSONResponse) VisitListGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroup401JSONResponse struct{ N401JSONResponse }
+
+func (response ListGroup401JSONResponse) VisitListGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroup403JSONResponse struct{ N403JSONResponse }
+
+func (response ListGroup403JSONResponse) VisitListGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroup404JSONResponse struct{ N404JSONResponse }
+
+func (response ListGroup404JSONResponse) VisitListGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroup409JSONResponse struct{ N409JSONResponse }
+
+func (response ListGroup409JSONResponse) VisitListGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroup500JSONResponse struct{ N500JSONResponse }
+
+func (response ListGroup500JSONResponse) VisitListGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroupRequestObject struct {
+	Body *CreateGroupJSONRequestBody
+}
+
+type CreateGroupResponseObject interface {
+	VisitCreateGroupResponse(w http.ResponseWriter) error
+}
+
+type CreateGroup201JSONResponse GroupCreate
+
+func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateGroup400JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateGroup401JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateGroup403JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateGroup409JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200JSONResponse UserRead
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login202JSONResponse LoginChallenge
+
+func (response Login202JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login400JSONResponse struct{ N400JSONResponse }
+
+func (response Login400JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login401JSONResponse struct{ N401JSONResponse }
+
+func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login403JSONResponse struct{ N403JSONResponse }
+
+func (response Login403JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login429JSONResponse struct{ N429JSONResponse }
+
+func (response Login429JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type Login500JSONResponse struct{ N500JSONResponse }
+
+func (response Login500JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginOidcLoginRequestObject struct {
+	Provider string `json:"provider"`
+}
+
+type BeginOidcLoginResponseObject interface {
+	VisitBeginOidcLoginResponse(w http.ResponseWriter) error
+}
+
+type BeginOidcLogin200JSONResponse struct {
+	AuthorizationUrl string `json:"authorization_url"`
+
+	// SessionToken Token to send back to finish signing in
+	SessionToken string `json:"session_token"`
+}
+
+func (response BeginOidcLogin200JSONResponse) VisitBeginOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginOidcLogin401JSONResponse struct{ N401JSONResponse }
+
+func (response BeginOidcLogin401JSONResponse) VisitBeginOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginOidcLogin403JSONResponse struct{ N403JSONResponse }
+
+func (response BeginOidcLogin403JSONResponse) VisitBeginOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginOidcLogin404JSONResponse struct{ N404JSONResponse }
+
+func (response BeginOidcLogin404JSONResponse) VisitBeginOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginOidcLogin500JSONResponse struct{ N500JSONResponse }
+
+func (response BeginOidcLogin500JSONResponse) VisitBeginOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishOidcLoginRequestObject struct {
+	Provider string `json:"provider"`
+	Body     *FinishOidcLoginJSONRequestBody
+}
+
+type FinishOidcLoginResponseObject interface {
+	VisitFinishOidcLoginResponse(w http.ResponseWriter) error
+}
+
+type FinishOidcLogin200JSONResponse UserRead
+
+func (response FinishOidcLogin200JSONResponse) VisitFinishOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishOidcLogin401JSONResponse struct{ N401JSONResponse }
+
+func (response FinishOidcLogin401JSONResponse) VisitFinishOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishOidcLogin403JSONResponse struct{ N403JSONResponse }
+
+func (response FinishOidcLogin403JSONResponse) VisitFinishOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishOidcLogin404JSONResponse struct{ N404JSONResponse }
+
+func (response FinishOidcLogin404JSONResponse) VisitFinishOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishOidcLogin500JSONResponse struct{ N500JSONResponse }
+
+func (response FinishOidcLogin500JSONResponse) VisitFinishOidcLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyTotpRequestObject struct {
+	Body *VerifyTotpJSONRequestBody
+}
+
+type VerifyTotpResponseObject interface {
+	VisitVerifyTotpResponse(w http.ResponseWriter) error
+}
+
+type VerifyTotp200JSONResponse UserRead
+
+func (response VerifyTotp200JSONResponse) VisitVerifyTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyTotp400JSONResponse struct{ N400JSONResponse }
+
+func (response VerifyTotp400JSONResponse) VisitVerifyTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyTotp401JSONResponse struct{ N401JSONResponse }
+
+func (response VerifyTotp401JSONResponse) VisitVerifyTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyTotp403JSONResponse struct{ N403JSONResponse }
+
+func (response VerifyTotp403JSONResponse) VisitVerifyTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyTotp429JSONResponse struct{ N429JSONResponse }
+
+func (response VerifyTotp429JSONResponse) VisitVerifyTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type VerifyTotp500JSONResponse struct{ N500JSONResponse }
+
+func (response VerifyTotp500JSONResponse) VisitVerifyTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebauthnLoginRequestObject struct {
+}
+
+type BeginWebauthnLoginResponseObject interface {
+	VisitBeginWebauthnLoginResponse(w http.ResponseWriter) error
+}
+
+type BeginWebauthnLogin200JSONResponse WebauthnCeremony
+
+func (response BeginWebauthnLogin200JSONResponse) VisitBeginWebauthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebauthnLogin401JSONResponse struct{ N401JSONResponse }
+
+func (response BeginWebauthnLogin401JSONResponse) VisitBeginWebauthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebauthnLogin403JSONResponse struct{ N403JSONResponse }
+
+func (response BeginWebauthnLogin403JSONResponse) VisitBeginWebauthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebauthnLogin500JSONResponse struct{ N500JSONResponse }
+
+func (response BeginWebauthnLogin500JSONResponse) VisitBeginWebauthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebauthnLoginRequestObject struct {
+	Body *FinishWebauthnLoginJSONRequestBody
+}
+
+type FinishWebauthnLoginResponseObject interface {
+	VisitFinishWebauthnLoginResponse(w http.ResponseWriter) error
+}
+
+type FinishWebauthnLogin200JSONResponse UserRead
+
+func (response FinishWebauthnLogin200JSONResponse) VisitFinishWebauthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebauthnLogin401JSONResponse struct{ N401JSONResponse }
+
+func (response FinishWebauthnLogin401JSONResponse) VisitFinishWebauthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebauthnLogin403JSONResponse struct{ N403JSONResponse }
+
+func (response FinishWebauthnLogin403JSONResponse) VisitFinishWebauthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebauthnLogin429JSONResponse struct{ N429JSONResponse }
+
+func (response FinishWebauthnLogin429JSONResponse) VisitFinishWebauthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type FinishWebauthnLogin500JSONResponse struct{ N500JSONResponse }
+
+func (response FinishWebauthnLogin500JSONResponse) VisitFinishWebauthnLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutRequestObject struct {
+}
+
+type LogoutResponseObject interface {
+	VisitLogoutResponse(w http.ResponseWriter) error
+}
+
+type Logout204Response struct {
+}
+
+func (response Logout204Response) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type Logout401JSONResponse struct{ N401JSONResponse }
+
+func (response Logout401JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Logout403JSONResponse struct{ N403JSONResponse }
+
+func (response Logout403JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Logout500JSONResponse struct{ N500JSONResponse }
+
+func (response Logout500JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePasswordRequestObject struct {
+	Body *ChangePasswordJSONRequestBody
+}
+
+type ChangePasswordResponseObject interface {
+	VisitChangePasswordResponse(w http.ResponseWriter) error
+}
+
+type ChangePassword204Response struct {
+}
+
+func (response ChangePassword204Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ChangePassword400JSONResponse struct{ N400JSONResponse }
+
+func (response ChangePassword400JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePassword401JSONResponse struct{ N401JSONResponse }
+
+func (response ChangePassword401JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePassword403JSONResponse struct{ N403JSONResponse }
+
+func (response ChangePassword403JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangePassword429JSONResponse struct{ N429JSONResponse }
+
+func (response ChangePassword429JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ChangePassword500JSONResponse struct{ N500JSONResponse }
+
+func (response ChangePassword500JSONResponse) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMySessionsRequestObject struct {
+}
+
+type ListMySessionsResponseObject interface {
+	VisitListMySessionsResponse(w http.ResponseWriter) error
+}
+
+type ListMySessions200JSONResponse []Session
+
+func (response ListMySessions200JSONResponse) VisitListMySessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMySessions401JSONResponse struct{ N401JSONResponse }
+
+func (response ListMySessions401JSONResponse) VisitListMySessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMySessions403JSONResponse struct{ N403JSONResponse }
+
+func (response ListMySessions403JSONResponse) VisitListMySessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMySessions500JSONResponse struct{ N500JSONResponse }
+
+func (response ListMySessions500JSONResponse) VisitListMySessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMySessionRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type DeleteMySessionResponseObject interface {
+	VisitDeleteMySessionResponse(w http.ResponseWriter) error
+}
+
+type DeleteMySession204Response struct {
+}
+
+func (response DeleteMySession204Response) VisitDeleteMySessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteMySession401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteMySession401JSONResponse) VisitDeleteMySessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMySession403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteMySession403JSONResponse) VisitDeleteMySessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMySession404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteMySession404JSONResponse) VisitDeleteMySessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMySession500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteMySession500JSONResponse) VisitDeleteMySessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTotpRequestObject struct {
+}
+
+type EnrollTotpResponseObject interface {
+	VisitEnrollTotpResponse(w http.ResponseWriter) error
+}
+
+type EnrollTotp200JSONResponse TotpEnrollment
+
+func (response EnrollTotp200JSONResponse) VisitEnrollTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTotp401JSONResponse struct{ N401JSONResponse }
+
+func (response EnrollTotp401JSONResponse) VisitEnrollTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTotp403JSONResponse struct{ N403JSONResponse }
+
+func (response EnrollTotp403JSONResponse) VisitEnrollTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTotp409JSONResponse struct{ N409JSONResponse }
+
+func (response EnrollTotp409JSONResponse) VisitEnrollTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrollTotp500JSONResponse struct{ N500JSONResponse }
+
+func (response EnrollTotp500JSONResponse) VisitEnrollTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTotpRequestObject struct {
+	Body *ConfirmTotpJSONRequestBody
+}
+
+type ConfirmTotpResponseObject interface {
+	VisitConfirmTotpResponse(w http.ResponseWriter) error
+}
+
+type ConfirmTotp200JSONResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (response ConfirmTotp200JSONResponse) VisitConfirmTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTotp400JSONResponse struct{ N400JSONResponse }
+
+func (response ConfirmTotp400JSONResponse) VisitConfirmTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTotp401JSONResponse struct{ N401JSONResponse }
+
+func (response ConfirmTotp401JSONResponse) VisitConfirmTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTotp403JSONResponse struct{ N403JSONResponse }
+
+func (response ConfirmTotp403JSONResponse) VisitConfirmTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmTotp500JSONResponse struct{ N500JSONResponse }
+
+func (response ConfirmTotp500JSONResponse) VisitConfirmTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableTotpRequestObject struct {
+	Body *DisableTotpJSONRequestBody
+}
+
+type DisableTotpResponseObject interface {
+	VisitDisableTotpResponse(w http.ResponseWriter) error
+}
+
+type DisableTotp204Response struct {
+}
+
+func (response DisableTotp204Response) VisitDisableTotpResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DisableTotp400JSONResponse struct{ N400JSONResponse }
+
+func (response DisableTotp400JSONResponse) VisitDisableTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableTotp401JSONResponse struct{ N401JSONResponse }
+
+func (response DisableTotp401JSONResponse) VisitDisableTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableTotp403JSONResponse struct{ N403JSONResponse }
+
+func (response DisableTotp403JSONResponse) VisitDisableTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableTotp500JSONResponse struct{ N500JSONResponse }
+
+func (response DisableTotp500JSONResponse) VisitDisableTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebauthnRegistrationRequestObject struct {
+}
+
+type BeginWebauthnRegistrationResponseObject interface {
+	VisitBeginWebauthnRegistrationResponse(w http.ResponseWriter) error
+}
+
+type BeginWebauthnRegistration200JSONResponse WebauthnCeremony
+
+func (response BeginWebauthnRegistration200JSONResponse) VisitBeginWebauthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebauthnRegistration401JSONResponse struct{ N401JSONResponse }
+
+func (response BeginWebauthnRegistration401JSONResponse) VisitBeginWebauthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebauthnRegistration403JSONResponse struct{ N403JSONResponse }
+
+func (response BeginWebauthnRegistration403JSONResponse) VisitBeginWebauthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BeginWebauthnRegistration500JSONResponse struct{ N500JSONResponse }
+
+func (response BeginWebauthnRegistration500JSONResponse) VisitBeginWebauthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebauthnRegistrationRequestObject struct {
+	Body *FinishWebauthnRegistrationJSONRequestBody
+}
+
+type FinishWebauthnRegistrationResponseObject interface {
+	VisitFinishWebauthnRegistrationResponse(w http.ResponseWriter) error
+}
+
+type FinishWebauthnRegistration201JSONResponse Credential
+
+func (response FinishWebauthnRegistration201JSONResponse) VisitFinishWebauthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebauthnRegistration400JSONResponse struct{ N400JSONResponse }
+
+func (response FinishWebauthnRegistration400JSONResponse) VisitFinishWebauthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebauthnRegistration401JSONResponse struct{ N401JSONResponse }
+
+func (response FinishWebauthnRegistration401JSONResponse) VisitFinishWebauthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebauthnRegistration403JSONResponse struct{ N403JSONResponse }
+
+func (response FinishWebauthnRegistration403JSONResponse) VisitFinishWebauthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishWebauthnRegistration500JSONResponse struct{ N500JSONResponse }
+
+func (response FinishWebauthnRegistration500JSONResponse) VisitFinishWebauthnRegistrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOauthClientRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type DeleteOauthClientResponseObject interface {
+	VisitDeleteOauthClientResponse(w http.ResponseWriter) error
+}
+
+type DeleteOauthClient204Response struct {
+}
+
+func (response DeleteOauthClient204Response) VisitDeleteOauthClientResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteOauthClient400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteOauthClient400JSONResponse) VisitDeleteOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOauthClient401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteOauthClient401JSONResponse) VisitDeleteOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOauthClient403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteOauthClient403JSONResponse) VisitDeleteOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOauthClient404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteOauthClient404JSONResponse) VisitDeleteOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOauthClient409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteOauthClient409JSONResponse) VisitDeleteOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOauthClient500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteOauthClient500JSONResponse) VisitDeleteOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOauthClientRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ReadOauthClientResponseObject interface {
+	VisitReadOauthClientResponse(w http.ResponseWriter) error
+}
+
+type ReadOauthClient200JSONResponse OauthClientRead
+
+func (response ReadOauthClient200JSONResponse) VisitReadOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOauthClient400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadOauthClient400JSONResponse) VisitReadOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOauthClient401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadOauthClient401JSONResponse) VisitReadOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOauthClient403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadOauthClient403JSONResponse) VisitReadOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOauthClient404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadOauthClient404JSONResponse) VisitReadOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOauthClient409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadOauthClient409JSONResponse) VisitReadOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOauthClient500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadOauthClient500JSONResponse) VisitReadOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOauthClientRequestObject struct {
+	Params ListOauthClientParams
+}
+
+type ListOauthClientResponseObject interface {
+	VisitListOauthClientResponse(w http.ResponseWriter) error
+}
+
+type ListOauthClient200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []OauthClientList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListOauthClient200JSONResponse) VisitListOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOauthClient400JSONResponse struct{ N400JSONResponse }
+
+func (response ListOauthClient400JSONResponse) VisitListOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOauthClient401JSONResponse struct{ N401JSONResponse }
+
+func (response ListOauthClient401JSONResponse) VisitListOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOauthClient403JSONResponse struct{ N403JSONResponse }
+
+func (response ListOauthClient403JSONResponse) VisitListOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOauthClient404JSONResponse struct{ N404JSONResponse }
+
+func (response ListOauthClient404JSONResponse) VisitListOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOauthClient409JSONResponse struct{ N409JSONResponse }
+
+func (response ListOauthClient409JSONResponse) VisitListOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOauthClient500JSONResponse struct{ N500JSONResponse }
+
+func (response ListOauthClient500JSONResponse) VisitListOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOauthClientRequestObject struct {
+	Body *CreateOauthClientJSONRequestBody
+}
+
+type CreateOauthClientResponseObject interface {
+	VisitCreateOauthClientResponse(w http.ResponseWriter) error
+}
+
+type CreateOauthClient201JSONResponse OauthClientCreate
+
+func (response CreateOauthClient201JSONResponse) VisitCreateOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOauthClient400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateOauthClient400JSONResponse) VisitCreateOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOauthClient401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateOauthClient401JSONResponse) VisitCreateOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOauthClient403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateOauthClient403JSONResponse) VisitCreateOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOauthClient409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateOauthClient409JSONResponse) VisitCreateOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOauthClient500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateOauthClient500JSONResponse) VisitCreateOauthClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthAuthorizeRequestObject struct {
+	Params OauthAuthorizeParams
+}
+
+type OauthAuthorizeResponseObject interface {
+	VisitOauthAuthorizeResponse(w http.ResponseWriter) error
+}
+
+type OauthAuthorize302ResponseHeaders struct {
+	Location string
+}
+
+type OauthAuthorize302Response struct {
+	Headers OauthAuthorize302ResponseHeaders
+}
+
+func (response OauthAuthorize302Response) VisitOauthAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type OauthAuthorize400JSONResponse struct{ N400JSONResponse }
+
+func (response OauthAuthorize400JSONResponse) VisitOauthAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthAuthorize401JSONResponse struct{ N401JSONResponse }
+
+func (response OauthAuthorize401JSONResponse) VisitOauthAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthAuthorize403JSONResponse struct{ N403JSONResponse }
+
+func (response OauthAuthorize403JSONResponse) VisitOauthAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthAuthorize500JSONResponse struct{ N500JSONResponse }
+
+func (response OauthAuthorize500JSONResponse) VisitOauthAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthJwksRequestObject struct {
+}
+
+type OauthJwksResponseObject interface {
+	VisitOauthJwksResponse(w http.ResponseWriter) error
+}
+
+type OauthJwks200JSONResponse struct {
+	Keys []map[string]string `json:"keys"`
+}
+
+func (response OauthJwks200JSONResponse) VisitOauthJwksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthJwks401JSONResponse struct{ N401JSONResponse }
+
+func (response OauthJwks401JSONResponse) VisitOauthJwksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthJwks403JSONResponse struct{ N403JSONResponse }
+
+func (response OauthJwks403JSONResponse) VisitOauthJwksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthJwks500JSONResponse struct{ N500JSONResponse }
+
+func (response OauthJwks500JSONResponse) VisitOauthJwksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthTokenRequestObject struct {
+	Body *OauthTokenFormdataRequestBody
+}
+
+type OauthTokenResponseObject interface {
+	VisitOauthTokenResponse(w http.ResponseWriter) error
+}
+
+type OauthToken200JSONResponse struct {
+	AccessToken  string  `json:"access_token"`
+	ExpiresIn    int     `json:"expires_in"`
+	IdToken      *string `json:"id_token,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	Scope        *string `json:"scope,omitempty"`
+	TokenType    string  `json:"token_type"`
+}
+
+func (response OauthToken200JSONResponse) VisitOauthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthToken400JSONResponse struct {
+	Error            string  `json:"error"`
+	ErrorDescription *string `json:"error_description,omitempty"`
+}
+
+func (response OauthToken400JSONResponse) VisitOauthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthToken401JSONResponse struct {
+	Error            string  `json:"error"`
+	ErrorDescription *string `json:"error_description,omitempty"`
+}
+
+func (response OauthToken401JSONResponse) VisitOauthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthToken403JSONResponse struct{ N403JSONResponse }
+
+func (response OauthToken403JSONResponse) VisitOauthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthToken500JSONResponse struct{ N500JSONResponse }
+
+func (response OauthToken500JSONResponse) VisitOauthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type DeleteOrganizationResponseObject interface {
+	VisitDeleteOrganizationResponse(w http.ResponseWriter) error
+}
+
+type DeleteOrganization204Response struct {
+}
+
+func (response DeleteOrganization204Response) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteOrganization400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteOrganization400JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteOrganization401JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteOrganization403JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteOrganization404JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteOrganization409JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteOrganization500JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOrganizationRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ReadOrganizationResponseObject interface {
+	VisitReadOrganizationResponse(w http.ResponseWriter) error
+}
+
+type ReadOrganization200JSONResponse OrganizationRead
+
+func (response ReadOrganization200JSONResponse) VisitReadOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOrganization400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadOrganization400JSONResponse) VisitReadOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOrganization401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadOrganization401JSONResponse) VisitReadOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOrganization403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadOrganization403JSONResponse) VisitReadOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOrganization404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadOrganization404JSONResponse) VisitReadOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOrganization409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadOrganization409JSONResponse) VisitReadOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadOrganization500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadOrganization500JSONResponse) VisitReadOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationRequestObject struct {
+	Id   uint32 `json:"id"`
+	Body *UpdateOrganizationJSONRequestBody
+}
+
+type UpdateOrganizationResponseObject interface {
+	VisitUpdateOrganizationResponse(w http.ResponseWriter) error
+}
+
+type UpdateOrganization200JSONResponse OrganizationUpdate
+
+func (response UpdateOrganization200JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateOrganization400JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateOrganization401JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateOrganization403JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateOrganization404JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateOrganization409JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization422JSONResponse struct{ N422JSONResponse }
+
+func (response UpdateOrganization422JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateOrganization500JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeScimTokenRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type RevokeScimTokenResponseObject interface {
+	VisitRevokeScimTokenResponse(w http.ResponseWriter) error
+}
+
+type RevokeScimToken204Response struct {
+}
+
+func (response RevokeScimToken204Response) VisitRevokeScimTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeScimToken401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeScimToken401JSONResponse) VisitRevokeScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeScimToken403JSONResponse struct{ N403JSONResponse }
+
+func (response RevokeScimToken403JSONResponse) VisitRevokeScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeScimToken404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeScimToken404JSONResponse) VisitRevokeScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeScimToken500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeScimToken500JSONResponse) VisitRevokeScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueScimTokenRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type IssueScimTokenResponseObject interface {
+	VisitIssueScimTokenResponse(w http.ResponseWriter) error
+}
+
+type IssueScimToken200JSONResponse ScimToken
+
+func (response IssueScimToken200JSONResponse) VisitIssueScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueScimToken401JSONResponse struct{ N401JSONResponse }
+
+func (response IssueScimToken401JSONResponse) VisitIssueScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueScimToken403JSONResponse struct{ N403JSONResponse }
+
+func (response IssueScimToken403JSONResponse) VisitIssueScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueScimToken404JSONResponse struct{ N404JSONResponse }
+
+func (response IssueScimToken404JSONResponse) VisitIssueScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueScimToken500JSONResponse struct{ N500JSONResponse }
+
+func (response IssueScimToken500JSONResponse) VisitIssueScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationRequestObject struct {
+	Params ListOrganizationParams
+}
+
+type ListOrganizationResponseObject interface {
+	VisitListOrganizationResponse(w http.ResponseWriter) error
+}
+
+type ListOrganization200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []OrganizationList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListOrganization200JSONResponse) VisitListOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganization400JSONResponse struct{ N400JSONResponse }
+
+func (response ListOrganization400JSONResponse) VisitListOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganization401JSONResponse struct{ N401JSONResponse }
+
+func (response ListOrganization401JSONResponse) VisitListOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganization403JSONResponse struct{ N403JSONResponse }
+
+func (response ListOrganization403JSONResponse) VisitListOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganization404JSONResponse struct{ N404JSONResponse }
+
+func (response ListOrganization404JSONResponse) VisitListOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganization409JSONResponse struct{ N409JSONResponse }
+
+func (response ListOrganization409JSONResponse) VisitListOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganization500JSONResponse struct{ N500JSONResponse }
+
+func (response ListOrganization500JSONResponse) VisitListOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationRequestObject struct {
+	Body *CreateOrganizationJSONRequestBody
+}
+
+type CreateOrganizationResponseObject interface {
+	VisitCreateOrganizationResponse(w http.ResponseWriter) error
+}
+
+type CreateOrganization201JSONResponse OrganizationCreate
+
+func (response CreateOrganization201JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateOrganization400JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateOrganization401JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateOrganization403JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateOrganization409JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateOrganization500JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ForgotPasswordRequestObject struct {
+	Body *ForgotPasswordJSONRequestBody
+}
+
+type ForgotPasswordResponseObject interface {
+	VisitForgotPasswordResponse(w http.ResponseWriter) error
+}
+
+type ForgotPassword202Response struct {
+}
+
+func (response ForgotPassword202Response) VisitForgotPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type ForgotPassword401JSONResponse struct{ N401JSONResponse }
+
+func (response ForgotPassword401JSONResponse) VisitForgotPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ForgotPassword403JSONResponse struct{ N403JSONResponse }
+
+func (response ForgotPassword403JSONResponse) VisitForgotPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ForgotPassword500JSONResponse struct{ N500JSONResponse }
+
+func (response ForgotPassword500JSONResponse) VisitForgotPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetPasswordRequestObject struct {
+	Body *ResetPasswordJSONRequestBody
+}
+
+type ResetPasswordResponseObject interface {
+	VisitResetPasswordResponse(w http.ResponseWriter) error
+}
+
+type ResetPassword204Response struct {
+}
+
+func (response ResetPassword204Response) VisitResetPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ResetPassword400JSONResponse struct{ N400JSONResponse }
+
+func (response ResetPassword400JSONResponse) VisitResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetPassword401JSONResponse struct{ N401JSONResponse }
+
+func (response ResetPassword401JSONResponse) VisitResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetPassword403JSONResponse struct{ N403JSONResponse }
+
+func (response ResetPassword403JSONResponse) VisitResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetPassword500JSONResponse struct{ N500JSONResponse }
+
+func (response ResetPassword500JSONResponse) VisitResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type DeletePermissionResponseObject interface {
+	VisitDeletePermissionResponse(w http.ResponseWriter) error
+}
+
+type DeletePermission204Response struct {
+}
+
+func (response DeletePermission204Response) VisitDeletePermissionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePermission400JSONResponse struct{ N400JSONResponse }
+
+func (response DeletePermission400JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermission401JSONResponse struct{ N401JSONResponse }
+
+func (response DeletePermission401JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermission403JSONResponse struct{ N403JSONResponse }
+
+func (response DeletePermission403JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermission404JSONResponse struct{ N404JSONResponse }
+
+func (response DeletePermission404JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermission409JSONResponse struct{ N409JSONResponse }
+
+func (response DeletePermission409JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermission500JSONResponse struct{ N500JSONResponse }
+
+func (response DeletePermission500JSONResponse) VisitDeletePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPermissionRequestObject struct {
+	Id     uint32 `json:"id"`
+	Params ReadPermissionParams
+}
+
+type ReadPermissionResponseObject interface {
+	VisitReadPermissionResponse(w http.ResponseWriter) error
+}
+
+type ReadPermission200JSONResponse PermissionRead
+
+func (response ReadPermission200JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPermission400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadPermission400JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPermission401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadPermission401JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPermission403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadPermission403JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPermission404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadPermission404JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPermission409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadPermission409JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPermission500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadPermission500JSONResponse) VisitReadPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionRequestObject struct {
+	Id   uint32 `json:"id"`
+	Body *UpdatePermissionJSONRequestBody
+}
+
+type UpdatePermissionResponseObject interface {
+	VisitUpdatePermissionResponse(w http.ResponseWriter) error
+}
+
+type UpdatePermission200JSONResponse PermissionUpdate
+
+func (response UpdatePermission200JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermission400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdatePermission400JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermission401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdatePermission401JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermission403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdatePermission403JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermission404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdatePermission404JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermission409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdatePermission409JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermission422JSONResponse struct{ N422JSONResponse }
+
+func (response UpdatePermission422JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermission500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdatePermission500JSONResponse) VisitUpdatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPermissionRequestObject struct {
+	Params ListPermissionParams
+}
+
+type ListPermissionResponseObject interface {
+	VisitListPermissionResponse(w http.ResponseWriter) error
+}
+
+type ListPermission200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []PermissionList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListPermission200JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPermission400JSONResponse struct{ N400JSONResponse }
+
+func (response ListPermission400JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPermission401JSONResponse struct{ N401JSONResponse }
+
+func (response ListPermission401JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPermission403JSONResponse struct{ N403JSONResponse }
+
+func (response ListPermission403JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPermission404JSONResponse struct{ N404JSONResponse }
+
+func (response ListPermission404JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPermission409JSONResponse struct{ N409JSONResponse }
+
+func (response ListPermission409JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPermission500JSONResponse struct{ N500JSONResponse }
+
+func (response ListPermission500JSONResponse) VisitListPermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePermissionRequestObject struct {
+	Body *CreatePermissionJSONRequestBody
+}
+
+type CreatePermissionResponseObject interface {
+	VisitCreatePermissionResponse(w http.ResponseWriter) error
+}
+
+type CreatePermission201JSONResponse PermissionCreate
+
+func (response CreatePermission201JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePermission400JSONResponse struct{ N400JSONResponse }
+
+func (response CreatePermission400JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePermission401JSONResponse struct{ N401JSONResponse }
+
+func (response CreatePermission401JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePermission403JSONResponse struct{ N403JSONResponse }
+
+func (response CreatePermission403JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePermission409JSONResponse struct{ N409JSONResponse }
+
+func (response CreatePermission409JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePermission500JSONResponse struct{ N500JSONResponse }
+
+func (response CreatePermission500JSONResponse) VisitCreatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalTokenRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type DeletePersonalTokenResponseObject interface {
+	VisitDeletePersonalTokenResponse(w http.ResponseWriter) error
+}
+
+type DeletePersonalToken204Response struct {
+}
+
+func (response DeletePersonalToken204Response) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePersonalToken400JSONResponse struct{ N400JSONResponse }
+
+func (response DeletePersonalToken400JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalToken401JSONResponse struct{ N401JSONResponse }
+
+func (response DeletePersonalToken401JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalToken403JSONResponse struct{ N403JSONResponse }
+
+func (response DeletePersonalToken403JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalToken404JSONResponse struct{ N404JSONResponse }
+
+func (response DeletePersonalToken404JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalToken409JSONResponse struct{ N409JSONResponse }
+
+func (response DeletePersonalToken409JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePersonalToken500JSONResponse struct{ N500JSONResponse }
+
+func (response DeletePersonalToken500JSONResponse) VisitDeletePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPersonalTokenRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type ReadPersonalTokenResponseObject interface {
+	VisitReadPersonalTokenResponse(w http.ResponseWriter) error
+}
+
+type ReadPersonalToken200JSONResponse PersonalTokenRead
+
+func (response ReadPersonalToken200JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPersonalToken400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadPersonalToken400JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPersonalToken401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadPersonalToken401JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPersonalToken403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadPersonalToken403JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPersonalToken404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadPersonalToken404JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPersonalToken409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadPersonalToken409JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadPersonalToken500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadPersonalToken500JSONResponse) VisitReadPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalTokenRequestObject struct {
+	Params ListPersonalTokenParams
+}
+
+type ListPersonalTokenResponseObject interface {
+	VisitListPersonalTokenResponse(w http.ResponseWriter) error
+}
+
+type ListPersonalToken200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []PersonalTokenList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListPersonalToken200JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalToken400JSONResponse struct{ N400JSONResponse }
+
+func (response ListPersonalToken400JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalToken401JSONResponse struct{ N401JSONResponse }
+
+func (response ListPersonalToken401JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalToken403JSONResponse struct{ N403JSONResponse }
+
+func (response ListPersonalToken403JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalToken404JSONResponse struct{ N404JSONResponse }
+
+func (response ListPersonalToken404JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalToken409JSONResponse struct{ N409JSONResponse }
+
+func (response ListPersonalToken409JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPersonalToken500JSONResponse struct{ N500JSONResponse }
+
+func (response ListPersonalToken500JSONResponse) VisitListPersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalTokenRequestObject struct {
+	Body *CreatePersonalTokenJSONRequestBody
+}
+
+type CreatePersonalTokenResponseObject interface {
+	VisitCreatePersonalTokenResponse(w http.ResponseWriter) error
+}
+
+type CreatePersonalToken201JSONResponse PersonalTokenCreate
+
+func (response CreatePersonalToken201JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalToken400JSONResponse struct{ N400JSONResponse }
+
+func (response CreatePersonalToken400JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalToken401JSONResponse struct{ N401JSONResponse }
+
+func (response CreatePersonalToken401JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalToken403JSONResponse struct{ N403JSONResponse }
+
+func (response CreatePersonalToken403JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalToken409JSONResponse struct{ N409JSONResponse }
+
+func (response CreatePersonalToken409JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalToken500JSONResponse struct{ N500JSONResponse }
+
+func (response CreatePersonalToken500JSONResponse) VisitCreatePersonalTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PingRequestObject struct {
+}
+
+type PingResponseObject interface {
+	VisitPingResponse(w http.ResponseWriter) error
+}
+
+type Ping204Response struct {
+}
+
+func (response Ping204Response) VisitPingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type Ping500JSONResponse struct{ N500JSONResponse }
+
+func (response Ping500JSONResponse) VisitPingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintPermissionsRequestObject struct {
+	Params HintPermissionsParams
+}
+
+type HintPermissionsResponseObject interface {
+	VisitHintPermissionsResponse(w http.ResponseWriter) error
+}
+
+type HintPermissions200JSONResponse []PermissionList
+
+func (response HintPermissions200JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintPermissions400JSONResponse struct{ N400JSONResponse }
+
+func (response HintPermissions400JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintPermissions401JSONResponse struct{ N401JSONResponse }
+
+func (response HintPermissions401JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintPermissions403JSONResponse struct{ N403JSONResponse }
+
+func (response HintPermissions403JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintPermissions500JSONResponse struct{ N500JSONResponse }
+
+func (response HintPermissions500JSONResponse) VisitHintPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintRolesRequestObject struct {
+	Params HintRolesParams
+}
+
+type HintRolesResponseObject interface {
+	VisitHintRolesResponse(w http.ResponseWriter) error
+}
+
+type HintRoles200JSONResponse []RoleList
+
+func (response HintRoles200JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintRoles400JSONResponse struct{ N400JSONResponse }
+
+func (response HintRoles400JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintRoles401JSONResponse struct{ N401JSONResponse }
+
+func (response HintRoles401JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintRoles403JSONResponse struct{ N403JSONResponse }
+
+func (response HintRoles403JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintRoles500JSONResponse struct{ N500JSONResponse }
+
+func (response HintRoles500JSONResponse) VisitHintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintUsersRequestObject struct {
+	Params HintUsersParams
+}
+
+type HintUsersResponseObject interface {
+	VisitHintUsersResponse(w http.ResponseWriter) error
+}
+
+type HintUsers200JSONResponse []UserList
+
+func (response HintUsers200JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintUsers400JSONResponse struct{ N400JSONResponse }
+
+func (response HintUsers400JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintUsers401JSONResponse struct{ N401JSONResponse }
+
+func (response HintUsers401JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintUsers403JSONResponse struct{ N403JSONResponse }
+
+func (response HintUsers403JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HintUsers500JSONResponse struct{ N500JSONResponse }
+
+func (response HintUsers500JSONResponse) VisitHintUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRoleRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type DeleteRoleResponseObject interface {
+	VisitDeleteRoleResponse(w http.ResponseWriter) error
+}
+
+type DeleteRole204Response struct {
+}
+
+func (response DeleteRole204Response) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRole400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteRole400JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRole401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteRole401JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRole403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteRole403JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRole404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteRole404JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRole409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteRole409JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRole500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteRole500JSONResponse) VisitDeleteRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadRoleRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ReadRoleResponseObject interface {
+	VisitReadRoleResponse(w http.ResponseWriter) error
+}
+
+type ReadRole200JSONResponse RoleRead
+
+func (response ReadRole200JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadRole400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadRole400JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadRole401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadRole401JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadRole403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadRole403JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadRole404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadRole404JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadRole409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadRole409JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadRole500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadRole500JSONResponse) VisitReadRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRoleRequestObject struct {
+	Id   uint32 `json:"id"`
+	Body *UpdateRoleJSONRequestBody
+}
+
+type UpdateRoleResponseObject interface {
+	VisitUpdateRoleResponse(w http.ResponseWriter) error
+}
+
+type UpdateRole200JSONResponse RoleUpdate
+
+func (response UpdateRole200JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateRole400JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateRole401JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateRole403JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateRole404JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateRole409JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole422JSONResponse struct{ N422JSONResponse }
+
+func (response UpdateRole422JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRole500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateRole500JSONResponse) VisitUpdateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleApproversRequestObject struct {
+	Id     uint32 `json:"id"`
+	Params ListRoleApproversParams
+}
+
+type ListRoleApproversResponseObject interface {
+	VisitListRoleApproversResponse(w http.ResponseWriter) error
+}
+
+type ListRoleApprovers200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []RoleApproversList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListRoleApprovers200JSONResponse) VisitListRoleApproversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleApprovers400JSONResponse struct{ N400JSONResponse }
+
+func (response ListRoleApprovers400JSONResponse) VisitListRoleApproversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleApprovers401JSONResponse struct{ N401JSONResponse }
+
+func (response ListRoleApprovers401JSONResponse) VisitListRoleApproversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleApprovers403JSONResponse struct{ N403JSONResponse }
+
+func (response ListRoleApprovers403JSONResponse) VisitListRoleApproversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleApprovers404JSONResponse struct{ N404JSONResponse }
+
+func (response ListRoleApprovers404JSONResponse) VisitListRoleApproversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleApprovers409JSONResponse struct{ N409JSONResponse }
+
+func (response ListRoleApprovers409JSONResponse) VisitListRoleApproversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleApprovers500JSONResponse struct{ N500JSONResponse }
+
+func (response ListRoleApprovers500JSONResponse) VisitListRoleApproversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolePermissionsRequestObject struct {
+	Id     uint32 `json:"id"`
+	Params ListRolePermissionsParams
+}
+
+type ListRolePermissionsResponseObject interface {
+	VisitListRolePermissionsResponse(w http.ResponseWriter) error
+}
+
+type ListRolePermissions200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []RolePermissionsList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListRolePermissions200JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolePermissions400JSONResponse struct{ N400JSONResponse }
+
+func (response ListRolePermissions400JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolePermissions401JSONResponse struct{ N401JSONResponse }
+
+func (response ListRolePermissions401JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolePermissions403JSONResponse struct{ N403JSONResponse }
+
+func (response ListRolePermissions403JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolePermissions404JSONResponse struct{ N404JSONResponse }
+
+func (response ListRolePermissions404JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolePermissions409JSONResponse struct{ N409JSONResponse }
+
+func (response ListRolePermissions409JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolePermissions500JSONResponse struct{ N500JSONResponse }
+
+func (response ListRolePermissions500JSONResponse) VisitListRolePermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignPermissionsRequestObject struct {
+	Id   uint32 `json:"id"`
+	Body *AssignPermissionsJSONRequestBody
+}
+
+type AssignPermissionsResponseObject interface {
+	VisitAssignPermissionsResponse(w http.ResponseWriter) error
+}
+
+type AssignPermissions204Response struct {
+}
+
+func (response AssignPermissions204Response) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AssignPermissions400JSONResponse struct{ N400JSONResponse }
+
+func (response AssignPermissions400JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignPermissions401JSONResponse struct{ N401JSONResponse }
+
+func (response AssignPermissions401JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignPermissions403JSONResponse struct{ N403JSONResponse }
+
+func (response AssignPermissions403JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignPermissions404JSONResponse struct{ N404JSONResponse }
+
+func (response AssignPermissions404JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignPermissions500JSONResponse struct{ N500JSONResponse }
+
+func (response AssignPermissions500JSONResponse) VisitAssignPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleUsersRequestObject struct {
+	Id     uint32 `json:"id"`
+	Params ListRoleUsersParams
+}
+
+type ListRoleUsersResponseObject interface {
+	VisitListRoleUsersResponse(w http.ResponseWriter) error
+}
+
+type ListRoleUsers200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []RoleUsersList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListRoleUsers200JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleUsers400JSONResponse struct{ N400JSONResponse }
+
+func (response ListRoleUsers400JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleUsers401JSONResponse struct{ N401JSONResponse }
+
+func (response ListRoleUsers401JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleUsers403JSONResponse struct{ N403JSONResponse }
+
+func (response ListRoleUsers403JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleUsers404JSONResponse struct{ N404JSONResponse }
+
+func (response ListRoleUsers404JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleUsers409JSONResponse struct{ N409JSONResponse }
+
+func (response ListRoleUsers409JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleUsers500JSONResponse struct{ N500JSONResponse }
+
+func (response ListRoleUsers500JSONResponse) VisitListRoleUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoleRequestObject struct {
+	Params ListRoleParams
+}
+
+type ListRoleResponseObject interface {
+	VisitListRoleResponse(w http.ResponseWriter) error
+}
+
+type ListRole200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []RoleList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListRole200JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRole400JSONResponse struct{ N400JSONResponse }
+
+func (response ListRole400JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRole401JSONResponse struct{ N401JSONResponse }
+
+func (response ListRole401JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRole403JSONResponse struct{ N403JSONResponse }
+
+func (response ListRole403JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRole404JSONResponse struct{ N404JSONResponse }
+
+func (response ListRole404JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRole409JSONResponse struct{ N409JSONResponse }
+
+func (response ListRole409JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRole500JSONResponse struct{ N500JSONResponse }
+
+func (response ListRole500JSONResponse) VisitListRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRoleRequestObject struct {
+	Body *CreateRoleJSONRequestBody
+}
+
+type CreateRoleResponseObject interface {
+	VisitCreateRoleResponse(w http.ResponseWriter) error
+}
+
+type CreateRole201JSONResponse RoleCreate
+
+func (response CreateRole201JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateRole400JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateRole401JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateRole403JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateRole409JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRole500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateRole500JSONResponse) VisitCreateRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimListGroupsRequestObject struct {
+	Params ScimListGroupsParams
+}
+
+type ScimListGroupsResponseObject interface {
+	VisitScimListGroupsResponse(w http.ResponseWriter) error
+}
+
+type ScimListGroups200ApplicationScimPlusJSONResponse ScimGroupList
+
+func (response ScimListGroups200ApplicationScimPlusJSONResponse) VisitScimListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimListGroups400ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimListGroups400ApplicationScimPlusJSONResponse) VisitScimListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimCreateGroupRequestObject struct {
+	JSONBody                    *ScimCreateGroupJSONRequestBody
+	ApplicationScimPlusJSONBody *ScimCreateGroupApplicationScimPlusJSONRequestBody
+}
+
+type ScimCreateGroupResponseObject interface {
+	VisitScimCreateGroupResponse(w http.ResponseWriter) error
+}
+
+type ScimCreateGroup201ApplicationScimPlusJSONResponse ScimGroup
+
+func (response ScimCreateGroup201ApplicationScimPlusJSONResponse) VisitScimCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimCreateGroup400ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimCreateGroup400ApplicationScimPlusJSONResponse) VisitScimCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimCreateGroup409ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimCreateGroup409ApplicationScimPlusJSONResponse) VisitScimCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimDeleteGroupRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ScimDeleteGroupResponseObject interface {
+	VisitScimDeleteGroupResponse(w http.ResponseWriter) error
+}
+
+type ScimDeleteGroup204Response struct {
+}
+
+func (response ScimDeleteGroup204Response) VisitScimDeleteGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ScimDeleteGroup404ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimDeleteGroup404ApplicationScimPlusJSONResponse) VisitScimDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReadGroupRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ScimReadGroupResponseObject interface {
+	VisitScimReadGroupResponse(w http.ResponseWriter) error
+}
+
+type ScimReadGroup200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response ScimReadGroup200ApplicationScimPlusJSONResponse) VisitScimReadGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReadGroup404ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimReadGroup404ApplicationScimPlusJSONResponse) VisitScimReadGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimPatchGroupRequestObject struct {
+	Id                          uint32 `json:"id"`
+	JSONBody                    *ScimPatchGroupJSONRequestBody
+	ApplicationScimPlusJSONBody *ScimPatchGroupApplicationScimPlusJSONRequestBody
+}
+
+type ScimPatchGroupResponseObject interface {
+	VisitScimPatchGroupResponse(w http.ResponseWriter) error
+}
+
+type ScimPatchGroup200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response ScimPatchGroup200ApplicationScimPlusJSONResponse) VisitScimPatchGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimPatchGroup400ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimPatchGroup400ApplicationScimPlusJSONResponse) VisitScimPatchGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimPatchGroup404ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimPatchGroup404ApplicationScimPlusJSONResponse) VisitScimPatchGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimPatchGroup409ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimPatchGroup409ApplicationScimPlusJSONResponse) VisitScimPatchGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReplaceGroupRequestObject struct {
+	Id                          uint32 `json:"id"`
+	JSONBody                    *ScimReplaceGroupJSONRequestBody
+	ApplicationScimPlusJSONBody *ScimReplaceGroupApplicationScimPlusJSONRequestBody
+}
+
+type ScimReplaceGroupResponseObject interface {
+	VisitScimReplaceGroupResponse(w http.ResponseWriter) error
+}
+
+type ScimReplaceGroup200ApplicationScimPlusJSONResponse ScimGroup
+
+func (response ScimReplaceGroup200ApplicationScimPlusJSONResponse) VisitScimReplaceGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReplaceGroup400ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimReplaceGroup400ApplicationScimPlusJSONResponse) VisitScimReplaceGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReplaceGroup404ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimReplaceGroup404ApplicationScimPlusJSONResponse) VisitScimReplaceGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReplaceGroup409ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimReplaceGroup409ApplicationScimPlusJSONResponse) VisitScimReplaceGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimServiceProviderConfigRequestObject struct {
+}
+
+type ScimServiceProviderConfigResponseObject interface {
+	VisitScimServiceProviderConfigResponse(w http.ResponseWriter) error
+}
+
+type ScimServiceProviderConfig200ApplicationScimPlusJSONResponse map[string]interface{}
+
+func (response ScimServiceProviderConfig200ApplicationScimPlusJSONResponse) VisitScimServiceProviderConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimListUsersRequestObject struct {
+	Params ScimListUsersParams
+}
+
+type ScimListUsersResponseObject interface {
+	VisitScimListUsersResponse(w http.ResponseWriter) error
+}
+
+type ScimListUsers200ApplicationScimPlusJSONResponse ScimUserList
+
+func (response ScimListUsers200ApplicationScimPlusJSONResponse) VisitScimListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimListUsers400ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimListUsers400ApplicationScimPlusJSONResponse) VisitScimListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimCreateUserRequestObject struct {
+	JSONBody                    *ScimCreateUserJSONRequestBody
+	ApplicationScimPlusJSONBody *ScimCreateUserApplicationScimPlusJSONRequestBody
+}
+
+type ScimCreateUserResponseObject interface {
+	VisitScimCreateUserResponse(w http.ResponseWriter) error
+}
+
+type ScimCreateUser201ApplicationScimPlusJSONResponse ScimUser
+
+func (response ScimCreateUser201ApplicationScimPlusJSONResponse) VisitScimCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimCreateUser400ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimCreateUser400ApplicationScimPlusJSONResponse) VisitScimCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimCreateUser409ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimCreateUser409ApplicationScimPlusJSONResponse) VisitScimCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimDeleteUserRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type ScimDeleteUserResponseObject interface {
+	VisitScimDeleteUserResponse(w http.ResponseWriter) error
+}
+
+type ScimDeleteUser204Response struct {
+}
+
+func (response ScimDeleteUser204Response) VisitScimDeleteUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ScimDeleteUser404ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimDeleteUser404ApplicationScimPlusJSONResponse) VisitScimDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReadUserRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type ScimReadUserResponseObject interface {
+	VisitScimReadUserResponse(w http.ResponseWriter) error
+}
+
+type ScimReadUser200ApplicationScimPlusJSONResponse ScimUser
+
+func (response ScimReadUser200ApplicationScimPlusJSONResponse) VisitScimReadUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReadUser404ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimReadUser404ApplicationScimPlusJSONResponse) VisitScimReadUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimPatchUserRequestObject struct {
+	Id                          uint64 `json:"id"`
+	JSONBody                    *ScimPatchUserJSONRequestBody
+	ApplicationScimPlusJSONBody *ScimPatchUserApplicationScimPlusJSONRequestBody
+}
+
+type ScimPatchUserResponseObject interface {
+	VisitScimPatchUserResponse(w http.ResponseWriter) error
+}
+
+type ScimPatchUser200ApplicationScimPlusJSONResponse ScimUser
+
+func (response ScimPatchUser200ApplicationScimPlusJSONResponse) VisitScimPatchUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimPatchUser400ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimPatchUser400ApplicationScimPlusJSONResponse) VisitScimPatchUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimPatchUser404ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimPatchUser404ApplicationScimPlusJSONResponse) VisitScimPatchUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimPatchUser409ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimPatchUser409ApplicationScimPlusJSONResponse) VisitScimPatchUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReplaceUserRequestObject struct {
+	Id                          uint64 `json:"id"`
+	JSONBody                    *ScimReplaceUserJSONRequestBody
+	ApplicationScimPlusJSONBody *ScimReplaceUserApplicationScimPlusJSONRequestBody
+}
+
+type ScimReplaceUserResponseObject interface {
+	VisitScimReplaceUserResponse(w http.ResponseWriter) error
+}
+
+type ScimReplaceUser200ApplicationScimPlusJSONResponse ScimUser
+
+func (response ScimReplaceUser200ApplicationScimPlusJSONResponse) VisitScimReplaceUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReplaceUser400ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimReplaceUser400ApplicationScimPlusJSONResponse) VisitScimReplaceUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReplaceUser404ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimReplaceUser404ApplicationScimPlusJSONResponse) VisitScimReplaceUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScimReplaceUser409ApplicationScimPlusJSONResponse ScimError
+
+func (response ScimReplaceUser409ApplicationScimPlusJSONResponse) VisitScimReplaceUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccountRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type DeleteServiceAccountResponseObject interface {
+	VisitDeleteServiceAccountResponse(w http.ResponseWriter) error
+}
+
+type DeleteServiceAccount204Response struct {
+}
+
+func (response DeleteServiceAccount204Response) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteServiceAccount400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteServiceAccount400JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccount401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteServiceAccount401JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccount403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteServiceAccount403JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccount404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteServiceAccount404JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccount409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteServiceAccount409JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServiceAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteServiceAccount500JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadServiceAccountRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ReadServiceAccountResponseObject interface {
+	VisitReadServiceAccountResponse(w http.ResponseWriter) error
+}
+
+type ReadServiceAccount200JSONResponse ServiceAccountRead
+
+func (response ReadServiceAccount200JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadServiceAccount400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadServiceAccount400JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadServiceAccount401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadServiceAccount401JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadServiceAccount403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadServiceAccount403JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadServiceAccount404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadServiceAccount404JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadServiceAccount409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadServiceAccount409JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadServiceAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadServiceAccount500JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccountRequestObject struct {
+	Params ListServiceAccountParams
+}
+
+type ListServiceAccountResponseObject interface {
+	VisitListServiceAccountResponse(w http.ResponseWriter) error
+}
+
+type ListServiceAccount200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []ServiceAccountList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListServiceAccount200JSONResponse) VisitListServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccount400JSONResponse struct{ N400JSONResponse }
+
+func (response ListServiceAccount400JSONResponse) VisitListServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccount401JSONResponse struct{ N401JSONResponse }
+
+func (response ListServiceAccount401JSONResponse) VisitListServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccount403JSONResponse struct{ N403JSONResponse }
+
+func (response ListServiceAccount403JSONResponse) VisitListServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccount404JSONResponse struct{ N404JSONResponse }
+
+func (response ListServiceAccount404JSONResponse) VisitListServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccount409JSONResponse struct{ N409JSONResponse }
+
+func (response ListServiceAccount409JSONResponse) VisitListServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response ListServiceAccount500JSONResponse) VisitListServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccountRequestObject struct {
+	Body *CreateServiceAccountJSONRequestBody
+}
+
+type CreateServiceAccountResponseObject interface {
+	VisitCreateServiceAccountResponse(w http.ResponseWriter) error
+}
+
+type CreateServiceAccount201JSONResponse ServiceAccountCreate
+
+func (response CreateServiceAccount201JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateServiceAccount400JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateServiceAccount401JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateServiceAccount403JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateServiceAccount409JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateServiceAccount500JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSodConstraintRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type DeleteSodConstraintResponseObject interface {
+	VisitDeleteSodConstraintResponse(w http.ResponseWriter) error
+}
+
+type DeleteSodConstraint204Response struct {
+}
+
+func (response DeleteSodConstraint204Response) VisitDeleteSodConstraintResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSodConstraint400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteSodConstraint400JSONResponse) VisitDeleteSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSodConstraint401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteSodConstraint401JSONResponse) VisitDeleteSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSodConstraint403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteSodConstraint403JSONResponse) VisitDeleteSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSodConstraint404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteSodConstraint404JSONResponse) VisitDeleteSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSodConstraint409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteSodConstraint409JSONResponse) VisitDeleteSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSodConstraint500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteSodConstraint500JSONResponse) VisitDeleteSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadSodConstraintRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ReadSodConstraintResponseObject interface {
+	VisitReadSodConstraintResponse(w http.ResponseWriter) error
+}
+
+type ReadSodConstraint200JSONResponse SodConstraintRead
+
+func (response ReadSodConstraint200JSONResponse) VisitReadSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadSodConstraint400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadSodConstraint400JSONResponse) VisitReadSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadSodConstraint401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadSodConstraint401JSONResponse) VisitReadSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadSodConstraint403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadSodConstraint403JSONResponse) VisitReadSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadSodConstraint404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadSodConstraint404JSONResponse) VisitReadSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadSodConstraint409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadSodConstraint409JSONResponse) VisitReadSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadSodConstraint500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadSodConstraint500JSONResponse) VisitReadSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSodConstraintRequestObject struct {
+	Id   uint32 `json:"id"`
+	Body *UpdateSodConstraintJSONRequestBody
+}
+
+type UpdateSodConstraintResponseObject interface {
+	VisitUpdateSodConstraintResponse(w http.ResponseWriter) error
+}
+
+type UpdateSodConstraint200JSONResponse SodConstraintUpdate
+
+func (response UpdateSodConstraint200JSONResponse) VisitUpdateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSodConstraint400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSodConstraint400JSONResponse) VisitUpdateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSodConstraint401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateSodConstraint401JSONResponse) VisitUpdateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSodConstraint403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateSodConstraint403JSONResponse) VisitUpdateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSodConstraint404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateSodConstraint404JSONResponse) VisitUpdateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSodConstraint409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateSodConstraint409JSONResponse) VisitUpdateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSodConstraint422JSONResponse struct{ N422JSONResponse }
+
+func (response UpdateSodConstraint422JSONResponse) VisitUpdateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSodConstraint500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSodConstraint500JSONResponse) VisitUpdateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraintRolesRequestObject struct {
+	Id     uint32 `json:"id"`
+	Params ListSodConstraintRolesParams
+}
+
+type ListSodConstraintRolesResponseObject interface {
+	VisitListSodConstraintRolesResponse(w http.ResponseWriter) error
+}
+
+type ListSodConstraintRoles200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []SodConstraintRolesList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListSodConstraintRoles200JSONResponse) VisitListSodConstraintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraintRoles400JSONResponse struct{ N400JSONResponse }
+
+func (response ListSodConstraintRoles400JSONResponse) VisitListSodConstraintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraintRoles401JSONResponse struct{ N401JSONResponse }
+
+func (response ListSodConstraintRoles401JSONResponse) VisitListSodConstraintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraintRoles403JSONResponse struct{ N403JSONResponse }
+
+func (response ListSodConstraintRoles403JSONResponse) VisitListSodConstraintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraintRoles404JSONResponse struct{ N404JSONResponse }
+
+func (response ListSodConstraintRoles404JSONResponse) VisitListSodConstraintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraintRoles409JSONResponse struct{ N409JSONResponse }
+
+func (response ListSodConstraintRoles409JSONResponse) VisitListSodConstraintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraintRoles500JSONResponse struct{ N500JSONResponse }
+
+func (response ListSodConstraintRoles500JSONResponse) VisitListSodConstraintRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodViolationsRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ListSodViolationsResponseObject interface {
+	VisitListSodViolationsResponse(w http.ResponseWriter) error
+}
+
+type ListSodViolations200JSONResponse []SodViolation
+
+func (response ListSodViolations200JSONResponse) VisitListSodViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodViolations401JSONResponse struct{ N401JSONResponse }
+
+func (response ListSodViolations401JSONResponse) VisitListSodViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodViolations403JSONResponse struct{ N403JSONResponse }
+
+func (response ListSodViolations403JSONResponse) VisitListSodViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodViolations404JSONResponse struct{ N404JSONResponse }
+
+func (response ListSodViolations404JSONResponse) VisitListSodViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodViolations500JSONResponse struct{ N500JSONResponse }
+
+func (response ListSodViolations500JSONResponse) VisitListSodViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraintRequestObject struct {
+	Params ListSodConstraintParams
+}
+
+type ListSodConstraintResponseObject interface {
+	VisitListSodConstraintResponse(w http.ResponseWriter) error
+}
+
+type ListSodConstraint200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []SodConstraintList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListSodConstraint200JSONResponse) VisitListSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraint400JSONResponse struct{ N400JSONResponse }
+
+func (response ListSodConstraint400JSONResponse) VisitListSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraint401JSONResponse struct{ N401JSONResponse }
+
+func (response ListSodConstraint401JSONResponse) VisitListSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraint403JSONResponse struct{ N403JSONResponse }
+
+func (response ListSodConstraint403JSONResponse) VisitListSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraint404JSONResponse struct{ N404JSONResponse }
+
+func (response ListSodConstraint404JSONResponse) VisitListSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraint409JSONResponse struct{ N409JSONResponse }
+
+func (response ListSodConstraint409JSONResponse) VisitListSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSodConstraint500JSONResponse struct{ N500JSONResponse }
+
+func (response ListSodConstraint500JSONResponse) VisitListSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSodConstraintRequestObject struct {
+	Body *CreateSodConstraintJSONRequestBody
+}
+
+type CreateSodConstraintResponseObject interface {
+	VisitCreateSodConstraintResponse(w http.ResponseWriter) error
+}
+
+type CreateSodConstraint201JSONResponse SodConstraintCreate
+
+func (response CreateSodConstraint201JSONResponse) VisitCreateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSodConstraint400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateSodConstraint400JSONResponse) VisitCreateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSodConstraint401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateSodConstraint401JSONResponse) VisitCreateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSodConstraint403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateSodConstraint403JSONResponse) VisitCreateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSodConstraint409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateSodConstraint409JSONResponse) VisitCreateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSodConstraint500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateSodConstraint500JSONResponse) VisitCreateSodConstraintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserRequestObject struct {
+	Id     uint64 `json:"id"`
+	Params DeleteUserParams
+}
+
+type DeleteUserResponseObject interface {
+	VisitDeleteUserResponse(w http.ResponseWriter) error
+}
+
+type DeleteUser204Response struct {
+}
+
+func (response DeleteUser204Response) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUser400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteUser400JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteUser401JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteUser403JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteUser404JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteUser409JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteUser500JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadUserRequestObject struct {
+	Id     uint64 `json:"id"`
+	Params ReadUserParams
+}
+
+type ReadUserResponseObject interface {
+	VisitReadUserResponse(w http.ResponseWriter) error
+}
+
+type ReadUser200JSONResponse UserRead
+
+func (response ReadUser200JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadUser400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadUser400JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadUser401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadUser401JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadUser403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadUser403JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadUser404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadUser404JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadUser409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadUser409JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadUser500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadUser500JSONResponse) VisitReadUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserRequestObject struct {
+	Id   uint64 `json:"id"`
+	Body *UpdateUserJSONRequestBody
+}
+
+type UpdateUserResponseObject interface {
+	VisitUpdateUserResponse(w http.ResponseWriter) error
+}
+
+type UpdateUser200JSONResponse UserUpdate
+
+func (response UpdateUser200JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateUser400JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateUser401JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateUser403JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateUser404JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateUser409JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser422JSONResponse struct{ N422JSONResponse }
+
+func (response UpdateUser422JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateUser500JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableUserRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type DisableUserResponseObject interface {
+	VisitDisableUserResponse(w http.ResponseWriter) error
+}
+
+type DisableUser204Response struct {
+}
+
+func (response DisableUser204Response) VisitDisableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DisableUser401JSONResponse struct{ N401JSONResponse }
+
+func (response DisableUser401JSONResponse) VisitDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableUser403JSONResponse struct{ N403JSONResponse }
+
+func (response DisableUser403JSONResponse) VisitDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableUser404JSONResponse struct{ N404JSONResponse }
+
+func (response DisableUser404JSONResponse) VisitDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableUser409JSONResponse struct{ N409JSONResponse }
+
+func (response DisableUser409JSONResponse) VisitDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisableUser500JSONResponse struct{ N500JSONResponse }
+
+func (response DisableUser500JSONResponse) VisitDisableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnableUserRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type EnableUserResponseObject interface {
+	VisitEnableUserResponse(w http.ResponseWriter) error
+}
+
+type EnableUser204Response struct {
+}
+
+func (response EnableUser204Response) VisitEnableUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type EnableUser401JSONResponse struct{ N401JSONResponse }
+
+func (response EnableUser401JSONResponse) VisitEnableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnableUser403JSONResponse struct{ N403JSONResponse }
+
+func (response EnableUser403JSONResponse) VisitEnableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnableUser404JSONResponse struct{ N404JSONResponse }
+
+func (response EnableUser404JSONResponse) VisitEnableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnableUser409JSONResponse struct{ N409JSONResponse }
+
+func (response EnableUser409JSONResponse) VisitEnableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnableUser500JSONResponse struct{ N500JSONResponse }
+
+func (response EnableUser500JSONResponse) VisitEnableUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImpersonateRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type ImpersonateResponseObject interface {
+	VisitImpersonateResponse(w http.ResponseWriter) error
+}
+
+type Impersonate200JSONResponse ImpersonationToken
+
+func (response Impersonate200JSONResponse) VisitImpersonateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Impersonate401JSONResponse struct{ N401JSONResponse }
+
+func (response Impersonate401JSONResponse) VisitImpersonateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Impersonate403JSONResponse struct{ N403JSONResponse }
+
+func (response Impersonate403JSONResponse) VisitImpersonateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Impersonate404JSONResponse struct{ N404JSONResponse }
+
+func (response Impersonate404JSONResponse) VisitImpersonateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Impersonate500JSONResponse struct{ N500JSONResponse }
+
+func (response Impersonate500JSONResponse) VisitImpersonateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserPasswordRequestObject struct {
+	Id   uint64 `json:"id"`
+	Body *SetUserPasswordJSONRequestBody
+}
+
+type SetUserPasswordResponseObject interface {
+	VisitSetUserPasswordResponse(w http.ResponseWriter) error
+}
+
+type SetUserPassword204Response struct {
+}
+
+func (response SetUserPassword204Response) VisitSetUserPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SetUserPassword400JSONResponse struct{ N400JSONResponse }
+
+func (response SetUserPassword400JSONResponse) VisitSetUserPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserPassword401JSONResponse struct{ N401JSONResponse }
+
+func (response SetUserPassword401JSONResponse) VisitSetUserPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserPassword403JSONResponse struct{ N403JSONResponse }
+
+func (response SetUserPassword403JSONResponse) VisitSetUserPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserPassword404JSONResponse struct{ N404JSONResponse }
+
+func (response SetUserPassword404JSONResponse) VisitSetUserPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserPassword500JSONResponse struct{ N500JSONResponse }
+
+func (response SetUserPassword500JSONResponse) VisitSetUserPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUserRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type RestoreUserResponseObject interface {
+	VisitRestoreUserResponse(w http.ResponseWriter) error
+}
+
+type RestoreUser204Response struct {
+}
+
+func (response RestoreUser204Response) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RestoreUser400JSONResponse struct{ N400JSONResponse }
+
+func (response RestoreUser400JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUser401JSONResponse struct{ N401JSONResponse }
+
+func (response RestoreUser401JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUser403JSONResponse struct{ N403JSONResponse }
+
+func (response RestoreUser403JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUser404JSONResponse struct{ N404JSONResponse }
+
+func (response RestoreUser404JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUser409JSONResponse struct{ N409JSONResponse }
+
+func (response RestoreUser409JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreUser500JSONResponse struct{ N500JSONResponse }
+
+func (response RestoreUser500JSONResponse) VisitRestoreUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeUserTokensRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type RevokeUserTokensResponseObject interface {
+	VisitRevokeUserTokensResponse(w http.ResponseWriter) error
+}
+
+type RevokeUserTokens204Response struct {
+}
+
+func (response RevokeUserTokens204Response) VisitRevokeUserTokensResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeUserTokens401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeUserTokens401JSONResponse) VisitRevokeUserTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeUserTokens403JSONResponse struct{ N403JSONResponse }
+
+func (response RevokeUserTokens403JSONResponse) VisitRevokeUserTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeUserTokens404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeUserTokens404JSONResponse) VisitRevokeUserTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeUserTokens500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeUserTokens500JSONResponse) VisitRevokeUserTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserRolesRequestObject struct {
+	Id     uint64 `json:"id"`
+	Params ListUserRolesParams
+}
+
+type ListUserRolesResponseObject interface {
+	VisitListUserRolesResponse(w http.ResponseWriter) error
+}
+
+type ListUserRoles200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []UserRolesList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListUserRoles200JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserRoles400JSONResponse struct{ N400JSONResponse }
+
+func (response ListUserRoles400JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserRoles401JSONResponse struct{ N401JSONResponse }
+
+func (response ListUserRoles401JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserRoles403JSONResponse struct{ N403JSONResponse }
+
+func (response ListUserRoles403JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserRoles404JSONResponse struct{ N404JSONResponse }
+
+func (response ListUserRoles404JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserRoles409JSONResponse struct{ N409JSONResponse }
+
+func (response ListUserRoles409JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserRoles500JSONResponse struct{ N500JSONResponse }
+
+func (response ListUserRoles500JSONResponse) VisitListUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignRolesRequestObject struct {
+	Id     uint64 `json:"id"`
+	Params AssignRolesParams
+	Body   *AssignRolesJSONRequestBody
+}
+
+type AssignRolesResponseObject interface {
+	VisitAssignRolesResponse(w http.ResponseWriter) error
+}
+
+type AssignRoles204Response struct {
+}
+
+func (response AssignRoles204Response) VisitAssignRolesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AssignRoles400JSONResponse struct{ N400JSONResponse }
+
+func (response AssignRoles400JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignRoles401JSONResponse struct{ N401JSONResponse }
+
+func (response AssignRoles401JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignRoles403JSONResponse struct{ N403JSONResponse }
+
+func (response AssignRoles403JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignRoles404JSONResponse struct{ N404JSONResponse }
+
+func (response AssignRoles404JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignRoles409JSONResponse struct{ N409JSONResponse }
+
+func (response AssignRoles409JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssignRoles500JSONResponse struct{ N500JSONResponse }
+
+func (response AssignRoles500JSONResponse) VisitAssignRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeUserSessionsRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type RevokeUserSessionsResponseObject interface {
+	VisitRevokeUserSessionsResponse(w http.ResponseWriter) error
+}
+
+type RevokeUserSessions204Response struct {
+}
+
+func (response RevokeUserSessions204Response) VisitRevokeUserSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeUserSessions401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeUserSessions401JSONResponse) VisitRevokeUserSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeUserSessions403JSONResponse struct{ N403JSONResponse }
+
+func (response RevokeUserSessions403JSONResponse) VisitRevokeUserSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeUserSessions404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeUserSessions404JSONResponse) VisitRevokeUserSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeUserSessions500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeUserSessions500JSONResponse) VisitRevokeUserSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlockUserRequestObject struct {
+	Id uint64 `json:"id"`
+}
+
+type UnlockUserResponseObject interface {
+	VisitUnlockUserResponse(w http.ResponseWriter) error
+}
+
+type UnlockUser204Response struct {
+}
+
+func (response UnlockUser204Response) VisitUnlockUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnlockUser401JSONResponse struct{ N401JSONResponse }
+
+func (response UnlockUser401JSONResponse) VisitUnlockUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlockUser403JSONResponse struct{ N403JSONResponse }
+
+func (response UnlockUser403JSONResponse) VisitUnlockUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlockUser404JSONResponse struct{ N404JSONResponse }
+
+func (response UnlockUser404JSONResponse) VisitUnlockUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlockUser500JSONResponse struct{ N500JSONResponse }
+
+func (response UnlockUser500JSONResponse) VisitUnlockUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthUserinfoRequestObject struct {
+}
+
+type OauthUserinfoResponseObject interface {
+	VisitOauthUserinfoResponse(w http.ResponseWriter) error
+}
+
+type OauthUserinfo200JSONResponse struct {
+	Email             *openapi_types.Email `json:"email,omitempty"`
+	PreferredUsername *string              `json:"preferred_username,omitempty"`
+	Sub               string               `json:"sub"`
+}
+
+func (response OauthUserinfo200JSONResponse) VisitOauthUserinfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthUserinfo401JSONResponse struct{ N401JSONResponse }
+
+func (response OauthUserinfo401JSONResponse) VisitOauthUserinfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthUserinfo403JSONResponse struct{ N403JSONResponse }
+
+func (response OauthUserinfo403JSONResponse) VisitOauthUserinfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OauthUserinfo500JSONResponse struct{ N500JSONResponse }
+
+func (response OauthUserinfo500JSONResponse) VisitOauthUserinfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserRequestObject struct {
+	Params ListUserParams
+}
+
+type ListUserResponseObject interface {
+	VisitListUserResponse(w http.ResponseWriter) error
+}
+
+type ListUser200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []UserList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListUser200JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUser400JSONResponse struct{ N400JSONResponse }
+
+func (response ListUser400JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUser401JSONResponse struct{ N401JSONResponse }
+
+func (response ListUser401JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUser403JSONResponse struct{ N403JSONResponse }
+
+func (response ListUser403JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUser404JSONResponse struct{ N404JSONResponse }
+
+func (response ListUser404JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUser409JSONResponse struct{ N409JSONResponse }
+
+func (response ListUser409JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUser500JSONResponse struct{ N500JSONResponse }
+
+func (response ListUser500JSONResponse) VisitListUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUserRequestObject struct {
+	Body *CreateUserJSONRequestBody
+}
+
+type CreateUserResponseObject interface {
+	VisitCreateUserResponse(w http.ResponseWriter) error
+}
+
+type CreateUser201JSONResponse UserCreate
+
+func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateUser400JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateUser401JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateUser403JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateUser409JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateUser500JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteWebhook400JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteWebhook409JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhookRequestObject struct {
+	Id uint32 `json:"id"`
+}
+
+type ReadWebhookResponseObject interface {
+	VisitReadWebhookResponse(w http.ResponseWriter) error
+}
+
+type ReadWebhook200JSONResponse WebhookRead
+
+func (response ReadWebhook200JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response ReadWebhook400JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response ReadWebhook401JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response ReadWebhook403JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook404JSONResponse struct{ N404JSONResponse }
+
+func (response ReadWebhook404JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response ReadWebhook409JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response ReadWebhook500JSONResponse) VisitReadWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookRequestObject struct {
+	Id   uint32 `json:"id"`
+	Body *UpdateWebhookJSONRequestBody
+}
+
+type UpdateWebhookResponseObject interface {
+	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+}
+
+type UpdateWebhook200JSONResponse WebhookUpdate
+
+func (response UpdateWebhook200JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateWebhook400JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateWebhook401JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateWebhook403JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateWebhook404JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateWebhook409JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook422JSONResponse struct{ N422JSONResponse }
+
+func (response UpdateWebhook422JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateWebhook500JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	Id     uint32 `json:"id"`
+	Params ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []WebhookDeliveriesList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries400JSONResponse struct{ N400JSONResponse }
+
+func (response ListWebhookDeliveries400JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401JSONResponse struct{ N401JSONResponse }
+
+func (response ListWebhookDeliveries401JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries403JSONResponse struct{ N403JSONResponse }
+
+func (response ListWebhookDeliveries403JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse struct{ N404JSONResponse }
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries409JSONResponse struct{ N409JSONResponse }
+
+func (response ListWebhookDeliveries409JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500JSONResponse struct{ N500JSONResponse }
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookRequestObject struct {
+	Params ListWebhookParams
+}
+
+type ListWebhookResponseObject interface {
+	VisitListWebhookResponse(w http.ResponseWriter) error
+}
+
+type ListWebhook200JSONResponse struct {
+	// CurrentPage Page number (1-based)
+	CurrentPage int `json:"current_page"`
+
+	// Data List of items
+	Data []WebhookList `json:"data"`
+
+	// FirstPageUrl URL to the first page
+	FirstPageUrl string `json:"first_page_url"`
+
+	// From Index (1-based) of the first item in the current page
+	From int `json:"from"`
+
+	// LastPage Last page number
+	LastPage int `json:"last_page"`
+
+	// LastPageUrl URL to the last page
+	LastPageUrl string `json:"last_page_url"`
+
+	// NextPageUrl URL to the next page
+	NextPageUrl string `json:"next_page_url"`
+
+	// Path Base path of the request
+	Path string `json:"path"`
+
+	// PerPage Number of items per page
+	PerPage int `json:"per_page"`
+
+	// PrevPageUrl URL to the previous page
+	PrevPageUrl string `json:"prev_page_url"`
+
+	// To Index (1-based) of the last item in the current page
+	To int `json:"to"`
+
+	// Total Total number of items
+	Total int `json:"total"`
+}
+
+func (response ListWebhook200JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response ListWebhook400JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response ListWebhook401JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response ListWebhook403JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook404JSONResponse struct{ N404JSONResponse }
+
+func (response ListWebhook404JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response ListWebhook409JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response ListWebhook500JSONResponse) VisitListWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Body *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse WebhookCreate
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateWebhook409JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// OpenID Connect discovery document
+	// (GET /.well-known/openid-configuration)
+	GetOpenidConfiguration(ctx context.Context, request GetOpenidConfigurationRequestObject) (GetOpenidConfigurationResponseObject, error)
+	// Find a AccessRequest by ID
+	// (GET /access-request/{id})
+	ReadAccessRequest(ctx context.Context, request ReadAccessRequestRequestObject) (ReadAccessRequestResponseObject, error)
+	// Approve an access request
+	// (POST /access-request/{id}/approve)
+	ApproveAccessRequest(ctx context.Context, request ApproveAccessRequestRequestObject) (ApproveAccessRequestResponseObject, error)
+	// Deny an access request
+	// (POST /access-request/{id}/deny)
+	DenyAccessRequest(ctx context.Context, request DenyAccessRequestRequestObject) (DenyAccessRequestResponseObject, error)
+	// List AccessRequests
+	// (GET /access-requests)
+	ListAccessRequest(ctx context.Context, request ListAccessRequestRequestObject) (ListAccessRequestResponseObject, error)
+	// Request a role
+	// (POST /access-requests)
+	CreateAccessRequest(ctx context.Context, request CreateAccessRequestRequestObject) (CreateAccessRequestResponseObject, error)
 	// Revoke current access token
 	// (DELETE /access-token)
 	RevokeAccessToken(ctx context.Context, request RevokeAccessTokenRequestObject) (RevokeAccessTokenResponseObject, error)
@@ -3598,12 +11565,132 @@ type StrictServerInterface interface {
 	// Refresh current access token
 	// (POST /access-token/refresh)
 	RefreshAccessToken(ctx context.Context, request RefreshAccessTokenRequestObject) (RefreshAccessTokenResponseObject, error)
+	// List AuditLogs
+	// (GET /audit-logs)
+	ListAuditLog(ctx context.Context, request ListAuditLogRequestObject) (ListAuditLogResponseObject, error)
+	// Deletes a Group by ID
+	// (DELETE /group/{id})
+	DeleteGroup(ctx context.Context, request DeleteGroupRequestObject) (DeleteGroupResponseObject, error)
+	// Find a Group by ID
+	// (GET /group/{id})
+	ReadGroup(ctx context.Context, request ReadGroupRequestObject) (ReadGroupResponseObject, error)
+	// Updates a Group
+	// (PATCH /group/{id})
+	UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error)
+	// List attached Roles
+	// (GET /group/{id}/roles)
+	ListGroupRoles(ctx context.Context, request ListGroupRolesRequestObject) (ListGroupRolesResponseObject, error)
+	// Assign roles to group
+	// (POST /group/{id}/roles)
+	AssignGroupRoles(ctx context.Context, request AssignGroupRolesRequestObject) (AssignGroupRolesResponseObject, error)
+	// Remove member from group
+	// (DELETE /group/{id}/user/{user_id})
+	RemoveGroupMember(ctx context.Context, request RemoveGroupMemberRequestObject) (RemoveGroupMemberResponseObject, error)
+	// List attached Users
+	// (GET /group/{id}/users)
+	ListGroupUsers(ctx context.Context, request ListGroupUsersRequestObject) (ListGroupUsersResponseObject, error)
+	// Add members to group
+	// (POST /group/{id}/users)
+	AddGroupMembers(ctx context.Context, request AddGroupMembersRequestObject) (AddGroupMembersResponseObject, error)
+	// List Groups
+	// (GET /groups)
+	ListGroup(ctx context.Context, request ListGroupRequestObject) (ListGroupResponseObject, error)
+	// Create a new Group
+	// (POST /groups)
+	CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error)
 	// Login
 	// (POST /login)
 	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Start signing in with an OpenID Connect provider
+	// (POST /login/oidc/{provider})
+	BeginOidcLogin(ctx context.Context, request BeginOidcLoginRequestObject) (BeginOidcLoginResponseObject, error)
+	// Sign in with the authorization code of the provider
+	// (POST /login/oidc/{provider}/finish)
+	FinishOidcLogin(ctx context.Context, request FinishOidcLoginRequestObject) (FinishOidcLoginResponseObject, error)
+	// Complete login with a one-time code
+	// (POST /login/totp)
+	VerifyTotp(ctx context.Context, request VerifyTotpRequestObject) (VerifyTotpResponseObject, error)
+	// Start signing in with a passkey
+	// (POST /login/webauthn)
+	BeginWebauthnLogin(ctx context.Context, request BeginWebauthnLoginRequestObject) (BeginWebauthnLoginResponseObject, error)
+	// Sign in with the assertion of the authenticator
+	// (POST /login/webauthn/finish)
+	FinishWebauthnLogin(ctx context.Context, request FinishWebauthnLoginRequestObject) (FinishWebauthnLoginResponseObject, error)
 	// Logout
 	// (POST /logout)
 	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
+	// Change password of the current user
+	// (POST /me/password)
+	ChangePassword(ctx context.Context, request ChangePasswordRequestObject) (ChangePasswordResponseObject, error)
+	// List active sessions of the current user
+	// (GET /me/sessions)
+	ListMySessions(ctx context.Context, request ListMySessionsRequestObject) (ListMySessionsResponseObject, error)
+	// Sign out a session of the current user
+	// (DELETE /me/sessions/{id})
+	DeleteMySession(ctx context.Context, request DeleteMySessionRequestObject) (DeleteMySessionResponseObject, error)
+	// Start TOTP enrollment of the current user
+	// (POST /me/totp)
+	EnrollTotp(ctx context.Context, request EnrollTotpRequestObject) (EnrollTotpResponseObject, error)
+	// Enable TOTP with the first code of the authenticator
+	// (POST /me/totp/confirm)
+	ConfirmTotp(ctx context.Context, request ConfirmTotpRequestObject) (ConfirmTotpResponseObject, error)
+	// Disable TOTP of the current user
+	// (POST /me/totp/disable)
+	DisableTotp(ctx context.Context, request DisableTotpRequestObject) (DisableTotpResponseObject, error)
+	// Start registering a passkey of the current user
+	// (POST /me/webauthn/register)
+	BeginWebauthnRegistration(ctx context.Context, request BeginWebauthnRegistrationRequestObject) (BeginWebauthnRegistrationResponseObject, error)
+	// Store the passkey created by the authenticator
+	// (POST /me/webauthn/register/finish)
+	FinishWebauthnRegistration(ctx context.Context, request FinishWebauthnRegistrationRequestObject) (FinishWebauthnRegistrationResponseObject, error)
+	// Deletes a OauthClient by ID
+	// (DELETE /oauth-client/{id})
+	DeleteOauthClient(ctx context.Context, request DeleteOauthClientRequestObject) (DeleteOauthClientResponseObject, error)
+	// Find a OauthClient by ID
+	// (GET /oauth-client/{id})
+	ReadOauthClient(ctx context.Context, request ReadOauthClientRequestObject) (ReadOauthClientResponseObject, error)
+	// List OauthClients
+	// (GET /oauth-clients)
+	ListOauthClient(ctx context.Context, request ListOauthClientRequestObject) (ListOauthClientResponseObject, error)
+	// Create a new OauthClient
+	// (POST /oauth-clients)
+	CreateOauthClient(ctx context.Context, request CreateOauthClientRequestObject) (CreateOauthClientResponseObject, error)
+	// Authorize an OAuth client
+	// (GET /oauth/authorize)
+	OauthAuthorize(ctx context.Context, request OauthAuthorizeRequestObject) (OauthAuthorizeResponseObject, error)
+	// Keys verifying ID tokens
+	// (GET /oauth/jwks)
+	OauthJwks(ctx context.Context, request OauthJwksRequestObject) (OauthJwksResponseObject, error)
+	// Issue tokens to an OAuth client
+	// (POST /oauth/token)
+	OauthToken(ctx context.Context, request OauthTokenRequestObject) (OauthTokenResponseObject, error)
+	// Deletes a Organization by ID
+	// (DELETE /organization/{id})
+	DeleteOrganization(ctx context.Context, request DeleteOrganizationRequestObject) (DeleteOrganizationResponseObject, error)
+	// Find a Organization by ID
+	// (GET /organization/{id})
+	ReadOrganization(ctx context.Context, request ReadOrganizationRequestObject) (ReadOrganizationResponseObject, error)
+	// Updates a Organization
+	// (PATCH /organization/{id})
+	UpdateOrganization(ctx context.Context, request UpdateOrganizationRequestObject) (UpdateOrganizationResponseObject, error)
+	// Revoke the SCIM token of the organization
+	// (DELETE /organization/{id}/scim-token)
+	RevokeScimToken(ctx context.Context, request RevokeScimTokenRequestObject) (RevokeScimTokenResponseObject, error)
+	// Issue the SCIM token of the organization
+	// (POST /organization/{id}/scim-token)
+	IssueScimToken(ctx context.Context, request IssueScimTokenRequestObject) (IssueScimTokenResponseObject, error)
+	// List Organizations
+	// (GET /organizations)
+	ListOrganization(ctx context.Context, request ListOrganizationRequestObject) (ListOrganizationResponseObject, error)
+	// Create a new Organization
+	// (POST /organizations)
+	CreateOrganization(ctx context.Context, request CreateOrganizationRequestObject) (CreateOrganizationResponseObject, error)
+	// Send a password reset token to the email of the user
+	// (POST /password/forgot)
+	ForgotPassword(ctx context.Context, request ForgotPasswordRequestObject) (ForgotPasswordResponseObject, error)
+	// Set a new password with a reset token
+	// (POST /password/reset)
+	ResetPassword(ctx context.Context, request ResetPasswordRequestObject) (ResetPasswordResponseObject, error)
 	// Deletes a Permission by ID
 	// (DELETE /permission/{id})
 	DeletePermission(ctx context.Context, request DeletePermissionRequestObject) (DeletePermissionResponseObject, error)
@@ -3652,6 +11739,9 @@ type StrictServerInterface interface {
 	// Updates a Role
 	// (PATCH /role/{id})
 	UpdateRole(ctx context.Context, request UpdateRoleRequestObject) (UpdateRoleResponseObject, error)
+	// List attached Approvers
+	// (GET /role/{id}/approvers)
+	ListRoleApprovers(ctx context.Context, request ListRoleApproversRequestObject) (ListRoleApproversResponseObject, error)
 	// List attached Permissions
 	// (GET /role/{id}/permissions)
 	ListRolePermissions(ctx context.Context, request ListRolePermissionsRequestObject) (ListRolePermissionsResponseObject, error)
@@ -3667,6 +11757,78 @@ type StrictServerInterface interface {
 	// Create a new Role
 	// (POST /roles)
 	CreateRole(ctx context.Context, request CreateRoleRequestObject) (CreateRoleResponseObject, error)
+	// List SCIM groups
+	// (GET /scim/v2/Groups)
+	ScimListGroups(ctx context.Context, request ScimListGroupsRequestObject) (ScimListGroupsResponseObject, error)
+	// Create SCIM group
+	// (POST /scim/v2/Groups)
+	ScimCreateGroup(ctx context.Context, request ScimCreateGroupRequestObject) (ScimCreateGroupResponseObject, error)
+	// Delete SCIM group
+	// (DELETE /scim/v2/Groups/{id})
+	ScimDeleteGroup(ctx context.Context, request ScimDeleteGroupRequestObject) (ScimDeleteGroupResponseObject, error)
+	// Read SCIM group
+	// (GET /scim/v2/Groups/{id})
+	ScimReadGroup(ctx context.Context, request ScimReadGroupRequestObject) (ScimReadGroupResponseObject, error)
+	// Patch SCIM group
+	// (PATCH /scim/v2/Groups/{id})
+	ScimPatchGroup(ctx context.Context, request ScimPatchGroupRequestObject) (ScimPatchGroupResponseObject, error)
+	// Replace SCIM group
+	// (PUT /scim/v2/Groups/{id})
+	ScimReplaceGroup(ctx context.Context, request ScimReplaceGroupRequestObject) (ScimReplaceGroupResponseObject, error)
+	// SCIM service provider configuration
+	// (GET /scim/v2/ServiceProviderConfig)
+	ScimServiceProviderConfig(ctx context.Context, request ScimServiceProviderConfigRequestObject) (ScimServiceProviderConfigResponseObject, error)
+	// List SCIM users
+	// (GET /scim/v2/Users)
+	ScimListUsers(ctx context.Context, request ScimListUsersRequestObject) (ScimListUsersResponseObject, error)
+	// Create SCIM user
+	// (POST /scim/v2/Users)
+	ScimCreateUser(ctx context.Context, request ScimCreateUserRequestObject) (ScimCreateUserResponseObject, error)
+	// Delete SCIM user
+	// (DELETE /scim/v2/Users/{id})
+	ScimDeleteUser(ctx context.Context, request ScimDeleteUserRequestObject) (ScimDeleteUserResponseObject, error)
+	// Read SCIM user
+	// (GET /scim/v2/Users/{id})
+	ScimReadUser(ctx context.Context, request ScimReadUserRequestObject) (ScimReadUserResponseObject, error)
+	// Patch SCIM user
+	// (PATCH /scim/v2/Users/{id})
+	ScimPatchUser(ctx context.Context, request ScimPatchUserRequestObject) (ScimPatchUserResponseObject, error)
+	// Replace SCIM user
+	// (PUT /scim/v2/Users/{id})
+	ScimReplaceUser(ctx context.Context, request ScimReplaceUserRequestObject) (ScimReplaceUserResponseObject, error)
+	// Deletes a ServiceAccount by ID
+	// (DELETE /service-account/{id})
+	DeleteServiceAccount(ctx context.Context, request DeleteServiceAccountRequestObject) (DeleteServiceAccountResponseObject, error)
+	// Find a ServiceAccount by ID
+	// (GET /service-account/{id})
+	ReadServiceAccount(ctx context.Context, request ReadServiceAccountRequestObject) (ReadServiceAccountResponseObject, error)
+	// List ServiceAccounts
+	// (GET /service-accounts)
+	ListServiceAccount(ctx context.Context, request ListServiceAccountRequestObject) (ListServiceAccountResponseObject, error)
+	// Create a new ServiceAccount
+	// (POST /service-accounts)
+	CreateServiceAccount(ctx context.Context, request CreateServiceAccountRequestObject) (CreateServiceAccountResponseObject, error)
+	// Deletes a SodConstraint by ID
+	// (DELETE /sod-constraint/{id})
+	DeleteSodConstraint(ctx context.Context, request DeleteSodConstraintRequestObject) (DeleteSodConstraintResponseObject, error)
+	// Find a SodConstraint by ID
+	// (GET /sod-constraint/{id})
+	ReadSodConstraint(ctx context.Context, request ReadSodConstraintRequestObject) (ReadSodConstraintResponseObject, error)
+	// Updates a SodConstraint
+	// (PATCH /sod-constraint/{id})
+	UpdateSodConstraint(ctx context.Context, request UpdateSodConstraintRequestObject) (UpdateSodConstraintResponseObject, error)
+	// List attached Roles
+	// (GET /sod-constraint/{id}/roles)
+	ListSodConstraintRoles(ctx context.Context, request ListSodConstraintRolesRequestObject) (ListSodConstraintRolesResponseObject, error)
+	// List users violating the constraint
+	// (GET /sod-constraint/{id}/violations)
+	ListSodViolations(ctx context.Context, request ListSodViolationsRequestObject) (ListSodViolationsResponseObject, error)
+	// List SodConstraints
+	// (GET /sod-constraints)
+	ListSodConstraint(ctx context.Context, request ListSodConstraintRequestObject) (ListSodConstraintResponseObject, error)
+	// Create a new SodConstraint
+	// (POST /sod-constraints)
+	CreateSodConstraint(ctx context.Context, request CreateSodConstraintRequestObject) (CreateSodConstraintResponseObject, error)
 	// Deletes a User by ID
 	// (DELETE /user/{id})
 	DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error)
@@ -3676,44 +11838,2523 @@ type StrictServerInterface interface {
 	// Updates a User
 	// (PATCH /user/{id})
 	UpdateUser(ctx context.Context, request UpdateUserRequestObject) (UpdateUserResponseObject, error)
+	// Disable the user
+	// (POST /user/{id}/disable)
+	DisableUser(ctx context.Context, request DisableUserRequestObject) (DisableUserResponseObject, error)
+	// Enable the disabled user
+	// (POST /user/{id}/enable)
+	EnableUser(ctx context.Context, request EnableUserRequestObject) (EnableUserResponseObject, error)
+	// Issue a short-lived access token to act as the user
+	// (POST /user/{id}/impersonate)
+	Impersonate(ctx context.Context, request ImpersonateRequestObject) (ImpersonateResponseObject, error)
+	// Set a temporary password of the user
+	// (POST /user/{id}/password)
+	SetUserPassword(ctx context.Context, request SetUserPasswordRequestObject) (SetUserPasswordResponseObject, error)
 	// Restore a trashed record
 	// (POST /user/{id}/restore)
 	RestoreUser(ctx context.Context, request RestoreUserRequestObject) (RestoreUserResponseObject, error)
+	// Revoke all tokens of the user
+	// (POST /user/{id}/revoke-tokens)
+	RevokeUserTokens(ctx context.Context, request RevokeUserTokensRequestObject) (RevokeUserTokensResponseObject, error)
 	// List attached Roles
 	// (GET /user/{id}/roles)
 	ListUserRoles(ctx context.Context, request ListUserRolesRequestObject) (ListUserRolesResponseObject, error)
 	// Assign roles to user
 	// (POST /user/{id}/roles)
 	AssignRoles(ctx context.Context, request AssignRolesRequestObject) (AssignRolesResponseObject, error)
+	// Sign out all sessions of the user
+	// (POST /user/{id}/sessions/revoke-all)
+	RevokeUserSessions(ctx context.Context, request RevokeUserSessionsRequestObject) (RevokeUserSessionsResponseObject, error)
+	// Lift the login lockout of the user
+	// (POST /user/{id}/unlock)
+	UnlockUser(ctx context.Context, request UnlockUserRequestObject) (UnlockUserResponseObject, error)
+	// Claims of the user of an OAuth access token
+	// (GET /userinfo)
+	OauthUserinfo(ctx context.Context, request OauthUserinfoRequestObject) (OauthUserinfoResponseObject, error)
 	// List Users
 	// (GET /users)
 	ListUser(ctx context.Context, request ListUserRequestObject) (ListUserResponseObject, error)
 	// Create a new User
 	// (POST /users)
 	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
+	// Deletes a Webhook by ID
+	// (DELETE /webhook/{id})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Find a Webhook by ID
+	// (GET /webhook/{id})
+	ReadWebhook(ctx context.Context, request ReadWebhookRequestObject) (ReadWebhookResponseObject, error)
+	// Updates a Webhook
+	// (PATCH /webhook/{id})
+	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
+	// List attached Deliveries
+	// (GET /webhook/{id}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+	// List Webhooks
+	// (GET /webhooks)
+	ListWebhook(ctx context.Context, request ListWebhookRequestObject) (ListWebhookResponseObject, error)
+	// Create a new Webhook
+	// (POST /webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetOpenidConfiguration operation middleware
+func (sh *strictHandler) GetOpenidConfiguration(ctx *gin.Context) {
+	var request GetOpenidConfigurationRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOpenidConfiguration(ctx, request.(GetOpenidConfigurationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOpenidConfiguration")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetOpenidConfigurationResponseObject); ok {
+		if err := validResponse.VisitGetOpenidConfigurationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadAccessRequest operation middleware
+func (sh *strictHandler) ReadAccessRequest(ctx *gin.Context, id uint64) {
+	var request ReadAccessRequestRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadAccessRequest(ctx, request.(ReadAccessRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadAccessRequest")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReadAccessRequestResponseObject); ok {
+		if err := validResponse.VisitReadAccessRequestResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApproveAccessRequest operation middleware
+func (sh *strictHandler) ApproveAccessRequest(ctx *gin.Context, id uint64) {
+	var request ApproveAccessRequestRequestObject
+
+	request.Id = id
+
+	var body ApproveAccessRequestJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveAccessRequest(ctx, request.(ApproveAccessRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveAccessRequest")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ApproveAccessRequestResponseObject); ok {
+		if err := validResponse.VisitApproveAccessRequestResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DenyAccessRequest operation middleware
+func (sh *strictHandler) DenyAccessRequest(ctx *gin.Context, id uint64) {
+	var request DenyAccessRequestRequestObject
+
+	request.Id = id
+
+	var body DenyAccessRequestJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DenyAccessRequest(ctx, request.(DenyAccessRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DenyAccessRequest")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DenyAccessRequestResponseObject); ok {
+		if err := validResponse.VisitDenyAccessRequestResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAccessRequest operation middleware
+func (sh *strictHandler) ListAccessRequest(ctx *gin.Context, params ListAccessRequestParams) {
+	var request ListAccessRequestRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAccessRequest(ctx, request.(ListAccessRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAccessRequest")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListAccessRequestResponseObject); ok {
+		if err := validResponse.VisitListAccessRequestResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAccessRequest operation middleware
+func (sh *strictHandler) CreateAccessRequest(ctx *gin.Context) {
+	var request CreateAccessRequestRequestObject
+
+	var body CreateAccessRequestJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAccessRequest(ctx, request.(CreateAccessRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAccessRequest")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateAccessRequestResponseObject); ok {
+		if err := validResponse.VisitCreateAccessRequestResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeAccessToken operation middleware
+func (sh *strictHandler) RevokeAccessToken(ctx *gin.Context) {
+	var request RevokeAccessTokenRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeAccessToken(ctx, request.(RevokeAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeAccessToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RevokeAccessTokenResponseObject); ok {
+		if err := validResponse.VisitRevokeAccessTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CheckAccessToken operation middleware
+func (sh *strictHandler) CheckAccessToken(ctx *gin.Context, params CheckAccessTokenParams) {
+	var request CheckAccessTokenRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckAccessToken(ctx, request.(CheckAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckAccessToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CheckAccessTokenResponseObject); ok {
+		if err := validResponse.VisitCheckAccessTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RefreshAccessToken operation middleware
+func (sh *strictHandler) RefreshAccessToken(ctx *gin.Context) {
+	var request RefreshAccessTokenRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RefreshAccessToken(ctx, request.(RefreshAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefreshAccessToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RefreshAccessTokenResponseObject); ok {
+		if err := validResponse.VisitRefreshAccessTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAuditLog operation middleware
+func (sh *strictHandler) ListAuditLog(ctx *gin.Context, params ListAuditLogParams) {
+	var request ListAuditLogRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditLog(ctx, request.(ListAuditLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditLog")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListAuditLogResponseObject); ok {
+		if err := validResponse.VisitListAuditLogResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteGroup operation middleware
+func (sh *strictHandler) DeleteGroup(ctx *gin.Context, id uint32) {
+	var request DeleteGroupRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteGroup(ctx, request.(DeleteGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadGroup operation middleware
+func (sh *strictHandler) ReadGroup(ctx *gin.Context, id uint32) {
+	var request ReadGroupRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadGroup(ctx, request.(ReadGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReadGroupResponseObject); ok {
+		if err := validResponse.VisitReadGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateGroup operation middleware
+func (sh *strictHandler) UpdateGroup(ctx *gin.Context, id uint32) {
+	var request UpdateGroupRequestObject
+
+	request.Id = id
+
+	var body UpdateGroupJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
+		if err := validResponse.VisitUpdateGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListGroupRoles operation middleware
+func (sh *strictHandler) ListGroupRoles(ctx *gin.Context, id uint32, params ListGroupRolesParams) {
+	var request ListGroupRolesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGroupRoles(ctx, request.(ListGroupRolesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGroupRoles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListGroupRolesResponseObject); ok {
+		if err := validResponse.VisitListGroupRolesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AssignGroupRoles operation middleware
+func (sh *strictHandler) AssignGroupRoles(ctx *gin.Context, id uint32) {
+	var request AssignGroupRolesRequestObject
+
+	request.Id = id
+
+	var body AssignGroupRolesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AssignGroupRoles(ctx, request.(AssignGroupRolesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssignGroupRoles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AssignGroupRolesResponseObject); ok {
+		if err := validResponse.VisitAssignGroupRolesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveGroupMember operation middleware
+func (sh *strictHandler) RemoveGroupMember(ctx *gin.Context, id uint32, userId uint64) {
+	var request RemoveGroupMemberRequestObject
+
+	request.Id = id
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveGroupMember(ctx, request.(RemoveGroupMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveGroupMember")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RemoveGroupMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveGroupMemberResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListGroupUsers operation middleware
+func (sh *strictHandler) ListGroupUsers(ctx *gin.Context, id uint32, params ListGroupUsersParams) {
+	var request ListGroupUsersRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGroupUsers(ctx, request.(ListGroupUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGroupUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListGroupUsersResponseObject); ok {
+		if err := validResponse.VisitListGroupUsersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddGroupMembers operation middleware
+func (sh *strictHandler) AddGroupMembers(ctx *gin.Context, id uint32) {
+	var request AddGroupMembersRequestObject
+
+	request.Id = id
+
+	var body AddGroupMembersJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddGroupMembers(ctx, request.(AddGroupMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddGroupMembers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AddGroupMembersResponseObject); ok {
+		if err := validResponse.VisitAddGroupMembersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListGroup operation middleware
+func (sh *strictHandler) ListGroup(ctx *gin.Context, params ListGroupParams) {
+	var request ListGroupRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGroup(ctx, request.(ListGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListGroupResponseObject); ok {
+		if err := validResponse.VisitListGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateGroup operation middleware
+func (sh *strictHandler) CreateGroup(ctx *gin.Context) {
+	var request CreateGroupRequestObject
+
+	var body CreateGroupJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
+		if err := validResponse.VisitCreateGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(ctx *gin.Context) {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BeginOidcLogin operation middleware
+func (sh *strictHandler) BeginOidcLogin(ctx *gin.Context, provider string) {
+	var request BeginOidcLoginRequestObject
+
+	request.Provider = provider
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BeginOidcLogin(ctx, request.(BeginOidcLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BeginOidcLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BeginOidcLoginResponseObject); ok {
+		if err := validResponse.VisitBeginOidcLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FinishOidcLogin operation middleware
+func (sh *strictHandler) FinishOidcLogin(ctx *gin.Context, provider string) {
+	var request FinishOidcLoginRequestObject
+
+	request.Provider = provider
+
+	var body FinishOidcLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.FinishOidcLogin(ctx, request.(FinishOidcLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FinishOidcLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(FinishOidcLoginResponseObject); ok {
+		if err := validResponse.VisitFinishOidcLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyTotp operation middleware
+func (sh *strictHandler) VerifyTotp(ctx *gin.Context) {
+	var request VerifyTotpRequestObject
+
+	var body VerifyTotpJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyTotp(ctx, request.(VerifyTotpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyTotp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(VerifyTotpResponseObject); ok {
+		if err := validResponse.VisitVerifyTotpResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BeginWebauthnLogin operation middleware
+func (sh *strictHandler) BeginWebauthnLogin(ctx *gin.Context) {
+	var request BeginWebauthnLoginRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BeginWebauthnLogin(ctx, request.(BeginWebauthnLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BeginWebauthnLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BeginWebauthnLoginResponseObject); ok {
+		if err := validResponse.VisitBeginWebauthnLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FinishWebauthnLogin operation middleware
+func (sh *strictHandler) FinishWebauthnLogin(ctx *gin.Context) {
+	var request FinishWebauthnLoginRequestObject
+
+	var body FinishWebauthnLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.FinishWebauthnLogin(ctx, request.(FinishWebauthnLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FinishWebauthnLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(FinishWebauthnLoginResponseObject); ok {
+		if err := validResponse.VisitFinishWebauthnLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Logout operation middleware
+func (sh *strictHandler) Logout(ctx *gin.Context) {
+	var request LogoutRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Logout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(LogoutResponseObject); ok {
+		if err := validResponse.VisitLogoutResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ChangePassword operation middleware
+func (sh *strictHandler) ChangePassword(ctx *gin.Context) {
+	var request ChangePasswordRequestObject
+
+	var body ChangePasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangePassword(ctx, request.(ChangePasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangePassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ChangePasswordResponseObject); ok {
+		if err := validResponse.VisitChangePasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListMySessions operation middleware
+func (sh *strictHandler) ListMySessions(ctx *gin.Context) {
+	var request ListMySessionsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMySessions(ctx, request.(ListMySessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMySessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListMySessionsResponseObject); ok {
+		if err := validResponse.VisitListMySessionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteMySession operation middleware
+func (sh *strictHandler) DeleteMySession(ctx *gin.Context, id uint64) {
+	var request DeleteMySessionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMySession(ctx, request.(DeleteMySessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMySession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteMySessionResponseObject); ok {
+		if err := validResponse.VisitDeleteMySessionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EnrollTotp operation middleware
+func (sh *strictHandler) EnrollTotp(ctx *gin.Context) {
+	var request EnrollTotpRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EnrollTotp(ctx, request.(EnrollTotpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EnrollTotp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(EnrollTotpResponseObject); ok {
+		if err := validResponse.VisitEnrollTotpResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfirmTotp operation middleware
+func (sh *strictHandler) ConfirmTotp(ctx *gin.Context) {
+	var request ConfirmTotpRequestObject
+
+	var body ConfirmTotpJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmTotp(ctx, request.(ConfirmTotpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmTotp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ConfirmTotpResponseObject); ok {
+		if err := validResponse.VisitConfirmTotpResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DisableTotp operation middleware
+func (sh *strictHandler) DisableTotp(ctx *gin.Context) {
+	var request DisableTotpRequestObject
+
+	var body DisableTotpJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DisableTotp(ctx, request.(DisableTotpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DisableTotp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DisableTotpResponseObject); ok {
+		if err := validResponse.VisitDisableTotpResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BeginWebauthnRegistration operation middleware
+func (sh *strictHandler) BeginWebauthnRegistration(ctx *gin.Context) {
+	var request BeginWebauthnRegistrationRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BeginWebauthnRegistration(ctx, request.(BeginWebauthnRegistrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BeginWebauthnRegistration")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BeginWebauthnRegistrationResponseObject); ok {
+		if err := validResponse.VisitBeginWebauthnRegistrationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FinishWebauthnRegistration operation middleware
+func (sh *strictHandler) FinishWebauthnRegistration(ctx *gin.Context) {
+	var request FinishWebauthnRegistrationRequestObject
+
+	var body FinishWebauthnRegistrationJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.FinishWebauthnRegistration(ctx, request.(FinishWebauthnRegistrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FinishWebauthnRegistration")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(FinishWebauthnRegistrationResponseObject); ok {
+		if err := validResponse.VisitFinishWebauthnRegistrationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOauthClient operation middleware
+func (sh *strictHandler) DeleteOauthClient(ctx *gin.Context, id uint32) {
+	var request DeleteOauthClientRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOauthClient(ctx, request.(DeleteOauthClientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOauthClient")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteOauthClientResponseObject); ok {
+		if err := validResponse.VisitDeleteOauthClientResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadOauthClient operation middleware
+func (sh *strictHandler) ReadOauthClient(ctx *gin.Context, id uint32) {
+	var request ReadOauthClientRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadOauthClient(ctx, request.(ReadOauthClientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadOauthClient")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReadOauthClientResponseObject); ok {
+		if err := validResponse.VisitReadOauthClientResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOauthClient operation middleware
+func (sh *strictHandler) ListOauthClient(ctx *gin.Context, params ListOauthClientParams) {
+	var request ListOauthClientRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOauthClient(ctx, request.(ListOauthClientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOauthClient")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListOauthClientResponseObject); ok {
+		if err := validResponse.VisitListOauthClientResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOauthClient operation middleware
+func (sh *strictHandler) CreateOauthClient(ctx *gin.Context) {
+	var request CreateOauthClientRequestObject
+
+	var body CreateOauthClientJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOauthClient(ctx, request.(CreateOauthClientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOauthClient")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateOauthClientResponseObject); ok {
+		if err := validResponse.VisitCreateOauthClientResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// OauthAuthorize operation middleware
+func (sh *strictHandler) OauthAuthorize(ctx *gin.Context, params OauthAuthorizeParams) {
+	var request OauthAuthorizeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.OauthAuthorize(ctx, request.(OauthAuthorizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OauthAuthorize")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(OauthAuthorizeResponseObject); ok {
+		if err := validResponse.VisitOauthAuthorizeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// OauthJwks operation middleware
+func (sh *strictHandler) OauthJwks(ctx *gin.Context) {
+	var request OauthJwksRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.OauthJwks(ctx, request.(OauthJwksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OauthJwks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(OauthJwksResponseObject); ok {
+		if err := validResponse.VisitOauthJwksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// OauthToken operation middleware
+func (sh *strictHandler) OauthToken(ctx *gin.Context) {
+	var request OauthTokenRequestObject
+
+	if err := ctx.Request.ParseForm(); err != nil {
+		ctx.Error(err)
+		return
+	}
+	var body OauthTokenFormdataRequestBody
+	if err := runtime.BindForm(&body, ctx.Request.Form, nil, nil); err != nil {
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.OauthToken(ctx, request.(OauthTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OauthToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(OauthTokenResponseObject); ok {
+		if err := validResponse.VisitOauthTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOrganization operation middleware
+func (sh *strictHandler) DeleteOrganization(ctx *gin.Context, id uint32) {
+	var request DeleteOrganizationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOrganization(ctx, request.(DeleteOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOrganization")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteOrganizationResponseObject); ok {
+		if err := validResponse.VisitDeleteOrganizationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadOrganization operation middleware
+func (sh *strictHandler) ReadOrganization(ctx *gin.Context, id uint32) {
+	var request ReadOrganizationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadOrganization(ctx, request.(ReadOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadOrganization")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReadOrganizationResponseObject); ok {
+		if err := validResponse.VisitReadOrganizationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateOrganization operation middleware
+func (sh *strictHandler) UpdateOrganization(ctx *gin.Context, id uint32) {
+	var request UpdateOrganizationRequestObject
+
+	request.Id = id
+
+	var body UpdateOrganizationJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganization(ctx, request.(UpdateOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganization")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateOrganizationResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeScimToken operation middleware
+func (sh *strictHandler) RevokeScimToken(ctx *gin.Context, id uint32) {
+	var request RevokeScimTokenRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeScimToken(ctx, request.(RevokeScimTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeScimToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RevokeScimTokenResponseObject); ok {
+		if err := validResponse.VisitRevokeScimTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// IssueScimToken operation middleware
+func (sh *strictHandler) IssueScimToken(ctx *gin.Context, id uint32) {
+	var request IssueScimTokenRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.IssueScimToken(ctx, request.(IssueScimTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IssueScimToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(IssueScimTokenResponseObject); ok {
+		if err := validResponse.VisitIssueScimTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOrganization operation middleware
+func (sh *strictHandler) ListOrganization(ctx *gin.Context, params ListOrganizationParams) {
+	var request ListOrganizationRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrganization(ctx, request.(ListOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrganization")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListOrganizationResponseObject); ok {
+		if err := validResponse.VisitListOrganizationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrganization operation middleware
+func (sh *strictHandler) CreateOrganization(ctx *gin.Context) {
+	var request CreateOrganizationRequestObject
+
+	var body CreateOrganizationJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganization(ctx, request.(CreateOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganization")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateOrganizationResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ForgotPassword operation middleware
+func (sh *strictHandler) ForgotPassword(ctx *gin.Context) {
+	var request ForgotPasswordRequestObject
+
+	var body ForgotPasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ForgotPassword(ctx, request.(ForgotPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ForgotPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ForgotPasswordResponseObject); ok {
+		if err := validResponse.VisitForgotPasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResetPassword operation middleware
+func (sh *strictHandler) ResetPassword(ctx *gin.Context) {
+	var request ResetPasswordRequestObject
+
+	var body ResetPasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ResetPassword(ctx, request.(ResetPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResetPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ResetPasswordResponseObject); ok {
+		if err := validResponse.VisitResetPasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePermission operation middleware
+func (sh *strictHandler) DeletePermission(ctx *gin.Context, id uint32) {
+	var request DeletePermissionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePermission(ctx, request.(DeletePermissionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePermission")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeletePermissionResponseObject); ok {
+		if err := validResponse.VisitDeletePermissionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadPermission operation middleware
+func (sh *strictHandler) ReadPermission(ctx *gin.Context, id uint32, params ReadPermissionParams) {
+	var request ReadPermissionRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadPermission(ctx, request.(ReadPermissionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadPermission")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReadPermissionResponseObject); ok {
+		if err := validResponse.VisitReadPermissionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdatePermission operation middleware
+func (sh *strictHandler) UpdatePermission(ctx *gin.Context, id uint32) {
+	var request UpdatePermissionRequestObject
+
+	request.Id = id
+
+	var body UpdatePermissionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePermission(ctx, request.(UpdatePermissionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePermission")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdatePermissionResponseObject); ok {
+		if err := validResponse.VisitUpdatePermissionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPermission operation middleware
+func (sh *strictHandler) ListPermission(ctx *gin.Context, params ListPermissionParams) {
+	var request ListPermissionRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPermission(ctx, request.(ListPermissionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPermission")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListPermissionResponseObject); ok {
+		if err := validResponse.VisitListPermissionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePermission operation middleware
+func (sh *strictHandler) CreatePermission(ctx *gin.Context) {
+	var request CreatePermissionRequestObject
+
+	var body CreatePermissionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePermission(ctx, request.(CreatePermissionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePermission")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreatePermissionResponseObject); ok {
+		if err := validResponse.VisitCreatePermissionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePersonalToken operation middleware
+func (sh *strictHandler) DeletePersonalToken(ctx *gin.Context, id uint64) {
+	var request DeletePersonalTokenRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePersonalToken(ctx, request.(DeletePersonalTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePersonalToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeletePersonalTokenResponseObject); ok {
+		if err := validResponse.VisitDeletePersonalTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadPersonalToken operation middleware
+func (sh *strictHandler) ReadPersonalToken(ctx *gin.Context, id uint64) {
+	var request ReadPersonalTokenRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadPersonalToken(ctx, request.(ReadPersonalTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadPersonalToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReadPersonalTokenResponseObject); ok {
+		if err := validResponse.VisitReadPersonalTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPersonalToken operation middleware
+func (sh *strictHandler) ListPersonalToken(ctx *gin.Context, params ListPersonalTokenParams) {
+	var request ListPersonalTokenRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPersonalToken(ctx, request.(ListPersonalTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPersonalToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListPersonalTokenResponseObject); ok {
+		if err := validResponse.VisitListPersonalTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePersonalToken operation middleware
+func (sh *strictHandler) CreatePersonalToken(ctx *gin.Context) {
+	var request CreatePersonalTokenRequestObject
+
+	var body CreatePersonalTokenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePersonalToken(ctx, request.(CreatePersonalTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePersonalToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreatePersonalTokenResponseObject); ok {
+		if err := validResponse.VisitCreatePersonalTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Ping operation middleware
+func (sh *strictHandler) Ping(ctx *gin.Context) {
+	var request PingRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Ping(ctx, request.(PingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Ping")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PingResponseObject); ok {
+		if err := validResponse.VisitPingResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HintPermissions operation middleware
+func (sh *strictHandler) HintPermissions(ctx *gin.Context, params HintPermissionsParams) {
+	var request HintPermissionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.HintPermissions(ctx, request.(HintPermissionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HintPermissions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(HintPermissionsResponseObject); ok {
+		if err := validResponse.VisitHintPermissionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HintRoles operation middleware
+func (sh *strictHandler) HintRoles(ctx *gin.Context, params HintRolesParams) {
+	var request HintRolesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.HintRoles(ctx, request.(HintRolesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HintRoles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(HintRolesResponseObject); ok {
+		if err := validResponse.VisitHintRolesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// HintUsers operation middleware
+func (sh *strictHandler) HintUsers(ctx *gin.Context, params HintUsersParams) {
+	var request HintUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.HintUsers(ctx, request.(HintUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HintUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(HintUsersResponseObject); ok {
+		if err := validResponse.VisitHintUsersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteRole operation middleware
+func (sh *strictHandler) DeleteRole(ctx *gin.Context, id uint32) {
+	var request DeleteRoleRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRole(ctx, request.(DeleteRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteRoleResponseObject); ok {
+		if err := validResponse.VisitDeleteRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadRole operation middleware
+func (sh *strictHandler) ReadRole(ctx *gin.Context, id uint32) {
+	var request ReadRoleRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadRole(ctx, request.(ReadRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReadRoleResponseObject); ok {
+		if err := validResponse.VisitReadRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateRole operation middleware
+func (sh *strictHandler) UpdateRole(ctx *gin.Context, id uint32) {
+	var request UpdateRoleRequestObject
+
+	request.Id = id
+
+	var body UpdateRoleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateRole(ctx, request.(UpdateRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateRoleResponseObject); ok {
+		if err := validResponse.VisitUpdateRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRoleApprovers operation middleware
+func (sh *strictHandler) ListRoleApprovers(ctx *gin.Context, id uint32, params ListRoleApproversParams) {
+	var request ListRoleApproversRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRoleApprovers(ctx, request.(ListRoleApproversRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRoleApprovers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListRoleApproversResponseObject); ok {
+		if err := validResponse.VisitListRoleApproversResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRolePermissions operation middleware
+func (sh *strictHandler) ListRolePermissions(ctx *gin.Context, id uint32, params ListRolePermissionsParams) {
+	var request ListRolePermissionsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRolePermissions(ctx, request.(ListRolePermissionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRolePermissions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListRolePermissionsResponseObject); ok {
+		if err := validResponse.VisitListRolePermissionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AssignPermissions operation middleware
+func (sh *strictHandler) AssignPermissions(ctx *gin.Context, id uint32) {
+	var request AssignPermissionsRequestObject
+
+	request.Id = id
+
+	var body AssignPermissionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AssignPermissions(ctx, request.(AssignPermissionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssignPermissions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AssignPermissionsResponseObject); ok {
+		if err := validResponse.VisitAssignPermissionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRoleUsers operation middleware
+func (sh *strictHandler) ListRoleUsers(ctx *gin.Context, id uint32, params ListRoleUsersParams) {
+	var request ListRoleUsersRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRoleUsers(ctx, request.(ListRoleUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRoleUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListRoleUsersResponseObject); ok {
+		if err := validResponse.VisitListRoleUsersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRole operation middleware
+func (sh *strictHandler) ListRole(ctx *gin.Context, params ListRoleParams) {
+	var request ListRoleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRole(ctx, request.(ListRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListRoleResponseObject); ok {
+		if err := validResponse.VisitListRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateRole operation middleware
+func (sh *strictHandler) CreateRole(ctx *gin.Context) {
+	var request CreateRoleRequestObject
+
+	var body CreateRoleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRole(ctx, request.(CreateRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRole")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateRoleResponseObject); ok {
+		if err := validResponse.VisitCreateRoleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScimListGroups operation middleware
+func (sh *strictHandler) ScimListGroups(ctx *gin.Context, params ScimListGroupsParams) {
+	var request ScimListGroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScimListGroups(ctx, request.(ScimListGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScimListGroups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ScimListGroupsResponseObject); ok {
+		if err := validResponse.VisitScimListGroupsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScimCreateGroup operation middleware
+func (sh *strictHandler) ScimCreateGroup(ctx *gin.Context) {
+	var request ScimCreateGroupRequestObject
+
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
+
+		var body ScimCreateGroupJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/scim+json") {
+
+		var body ScimCreateGroupApplicationScimPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScimCreateGroup(ctx, request.(ScimCreateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScimCreateGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ScimCreateGroupResponseObject); ok {
+		if err := validResponse.VisitScimCreateGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScimDeleteGroup operation middleware
+func (sh *strictHandler) ScimDeleteGroup(ctx *gin.Context, id uint32) {
+	var request ScimDeleteGroupRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScimDeleteGroup(ctx, request.(ScimDeleteGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScimDeleteGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ScimDeleteGroupResponseObject); ok {
+		if err := validResponse.VisitScimDeleteGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScimReadGroup operation middleware
+func (sh *strictHandler) ScimReadGroup(ctx *gin.Context, id uint32) {
+	var request ScimReadGroupRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScimReadGroup(ctx, request.(ScimReadGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScimReadGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ScimReadGroupResponseObject); ok {
+		if err := validResponse.VisitScimReadGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScimPatchGroup operation middleware
+func (sh *strictHandler) ScimPatchGroup(ctx *gin.Context, id uint32) {
+	var request ScimPatchGroupRequestObject
+
+	request.Id = id
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
+
+		var body ScimPatchGroupJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/scim+json") {
+
+		var body ScimPatchGroupApplicationScimPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScimPatchGroup(ctx, request.(ScimPatchGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScimPatchGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ScimPatchGroupResponseObject); ok {
+		if err := validResponse.VisitScimPatchGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScimReplaceGroup operation middleware
+func (sh *strictHandler) ScimReplaceGroup(ctx *gin.Context, id uint32) {
+	var request ScimReplaceGroupRequestObject
+
+	request.Id = id
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
+
+		var body ScimReplaceGroupJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/scim+json") {
+
+		var body ScimReplaceGroupApplicationScimPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScimReplaceGroup(ctx, request.(ScimReplaceGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScimReplaceGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ScimReplaceGroupResponseObject); ok {
+		if err := validResponse.VisitScimReplaceGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScimServiceProviderConfig operation middleware
+func (sh *strictHandler) ScimServiceProviderConfig(ctx *gin.Context) {
+	var request ScimServiceProviderConfigRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScimServiceProviderConfig(ctx, request.(ScimServiceProviderConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScimServiceProviderConfig")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ScimServiceProviderConfigResponseObject); ok {
+		if err := validResponse.VisitScimServiceProviderConfigResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScimListUsers operation middleware
+func (sh *strictHandler) ScimListUsers(ctx *gin.Context, params ScimListUsersParams) {
+	var request ScimListUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ScimListUsers(ctx, request.(ScimListUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScimListUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ScimListUsersResponseObject); ok {
+		if err := validResponse.VisitScimListUsersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+// ScimCreateUser operation middleware
+func (sh *strictHandler) ScimCreateUser(ctx *gin.Context) {
+	var request ScimCreateUserRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+		var body ScimCreateUserJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/scim+json") {
 
-// RevokeAccessToken operation middleware
-func (sh *strictHandler) RevokeAccessToken(ctx *gin.Context) {
-	var request RevokeAccessTokenRequestObject
+		var body ScimCreateUserApplicationScimPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeAccessToken(ctx, request.(RevokeAccessTokenRequestObject))
+		return sh.ssi.ScimCreateUser(ctx, request.(ScimCreateUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeAccessToken")
+		handler = middleware(handler, "ScimCreateUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -3721,8 +14362,8 @@ func (sh *strictHandler) RevokeAccessToken(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RevokeAccessTokenResponseObject); ok {
-		if err := validResponse.VisitRevokeAccessTokenResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ScimCreateUserResponseObject); ok {
+		if err := validResponse.VisitScimCreateUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3730,15 +14371,17 @@ func (sh *strictHandler) RevokeAccessToken(ctx *gin.Context) {
 	}
 }
 
-// CheckAccessToken operation middleware
-func (sh *strictHandler) CheckAccessToken(ctx *gin.Context) {
-	var request CheckAccessTokenRequestObject
+// ScimDeleteUser operation middleware
+func (sh *strictHandler) ScimDeleteUser(ctx *gin.Context, id uint64) {
+	var request ScimDeleteUserRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CheckAccessToken(ctx, request.(CheckAccessTokenRequestObject))
+		return sh.ssi.ScimDeleteUser(ctx, request.(ScimDeleteUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CheckAccessToken")
+		handler = middleware(handler, "ScimDeleteUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -3746,8 +14389,8 @@ func (sh *strictHandler) CheckAccessToken(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CheckAccessTokenResponseObject); ok {
-		if err := validResponse.VisitCheckAccessTokenResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ScimDeleteUserResponseObject); ok {
+		if err := validResponse.VisitScimDeleteUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3755,15 +14398,17 @@ func (sh *strictHandler) CheckAccessToken(ctx *gin.Context) {
 	}
 }
 
-// RefreshAccessToken operation middleware
-func (sh *strictHandler) RefreshAccessToken(ctx *gin.Context) {
-	var request RefreshAccessTokenRequestObject
+// ScimReadUser operation middleware
+func (sh *strictHandler) ScimReadUser(ctx *gin.Context, id uint64) {
+	var request ScimReadUserRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RefreshAccessToken(ctx, request.(RefreshAccessTokenRequestObject))
+		return sh.ssi.ScimReadUser(ctx, request.(ScimReadUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RefreshAccessToken")
+		handler = middleware(handler, "ScimReadUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -3771,8 +14416,8 @@ func (sh *strictHandler) RefreshAccessToken(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RefreshAccessTokenResponseObject); ok {
-		if err := validResponse.VisitRefreshAccessTokenResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ScimReadUserResponseObject); ok {
+		if err := validResponse.VisitScimReadUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3780,23 +14425,37 @@ func (sh *strictHandler) RefreshAccessToken(ctx *gin.Context) {
 	}
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(ctx *gin.Context) {
-	var request LoginRequestObject
+// ScimPatchUser operation middleware
+func (sh *strictHandler) ScimPatchUser(ctx *gin.Context, id uint64) {
+	var request ScimPatchUserRequestObject
 
-	var body LoginJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusUnprocessableEntity)
-		ctx.Error(err)
-		return
+	request.Id = id
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
+
+		var body ScimPatchUserJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/scim+json") {
+
+		var body ScimPatchUserApplicationScimPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
 	}
-	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+		return sh.ssi.ScimPatchUser(ctx, request.(ScimPatchUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "ScimPatchUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -3804,8 +14463,8 @@ func (sh *strictHandler) Login(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ScimPatchUserResponseObject); ok {
+		if err := validResponse.VisitScimPatchUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3813,15 +14472,37 @@ func (sh *strictHandler) Login(ctx *gin.Context) {
 	}
 }
 
-// Logout operation middleware
-func (sh *strictHandler) Logout(ctx *gin.Context) {
-	var request LogoutRequestObject
+// ScimReplaceUser operation middleware
+func (sh *strictHandler) ScimReplaceUser(ctx *gin.Context, id uint64) {
+	var request ScimReplaceUserRequestObject
+
+	request.Id = id
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
+
+		var body ScimReplaceUserJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/scim+json") {
+
+		var body ScimReplaceUserApplicationScimPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusUnprocessableEntity)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationScimPlusJSONBody = &body
+	}
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+		return sh.ssi.ScimReplaceUser(ctx, request.(ScimReplaceUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Logout")
+		handler = middleware(handler, "ScimReplaceUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -3829,8 +14510,8 @@ func (sh *strictHandler) Logout(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(LogoutResponseObject); ok {
-		if err := validResponse.VisitLogoutResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ScimReplaceUserResponseObject); ok {
+		if err := validResponse.VisitScimReplaceUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3838,17 +14519,17 @@ func (sh *strictHandler) Logout(ctx *gin.Context) {
 	}
 }
 
-// DeletePermission operation middleware
-func (sh *strictHandler) DeletePermission(ctx *gin.Context, id uint32) {
-	var request DeletePermissionRequestObject
+// DeleteServiceAccount operation middleware
+func (sh *strictHandler) DeleteServiceAccount(ctx *gin.Context, id uint32) {
+	var request DeleteServiceAccountRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePermission(ctx, request.(DeletePermissionRequestObject))
+		return sh.ssi.DeleteServiceAccount(ctx, request.(DeleteServiceAccountRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePermission")
+		handler = middleware(handler, "DeleteServiceAccount")
 	}
 
 	response, err := handler(ctx, request)
@@ -3856,8 +14537,8 @@ func (sh *strictHandler) DeletePermission(ctx *gin.Context, id uint32) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeletePermissionResponseObject); ok {
-		if err := validResponse.VisitDeletePermissionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteServiceAccountResponseObject); ok {
+		if err := validResponse.VisitDeleteServiceAccountResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3865,18 +14546,17 @@ func (sh *strictHandler) DeletePermission(ctx *gin.Context, id uint32) {
 	}
 }
 
-// ReadPermission operation middleware
-func (sh *strictHandler) ReadPermission(ctx *gin.Context, id uint32, params ReadPermissionParams) {
-	var request ReadPermissionRequestObject
+// ReadServiceAccount operation middleware
+func (sh *strictHandler) ReadServiceAccount(ctx *gin.Context, id uint32) {
+	var request ReadServiceAccountRequestObject
 
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadPermission(ctx, request.(ReadPermissionRequestObject))
+		return sh.ssi.ReadServiceAccount(ctx, request.(ReadServiceAccountRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReadPermission")
+		handler = middleware(handler, "ReadServiceAccount")
 	}
 
 	response, err := handler(ctx, request)
@@ -3884,8 +14564,8 @@ func (sh *strictHandler) ReadPermission(ctx *gin.Context, id uint32, params Read
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ReadPermissionResponseObject); ok {
-		if err := validResponse.VisitReadPermissionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ReadServiceAccountResponseObject); ok {
+		if err := validResponse.VisitReadServiceAccountResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3893,13 +14573,38 @@ func (sh *strictHandler) ReadPermission(ctx *gin.Context, id uint32, params Read
 	}
 }
 
-// UpdatePermission operation middleware
-func (sh *strictHandler) UpdatePermission(ctx *gin.Context, id uint32) {
-	var request UpdatePermissionRequestObject
+// ListServiceAccount operation middleware
+func (sh *strictHandler) ListServiceAccount(ctx *gin.Context, params ListServiceAccountParams) {
+	var request ListServiceAccountRequestObject
 
-	request.Id = id
+	request.Params = params
 
-	var body UpdatePermissionJSONRequestBody
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListServiceAccount(ctx, request.(ListServiceAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListServiceAccount")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListServiceAccountResponseObject); ok {
+		if err := validResponse.VisitListServiceAccountResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateServiceAccount operation middleware
+func (sh *strictHandler) CreateServiceAccount(ctx *gin.Context) {
+	var request CreateServiceAccountRequestObject
+
+	var body CreateServiceAccountJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusUnprocessableEntity)
 		ctx.Error(err)
@@ -3908,10 +14613,10 @@ func (sh *strictHandler) UpdatePermission(ctx *gin.Context, id uint32) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdatePermission(ctx, request.(UpdatePermissionRequestObject))
+		return sh.ssi.CreateServiceAccount(ctx, request.(CreateServiceAccountRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdatePermission")
+		handler = middleware(handler, "CreateServiceAccount")
 	}
 
 	response, err := handler(ctx, request)
@@ -3919,8 +14624,8 @@ func (sh *strictHandler) UpdatePermission(ctx *gin.Context, id uint32) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdatePermissionResponseObject); ok {
-		if err := validResponse.VisitUpdatePermissionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateServiceAccountResponseObject); ok {
+		if err := validResponse.VisitCreateServiceAccountResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3928,17 +14633,17 @@ func (sh *strictHandler) UpdatePermission(ctx *gin.Context, id uint32) {
 	}
 }
 
-// ListPermission operation middleware
-func (sh *strictHandler) ListPermission(ctx *gin.Context, params ListPermissionParams) {
-	var request ListPermissionRequestObject
+// DeleteSodConstraint operation middleware
+func (sh *strictHandler) DeleteSodConstraint(ctx *gin.Context, id uint32) {
+	var request DeleteSodConstraintRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPermission(ctx, request.(ListPermissionRequestObject))
+		return sh.ssi.DeleteSodConstraint(ctx, request.(DeleteSodConstraintRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPermission")
+		handler = middleware(handler, "DeleteSodConstraint")
 	}
 
 	response, err := handler(ctx, request)
@@ -3946,8 +14651,8 @@ func (sh *strictHandler) ListPermission(ctx *gin.Context, params ListPermissionP
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListPermissionResponseObject); ok {
-		if err := validResponse.VisitListPermissionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteSodConstraintResponseObject); ok {
+		if err := validResponse.VisitDeleteSodConstraintResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3955,11 +14660,40 @@ func (sh *strictHandler) ListPermission(ctx *gin.Context, params ListPermissionP
 	}
 }
 
-// CreatePermission operation middleware
-func (sh *strictHandler) CreatePermission(ctx *gin.Context) {
-	var request CreatePermissionRequestObject
+// ReadSodConstraint operation middleware
+func (sh *strictHandler) ReadSodConstraint(ctx *gin.Context, id uint32) {
+	var request ReadSodConstraintRequestObject
 
-	var body CreatePermissionJSONRequestBody
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadSodConstraint(ctx, request.(ReadSodConstraintRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadSodConstraint")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReadSodConstraintResponseObject); ok {
+		if err := validResponse.VisitReadSodConstraintResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateSodConstraint operation middleware
+func (sh *strictHandler) UpdateSodConstraint(ctx *gin.Context, id uint32) {
+	var request UpdateSodConstraintRequestObject
+
+	request.Id = id
+
+	var body UpdateSodConstraintJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusUnprocessableEntity)
 		ctx.Error(err)
@@ -3968,10 +14702,10 @@ func (sh *strictHandler) CreatePermission(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePermission(ctx, request.(CreatePermissionRequestObject))
+		return sh.ssi.UpdateSodConstraint(ctx, request.(UpdateSodConstraintRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePermission")
+		handler = middleware(handler, "UpdateSodConstraint")
 	}
 
 	response, err := handler(ctx, request)
@@ -3979,8 +14713,8 @@ func (sh *strictHandler) CreatePermission(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreatePermissionResponseObject); ok {
-		if err := validResponse.VisitCreatePermissionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateSodConstraintResponseObject); ok {
+		if err := validResponse.VisitUpdateSodConstraintResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3988,17 +14722,18 @@ func (sh *strictHandler) CreatePermission(ctx *gin.Context) {
 	}
 }
 
-// DeletePersonalToken operation middleware
-func (sh *strictHandler) DeletePersonalToken(ctx *gin.Context, id uint64) {
-	var request DeletePersonalTokenRequestObject
+// ListSodConstraintRoles operation middleware
+func (sh *strictHandler) ListSodConstraintRoles(ctx *gin.Context, id uint32, params ListSodConstraintRolesParams) {
+	var request ListSodConstraintRolesRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePersonalToken(ctx, request.(DeletePersonalTokenRequestObject))
+		return sh.ssi.ListSodConstraintRoles(ctx, request.(ListSodConstraintRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePersonalToken")
+		handler = middleware(handler, "ListSodConstraintRoles")
 	}
 
 	response, err := handler(ctx, request)
@@ -4006,8 +14741,8 @@ func (sh *strictHandler) DeletePersonalToken(ctx *gin.Context, id uint64) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeletePersonalTokenResponseObject); ok {
-		if err := validResponse.VisitDeletePersonalTokenResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListSodConstraintRolesResponseObject); ok {
+		if err := validResponse.VisitListSodConstraintRolesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4015,17 +14750,17 @@ func (sh *strictHandler) DeletePersonalToken(ctx *gin.Context, id uint64) {
 	}
 }
 
-// ReadPersonalToken operation middleware
-func (sh *strictHandler) ReadPersonalToken(ctx *gin.Context, id uint64) {
-	var request ReadPersonalTokenRequestObject
+// ListSodViolations operation middleware
+func (sh *strictHandler) ListSodViolations(ctx *gin.Context, id uint32) {
+	var request ListSodViolationsRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadPersonalToken(ctx, request.(ReadPersonalTokenRequestObject))
+		return sh.ssi.ListSodViolations(ctx, request.(ListSodViolationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReadPersonalToken")
+		handler = middleware(handler, "ListSodViolations")
 	}
 
 	response, err := handler(ctx, request)
@@ -4033,8 +14768,8 @@ func (sh *strictHandler) ReadPersonalToken(ctx *gin.Context, id uint64) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ReadPersonalTokenResponseObject); ok {
-		if err := validResponse.VisitReadPersonalTokenResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListSodViolationsResponseObject); ok {
+		if err := validResponse.VisitListSodViolationsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4042,17 +14777,17 @@ func (sh *strictHandler) ReadPersonalToken(ctx *gin.Context, id uint64) {
 	}
 }
 
-// ListPersonalToken operation middleware
-func (sh *strictHandler) ListPersonalToken(ctx *gin.Context, params ListPersonalTokenParams) {
-	var request ListPersonalTokenRequestObject
+// ListSodConstraint operation middleware
+func (sh *strictHandler) ListSodConstraint(ctx *gin.Context, params ListSodConstraintParams) {
+	var request ListSodConstraintRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPersonalToken(ctx, request.(ListPersonalTokenRequestObject))
+		return sh.ssi.ListSodConstraint(ctx, request.(ListSodConstraintRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPersonalToken")
+		handler = middleware(handler, "ListSodConstraint")
 	}
 
 	response, err := handler(ctx, request)
@@ -4060,8 +14795,8 @@ func (sh *strictHandler) ListPersonalToken(ctx *gin.Context, params ListPersonal
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListPersonalTokenResponseObject); ok {
-		if err := validResponse.VisitListPersonalTokenResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListSodConstraintResponseObject); ok {
+		if err := validResponse.VisitListSodConstraintResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4069,11 +14804,11 @@ func (sh *strictHandler) ListPersonalToken(ctx *gin.Context, params ListPersonal
 	}
 }
 
-// CreatePersonalToken operation middleware
-func (sh *strictHandler) CreatePersonalToken(ctx *gin.Context) {
-	var request CreatePersonalTokenRequestObject
+// CreateSodConstraint operation middleware
+func (sh *strictHandler) CreateSodConstraint(ctx *gin.Context) {
+	var request CreateSodConstraintRequestObject
 
-	var body CreatePersonalTokenJSONRequestBody
+	var body CreateSodConstraintJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusUnprocessableEntity)
 		ctx.Error(err)
@@ -4082,10 +14817,10 @@ func (sh *strictHandler) CreatePersonalToken(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePersonalToken(ctx, request.(CreatePersonalTokenRequestObject))
+		return sh.ssi.CreateSodConstraint(ctx, request.(CreateSodConstraintRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePersonalToken")
+		handler = middleware(handler, "CreateSodConstraint")
 	}
 
 	response, err := handler(ctx, request)
@@ -4093,8 +14828,64 @@ func (sh *strictHandler) CreatePersonalToken(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreatePersonalTokenResponseObject); ok {
-		if err := validResponse.VisitCreatePersonalTokenResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateSodConstraintResponseObject); ok {
+		if err := validResponse.VisitCreateSodConstraintResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUser operation middleware
+func (sh *strictHandler) DeleteUser(ctx *gin.Context, id uint64, params DeleteUserParams) {
+	var request DeleteUserRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUser(ctx, request.(DeleteUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteUserResponseObject); ok {
+		if err := validResponse.VisitDeleteUserResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadUser operation middleware
+func (sh *strictHandler) ReadUser(ctx *gin.Context, id uint64, params ReadUserParams) {
+	var request ReadUserRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadUser(ctx, request.(ReadUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ReadUserResponseObject); ok {
+		if err := validResponse.VisitReadUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4102,15 +14893,25 @@ func (sh *strictHandler) CreatePersonalToken(ctx *gin.Context) {
 	}
 }
 
-// Ping operation middleware
-func (sh *strictHandler) Ping(ctx *gin.Context) {
-	var request PingRequestObject
+// UpdateUser operation middleware
+func (sh *strictHandler) UpdateUser(ctx *gin.Context, id uint64) {
+	var request UpdateUserRequestObject
+
+	request.Id = id
+
+	var body UpdateUserJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.Ping(ctx, request.(PingRequestObject))
+		return sh.ssi.UpdateUser(ctx, request.(UpdateUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Ping")
+		handler = middleware(handler, "UpdateUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -4118,8 +14919,8 @@ func (sh *strictHandler) Ping(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PingResponseObject); ok {
-		if err := validResponse.VisitPingResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateUserResponseObject); ok {
+		if err := validResponse.VisitUpdateUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4127,17 +14928,17 @@ func (sh *strictHandler) Ping(ctx *gin.Context) {
 	}
 }
 
-// HintPermissions operation middleware
-func (sh *strictHandler) HintPermissions(ctx *gin.Context, params HintPermissionsParams) {
-	var request HintPermissionsRequestObject
+// DisableUser operation middleware
+func (sh *strictHandler) DisableUser(ctx *gin.Context, id uint64) {
+	var request DisableUserRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.HintPermissions(ctx, request.(HintPermissionsRequestObject))
+		return sh.ssi.DisableUser(ctx, request.(DisableUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HintPermissions")
+		handler = middleware(handler, "DisableUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -4145,8 +14946,8 @@ func (sh *strictHandler) HintPermissions(ctx *gin.Context, params HintPermission
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(HintPermissionsResponseObject); ok {
-		if err := validResponse.VisitHintPermissionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DisableUserResponseObject); ok {
+		if err := validResponse.VisitDisableUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4154,17 +14955,17 @@ func (sh *strictHandler) HintPermissions(ctx *gin.Context, params HintPermission
 	}
 }
 
-// HintRoles operation middleware
-func (sh *strictHandler) HintRoles(ctx *gin.Context, params HintRolesParams) {
-	var request HintRolesRequestObject
+// EnableUser operation middleware
+func (sh *strictHandler) EnableUser(ctx *gin.Context, id uint64) {
+	var request EnableUserRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.HintRoles(ctx, request.(HintRolesRequestObject))
+		return sh.ssi.EnableUser(ctx, request.(EnableUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HintRoles")
+		handler = middleware(handler, "EnableUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -4172,8 +14973,8 @@ func (sh *strictHandler) HintRoles(ctx *gin.Context, params HintRolesParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(HintRolesResponseObject); ok {
-		if err := validResponse.VisitHintRolesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(EnableUserResponseObject); ok {
+		if err := validResponse.VisitEnableUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4181,17 +14982,17 @@ func (sh *strictHandler) HintRoles(ctx *gin.Context, params HintRolesParams) {
 	}
 }
 
-// HintUsers operation middleware
-func (sh *strictHandler) HintUsers(ctx *gin.Context, params HintUsersParams) {
-	var request HintUsersRequestObject
+// Impersonate operation middleware
+func (sh *strictHandler) Impersonate(ctx *gin.Context, id uint64) {
+	var request ImpersonateRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.HintUsers(ctx, request.(HintUsersRequestObject))
+		return sh.ssi.Impersonate(ctx, request.(ImpersonateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HintUsers")
+		handler = middleware(handler, "Impersonate")
 	}
 
 	response, err := handler(ctx, request)
@@ -4199,8 +15000,8 @@ func (sh *strictHandler) HintUsers(ctx *gin.Context, params HintUsersParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(HintUsersResponseObject); ok {
-		if err := validResponse.VisitHintUsersResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ImpersonateResponseObject); ok {
+		if err := validResponse.VisitImpersonateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4208,17 +15009,25 @@ func (sh *strictHandler) HintUsers(ctx *gin.Context, params HintUsersParams) {
 	}
 }
 
-// DeleteRole operation middleware
-func (sh *strictHandler) DeleteRole(ctx *gin.Context, id uint32) {
-	var request DeleteRoleRequestObject
+// SetUserPassword operation middleware
+func (sh *strictHandler) SetUserPassword(ctx *gin.Context, id uint64) {
+	var request SetUserPasswordRequestObject
 
 	request.Id = id
 
+	var body SetUserPasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteRole(ctx, request.(DeleteRoleRequestObject))
+		return sh.ssi.SetUserPassword(ctx, request.(SetUserPasswordRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteRole")
+		handler = middleware(handler, "SetUserPassword")
 	}
 
 	response, err := handler(ctx, request)
@@ -4226,8 +15035,8 @@ func (sh *strictHandler) DeleteRole(ctx *gin.Context, id uint32) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteRoleResponseObject); ok {
-		if err := validResponse.VisitDeleteRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(SetUserPasswordResponseObject); ok {
+		if err := validResponse.VisitSetUserPasswordResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4235,17 +15044,17 @@ func (sh *strictHandler) DeleteRole(ctx *gin.Context, id uint32) {
 	}
 }
 
-// ReadRole operation middleware
-func (sh *strictHandler) ReadRole(ctx *gin.Context, id uint32) {
-	var request ReadRoleRequestObject
+// RestoreUser operation middleware
+func (sh *strictHandler) RestoreUser(ctx *gin.Context, id uint64) {
+	var request RestoreUserRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadRole(ctx, request.(ReadRoleRequestObject))
+		return sh.ssi.RestoreUser(ctx, request.(RestoreUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReadRole")
+		handler = middleware(handler, "RestoreUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -4253,8 +15062,8 @@ func (sh *strictHandler) ReadRole(ctx *gin.Context, id uint32) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ReadRoleResponseObject); ok {
-		if err := validResponse.VisitReadRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(RestoreUserResponseObject); ok {
+		if err := validResponse.VisitRestoreUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4262,25 +15071,17 @@ func (sh *strictHandler) ReadRole(ctx *gin.Context, id uint32) {
 	}
 }
 
-// UpdateRole operation middleware
-func (sh *strictHandler) UpdateRole(ctx *gin.Context, id uint32) {
-	var request UpdateRoleRequestObject
+// RevokeUserTokens operation middleware
+func (sh *strictHandler) RevokeUserTokens(ctx *gin.Context, id uint64) {
+	var request RevokeUserTokensRequestObject
 
 	request.Id = id
 
-	var body UpdateRoleJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusUnprocessableEntity)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateRole(ctx, request.(UpdateRoleRequestObject))
+		return sh.ssi.RevokeUserTokens(ctx, request.(RevokeUserTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateRole")
+		handler = middleware(handler, "RevokeUserTokens")
 	}
 
 	response, err := handler(ctx, request)
@@ -4288,8 +15089,8 @@ func (sh *strictHandler) UpdateRole(ctx *gin.Context, id uint32) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdateRoleResponseObject); ok {
-		if err := validResponse.VisitUpdateRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(RevokeUserTokensResponseObject); ok {
+		if err := validResponse.VisitRevokeUserTokensResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4297,18 +15098,18 @@ func (sh *strictHandler) UpdateRole(ctx *gin.Context, id uint32) {
 	}
 }
 
-// ListRolePermissions operation middleware
-func (sh *strictHandler) ListRolePermissions(ctx *gin.Context, id uint32, params ListRolePermissionsParams) {
-	var request ListRolePermissionsRequestObject
+// ListUserRoles operation middleware
+func (sh *strictHandler) ListUserRoles(ctx *gin.Context, id uint64, params ListUserRolesParams) {
+	var request ListUserRolesRequestObject
 
 	request.Id = id
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListRolePermissions(ctx, request.(ListRolePermissionsRequestObject))
+		return sh.ssi.ListUserRoles(ctx, request.(ListUserRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListRolePermissions")
+		handler = middleware(handler, "ListUserRoles")
 	}
 
 	response, err := handler(ctx, request)
@@ -4316,8 +15117,8 @@ func (sh *strictHandler) ListRolePermissions(ctx *gin.Context, id uint32, params
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListRolePermissionsResponseObject); ok {
-		if err := validResponse.VisitListRolePermissionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListUserRolesResponseObject); ok {
+		if err := validResponse.VisitListUserRolesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4325,13 +15126,14 @@ func (sh *strictHandler) ListRolePermissions(ctx *gin.Context, id uint32, params
 	}
 }
 
-// AssignPermissions operation middleware
-func (sh *strictHandler) AssignPermissions(ctx *gin.Context, id uint32) {
-	var request AssignPermissionsRequestObject
+// AssignRoles operation middleware
+func (sh *strictHandler) AssignRoles(ctx *gin.Context, id uint64, params AssignRolesParams) {
+	var request AssignRolesRequestObject
 
 	request.Id = id
+	request.Params = params
 
-	var body AssignPermissionsJSONRequestBody
+	var body AssignRolesJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusUnprocessableEntity)
 		ctx.Error(err)
@@ -4340,10 +15142,10 @@ func (sh *strictHandler) AssignPermissions(ctx *gin.Context, id uint32) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AssignPermissions(ctx, request.(AssignPermissionsRequestObject))
+		return sh.ssi.AssignRoles(ctx, request.(AssignRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AssignPermissions")
+		handler = middleware(handler, "AssignRoles")
 	}
 
 	response, err := handler(ctx, request)
@@ -4351,8 +15153,8 @@ func (sh *strictHandler) AssignPermissions(ctx *gin.Context, id uint32) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AssignPermissionsResponseObject); ok {
-		if err := validResponse.VisitAssignPermissionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(AssignRolesResponseObject); ok {
+		if err := validResponse.VisitAssignRolesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4360,18 +15162,17 @@ func (sh *strictHandler) AssignPermissions(ctx *gin.Context, id uint32) {
 	}
 }
 
-// ListRoleUsers operation middleware
-func (sh *strictHandler) ListRoleUsers(ctx *gin.Context, id uint32, params ListRoleUsersParams) {
-	var request ListRoleUsersRequestObject
+// RevokeUserSessions operation middleware
+func (sh *strictHandler) RevokeUserSessions(ctx *gin.Context, id uint64) {
+	var request RevokeUserSessionsRequestObject
 
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListRoleUsers(ctx, request.(ListRoleUsersRequestObject))
+		return sh.ssi.RevokeUserSessions(ctx, request.(RevokeUserSessionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListRoleUsers")
+		handler = middleware(handler, "RevokeUserSessions")
 	}
 
 	response, err := handler(ctx, request)
@@ -4379,8 +15180,8 @@ func (sh *strictHandler) ListRoleUsers(ctx *gin.Context, id uint32, params ListR
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListRoleUsersResponseObject); ok {
-		if err := validResponse.VisitListRoleUsersResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(RevokeUserSessionsResponseObject); ok {
+		if err := validResponse.VisitRevokeUserSessionsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4388,17 +15189,17 @@ func (sh *strictHandler) ListRoleUsers(ctx *gin.Context, id uint32, params ListR
 	}
 }
 
-// ListRole operation middleware
-func (sh *strictHandler) ListRole(ctx *gin.Context, params ListRoleParams) {
-	var request ListRoleRequestObject
+// UnlockUser operation middleware
+func (sh *strictHandler) UnlockUser(ctx *gin.Context, id uint64) {
+	var request UnlockUserRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListRole(ctx, request.(ListRoleRequestObject))
+		return sh.ssi.UnlockUser(ctx, request.(UnlockUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListRole")
+		handler = middleware(handler, "UnlockUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -4406,8 +15207,8 @@ func (sh *strictHandler) ListRole(ctx *gin.Context, params ListRoleParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListRoleResponseObject); ok {
-		if err := validResponse.VisitListRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UnlockUserResponseObject); ok {
+		if err := validResponse.VisitUnlockUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4415,23 +15216,15 @@ func (sh *strictHandler) ListRole(ctx *gin.Context, params ListRoleParams) {
 	}
 }
 
-// CreateRole operation middleware
-func (sh *strictHandler) CreateRole(ctx *gin.Context) {
-	var request CreateRoleRequestObject
-
-	var body CreateRoleJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusUnprocessableEntity)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+// OauthUserinfo operation middleware
+func (sh *strictHandler) OauthUserinfo(ctx *gin.Context) {
+	var request OauthUserinfoRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateRole(ctx, request.(CreateRoleRequestObject))
+		return sh.ssi.OauthUserinfo(ctx, request.(OauthUserinfoRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateRole")
+		handler = middleware(handler, "OauthUserinfo")
 	}
 
 	response, err := handler(ctx, request)
@@ -4439,8 +15232,8 @@ func (sh *strictHandler) CreateRole(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateRoleResponseObject); ok {
-		if err := validResponse.VisitCreateRoleResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(OauthUserinfoResponseObject); ok {
+		if err := validResponse.VisitOauthUserinfoResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4448,18 +15241,17 @@ func (sh *strictHandler) CreateRole(ctx *gin.Context) {
 	}
 }
 
-// DeleteUser operation middleware
-func (sh *strictHandler) DeleteUser(ctx *gin.Context, id uint64, params DeleteUserParams) {
-	var request DeleteUserRequestObject
+// ListUser operation middleware
+func (sh *strictHandler) ListUser(ctx *gin.Context, params ListUserParams) {
+	var request ListUserRequestObject
 
-	request.Id = id
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteUser(ctx, request.(DeleteUserRequestObject))
+		return sh.ssi.ListUser(ctx, request.(ListUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteUser")
+		handler = middleware(handler, "ListUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -4467,8 +15259,8 @@ func (sh *strictHandler) DeleteUser(ctx *gin.Context, id uint64, params DeleteUs
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteUserResponseObject); ok {
-		if err := validResponse.VisitDeleteUserResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListUserResponseObject); ok {
+		if err := validResponse.VisitListUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4476,18 +15268,23 @@ func (sh *strictHandler) DeleteUser(ctx *gin.Context, id uint64, params DeleteUs
 	}
 }
 
-// ReadUser operation middleware
-func (sh *strictHandler) ReadUser(ctx *gin.Context, id uint64, params ReadUserParams) {
-	var request ReadUserRequestObject
+// CreateUser operation middleware
+func (sh *strictHandler) CreateUser(ctx *gin.Context) {
+	var request CreateUserRequestObject
 
-	request.Id = id
-	request.Params = params
+	var body CreateUserJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadUser(ctx, request.(ReadUserRequestObject))
+		return sh.ssi.CreateUser(ctx, request.(CreateUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReadUser")
+		handler = middleware(handler, "CreateUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -4495,8 +15292,8 @@ func (sh *strictHandler) ReadUser(ctx *gin.Context, id uint64, params ReadUserPa
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ReadUserResponseObject); ok {
-		if err := validResponse.VisitReadUserResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateUserResponseObject); ok {
+		if err := validResponse.VisitCreateUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4504,25 +15301,17 @@ func (sh *strictHandler) ReadUser(ctx *gin.Context, id uint64, params ReadUserPa
 	}
 }
 
-// UpdateUser operation middleware
-func (sh *strictHandler) UpdateUser(ctx *gin.Context, id uint64) {
-	var request UpdateUserRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(ctx *gin.Context, id uint32) {
+	var request DeleteWebhookRequestObject
 
 	request.Id = id
 
-	var body UpdateUserJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusUnprocessableEntity)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateUser(ctx, request.(UpdateUserRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateUser")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(ctx, request)
@@ -4530,8 +15319,8 @@ func (sh *strictHandler) UpdateUser(ctx *gin.Context, id uint64) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdateUserResponseObject); ok {
-		if err := validResponse.VisitUpdateUserResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4539,17 +15328,17 @@ func (sh *strictHandler) UpdateUser(ctx *gin.Context, id uint64) {
 	}
 }
 
-// RestoreUser operation middleware
-func (sh *strictHandler) RestoreUser(ctx *gin.Context, id uint64) {
-	var request RestoreUserRequestObject
+// ReadWebhook operation middleware
+func (sh *strictHandler) ReadWebhook(ctx *gin.Context, id uint32) {
+	var request ReadWebhookRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RestoreUser(ctx, request.(RestoreUserRequestObject))
+		return sh.ssi.ReadWebhook(ctx, request.(ReadWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RestoreUser")
+		handler = middleware(handler, "ReadWebhook")
 	}
 
 	response, err := handler(ctx, request)
@@ -4557,8 +15346,8 @@ func (sh *strictHandler) RestoreUser(ctx *gin.Context, id uint64) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RestoreUserResponseObject); ok {
-		if err := validResponse.VisitRestoreUserResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ReadWebhookResponseObject); ok {
+		if err := validResponse.VisitReadWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4566,18 +15355,25 @@ func (sh *strictHandler) RestoreUser(ctx *gin.Context, id uint64) {
 	}
 }
 
-// ListUserRoles operation middleware
-func (sh *strictHandler) ListUserRoles(ctx *gin.Context, id uint64, params ListUserRolesParams) {
-	var request ListUserRolesRequestObject
+// UpdateWebhook operation middleware
+func (sh *strictHandler) UpdateWebhook(ctx *gin.Context, id uint32) {
+	var request UpdateWebhookRequestObject
 
 	request.Id = id
-	request.Params = params
+
+	var body UpdateWebhookJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusUnprocessableEntity)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListUserRoles(ctx, request.(ListUserRolesRequestObject))
+		return sh.ssi.UpdateWebhook(ctx, request.(UpdateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListUserRoles")
+		handler = middleware(handler, "UpdateWebhook")
 	}
 
 	response, err := handler(ctx, request)
@@ -4585,8 +15381,8 @@ func (sh *strictHandler) ListUserRoles(ctx *gin.Context, id uint64, params ListU
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListUserRolesResponseObject); ok {
-		if err := validResponse.VisitListUserRolesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateWebhookResponseObject); ok {
+		if err := validResponse.VisitUpdateWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4594,25 +15390,18 @@ func (sh *strictHandler) ListUserRoles(ctx *gin.Context, id uint64, params ListU
 	}
 }
 
-// AssignRoles operation middleware
-func (sh *strictHandler) AssignRoles(ctx *gin.Context, id uint64) {
-	var request AssignRolesRequestObject
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(ctx *gin.Context, id uint32, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
 
 	request.Id = id
-
-	var body AssignRolesJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusUnprocessableEntity)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AssignRoles(ctx, request.(AssignRolesRequestObject))
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AssignRoles")
+		handler = middleware(handler, "ListWebhookDeliveries")
 	}
 
 	response, err := handler(ctx, request)
@@ -4620,8 +15409,8 @@ func (sh *strictHandler) AssignRoles(ctx *gin.Context, id uint64) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AssignRolesResponseObject); ok {
-		if err := validResponse.VisitAssignRolesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4629,17 +15418,17 @@ func (sh *strictHandler) AssignRoles(ctx *gin.Context, id uint64) {
 	}
 }
 
-// ListUser operation middleware
-func (sh *strictHandler) ListUser(ctx *gin.Context, params ListUserParams) {
-	var request ListUserRequestObject
+// ListWebhook operation middleware
+func (sh *strictHandler) ListWebhook(ctx *gin.Context, params ListWebhookParams) {
+	var request ListWebhookRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListUser(ctx, request.(ListUserRequestObject))
+		return sh.ssi.ListWebhook(ctx, request.(ListWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListUser")
+		handler = middleware(handler, "ListWebhook")
 	}
 
 	response, err := handler(ctx, request)
@@ -4647,8 +15436,8 @@ func (sh *strictHandler) ListUser(ctx *gin.Context, params ListUserParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListUserResponseObject); ok {
-		if err := validResponse.VisitListUserResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListWebhookResponseObject); ok {
+		if err := validResponse.VisitListWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4656,11 +15445,11 @@ func (sh *strictHandler) ListUser(ctx *gin.Context, params ListUserParams) {
 	}
 }
 
-// CreateUser operation middleware
-func (sh *strictHandler) CreateUser(ctx *gin.Context) {
-	var request CreateUserRequestObject
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(ctx *gin.Context) {
+	var request CreateWebhookRequestObject
 
-	var body CreateUserJSONRequestBody
+	var body CreateWebhookJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusUnprocessableEntity)
 		ctx.Error(err)
@@ -4669,10 +15458,10 @@ func (sh *strictHandler) CreateUser(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateUser(ctx, request.(CreateUserRequestObject))
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateUser")
+		handler = middleware(handler, "CreateWebhook")
 	}
 
 	response, err := handler(ctx, request)
@@ -4680,8 +15469,8 @@ func (sh *strictHandler) CreateUser(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateUserResponseObject); ok {
-		if err := validResponse.VisitCreateUserResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4692,60 +15481,196 @@ func (sh *strictHandler) CreateUser(ctx *gin.Context) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdW2/cuBX+K4RaoC2g+Jpsd+fNiVs0gLEIknX7sAgMWjozw42GVEjKlwbz3xckpRnq",
-	"To1lz8V8SjKheDn8zuF3LqJ+BBFbpIwClSKY/Ag4iJRRAfofb09O1B8RoxKoVH/FaZqQCEvC6PEfglH1",
-	"m4jmsMDqbylnKXBJzNMRi0H9KR9TCCYBoRJmwINlGADnjKs2yzAQEstMWO2E5ITOguUyDDh8zwiHOJj8",
-	"bnpbNf8aFs3Z7R8QyWCp2scgIk5SNTs94B1OSIwITTMZohhLjPLf1CTenpzu8eKuKc7knHHyf8hXc77X",
-	"WyWy6ZREBKhEKfAFEYIwKszK3u7xyjgIlvEIEGUSTVlG8936ZY/XFDE6TUgkCZ2hYn1mq87O9lqlUs4i",
-	"EALfJoD+RSWRj2r0d3ttBTMKDylEEmKkB9Rdmsnq8S4iteTf2DegDZPngCXEN1gve8r4Qv0tiLGEN5Is",
-	"1FQq8w0DEpfaZoTKn94GYbAglCyyRTA5DRuEwe4pcPXgXzlMg0nwl+P1uXScT/f4WpjGmQB+s8k4FWmS",
-	"OFh3VhdmGHxamaJxZFPamh/BAj9cAZ3JeTA5e/fOTZbnZ72ypHgBTd0vCC3+fdowGGeJWRmRsBB9e/GZ",
-	"JaCeyrvBnGOtLVkaDxRL06boJXTvyAct/1ewLy8m0SsipJfnePL8DDj28hxPntd6IC/RESQqGMXJiId+",
-	"RZwNp3K7BM4OiESUJdEr/Gc8w7a0BbLAVB3Uzy/vYvheuT/bSbclqe8Clp/ttHtdMtW8+vCPODvI4+py",
-	"WN7YKI6H2Rb38YuTozzyoKNX7a53XMahMUqW3mUZS5LeWRlLkt5NGU+WN2ubL7yujyZVdZK1yBNLyeu/",
-	"xpDKfhmIbLHA/DGYBJeQYi4XQCX6eBk0SSeBO0hqXf7c2uMXiDJO5CP6kGAONAJ0pXvo5V966sV4TRLZ",
-	"BEGwwKQ8e/PLeOxzQzbjALozJwCtOmsSmWZCdejoVMKN9sHcWZWdgGigdR6PO4HHNHf1hu5uOdbUsL8c",
-	"phzEfGTUbCuX8dJK2OZLeK3xVtwJQJ4EePg8AT7NXqOHj5sXksD6mXLVxiWWgDCNkXoY3c+BIjkHxCFi",
-	"PEb3WKD86SBsHo1mSYJvEwgmkmfgwdsE3jZH3cPXWz8nAN0o4ujjEiPEJVRbQqesbgkvkCCLNAGEMzkH",
-	"KvO6OySA35EIEJui+eMtJzG6eH/xQdvMz+8vPqjZEKnsX/Cl6/kgDO6Amwqv4OTo5OhUJ5JToDglwSQ4",
-	"Pzo5Og/CIMVyrvf12Hi5b1aJTmOI6xP/DHfsG6Ao41zZAPMY0o/pWeaOj/kl0GNyPbWP8epp28sJy7XZ",
-	"Z6Ystjzml0w/MM2S5BFx3UVcGtkUn560OUSrEY5Vo3WRdF/bU6sEua/tuVVT2d1WNdLViisL2CFTteF4",
-	"JhTQbKl9XYbBDDRkywL+MIfo25PkqyvJlUockoT/my9qmIyXYVkvjnNwa7PIhGzSDoP+RvXIhCkt7lYQ",
-	"/b9P1BDdx6HpSLtkOzcwYTNC23fsYm0/AWGkDsfcjsmMU4Rb9ulK92pOAhDyPYsfn1BNnWIh7hmPWytM",
-	"isOt+yhatQzXPTYfSuunFI9e1iA2rDS8L7us3amGIu4Saq2DrHj3Y2/BatBRwI9lsh1/H2lhcLUrpLbw",
-	"b6KK7hr2VJeD7ULCZjOIkXp2J0WmJ6Zktq6iOP5B4mUXHbnUvwstu3VSDd0TOc9dS62fEKOPl0c1SZqn",
-	"rQIMpTgcL0DqAorfa5t1qYhZeaxAUbxgoqlUwQQnhhWWtSy0NGYYuV1+ddnr6vLtpZd86x3RrfwNqL62",
-	"b603i/ra/vIEABZIwjaObh9zLzc/XqxttyhYeR/+TWjsBkjroBGIyKMGNoDj3UdnWJ3IdX4OFdNRRq2Y",
-	"yPcM+ON6JtaRtR5/mIv79RlPr0rVe8MZ1qt11ttxXucqOqdUZZDCpVhG87rKmZBXRXeVcqXKCRZSoGiO",
-	"6UydEwwJyTieQV3bTC97chpsRjtxHBP1Xzj5ZBHQKU4EhLXA4LCozWivZw0N/NTqFntfHrRAsl6zgoYJ",
-	"BgUvyY9r74F0zzcPVx26Pclfue1pe3b2BNvTZDPaDE+ZlGoMNB79V0RIqztRtzGqhbuFuZ9jiVI8AwVO",
-	"DjRuPUdVo/IZOujEVsqHIpZRuR5JGU+U99s4JPCb+rD4wQxb6P6ASfxqUYa0ZlEro2+ZNFSi4iYoYcRR",
-	"J+ZqA2m2uAWO/n765hYLiP/Ra9ZiLHELwtgUGWsZDq0w10H9hpKQKeHCTP8m40nDAfv5SgFD7YxuWuCi",
-	"Zs6nnC2anNwYHtZrLzbZdKWxR0wmsIju5N2vJHTSmGjCok3kVzifYy73Xmmv+updf4I7lk/hwbEb1bK1",
-	"G80eak+/xwKQ+q9CfjkLCJpfRGgRza8GiAWGbC3vqZXicOe2NtWSsEy0rk8yZ4xocW8MEckkbpjrb+rn",
-	"QiUtberqrHplga3yxUChbRTX8MzVQq+7pmtV7FVBVBV8WHBLbSBc7kr4hGeE6oh+kluP2l0k3jOphqMq",
-	"J3mHS9IY1jMVbIpdULhv9UqI7HRITCcluuCJfwfxt/WzIyPbSq4lQya/7eABnD6DB5CXPXZPMs/A747e",
-	"PrsuGrHUVKmHrOv63DxvNzCKvC7tHRxItqqCh0QPSo89MYDQ/yanazi5KgcfUd48omzJsiHGZe2/W1y5",
-	"D6KuoeU9xeuo8ZfKy97N5rdXF3yc1yXOO0AN6na8P/Cy7qA99uIO+YMOv7y2CEjlmgwfBPFBEB8Eec1B",
-	"ECJEBsYZV6ahVHPjwyLdYRHrnO0kso7BEYsVbBQfKR3pLx8iefJVPiJiaSUYUjcXldNKyiadJgtNVhJy",
-	"B8pWCIgYjYX9Ok09uHL+08mJw8sW9oVU+YTNLByjLNYmby3QUrujrHeqPtxSUq5Osq6Q2sbQdWE6IuY8",
-	"K95yIALxjFL1XFW3P5kfHUoc631tvvZPRVnt8XenhG/hYWM0hXvjAtrBdeMqzsgdUMUHpuShts7/ECrL",
-	"ceZOl0QqxqRsImAezVtcg++dzvfYqdBRUpB1NSx4fk2oe14b/D0j0bd8/8rLMrBbxcWdAaefcISafrXr",
-	"UEG2ujprMLyM0A8IWPmCDKRW98E5Q0o/4QgpfevOoUJqdZnAYEgZoR8QpPIFKUgpdA1KqijNHJZL0ZeX",
-	"OIek89a7UYi/XqxPmGyYMNEirAaI9SY7pEfaweaUFdkX5I2XA1ldlNhg1DrA7DMevRmPbhz3VrPrxzes",
-	"Y99hGB9KCUvbbb9PK2Rpur938H3lg2viNdS2Xg1vXTTaNkdfAT9+BXyu/RUDVWJ67vXvWEoczSHuL4RX",
-	"4wyIfby8tQpHzAi/YBbYl9pvLdHceLuvzzX7XLPPNb/mXPPqTOSaZvnye5c8cxORaHSj8iRzmWBcCEFm",
-	"dCN6wXffGRqraN7hLpi+a0WwFjSUE1+KwOTXD+8bvDeErMFbsxDKTLo7HF+Gvg6ut7Nnp9i7583Pwps7",
-	"brXwjHkgY15/ucFzZc+VPVf2XLngyjuVRt1pllxwgZYoXg/j0IUh7UTD31TRRgNsZ8HTgCfRAE8APAHw",
-	"BMATAJsA+LO/4+wvqjlbQ2Jd713UiwucXrfI2YDP4m8hi7/RBRd6n7fzxoX1XeC2ib3y9yvaM+8KPoNq",
-	"LBX/H1ZjeW0iWI7Bw2sr3vWcb/vX2Pb/5iDnwBWGCY2SLAYkOdYX3K8oZgP7zts0RfhuGUsAU9eCzrVk",
-	"fUHnhgWdWoTVQjiNKIeCznZkOxV0epg/a/Vo1w3/HZrjq0d7q0e7laa3evS6+JbFBtWju6kzL1M9+vo+",
-	"lDbgo2fbuK9ZA3nrtanWt/Xa5uhrU8evTc1tS8X8lRiy6kkyDl3fZdINEC6+NSnnWOpjqIgGJY9IsKm0",
-	"PkBZJRK6hx22i72vJeVf2Ww8jXMJ+gO57etTBX4KSmZwFFSB2J9oWUW3OjIumlK5vKm7A7T1EEs7fE5n",
-	"lJxO5WOiPrPjMzs+s+MzO2BejvfpHefSjmqeZx0D6Ch9Hkggst334Xex6NlcvqI8UmHGel3lzpXlF2y4",
-	"hwN3VDW7OFivt9jI1xyPRkw9JfWU1FNST0ltSurZaAcbrdYX10hoV7FRPRflVGyUswGf9Nlu0sf+Pv2q",
-	"9erH0nW3/zwrMY2fXyCFVP5E/jDas+kH9BuSP9spsVJDt5dY6Ym98hKrlgTScvlnAAAA//9mS0yHJbQA",
-	"AA==",
+	"H4sIAAAAAAAC/+x97XPbNtbvv4LRvTP7PHPl2HHdbJtvrtPd9TbZ5NrJ9sNOR4ZJSEJNASoA2vHTyf/+",
+	"DF5IgiTAN1OWZOFDp7FIgMDBwcE5v/OCPycRXa0pQUTwyds/JwzxNSUcqT/OTk7k/yJKBCJC/hOu1wmO",
+	"oMCUHP/OKZG/8WiJVlD+a83oGjGBdeuIxkj+Xzyu0eTtBBOBFohNvk0niDHK5DvfphMuoEi59R4XDJPF",
+	"5Nu36YShP1LMUDx5+x/dW/76b9PsdXr7O4rE5Jt8P0Y8YngtR6c+eA8THANM1qmYghgKCMxvchBnJ6/3",
+	"eHJfCEzFkjL8P8jM5ru9Xiqezuc4wogIsEZshTnHlHA9s7M9nhlDnKYsQoBQAeY0JWa1ftzjOUWUzBMc",
+	"CUwWIJufXqrT073eUmtGI8Q5vE0Q+JkILB71rPZ5sT5TCj5A8giu0B8p4oJPppMlgjFSn55cIcEej87n",
+	"AjH5Z7ntNYooiTkQFDxALMAtmlOGgGCPcunhAmIysccnWIqmFiWqc1bD+36vD5WUoK9rFAkUA/VB1aUe",
+	"rPreeSQ5yND6PebCMQWGoEDxDKpnc8pW8l+TGAp0JPAKTabVUU8nccqgHoHVIsVEfHc6mU5WmOBVupq8",
+	"fT11EAbHtVZvzlpb/Z5ygedmWWQHK/j1PSILsZy8fX1ycuIYJGULSPD/qBYzHA8ZK9OEQ2w2bNQM3WP0",
+	"MIvoamWYq33Yuk3PBTGNBo+TJmggiQrejtEcpolsukYklgObThCRDf9j/QLXa0bvUTyRrEwwiie/1eZT",
+	"2RY4ntRXs7I4xRyqvJIPcWqzen1zTcub5QrBOGyWsFnCZmnaLJ/pHSLjbJNhi0EfiFYV/i9D88nbyf85",
+	"LgzIY3MQHn/h+uWUD1x0F42zzpzESWMs3tOF+8SFUbYva0SAkaBD+XKQbEIC4sRWTYpJDBsFXq0R45TA",
+	"4RN5sizqtiXMMrRy+gVDMSICw2SbjJ5ALmYp7/ktAleoIklPv//e8eJGtob5fiuF/85ouh7psLXV4w7z",
+	"HibHfWRdYZIfWC2HbJvM+mi/O9IBTRNNWCzQircN4IomaPIt7wcyBpX9ma7j3qsiGaL7lzNxXf5yt01t",
+	"GM5+4Ge5C8VgB8Z4A5mn/7L3WDDvCo1osob12cD6jGglhfXZwPp8UV8NK7S7KzST5ywPcq4PyoAZmq3m",
+	"NqZ7S2mCINn4Mpa/719UqcJ4FhUKweq/xmgt2snB09UKssfJ28k7tIZMrKSD6vLdxGkwoHuU1Lr8wdvj",
+	"NYpShsUjuEggQyRC4L3qoVXlV0PPvuciyRA2RiuIy6PXv4wHHGxDYGhNuMPmOR3IpHn3rnW4zE1zTIkH",
+	"uYEK1pmJ7GlJnBjQB6inIILMeF4IuIGRuAFRAvEK0DkQSwRsIMC5wl/XmCHei3rqwzP98585XvYTggyx",
+	"dmisNLdSZ6XRuEj3ni4wuVjCJEFkgeqEyR9J55Q0aRIkEEhkK/CAxRJAwJX3CswVxDOZVkV91kFB+hEo",
+	"VvUvVT7SOu2P0qt/kWBEhNdWUk/NDqoN2TzlKGJIOD16DAnJMdKJiw3MAnQrPgWUJI+AIZEygmJACVCi",
+	"BCvUpv6tAWJmwSARigfK5mnGWllUg95ixj/H0JwhvsyJaCYZ5TgRd/Ci2t6Xuv/Xdat6Y8fw6WaO4XV6",
+	"m+DIfQLrZ7M79FgZ29nJj2+ceHyMGYrELGW4vAzFqBieTO2uTk/OfnB0NRZYMRoU5tUjin2TE7PMji37",
+	"0aMxNu/GsEPCDjmYHeLBJsIOCTvkoHdIxQnx/JDDQprI3d0B2jv07Rn53QVdjOM84TSeRZRwwSAmont/",
+	"1zS+yJvtulfGa4DarHcwvpdx8KhOND0QFPEZKXog/o1npOjBeCQ2TNNPeVrCAdByW5ELA1cknG1jUzSc",
+	"bOPSM5xr49IznGqjUVS6jJIR44or5HSY4/2Aj32NUy5TopX4GzzDtrQEfsfaM9A7+3wr3Td20m2J6rvA",
+	"yxs77Q6LpkqvrpHR5Kg8GUmaTiDneEFWiIh+ffn0/YBl7mYcup1R35VwlrnroF5rUNhB4K6dYtY6xMbL",
+	"/RRC419OSKJczxBg+pJWM4Tdv6TVDEH6L2s9Z+eZShwiwEME+C5FgCvuLFRpHmD1MUBLRdWQ8RH2+87t",
+	"9+sIr37O6FoJvb+4/ABkY5CRucyea4Y1m7gOWf2LA0m9h0mK2gtd6de8I2aMMs+IVZUrkJWFrI1a17Nw",
+	"Ds2qipXb3a2xfzzCq8++yXYt65V9uaGyl554XgfBMXEFNOX17aZgBdeqEpoUPnUyYL5O4OO/DNs5MkmE",
+	"5Jrk0h0F6wmOXaHVbR/kQk7pg2rjIu0KCditBwEHrl9VbFlUaVyCTIw7liHBXPjZ7yovP9iHRn5QUPbw",
+	"CbFPcOGpMjeMqQVk4pLE6Ku7U0EFTK4QTxPBXW942bvUsPSdylymFqV8K2E4p2k3aIasrYKmt4MQhgE2",
+	"J7k+GJ52jDivvCn5PoYC1oZtDs3uJ4ssCfOBxniOe7WiRV2w2sNslB6Z980z709QRMuPPtH16fzzxT+A",
+	"qUBVm/bHNdL10fptGvNJ09Ytu58qMKyh/dY8c1SUePMSgOZvVUlA186xraFYNvJqdbx07R2nx6HuyfPU",
+	"GZUmz9Pkc6qZ6IjzemJe1K5D+/2fcnwK5W5QUuoHn2oxdZTWukdupUXpOf2YTOtODt4aeoD2O/LWkPMH",
+	"ysqbO/9xOpKOI+nr0RQqS5i/2bSKz3x4+pyJh3t2InaPI3QeRTTdeNou1F/hKsmZpgLoNBRwhx6fIXt3",
+	"Z0AHZ4KUjPh5ZjtztKCArIxWNoeiezvrqJ39nitLNXDCrnPCc2VjBk7YRU4YMVEhShkz9YFLaz35dYnE",
+	"EjGABcBcqaxcfxes4J2sUyJ/KqyQuoI4sODp2mNJqQTfIQsGF/X6x89atdMahZpfaScWK+Bc6lLATCjp",
+	"+UJLeo5bjLPENCHyaIdL1pVWKsQU7cc6hWih/VinEAe0LysVina+rKitaxr/G9PEUxOlrmR1wlRnw5M7",
+	"SGc4thIEkGmErkl+pmL9M2E0SbL7Vaoef3qPpb2EyUKW0albWDdUrGU1o7fHxzfgy9WlhOEZIjFiAHLw",
+	"/6+AKW5Ux6U9QOJPkKPvTgEismEM9GtgThlYQZLCBCAi2GPr4pvup/UpuOiQuRr8hS+7a9T2LSiujBYV",
+	"CCgvcJuNo6mPnSITQpNGDk3aYD5QzxCn7ST2qKS7vruonPXrzO+xqqWNtju3ex/Gc8SEdbC9pXTwmdxB",
+	"OoTAxe0ELtqO5MCTgSd3hSfdUErgyW5GboKKNmVD4B0UCEASA9kYPCwRMd6aiLIYPEAOTOvJ1P01kiaJ",
+	"1LOzW3/3ckfY10OW4i2EJI+JwzIhF1MQY3U1dKzoZsijgqU4iCD5iwDSVENE4AgKZF0vaWKkppOsg0m+",
+	"NhMVHnjnvGdyTzess2bBkLsHKktaY4oTn3+mq2LpulfUKiuhv3J2+uPZj2/+evrj922fVhFEvPdiPaVG",
+	"TReauNY1m3nRq/7N/OBdXB9gGuRx0BG2J3K2DQ6XhVvl+n15vEIBHpY4WurjJEeUgGnoO2JfPgpdEpkO",
+	"ws0ZXblJhznABKD5XPJBV/ptGfT+Fd1KDYFcIIZWlDzWJ/1R/UNFW8uoY/l/Oe9bRh84Yn/h4Fd0ey67",
+	"AOefLh1R7XlUfwVGLm7UvTARoeZLN4AyYD02d7FnTyeOWZgYH9+NRQr8kQPniMTgFkZ38o85JpjrRYyy",
+	"2beDzPaHpvn0PKRdUnrnvvrZF58+UKfG94jhHjiWGds73fLRGd1+XwOYM+VRSr9XZqRGGL4qlEf1p4LV",
+	"ZtESkgXKTvJXVs0f65Gi5SuG7qlP59zAhQdbBkkHHWks6XKVQaMI7Xi6sWSSM8C0sBVacUTDVl4ocWTG",
+	"PxwWPSA2a+CrXFy5lH20Wgs+lt30BDE8hIcb8ocGXRGPsuTpWqcEfRUzQ61e48wydvTdNW//bEEu5jBN",
+	"xOTtZI1ILHsokIfil5xgk+lkDnHi2VoPxTna4UizWgzaNC7WtfrLFiyf7bTgvTp186ZNXO2BuIOsDLLy",
+	"CbLSA1IHrgpc9QSu8oJtga8CXz2Br2bvchvW6/ENCt7LVvC2r67VGVT2j8mcOm7BBhzLG55tB5tMM+M6",
+	"4VE66paPtwzH4Pyn8wvlnrv66fxiMp0ILBI0eTu5bmo/mU5k7UD9rZNXJ69eK8GxRgSu8eTt5LtXJ6++",
+	"m+jyEGpFjl89oCQ5uiP0gRzL93B8pO5TXqRFWYqFjr/Mi1DIygWTvyPxUb1/UXq94AjV/+nJifxfRIkw",
+	"/AzX68SM+vh3rj+glXHH9i1df4lIvKbYsyvU/eF8xtP1mjJTCqV7zK1k3llxxfUKiSWNB/dmXaM4tAsc",
+	"a7BwJmFiGZEKk8VM1e4Y3iXnKXLv/t8f7ngWtuvf4U+aEI/oU1qnamc9bQiaoI1cVH5lJvnvqcwgVQsp",
+	"C5o+XBVHeqWmPv6vTcX1FWtVG9bQx6x+knflTceSd6Vvh/3o2PBuMVwWv59kkLeMOc8rKX2bTs5OXvtQ",
+	"glyWHcuX1LvfdXn3O7WmhfdWSsrLd+CCEoIiIcMvIlnk9RHENEpVSL18/1gHkh+ZROPjP3H8zZK/5an8",
+	"DZNYpyrrUFXj71DVNOx0ZRSDy3fqINHlNDjA4tVkWpHm0u4r9aMOCQZXSCDGJ2//U/385bssqKTaDMvH",
+	"8oDJ3Elvjac+53AdZlMI/Z75x7898YxpD/s1k5FEcXGRg+AlYsuYozlNSaw55qQLx5xsjhPlu2dd3j3T",
+	"7/7Y5d0f5bvfd5mbfKm8GyTrAljh29tHEyoBF5LfykSe/ObbH8fmDhGlPFDuUFRMReWB3K0/Cdgzs7f6",
+	"2k80fnyC9hTR1apeDeD1ycnJtL0427dv33Zjm2WkB2ahdcyaEnA0yRzpKGw191YzzA8gqXKyd0PFiDz6",
+	"d9M7RB7DVtrzrRQjgsOW8WwZyeHd9gv3KmcSjSqfb7yuc8mXem2lhyUUYA0XqEiezHbRHylij8U2Wutq",
+	"bwUHNYMh1Q9hgVZAhQoXXwJr+Z/u1/lJxGb1z2YQWxam1WMQ1wp6yYRHTnL313Ocpvh2HVjKThCFMRFc",
+	"shmK7esXYIWSZyJMXSMpAlI9YqsDONU6BFmSKOXe5c8GyhoHsnlNuyJEde0fzSW1LSMLFAKSyqq84L9e",
+	"H91CjuL/boU1lQ3n3n50DrSVPu2T8We2ouzBZc3PMeN6BjODi5e//OXqfRbqpl7NdkwNJpDxgPXmqmpj",
+	"Mf1s1XVXaldind1gaJl134xaK+zXTfX30IzRkL4bjtxp/glsmL7CUDt1I9/0dpOVua2nhgP5qLJrnD1k",
+	"YqvWy780L2ZsZMu/ZhKtGbrvNjf5JqYp985P0M48osg9mEVUCVFXCKSACSBlSvSL0i/t+uxDU/u4KNjT",
+	"bAv51qS216q8V2WiKuGnmWqpZEQndAguMJH+HV1tls4rOgAPGpNTY3JoOw2G/NRjWejwv6pCNI7+Hqe+",
+	"ItvvzJPcSLECs6VbJaIk5lNAV1gXl8AkRjIEWKDJtHycdnC//p5ygee4KKJeMyZaItldeT5DHF6FklIe",
+	"knuXlO2xujHzeqvGTObU35G9OXAPZTAY1LqlbW1Y4ekJEo6T6krFIeQC3wgt1cwAv6oEAijCz8vQr2xt",
+	"1zuoLfCZI68wVQ3maaIKNcsu4tKX9349vDStyTZNNSnZnJ7SiyWK7sr0bTTzzhNOQSQbKYmkathjDmCS",
+	"0Aekoa/8A1MgVf5Y1tC5iekKYvI2v1HgZqrevZFelhugnwI8V7LMeHIctkPedclwqFpJv/XmkXuYYBV8",
+	"8pK45N9mUv34pLq3j80GtVG36g7XO9i5xVMuzcG2Ta6ePnGXm7K0L2uf+ynbvIBpjMVRQhdtKJB87z1d",
+	"+AAg8zhgP+Uj3tbJEBEMIx/0A6MB0uol4xiGowKEESCMAGEECEOKA5DQRS5GA4jhBzGyw9o++s1v5txX",
+	"Vfry0ByfSfZO/a7jc1ShPndcTl0n0A1Vk+6e1ez1JzpU21GETmq/Nd9aWEwWch940O161FwDDc9UY2LU",
+	"r7ah6QsJa2C5TqFg+8N/4znS1SR8mFMTT4dQr9ZQrzZ2XsurEB16hkpXKfaDZN01YhxzwYHOxlGVJbig",
+	"DC5QnZd1BzvNzcPgbRjHWD6CySfLNpnDhKP6vcb9asAMLLJSr4jaFx13RU439PjmrGeP3zroT5rPCgJK",
+	"7tJJU5N2HHxkWaSZ1z9KPa4XL3nOTk87vHt6+gQpVZEzDhFV1vyOc2b34z5QCBhJsEyVdHKDP/rIUX3t",
+	"nnSajghBPSPs1P4peQ1pblb7A3nU/yqAVo+CYAeFOSmmtIqXBdgpwE4Bdjpk2Enfsq/PyWAaeQGnspbg",
+	"to3cWS0qTmWIArHYA/NmJBuiS/BKm9czSzDRvCzlzyK7eCMwdT3fRFGrRquK+iwtu+M/TcHeCo5aBaNW",
+	"9F4b8B+QOWZ3jc8bwrXtGO3yEKxqxZvLi2z36K9URtVKkVbXKLW4e/scO9ipL+flmpaDEbvacbI+b5Md",
+	"p54HO247dlxDMkSw4/racYqTgx0X7LhgxwU7Ttlx+iQNllwXSy7TAzpbcnFs6bcHYcj1d92MYcjFca7q",
+	"BjuuzY6LXaTKtecWnVnxc5OuHKJdfYpsaVMHTfZpmmzQYYMOG3TYoMPqIyuc9F7lVR/YjUprmcg6WVdG",
+	"TBD04IrOwqIxMEu3z3SBlxMFNcZVU/sQSGXLBs+NZr6wJUFNyu7kOTOL1bc11/mHtmOpxM+w+zVF7G3s",
+	"CX5K6AITf4LiuXVfKIA6Z7UINAbQk5b4XvU6VpZ/fitheWw/y5+nMvNVHqgxWif0cZXl+q0FB6odkI6r",
+	"IzWa9ksNq/dA+Ux0+z0lD6VzTJ7sdApMMWxlX70eUExgDTl/oCx2VqG1LzKsKC7myVTel6Zn3kAZLJaI",
+	"td5vlg9lWP2A8eIm83uWHVu8DAZY/Kr2++nJ6WijUFx9kVWvdesommCG0CieAggo0WXWgax+KzO+c7rt",
+	"ijw67SKPTp+kjUjSTQqJc0xxHB3/uTZ1e7/5JdA1IrF9xWCmnZdqKIMvV++nRmnXPQKGYsxQJHh+w598",
+	"PGeKEeIih+JGrsqNEmo3XECBboAyz0EBZNTVnJ/QApOPOI4yQdeIetg4QKVicDZcN9xnPfWDfpuFA8ql",
+	"qt13RtSk1FMuYDTFpwEm9Z4r4qk+tuqnu2gv5yVGUvd9onij223zjvJrOQuLlJrdIfGyn39nHut18VcO",
+	"/Zt6vsubYZxKoTFyfMDB6/U3hLkkp99louqLWev9PYD3eRcZrS4/K8pnjjrODSe7NpKgYu3fNv9GDM8f",
+	"P8t3RuPRvLC+nxkzPrbs3zdnJfP3TZvYrX7GdLrPLHoQOtgFlTfMCAQUe5ojoayd2uz7YO6C9rOw0oKy",
+	"K6MLk29D61u7m9qxztmz5zjGxz2a1W3ad+jRtQIdj+D6SowiVPKbtx23dn9KbxMc/YIei/u5bww2gGKZ",
+	"F2op7p1u6u55RBZjCwfkFiRK/YDkHDG7xI81W1ocjjQVfoPvkmQlxfIIwL/wav2mGtwku+wdOpDQxQLF",
+	"QLbdPUFhJqVotkLHNizkJtxHsUQMmD3C7QhKABkCJtydpmJa8kBRgsACCa6gQkVfh8F7oZKyM3hjPOGS",
+	"u58aMC/7YW55rouhWKrMX09LqswPrapM9fvTp2JeZy6fue4RZPdMHpDKoWYMMppmPJmxnuTNnMEzxvXe",
+	"TCc9Wh8er7PXnijMO0UbmI+5A5eqOJUe1xSsKBeAoQgRIeW9uu1SBwnsopRRMW56jFXR0bhMraVyFMqT",
+	"92WaKUlkyor6iuTka9w9ZI7nDbZwS5XrlDGzLWTuSzCFaSoAzFeyiUmaTd+fCaNJkpu+G9LIZP/6S+Yi",
+	"NseWjRgyAWILzAViWXRGSW0BcL3ec3egNjo+f/z8CaCcJK0reKxuDGUr/0pe6BeeiGJU1JiSu4TO3Qui",
+	"3FxQill94Z5By9yg3VPAjueCNsojz+alrt/lfW6mrIy/0lEXQNywCbxNpP+qRGGuNcklfSCAkgjteZHY",
+	"n9Uk9b7IbRgdzudlvvIOiTGXXTTcq6VfCDuko7Ks1sJQdd9rv5u11/zVJGxzoCc7hzpiblfq9XFuaD4Y",
+	"6C2jsYTfctit3/L0BORqy7TzuJw7xES57gQFAiWJ6iEjHlxDJibTWlBdb2fZpvG+8eLOCsL64kAkYTKG",
+	"2XtRdi0oQ6U1NzF1GTM5zkgqfzuKEoyI6FVY9aNseKHa9SuvajXsbjuWG+1GqdUaBULB1YEFV21KVutU",
+	"Ws+6FF9tZctOJVj3k0fH0yWsqfj8Je3cH0qztpZm7c74VVndkgRoNfakAvZg8RedEHhQiXnWoof0vJCe",
+	"F9LzDj4976OMrAXZmRIOa6/7zT5RG9TTLhl7VpsBeXvlg/sZsvcWDBIxk7xVxrizO77LEd4GwTT3lRWo",
+	"gBrwrAAHuOP+b7XtLnX/r+tHU4e0wNPNpAWuFVRjYSG3lCYIkuLZTIaFlcd2dvLjG8dwspyHWcqwLzFQ",
+	"Rezb0zw5+2Ha5lSY5nXt+jtMHUmF09LCd5EuNl9vJ9HQGoE/3dAe5oEnHZZlSYvlcZxtdOS1PS45T6WY",
+	"I64obKMSaEmg7vOVfxrvOyYKzp0a8zxLC7JziuwUId3JK/B5icCnXy5+Bten37/Rn8kjn+0srle6MpCy",
+	"VKWH3I504IZflY6hom6VukjnpWQklcOofK2LlLniIhTVznMStdhUH1IuwG2W2eSxbbJ1VHuw8ZK9milV",
+	"bEMs10QTTa+C52NGQuO434c+ElQooXrZwJeryyI+pQSI1CZYyMJ+n72O6BpxwJGksoE4+RpGPjORy/f7",
+	"feIqg+LLjJuSLETM8yUBRc8vVVJsiPLaunvPnvXoXe2P8tbwsQCN0cx+qcdXcoaWO/Gm0wdmKySWNO51",
+	"e+R3OlW0ulKG8corRVllT0+mkyWCsUnIf0+Lu8C7Jyt9228fQS6gVKKZpfxb7oDj3x/u/BF+Ss79U74x",
+	"KnJyhx7L2pBbQ/WHVhSOscZYC/WdLtrMP68//gv8im7BL+gRXKNdjAv8BT1ycK9ylKSj9PKdCQ221zL3",
+	"4nksE3miGZU8MwNL4e8a3P3H58+fwC3kOLIfqjuwdX5udniYJF3zN1fBWzdWmq4JePjnr5+tOHSjA+R4",
+	"ve3r1Vv5v67+dgH++v3pd//tOXiLa5W72ENfjx4eHo6kjnyUsgQRKZfiRlxPzycfsvyNpEmi40q0zKin",
+	"cVUa6WO8R0sc93lbE7tbCxNR0unFmeIwjFinFoXR4NyspRO/S39lQ7JLC33Ut79ZEQzWwJ8/lExnTjSk",
+	"BKKva8wQn2Hiqs88neC4oXGNhn6a1Z6oNr71rJCwNItS09IEOsW36cBkpbza5tlA+iLGKHMTVj6ZVSoU",
+	"NU9Td9bJIlYHrH6/OD72fA4bP9YutcWiOUBQj6ZioTr9Aheshj0jF6yWPdzC5VY7ErtQI0IIXhgavGCT",
+	"subEtR52Cl9oZc5u8Qt7yqkjRjBYc/GGMLRvghDD0B7D0Iv/Wy+bLXU38M7ZPWL/nau9WJSYHq0E4sBy",
+	"jpzGs4gSLhjERIw5pC6X0Zb4cOt30tqj8V9NWxpzuKF2/BtqKyLCI+Wc6ukxj/DqyCq25dZUry8uP0jH",
+	"RZZdkftJaGlticCJ8eGoLgHObCWXQiAzOa8jvMpAko4Cke6i5qoIpOdsUlT3/r4sOQtFcGturiXwhhd8",
+	"ztpmwHe9sS5+pDOHseDK2ca1Lqmui8t1TfV57VjLg3dyFvMmBSuTbS9ZbDwZXUzflcZaLK2Naewt117m",
+	"XsU2pq1Kw7bIVftVT+hqD/UuxK6+mNhVa9VD8GoIXg3BqwcfvFo+VgJK4w9eLRGqCZ7pEr7qRWi6xa+W",
+	"T+8AgWwLAhl0jcTHSlH9bQR5WkNoiPK0B3roYZ6dYYusCNjxnLIFbSgA+Fmd2/r70jJUoZxwhcDDEqkS",
+	"d/IHGGmFGH1V4oEyQKjDY/E39a3R69blN1Lsw5USVfesGumwOARngBxHwhhpKtwVzx0LtIsp7Uj5GDK2",
+	"BMyaiNHSFKlKV1GXOVk1abg8JUm6lGN0wWocjc+0Y9ZRNBEYvtr+DEUI3+vQWc/OqHClyMGdjZVf1Ku1",
+	"53UYkDCCN+dbUz/YYl/DpYitMOcZSNw1huFT3qxfBEPRrjtEV2qzGxhwdfohdmFg7IJFyKrntnjUJW6h",
+	"jSE7RS3sPndOfRc6lc4fN9iX3wq1vUtkm7TqgpC+cInWXReCJVqDJbpvuNZACaurgWESe3IavJTrKce+",
+	"W7JLFIPFJFuPYSjG4o9gsMYb4hfGj18o7V6n4CkrpS2+uqKlx1PXXcIc7qXz65pEDTfPD3YSFgwXXITB",
+	"RRhchAfvIrTPsmCZeB2E1kneYJJ0cA76rJJOrsGSuhAU/7F9d9babMdzVwzA77ezBnngXrvOyjqXzK5D",
+	"i/uiyKqpdgf0BZKLpr3Qg1Kz3bgIxkGHgCgPR5QtWjowruJpR1y5jUW7Qst7yq+j4i/FZBpA3ta9EHDe",
+	"Ljhvj21Ql+PtwEvRgR976c7yIUz6BSEgxbIHECSAIAEEOXgQRGccgeyIKd3TG2CRZljEOmcbFdmO4EjR",
+	"ahg+UjrSnx8icaiz/YrxqkJDve7Km06EcO1prO85SuSlqJgAjiJKYt4cePndm5P2rW5/Jx+wHkVHlMVa",
+	"5K0BLcUYGrEWa6gBbiltrkZlXXKqT0O/WKLoLouw5Yjd40jXok0Jke2qe/uT/rHLhbW1vobP/VMW4Hn8",
+	"RyeHb2ZhQzBHD9oEtMF1bSou8D0iUh+Y46+1ef4DE1HGmRtNEoG+apmIIIuWHtPgj0bje2xX6CguyPo2",
+	"zPT8GlH3PA72jxRHd2b9ytPSbJfj4p0ZzspVb2W1K9X7C2UyOblh7KWJ/oIYy0xIs5Qqa9CLpdK8Lng7",
+	"S6ka4i+VpeTkhrGUJvoLYikzIclSkrt6OVXkzuznS5EtukPS5u3dCMQvJhscJgMdJoqEVYBY/tjFPeJn",
+	"tk5ekX3hvPF8IHIOPtdHAzMHj0erx6OZj1uj2VXzgXHsO8zGG8dn4FoWdjJKjzu+pNXrWEdcnikwpmJ3",
+	"jpZtb9ZwtppD951Nm8ur15cwjbkaXcL81e7ZeoC/HIU/tF+NMQT1jx/UbwRaReaWlNfjkpjw+5WhEDBa",
+	"ohicZ++7fcvyG/kruyd6pyO6t5/Rpd0vb6Ah1TBkDHT3l0uenOW8HBzmwWEeHOYH7zBnyhrJD7hg+Hk9",
+	"5HWNoU0V6ZxdmHfdmmYov9PDsxQUko0oJCGRcWy1xOLpoJgExSQoJgevmORnotJQQnJjPx3FneVYgNQm",
+	"hK+sYJxzjhdkkHrBdh9qHislcVjZt+tURaLO0yR5BJCbcnt2WJGgmop7yN5Db+hVZHAToaxJNwc7lFlf",
+	"hS74tedOkQ1Bbw5A3o5rzIqPg64cdOWgKwdduaQr71SQ2k5ryZku4EHxWjQO+XaDohHqgPnUANtYCGrA",
+	"k9SAoAAEBSAoAEEBsBWAcPY3nP1ZrowXEmvKaq2HbnZKZjXaQIiRbI6RtK8cmeF4CFQXwiw3WFRNcf92",
+	"snzlp/3JvWpgB57T6w+N5BFeHd+fHv89v+vLmDRlUSXvdJUS0rzWYryoq1DnOBGIAfR1zVCTN1y/N2nK",
+	"+6rZC0ZtAFhpESX9kiGeJsLzLS4gE0r1eILF9EEbOpZCob/J28wmZWW5P3zy5PwPuZD/r/9FvWpBtZ3g",
+	"uDvsF2vDjPvdnxmjzPXNn2AMroym6zihFWuZi+m8rir5Ac3/anpPOF07kU+Nc6y16OZDer0hTnCtyEVN",
+	"dm6DFXKh+Uwfv6BknuBIuMVqwYYThxh1JErWGVSnvmUM2tWTijhNWbRDmY9mQPVcx7NnXKx/UQH+pnPT",
+	"HOmFpdWa+g84mRC3V+tx8oxS4OMvu7OscqEqi5on1tWX9ZN8tOvrupnjSU3942gHVNHdt6flU22KObd6",
+	"Om1pZ+zIwah4o7opU6+kXScwQoe5KbelM4YNeVAb0uwxr6pqqmh9YvQex4jJXvCiEQBwtxiDz9qv2/6l",
+	"ereonFVWVGxtRgQiNaRUj7s83S+VIDE3vtEp/ivAG3sDbxTFfPYH3cgjNNrAjS86YG5T59SX7A7pkdZh",
+	"a8hG8fEAbHQCNorbw0vSswesYVjz2RW7N2cHhmropWoBNfZpNU6eb//vJqSRrWgborHjixoAjRE4M5hP",
+	"u4Bn5DuyBc44yB25HS0x7MWDhTIs5VSb/0cwUqZnr/KkBss41037FSott+2+42vtdsOF5yJFKGM6sIxp",
+	"hZjVQpDlx11Km3Zh1E5FTveZa8erzVeeja8EaqctEYqhthZD7bsbHFK9Jc2m3IUn4aYf64c74F5KDkx5",
+	"3UM2TMiGCdkwB58NkzkN8/MlnODefJjK6dqsyHbJkSk3G5AtUzvK9/N6fNeVcPSBIDY0tSW9TXA0u0OP",
+	"jmP45w8AkYjGKAb6PXCHHsE9Ynj+iMlCSZZ//voZQM7lpFWllWW+QQBMxRIRIUlpLh16Bc5BlGApiTiK",
+	"GBIAc7BARC6XdFvPAV1hIVD8qnwx3tnJj2+mdfd3PeHEIkaXTV7hqu1koZQH4c9HqQz2wDNTavu52Tag",
+	"8VGRSNUP8KHxRd6yJ95jN+1hOFea7QjaU6dDAHuGgj0lWtasW/tpJ6innUW7IT17y68j4jz2ZLwwT4e9",
+	"EFCedpSn5zZovQSn3OHA23D2ahfsow7rytLOC+MMzm9eYXKp254OuQGmzDpbvwqmNBz/nTDlUYfLYca/",
+	"HKa6rX3SyaNldqn4lJfZaCj9VD6WutzMukXJdBBVJ0O5qXGgdps5Z4qzA94e8PaAtx883r5KRQplXWv0",
+	"NUpSju9RqEPVuQZltSBVV3XlHtNEnSv+lCejjvy7eLOzJhLtFUzQ9fzKKdHl5m+VEAGWNIklgr6iDAGx",
+	"hARQUtIs+J4XYVfcqK+GNyxFFlUWcDBhW9CGzcVdNOVQLdWnvta3YlBix1Fig/oa1NegvoZwESRPHvn4",
+	"iM6P4lSBifZJF9RYf/hI6Zxv9gl0CR7xuwW6xY5UVIqXA7uPUBx108i9I8ZDf/K33qj+luI77DE0hHeU",
+	"hnro0R09UPeUI9YrokOaYP0COfol6H2x7iPaZHJeTb//dYnEEjHJ55hESRojIBjkEg/I1VmHpm/ecWHh",
+	"eS3ibjEiBWVDaMjA0BBFwqorXP7YJRDEz9md4j8Cm280tEQSzBdR0rBzQiBJayBJ86ZpDRtRzQdGi+zm",
+	"nnme2BAoBKtjNTFai3Y10JZ9a8jEChFh1q8OHKB7lNS6/MHb4zWKUobFI7hIIEMkQuC96qHV4FRDz75X",
+	"1y+nE7SCuDwS/ctGIlr6B7EoRt567IochT9kRY0xRKqMH6liZEtF/JU05OMYc3ibKP3YbTi/0y/EBruP",
+	"IPmLAOo+VUymSkaKJcLy7L1DhAPIEGDont7pkP2K7qz76ich0+eUkJ30WUOz+JDUUT3lYkEqbIRIlYvK",
+	"K/8zeQkLj8ihrbteN7UcsS0IquuPV2vEOCVQNDDBpfVSLy5Qin2p8Y6VE+t3HBZ0wJR8lnLTdSyeq4u8",
+	"tVzNiGERIc6LCe2xU/iS8xQBCPiSMnGU4HsUA2jPW1AAIwEg90qeNeT8gbLYf4J9zrhoKbuhRpWXQC+c",
+	"C8TUYSad0dl5BpMEcGQuCre5UJ5t5kJ1mjos5mukqr5+yka0m5JumBFQ1uhtoudjWBfztkDnv56WMOcf",
+	"2rL28l7caO6AK/Gz9QAciQO69f4aCQCBQKs1ZZA9goywJaarbCaGuKCsQRu80i8ACBiKZGdCxktIUCJz",
+	"PyaPgNO5yOG9OqykethhK7m9pKeauRubMRQM8IybKQv+yQA6zUd1RpQmxJE2KvzsqM/IKWBozhBf5pCN",
+	"BCsyiwTLMyYGnII5ZMY+kfIExQ5x3ybir9S4JDt+1kPbT21WDz4z1PZc0Ok1UetolrxJwo2TfaGQ235J",
+	"F1tDx0OuRQhTawDmQopFiFELMWohRq18wbcyeEN2xdDsisLV6ETCzrlUMnsqEOm2FIjPeIWAZFTwsMSR",
+	"DiWAagYrSSalNGMC0Hwu+azhQhk+g2LiHFkMBToSeOUQCp7xQOEZDfq6xsynHOiHw8bxBPRkLI/fMADk",
+	"OlVm0jyVuVOaVNkV/sohmOOIYZNXNrnep1VSlS2KzHbMbFaYJA1YZMlAKZmdXq9ZYXNem/f31OrMhm9Z",
+	"2PuOsUnuoKnwYsZVZklJQqM7v4vki3re30+mmFP3vVMeMz2mvYcY3uO50EonXWAC5JzkqjsXGpM59QIM",
+	"H4kUwVGE1vLctDwdOU51+whujqmslHesHtwU4Xs3dI0Ijm8Aj+jaEX/0Ubb6kg1hVDu2R5jLmqE5YgzF",
+	"M0mNLAy99hpPb5032JV0aflSF0X2IoF4Vdl4m2O4ofHMtUHKf0MCPp6nYlnihoKbWrAqudoNEFXIdvQB",
+	"SPbBGACkJwFIAToK0FGAjgJ0ZENHATVqQI2yq4G9YFFT6mI9NL1TxuITb3gNMeDjxICPkF45ZuzN+EHp",
+	"04mt9ffTnMpkz/uZtsUEOezO7SR2yk/78znVwA48jdMfkv6AbpeU3vXK2/xVt+mXumkadcdXiga7UXe7",
+	"NOuQVjkwrTKjYjVJzPzeJbmykf065VfuFy+OFwFtpuHLfWzm8JD+2Jr+2IW5W5Mgs04G5kHuPG9vXhOO",
+	"BL5Hrqzi6QTdIyLKihci6SpTfF5leoLWp14V0bTqT6W1zfRaxKb+xas1YiusXRDWI4Unvsoi/X5zqIBF",
+	"tY3XdW1O38ri8uXI36WtFytekI6QGMkIfobN3S7gHx/OL46u/3F++v2bybRJF3z9xjEsAwgUK8twpZeT",
+	"sx9a6pl0SZHM2HzrWZJmIP5EyWykIVdy/FzJQvjUZWVVPT0uGL1jJOm7vIEbqzffKl7bSan5UiJLDwqh",
+	"N/wxK3gr4PUBrw94/cHj9eZEs7S2YFN1iPQsndGNukKLcmDaNGsE4YbpAzunw+EcDudwOIfDOTtCwpHs",
+	"PZKzA9SHb3Zwpjshzk7+9OJ8DkBiJyBxBM/33mORJfe2khRm9fK5/dYDsNyOj9t83e/mzoZ34J7uRkDx",
+	"27f/HQCsi0Bl32sCAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/accesstoken"
+	"github.com/eidng8/go-attr-rbac/ent/session"
+)
+
+const (
+	accessTokenTtl  = time.Hour
+	refreshTokenTtl = 7 * 24 * time.Hour
+)
+
+// Access and refresh tokens issued to a session, with their JTIs.
+type sessionTokens struct {
+	access     string
+	refresh    string
+	accessJti  []byte
+	refreshJti []byte
+	expiresAt  time.Time
+}
+
+// Issues a pair of access and refresh tokens for the user.
+// Doesn't access database.
+func (s Server) issueSessionTokens(u *ent.User) (*sessionTokens, error) {
+	issue := func(ttl time.Duration) (string, []byte, *time.Time, error) {
+		jti, claims, err := s.buildTokenClaims(u, ttl)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		token, err := s.issueJwtTokenWithClaims(jwt.SigningMethodHS256, claims)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		id, err := jti.MarshalBinary()
+		if err != nil {
+			return "", nil, nil, err
+		}
+		return token, id, &claims.ExpiresAt.Time, nil
+	}
+	at, atid, _, err := issue(accessTokenTtl)
+	if err != nil {
+		return nil, err
+	}
+	rt, rtid, exp, err := issue(refreshTokenTtl)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionTokens{
+		access: at, refresh: rt, accessJti: atid, refreshJti: rtid,
+		expiresAt: *exp,
+	}, nil
+}
+
+// Starts a session of the user on the requesting device, and sets the access
+// and refresh token cookies.
+func (s Server) startSession(gc *gin.Context, u *ent.User) error {
+	tokens, err := s.issueSessionTokens(u)
+	if err != nil {
+		return err
+	}
+	err = s.db.Session.Create().SetUserID(u.ID).
+		SetAccessToken(tokens.accessJti).SetRefreshToken(tokens.refreshJti).
+		SetUserAgent(truncate(gc.Request.UserAgent(), 255)).
+		SetIP(gc.ClientIP()).SetExpiresAt(tokens.expiresAt).
+		Exec(context.Background())
+	if err != nil {
+		return err
+	}
+	s.setToken(gc, tokens.access, tokens.refresh)
+	return nil
+}
+
+// Replaces the tokens of the session holding the given refresh token, and
+// revokes the replaced ones, so each refresh token can only be used once.
+// Tokens issued before sessions were tracked start a new session. Sets the
+// access and refresh token cookies.
+func (s Server) refreshSession(gc *gin.Context, rt *jwtToken) error {
+	rtid, err := rt.getJtiBinary()
+	if err != nil {
+		return errInvalidToken
+	}
+	tokens, err := s.issueSessionTokens(rt.user)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			ss, err := tx.Session.Query().
+				Where(session.RefreshTokenEQ(rtid)).Only(qc)
+			if err != nil {
+				if !ent.IsNotFound(err) {
+					return nil, err
+				}
+				// another request may have just used the token
+				revoked, err := tx.AccessToken.Query().
+					Where(accesstoken.RefreshTokenEQ(rtid)).Exist(qc)
+				if err != nil {
+					return nil, err
+				}
+				if revoked {
+					return nil, errInvalidToken
+				}
+				return nil, tx.Session.Create().SetUserID(rt.user.ID).
+					SetAccessToken(tokens.accessJti).
+					SetRefreshToken(tokens.refreshJti).
+					SetUserAgent(truncate(gc.Request.UserAgent(), 255)).
+					SetIP(gc.ClientIP()).SetExpiresAt(tokens.expiresAt).
+					Exec(qc)
+			}
+			err = tx.AccessToken.Create().SetUserID(ss.UserID).
+				SetAccessToken(ss.AccessToken).SetRefreshToken(ss.RefreshToken).
+				Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, tx.Session.UpdateOne(ss).
+				SetAccessToken(tokens.accessJti).
+				SetRefreshToken(tokens.refreshJti).
+				SetUserAgent(truncate(gc.Request.UserAgent(), 255)).
+				SetIP(gc.ClientIP()).SetExpiresAt(tokens.expiresAt).
+				SetRefreshedAt(time.Now()).Exec(qc)
+		},
+	)
+	if err != nil {
+		return err
+	}
+	s.setToken(gc, tokens.access, tokens.refresh)
+	return nil
+}
+
+// Signs out the sessions by revoking their tokens, and forgets them.
+func revokeSessions(
+	qc context.Context, tx *ent.Tx, sessions []*ent.Session,
+) error {
+	for _, ss := range sessions {
+		err := tx.AccessToken.Create().SetUserID(ss.UserID).
+			SetAccessToken(ss.AccessToken).SetRefreshToken(ss.RefreshToken).
+			Exec(qc)
+		if err != nil {
+			return err
+		}
+		jti, err := uuid.FromBytes(ss.AccessToken)
+		if err != nil {
+			return err
+		}
+		err = emitUserEvent(
+			qc, tx, ss.UserID, api.EventTokenRevoked,
+			map[string]interface{}{
+				"user_id": ss.UserID, "jti": jti.String(),
+				"type": accessTokenName,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if err = tx.Session.DeleteOne(ss).Exec(qc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Forgets the sessions of the user, whose tokens are no longer accepted.
+func forgetSessions(qc context.Context, tx *ent.Tx, userId uint64) error {
+	_, err := tx.Session.Delete().Where(session.UserIDEQ(userId)).Exec(qc)
+	return err
+}
+
+// Returns at most the first n bytes of s, without splitting characters.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/session"
+)
+
+// Starts a session of the user from the given user agent, returns the access
+// and refresh token cookies of the session.
+func startTestSession(
+	tb testing.TB, svr *Server, u *ent.User, agent string,
+) (*http.Cookie, *http.Cookie) {
+	res := httptest.NewRecorder()
+	gc, _ := gin.CreateTestContext(res)
+	gc.Request = httptest.NewRequest(http.MethodPost, "/login", nil)
+	gc.Request.Header.Set("User-Agent", agent)
+	require.Nil(tb, svr.startSession(gc, u))
+	at, rt := getTokensFromSetCookieHeaders(tb, res)
+	require.NotNil(tb, at)
+	require.NotNil(tb, rt)
+	return at, rt
+}
+
+// Serves the request with the given token cookies, returns the response.
+func serveWithCookies(
+	engine http.Handler, req *http.Request, cookies ...*http.Cookie,
+) *httptest.ResponseRecorder {
+	for _, c := range cookies {
+		req.AddCookie(&http.Cookie{Name: c.Name, Value: c.Value})
+	}
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+// Lists users with the access token cookie, returns the response.
+func getUsersWithCookie(
+	tb testing.TB, engine http.Handler, at *http.Cookie,
+) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(http.MethodGet, "/users", nil)
+	require.Nil(tb, err)
+	return serveWithCookies(engine, req, at)
+}
+
+// Refreshes tokens with the refresh token cookie, returns the response.
+func refreshWithCookie(
+	tb testing.TB, engine http.Handler, rt *http.Cookie,
+) *httptest.ResponseRecorder {
+	req, err := http.NewRequest(http.MethodPost, "/access-token/refresh", nil)
+	require.Nil(tb, err)
+	return serveWithCookies(engine, req, rt)
+}
+
+func Test_startSession_records_device(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	at, _ := startTestSession(t, svr, u, "test agent")
+	ss := db.Session.Query().Where(session.UserIDEQ(1)).
+		OnlyX(context.Background())
+	require.Equal(t, "test agent", ss.UserAgent)
+	require.Equal(t, "192.0.2.1", ss.IP)
+	require.Nil(t, ss.RefreshedAt)
+	require.WithinDuration(
+		t, time.Now().Add(refreshTokenTtl), ss.ExpiresAt, time.Minute,
+	)
+	tk := &jwtToken{svr: svr}
+	require.Nil(t, tk.parse(at.Value))
+	jti, err := tk.getJtiBinary()
+	require.Nil(t, err)
+	require.Equal(t, jti, ss.AccessToken)
+}
+
+func Test_refreshSession_rotates_tokens(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	at, rt := startTestSession(t, svr, getUserById(t, db, 1), "test agent")
+	res := refreshWithCookie(t, engine, rt)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	nat, nrt := getTokensFromSetCookieHeaders(t, res)
+	require.NotNil(t, nat)
+	require.NotNil(t, nrt)
+	ss := db.Session.Query().OnlyX(context.Background())
+	require.NotNil(t, ss.RefreshedAt)
+	// replaced tokens are revoked
+	require.Equal(
+		t, http.StatusUnauthorized, getUsersWithCookie(t, engine, at).Code,
+	)
+	require.Equal(
+		t, http.StatusUnauthorized, refreshWithCookie(t, engine, rt).Code,
+	)
+	require.Equal(t, http.StatusOK, getUsersWithCookie(t, engine, nat).Code)
+	require.Equal(
+		t, http.StatusNoContent, refreshWithCookie(t, engine, nrt).Code,
+	)
+	require.Equal(t, 1, db.Session.Query().CountX(context.Background()))
+}
+
+func Test_refreshSession_starts_session_of_untracked_tokens(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	req, err := svr.postAs(getUserById(t, db, 1), "/access-token/refresh", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	ss := db.Session.Query().OnlyX(context.Background())
+	require.Equal(t, uint64(1), ss.UserID)
+	require.Nil(t, ss.RefreshedAt)
+}
+
+func Test_truncate_keeps_whole_characters(t *testing.T) {
+	require.Equal(t, "abc", truncate("abc", 5))
+	require.Equal(t, "ab", truncate("abc", 2))
+	require.Equal(t, "a", truncate("a€", 3))
+	require.Len(t, truncate(strings.Repeat("€", 100), 255), 255)
+}
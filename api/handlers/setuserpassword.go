@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// SetUserPassword sets a temporary password of the user, which the user has to
+// change after signing in. All sessions of the user are signed out.
+//
+// Endpoint: POST /user/{id}/password
+func (s Server) SetUserPassword(
+	ctx context.Context, request SetUserPasswordRequestObject,
+) (SetUserPasswordResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("SetUserPassword error: %v", err)
+		return nil, err
+	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("SetUserPassword error: %v", err)
+		return nil, err
+	}
+	hash, err := s.hashNewPassword(request.Body.Password)
+	if err != nil {
+		var pe *passwordPolicyError
+		if errors.As(err, &pe) {
+			return SetUserPassword400JSONResponse{pe.response()}, nil
+		}
+		api.Log.Debugf("SetUserPassword error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = s.rotatePassword(qc, tx, u, request.Body.Password)
+			if err != nil {
+				return nil, err
+			}
+			if err = revokeUserTokens(qc, tx, u.ID); err != nil {
+				return nil, err
+			}
+			err = tx.User.UpdateOne(u).SetPassword(hash).
+				SetMustChangePassword(true).Exec(qc)
+			if err != nil {
+				return nil, err
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditPasswordSet, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+		},
+	)
+	if err != nil {
+		var pe *passwordPolicyError
+		if errors.As(err, &pe) {
+			return SetUserPassword400JSONResponse{pe.response()}, nil
+		}
+		if ent.IsNotFound(err) {
+			return SetUserPassword404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("SetUserPassword error: %v", err)
+		return nil, err
+	}
+	return SetUserPassword204Response{}, nil
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/eidng8/go-utils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+)
+
+func Test_SetUserPassword_sets_temporary_password(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	old := issueTokenAt(
+		t, svr, getUserById(t, db, 2), time.Now().Add(-time.Minute),
+	)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/user/2/password",
+		SetUserPasswordJSONRequestBody{Password: "Temp_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	u := getUserById(t, db, 2)
+	require.True(t, u.MustChangePassword)
+	require.NotNil(t, u.TokensValidAfter)
+	m, err := utils.ComparePassword("Temp_123", u.Password)
+	require.Nil(t, err)
+	require.True(t, m)
+	// existing sessions of the user are signed out
+	res = getUsersWithToken(t, svr, engine, old)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditPasswordSet)).
+		OnlyX(context.Background())
+	require.Equal(t, uint64(1), *log.ActorID)
+	require.Equal(t, float64(2), log.Details["user_id"])
+}
+
+func Test_SetUserPassword_forces_change_after_login(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	grantPermissions(t, db, 2, "auth:ChangePassword")
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/user/2/password",
+		SetUserPasswordJSONRequestBody{Password: "Temp_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	username := getUserById(t, db, 2).Username
+	res = tryLogin(t, svr, engine, username, "Temp_123")
+	require.Equal(t, http.StatusOK, res.Code)
+	cat, _ := getTokensFromSetCookieHeaders(t, res)
+	res = getUsersWithToken(t, svr, engine, cat.Value)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	res = tryChangePassword(
+		t, svr, engine, cat.Value, "Temp_123", "Test_456",
+	)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.False(t, getUserById(t, db, 2).MustChangePassword)
+}
+
+func Test_SetUserPassword_reports_400_if_password_too_simple(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/user/2/password",
+		SetUserPasswordJSONRequestBody{Password: "abcdefgh"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.False(t, getUserById(t, db, 2).MustChangePassword)
+}
+
+func Test_SetUserPassword_returns_404_if_user_not_found(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/user/999999/password",
+		SetUserPasswordJSONRequestBody{Password: "Temp_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_SetUserPassword_returns_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), fmt.Sprintf("/user/%d/password", a.UserID),
+		SetUserPasswordJSONRequestBody{Password: "Temp_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_SetUserPassword_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post(
+		"/user/2/password", SetUserPasswordJSONRequestBody{Password: "Temp_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_SetUserPassword_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 2), "/user/3/password",
+		SetUserPasswordJSONRequestBody{Password: "Temp_123"},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_SetUserPassword_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), "/user/2/password",
+		SetUserPasswordJSONRequestBody{Password: "Temp_123"},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
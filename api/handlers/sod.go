@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/eidng8/go-utils"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+// sodViolation is the error of a user holding more than one role of a
+// separation-of-duties constraint.
+type sodViolation struct {
+	UserId     uint64   `json:"user_id"`
+	Constraint string   `json:"constraint"`
+	Roles      []string `json:"roles"`
+}
+
+func (v *sodViolation) Error() string {
+	return fmt.Sprintf(
+		"user %d holds roles %v excluded by constraint %q",
+		v.UserId, v.Roles, v.Constraint,
+	)
+}
+
+// response returns the 409 response body naming the conflicting roles.
+func (v *sodViolation) response() N409JSONResponse {
+	var detail interface{} = v
+	return N409JSONResponse{
+		Code:   http.StatusConflict,
+		Errors: &detail,
+		Status: msgError,
+	}
+}
+
+// Returns the predicate matching roles held by the user. Unlike
+// effectiveRoles, assignments not yet in effect are included, as they will be
+// held at some point.
+func heldRoles(id uint64) predicate.Role {
+	return role.Or(
+		role.HasAssignmentsWith(userrole.UserIDEQ(id), unexpiredAssignment()),
+		role.HasGroupsWith(group.HasUsersWith(user.IDEQ(id))),
+	)
+}
+
+// Checks that none of the users holds more than one role of any
+// separation-of-duties constraint. It is meant to be called within the
+// transaction that changes the assignments, after they are changed, so that
+// the whole transaction is rolled back on violation.
+func checkSeparationOfDuties(
+	qc context.Context, tx *ent.Tx, users ...uint64,
+) error {
+	for _, id := range users {
+		held, err := tx.Role.Query().Where(heldRoles(id)).IDs(qc)
+		if err != nil {
+			return err
+		}
+		if len(held) < 2 {
+			continue
+		}
+		constraints, err := tx.SodConstraint.Query().
+			Where(sodconstraint.HasRolesWith(role.IDIn(held...))).
+			WithRoles(
+				func(q *ent.RoleQuery) {
+					q.Where(role.IDIn(held...)).Order(role.ByID())
+				},
+			).
+			Order(sodconstraint.ByID()).All(qc)
+		if err != nil {
+			return err
+		}
+		for _, c := range constraints {
+			if len(c.Edges.Roles) > 1 {
+				return &sodViolation{
+					UserId:     id,
+					Constraint: c.Name,
+					Roles: utils.Pluck(
+						c.Edges.Roles,
+						func(r *ent.Role) string { return r.Name },
+					),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Finds users holding more than one role of the constraint.
+func findSodViolations(
+	qc context.Context, db *ent.Client, c *ent.SodConstraint,
+) ([]SodViolation, error) {
+	ids, err := c.QueryRoles().IDs(qc)
+	if err != nil {
+		return nil, err
+	}
+	users, err := db.User.Query().Where(
+		user.Or(
+			user.HasAssignmentsWith(
+				userrole.RoleIDIn(ids...), unexpiredAssignment(),
+			),
+			user.HasGroupsWith(group.HasRolesWith(role.IDIn(ids...))),
+		),
+	).Order(user.ByID()).All(qc)
+	if err != nil {
+		return nil, err
+	}
+	violations := []SodViolation{}
+	for _, u := range users {
+		roles, err := db.Role.Query().
+			Where(heldRoles(u.ID), role.IDIn(ids...)).Order(role.ByID()).
+			Select(role.FieldName).Strings(qc)
+		if err != nil {
+			return nil, err
+		}
+		if len(roles) > 1 {
+			violations = append(
+				violations,
+				SodViolation{UserId: u.ID, Username: u.Username, Roles: roles},
+			)
+		}
+	}
+	return violations, nil
+}
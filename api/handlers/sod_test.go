@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+func Test_checkSeparationOfDuties_passes_if_no_violation(t *testing.T) {
+	_, _, db, _ := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 5)
+	requireSodCheck(t, db, nil, 2, 3)
+}
+
+func Test_checkSeparationOfDuties_reports_direct_roles(t *testing.T) {
+	_, _, db, _ := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 3)
+	requireSodCheck(
+		t, db, &sodViolation{
+			UserId:     2,
+			Constraint: "constraint 0",
+			Roles:      []string{"role 0", "role 1"},
+		}, 2,
+	)
+}
+
+func Test_checkSeparationOfDuties_reports_group_roles(t *testing.T) {
+	_, _, db, _ := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 5)
+	seedGroup(t, db, []uint64{2}, []uint32{5})
+	requireSodCheck(
+		t, db, &sodViolation{
+			UserId:     2,
+			Constraint: "constraint 0",
+			Roles:      []string{"role 0", "role 3"},
+		}, 2,
+	)
+}
+
+func Test_checkSeparationOfDuties_reports_future_assignments(t *testing.T) {
+	_, _, db, _ := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 3)
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(3)).
+		SetStartsAt(time.Now().Add(time.Hour)).ExecX(context.Background())
+	requireSodCheck(
+		t, db, &sodViolation{
+			UserId:     2,
+			Constraint: "constraint 0",
+			Roles:      []string{"role 0", "role 1"},
+		}, 2,
+	)
+}
+
+func Test_checkSeparationOfDuties_ignores_expired_assignments(t *testing.T) {
+	_, _, db, _ := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 3)
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(3)).
+		SetExpiresAt(time.Now().Add(-time.Second)).
+		ExecX(context.Background())
+	requireSodCheck(t, db, nil, 2)
+}
+
+func requireSodCheck(
+	tb testing.TB, db *ent.Client, expected *sodViolation, users ...uint64,
+) {
+	_, err := db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			return nil, checkSeparationOfDuties(qc, tx, users...)
+		},
+	)
+	if nil == expected {
+		require.Nil(tb, err)
+		return
+	}
+	require.Equal(tb, expected, err)
+}
+
+// Asserts the response is a 409 naming the conflicting roles.
+func requireSodConflict(
+	tb testing.TB, res *httptest.ResponseRecorder, roles ...string,
+) {
+	require.Equal(tb, http.StatusConflict, res.Code)
+	actual := unmarshalResponse(tb, N409JSONResponse{}, res)
+	require.Equal(tb, http.StatusConflict, actual.Code)
+	detail := (*actual.Errors).(map[string]interface{})
+	require.Equal(tb, "constraint 0", detail["constraint"])
+	names := make([]string, len(detail["roles"].([]interface{})))
+	for i, r := range detail["roles"].([]interface{}) {
+		names[i] = r.(string)
+	}
+	require.Equal(tb, roles, names)
+}
@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/permission"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+)
+
+// Returns the ID of the organization that the request is confined to.
+// Platform administrators, users granted the cross-tenant permission, are not
+// confined to any organization, nil is returned for them.
+func (s Server) requestTenant(ctx context.Context) (*uint32, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		return nil, err
+	}
+	err = s.operationAllowed(token.user, api.PermissionCrossTenant)
+	if nil == err {
+		return nil, nil
+	}
+	if !errors.Is(err, errAccessDenied) {
+		return nil, err
+	}
+	return &token.user.OrganizationID, nil
+}
+
+// Checks that the request isn't confined to an organization. Resources shared
+// by all organizations, such as permissions, are managed by platform
+// administrators only.
+func (s Server) requirePlatform(ctx context.Context) error {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return err
+	}
+	if nil != tenant {
+		return errAccessDenied
+	}
+	return nil
+}
+
+// Returns the organization to create a resource in. Defaults to the current
+// user's organization if not requested. Only platform administrators can
+// create resources in other organizations.
+func (s Server) creationTenant(ctx context.Context, requested *uint32) (
+	uint32, error,
+) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if nil != tenant {
+		if nil != requested && *requested != *tenant {
+			return 0, errAccessDenied
+		}
+		return *tenant, nil
+	}
+	if nil != requested {
+		return *requested, nil
+	}
+	token, err := s.getToken(ctx.(*gin.Context))
+	if err != nil {
+		return 0, err
+	}
+	return token.user.OrganizationID, nil
+}
+
+// Returns the predicate confining user queries to the request's organization.
+func (s Server) userScope(ctx context.Context) (predicate.User, error) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if nil == tenant {
+		return func(*sql.Selector) {}, nil
+	}
+	return user.OrganizationIDEQ(*tenant), nil
+}
+
+// Returns the predicate confining queries of the user endpoints to users of the
+// request's organization. Users backing service accounts are left out, they
+// are managed by the service account endpoints.
+func (s Server) userAccountScope(ctx context.Context) (predicate.User, error) {
+	scope, err := s.userScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return user.And(scope, user.IsServiceAccountEQ(false)), nil
+}
+
+// Returns the predicate confining role queries to the request's organization.
+func (s Server) roleScope(ctx context.Context) (predicate.Role, error) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if nil == tenant {
+		return func(*sql.Selector) {}, nil
+	}
+	return role.OrganizationIDEQ(*tenant), nil
+}
+
+// Returns the predicate confining group queries to the request's organization.
+func (s Server) groupScope(ctx context.Context) (predicate.Group, error) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if nil == tenant {
+		return func(*sql.Selector) {}, nil
+	}
+	return group.OrganizationIDEQ(*tenant), nil
+}
+
+// Returns the predicate confining service account queries to the request's
+// organization, which is the organization of the owner group.
+func (s Server) serviceAccountScope(ctx context.Context) (
+	predicate.ServiceAccount, error,
+) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if nil == tenant {
+		return func(*sql.Selector) {}, nil
+	}
+	return serviceaccount.HasOwnerWith(group.OrganizationIDEQ(*tenant)), nil
+}
+
+// Returns the predicate confining separation-of-duties constraint queries to
+// the request's organization.
+func (s Server) sodConstraintScope(ctx context.Context) (
+	predicate.SodConstraint, error,
+) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if nil == tenant {
+		return func(*sql.Selector) {}, nil
+	}
+	return sodconstraint.OrganizationIDEQ(*tenant), nil
+}
+
+// Returns the predicate confining access request queries to the request's
+// organization.
+func (s Server) accessRequestScope(ctx context.Context) (
+	predicate.AccessRequest, error,
+) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if nil == tenant {
+		return func(*sql.Selector) {}, nil
+	}
+	return accessrequest.OrganizationIDEQ(*tenant), nil
+}
+
+// Returns the predicate confining webhook queries to the request's
+// organization.
+func (s Server) webhookScope(ctx context.Context) (predicate.Webhook, error) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if nil == tenant {
+		return func(*sql.Selector) {}, nil
+	}
+	return webhook.OrganizationIDEQ(*tenant), nil
+}
+
+// Returns the predicate confining OAuth client queries to the request's
+// organization.
+func (s Server) oauthClientScope(ctx context.Context) (
+	predicate.OauthClient, error,
+) {
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if nil == tenant {
+		return func(*sql.Selector) {}, nil
+	}
+	return oauthclient.OrganizationIDEQ(*tenant), nil
+}
+
+// Checks that all the roles belong to the organization. Roles can only be
+// assigned to users of the same organization.
+func checkTenantRoles(
+	qc context.Context, tx *ent.Tx, organization uint32, ids []uint32,
+) error {
+	found, err := tx.Role.Query().Where(
+		role.IDIn(ids...), role.OrganizationIDNEQ(organization),
+	).Exist(qc)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errCrossTenant
+	}
+	return nil
+}
+
+// Checks that the permissions can be granted by the request of the tenant.
+// Platform permissions would lift roles out of their organization, so only
+// platform administrators, whose tenant is nil, can grant them.
+func checkTenantPermissions(
+	qc context.Context, tx *ent.Tx, tenant *uint32, ids []uint32,
+) error {
+	if nil == tenant || 0 == len(ids) {
+		return nil
+	}
+	found, err := tx.Permission.Query().Where(
+		permission.IDIn(ids...),
+		permission.NameHasPrefix(api.PlatformPermissionPrefix),
+	).Exist(qc)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errAccessDenied
+	}
+	return nil
+}
+
+// Checks that all the users belong to the organization.
+func checkTenantUsers(
+	qc context.Context, tx *ent.Tx, organization uint32, ids []uint64,
+) error {
+	found, err := tx.User.Query().Where(
+		user.IDIn(ids...), user.OrganizationIDNEQ(organization),
+	).Exist(qc)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errCrossTenant
+	}
+	return nil
+}
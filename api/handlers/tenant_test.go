@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_tenant_user_lists_only_users_of_own_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o, u, _ := seedOrganization(t, db, "auth:ListUser")
+	req, err := svr.getAs(u, "/users")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, ListUserPaginateResponse{}, res)
+	require.Equal(t, 1, actual.Total)
+	require.Equal(t, u.ID, actual.Data[0].ID)
+	require.Equal(t, o.ID, actual.Data[0].OrganizationID)
+}
+
+func Test_tenant_user_cannot_read_user_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:ReadUser")
+	req, err := svr.getAs(u, "/user/2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_tenant_user_cannot_delete_role_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:DeleteRole")
+	req, err := svr.deleteAs(u, "/role/3")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.True(t, db.Role.Query().Where(role.IDEQ(3)).ExistX(context.Background()))
+}
+
+func Test_tenant_user_creates_user_in_own_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o, u, r := seedOrganization(t, db, "auth:CreateUser")
+	roles := []uint32{r.ID}
+	body := CreateUserJSONBody{
+		Username: "user1", Password: "Test_123", Roles: &roles,
+	}
+	req, err := svr.postAs(u, "/users", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	require.True(
+		t, db.User.Query().Where(
+			user.UsernameEQ("user1"), user.OrganizationIDEQ(o.ID),
+		).ExistX(context.Background()),
+	)
+}
+
+func Test_tenant_user_cannot_create_user_in_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:CreateUser")
+	organization := uint32(1)
+	body := CreateUserJSONBody{
+		Username: "test", Password: "Test_123", OrganizationId: &organization,
+	}
+	req, err := svr.postAs(u, "/users", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_tenant_user_cannot_assign_role_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:AssignRoles")
+	req, err := svr.postAs(u, fmt.Sprintf("/user/%d/roles", u.ID), []uint32{2})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_platform_admin_creates_user_in_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	o, _, _ := seedOrganization(t, db)
+	// usernames are unique within an organization, "user1" exists in default
+	body := CreateUserJSONBody{
+		Username: "user1", Password: "Test_123", OrganizationId: &o.ID,
+	}
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/users", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	require.Equal(
+		t, 2, db.User.Query().Where(user.UsernameEQ("user1")).
+			CountX(context.Background()),
+	)
+}
+
+func Test_token_of_other_organization_is_rejected(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	u.OrganizationID = 12345
+	req, err := svr.getAs(u, "/user/2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/eidng8/go-utils"
@@ -17,6 +18,7 @@ import (
 	"github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/accesstoken"
 	"github.com/eidng8/go-attr-rbac/ent/personaltoken"
+	"github.com/eidng8/go-attr-rbac/ent/session"
 	"github.com/eidng8/go-attr-rbac/ent/user"
 )
 
@@ -24,6 +26,13 @@ type jwtToken struct {
 	svr   *Server
 	token *jwt.Token
 	user  *ent.User
+	// the impersonator, if the token was issued by impersonation
+	actor *ent.User
+}
+
+// RFC 8693 actor claim, identifies the party acting on behalf of the subject.
+type actClaim struct {
+	Subject string `json:"sub"`
 }
 
 type accessTokenClaims struct {
@@ -31,8 +40,19 @@ type accessTokenClaims struct {
 	Roles  *[]string               `json:"roles,omitempty"`
 	Attr   *map[string]interface{} `json:"attr,omitempty"`
 	Scopes *[]string               `json:"scopes,omitempty"`
+	// ID of the organization the user belongs to
+	Tenant uint32 `json:"tenant"`
+	// the impersonator, only present in impersonation tokens
+	Act *actClaim `json:"act,omitempty"`
+	// the pending second factor, only present in login challenge tokens
+	Mfa string `json:"mfa,omitempty"`
+	// the OAuth client the token was issued to, only present in OAuth tokens
+	ClientId string `json:"client_id,omitempty"`
 }
 
+// Lifetime of login challenge tokens, to enter the one-time code.
+const mfaChallengeTtl = 5 * time.Minute
+
 // Returns the roles from the JWT token. It does NOT access the database, just
 // reads roles from the token's claims. To actually get roles from database,
 // use the user field directly.
@@ -92,6 +112,75 @@ func (tk *jwtToken) getAttr() (*map[string]interface{}, error) {
 	return &attr, nil
 }
 
+// Returns the organization ID from the token's tenant claim.
+// Doesn't access database. Doesn't log errors.
+func (tk *jwtToken) getTenant() (uint32, error) {
+	claims, ok := tk.token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, errInvalidToken
+	}
+	tenant, ok := claims["tenant"].(jso.Number)
+	if !ok {
+		return 0, errInvalidToken
+	}
+	id, err := strconv.ParseUint(tenant.String(), 10, 32)
+	if err != nil {
+		return 0, errInvalidToken
+	}
+	return uint32(id), nil
+}
+
+// Returns the impersonator's ID from the act claim, nil if the token was not
+// issued by impersonation.
+// Doesn't access database. Doesn't log errors.
+func (tk *jwtToken) getActorId() (*uint64, error) {
+	claims, ok := tk.token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errInvalidToken
+	}
+	act, ok := claims["act"]
+	if !ok {
+		return nil, nil
+	}
+	m, ok := act.(map[string]interface{})
+	if !ok {
+		return nil, errInvalidToken
+	}
+	sub, ok := m["sub"].(string)
+	if !ok {
+		return nil, errInvalidToken
+	}
+	id, err := strconv.ParseUint(sub, 10, 64)
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	return &id, nil
+}
+
+// Checks whether the token is a login challenge, which only proves the
+// password and must not grant access.
+// Doesn't access database. Doesn't log errors.
+func (tk *jwtToken) isMfaChallenge() bool {
+	claims, ok := tk.token.Claims.(jwt.MapClaims)
+	if !ok {
+		return false
+	}
+	_, ok = claims["mfa"]
+	return ok
+}
+
+// Returns the OAuth client the token was issued to, empty if the token wasn't
+// issued by the OAuth endpoints.
+// Doesn't access database. Doesn't log errors.
+func (tk *jwtToken) getClientId() string {
+	claims, ok := tk.token.Claims.(jwt.MapClaims)
+	if !ok {
+		return ""
+	}
+	id, _ := claims["client_id"].(string)
+	return id
+}
+
 // Returns the token's uuid from JTI.
 // Doesn't access database. Doesn't log errors.
 func (tk *jwtToken) getJti() (*uuid.UUID, error) {
@@ -132,12 +221,14 @@ func (tk *jwtToken) getUserBySubject() error {
 		api.Log.Debugf("failed to get subject from token: %v", err)
 		return errInvalidToken
 	}
-	id, err := strconv.ParseUint(subject, 10, 64)
+	sub, service := strings.CutPrefix(subject, api.ServiceSubjectPrefix)
+	id, err := strconv.ParseUint(sub, 10, 64)
 	if err != nil {
 		api.Log.Debugf("invalid subject %s", subject)
 		return errInvalidToken
 	}
-	u, err := tk.svr.db.User.Query().WithRoles().Where(user.IDEQ(id)).
+	u, err := tk.svr.db.User.Query().
+		Where(user.IDEQ(id), user.IsServiceAccountEQ(service)).
 		First(context.Background())
 	if err != nil {
 		api.Log.Debugf("query user error: %s", err)
@@ -147,6 +238,30 @@ func (tk *jwtToken) getUserBySubject() error {
 		api.Log.Debugf("user not found %d", id)
 		return errInvalidToken
 	}
+	// the user must still belong to the organization the token was issued in
+	tenant, err := tk.getTenant()
+	if err != nil || tenant != u.OrganizationID {
+		api.Log.Debugf("invalid tenant of user %d", id)
+		return errInvalidToken
+	}
+	if err = tk.svr.loadRoles(u); err != nil {
+		api.Log.Debugf("query user roles error: %s", err)
+		return errInvalidToken
+	}
+	actor, err := tk.getActorId()
+	if err != nil {
+		api.Log.Debugf("invalid act claim: %v", err)
+		return errInvalidToken
+	}
+	if nil != actor {
+		a, err := tk.svr.db.User.Query().Where(user.IDEQ(*actor)).
+			Only(context.Background())
+		if err != nil {
+			api.Log.Debugf("query impersonator error: %s", err)
+			return errInvalidToken
+		}
+		tk.actor = a
+	}
 	tk.user = u
 	return nil
 }
@@ -226,6 +341,11 @@ func (tk *jwtToken) checkRefreshToken() error {
 		api.Log.Debugf("refresh token invalid")
 		return errInvalidToken
 	}
+	// impersonation must not outlive its short-lived access token
+	if nil != tk.actor {
+		api.Log.Debugf("impersonation token cannot be refreshed")
+		return errInvalidToken
+	}
 	return nil
 }
 
@@ -255,11 +375,18 @@ func (tk *jwtToken) checkPersonalToken() error {
 	return nil
 }
 
-// checkToken checks JTI & SUB:
+// checkToken checks JTI, SUB & IAT:
 // returns true if the token pass the `valid()` check, otherwise false;
-// also calls getUserBySubject() if the token is valid.
+// also calls getUserBySubject() if the token is valid, and rejects it if
+// issued before the user's tokens were revoked, or if the user is disabled or
+// deleted. Impersonation tokens are checked the same against the actor, so
+// they die with the impersonator's account or tokens.
 // Accesses database. Debug logs errors.
 func (tk *jwtToken) checkToken(valid func([]byte) bool) (bool, error) {
+	if tk.isMfaChallenge() {
+		api.Log.Debugf("login challenge used as token")
+		return false, errInvalidToken
+	}
 	jti, err := tk.getJtiBinary()
 	if err != nil {
 		api.Log.Debugf("invalid jti: %v", err)
@@ -271,9 +398,45 @@ func (tk *jwtToken) checkToken(valid func([]byte) bool) (bool, error) {
 	if err = tk.getUserBySubject(); err != nil {
 		return true, errInvalidToken
 	}
+	if err = tk.checkValidAfter(tk.user); err != nil {
+		return true, err
+	}
+	if accountClosed(tk.user) {
+		api.Log.Debugf("token of %s user", tk.user.Status)
+		return true, errInvalidToken
+	}
+	if nil == tk.actor {
+		return true, nil
+	}
+	if err = tk.checkValidAfter(tk.actor); err != nil {
+		return true, err
+	}
+	if accountClosed(tk.actor) {
+		api.Log.Debugf("token of %s impersonator", tk.actor.Status)
+		return true, errInvalidToken
+	}
 	return true, nil
 }
 
+// Rejects tokens issued before the user's tokens were last revoked.
+// Timestamps in tokens are truncated to seconds, so tokens issued within the
+// second of the revocation are still accepted. Doesn't access database.
+func (tk *jwtToken) checkValidAfter(u *ent.User) error {
+	if nil == u.TokensValidAfter {
+		return nil
+	}
+	iat, err := tk.token.Claims.GetIssuedAt()
+	if err != nil || nil == iat {
+		api.Log.Debugf("invalid issued at %v", err)
+		return errInvalidToken
+	}
+	if iat.Before(u.TokensValidAfter.Truncate(time.Second)) {
+		api.Log.Debugf("token issued before revocation")
+		return errInvalidToken
+	}
+	return nil
+}
+
 // expired checks if the token is expired. Doesn't access database.
 // Debug logs errors.
 func (tk *jwtToken) expired() error {
@@ -295,12 +458,12 @@ func (tk *jwtToken) expired() error {
 
 // Issues an access token for the user. Doesn't access database.
 func (s Server) issueAccessToken(user *ent.User) (string, error) {
-	return s.issueJwtToken(user, time.Hour)
+	return s.issueJwtToken(user, accessTokenTtl)
 }
 
 // Issues a refresh token for the user. Doesn't access database.
 func (s Server) issueRefreshToken(user *ent.User) (string, error) {
-	return s.issueJwtToken(user, 7*24*time.Hour)
+	return s.issueJwtToken(user, refreshTokenTtl)
 }
 
 // Issues a personal token for the user. Doesn't access database.
@@ -319,6 +482,45 @@ func (s Server) issuePersonalToken(
 	return uid, token, nil
 }
 
+// Issues a short-lived access token for the user on behalf of the actor, who
+// is identified by the act claim. Returns the token and its expiration time.
+// Doesn't access database.
+func (s Server) issueImpersonationToken(user, actor *ent.User) (
+	string, *time.Time, error,
+) {
+	if actor == nil {
+		return "", nil, errInvalidArgument
+	}
+	_, claims, err := s.buildTokenClaims(user, s.impersonationTtl)
+	if err != nil {
+		return "", nil, err
+	}
+	claims.Act = &actClaim{Subject: fmt.Sprintf("%d", actor.ID)}
+	token, err := s.issueJwtTokenWithClaims(jwt.SigningMethodHS256, claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, &claims.ExpiresAt.Time, nil
+}
+
+// Issues a login challenge token for the user who passed the password check
+// and has to enter a one-time code. Returns the token and its expiration time.
+// Doesn't access database.
+func (s Server) issueMfaChallenge(user *ent.User) (string, *time.Time, error) {
+	_, claims, err := s.buildTokenClaims(user, mfaChallengeTtl)
+	if err != nil {
+		return "", nil, err
+	}
+	claims.Roles = nil
+	claims.Attr = nil
+	claims.Mfa = "totp"
+	token, err := s.issueJwtTokenWithClaims(jwt.SigningMethodHS256, claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, &claims.ExpiresAt.Time, nil
+}
+
 // issueAccessToken issues an access token for the user.
 // Doesn't access database.
 func (s Server) issueJwtToken(user *ent.User, ttl time.Duration) (
@@ -367,20 +569,31 @@ func (s Server) buildTokenClaims(user *ent.User, ttl time.Duration) (
 		)
 		roles = &r
 	}
+	// ttl is a duration already, scaling it by time.Second overflows
 	return &uid, &accessTokenClaims{
-		Roles: roles,
-		Attr:  attr,
+		Roles:  roles,
+		Attr:   attr,
+		Tenant: user.OrganizationID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        uid.String(),
 			Audience:  []string{s.Domain()}, // TODO allow customize?
 			Issuer:    s.Domain(),           // TODO allow customize?
-			Subject:   fmt.Sprintf("%d", user.ID),
+			Subject:   tokenSubject(user),
 			IssuedAt:  &jwt.NumericDate{Time: time.Now()},
-			ExpiresAt: &jwt.NumericDate{Time: time.Now().Add(ttl * time.Second)},
+			ExpiresAt: &jwt.NumericDate{Time: time.Now().Add(ttl)},
 		},
 	}, nil
 }
 
+// Returns the `sub` claim of the user's tokens. Subjects of service accounts
+// are prefixed, so they can't be taken for users.
+func tokenSubject(user *ent.User) string {
+	if user.IsServiceAccount {
+		return fmt.Sprintf("%s%d", api.ServiceSubjectPrefix, user.ID)
+	}
+	return fmt.Sprintf("%d", user.ID)
+}
+
 func (s Server) jwtTokenFromString(token string) (*jwtToken, error) {
 	t := jwtToken{svr: &s}
 	if err := t.parse(token); err != nil {
@@ -389,6 +602,28 @@ func (s Server) jwtTokenFromString(token string) (*jwtToken, error) {
 	return &t, nil
 }
 
+// mfaChallengeFromString verifies the login challenge token and retrieves its
+// user. Accesses database. Debug logs errors.
+func (s Server) mfaChallengeFromString(token string) (*jwtToken, error) {
+	t, err := s.jwtTokenFromString(token)
+	if err != nil {
+		api.Log.Debugf("parse challenge error: %v", err)
+		return nil, errInvalidToken
+	}
+	if !t.isMfaChallenge() {
+		api.Log.Debugf("not a login challenge")
+		return nil, errInvalidToken
+	}
+	if err = t.getUserBySubject(); err != nil {
+		return nil, err
+	}
+	if accountClosed(t.user) {
+		api.Log.Debugf("login challenge of %s user", t.user.Status)
+		return nil, errInvalidToken
+	}
+	return t, nil
+}
+
 // jwtTokenFromCookie gets token from the cookie. Doesn't access database.
 // Debug logs errors.
 func (s Server) jwtTokenFromCookie(gc *gin.Context, name string) (
@@ -440,6 +675,25 @@ func (s Server) getRefreshToken(gc *gin.Context) (*jwtToken, error) {
 	return token, nil
 }
 
+// Revokes all tokens of the user issued so far, access, refresh and personal
+// tokens alike, and forgets its sessions.
+func revokeUserTokens(qc context.Context, tx *ent.Tx, id uint64) error {
+	err := tx.User.UpdateOneID(id).SetTokensValidAfter(time.Now()).Exec(qc)
+	if err != nil {
+		return err
+	}
+	return forgetSessions(qc, tx, id)
+}
+
+// Revokes the tokens of the user whose roles changed, which carry the former
+// roles, and emits the `user.roles_changed` event.
+func userRolesChanged(qc context.Context, tx *ent.Tx, id uint64) error {
+	if err := revokeUserTokens(qc, tx, id); err != nil {
+		return err
+	}
+	return emitUserRolesChanged(qc, tx, id)
+}
+
 func (s Server) revokeAccessToken(ctx context.Context) error {
 	gc, ok := ctx.(*gin.Context)
 	if !ok {
@@ -488,7 +742,22 @@ func (s Server) revokeAccessToken(ctx context.Context) error {
 			if err != nil {
 				return nil, err
 			}
-			return nil, nil
+			_, err = tx.Session.Delete().
+				Where(session.RefreshTokenEQ(rtid)).Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+			jti, err := at.getJti()
+			if err != nil {
+				return nil, err
+			}
+			return nil, emitEvent(
+				ctx, tx, at.user.OrganizationID, api.EventTokenRevoked,
+				map[string]interface{}{
+					"user_id": at.user.ID, "jti": jti.String(),
+					"type": accessTokenName,
+				},
+			)
 		},
 	)
 	gc.SetSameSite(http.SameSiteStrictMode)
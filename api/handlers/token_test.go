@@ -1,19 +1,25 @@
 package handlers
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/eidng8/go-utils"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
 )
 
 func Test_issueAccessToken(t *testing.T) {
 	var actual any
 	server, _, db, _ := setupTestCase(t, false)
 	u := getUserById(t, db, 1)
-	require.Nil(t, loadRoles(u))
+	require.Nil(t, server.loadRoles(u))
 	accessToken, err := server.issueAccessToken(u)
 	require.Nil(t, err)
 	at, err := server.jwtTokenFromString(accessToken)
@@ -68,8 +74,221 @@ func Test_issueRefreshToken(t *testing.T) {
 	require.Nil(t, actual)
 }
 
+func Test_issueJwtToken_expires_after_ttl(t *testing.T) {
+	server, _, db, _ := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	at, err := server.issueAccessToken(u)
+	require.Nil(t, err)
+	rt, err := server.issueRefreshToken(u)
+	require.Nil(t, err)
+	_, pt, err := server.issuePersonalToken(
+		u, []string{"auth:Ping"}, 90*24*time.Hour,
+	)
+	require.Nil(t, err)
+	tests := []struct {
+		name  string
+		token string
+		ttl   time.Duration
+	}{
+		{"access token", at, time.Hour},
+		{"refresh token", rt, 7 * 24 * time.Hour},
+		{"personal token", pt, 90 * 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				token, err := server.jwtTokenFromString(tt.token)
+				require.Nil(t, err)
+				iat, err := token.token.Claims.GetIssuedAt()
+				require.Nil(t, err)
+				exp, err := token.token.Claims.GetExpirationTime()
+				require.Nil(t, err)
+				require.InDelta(
+					t, tt.ttl, exp.Sub(iat.Time), float64(time.Second),
+				)
+			},
+		)
+	}
+}
+
 func Test_issueJwtToken_handles_nil_user(t *testing.T) {
 	server, _, _, _ := setupTestCase(t, false)
 	_, err := server.issueJwtToken(nil, time.Hour)
 	require.True(t, errors.Is(err, errInvalidArgument))
 }
+
+func Test_issueAccessToken_includes_group_roles(t *testing.T) {
+	server, _, db, _ := setupTestCase(t, true)
+	seedGroup(t, db, []uint64{3}, []uint32{5})
+	u := getUserById(t, db, 3)
+	require.Nil(t, server.loadRoles(u))
+	accessToken, err := server.issueAccessToken(u)
+	require.Nil(t, err)
+	at, err := server.jwtTokenFromString(accessToken)
+	require.Nil(t, err)
+	roles, err := at.getRoles()
+	require.Nil(t, err)
+	require.Equal(t, &[]string{"role 3"}, roles)
+	require.Nil(t, at.getUserBySubject())
+	require.Equal(t, []uint32{5}, utils.Pluck(at.user.Edges.Roles, pluckRoleId))
+}
+
+func Test_issueImpersonationToken(t *testing.T) {
+	server, _, db, _ := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	actor := getUserById(t, db, 1)
+	require.Nil(t, server.loadRoles(u))
+	token, exp, err := server.issueImpersonationToken(u, actor)
+	require.Nil(t, err)
+	require.WithinDuration(
+		t, time.Now().Add(server.impersonationTtl), *exp, time.Minute,
+	)
+	at, err := server.jwtTokenFromString(token)
+	require.Nil(t, err)
+	id, err := at.getActorId()
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), *id)
+	require.Nil(t, at.getUserBySubject())
+	require.Equal(t, u.ID, at.user.ID)
+	require.Equal(t, actor.ID, at.actor.ID)
+	require.Nil(t, at.checkAccessToken())
+	require.NotNil(t, at.checkRefreshToken())
+}
+
+func Test_issueImpersonationToken_handles_nil_actor(t *testing.T) {
+	server, _, db, _ := setupTestCase(t, false)
+	_, _, err := server.issueImpersonationToken(getUserById(t, db, 2), nil)
+	require.True(t, errors.Is(err, errInvalidArgument))
+}
+
+func Test_getActorId_returns_nil_if_not_impersonated(t *testing.T) {
+	server, _, db, _ := setupTestCase(t, false)
+	token, err := server.issueAccessToken(getUserById(t, db, 1))
+	require.Nil(t, err)
+	at, err := server.jwtTokenFromString(token)
+	require.Nil(t, err)
+	id, err := at.getActorId()
+	require.Nil(t, err)
+	require.Nil(t, id)
+}
+
+func Test_getUserBySubject_tells_service_accounts_from_users(t *testing.T) {
+	server, _, db, _ := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	su := getUserById(t, db, a.UserID)
+	u := getUserById(t, db, 2)
+	token, err := server.issueAccessToken(su)
+	require.Nil(t, err)
+	tk, err := server.jwtTokenFromString(token)
+	require.Nil(t, err)
+	sub, err := tk.token.Claims.GetSubject()
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf("svc:%d", su.ID), sub)
+	require.Nil(t, tk.getUserBySubject())
+	require.Equal(t, su.ID, tk.user.ID)
+	// subjects without the prefix are users, and vice versa
+	for _, tt := range []struct {
+		user    *ent.User
+		subject string
+	}{
+		{su, fmt.Sprintf("%d", su.ID)},
+		{u, fmt.Sprintf("svc:%d", u.ID)},
+	} {
+		_, claims, err := server.buildTokenClaims(tt.user, time.Hour)
+		require.Nil(t, err)
+		claims.Subject = tt.subject
+		token, err = server.issueJwtTokenWithClaims(
+			jwt.SigningMethodHS256, claims,
+		)
+		require.Nil(t, err)
+		tk, err = server.jwtTokenFromString(token)
+		require.Nil(t, err)
+		require.ErrorIs(t, tk.getUserBySubject(), errInvalidToken)
+	}
+}
+
+func Test_checkValidAfter_rejects_tokens_issued_before_revocation(
+	t *testing.T,
+) {
+	svr, _, db, _ := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	at, err := svr.issueAccessToken(u)
+	require.Nil(t, err)
+	rt, err := svr.issueRefreshToken(u)
+	require.Nil(t, err)
+	jti, pt, err := svr.issuePersonalToken(u, []string{"auth:Ping"}, time.Hour)
+	require.Nil(t, err)
+	bin, err := jti.MarshalBinary()
+	require.Nil(t, err)
+	db.PersonalToken.Create().SetToken(bin).SetUserID(1).SetDescription("test").
+		ExecX(context.Background())
+	db.User.UpdateOneID(1).SetTokensValidAfter(time.Now().Add(2 * time.Second)).
+		ExecX(context.Background())
+	tk, err := svr.jwtTokenFromString(at)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkAccessToken(), errInvalidToken)
+	tk, err = svr.jwtTokenFromString(rt)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkRefreshToken(), errInvalidToken)
+	tk, err = svr.jwtTokenFromString(pt)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkPersonalToken(), errInvalidToken)
+}
+
+func Test_checkValidAfter_accepts_tokens_issued_after_revocation(
+	t *testing.T,
+) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	old := issueTokenAt(t, svr, u, time.Now().Add(-time.Minute))
+	db.User.UpdateOneID(1).SetTokensValidAfter(time.Now().Add(-time.Second)).
+		ExecX(context.Background())
+	res := getUsersWithToken(t, svr, engine, old)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	at, err := svr.issueAccessToken(u)
+	require.Nil(t, err)
+	res = getUsersWithToken(t, svr, engine, at)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_checkValidAfter_rejects_tokens_issued_before_role_change(
+	t *testing.T,
+) {
+	svr, engine, db, res := setupTestCase(t, true)
+	old := issueTokenAt(
+		t, svr, getUserById(t, db, 3), time.Now().Add(-time.Minute),
+	)
+	tk, err := svr.jwtTokenFromString(old)
+	require.Nil(t, err)
+	require.Nil(t, tk.checkAccessToken())
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/3/roles", []uint32{5})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	tk, err = svr.jwtTokenFromString(old)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkAccessToken(), errInvalidToken)
+	tk, err = svr.jwtTokenFromString(old)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkRefreshToken(), errInvalidToken)
+}
+
+func Test_checkValidAfter_rejects_tokens_issued_before_deletion(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	old := issueTokenAt(
+		t, svr, getUserById(t, db, 2), time.Now().Add(-time.Minute),
+	)
+	tk, err := svr.jwtTokenFromString(old)
+	require.Nil(t, err)
+	require.Nil(t, tk.checkAccessToken())
+	req, err := svr.deleteAs(getUserById(t, db, 1), "/user/2")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	tk, err = svr.jwtTokenFromString(old)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkAccessToken(), errInvalidToken)
+	tk, err = svr.jwtTokenFromString(old)
+	require.Nil(t, err)
+	require.ErrorIs(t, tk.checkRefreshToken(), errInvalidToken)
+}
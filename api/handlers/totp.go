@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// RFC 6238 parameters, the defaults of authenticator apps.
+const (
+	totpPeriod = 30
+	totpDigits = 6
+	// number of time steps before and after the current one to accept, to
+	// tolerate clock drift
+	totpSkew = 1
+	// number of recovery codes issued on enrollment
+	recoveryCodeCount = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Generates a random 160-bit TOTP secret, base32 encoded.
+func newTotpSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// Returns the RFC 6238 code of the time step.
+func totpCode(secret []byte, step int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, bin%1000000)
+}
+
+// Returns the time step the code was generated in, within the allowed skew of
+// `now`. Returns -1 if the code doesn't match.
+func matchTotp(secret, code string, now time.Time) int64 {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return -1
+	}
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if 1 == subtle.ConstantTimeCompare(
+			[]byte(totpCode(key, step)), []byte(code),
+		) {
+			return step
+		}
+	}
+	return -1
+}
+
+// Returns the `otpauth://` URI for authenticator apps to scan as QR code.
+func totpProvisioningUri(issuer, account, secret string) string {
+	label := url.PathEscape(issuer + ":" + account)
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", totpDigits))
+	query.Set("period", fmt.Sprintf("%d", totpPeriod))
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// Generates recovery codes, returns them along with their hashes to store.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	b := make([]byte, 10)
+	for i := range codes {
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(totpEncoding.EncodeToString(b))
+		codes[i] = code[:8] + "-" + code[8:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// Recovery codes carry 80 random bits, a plain SHA-256 suffices to store
+// them. Dashes and case are ignored.
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(code, "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// Returns the TOTP issuer shown in authenticator apps.
+func (s Server) getTotpIssuer() string {
+	if "" != s.totpIssuer {
+		return s.totpIssuer
+	}
+	if domain := s.Domain(); "" != domain {
+		return domain
+	}
+	return "go-attr-rbac"
+}
+
+// Checks the one-time code of the user within the transaction. The code is
+// either a TOTP code not used before, or an unused recovery code, which is
+// consumed. Returns whether the code was accepted, and whether it was a
+// recovery code.
+func verifySecondFactor(
+	qc context.Context, tx *ent.Tx, u *ent.User, code string,
+) (bool, bool, error) {
+	if nil == u.TotpSecret {
+		return false, false, nil
+	}
+	if step := matchTotp(*u.TotpSecret, code, time.Now()); step >= 0 {
+		// conditional update so that concurrent requests can't reuse a code
+		n, err := tx.User.Update().
+			Where(user.IDEQ(u.ID), user.TotpLastStepLT(step)).
+			SetTotpLastStep(step).Save(qc)
+		return n > 0, false, err
+	}
+	// re-reads the codes, another request may have consumed one meanwhile
+	codes, err := tx.User.Query().Where(user.IDEQ(u.ID)).
+		Select(user.FieldRecoveryCodes).Only(qc)
+	if err != nil {
+		return false, false, err
+	}
+	hash := hashRecoveryCode(code)
+	i := slices.Index(codes.RecoveryCodes, hash)
+	if i < 0 {
+		return false, false, nil
+	}
+	// codes are only removed or replaced, so the same length with the code
+	// still in it means nobody changed them after the read above
+	n, err := tx.User.Update().Where(
+		user.IDEQ(u.ID), func(s *sql.Selector) {
+			c := s.C(user.FieldRecoveryCodes)
+			s.Where(
+				sql.And(
+					sqljson.LenEQ(c, len(codes.RecoveryCodes)),
+					sqljson.ValueContains(c, hash),
+				),
+			)
+		},
+	).SetRecoveryCodes(slices.Delete(codes.RecoveryCodes, i, i+1)).Save(qc)
+	return n > 0, true, err
+}
+
+// Operations available to users who must enroll a second factor before
+// anything else. Changing the password is allowed, so that users with a
+// temporary password can get past checkMustChangePassword first.
+var mfaEnrollmentOperations = []string{
+	"auth:EnrollTotp", "auth:ConfirmTotp", "auth:Logout", "auth:ChangePassword",
+}
+
+// Returns errMfaRequired if a role of the user requires a second factor that
+// the user hasn't enrolled yet, unless the operation is part of enrollment.
+func (s Server) checkMfaEnrollment(u *ent.User, operation string) error {
+	if nil != u.TotpConfirmedAt ||
+		slices.Contains(mfaEnrollmentOperations, operation) {
+		return nil
+	}
+	required, err := s.db.Role.Query().
+		Where(effectiveRoles(u.ID), role.RequireMfaEQ(true)).
+		Exist(context.Background())
+	if err != nil {
+		return err
+	}
+	if required {
+		return errMfaRequired
+	}
+	return nil
+}
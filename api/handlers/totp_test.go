@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// Enables TOTP of the user, returns the secret.
+func enableTotp(tb testing.TB, db *ent.Client, id uint64) string {
+	secret, err := newTotpSecret()
+	require.Nil(tb, err)
+	_, hashes, err := newRecoveryCodes()
+	require.Nil(tb, err)
+	db.User.UpdateOneID(id).SetTotpSecret(secret).
+		SetTotpConfirmedAt(time.Now()).SetRecoveryCodes(hashes).
+		ExecX(context.Background())
+	return secret
+}
+
+// Returns the current TOTP code of the secret.
+func currentTotp(tb testing.TB, secret string) string {
+	key, err := totpEncoding.DecodeString(secret)
+	require.Nil(tb, err)
+	return totpCode(key, time.Now().Unix()/totpPeriod)
+}
+
+// Returns a recovery code of the user, replacing the stored ones.
+func recoveryCode(tb testing.TB, db *ent.Client, id uint64) string {
+	codes, hashes, err := newRecoveryCodes()
+	require.Nil(tb, err)
+	db.User.UpdateOneID(id).SetRecoveryCodes(hashes).
+		ExecX(context.Background())
+	return codes[0]
+}
+
+func Test_totpCode_matches_rfc6238_vectors(t *testing.T) {
+	key := []byte("12345678901234567890")
+	for ts, code := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		require.Equal(t, code, totpCode(key, ts/totpPeriod))
+	}
+}
+
+func Test_matchTotp_tolerates_one_step(t *testing.T) {
+	secret, err := newTotpSecret()
+	require.Nil(t, err)
+	key, err := totpEncoding.DecodeString(secret)
+	require.Nil(t, err)
+	now := time.Now()
+	step := now.Unix() / totpPeriod
+	require.Equal(t, step, matchTotp(secret, totpCode(key, step), now))
+	require.Equal(t, step-1, matchTotp(secret, totpCode(key, step-1), now))
+	require.Equal(t, step+1, matchTotp(secret, totpCode(key, step+1), now))
+	require.Equal(t, int64(-1), matchTotp(secret, totpCode(key, step-2), now))
+	require.Equal(t, int64(-1), matchTotp(secret, "12345", now))
+	require.Equal(t, int64(-1), matchTotp("not base32!", "123456", now))
+}
+
+func Test_totpProvisioningUri(t *testing.T) {
+	u, err := url.Parse(totpProvisioningUri("example.com", "user 0", "ABC"))
+	require.Nil(t, err)
+	require.Equal(t, "otpauth", u.Scheme)
+	require.Equal(t, "totp", u.Host)
+	require.Equal(t, "/example.com:user 0", u.Path)
+	require.Equal(t, "ABC", u.Query().Get("secret"))
+	require.Equal(t, "example.com", u.Query().Get("issuer"))
+	require.Equal(t, "6", u.Query().Get("digits"))
+	require.Equal(t, "30", u.Query().Get("period"))
+}
+
+func Test_newRecoveryCodes_returns_hashes(t *testing.T) {
+	codes, hashes, err := newRecoveryCodes()
+	require.Nil(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+	require.Len(t, hashes, recoveryCodeCount)
+	seen := map[string]bool{}
+	for i, code := range codes {
+		require.Len(t, code, 17)
+		require.False(t, seen[code])
+		seen[code] = true
+		require.Equal(t, hashes[i], hashRecoveryCode(code))
+		require.NotEqual(t, code, hashes[i])
+	}
+	require.Equal(
+		t, hashRecoveryCode("abcdefgh-ijklmnop"),
+		hashRecoveryCode("ABCDEFGHIJKLMNOP"),
+	)
+}
+
+func Test_getTotpIssuer_falls_back_to_domain(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	svr.baseUrl = "https://auth.example.com/"
+	require.Equal(t, "auth.example.com", svr.getTotpIssuer())
+	svr.totpIssuer = "Example"
+	require.Equal(t, "Example", svr.getTotpIssuer())
+	svr.totpIssuer = ""
+	svr.baseUrl = ""
+	require.Equal(t, "go-attr-rbac", svr.getTotpIssuer())
+}
+
+func Test_verifySecondFactor_rejects_reused_code(t *testing.T) {
+	_, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	secret := enableTotp(t, db, 2)
+	code := currentTotp(t, secret)
+	for _, expected := range []bool{true, false} {
+		tx, err := db.Tx(qc)
+		require.Nil(t, err)
+		u := tx.User.GetX(qc, 2)
+		ok, recovery, err := verifySecondFactor(qc, tx, u, code)
+		require.Nil(t, err)
+		require.Equal(t, expected, ok)
+		require.False(t, recovery)
+		require.Nil(t, tx.Commit())
+	}
+}
+
+func Test_verifySecondFactor_consumes_recovery_code(t *testing.T) {
+	_, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	enableTotp(t, db, 2)
+	code := recoveryCode(t, db, 2)
+	for _, expected := range []bool{true, false} {
+		tx, err := db.Tx(qc)
+		require.Nil(t, err)
+		u := tx.User.GetX(qc, 2)
+		ok, recovery, err := verifySecondFactor(qc, tx, u, code)
+		require.Nil(t, err)
+		require.Equal(t, expected, ok)
+		require.Equal(t, expected, recovery)
+		require.Nil(t, tx.Commit())
+	}
+	require.Len(t, db.User.GetX(qc, 2).RecoveryCodes, recoveryCodeCount-1)
+}
+
+func Test_verifySecondFactor_consumes_recovery_code_of_stale_user(t *testing.T) {
+	_, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	enableTotp(t, db, 2)
+	codes, hashes, err := newRecoveryCodes()
+	require.Nil(t, err)
+	db.User.UpdateOneID(2).SetRecoveryCodes(hashes).ExecX(qc)
+	// loaded before any of the concurrent requests consumed a code
+	stale := db.User.GetX(qc, 2)
+	for _, tc := range []struct {
+		code     string
+		expected bool
+	}{{codes[0], true}, {codes[0], false}, {codes[1], true}} {
+		tx, err := db.Tx(qc)
+		require.Nil(t, err)
+		ok, _, err := verifySecondFactor(qc, tx, stale, tc.code)
+		require.Nil(t, err)
+		require.Equal(t, tc.expected, ok)
+		require.Nil(t, tx.Commit())
+	}
+	// consuming the second code doesn't bring back the first one
+	require.Len(t, db.User.GetX(qc, 2).RecoveryCodes, recoveryCodeCount-2)
+}
+
+func Test_verifySecondFactor_rejects_if_not_enrolled(t *testing.T) {
+	_, _, db, _ := setupTestCase(t, false)
+	qc := context.Background()
+	tx, err := db.Tx(qc)
+	require.Nil(t, err)
+	defer func() { require.Nil(t, tx.Rollback()) }()
+	ok, _, err := verifySecondFactor(qc, tx, tx.User.GetX(qc, 2), "123456")
+	require.Nil(t, err)
+	require.False(t, ok)
+}
+
+func Test_checkMfaEnrollment_blocks_until_enrolled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	db.Role.UpdateOneID(3).SetRequireMfa(true).ExecX(qc)
+	grantPermissions(t, db, 2, "auth:ListUser", "auth:EnrollTotp")
+	req, err := svr.getAs(getUserById(t, db, 2), "/users")
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusForbidden,
+		"errors": msgMfaRequired,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+	req, err = svr.postAs(getUserById(t, db, 2), "/me/totp", nil)
+	require.Nil(t, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	enableTotp(t, db, 2)
+	req, err = svr.getAs(getUserById(t, db, 2), "/users")
+	require.Nil(t, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_checkMfaEnrollment_ignores_roles_not_requiring_mfa(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, false)
+	require.Nil(t, svr.checkMfaEnrollment(getUserById(t, db, 2), "auth:X"))
+}
@@ -10,6 +10,181 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AccessRequestListStatus.
+const (
+	AccessRequestListStatusApproved AccessRequestListStatus = "approved"
+	AccessRequestListStatusDenied   AccessRequestListStatus = "denied"
+	AccessRequestListStatusPending  AccessRequestListStatus = "pending"
+)
+
+// Defines values for AccessRequestReadStatus.
+const (
+	AccessRequestReadStatusApproved AccessRequestReadStatus = "approved"
+	AccessRequestReadStatusDenied   AccessRequestReadStatus = "denied"
+	AccessRequestReadStatusPending  AccessRequestReadStatus = "pending"
+)
+
+// Defines values for ImpersonationTokenTokenType.
+const (
+	Bearer ImpersonationTokenTokenType = "Bearer"
+)
+
+// Defines values for OauthClientCreateGrantTypes.
+const (
+	OauthClientCreateGrantTypesAuthorizationCode OauthClientCreateGrantTypes = "authorization_code"
+	OauthClientCreateGrantTypesClientCredentials OauthClientCreateGrantTypes = "client_credentials"
+	OauthClientCreateGrantTypesRefreshToken      OauthClientCreateGrantTypes = "refresh_token"
+)
+
+// Defines values for OauthClientListGrantTypes.
+const (
+	OauthClientListGrantTypesAuthorizationCode OauthClientListGrantTypes = "authorization_code"
+	OauthClientListGrantTypesClientCredentials OauthClientListGrantTypes = "client_credentials"
+	OauthClientListGrantTypesRefreshToken      OauthClientListGrantTypes = "refresh_token"
+)
+
+// Defines values for OauthClientReadGrantTypes.
+const (
+	OauthClientReadGrantTypesAuthorizationCode OauthClientReadGrantTypes = "authorization_code"
+	OauthClientReadGrantTypesClientCredentials OauthClientReadGrantTypes = "client_credentials"
+	OauthClientReadGrantTypesRefreshToken      OauthClientReadGrantTypes = "refresh_token"
+)
+
+// Defines values for UserReadStatus.
+const (
+	Active   UserReadStatus = "active"
+	Deleted  UserReadStatus = "deleted"
+	Disabled UserReadStatus = "disabled"
+	Locked   UserReadStatus = "locked"
+)
+
+// Defines values for WebhookEvents.
+const (
+	WebhookEventsRolePermissionsChanged WebhookEvents = "role.permissions_changed"
+	WebhookEventsTokenRevoked           WebhookEvents = "token.revoked"
+	WebhookEventsUserCreated            WebhookEvents = "user.created"
+	WebhookEventsUserDeleted            WebhookEvents = "user.deleted"
+	WebhookEventsUserRolesChanged       WebhookEvents = "user.roles_changed"
+)
+
+// Defines values for WebhookCreateEvents.
+const (
+	WebhookCreateEventsRolePermissionsChanged WebhookCreateEvents = "role.permissions_changed"
+	WebhookCreateEventsTokenRevoked           WebhookCreateEvents = "token.revoked"
+	WebhookCreateEventsUserCreated            WebhookCreateEvents = "user.created"
+	WebhookCreateEventsUserDeleted            WebhookCreateEvents = "user.deleted"
+	WebhookCreateEventsUserRolesChanged       WebhookCreateEvents = "user.roles_changed"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookListEvents.
+const (
+	WebhookListEventsRolePermissionsChanged WebhookListEvents = "role.permissions_changed"
+	WebhookListEventsTokenRevoked           WebhookListEvents = "token.revoked"
+	WebhookListEventsUserCreated            WebhookListEvents = "user.created"
+	WebhookListEventsUserDeleted            WebhookListEvents = "user.deleted"
+	WebhookListEventsUserRolesChanged       WebhookListEvents = "user.roles_changed"
+)
+
+// Defines values for WebhookReadEvents.
+const (
+	WebhookReadEventsRolePermissionsChanged WebhookReadEvents = "role.permissions_changed"
+	WebhookReadEventsTokenRevoked           WebhookReadEvents = "token.revoked"
+	WebhookReadEventsUserCreated            WebhookReadEvents = "user.created"
+	WebhookReadEventsUserDeleted            WebhookReadEvents = "user.deleted"
+	WebhookReadEventsUserRolesChanged       WebhookReadEvents = "user.roles_changed"
+)
+
+// Defines values for WebhookUpdateEvents.
+const (
+	WebhookUpdateEventsRolePermissionsChanged WebhookUpdateEvents = "role.permissions_changed"
+	WebhookUpdateEventsTokenRevoked           WebhookUpdateEvents = "token.revoked"
+	WebhookUpdateEventsUserCreated            WebhookUpdateEvents = "user.created"
+	WebhookUpdateEventsUserDeleted            WebhookUpdateEvents = "user.deleted"
+	WebhookUpdateEventsUserRolesChanged       WebhookUpdateEvents = "user.roles_changed"
+)
+
+// Defines values for WebhookDeliveriesListStatus.
+const (
+	WebhookDeliveriesListStatusDelivered WebhookDeliveriesListStatus = "delivered"
+	WebhookDeliveriesListStatusFailed    WebhookDeliveriesListStatus = "failed"
+	WebhookDeliveriesListStatusPending   WebhookDeliveriesListStatus = "pending"
+)
+
+// Defines values for ListAccessRequestParamsStatus.
+const (
+	Approved ListAccessRequestParamsStatus = "approved"
+	Denied   ListAccessRequestParamsStatus = "denied"
+	Pending  ListAccessRequestParamsStatus = "pending"
+)
+
+// Defines values for CreateOauthClientJSONBodyGrantTypes.
+const (
+	CreateOauthClientJSONBodyGrantTypesAuthorizationCode CreateOauthClientJSONBodyGrantTypes = "authorization_code"
+	CreateOauthClientJSONBodyGrantTypesClientCredentials CreateOauthClientJSONBodyGrantTypes = "client_credentials"
+	CreateOauthClientJSONBodyGrantTypesRefreshToken      CreateOauthClientJSONBodyGrantTypes = "refresh_token"
+)
+
+// Defines values for UpdateWebhookJSONBodyEvents.
+const (
+	UpdateWebhookJSONBodyEventsRolePermissionsChanged UpdateWebhookJSONBodyEvents = "role.permissions_changed"
+	UpdateWebhookJSONBodyEventsTokenRevoked           UpdateWebhookJSONBodyEvents = "token.revoked"
+	UpdateWebhookJSONBodyEventsUserCreated            UpdateWebhookJSONBodyEvents = "user.created"
+	UpdateWebhookJSONBodyEventsUserDeleted            UpdateWebhookJSONBodyEvents = "user.deleted"
+	UpdateWebhookJSONBodyEventsUserRolesChanged       UpdateWebhookJSONBodyEvents = "user.roles_changed"
+)
+
+// Defines values for CreateWebhookJSONBodyEvents.
+const (
+	RolePermissionsChanged CreateWebhookJSONBodyEvents = "role.permissions_changed"
+	TokenRevoked           CreateWebhookJSONBodyEvents = "token.revoked"
+	UserCreated            CreateWebhookJSONBodyEvents = "user.created"
+	UserDeleted            CreateWebhookJSONBodyEvents = "user.deleted"
+	UserRolesChanged       CreateWebhookJSONBodyEvents = "user.roles_changed"
+)
+
+// AccessRequestList defines model for AccessRequestList.
+type AccessRequestList struct {
+	CreatedAt      time.Time               `json:"created_at"`
+	Duration       *uint32                 `json:"duration,omitempty"`
+	Id             uint64                  `json:"id"`
+	Justification  string                  `json:"justification"`
+	OrganizationId uint32                  `json:"organization_id"`
+	RequesterId    uint64                  `json:"requester_id"`
+	ReviewComment  *string                 `json:"review_comment,omitempty"`
+	ReviewedAt     *time.Time              `json:"reviewed_at,omitempty"`
+	ReviewerId     *uint64                 `json:"reviewer_id,omitempty"`
+	RoleId         uint32                  `json:"role_id"`
+	Status         AccessRequestListStatus `json:"status"`
+}
+
+// AccessRequestListStatus defines model for AccessRequestList.Status.
+type AccessRequestListStatus string
+
+// AccessRequestRead defines model for AccessRequestRead.
+type AccessRequestRead struct {
+	CreatedAt      time.Time               `json:"created_at"`
+	Duration       *uint32                 `json:"duration,omitempty"`
+	Id             uint64                  `json:"id"`
+	Justification  string                  `json:"justification"`
+	OrganizationId uint32                  `json:"organization_id"`
+	RequesterId    uint64                  `json:"requester_id"`
+	ReviewComment  *string                 `json:"review_comment,omitempty"`
+	ReviewedAt     *time.Time              `json:"reviewed_at,omitempty"`
+	ReviewerId     *uint64                 `json:"reviewer_id,omitempty"`
+	RoleId         uint32                  `json:"role_id"`
+	Status         AccessRequestReadStatus `json:"status"`
+}
+
+// AccessRequestReadStatus defines model for AccessRequestRead.Status.
+type AccessRequestReadStatus string
+
 // AccessToken defines model for AccessToken.
 type AccessToken struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
@@ -18,6 +193,227 @@ type AccessToken struct {
 	UserId    uint64     `json:"user_id"`
 }
 
+// AuditLogList defines model for AuditLogList.
+type AuditLogList struct {
+	Action         string                  `json:"action"`
+	ActorId        *uint64                 `json:"actor_id,omitempty"`
+	CreatedAt      time.Time               `json:"created_at"`
+	Details        *map[string]interface{} `json:"details,omitempty"`
+	Id             uint64                  `json:"id"`
+	ImpersonatorId *uint64                 `json:"impersonator_id,omitempty"`
+	OrganizationId uint32                  `json:"organization_id"`
+}
+
+// Credential defines model for Credential.
+type Credential struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	Id         uint64     `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+	UserId     uint64     `json:"user_id"`
+}
+
+// Group defines model for Group.
+type Group struct {
+	CreatedAt      *time.Time   `json:"created_at,omitempty"`
+	Description    *string      `json:"description,omitempty"`
+	Id             uint32       `json:"id"`
+	Name           string       `json:"name"`
+	Organization   Organization `json:"organization"`
+	OrganizationId uint32       `json:"organization_id"`
+	Roles          *[]Role      `json:"roles,omitempty"`
+	UpdatedAt      *time.Time   `json:"updated_at,omitempty"`
+	Users          *[]User      `json:"users,omitempty"`
+}
+
+// GroupCreate defines model for GroupCreate.
+type GroupCreate struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// GroupList defines model for GroupList.
+type GroupList struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// GroupRead defines model for GroupRead.
+type GroupRead struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// GroupUpdate defines model for GroupUpdate.
+type GroupUpdate struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// GroupRolesList defines model for Group_RolesList.
+type GroupRolesList struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	RequireMfa     bool       `json:"require_mfa"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// GroupUsersList defines model for Group_UsersList.
+type GroupUsersList struct {
+	Attr *struct {
+		Dept  uint32 `json:"dept"`
+		Level uint8  `json:"level"`
+	} `json:"attr,omitempty"`
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	Id             uint64               `json:"id"`
+	OrganizationId uint32               `json:"organization_id"`
+	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
+	Username       string               `json:"username"`
+}
+
+// ImpersonationToken defines model for ImpersonationToken.
+type ImpersonationToken struct {
+	// AccessToken Access token carrying an `act` claim of the impersonator
+	AccessToken string                      `json:"access_token"`
+	ExpiresAt   time.Time                   `json:"expires_at"`
+	TokenType   ImpersonationTokenTokenType `json:"token_type"`
+}
+
+// ImpersonationTokenTokenType defines model for ImpersonationToken.TokenType.
+type ImpersonationTokenTokenType string
+
+// LoginChallenge Challenge to complete login with a second factor
+type LoginChallenge struct {
+	ChallengeToken string    `json:"challenge_token"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// OauthClientCreate defines model for OauthClientCreate.
+type OauthClientCreate struct {
+	ClientId string `json:"client_id"`
+
+	// ClientSecret Secret of confidential clients, only returned on creation
+	ClientSecret   *string                       `json:"client_secret,omitempty"`
+	CreatedAt      *time.Time                    `json:"created_at,omitempty"`
+	GrantTypes     []OauthClientCreateGrantTypes `json:"grant_types"`
+	Id             uint32                        `json:"id"`
+	Name           string                        `json:"name"`
+	OrganizationId uint32                        `json:"organization_id"`
+	Public         bool                          `json:"public"`
+	PublicKey      *string                       `json:"public_key,omitempty"`
+	RedirectUris   *[]string                     `json:"redirect_uris,omitempty"`
+	UpdatedAt      *time.Time                    `json:"updated_at,omitempty"`
+	UserId         *uint64                       `json:"user_id,omitempty"`
+}
+
+// OauthClientCreateGrantTypes defines model for OauthClientCreate.GrantTypes.
+type OauthClientCreateGrantTypes string
+
+// OauthClientList defines model for OauthClientList.
+type OauthClientList struct {
+	ClientId       string                      `json:"client_id"`
+	CreatedAt      *time.Time                  `json:"created_at,omitempty"`
+	GrantTypes     []OauthClientListGrantTypes `json:"grant_types"`
+	Id             uint32                      `json:"id"`
+	Name           string                      `json:"name"`
+	OrganizationId uint32                      `json:"organization_id"`
+	Public         bool                        `json:"public"`
+	PublicKey      *string                     `json:"public_key,omitempty"`
+	RedirectUris   *[]string                   `json:"redirect_uris,omitempty"`
+	UpdatedAt      *time.Time                  `json:"updated_at,omitempty"`
+	UserId         *uint64                     `json:"user_id,omitempty"`
+}
+
+// OauthClientListGrantTypes defines model for OauthClientList.GrantTypes.
+type OauthClientListGrantTypes string
+
+// OauthClientRead defines model for OauthClientRead.
+type OauthClientRead struct {
+	ClientId       string                      `json:"client_id"`
+	CreatedAt      *time.Time                  `json:"created_at,omitempty"`
+	GrantTypes     []OauthClientReadGrantTypes `json:"grant_types"`
+	Id             uint32                      `json:"id"`
+	Name           string                      `json:"name"`
+	OrganizationId uint32                      `json:"organization_id"`
+	Public         bool                        `json:"public"`
+	PublicKey      *string                     `json:"public_key,omitempty"`
+	RedirectUris   *[]string                   `json:"redirect_uris,omitempty"`
+	UpdatedAt      *time.Time                  `json:"updated_at,omitempty"`
+	UserId         *uint64                     `json:"user_id,omitempty"`
+}
+
+// OauthClientReadGrantTypes defines model for OauthClientRead.GrantTypes.
+type OauthClientReadGrantTypes string
+
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt      *time.Time       `json:"created_at,omitempty"`
+	Description    *string          `json:"description,omitempty"`
+	Groups         *[]Group         `json:"groups,omitempty"`
+	Id             uint32           `json:"id"`
+	Name           string           `json:"name"`
+	Roles          *[]Role          `json:"roles,omitempty"`
+	SodConstraints *[]SodConstraint `json:"sod_constraints,omitempty"`
+	UpdatedAt      *time.Time       `json:"updated_at,omitempty"`
+	Users          *[]User          `json:"users,omitempty"`
+}
+
+// OrganizationCreate defines model for OrganizationCreate.
+type OrganizationCreate struct {
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Id          uint32     `json:"id"`
+	Name        string     `json:"name"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// OrganizationList defines model for OrganizationList.
+type OrganizationList struct {
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Id          uint32     `json:"id"`
+	Name        string     `json:"name"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// OrganizationRead defines model for OrganizationRead.
+type OrganizationRead struct {
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Id          uint32     `json:"id"`
+	Name        string     `json:"name"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// OrganizationUpdate defines model for OrganizationUpdate.
+type OrganizationUpdate struct {
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Id          uint32     `json:"id"`
+	Name        string     `json:"name"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
 // Permission defines model for Permission.
 type Permission struct {
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
@@ -100,49 +496,78 @@ type PersonalTokenRead struct {
 
 // Role defines model for Role.
 type Role struct {
-	CreatedAt   *time.Time    `json:"created_at,omitempty"`
-	Description *string       `json:"description,omitempty"`
-	Id          uint32        `json:"id"`
-	Name        string        `json:"name"`
-	Permissions *[]Permission `json:"permissions,omitempty"`
-	UpdatedAt   *time.Time    `json:"updated_at,omitempty"`
-	Users       *[]User       `json:"users,omitempty"`
+	Approvers      *[]User          `json:"approvers,omitempty"`
+	Assignments    *[]UserRole      `json:"assignments,omitempty"`
+	CreatedAt      *time.Time       `json:"created_at,omitempty"`
+	Description    *string          `json:"description,omitempty"`
+	Groups         *[]Group         `json:"groups,omitempty"`
+	Id             uint32           `json:"id"`
+	Name           string           `json:"name"`
+	Organization   Organization     `json:"organization"`
+	OrganizationId uint32           `json:"organization_id"`
+	Permissions    *[]Permission    `json:"permissions,omitempty"`
+	RequireMfa     bool             `json:"require_mfa"`
+	SodConstraints *[]SodConstraint `json:"sod_constraints,omitempty"`
+	UpdatedAt      *time.Time       `json:"updated_at,omitempty"`
+	Users          *[]User          `json:"users,omitempty"`
 }
 
 // RoleCreate defines model for RoleCreate.
 type RoleCreate struct {
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	Description *string    `json:"description,omitempty"`
-	Id          uint32     `json:"id"`
-	Name        string     `json:"name"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	RequireMfa     bool       `json:"require_mfa"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
 }
 
 // RoleList defines model for RoleList.
 type RoleList struct {
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	Description *string    `json:"description,omitempty"`
-	Id          uint32     `json:"id"`
-	Name        string     `json:"name"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	RequireMfa     bool       `json:"require_mfa"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
 }
 
 // RoleRead defines model for RoleRead.
 type RoleRead struct {
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	Description *string    `json:"description,omitempty"`
-	Id          uint32     `json:"id"`
-	Name        string     `json:"name"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	RequireMfa     bool       `json:"require_mfa"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
 }
 
 // RoleUpdate defines model for RoleUpdate.
 type RoleUpdate struct {
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	Description *string    `json:"description,omitempty"`
-	Id          uint32     `json:"id"`
-	Name        string     `json:"name"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	RequireMfa     bool       `json:"require_mfa"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// RoleApproversList defines model for Role_ApproversList.
+type RoleApproversList struct {
+	Attr *struct {
+		Dept  uint32 `json:"dept"`
+		Level uint8  `json:"level"`
+	} `json:"attr,omitempty"`
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	Id             uint64               `json:"id"`
+	OrganizationId uint32               `json:"organization_id"`
+	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
+	Username       string               `json:"username"`
 }
 
 // RolePermissionsList defines model for Role_PermissionsList.
@@ -160,23 +585,253 @@ type RoleUsersList struct {
 		Dept  uint32 `json:"dept"`
 		Level uint8  `json:"level"`
 	} `json:"attr,omitempty"`
-	CreatedAt *time.Time           `json:"created_at,omitempty"`
-	Email     *openapi_types.Email `json:"email,omitempty"`
-	Id        uint64               `json:"id"`
-	UpdatedAt *time.Time           `json:"updated_at,omitempty"`
-	Username  string               `json:"username"`
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	Id             uint64               `json:"id"`
+	OrganizationId uint32               `json:"organization_id"`
+	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
+	Username       string               `json:"username"`
+}
+
+// ScimEmail SCIM user email
+type ScimEmail struct {
+	Primary *bool   `json:"primary,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	Value   string  `json:"value"`
+}
+
+// ScimError SCIM error response
+type ScimError struct {
+	Detail   *string  `json:"detail,omitempty"`
+	Schemas  []string `json:"schemas"`
+	ScimType *string  `json:"scimType,omitempty"`
+	Status   string   `json:"status"`
+}
+
+// ScimGroup SCIM group resource, maps to Role
+type ScimGroup struct {
+	DisplayName string        `json:"displayName"`
+	ExternalId  *string       `json:"externalId,omitempty"`
+	Id          *string       `json:"id,omitempty"`
+	Members     *[]ScimMember `json:"members,omitempty"`
+
+	// Meta SCIM resource metadata
+	Meta    *ScimMeta `json:"meta,omitempty"`
+	Schemas *[]string `json:"schemas,omitempty"`
+}
+
+// ScimGroupList SCIM list response
+type ScimGroupList struct {
+	Resources    []ScimGroup `json:"Resources"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Schemas      []string    `json:"schemas"`
+	StartIndex   int         `json:"startIndex"`
+	TotalResults int         `json:"totalResults"`
+}
+
+// ScimMember SCIM group member
+type ScimMember struct {
+	Ref     *string `json:"$ref,omitempty"`
+	Display *string `json:"display,omitempty"`
+	Value   string  `json:"value"`
+}
+
+// ScimMeta SCIM resource metadata
+type ScimMeta struct {
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     *string    `json:"location,omitempty"`
+	ResourceType *string    `json:"resourceType,omitempty"`
+}
+
+// ScimPatchOp SCIM PATCH request
+type ScimPatchOp struct {
+	Operations []ScimPatchOperation `json:"Operations"`
+	Schemas    *[]string            `json:"schemas,omitempty"`
+}
+
+// ScimPatchOperation SCIM PATCH operation
+type ScimPatchOperation struct {
+	Op    string       `json:"op"`
+	Path  *string      `json:"path,omitempty"`
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// ScimToken defines model for ScimToken.
+type ScimToken struct {
+	// Token Bearer token of the SCIM client, only returned once
+	Token string `json:"token"`
+}
+
+// ScimUser SCIM user resource, maps to User
+type ScimUser struct {
+	Active     *bool        `json:"active,omitempty"`
+	Emails     *[]ScimEmail `json:"emails,omitempty"`
+	ExternalId *string      `json:"externalId,omitempty"`
+	Id         *string      `json:"id,omitempty"`
+
+	// Meta SCIM resource metadata
+	Meta     *ScimMeta `json:"meta,omitempty"`
+	Password *string   `json:"password,omitempty"`
+	Schemas  *[]string `json:"schemas,omitempty"`
+	UserName string    `json:"userName"`
+}
+
+// ScimUserList SCIM list response
+type ScimUserList struct {
+	Resources    []ScimUser `json:"Resources"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Schemas      []string   `json:"schemas"`
+	StartIndex   int        `json:"startIndex"`
+	TotalResults int        `json:"totalResults"`
+}
+
+// ServiceAccountCreate defines model for ServiceAccountCreate.
+type ServiceAccountCreate struct {
+	ClientId string `json:"client_id"`
+
+	// ClientSecret Secret of accounts without public key, only returned on creation
+	ClientSecret *string    `json:"client_secret,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	Id           uint32     `json:"id"`
+	Name         string     `json:"name"`
+	OwnerId      uint32     `json:"owner_id"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	UserId       uint64     `json:"user_id"`
+}
+
+// ServiceAccountList defines model for ServiceAccountList.
+type ServiceAccountList struct {
+	ClientId    string     `json:"client_id"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Id          uint32     `json:"id"`
+	Name        string     `json:"name"`
+	OwnerId     uint32     `json:"owner_id"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	UserId      uint64     `json:"user_id"`
+}
+
+// ServiceAccountRead defines model for ServiceAccountRead.
+type ServiceAccountRead struct {
+	ClientId    string     `json:"client_id"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Id          uint32     `json:"id"`
+	Name        string     `json:"name"`
+	OwnerId     uint32     `json:"owner_id"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	UserId      uint64     `json:"user_id"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current Whether it is the session making the request
+	Current     bool       `json:"current"`
+	Id          uint64     `json:"id"`
+	Ip          string     `json:"ip"`
+	RefreshedAt *time.Time `json:"refreshed_at,omitempty"`
+	UserAgent   string     `json:"user_agent"`
+	UserId      uint64     `json:"user_id"`
+}
+
+// SodConstraint defines model for SodConstraint.
+type SodConstraint struct {
+	CreatedAt      *time.Time   `json:"created_at,omitempty"`
+	Description    *string      `json:"description,omitempty"`
+	Id             uint32       `json:"id"`
+	Name           string       `json:"name"`
+	Organization   Organization `json:"organization"`
+	OrganizationId uint32       `json:"organization_id"`
+	Roles          *[]Role      `json:"roles,omitempty"`
+	UpdatedAt      *time.Time   `json:"updated_at,omitempty"`
+}
+
+// SodConstraintCreate defines model for SodConstraintCreate.
+type SodConstraintCreate struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// SodConstraintList defines model for SodConstraintList.
+type SodConstraintList struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// SodConstraintRead defines model for SodConstraintRead.
+type SodConstraintRead struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// SodConstraintUpdate defines model for SodConstraintUpdate.
+type SodConstraintUpdate struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// SodConstraintRolesList defines model for SodConstraint_RolesList.
+type SodConstraintRolesList struct {
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	RequireMfa     bool       `json:"require_mfa"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+// SodViolation defines model for SodViolation.
+type SodViolation struct {
+	Roles    []string `json:"roles"`
+	UserId   uint64   `json:"user_id"`
+	Username string   `json:"username"`
+}
+
+// TotpEnrollment defines model for TotpEnrollment.
+type TotpEnrollment struct {
+	// ProvisioningUri `otpauth://` URI to render as QR code
+	ProvisioningUri string `json:"provisioning_uri"`
+
+	// Secret Base32 encoded secret for manual entry
+	Secret string `json:"secret"`
 }
 
 // User defines model for User.
 type User struct {
-	AccessTokens *[]AccessToken `json:"access_tokens,omitempty"`
-	Attr         *struct {
+	AccessTokens    *[]AccessToken `json:"access_tokens,omitempty"`
+	ApprovableRoles *[]Role        `json:"approvable_roles,omitempty"`
+	Assignments     *[]UserRole    `json:"assignments,omitempty"`
+	Attr            *struct {
 		Dept  uint32 `json:"dept"`
 		Level uint8  `json:"level"`
 	} `json:"attr,omitempty"`
 	CreatedAt      *time.Time           `json:"created_at,omitempty"`
 	Email          *openapi_types.Email `json:"email,omitempty"`
+	Groups         *[]Group             `json:"groups,omitempty"`
 	Id             uint64               `json:"id"`
+	Organization   Organization         `json:"organization"`
+	OrganizationId uint32               `json:"organization_id"`
 	PersonalTokens *[]PersonalToken     `json:"personal_tokens,omitempty"`
 	RefreshTokens  *[]AccessToken       `json:"refresh_tokens,omitempty"`
 	Roles          *[]Role              `json:"roles,omitempty"`
@@ -190,11 +845,12 @@ type UserCreate struct {
 		Dept  uint32 `json:"dept"`
 		Level uint8  `json:"level"`
 	} `json:"attr,omitempty"`
-	CreatedAt *time.Time           `json:"created_at,omitempty"`
-	Email     *openapi_types.Email `json:"email,omitempty"`
-	Id        uint64               `json:"id"`
-	UpdatedAt *time.Time           `json:"updated_at,omitempty"`
-	Username  string               `json:"username"`
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	Id             uint64               `json:"id"`
+	OrganizationId uint32               `json:"organization_id"`
+	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
+	Username       string               `json:"username"`
 }
 
 // UserList defines model for UserList.
@@ -203,11 +859,12 @@ type UserList struct {
 		Dept  uint32 `json:"dept"`
 		Level uint8  `json:"level"`
 	} `json:"attr,omitempty"`
-	CreatedAt *time.Time           `json:"created_at,omitempty"`
-	Email     *openapi_types.Email `json:"email,omitempty"`
-	Id        uint64               `json:"id"`
-	UpdatedAt *time.Time           `json:"updated_at,omitempty"`
-	Username  string               `json:"username"`
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	Id             uint64               `json:"id"`
+	OrganizationId uint32               `json:"organization_id"`
+	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
+	Username       string               `json:"username"`
 }
 
 // UserRead defines model for UserRead.
@@ -219,11 +876,29 @@ type UserRead struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
 	// DeletedAt Date and time when the record was deleted
-	DeletedAt *time.Time           `json:"deleted_at"`
-	Email     *openapi_types.Email `json:"email,omitempty"`
-	Id        uint64               `json:"id"`
-	UpdatedAt *time.Time           `json:"updated_at,omitempty"`
-	Username  string               `json:"username"`
+	DeletedAt      *time.Time           `json:"deleted_at"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	Id             uint64               `json:"id"`
+	OrganizationId uint32               `json:"organization_id"`
+
+	// Status State of the account, disabled and deleted users can't authenticate
+	Status    *UserReadStatus `json:"status,omitempty"`
+	UpdatedAt *time.Time      `json:"updated_at,omitempty"`
+	Username  string          `json:"username"`
+}
+
+// UserReadStatus State of the account, disabled and deleted users can't authenticate
+type UserReadStatus string
+
+// UserRole defines model for UserRole.
+type UserRole struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Id        int64      `json:"id"`
+	Role      Role       `json:"role"`
+	RoleId    int64      `json:"role_id"`
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+	User      User       `json:"user"`
+	UserId    int64      `json:"user_id"`
 }
 
 // UserUpdate defines model for UserUpdate.
@@ -232,22 +907,147 @@ type UserUpdate struct {
 		Dept  uint32 `json:"dept"`
 		Level uint8  `json:"level"`
 	} `json:"attr,omitempty"`
-	CreatedAt *time.Time           `json:"created_at,omitempty"`
-	Email     *openapi_types.Email `json:"email,omitempty"`
-	Id        uint64               `json:"id"`
-	UpdatedAt *time.Time           `json:"updated_at,omitempty"`
-	Username  string               `json:"username"`
+	CreatedAt      *time.Time           `json:"created_at,omitempty"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	Id             uint64               `json:"id"`
+	OrganizationId uint32               `json:"organization_id"`
+	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
+	Username       string               `json:"username"`
 }
 
 // UserRolesList defines model for User_RolesList.
 type UserRolesList struct {
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
 	Description *string    `json:"description,omitempty"`
-	Id          uint32     `json:"id"`
-	Name        string     `json:"name"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// ExpiresAt Time at which the assignment expires
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	Id             uint32     `json:"id"`
+	Name           string     `json:"name"`
+	OrganizationId uint32     `json:"organization_id"`
+	RequireMfa     bool       `json:"require_mfa"`
+
+	// StartsAt Time from which the assignment is in effect
+	StartsAt  *time.Time `json:"starts_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// WebauthnCeremony Options to pass to the browser's WebAuthn API
+type WebauthnCeremony struct {
+	// Options `CredentialCreationOptions` or `CredentialRequestOptions`
+	Options map[string]interface{} `json:"options"`
+
+	// SessionToken Token to send back to finish the ceremony
+	SessionToken string `json:"session_token"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Active         bool               `json:"active"`
+	CreatedAt      *time.Time         `json:"created_at,omitempty"`
+	Deliveries     *[]WebhookDelivery `json:"deliveries,omitempty"`
+	Events         []WebhookEvents    `json:"events"`
+	Id             uint32             `json:"id"`
+	Organization   Organization       `json:"organization"`
+	OrganizationId uint32             `json:"organization_id"`
+	UpdatedAt      *time.Time         `json:"updated_at,omitempty"`
+	Url            string             `json:"url"`
+}
+
+// WebhookEvents defines model for Webhook.Events.
+type WebhookEvents string
+
+// WebhookCreate defines model for WebhookCreate.
+type WebhookCreate struct {
+	Active         bool                  `json:"active"`
+	CreatedAt      *time.Time            `json:"created_at,omitempty"`
+	Events         []WebhookCreateEvents `json:"events"`
+	Id             uint32                `json:"id"`
+	OrganizationId uint32                `json:"organization_id"`
+	UpdatedAt      *time.Time            `json:"updated_at,omitempty"`
+	Url            string                `json:"url"`
+}
+
+// WebhookCreateEvents defines model for WebhookCreate.Events.
+type WebhookCreateEvents string
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts      int64                 `json:"attempts"`
+	CreatedAt     *time.Time            `json:"created_at,omitempty"`
+	DeliveredAt   *time.Time            `json:"delivered_at,omitempty"`
+	Event         string                `json:"event"`
+	Id            uint64                `json:"id"`
+	LastError     *string               `json:"last_error,omitempty"`
+	NextAttemptAt time.Time             `json:"next_attempt_at"`
+	ResponseCode  *int                  `json:"response_code,omitempty"`
+	Status        WebhookDeliveryStatus `json:"status"`
+	Webhook       Webhook               `json:"webhook"`
+	WebhookId     uint32                `json:"webhook_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookList defines model for WebhookList.
+type WebhookList struct {
+	Active         bool                `json:"active"`
+	CreatedAt      *time.Time          `json:"created_at,omitempty"`
+	Events         []WebhookListEvents `json:"events"`
+	Id             uint32              `json:"id"`
+	OrganizationId uint32              `json:"organization_id"`
+	UpdatedAt      *time.Time          `json:"updated_at,omitempty"`
+	Url            string              `json:"url"`
+}
+
+// WebhookListEvents defines model for WebhookList.Events.
+type WebhookListEvents string
+
+// WebhookRead defines model for WebhookRead.
+type WebhookRead struct {
+	Active         bool                `json:"active"`
+	CreatedAt      *time.Time          `json:"created_at,omitempty"`
+	Events         []WebhookReadEvents `json:"events"`
+	Id             uint32              `json:"id"`
+	OrganizationId uint32              `json:"organization_id"`
+	UpdatedAt      *time.Time          `json:"updated_at,omitempty"`
+	Url            string              `json:"url"`
+}
+
+// WebhookReadEvents defines model for WebhookRead.Events.
+type WebhookReadEvents string
+
+// WebhookUpdate defines model for WebhookUpdate.
+type WebhookUpdate struct {
+	Active         bool                  `json:"active"`
+	CreatedAt      *time.Time            `json:"created_at,omitempty"`
+	Events         []WebhookUpdateEvents `json:"events"`
+	Id             uint32                `json:"id"`
+	OrganizationId uint32                `json:"organization_id"`
+	UpdatedAt      *time.Time            `json:"updated_at,omitempty"`
+	Url            string                `json:"url"`
+}
+
+// WebhookUpdateEvents defines model for WebhookUpdate.Events.
+type WebhookUpdateEvents string
+
+// WebhookDeliveriesList defines model for Webhook_DeliveriesList.
+type WebhookDeliveriesList struct {
+	Attempts      int64                       `json:"attempts"`
+	CreatedAt     *time.Time                  `json:"created_at,omitempty"`
+	DeliveredAt   *time.Time                  `json:"delivered_at,omitempty"`
+	Event         string                      `json:"event"`
+	Id            uint64                      `json:"id"`
+	LastError     *string                     `json:"last_error,omitempty"`
+	NextAttemptAt time.Time                   `json:"next_attempt_at"`
+	ResponseCode  *int                        `json:"response_code,omitempty"`
+	Status        WebhookDeliveriesListStatus `json:"status"`
+	WebhookId     uint32                      `json:"webhook_id"`
+}
+
+// WebhookDeliveriesListStatus defines model for WebhookDeliveriesList.Status.
+type WebhookDeliveriesListStatus string
+
 // N400 defines model for 400.
 type N400 struct {
 	Code   int          `json:"code"`
@@ -290,6 +1090,13 @@ type N422 struct {
 	Status string       `json:"status"`
 }
 
+// N429 defines model for 429.
+type N429 struct {
+	Code   int          `json:"code"`
+	Errors *interface{} `json:"errors,omitempty"`
+	Status string       `json:"status"`
+}
+
 // N500 defines model for 500.
 type N500 struct {
 	Code   int          `json:"code"`
@@ -297,10 +1104,284 @@ type N500 struct {
 	Status string       `json:"status"`
 }
 
+// ApproveAccessRequestJSONBody defines parameters for ApproveAccessRequest.
+type ApproveAccessRequestJSONBody struct {
+	Comment *string `json:"comment,omitempty"`
+}
+
+// DenyAccessRequestJSONBody defines parameters for DenyAccessRequest.
+type DenyAccessRequestJSONBody struct {
+	Comment *string `json:"comment,omitempty"`
+}
+
+// ListAccessRequestParams defines parameters for ListAccessRequest.
+type ListAccessRequestParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Status Status of the requests
+	Status *ListAccessRequestParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// RoleId ID of the requested role
+	RoleId *uint32 `form:"role_id,omitempty" json:"role_id,omitempty"`
+
+	// RequesterId ID of the requesting user
+	RequesterId *uint64 `form:"requester_id,omitempty" json:"requester_id,omitempty"`
+}
+
+// ListAccessRequestParamsStatus defines parameters for ListAccessRequest.
+type ListAccessRequestParamsStatus string
+
+// CreateAccessRequestJSONBody defines parameters for CreateAccessRequest.
+type CreateAccessRequestJSONBody struct {
+	// Duration Duration of the assignment in seconds, omit for indefinite
+	Duration      *uint32 `json:"duration,omitempty"`
+	Justification string  `json:"justification"`
+	RoleId        uint32  `json:"role_id"`
+}
+
+// CheckAccessTokenParams defines parameters for CheckAccessToken.
+type CheckAccessTokenParams struct {
+	// Operation Also check the user is allowed the operation, named as `domain:Operation`, the `auth` domain if omitted
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty"`
+}
+
+// ListAuditLogParams defines parameters for ListAuditLog.
+type ListAuditLogParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Action Action of the entries
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+}
+
+// UpdateGroupJSONBody defines parameters for UpdateGroup.
+type UpdateGroupJSONBody struct {
+	Description *string   `json:"description,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Roles       *[]uint32 `json:"roles,omitempty"`
+	Users       *[]uint64 `json:"users,omitempty"`
+}
+
+// ListGroupRolesParams defines parameters for ListGroupRoles.
+type ListGroupRolesParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Name Name of the role
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// AssignGroupRolesJSONBody defines parameters for AssignGroupRoles.
+type AssignGroupRolesJSONBody = []uint32
+
+// ListGroupUsersParams defines parameters for ListGroupUsers.
+type ListGroupUsersParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Name Name of the user
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// AddGroupMembersJSONBody defines parameters for AddGroupMembers.
+type AddGroupMembersJSONBody = []uint64
+
+// ListGroupParams defines parameters for ListGroup.
+type ListGroupParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Name Name of the group
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// CreateGroupJSONBody defines parameters for CreateGroup.
+type CreateGroupJSONBody struct {
+	Description    *string   `json:"description,omitempty"`
+	Name           string    `json:"name"`
+	OrganizationId *uint32   `json:"organization_id,omitempty"`
+	Roles          *[]uint32 `json:"roles,omitempty"`
+	Users          *[]uint64 `json:"users,omitempty"`
+}
+
 // LoginJSONBody defines parameters for Login.
 type LoginJSONBody struct {
+	// Email Email, if the deployment accepts email sign-in
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// Organization ID of the organization to sign in to, defaults to 1
+	Organization *uint32 `json:"organization,omitempty"`
+	Password     string  `json:"password"`
+
+	// Username Username, or email if the deployment accepts either
+	Username *string `json:"username,omitempty"`
+}
+
+// FinishOidcLoginJSONBody defines parameters for FinishOidcLogin.
+type FinishOidcLoginJSONBody struct {
+	Code         string `json:"code"`
+	SessionToken string `json:"session_token"`
+	State        string `json:"state"`
+}
+
+// VerifyTotpJSONBody defines parameters for VerifyTotp.
+type VerifyTotpJSONBody struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"`
+}
+
+// FinishWebauthnLoginJSONBody defines parameters for FinishWebauthnLogin.
+type FinishWebauthnLoginJSONBody struct {
+	// Credential `PublicKeyCredential` returned by the browser
+	Credential   map[string]interface{} `json:"credential"`
+	SessionToken string                 `json:"session_token"`
+}
+
+// ChangePasswordJSONBody defines parameters for ChangePassword.
+type ChangePasswordJSONBody struct {
+	CurrentPassword string `json:"current_password"`
+	Password        string `json:"password"`
+}
+
+// ConfirmTotpJSONBody defines parameters for ConfirmTotp.
+type ConfirmTotpJSONBody struct {
+	Code string `json:"code"`
+}
+
+// DisableTotpJSONBody defines parameters for DisableTotp.
+type DisableTotpJSONBody struct {
+	Code string `json:"code"`
+}
+
+// FinishWebauthnRegistrationJSONBody defines parameters for FinishWebauthnRegistration.
+type FinishWebauthnRegistrationJSONBody struct {
+	// Credential `PublicKeyCredential` returned by the browser
+	Credential map[string]interface{} `json:"credential"`
+
+	// Name Name to tell the passkey apart
+	Name         *string `json:"name,omitempty"`
+	SessionToken string  `json:"session_token"`
+}
+
+// ListOauthClientParams defines parameters for ListOauthClient.
+type ListOauthClientParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// CreateOauthClientJSONBody defines parameters for CreateOauthClient.
+type CreateOauthClientJSONBody struct {
+	GrantTypes     []CreateOauthClientJSONBodyGrantTypes `json:"grant_types"`
+	Name           string                                `json:"name"`
+	OrganizationId *uint32                               `json:"organization_id,omitempty"`
+	Public         *bool                                 `json:"public,omitempty"`
+	PublicKey      *string                               `json:"public_key,omitempty"`
+	RedirectUris   *[]string                             `json:"redirect_uris,omitempty"`
+	UserId         *uint64                               `json:"user_id,omitempty"`
+}
+
+// CreateOauthClientJSONBodyGrantTypes defines parameters for CreateOauthClient.
+type CreateOauthClientJSONBodyGrantTypes string
+
+// OauthAuthorizeParams defines parameters for OauthAuthorize.
+type OauthAuthorizeParams struct {
+	// ResponseType Must be `code`
+	ResponseType *string `form:"response_type,omitempty" json:"response_type,omitempty"`
+
+	// ClientId Client to issue the code to
+	ClientId *string `form:"client_id,omitempty" json:"client_id,omitempty"`
+
+	// RedirectUri One of the redirect URIs of the client
+	RedirectUri *string `form:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
+
+	// Scope Scopes separated by space
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty"`
+
+	// State Returned to the client unchanged
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Nonce OpenID Connect nonce
+	Nonce *string `form:"nonce,omitempty" json:"nonce,omitempty"`
+
+	// CodeChallenge PKCE code challenge
+	CodeChallenge *string `form:"code_challenge,omitempty" json:"code_challenge,omitempty"`
+
+	// CodeChallengeMethod Must be `S256`
+	CodeChallengeMethod *string `form:"code_challenge_method,omitempty" json:"code_challenge_method,omitempty"`
+}
+
+// OauthTokenFormdataBody defines parameters for OauthToken.
+type OauthTokenFormdataBody struct {
+	ClientAssertion     *string `form:"client_assertion" json:"client_assertion"`
+	ClientAssertionType *string `form:"client_assertion_type" json:"client_assertion_type"`
+	ClientId            *string `form:"client_id" json:"client_id"`
+	ClientSecret        *string `form:"client_secret" json:"client_secret"`
+	Code                *string `form:"code" json:"code"`
+	CodeVerifier        *string `form:"code_verifier" json:"code_verifier"`
+	GrantType           string  `form:"grant_type" json:"grant_type"`
+	RedirectUri         *string `form:"redirect_uri" json:"redirect_uri"`
+	RefreshToken        *string `form:"refresh_token" json:"refresh_token"`
+	Scope               *string `form:"scope" json:"scope"`
+}
+
+// UpdateOrganizationJSONBody defines parameters for UpdateOrganization.
+type UpdateOrganizationJSONBody struct {
+	Description    *string   `json:"description,omitempty"`
+	Groups         *[]uint32 `json:"groups,omitempty"`
+	Name           *string   `json:"name,omitempty"`
+	SodConstraints *[]uint32 `json:"sod_constraints,omitempty"`
+}
+
+// ListOrganizationParams defines parameters for ListOrganization.
+type ListOrganizationParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// CreateOrganizationJSONBody defines parameters for CreateOrganization.
+type CreateOrganizationJSONBody struct {
+	Description    *string   `json:"description,omitempty"`
+	Groups         *[]uint32 `json:"groups,omitempty"`
+	Name           string    `json:"name"`
+	SodConstraints *[]uint32 `json:"sod_constraints,omitempty"`
+}
+
+// ForgotPasswordJSONBody defines parameters for ForgotPassword.
+type ForgotPasswordJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+
+	// Organization ID of the organization to sign in to, defaults to 1
+	Organization *uint32 `json:"organization,omitempty"`
+}
+
+// ResetPasswordJSONBody defines parameters for ResetPassword.
+type ResetPasswordJSONBody struct {
 	Password string `json:"password"`
-	Username string `json:"username"`
+
+	// Token Token received by email
+	Token string `json:"token"`
 }
 
 // ReadPermissionParams defines parameters for ReadPermission.
@@ -373,10 +1454,25 @@ type HintUsersParams struct {
 
 // UpdateRoleJSONBody defines parameters for UpdateRole.
 type UpdateRoleJSONBody struct {
-	Description *string   `json:"description,omitempty"`
-	Name        *string   `json:"name,omitempty"`
-	Permissions *[]uint32 `json:"permissions,omitempty"`
-	Users       *[]uint64 `json:"users,omitempty"`
+	Approvers      *[]uint64 `json:"approvers,omitempty"`
+	Description    *string   `json:"description,omitempty"`
+	Name           *string   `json:"name,omitempty"`
+	Permissions    *[]uint32 `json:"permissions,omitempty"`
+	RequireMfa     *bool     `json:"require_mfa,omitempty"`
+	SodConstraints *[]uint32 `json:"sod_constraints,omitempty"`
+	Users          *[]uint64 `json:"users,omitempty"`
+}
+
+// ListRoleApproversParams defines parameters for ListRoleApprovers.
+type ListRoleApproversParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Name Name of the user
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
 }
 
 // ListRolePermissionsParams defines parameters for ListRolePermissions.
@@ -420,10 +1516,96 @@ type ListRoleParams struct {
 
 // CreateRoleJSONBody defines parameters for CreateRole.
 type CreateRoleJSONBody struct {
+	Approvers      *[]uint64 `json:"approvers,omitempty"`
+	Description    *string   `json:"description,omitempty"`
+	Name           string    `json:"name"`
+	OrganizationId *uint32   `json:"organization_id,omitempty"`
+	Permissions    *[]uint32 `json:"permissions,omitempty"`
+	RequireMfa     *bool     `json:"require_mfa,omitempty"`
+	SodConstraints *[]uint32 `json:"sod_constraints,omitempty"`
+	Users          *[]uint64 `json:"users,omitempty"`
+}
+
+// ScimListGroupsParams defines parameters for ScimListGroups.
+type ScimListGroupsParams struct {
+	// Filter SCIM filter expression
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// StartIndex 1-based index of the first result
+	StartIndex *int `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+
+	// Count Maximum number of results per page
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// ScimListUsersParams defines parameters for ScimListUsers.
+type ScimListUsersParams struct {
+	// Filter SCIM filter expression
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// StartIndex 1-based index of the first result
+	StartIndex *int `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+
+	// Count Maximum number of results per page
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// ListServiceAccountParams defines parameters for ListServiceAccount.
+type ListServiceAccountParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// CreateServiceAccountJSONBody defines parameters for CreateServiceAccount.
+type CreateServiceAccountJSONBody struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	OwnerId     uint32  `json:"owner_id"`
+
+	// PublicKey PEM encoded public key verifying the JWT assertions the account authenticates with. A client secret is generated if omitted.
+	PublicKey *string `json:"public_key,omitempty"`
+}
+
+// UpdateSodConstraintJSONBody defines parameters for UpdateSodConstraint.
+type UpdateSodConstraintJSONBody struct {
 	Description *string   `json:"description,omitempty"`
-	Name        string    `json:"name"`
-	Permissions *[]uint32 `json:"permissions,omitempty"`
-	Users       *[]uint64 `json:"users,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Roles       *[]uint32 `json:"roles,omitempty"`
+}
+
+// ListSodConstraintRolesParams defines parameters for ListSodConstraintRoles.
+type ListSodConstraintRolesParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Name Name of the role
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// ListSodConstraintParams defines parameters for ListSodConstraint.
+type ListSodConstraintParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Name Name of the constraint
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// CreateSodConstraintJSONBody defines parameters for CreateSodConstraint.
+type CreateSodConstraintJSONBody struct {
+	Description    *string  `json:"description,omitempty"`
+	Name           string   `json:"name"`
+	OrganizationId *uint32  `json:"organization_id,omitempty"`
+	Roles          []uint32 `json:"roles"`
 }
 
 // DeleteUserParams defines parameters for DeleteUser.
@@ -448,6 +1630,11 @@ type UpdateUserJSONBody struct {
 	Roles *[]uint32            `json:"roles,omitempty"`
 }
 
+// SetUserPasswordJSONBody defines parameters for SetUserPassword.
+type SetUserPasswordJSONBody struct {
+	Password string `json:"password"`
+}
+
 // ListUserRolesParams defines parameters for ListUserRoles.
 type ListUserRolesParams struct {
 	// Page what page to render
@@ -463,6 +1650,15 @@ type ListUserRolesParams struct {
 // AssignRolesJSONBody defines parameters for AssignRoles.
 type AssignRolesJSONBody = []uint32
 
+// AssignRolesParams defines parameters for AssignRoles.
+type AssignRolesParams struct {
+	// StartsAt Time from which the assignments are in effect
+	StartsAt *time.Time `form:"starts_at,omitempty" json:"starts_at,omitempty"`
+
+	// ExpiresAt Time at which the assignments expire
+	ExpiresAt *time.Time `form:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
 // ListUserParams defines parameters for ListUser.
 type ListUserParams struct {
 	// Page what page to render
@@ -481,15 +1677,121 @@ type CreateUserJSONBody struct {
 		Dept  uint32 `json:"dept"`
 		Level uint8  `json:"level"`
 	} `json:"attr,omitempty"`
-	Email    *openapi_types.Email `json:"email,omitempty"`
-	Password string               `json:"password"`
-	Roles    *[]uint32            `json:"roles,omitempty"`
-	Username string               `json:"username"`
+	Email          *openapi_types.Email `json:"email,omitempty"`
+	OrganizationId *uint32              `json:"organization_id,omitempty"`
+	Password       string               `json:"password"`
+	Roles          *[]uint32            `json:"roles,omitempty"`
+	Username       string               `json:"username"`
+}
+
+// UpdateWebhookJSONBody defines parameters for UpdateWebhook.
+type UpdateWebhookJSONBody struct {
+	Active *bool                          `json:"active,omitempty"`
+	Events *[]UpdateWebhookJSONBodyEvents `json:"events,omitempty"`
+
+	// Secret Secret used to sign deliveries with HMAC-SHA256
+	Secret *string `json:"secret,omitempty"`
+	Url    *string `json:"url,omitempty"`
+}
+
+// UpdateWebhookJSONBodyEvents defines parameters for UpdateWebhook.
+type UpdateWebhookJSONBodyEvents string
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// ListWebhookParams defines parameters for ListWebhook.
+type ListWebhookParams struct {
+	// Page what page to render
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage item count to render per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// CreateWebhookJSONBody defines parameters for CreateWebhook.
+type CreateWebhookJSONBody struct {
+	Active         *bool                         `json:"active,omitempty"`
+	Events         []CreateWebhookJSONBodyEvents `json:"events"`
+	OrganizationId *uint32                       `json:"organization_id,omitempty"`
+
+	// Secret Secret used to sign deliveries with HMAC-SHA256
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
 }
 
+// CreateWebhookJSONBodyEvents defines parameters for CreateWebhook.
+type CreateWebhookJSONBodyEvents string
+
+// ApproveAccessRequestJSONRequestBody defines body for ApproveAccessRequest for application/json ContentType.
+type ApproveAccessRequestJSONRequestBody ApproveAccessRequestJSONBody
+
+// DenyAccessRequestJSONRequestBody defines body for DenyAccessRequest for application/json ContentType.
+type DenyAccessRequestJSONRequestBody DenyAccessRequestJSONBody
+
+// CreateAccessRequestJSONRequestBody defines body for CreateAccessRequest for application/json ContentType.
+type CreateAccessRequestJSONRequestBody CreateAccessRequestJSONBody
+
+// UpdateGroupJSONRequestBody defines body for UpdateGroup for application/json ContentType.
+type UpdateGroupJSONRequestBody UpdateGroupJSONBody
+
+// AssignGroupRolesJSONRequestBody defines body for AssignGroupRoles for application/json ContentType.
+type AssignGroupRolesJSONRequestBody = AssignGroupRolesJSONBody
+
+// AddGroupMembersJSONRequestBody defines body for AddGroupMembers for application/json ContentType.
+type AddGroupMembersJSONRequestBody = AddGroupMembersJSONBody
+
+// CreateGroupJSONRequestBody defines body for CreateGroup for application/json ContentType.
+type CreateGroupJSONRequestBody CreateGroupJSONBody
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody LoginJSONBody
 
+// FinishOidcLoginJSONRequestBody defines body for FinishOidcLogin for application/json ContentType.
+type FinishOidcLoginJSONRequestBody FinishOidcLoginJSONBody
+
+// VerifyTotpJSONRequestBody defines body for VerifyTotp for application/json ContentType.
+type VerifyTotpJSONRequestBody VerifyTotpJSONBody
+
+// FinishWebauthnLoginJSONRequestBody defines body for FinishWebauthnLogin for application/json ContentType.
+type FinishWebauthnLoginJSONRequestBody FinishWebauthnLoginJSONBody
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody ChangePasswordJSONBody
+
+// ConfirmTotpJSONRequestBody defines body for ConfirmTotp for application/json ContentType.
+type ConfirmTotpJSONRequestBody ConfirmTotpJSONBody
+
+// DisableTotpJSONRequestBody defines body for DisableTotp for application/json ContentType.
+type DisableTotpJSONRequestBody DisableTotpJSONBody
+
+// FinishWebauthnRegistrationJSONRequestBody defines body for FinishWebauthnRegistration for application/json ContentType.
+type FinishWebauthnRegistrationJSONRequestBody FinishWebauthnRegistrationJSONBody
+
+// CreateOauthClientJSONRequestBody defines body for CreateOauthClient for application/json ContentType.
+type CreateOauthClientJSONRequestBody CreateOauthClientJSONBody
+
+// OauthTokenFormdataRequestBody defines body for OauthToken for application/x-www-form-urlencoded ContentType.
+type OauthTokenFormdataRequestBody OauthTokenFormdataBody
+
+// UpdateOrganizationJSONRequestBody defines body for UpdateOrganization for application/json ContentType.
+type UpdateOrganizationJSONRequestBody UpdateOrganizationJSONBody
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody CreateOrganizationJSONBody
+
+// ForgotPasswordJSONRequestBody defines body for ForgotPassword for application/json ContentType.
+type ForgotPasswordJSONRequestBody ForgotPasswordJSONBody
+
+// ResetPasswordJSONRequestBody defines body for ResetPassword for application/json ContentType.
+type ResetPasswordJSONRequestBody ResetPasswordJSONBody
+
 // UpdatePermissionJSONRequestBody defines body for UpdatePermission for application/json ContentType.
 type UpdatePermissionJSONRequestBody UpdatePermissionJSONBody
 
@@ -508,11 +1810,65 @@ type AssignPermissionsJSONRequestBody = AssignPermissionsJSONBody
 // CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
 type CreateRoleJSONRequestBody CreateRoleJSONBody
 
+// ScimCreateGroupJSONRequestBody defines body for ScimCreateGroup for application/json ContentType.
+type ScimCreateGroupJSONRequestBody = ScimGroup
+
+// ScimCreateGroupApplicationScimPlusJSONRequestBody defines body for ScimCreateGroup for application/scim+json ContentType.
+type ScimCreateGroupApplicationScimPlusJSONRequestBody = ScimGroup
+
+// ScimPatchGroupJSONRequestBody defines body for ScimPatchGroup for application/json ContentType.
+type ScimPatchGroupJSONRequestBody = ScimPatchOp
+
+// ScimPatchGroupApplicationScimPlusJSONRequestBody defines body for ScimPatchGroup for application/scim+json ContentType.
+type ScimPatchGroupApplicationScimPlusJSONRequestBody = ScimPatchOp
+
+// ScimReplaceGroupJSONRequestBody defines body for ScimReplaceGroup for application/json ContentType.
+type ScimReplaceGroupJSONRequestBody = ScimGroup
+
+// ScimReplaceGroupApplicationScimPlusJSONRequestBody defines body for ScimReplaceGroup for application/scim+json ContentType.
+type ScimReplaceGroupApplicationScimPlusJSONRequestBody = ScimGroup
+
+// ScimCreateUserJSONRequestBody defines body for ScimCreateUser for application/json ContentType.
+type ScimCreateUserJSONRequestBody = ScimUser
+
+// ScimCreateUserApplicationScimPlusJSONRequestBody defines body for ScimCreateUser for application/scim+json ContentType.
+type ScimCreateUserApplicationScimPlusJSONRequestBody = ScimUser
+
+// ScimPatchUserJSONRequestBody defines body for ScimPatchUser for application/json ContentType.
+type ScimPatchUserJSONRequestBody = ScimPatchOp
+
+// ScimPatchUserApplicationScimPlusJSONRequestBody defines body for ScimPatchUser for application/scim+json ContentType.
+type ScimPatchUserApplicationScimPlusJSONRequestBody = ScimPatchOp
+
+// ScimReplaceUserJSONRequestBody defines body for ScimReplaceUser for application/json ContentType.
+type ScimReplaceUserJSONRequestBody = ScimUser
+
+// ScimReplaceUserApplicationScimPlusJSONRequestBody defines body for ScimReplaceUser for application/scim+json ContentType.
+type ScimReplaceUserApplicationScimPlusJSONRequestBody = ScimUser
+
+// CreateServiceAccountJSONRequestBody defines body for CreateServiceAccount for application/json ContentType.
+type CreateServiceAccountJSONRequestBody CreateServiceAccountJSONBody
+
+// UpdateSodConstraintJSONRequestBody defines body for UpdateSodConstraint for application/json ContentType.
+type UpdateSodConstraintJSONRequestBody UpdateSodConstraintJSONBody
+
+// CreateSodConstraintJSONRequestBody defines body for CreateSodConstraint for application/json ContentType.
+type CreateSodConstraintJSONRequestBody CreateSodConstraintJSONBody
+
 // UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
 type UpdateUserJSONRequestBody UpdateUserJSONBody
 
+// SetUserPasswordJSONRequestBody defines body for SetUserPassword for application/json ContentType.
+type SetUserPasswordJSONRequestBody SetUserPasswordJSONBody
+
 // AssignRolesJSONRequestBody defines body for AssignRoles for application/json ContentType.
 type AssignRolesJSONRequestBody = AssignRolesJSONBody
 
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody CreateUserJSONBody
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody UpdateWebhookJSONBody
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody CreateWebhookJSONBody
@@ -1,5 +1,7 @@
 package handlers
 
+import "github.com/eidng8/go-utils"
+
 func userAttrFromMap(am map[string]interface{}) *struct {
 	Dept  uint32 `json:"dept"`
 	Level uint8  `json:"level"`
@@ -36,3 +38,13 @@ func userAttrOf(dept uint32, level uint8) *struct {
 		Level uint8  `json:"level"`
 	}{Dept: dept, Level: level}
 }
+
+// Converts the list of string-like values to a list of strings.
+func stringsOf[T ~string](a []T) []string {
+	return utils.Pluck(a, func(v T) string { return string(v) })
+}
+
+// Converts the list of strings to a list of string-like values.
+func stringsTo[T ~string](a []string) []T {
+	return utils.Pluck(a, func(v string) T { return T(v) })
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// UnlockUser lifts the login lockout of the user, sets it active if it was
+// locked, and forgets its failed logins. Lockouts of source addresses are left in place.
+//
+// Endpoint: POST /user/{id}/unlock
+func (s Server) UnlockUser(
+	ctx context.Context, request UnlockUserRequestObject,
+) (UnlockUserResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	token, err := s.getToken(gc)
+	if err != nil {
+		api.Log.Debugf("UnlockUser error: %v", err)
+		return nil, err
+	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("UnlockUser error: %v", err)
+		return nil, err
+	}
+	_, err = s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			err = s.loginAttempts.Reset(qc, userAttemptKey(u.ID))
+			if err != nil {
+				return nil, err
+			}
+			if user.StatusLocked == u.Status {
+				err = tx.User.UpdateOne(u).SetStatus(user.StatusActive).Exec(qc)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditUserUnlocked, u.OrganizationID, token,
+				map[string]interface{}{
+					"user_id":  u.ID,
+					"username": u.Username,
+				},
+			)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return UnlockUser404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Errors: &msgNotFound,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("UnlockUser error: %v", err)
+		return nil, err
+	}
+	return UnlockUser204Response{}, nil
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_UnlockUser_lifts_lockout(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	_, err := svr.loginAttempts.Fail(qc, userAttemptKey(3), time.Hour)
+	require.Nil(t, err)
+	require.Nil(
+		t, svr.loginAttempts.Lock(
+			qc, userAttemptKey(3), time.Now().Add(time.Hour),
+		),
+	)
+	require.Nil(
+		t, svr.loginAttempts.Lock(
+			qc, ipAttemptKey("192.0.2.1"), time.Now().Add(time.Hour),
+		),
+	)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/3/unlock", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	wait, err := svr.loginLockedOut(qc, userAttemptKey(3))
+	require.Nil(t, err)
+	require.Zero(t, wait)
+	wait, err = svr.loginLockedOut(qc, ipAttemptKey("192.0.2.1"))
+	require.Nil(t, err)
+	require.NotZero(t, wait)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditUserUnlocked)).OnlyX(qc)
+	require.Equal(t, uint64(1), *log.ActorID)
+	require.Equal(t, float64(3), log.Details["user_id"])
+}
+
+func Test_UnlockUser_sets_locked_user_active(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.User.UpdateOneID(3).SetStatus(user.StatusLocked).
+		ExecX(context.Background())
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/3/unlock", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Equal(t, user.StatusActive, getUserById(t, db, 3).Status)
+}
+
+func Test_UnlockUser_keeps_disabled_user_disabled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	db.User.UpdateOneID(3).SetStatus(user.StatusDisabled).
+		ExecX(context.Background())
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/3/unlock", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNoContent, res.Code)
+	require.Equal(t, user.StatusDisabled, getUserById(t, db, 3).Status)
+}
+
+func Test_UnlockUser_reports_404_if_user_not_exists(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/12345/unlock", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_UnlockUser_reports_404_if_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:UnlockUser")
+	req, err := svr.postAs(u, "/user/2/unlock", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_UnlockUser_reports_404_if_service_account(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	req, err := svr.postAs(
+		getUserById(t, db, 1), fmt.Sprintf("/user/%d/unlock", a.UserID), nil,
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_UnlockUser_returns_401_if_non_user(t *testing.T) {
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.post("/user/2/unlock", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_UnlockUser_returns_403_if_user_without_permission(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 2), "/user/3/unlock", nil)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_UnlockUser_returns_500_if_db_error_unhandled(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	req, err := svr.postAs(getUserById(t, db, 1), "/user/2/unlock", nil)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+)
+
+// UpdateGroup updates a group. Members and roles are replaced if given.
+//
+// Endpoint: PATCH /group/{id}
+func (s Server) UpdateGroup(
+	ctx context.Context, request UpdateGroupRequestObject,
+) (UpdateGroupResponseObject, error) {
+	if nil == request.Body.Name && nil == request.Body.Description &&
+		(nil == request.Body.Roles || len(*request.Body.Roles) == 0) &&
+		(nil == request.Body.Users || len(*request.Body.Users) == 0) {
+		return UpdateGroup422JSONResponse{
+			N422JSONResponse: N422JSONResponse{
+				Code:   http.StatusUnprocessableEntity,
+				Status: msgError,
+				Errors: &msgEmptyRequest,
+			},
+		}, nil
+	}
+	scope, err := s.groupScope(ctx)
+	if err != nil {
+		api.Log.Debugf("UpdateGroup error: %v", err)
+		return nil, err
+	}
+	r, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			g, err := tx.Group.Query().Where(group.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			before, err := g.QueryUsers().IDs(qc)
+			if err != nil {
+				return nil, err
+			}
+			after := before
+			u := tx.Group.UpdateOne(g)
+			if request.Body.Name != nil {
+				u.SetName(*request.Body.Name)
+			}
+			if request.Body.Description != nil {
+				u.SetDescription(*request.Body.Description)
+			}
+			roles := request.Body.Roles != nil && len(*request.Body.Roles) > 0
+			if roles {
+				err = checkTenantRoles(
+					qc, tx, g.OrganizationID, *request.Body.Roles,
+				)
+				if err != nil {
+					return nil, err
+				}
+				u.ClearRoles()
+				u.AddRoleIDs(*request.Body.Roles...)
+			}
+			if request.Body.Users != nil && len(*request.Body.Users) > 0 {
+				err = checkTenantUsers(
+					qc, tx, g.OrganizationID, *request.Body.Users,
+				)
+				if err != nil {
+					return nil, err
+				}
+				after = *request.Body.Users
+				u.ClearUsers()
+				u.AddUserIDs(after...)
+			}
+			g, err = u.Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			// if roles changed, all members old and new are affected,
+			// otherwise only those joined or left the group
+			changed := changedIds(before, after)
+			if roles {
+				changed = changedIds(nil, slices.Concat(before, after))
+			}
+			if err = checkSeparationOfDuties(qc, tx, changed...); err != nil {
+				return nil, err
+			}
+			for _, id := range changed {
+				if err = userRolesChanged(qc, tx, id); err != nil {
+					return nil, err
+				}
+			}
+			return g, nil
+		},
+	)
+	if err != nil {
+		var sv *sodViolation
+		if errors.As(err, &sv) {
+			return UpdateGroup409JSONResponse{N409JSONResponse: sv.response()}, nil
+		}
+		if ent.IsNotFound(err) {
+			return UpdateGroup404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		if ent.IsUniqueKeyError(err) {
+			return UpdateGroup400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgExists,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsForeignKeyError(err) || errors.Is(err, errCrossTenant) {
+			return UpdateGroup400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("UpdateGroup error: %v", err)
+		return nil, err
+	}
+	g := r.(*ent.Group)
+	return UpdateGroup200JSONResponse{
+		Id:             g.ID,
+		OrganizationId: g.OrganizationID,
+		Name:           g.Name,
+		Description:    &g.Description,
+		CreatedAt:      g.CreatedAt,
+		UpdatedAt:      g.UpdatedAt,
+	}, nil
+}
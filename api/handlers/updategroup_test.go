@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+func Test_UpdateGroup_updates_a_group(t *testing.T) {
+	name := "renamed"
+	body := UpdateGroupJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, fmt.Sprintf("/group/%d", g.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, UpdateGroup200JSONResponse{}, res)
+	require.Equal(t, name, actual.Name)
+	require.Equal(t, name, db.Group.GetX(context.Background(), g.ID).Name)
+}
+
+func Test_UpdateGroup_replaces_members_and_roles(t *testing.T) {
+	users := []uint64{5, 6}
+	roles := []uint32{4}
+	body := UpdateGroupJSONBody{Users: &users, Roles: &roles}
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, []uint64{3, 4}, []uint32{2, 3})
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, fmt.Sprintf("/group/%d", g.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	qc := context.Background()
+	require.Equal(
+		t, users, db.Group.Query().Where(group.IDEQ(g.ID)).QueryUsers().
+			Order(user.ByID()).IDsX(qc),
+	)
+	require.Equal(
+		t, roles, db.Group.Query().Where(group.IDEQ(g.ID)).QueryRoles().
+			Order(role.ByID()).IDsX(qc),
+	)
+}
+
+func Test_UpdateGroup_returns_422_if_empty_body(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/group/1", UpdateGroupJSONBody{})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_UpdateGroup_returns_404_if_not_found(t *testing.T) {
+	name := "renamed"
+	body := UpdateGroupJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/group/12345", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_UpdateGroup_returns_400_if_name_exists(t *testing.T) {
+	name := "group 0"
+	body := UpdateGroupJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedGroup(t, db, nil, nil)
+	g := db.Group.Create().SetName("group 1").SaveX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, fmt.Sprintf("/group/%d", g.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_UpdateGroup_returns_400_if_role_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	g := seedGroup(t, db, nil, nil)
+	_, _, r := seedOrganization(t, db)
+	roles := []uint32{r.ID}
+	body := UpdateGroupJSONBody{Roles: &roles}
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, fmt.Sprintf("/group/%d", g.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_UpdateGroup_returns_409_if_violates_sod_constraint(t *testing.T) {
+	users := []uint64{2}
+	body := UpdateGroupJSONBody{Users: &users}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 5)
+	g := seedGroup(t, db, []uint64{3}, []uint32{5})
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, fmt.Sprintf("/group/%d", g.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	requireSodConflict(t, res, "role 0", "role 3")
+}
+
+func Test_UpdateGroup_returns_401_if_non_user(t *testing.T) {
+	name := "renamed"
+	body := UpdateGroupJSONBody{Name: &name}
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.patch("/group/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_UpdateGroup_returns_403_if_user_without_permission(t *testing.T) {
+	name := "renamed"
+	body := UpdateGroupJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.patchAs(u, "/group/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_UpdateGroup_returns_500_if_db_error_unhandled(t *testing.T) {
+	name := "renamed"
+	body := UpdateGroupJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/group/1", body)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// UpdateOrganization updates an organization. Users other than platform
+// administrators can only update their own organization.
+//
+// Endpoint: PATCH /organization/{id}
+func (s Server) UpdateOrganization(
+	ctx context.Context, request UpdateOrganizationRequestObject,
+) (UpdateOrganizationResponseObject, error) {
+	if nil == request.Body.Name && nil == request.Body.Description {
+		return UpdateOrganization422JSONResponse{
+			N422JSONResponse: N422JSONResponse{
+				Code:   http.StatusUnprocessableEntity,
+				Status: msgError,
+				Errors: &msgEmptyRequest,
+			},
+		}, nil
+	}
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("UpdateOrganization error: %v", err)
+		return nil, err
+	}
+	notFound := UpdateOrganization404JSONResponse{
+		N404JSONResponse: N404JSONResponse{
+			Code:   http.StatusNotFound,
+			Status: msgError,
+			Errors: &msgNotFound,
+		},
+	}
+	if nil != tenant && *tenant != request.Id {
+		return notFound, nil
+	}
+	o, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			update := tx.Organization.UpdateOneID(request.Id)
+			if request.Body.Name != nil {
+				update.SetName(*request.Body.Name)
+			}
+			if request.Body.Description != nil {
+				update.SetDescription(*request.Body.Description)
+			}
+			return update.Save(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return notFound, nil
+		}
+		if ent.IsUniqueKeyError(err) {
+			return UpdateOrganization400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgExists,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("UpdateOrganization error: %v", err)
+		return nil, err
+	}
+	org := o.(*ent.Organization)
+	return UpdateOrganization200JSONResponse{
+		Id:          org.ID,
+		Name:        org.Name,
+		Description: &org.Description,
+		CreatedAt:   org.CreatedAt,
+		UpdatedAt:   org.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpdateOrganization_updates_an_organization(t *testing.T) {
+	name := "renamed"
+	body := UpdateOrganizationJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/organization/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, UpdateOrganization200JSONResponse{}, res)
+	require.Equal(t, name, actual.Name)
+	o := db.Organization.GetX(context.Background(), 1)
+	require.Equal(t, name, o.Name)
+}
+
+func Test_UpdateOrganization_updates_own_organization_of_tenant_user(t *testing.T) {
+	desc := "new description"
+	body := UpdateOrganizationJSONBody{Description: &desc}
+	svr, engine, db, res := setupTestCase(t, true)
+	o, u, _ := seedOrganization(t, db, "auth:UpdateOrganization")
+	req, err := svr.patchAs(u, fmt.Sprintf("/organization/%d", o.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	o = db.Organization.GetX(context.Background(), o.ID)
+	require.Equal(t, desc, o.Description)
+}
+
+func Test_UpdateOrganization_returns_404_if_other_organization(t *testing.T) {
+	name := "renamed"
+	body := UpdateOrganizationJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, _ := seedOrganization(t, db, "auth:UpdateOrganization")
+	req, err := svr.patchAs(u, "/organization/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.Equal(
+		t, "default", db.Organization.GetX(context.Background(), 1).Name,
+	)
+}
+
+func Test_UpdateOrganization_returns_404_if_not_found(t *testing.T) {
+	name := "renamed"
+	body := UpdateOrganizationJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/organization/12345", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_UpdateOrganization_returns_400_if_name_exists(t *testing.T) {
+	name := "tenant"
+	body := UpdateOrganizationJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedOrganization(t, db)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/organization/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_UpdateOrganization_returns_422_if_empty_body(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/organization/1", UpdateOrganizationJSONBody{})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_UpdateOrganization_returns_401_if_non_user(t *testing.T) {
+	name := "renamed"
+	body := UpdateOrganizationJSONBody{Name: &name}
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.patch("/organization/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_UpdateOrganization_returns_403_if_user_without_permission(t *testing.T) {
+	name := "renamed"
+	body := UpdateOrganizationJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.patchAs(u, "/organization/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_UpdateOrganization_returns_500_if_db_error_unhandled(t *testing.T) {
+	name := "renamed"
+	body := UpdateOrganizationJSONBody{Name: &name}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/organization/1", body)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
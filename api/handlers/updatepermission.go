@@ -2,13 +2,16 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/permission"
 )
 
-// UpdatePermission updates a permission.
+// UpdatePermission updates a permission. Permissions are shared by all
+// organizations, only platform administrators can update them.
 //
 // Endpoint: PATCH /permission/{id}
 func (s Server) UpdatePermission(
@@ -24,6 +27,19 @@ func (s Server) UpdatePermission(
 			},
 		}, nil
 	}
+	if err := s.requirePlatform(ctx); err != nil {
+		if errors.Is(err, errAccessDenied) {
+			return UpdatePermission403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("UpdatePermission error: %v", err)
+		return nil, err
+	}
 	p, err := s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
@@ -34,11 +50,29 @@ func (s Server) UpdatePermission(
 			if request.Body.Description != nil {
 				p.SetDescription(*request.Body.Description)
 			}
+			var roles []uint32
 			if request.Body.Roles != nil && len(*request.Body.Roles) > 0 {
+				var err error
+				roles, err = tx.Permission.Query().
+					Where(permission.IDEQ(request.Id)).QueryRoles().IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
 				p.ClearRoles()
 				p.AddRoleIDs(*request.Body.Roles...)
 			}
-			return p.Save(ctx)
+			perm, err := p.Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if nil != roles {
+				for _, id := range changedIds(roles, *request.Body.Roles) {
+					if err = emitRolePermissionsChanged(ctx, tx, id); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return perm, nil
 		},
 	)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/eidng8/go-utils"
 	"github.com/stretchr/testify/require"
 
+	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/permission"
 )
@@ -129,6 +130,25 @@ func Test_UpdatePermission_returns_403_if_user_without_permission(t *testing.T)
 	)
 }
 
+func Test_UpdatePermission_returns_403_if_tenant_admin(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, r := seedOrganization(t, db, "auth:UpdatePermission")
+	qc := context.Background()
+	roles := db.Permission.Query().Where(permission.IDEQ(2)).QueryRoles().
+		IDsX(qc)
+	require.Contains(t, roles, uint32(api.RootRoleId))
+	body := UpdatePermissionJSONBody{Roles: &[]uint32{r.ID}}
+	req, err := svr.patchAs(u, "/permission/2", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.Equal(
+		t, roles,
+		db.Permission.Query().Where(permission.IDEQ(2)).QueryRoles().
+			IDsX(qc),
+	)
+}
+
 func Test_UpdatePermission_reports_422_if_request_empty(t *testing.T) {
 	svr, engine, db, res := setupTestCase(t, false)
 	u := getUserById(t, db, 1)
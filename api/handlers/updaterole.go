@@ -2,21 +2,24 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/role"
 )
 
 // UpdateRole updates a role.
 //
 // Endpoint: PATCH /role/{id}
 func (s Server) UpdateRole(
-	_ context.Context, request UpdateRoleRequestObject,
+	ctx context.Context, request UpdateRoleRequestObject,
 ) (UpdateRoleResponseObject, error) {
 	if nil == request.Body.Name && nil == request.Body.Description &&
 		(nil == request.Body.Permissions || len(*request.Body.Permissions) == 0) &&
-		(nil == request.Body.Users || len(*request.Body.Users) == 0) {
+		(nil == request.Body.Users || len(*request.Body.Users) == 0) &&
+		nil == request.Body.Approvers && nil == request.Body.RequireMfa {
 		return UpdateRole422JSONResponse{
 			N422JSONResponse: N422JSONResponse{
 				Code:   http.StatusUnprocessableEntity,
@@ -25,28 +28,119 @@ func (s Server) UpdateRole(
 			},
 		}, nil
 	}
+	tenant, err := s.requestTenant(ctx)
+	if err != nil {
+		api.Log.Debugf("UpdateRole error: %v", err)
+		return nil, err
+	}
+	scope, err := s.roleScope(ctx)
+	if err != nil {
+		api.Log.Debugf("UpdateRole error: %v", err)
+		return nil, err
+	}
 	r, err := s.db.Transaction(
 		context.Background(),
 		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
-			r := tx.Role.UpdateOneID(request.Id)
+			ro, err := tx.Role.Query().Where(role.IDEQ(request.Id), scope).
+				Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			r := tx.Role.UpdateOne(ro)
 			if request.Body.Name != nil {
 				r.SetName(*request.Body.Name)
 			}
 			if request.Body.Description != nil {
 				r.SetDescription(*request.Body.Description)
 			}
-			if request.Body.Permissions != nil && len(*request.Body.Permissions) > 0 {
+			if request.Body.RequireMfa != nil {
+				r.SetRequireMfa(*request.Body.RequireMfa)
+			}
+			perms := request.Body.Permissions != nil &&
+				len(*request.Body.Permissions) > 0
+			if perms {
+				err = checkTenantPermissions(
+					qc, tx, tenant, *request.Body.Permissions,
+				)
+				if err != nil {
+					return nil, err
+				}
 				r.ClearPermissions()
 				r.AddPermissionIDs(*request.Body.Permissions...)
 			}
-			if request.Body.Users != nil && len(*request.Body.Users) > 0 {
+			if request.Body.Approvers != nil {
+				err = checkTenantUsers(
+					qc, tx, ro.OrganizationID, *request.Body.Approvers,
+				)
+				if err != nil {
+					return nil, err
+				}
+				r.ClearApprovers()
+				r.AddApproverIDs(*request.Body.Approvers...)
+			}
+			var users []uint64
+			replaceUsers := request.Body.Users != nil &&
+				len(*request.Body.Users) > 0
+			if replaceUsers {
+				err = checkTenantUsers(
+					qc, tx, ro.OrganizationID, *request.Body.Users,
+				)
+				if err != nil {
+					return nil, err
+				}
+				users, err = ro.QueryUsers().IDs(qc)
+				if err != nil {
+					return nil, err
+				}
 				r.ClearUsers()
 				r.AddUserIDs(*request.Body.Users...)
 			}
-			return r.Save(qc)
+			ro, err = r.Save(qc)
+			if err != nil {
+				return nil, err
+			}
+			if perms {
+				if err = emitRolePermissionsChanged(qc, tx, ro.ID); err != nil {
+					return nil, err
+				}
+			}
+			if replaceUsers {
+				err = checkSeparationOfDuties(qc, tx, *request.Body.Users...)
+				if err != nil {
+					return nil, err
+				}
+				for _, id := range changedIds(users, *request.Body.Users) {
+					if err = userRolesChanged(qc, tx, id); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return ro, nil
 		},
 	)
 	if err != nil {
+		var sv *sodViolation
+		if errors.As(err, &sv) {
+			return UpdateRole409JSONResponse{N409JSONResponse: sv.response()}, nil
+		}
+		if errors.Is(err, errAccessDenied) {
+			return UpdateRole403JSONResponse{
+				N403JSONResponse: N403JSONResponse{
+					Code:   http.StatusForbidden,
+					Errors: &msgAccessDenied,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if errors.Is(err, errCrossTenant) {
+			return UpdateRole400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
 		if ent.IsNotFound(err) {
 			return UpdateRole404JSONResponse{
 				N404JSONResponse: N404JSONResponse{
@@ -64,6 +158,7 @@ func (s Server) UpdateRole(
 		Id:          ro.ID,
 		Name:        ro.Name,
 		Description: &ro.Description,
+		RequireMfa:  ro.RequireMfa,
 		CreatedAt:   ro.CreatedAt,
 		UpdatedAt:   ro.UpdatedAt,
 	}, nil
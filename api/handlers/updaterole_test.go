@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
@@ -10,7 +11,9 @@ import (
 	"github.com/eidng8/go-utils"
 	"github.com/stretchr/testify/require"
 
+	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/permission"
 	"github.com/eidng8/go-attr-rbac/ent/role"
 )
 
@@ -82,6 +85,23 @@ func Test_UpdateRole_updates_a_role_replaces_permissions(t *testing.T) {
 	)
 }
 
+func Test_UpdateRole_returns_403_if_tenant_grants_platform_permission(
+	t *testing.T,
+) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, u, r := seedOrganization(t, db, "auth:UpdateRole")
+	id := getPermissionId(t, db, api.PermissionCrossTenant)
+	body := UpdateRoleJSONBody{Permissions: &[]uint32{id}}
+	req, err := svr.patchAs(u, fmt.Sprintf("/role/%d", r.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+	require.False(
+		t, db.Role.Query().Where(role.IDEQ(r.ID)).QueryPermissions().
+			Where(permission.IDEQ(id)).ExistX(context.Background()),
+	)
+}
+
 func Test_UpdateRole_updates_a_role_replaces_users(t *testing.T) {
 	body := UpdateRoleJSONBody{Users: &[]uint64{5, 6}}
 	svr, engine, db, res := setupTestCase(t, true)
@@ -100,6 +120,66 @@ func Test_UpdateRole_updates_a_role_replaces_users(t *testing.T) {
 	)
 }
 
+func Test_UpdateRole_updates_a_role_replaces_approvers(t *testing.T) {
+	body := UpdateRoleJSONBody{Approvers: &[]uint64{5, 6}}
+	svr, engine, db, res := setupTestCase(t, true)
+	db.Role.UpdateOneID(2).AddApproverIDs(3).ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/role/2", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(
+		t, []uint64{5, 6},
+		db.Role.Query().Where(role.IDEQ(2)).QueryApprovers().
+			IDsX(context.Background()),
+	)
+}
+
+func Test_UpdateRole_clears_approvers(t *testing.T) {
+	body := UpdateRoleJSONBody{Approvers: &[]uint64{}}
+	svr, engine, db, res := setupTestCase(t, true)
+	db.Role.UpdateOneID(2).AddApproverIDs(3).ExecX(context.Background())
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/role/2", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	require.False(
+		t, db.Role.Query().Where(role.IDEQ(2), role.HasApprovers()).
+			ExistX(context.Background()),
+	)
+}
+
+func Test_UpdateRole_sets_require_mfa(t *testing.T) {
+	mfa := true
+	body := UpdateRoleJSONBody{RequireMfa: &mfa}
+	svr, engine, db, res := setupTestCase(t, true)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/role/2", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, UpdateRole200JSONResponse{}, res)
+	require.True(t, actual.RequireMfa)
+	require.True(t, db.Role.GetX(context.Background(), 2).RequireMfa)
+}
+
+func Test_UpdateRole_reports_400_if_approver_of_other_organization(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	_, other, _ := seedOrganization(t, db)
+	body := UpdateRoleJSONBody{Approvers: &[]uint64{other.ID}}
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/role/2", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+	require.False(
+		t, db.Role.Query().Where(role.IDEQ(2), role.HasApprovers()).
+			ExistX(context.Background()),
+	)
+}
+
 func Test_UpdateRole_returns_401_if_non_user(t *testing.T) {
 	name := "test name"
 	body := UpdateRoleJSONBody{Name: &name}
@@ -240,6 +320,17 @@ func Test_UpdateRole_reports_404_if_role_not_exists(t *testing.T) {
 	require.Equal(t, 404, res.Code)
 }
 
+func Test_UpdateRole_reports_409_if_violates_sod_constraint(t *testing.T) {
+	body := UpdateRoleJSONBody{Users: &[]uint64{2}}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/role/5", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	requireSodConflict(t, res, "role 0", "role 3")
+}
+
 func Test_UpdateRole_returns_500_if_db_error_unhandled(t *testing.T) {
 	name := "test_role"
 	body := UpdateRoleJSONBody{Name: &name}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+// UpdateSodConstraint updates a separation-of-duties constraint. Roles are
+// replaced if given.
+//
+// Endpoint: PATCH /sod-constraint/{id}
+func (s Server) UpdateSodConstraint(
+	ctx context.Context, request UpdateSodConstraintRequestObject,
+) (UpdateSodConstraintResponseObject, error) {
+	if nil == request.Body.Name && nil == request.Body.Description &&
+		(nil == request.Body.Roles || len(*request.Body.Roles) == 0) {
+		return UpdateSodConstraint422JSONResponse{
+			N422JSONResponse: N422JSONResponse{
+				Code:   http.StatusUnprocessableEntity,
+				Status: msgError,
+				Errors: &msgEmptyRequest,
+			},
+		}, nil
+	}
+	scope, err := s.sodConstraintScope(ctx)
+	if err != nil {
+		api.Log.Debugf("UpdateSodConstraint error: %v", err)
+		return nil, err
+	}
+	r, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			c, err := tx.SodConstraint.Query().
+				Where(sodconstraint.IDEQ(request.Id), scope).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			u := tx.SodConstraint.UpdateOne(c)
+			if request.Body.Name != nil {
+				u.SetName(*request.Body.Name)
+			}
+			if request.Body.Description != nil {
+				u.SetDescription(*request.Body.Description)
+			}
+			if request.Body.Roles != nil && len(*request.Body.Roles) > 0 {
+				err = checkTenantRoles(
+					qc, tx, c.OrganizationID, *request.Body.Roles,
+				)
+				if err != nil {
+					return nil, err
+				}
+				u.ClearRoles()
+				u.AddRoleIDs(*request.Body.Roles...)
+			}
+			return u.Save(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return UpdateSodConstraint404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		if ent.IsUniqueKeyError(err) {
+			return UpdateSodConstraint400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgExists,
+					Status: msgError,
+				},
+			}, nil
+		}
+		if ent.IsForeignKeyError(err) || errors.Is(err, errCrossTenant) {
+			return UpdateSodConstraint400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("UpdateSodConstraint error: %v", err)
+		return nil, err
+	}
+	c := r.(*ent.SodConstraint)
+	return UpdateSodConstraint200JSONResponse{
+		Id:             c.ID,
+		OrganizationId: c.OrganizationID,
+		Name:           c.Name,
+		Description:    &c.Description,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+	}, nil
+}
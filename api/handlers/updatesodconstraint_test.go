@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+func Test_UpdateSodConstraint_updates_a_constraint(t *testing.T) {
+	name := "renamed"
+	roles := []uint32{3, 6}
+	body := UpdateSodConstraintJSONBody{Name: &name, Roles: &roles}
+	svr, engine, db, res := setupTestCase(t, true)
+	c := seedSodConstraint(t, db, 2, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, fmt.Sprintf("/sod-constraint/%d", c.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, UpdateSodConstraint200JSONResponse{}, res)
+	require.Equal(t, name, actual.Name)
+	require.Equal(
+		t, roles,
+		db.SodConstraint.Query().Where(sodconstraint.IDEQ(c.ID)).
+			QueryRoles().Order(role.ByID()).IDsX(context.Background()),
+	)
+}
+
+func Test_UpdateSodConstraint_returns_422_if_empty_request(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(
+		u, "/sod-constraint/1", UpdateSodConstraintJSONBody{},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_UpdateSodConstraint_returns_404_if_not_found(t *testing.T) {
+	name := "renamed"
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(
+		u, "/sod-constraint/12345", UpdateSodConstraintJSONBody{Name: &name},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_UpdateSodConstraint_returns_400_if_role_not_found(t *testing.T) {
+	roles := []uint32{2, 12345}
+	svr, engine, db, res := setupTestCase(t, true)
+	c := seedSodConstraint(t, db, 2, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(
+		u, fmt.Sprintf("/sod-constraint/%d", c.ID),
+		UpdateSodConstraintJSONBody{Roles: &roles},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_UpdateSodConstraint_returns_401_if_non_user(t *testing.T) {
+	name := "renamed"
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.patch(
+		"/sod-constraint/1", UpdateSodConstraintJSONBody{Name: &name},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_UpdateSodConstraint_returns_403_if_user_without_permission(t *testing.T) {
+	name := "renamed"
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.patchAs(
+		u, "/sod-constraint/1", UpdateSodConstraintJSONBody{Name: &name},
+	)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_UpdateSodConstraint_returns_500_if_db_error_unhandled(t *testing.T) {
+	name := "renamed"
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(
+		u, "/sod-constraint/1", UpdateSodConstraintJSONBody{Name: &name},
+	)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -2,12 +2,14 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/oapi-codegen/runtime/types"
 
 	"github.com/eidng8/go-attr-rbac/api"
 	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/user"
 )
 
 // UpdateUser updates a user.
@@ -26,24 +28,65 @@ func (s Server) UpdateUser(
 			},
 		}, nil
 	}
+	scope, err := s.userAccountScope(ctx)
+	if err != nil {
+		api.Log.Debugf("UpdateUser error: %v", err)
+		return nil, err
+	}
 	r, err := s.db.Transaction(
 		context.Background(),
 		func(ctx context.Context, tx *ent.Tx) (interface{}, error) {
-			r := tx.User.UpdateOneID(request.Id)
+			u, err := tx.User.Query().Where(user.IDEQ(request.Id), scope).
+				Only(ctx)
+			if err != nil {
+				return nil, err
+			}
+			r := tx.User.UpdateOne(u)
 			if request.Body.Email != nil {
 				r.SetEmail(string(*request.Body.Email))
 			}
 			if nil != request.Body.Roles && len(*request.Body.Roles) > 0 {
+				err = checkTenantRoles(
+					ctx, tx, u.OrganizationID, *request.Body.Roles,
+				)
+				if err != nil {
+					return nil, err
+				}
 				r.ClearRoles()
 				r.AddRoleIDs(*request.Body.Roles...)
 			}
 			if request.Body.Attr != nil {
 				r.SetAttr(userAttrToMap(*request.Body.Attr))
 			}
-			return r.Save(ctx)
+			u, err = r.Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if nil != request.Body.Roles && len(*request.Body.Roles) > 0 {
+				if err = checkSeparationOfDuties(ctx, tx, u.ID); err != nil {
+					return nil, err
+				}
+				if err = userRolesChanged(ctx, tx, u.ID); err != nil {
+					return nil, err
+				}
+			}
+			return u, nil
 		},
 	)
 	if err != nil {
+		var sv *sodViolation
+		if errors.As(err, &sv) {
+			return UpdateUser409JSONResponse{N409JSONResponse: sv.response()}, nil
+		}
+		if errors.Is(err, errCrossTenant) {
+			return UpdateUser400JSONResponse{
+				N400JSONResponse: N400JSONResponse{
+					Code:   http.StatusBadRequest,
+					Errors: &msgInvalidAssignment,
+					Status: msgError,
+				},
+			}, nil
+		}
 		if ent.IsNotFound(err) {
 			return UpdateUser404JSONResponse{
 				N404JSONResponse: N404JSONResponse{
@@ -2,10 +2,12 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/eidng8/go-utils"
 	"github.com/oapi-codegen/runtime/types"
@@ -87,6 +89,20 @@ func Test_UpdateUser_updates_a_user_replaces_roles(t *testing.T) {
 	)
 }
 
+func Test_UpdateUser_revokes_tokens_if_roles_replaced(t *testing.T) {
+	body := UpdateUserJSONBody{Roles: &[]uint32{5}}
+	svr, engine, db, res := setupTestCase(t, true)
+	old := issueTokenAt(
+		t, svr, getUserById(t, db, 1), time.Now().Add(-time.Minute),
+	)
+	req, err := svr.patchAs(getUserById(t, db, 1), "/user/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	res = getUsersWithToken(t, svr, engine, old)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
 func Test_UpdateUser_returns_401_if_non_user(t *testing.T) {
 	email := types.Email("test@example.com")
 	body := UpdateUserJSONBody{Email: &email}
@@ -235,6 +251,30 @@ func Test_UpdateUser_reports_404_if_user_not_exists(t *testing.T) {
 	require.Equal(t, 404, res.Code)
 }
 
+func Test_UpdateUser_reports_404_if_service_account(t *testing.T) {
+	email := types.Email("test@example.com")
+	body := UpdateUserJSONBody{Email: &email}
+	svr, engine, db, res := setupTestCase(t, true)
+	a, _, _ := seedServiceAccount(t, db, seedGroup(t, db, nil, nil), nil)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, fmt.Sprintf("/user/%d", a.UserID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, 404, res.Code)
+	require.Nil(t, getUserById(t, db, a.UserID).Email)
+}
+
+func Test_UpdateUser_reports_409_if_violates_sod_constraint(t *testing.T) {
+	body := UpdateUserJSONBody{Roles: &[]uint32{2, 5}}
+	svr, engine, db, res := setupTestCase(t, true)
+	seedSodConstraint(t, db, 2, 5)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/user/3", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	requireSodConflict(t, res, "role 0", "role 3")
+}
+
 func Test_UpdateUser_returns_500_if_db_error_unhandled(t *testing.T) {
 	email := types.Email("test@example.com")
 	body := UpdateUserJSONBody{Email: &email}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+)
+
+// UpdateWebhook updates a webhook.
+//
+// Endpoint: PATCH /webhook/{id}
+func (s Server) UpdateWebhook(
+	ctx context.Context, request UpdateWebhookRequestObject,
+) (UpdateWebhookResponseObject, error) {
+	if nil == request.Body.Url && nil == request.Body.Secret &&
+		nil == request.Body.Active &&
+		(nil == request.Body.Events || len(*request.Body.Events) == 0) {
+		return UpdateWebhook422JSONResponse{
+			N422JSONResponse: N422JSONResponse{
+				Code:   http.StatusUnprocessableEntity,
+				Status: msgError,
+				Errors: &msgEmptyRequest,
+			},
+		}, nil
+	}
+	if nil != request.Body.Url && !validWebhookUrl(*request.Body.Url) {
+		return UpdateWebhook400JSONResponse{
+			N400JSONResponse: N400JSONResponse{
+				Code:   http.StatusBadRequest,
+				Errors: &msgInvalidWebhookUrl,
+				Status: msgError,
+			},
+		}, nil
+	}
+	scope, err := s.webhookScope(ctx)
+	if err != nil {
+		api.Log.Debugf("UpdateWebhook error: %v", err)
+		return nil, err
+	}
+	w, err := s.db.Transaction(
+		context.Background(),
+		func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			wh, err := tx.Webhook.Query().
+				Where(webhook.IDEQ(request.Id), scope).Only(qc)
+			if err != nil {
+				return nil, err
+			}
+			w := tx.Webhook.UpdateOne(wh)
+			if request.Body.Url != nil {
+				w.SetURL(*request.Body.Url)
+			}
+			if request.Body.Secret != nil {
+				w.SetSecret(*request.Body.Secret)
+			}
+			if request.Body.Events != nil && len(*request.Body.Events) > 0 {
+				w.SetEvents(stringsOf(*request.Body.Events))
+			}
+			if request.Body.Active != nil {
+				w.SetActive(*request.Body.Active)
+			}
+			return w.Save(qc)
+		},
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return UpdateWebhook404JSONResponse{
+				N404JSONResponse: N404JSONResponse{
+					Code:   http.StatusNotFound,
+					Status: msgError,
+					Errors: &msgNotFound,
+				},
+			}, nil
+		}
+		api.Log.Debugf("UpdateWebhook error: %v", err)
+		return nil, err
+	}
+	wh := w.(*ent.Webhook)
+	return UpdateWebhook200JSONResponse{
+		Id:             wh.ID,
+		OrganizationId: wh.OrganizationID,
+		Url:            wh.URL,
+		Events:         stringsTo[WebhookUpdateEvents](wh.Events),
+		Active:         wh.Active,
+		CreatedAt:      wh.CreatedAt,
+		UpdatedAt:      wh.UpdatedAt,
+	}, nil
+}
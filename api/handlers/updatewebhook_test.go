@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+func Test_UpdateWebhook_updates_a_webhook(t *testing.T) {
+	url := "https://example.com/updated"
+	secret := "fedcba9876543210"
+	active := false
+	events := []UpdateWebhookJSONBodyEvents{
+		UpdateWebhookJSONBodyEvents(api.EventTokenRevoked),
+	}
+	body := UpdateWebhookJSONBody{
+		Url: &url, Secret: &secret, Active: &active, Events: &events,
+	}
+	svr, engine, db, res := setupTestCase(t, true)
+	w := seedWebhook(t, db, "https://example.com/hook", api.EventUserDeleted)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, fmt.Sprintf("/webhook/%d", w.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, WebhookUpdate{}, res)
+	require.Equal(t, url, actual.Url)
+	require.False(t, actual.Active)
+	require.Equal(t, []WebhookUpdateEvents{"token.revoked"}, actual.Events)
+	row := db.Webhook.GetX(context.Background(), w.ID)
+	require.Equal(t, secret, row.Secret)
+	require.Equal(t, []string{api.EventTokenRevoked}, row.Events)
+}
+
+func Test_UpdateWebhook_returns_400_if_url_not_http(t *testing.T) {
+	url := "mailto:someone@example.com"
+	body := UpdateWebhookJSONBody{Url: &url}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/webhook/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_UpdateWebhook_returns_422_if_empty_request(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/webhook/1", UpdateWebhookJSONBody{})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+}
+
+func Test_UpdateWebhook_returns_404_if_not_found(t *testing.T) {
+	active := false
+	body := UpdateWebhookJSONBody{Active: &active}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/webhook/12345", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_UpdateWebhook_returns_401_if_non_user(t *testing.T) {
+	active := false
+	body := UpdateWebhookJSONBody{Active: &active}
+	svr, engine, _, res := setupTestCase(t, false)
+	req, err := svr.patch("/webhook/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_UpdateWebhook_returns_403_if_user_without_permission(t *testing.T) {
+	active := false
+	body := UpdateWebhookJSONBody{Active: &active}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 2)
+	req, err := svr.patchAs(u, "/webhook/1", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+}
+
+func Test_UpdateWebhook_returns_500_if_db_error_unhandled(t *testing.T) {
+	active := false
+	body := UpdateWebhookJSONBody{Active: &active}
+	svr, engine, db, res := setupTestCase(t, false)
+	u := getUserById(t, db, 1)
+	req, err := svr.patchAs(u, "/webhook/1", body)
+	require.Nil(t, err)
+	svr.db = useEmptyDb(t)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func Test_UpdateWebhook_returns_404_if_other_organization(t *testing.T) {
+	active := false
+	body := UpdateWebhookJSONBody{Active: &active}
+	svr, engine, db, res := setupTestCase(t, true)
+	w := seedWebhook(t, db, "https://example.com/hook", api.EventUserDeleted)
+	_, u, _ := seedOrganization(t, db, "auth:UpdateWebhook")
+	req, err := svr.patchAs(u, fmt.Sprintf("/webhook/%d", w.ID), body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusNotFound, res.Code)
+	require.True(t, db.Webhook.GetX(context.Background(), w.ID).Active)
+}
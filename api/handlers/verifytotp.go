@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// VerifyTotp completes login of a user with TOTP enabled. It takes the
+// challenge token returned by Login, and a TOTP code or a recovery code.
+// Wrong codes count as failed logins towards the lockout.
+//
+// Endpoint: POST /login/totp
+func (s Server) VerifyTotp(
+	ctx context.Context, request VerifyTotpRequestObject,
+) (VerifyTotpResponseObject, error) {
+	gc, ok := ctx.(*gin.Context)
+	if !ok {
+		return nil, errInvalidContext
+	}
+	qc := context.Background()
+	ip := gc.ClientIP()
+	wait, err := s.loginLockedOut(qc, ipAttemptKey(ip))
+	if err != nil {
+		api.Log.Debugf("VerifyTotp error: %v", err)
+		return nil, err
+	}
+	if wait > 0 {
+		return VerifyTotp429JSONResponse{lockedOut(wait)}, nil
+	}
+	challenge, err := s.mfaChallengeFromString(request.Body.ChallengeToken)
+	if err != nil || nil == challenge.user.TotpConfirmedAt {
+		return VerifyTotp401JSONResponse{
+			N401JSONResponse: N401JSONResponse{
+				Code:   http.StatusUnauthorized,
+				Errors: &api.ResponseMessageCredentialsInvalid,
+				Status: msgError,
+			},
+		}, nil
+	}
+	u := challenge.user
+	wait, err = s.loginLockedOut(qc, userAttemptKey(u.ID))
+	if err != nil {
+		api.Log.Debugf("VerifyTotp error: %v", err)
+		return nil, err
+	}
+	if wait > 0 {
+		return VerifyTotp429JSONResponse{lockedOut(wait)}, nil
+	}
+	_, err = s.db.Transaction(
+		qc, func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			ok, recovery, err := verifySecondFactor(
+				qc, tx, u, request.Body.Code,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, errInvalidArgument
+			}
+			if !recovery {
+				return nil, nil
+			}
+			return nil, recordAudit(
+				qc, tx, api.AuditRecoveryCodeUsed, u.OrganizationID,
+				challenge, map[string]interface{}{
+					"user_id":   u.ID,
+					"username":  u.Username,
+					"ip":        ip,
+					"remaining": len(u.RecoveryCodes) - 1,
+				},
+			)
+		},
+	)
+	if err != nil {
+		if errors.Is(err, errInvalidArgument) {
+			if err = s.loginFailed(qc, ip, u); err != nil {
+				api.Log.Debugf("VerifyTotp error: %v", err)
+				return nil, err
+			}
+			return VerifyTotp401JSONResponse{
+				N401JSONResponse: N401JSONResponse{
+					Code:   http.StatusUnauthorized,
+					Errors: &msgInvalidCode,
+					Status: msgError,
+				},
+			}, nil
+		}
+		api.Log.Debugf("VerifyTotp error: %v", err)
+		return nil, err
+	}
+	res, err := s.signIn(gc, u)
+	if err != nil {
+		api.Log.Debugf("VerifyTotp error: %v", err)
+		return nil, err
+	}
+	return VerifyTotp200JSONResponse(*res), nil
+}
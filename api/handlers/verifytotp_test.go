@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+)
+
+// Creates the user "test" with TOTP enabled and passes the password step of
+// login. Returns the user, its TOTP secret and the challenge token.
+func loginWithTotp(
+	tb testing.TB, svr *Server, engine http.Handler, db *ent.Client,
+) (*ent.User, string, string) {
+	u, err := svr.createUser(
+		context.Background(), db.User.Create(),
+		CreateUserJSONBody{Username: "test", Password: "Test_123"},
+	)
+	require.Nil(tb, err)
+	secret := enableTotp(tb, db, u.ID)
+	res := tryLogin(tb, svr, engine, "test", "Test_123")
+	require.Equal(tb, http.StatusAccepted, res.Code)
+	challenge := unmarshalResponse(tb, Login202JSONResponse{}, res)
+	return u, secret, challenge.ChallengeToken
+}
+
+// Submits the one-time code, returns the response.
+func tryTotp(
+	tb testing.TB, svr *Server, engine http.Handler, challenge, code string,
+) *httptest.ResponseRecorder {
+	req, err := svr.post(
+		"/login/totp",
+		VerifyTotpJSONRequestBody{ChallengeToken: challenge, Code: code},
+	)
+	require.Nil(tb, err)
+	req.RemoteAddr = "192.0.2.1:1234"
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	return res
+}
+
+func Test_VerifyTotp_completes_login(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u, secret, challenge := loginWithTotp(t, svr, engine, db)
+	res := tryTotp(t, svr, engine, challenge, currentTotp(t, secret))
+	require.Equal(t, http.StatusOK, res.Code)
+	actual := unmarshalResponse(t, VerifyTotp200JSONResponse{}, res)
+	require.Equal(t, u.ID, actual.Id)
+	cookies := res.Result().Cookies()
+	require.Len(t, cookies, 2)
+	at, err := svr.jwtTokenFromString(cookies[0].Value)
+	require.Nil(t, err)
+	require.Nil(t, at.checkAccessToken())
+}
+
+func Test_VerifyTotp_accepts_recovery_code_once(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u, _, challenge := loginWithTotp(t, svr, engine, db)
+	code := recoveryCode(t, db, u.ID)
+	res := tryTotp(t, svr, engine, challenge, code)
+	require.Equal(t, http.StatusOK, res.Code)
+	log := db.AuditLog.Query().
+		Where(auditlog.ActionEQ(api.AuditRecoveryCodeUsed)).
+		OnlyX(context.Background())
+	require.Equal(t, u.ID, *log.ActorID)
+	require.Equal(t, float64(recoveryCodeCount-1), log.Details["remaining"])
+	res = tryTotp(t, svr, engine, challenge, code)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_VerifyTotp_rejects_reused_code(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, secret, challenge := loginWithTotp(t, svr, engine, db)
+	code := currentTotp(t, secret)
+	res := tryTotp(t, svr, engine, challenge, code)
+	require.Equal(t, http.StatusOK, res.Code)
+	res = tryTotp(t, svr, engine, challenge, code)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	expected := map[string]interface{}{
+		"code":   http.StatusUnauthorized,
+		"errors": msgInvalidCode,
+		"status": msgError,
+	}
+	requireJsonEqualsString(t, expected, res.Body.String())
+}
+
+func Test_VerifyTotp_locks_out_after_too_many_failures(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, secret, challenge := loginWithTotp(t, svr, engine, db)
+	for i := 0; i < svr.loginMaxAttempts; i++ {
+		res := tryTotp(t, svr, engine, challenge, "000000")
+		require.Equal(t, http.StatusUnauthorized, res.Code)
+	}
+	res := tryTotp(t, svr, engine, challenge, currentTotp(t, secret))
+	require.Equal(t, http.StatusTooManyRequests, res.Code)
+	require.NotEmpty(t, res.Header().Get("Retry-After"))
+}
+
+func Test_VerifyTotp_reports_401_if_challenge_invalid(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u, secret, _ := loginWithTotp(t, svr, engine, db)
+	at, err := svr.issueAccessToken(u)
+	require.Nil(t, err)
+	res := tryTotp(t, svr, engine, at, currentTotp(t, secret))
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	res = tryTotp(t, svr, engine, "invalid", currentTotp(t, secret))
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_VerifyTotp_reports_401_if_totp_disabled_since(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u, secret, challenge := loginWithTotp(t, svr, engine, db)
+	db.User.UpdateOneID(u.ID).ClearTotpSecret().ClearTotpConfirmedAt().
+		ExecX(context.Background())
+	res := tryTotp(t, svr, engine, challenge, currentTotp(t, secret))
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_VerifyTotp_reports_401_if_challenge_expired(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	u, secret, _ := loginWithTotp(t, svr, engine, db)
+	_, claims, err := svr.buildTokenClaims(u, -time.Minute)
+	require.Nil(t, err)
+	claims.Mfa = "totp"
+	challenge, err := svr.issueJwtTokenWithClaims(
+		jwt.SigningMethodHS256, claims,
+	)
+	require.Nil(t, err)
+	res := tryTotp(t, svr, engine, challenge, currentTotp(t, secret))
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func Test_VerifyTotp_returns_500_if_attempt_store_error(t *testing.T) {
+	svr, engine, db, _ := setupTestCase(t, true)
+	_, secret, challenge := loginWithTotp(t, svr, engine, db)
+	svr.UseLoginAttemptStore(failingLoginAttemptStore{})
+	res := tryTotp(t, svr, engine, challenge, currentTotp(t, secret))
+	require.Equal(t, http.StatusInternalServerError, res.Code)
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/credential"
+	"github.com/eidng8/go-attr-rbac/ent/webauthnchallenge"
+)
+
+// How long the user has to complete a WebAuthn ceremony.
+const webauthnTimeout = 5 * time.Minute
+
+// Builds the WebAuthn relying party from the base URL. The relying party ID is
+// the host name, and the origin is the scheme and host of the base URL.
+func (s Server) relyingParty() (*webauthn.WebAuthn, error) {
+	u, err := url.Parse(s.baseUrl)
+	if err != nil {
+		return nil, err
+	}
+	if "" == u.Hostname() {
+		return nil, fmt.Errorf("base URL %q has no host", s.baseUrl)
+	}
+	timeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    webauthnTimeout,
+		TimeoutUVD: webauthnTimeout,
+	}
+	return webauthn.New(
+		&webauthn.Config{
+			RPID:          u.Hostname(),
+			RPDisplayName: s.getTotpIssuer(),
+			RPOrigins:     []string{u.Scheme + "://" + u.Host},
+			Timeouts: webauthn.TimeoutsConfig{
+				Login:        timeout,
+				Registration: timeout,
+			},
+		},
+	)
+}
+
+// Adapts the user and its credentials to webauthn.User.
+type webauthnUser struct {
+	*ent.User
+	credentials []*ent.Credential
+}
+
+// Returns the user with its registered credentials loaded.
+func loadWebauthnUser(
+	qc context.Context, db *ent.Client, u *ent.User,
+) (*webauthnUser, error) {
+	creds, err := db.Credential.Query().
+		Where(credential.UserIDEQ(u.ID)).All(qc)
+	if err != nil {
+		return nil, err
+	}
+	return &webauthnUser{User: u, credentials: creds}, nil
+}
+
+// The user handle is the big-endian user ID, which doesn't reveal anything
+// the user can't see anyway.
+func webauthnUserHandle(id uint64) []byte {
+	handle := make([]byte, 8)
+	binary.BigEndian.PutUint64(handle, id)
+	return handle
+}
+
+func (u *webauthnUser) WebAuthnID() []byte {
+	return webauthnUserHandle(u.ID)
+}
+
+func (u *webauthnUser) WebAuthnName() string {
+	return u.Username
+}
+
+func (u *webauthnUser) WebAuthnDisplayName() string {
+	return u.Username
+}
+
+func (u *webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	creds := make([]webauthn.Credential, len(u.credentials))
+	for i, c := range u.credentials {
+		transports := make([]protocol.AuthenticatorTransport, len(c.Transports))
+		for j, t := range c.Transports {
+			transports[j] = protocol.AuthenticatorTransport(t)
+		}
+		creds[i] = webauthn.Credential{
+			ID:              c.CredentialID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				UserPresent:    true,
+				UserVerified:   true,
+				BackupEligible: c.BackupEligible,
+				BackupState:    c.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    c.Aaguid,
+				SignCount: c.SignCount,
+			},
+		}
+	}
+	return creds
+}
+
+// Returns the stored credential with the given credential ID.
+func (u *webauthnUser) credential(id []byte) *ent.Credential {
+	for _, c := range u.credentials {
+		if hmac.Equal(c.CredentialID, id) {
+			return c
+		}
+	}
+	return nil
+}
+
+// Seals the ceremony state into a token handed to the client.
+func sealWebauthnSession(session *webauthn.SessionData) (string, error) {
+	return sealState("webauthn", session)
+}
+
+// Verifies and decodes the token returned by sealWebauthnSession. Expired
+// ceremonies are rejected.
+func openWebauthnSession(token string) (*webauthn.SessionData, error) {
+	var session webauthn.SessionData
+	if err := openState("webauthn", token, &session); err != nil {
+		return nil, err
+	}
+	if session.Expires.IsZero() || time.Now().After(session.Expires) {
+		return nil, errInvalidSession
+	}
+	return &session, nil
+}
+
+// Records the challenge of the ceremony as consumed, so its assertion can't be
+// replayed. Returns errInvalidSession if the challenge was consumed already.
+// Challenges of expired ceremonies are removed along the way, they're rejected
+// by openWebauthnSession anyway.
+func consumeWebauthnChallenge(
+	qc context.Context, db *ent.Client, session *webauthn.SessionData,
+) error {
+	_, err := db.WebauthnChallenge.Delete().
+		Where(webauthnchallenge.ExpiresAtLT(time.Now())).Exec(qc)
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256([]byte(session.Challenge))
+	err = db.WebauthnChallenge.Create().SetChallenge(hash[:]).
+		SetExpiresAt(session.Expires).Exec(qc)
+	if ent.IsConstraintError(err) {
+		return errInvalidSession
+	}
+	return err
+}
+
+// Converts the options of a ceremony to the generic map of the response.
+func webauthnOptions(options interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	var res map[string]interface{}
+	if err = json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/ent"
+)
+
+// A software authenticator holding one ES256 passkey, which creates and
+// signs responses the way a browser and a platform authenticator would.
+type softAuthenticator struct {
+	key        *ecdsa.PrivateKey
+	id         []byte
+	userHandle []byte
+	counter    uint32
+	// synced passkeys don't count signatures, they always report zero
+	uncounted bool
+}
+
+func newSoftAuthenticator(tb testing.TB) *softAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(tb, err)
+	id := make([]byte, 16)
+	_, err = rand.Read(id)
+	require.Nil(tb, err)
+	return &softAuthenticator{key: key, id: id}
+}
+
+// Returns the challenge and the public key options of the ceremony.
+func ceremonyOptions(
+	tb testing.TB, options map[string]interface{},
+) (string, map[string]interface{}) {
+	pk, ok := options["publicKey"].(map[string]interface{})
+	require.True(tb, ok)
+	challenge, ok := pk["challenge"].(string)
+	require.True(tb, ok)
+	return challenge, pk
+}
+
+func (a *softAuthenticator) clientData(
+	tb testing.TB, typ, challenge, origin string,
+) []byte {
+	data, err := json.Marshal(
+		map[string]interface{}{
+			"type":      typ,
+			"challenge": challenge,
+			"origin":    origin,
+		},
+	)
+	require.Nil(tb, err)
+	return data
+}
+
+// Returns the authenticator data with user presence and verification flags.
+func (a *softAuthenticator) authData(rpId string, attested []byte) []byte {
+	hash := sha256.Sum256([]byte(rpId))
+	flags := byte(0x05)
+	if nil != attested {
+		flags |= 0x40
+	}
+	if !a.uncounted {
+		a.counter++
+	}
+	data := append(hash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, a.counter)
+	return append(data, attested...)
+}
+
+// Responds to `navigator.credentials.create()` with the given options.
+func (a *softAuthenticator) create(
+	tb testing.TB, options map[string]interface{}, origin string,
+) map[string]interface{} {
+	challenge, pk := ceremonyOptions(tb, options)
+	rp, ok := pk["rp"].(map[string]interface{})
+	require.True(tb, ok)
+	usr, ok := pk["user"].(map[string]interface{})
+	require.True(tb, ok)
+	handle, err := base64.RawURLEncoding.DecodeString(usr["id"].(string))
+	require.Nil(tb, err)
+	a.userHandle = handle
+	cose, err := webauthncbor.Marshal(
+		webauthncose.EC2PublicKeyData{
+			PublicKeyData: webauthncose.PublicKeyData{
+				KeyType:   int64(webauthncose.EllipticKey),
+				Algorithm: int64(webauthncose.AlgES256),
+			},
+			Curve:  int64(webauthncose.P256),
+			XCoord: a.key.X.FillBytes(make([]byte, 32)),
+			YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+		},
+	)
+	require.Nil(tb, err)
+	// zero AAGUID, then the credential ID and public key
+	attested := make([]byte, 16)
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.id)))
+	attested = append(attested, a.id...)
+	attested = append(attested, cose...)
+	att, err := webauthncbor.Marshal(
+		map[string]interface{}{
+			"fmt":      "none",
+			"attStmt":  map[string]interface{}{},
+			"authData": a.authData(rp["id"].(string), attested),
+		},
+	)
+	require.Nil(tb, err)
+	id := base64.RawURLEncoding.EncodeToString(a.id)
+	return map[string]interface{}{
+		"id":    id,
+		"rawId": id,
+		"type":  "public-key",
+		"response": map[string]interface{}{
+			"clientDataJSON": base64.RawURLEncoding.EncodeToString(
+				a.clientData(tb, "webauthn.create", challenge, origin),
+			),
+			"attestationObject": base64.RawURLEncoding.EncodeToString(att),
+		},
+	}
+}
+
+// Responds to `navigator.credentials.get()` with the given options.
+func (a *softAuthenticator) get(
+	tb testing.TB, options map[string]interface{}, origin string,
+) map[string]interface{} {
+	challenge, pk := ceremonyOptions(tb, options)
+	data := a.authData(pk["rpId"].(string), nil)
+	client := a.clientData(tb, "webauthn.get", challenge, origin)
+	hash := sha256.Sum256(client)
+	digest := sha256.Sum256(append(data, hash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.Nil(tb, err)
+	id := base64.RawURLEncoding.EncodeToString(a.id)
+	return map[string]interface{}{
+		"id":    id,
+		"rawId": id,
+		"type":  "public-key",
+		"response": map[string]interface{}{
+			"clientDataJSON": base64.RawURLEncoding.EncodeToString(client),
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(
+				data,
+			),
+			"signature":  base64.RawURLEncoding.EncodeToString(sig),
+			"userHandle": base64.RawURLEncoding.EncodeToString(a.userHandle),
+		},
+	}
+}
+
+// Returns the origin of the relying party of the server.
+func webauthnOrigin(tb testing.TB, svr *Server) string {
+	u, err := url.Parse(svr.baseUrl)
+	require.Nil(tb, err)
+	return u.Scheme + "://" + u.Host
+}
+
+// Registers a passkey for the user through the API, returns the authenticator.
+func registerPasskey(
+	tb testing.TB, svr *Server, engine http.Handler, db *ent.Client, id uint64,
+) *softAuthenticator {
+	usr := getUserById(tb, db, id)
+	req, err := svr.postAs(usr, "/me/webauthn/register", nil)
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(tb, http.StatusOK, res.Code)
+	ceremony := unmarshalResponse(
+		tb, BeginWebauthnRegistration200JSONResponse{}, res,
+	)
+	a := newSoftAuthenticator(tb)
+	req, err = svr.postAs(
+		usr, "/me/webauthn/register/finish",
+		FinishWebauthnRegistrationJSONRequestBody{
+			SessionToken: ceremony.SessionToken,
+			Credential:   a.create(tb, ceremony.Options, webauthnOrigin(tb, svr)),
+		},
+	)
+	require.Nil(tb, err)
+	res = httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(tb, http.StatusCreated, res.Code)
+	return a
+}
+
+// Starts a passkey login through the API, returns the ceremony.
+func beginPasskeyLogin(
+	tb testing.TB, svr *Server, engine http.Handler,
+) BeginWebauthnLogin200JSONResponse {
+	req, err := svr.post("/login/webauthn", nil)
+	require.Nil(tb, err)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, req)
+	require.Equal(tb, http.StatusOK, res.Code)
+	return unmarshalResponse(tb, BeginWebauthnLogin200JSONResponse{}, res)
+}
+
+func Test_relyingParty_uses_base_url(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	svr.baseUrl = "https://example.com:8443/api"
+	wa, err := svr.relyingParty()
+	require.Nil(t, err)
+	require.Equal(t, "example.com", wa.Config.RPID)
+	require.Equal(t, []string{"https://example.com:8443"}, wa.Config.RPOrigins)
+}
+
+func Test_relyingParty_requires_host(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	svr.baseUrl = "/api"
+	_, err := svr.relyingParty()
+	require.NotNil(t, err)
+}
+
+func Test_openWebauthnSession_returns_sealed_session(t *testing.T) {
+	setupTestEnv(t)
+	session := webauthn.SessionData{
+		Challenge: "abc",
+		UserID:    []byte{1},
+		Expires:   time.Now().Add(time.Minute),
+	}
+	token, err := sealWebauthnSession(&session)
+	require.Nil(t, err)
+	actual, err := openWebauthnSession(token)
+	require.Nil(t, err)
+	require.Equal(t, session.Challenge, actual.Challenge)
+	require.Equal(t, session.UserID, actual.UserID)
+}
+
+func Test_openWebauthnSession_rejects_tampered_token(t *testing.T) {
+	setupTestEnv(t)
+	token, err := sealWebauthnSession(
+		&webauthn.SessionData{
+			Challenge: "abc",
+			Expires:   time.Now().Add(time.Minute),
+		},
+	)
+	require.Nil(t, err)
+	forged, err := json.Marshal(
+		webauthn.SessionData{
+			Challenge: "xyz",
+			Expires:   time.Now().Add(time.Minute),
+		},
+	)
+	require.Nil(t, err)
+	_, sig, _ := strings.Cut(token, ".")
+	_, err = openWebauthnSession(
+		base64.RawURLEncoding.EncodeToString(forged) + "." + sig,
+	)
+	require.ErrorIs(t, err, errInvalidSession)
+	_, err = openWebauthnSession("abc")
+	require.ErrorIs(t, err, errInvalidSession)
+}
+
+func Test_openWebauthnSession_rejects_expired_session(t *testing.T) {
+	setupTestEnv(t)
+	token, err := sealWebauthnSession(
+		&webauthn.SessionData{
+			Challenge: "abc",
+			Expires:   time.Now().Add(-time.Second),
+		},
+	)
+	require.Nil(t, err)
+	_, err = openWebauthnSession(token)
+	require.ErrorIs(t, err, errInvalidSession)
+}
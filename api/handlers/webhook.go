@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/eidng8/go-ent/softdelete"
+	"github.com/google/uuid"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/permission"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+	"github.com/eidng8/go-attr-rbac/ent/webhookdelivery"
+)
+
+// webhook request headers
+const (
+	webhookHeaderDelivery  = "X-Webhook-Delivery"
+	webhookHeaderEvent     = "X-Webhook-Event"
+	webhookHeaderSignature = "X-Webhook-Signature"
+	webhookHeaderTimestamp = "X-Webhook-Timestamp"
+)
+
+// number of deliveries to pick from the outbox in one round
+const webhookBatchSize = 100
+
+// Claimed deliveries are leased for the send timeout plus this margin, other
+// dispatchers don't pick them up until the lease runs out.
+const webhookLeaseMargin = 30 * time.Second
+
+// emitEvent writes the event to the outbox of every active webhook of the
+// organization subscribed to it. It must be called within the transaction that
+// makes the change, so that the event is recorded if and only if the change
+// is committed.
+func emitEvent(
+	qc context.Context, tx *ent.Tx, organization uint32, event string,
+	data map[string]interface{},
+) error {
+	hooks, err := tx.Webhook.Query().Where(
+		webhook.ActiveEQ(true), webhook.OrganizationIDEQ(organization),
+	).Select(webhook.FieldID, webhook.FieldEvents).All(qc)
+	if err != nil {
+		return err
+	}
+	hooks = slices.DeleteFunc(
+		hooks, func(h *ent.Webhook) bool {
+			return !slices.Contains(h.Events, event)
+		},
+	)
+	if len(hooks) == 0 {
+		return nil
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	payload := map[string]interface{}{
+		"id":         id.String(),
+		"event":      event,
+		"created_at": time.Now().UTC().Format(time.RFC3339),
+		"data":       data,
+	}
+	deliveries := make([]*ent.WebhookDeliveryCreate, len(hooks))
+	for i, h := range hooks {
+		deliveries[i] = tx.WebhookDelivery.Create().SetWebhookID(h.ID).
+			SetEvent(event).SetPayload(payload)
+	}
+	return tx.WebhookDelivery.CreateBulk(deliveries...).Exec(qc)
+}
+
+// emitUserEvent emits the event of the user to the webhooks of the user's
+// organization. Deleted users are included, so that their deletion can be
+// reported.
+func emitUserEvent(
+	qc context.Context, tx *ent.Tx, id uint64, event string,
+	data map[string]interface{},
+) error {
+	u, err := tx.User.Query().Where(user.IDEQ(id)).
+		Select(user.FieldOrganizationID).Only(softdelete.IncludeTrashed(qc))
+	if err != nil {
+		return err
+	}
+	return emitEvent(qc, tx, u.OrganizationID, event, data)
+}
+
+// emitUserRolesChanged emits the `user.roles_changed` event with the current
+// effective role names of the user, resolved the same way as its tokens'.
+func emitUserRolesChanged(qc context.Context, tx *ent.Tx, id uint64) error {
+	roles, err := queryEffectiveRoles(tx.Role, id).Select(role.FieldName).
+		Strings(qc)
+	if err != nil {
+		return err
+	}
+	return emitUserEvent(
+		qc, tx, id, api.EventUserRolesChanged,
+		map[string]interface{}{"user_id": id, "roles": roles},
+	)
+}
+
+// emitRolePermissionsChanged emits the `role.permissions_changed` event with
+// the current permission names of the role.
+func emitRolePermissionsChanged(
+	qc context.Context, tx *ent.Tx, id uint32,
+) error {
+	r, err := tx.Role.Get(qc, id)
+	if err != nil {
+		return err
+	}
+	perms, err := tx.Role.QueryPermissions(r).Order(permission.ByID()).
+		Select(permission.FieldName).Strings(qc)
+	if err != nil {
+		return err
+	}
+	return emitEvent(
+		qc, tx, r.OrganizationID, api.EventRolePermissionsChanged,
+		map[string]interface{}{"role_id": id, "permissions": perms},
+	)
+}
+
+// changedIds returns IDs that are in only one of the given lists, i.e. the
+// ones whose association was either added or removed.
+func changedIds[T comparable](before, after []T) []T {
+	var changed []T
+	for _, id := range before {
+		if !slices.Contains(after, id) {
+			changed = append(changed, id)
+		}
+	}
+	for _, id := range after {
+		if !slices.Contains(before, id) && !slices.Contains(changed, id) {
+			changed = append(changed, id)
+		}
+	}
+	return changed
+}
+
+// DispatchWebhooks delivers pending webhook events from the outbox, polling
+// it every `interval`, until the context is cancelled. It is meant to be run
+// in its own goroutine. Deliveries are claimed before being sent, so it is
+// safe to run the dispatcher on several replicas.
+func (s Server) DispatchWebhooks(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.deliverWebhooks(ctx); err != nil {
+			api.Log.Errorf("webhook dispatch error: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverWebhooks makes one delivery attempt for every due delivery.
+func (s Server) deliverWebhooks(ctx context.Context) error {
+	rows, err := s.db.WebhookDelivery.Query().WithWebhook().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+		).
+		Order(webhookdelivery.ByID()).Limit(webhookBatchSize).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err = s.deliverWebhook(ctx, row); err != nil {
+			api.Log.Debugf("webhook delivery %d error: %v", row.ID, err)
+		}
+	}
+	return nil
+}
+
+// deliverWebhook claims the delivery by bumping its attempt counter and
+// leasing it past the send timeout, sends it, and records the outcome. Failed
+// deliveries are rescheduled with exponential backoff, until the maximum number
+// of attempts is reached. Deliveries of dispatchers that died while sending
+// are picked up again once their lease runs out.
+func (s Server) deliverWebhook(
+	ctx context.Context, d *ent.WebhookDelivery,
+) error {
+	n, err := s.db.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.IDEQ(d.ID),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.AttemptsEQ(d.Attempts),
+		).
+		AddAttempts(1).
+		SetNextAttemptAt(
+			time.Now().Add(s.webhookClient.Timeout + webhookLeaseMargin),
+		).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if 0 == n {
+		// claimed by another dispatcher
+		return nil
+	}
+	attempts := d.Attempts + 1
+	code, err := s.postWebhook(ctx, d)
+	update := s.db.WebhookDelivery.UpdateOneID(d.ID)
+	if code > 0 {
+		update.SetResponseCode(code)
+	}
+	if nil == err {
+		return update.SetStatus(webhookdelivery.StatusDelivered).
+			SetDeliveredAt(time.Now()).ClearLastError().Exec(ctx)
+	}
+	update.SetLastError(err.Error())
+	if attempts >= s.webhookMaxAttempts {
+		update.SetStatus(webhookdelivery.StatusFailed)
+	} else {
+		update.SetNextAttemptAt(time.Now().Add(webhookBackoff(attempts)))
+	}
+	return update.Exec(ctx)
+}
+
+// postWebhook sends the delivery to its webhook URL. Returns the response
+// status code, if a response was received, and an error if the delivery was
+// not accepted with a 2xx status.
+func (s Server) postWebhook(
+	ctx context.Context, d *ent.WebhookDelivery,
+) (int, error) {
+	body, err := json.Marshal(d.Payload)
+	if err != nil {
+		return 0, err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, d.Edges.Webhook.URL, bytes.NewReader(body),
+	)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookHeaderDelivery, strconv.FormatUint(d.ID, 10))
+	req.Header.Set(webhookHeaderEvent, d.Event)
+	req.Header.Set(webhookHeaderTimestamp, ts)
+	req.Header.Set(
+		webhookHeaderSignature,
+		"sha256="+signWebhook(d.Edges.Webhook.Secret, ts, body),
+	)
+	res, err := s.webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return res.StatusCode, nil
+}
+
+// signWebhook returns the hex encoded HMAC-SHA256 of `timestamp.body`, keyed
+// with the webhook secret. Receivers compute the same value to verify the
+// delivery, and reject stale timestamps to prevent replays.
+func signWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff returns the delay before the next attempt, doubling from 30
+// seconds for each attempt made, up to about 8.5 hours.
+func webhookBackoff(attempts uint32) time.Duration {
+	return time.Duration(1<<min(attempts-1, 10)) * 30 * time.Second
+}
@@ -0,0 +1,256 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eidng8/go-utils"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/ent"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+	"github.com/eidng8/go-attr-rbac/ent/webhookdelivery"
+)
+
+func Test_emitEvent_writes_outbox_for_subscribed_webhooks(t *testing.T) {
+	body := CreateUserJSONBody{Username: "test_user", Password: "Abcd_1234"}
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	w := seedWebhook(t, db, "https://example.com/hook", api.EventUserCreated)
+	seedWebhook(t, db, "https://example.com/other", api.EventUserDeleted)
+	db.Webhook.Create().SetURL("https://example.com/inactive").
+		SetSecret(randomSecret(32)).SetEvents([]string{api.EventUserCreated}).
+		SetActive(false).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/users", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	rows := db.WebhookDelivery.Query().AllX(qc)
+	require.Len(t, rows, 1)
+	require.Equal(t, w.ID, rows[0].WebhookID)
+	require.Equal(t, api.EventUserCreated, rows[0].Event)
+	require.Equal(t, webhookdelivery.StatusPending, rows[0].Status)
+	require.Equal(t, api.EventUserCreated, rows[0].Payload["event"])
+	data := rows[0].Payload["data"].(map[string]interface{})
+	require.Equal(t, body.Username, data["username"])
+}
+
+func Test_emitEvent_not_recorded_if_transaction_fails(t *testing.T) {
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	seedWebhook(t, db, "https://example.com/hook", api.EventUserRolesChanged)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/user/2/roles", []int{1234})
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.NotEqual(t, http.StatusNoContent, res.Code)
+	require.Zero(t, db.WebhookDelivery.Query().CountX(qc))
+}
+
+func Test_emitUserRolesChanged_sends_effective_roles(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	seedWebhook(t, db, "https://example.com/hook", api.EventUserRolesChanged)
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(2)).
+		SetStartsAt(time.Now().Add(time.Hour)).ExecX(qc)
+	db.UserRole.Update().Where(userrole.UserIDEQ(2), userrole.RoleIDEQ(3)).
+		SetExpiresAt(time.Now().Add(-time.Second)).ExecX(qc)
+	seedGroup(t, db, []uint64{2}, []uint32{5})
+	_, err := db.Transaction(
+		qc, func(qc context.Context, tx *ent.Tx) (interface{}, error) {
+			return nil, emitUserRolesChanged(qc, tx, 2)
+		},
+	)
+	require.Nil(t, err)
+	d := db.WebhookDelivery.Query().OnlyX(qc)
+	data := d.Payload["data"].(map[string]interface{})
+	u := getUserById(t, db, 2)
+	require.Nil(t, svr.loadRoles(u))
+	expected := utils.Pluck(u.Edges.Roles, ent.PluckRoleName)
+	require.Equal(t, []string{"role 2", "role 3"}, expected)
+	require.Equal(t, []interface{}{"role 2", "role 3"}, data["roles"])
+}
+
+func Test_deliverWebhooks_sends_signed_payload(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	var header http.Header
+	var body []byte
+	hook := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header.Clone()
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(http.StatusNoContent)
+			},
+		),
+	)
+	defer hook.Close()
+	w := seedWebhook(t, db, hook.URL, api.EventUserDeleted)
+	d := db.WebhookDelivery.Create().SetWebhookID(w.ID).
+		SetEvent(api.EventUserDeleted).
+		SetPayload(map[string]interface{}{"event": api.EventUserDeleted}).
+		SaveX(qc)
+	require.Nil(t, svr.deliverWebhooks(qc))
+	require.JSONEq(t, `{"event":"user.deleted"}`, string(body))
+	require.Equal(t, api.EventUserDeleted, header.Get(webhookHeaderEvent))
+	ts := header.Get(webhookHeaderTimestamp)
+	require.Equal(
+		t, "sha256="+signWebhook(w.Secret, ts, body),
+		header.Get(webhookHeaderSignature),
+	)
+	d = db.WebhookDelivery.GetX(qc, d.ID)
+	require.Equal(t, webhookdelivery.StatusDelivered, d.Status)
+	require.Equal(t, uint32(1), d.Attempts)
+	require.Equal(t, http.StatusNoContent, *d.ResponseCode)
+	require.NotNil(t, d.DeliveredAt)
+	require.Nil(t, d.LastError)
+}
+
+func Test_deliverWebhooks_retries_with_backoff_then_fails(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	hook := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+		),
+	)
+	defer hook.Close()
+	svr.webhookMaxAttempts = 2
+	w := seedWebhook(t, db, hook.URL, api.EventUserDeleted)
+	d := db.WebhookDelivery.Create().SetWebhookID(w.ID).
+		SetEvent(api.EventUserDeleted).
+		SetPayload(map[string]interface{}{}).SaveX(qc)
+	require.Nil(t, svr.deliverWebhooks(qc))
+	d = db.WebhookDelivery.GetX(qc, d.ID)
+	require.Equal(t, webhookdelivery.StatusPending, d.Status)
+	require.Equal(t, uint32(1), d.Attempts)
+	require.Equal(t, http.StatusServiceUnavailable, *d.ResponseCode)
+	require.NotNil(t, d.LastError)
+	require.True(t, d.NextAttemptAt.After(time.Now().Add(29*time.Second)))
+	// not due yet
+	require.Nil(t, svr.deliverWebhooks(qc))
+	require.Equal(t, uint32(1), db.WebhookDelivery.GetX(qc, d.ID).Attempts)
+	db.WebhookDelivery.UpdateOneID(d.ID).SetNextAttemptAt(time.Now()).
+		ExecX(qc)
+	require.Nil(t, svr.deliverWebhooks(qc))
+	d = db.WebhookDelivery.GetX(qc, d.ID)
+	require.Equal(t, webhookdelivery.StatusFailed, d.Status)
+	require.Equal(t, uint32(2), d.Attempts)
+}
+
+func Test_deliverWebhook_skips_delivery_claimed_elsewhere(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	called := false
+	hook := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) { called = true },
+		),
+	)
+	defer hook.Close()
+	w := seedWebhook(t, db, hook.URL, api.EventUserDeleted)
+	d := db.WebhookDelivery.Create().SetWebhookID(w.ID).
+		SetEvent(api.EventUserDeleted).
+		SetPayload(map[string]interface{}{}).SaveX(qc)
+	d = db.WebhookDelivery.Query().WithWebhook().
+		Where(webhookdelivery.IDEQ(d.ID)).OnlyX(qc)
+	db.WebhookDelivery.UpdateOneID(d.ID).AddAttempts(1).ExecX(qc)
+	require.Nil(t, svr.deliverWebhook(qc, d))
+	require.False(t, called)
+}
+
+func Test_deliverWebhook_leases_delivery_while_sending(t *testing.T) {
+	svr, _, db, _ := setupTestCase(t, true)
+	qc := context.Background()
+	var leased time.Time
+	var picked int
+	hook := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				d := db.WebhookDelivery.Query().OnlyX(qc)
+				leased = d.NextAttemptAt
+				// another dispatcher polling during the send
+				picked = db.WebhookDelivery.Query().Where(
+					webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+					webhookdelivery.NextAttemptAtLTE(time.Now()),
+				).CountX(qc)
+				w.WriteHeader(http.StatusNoContent)
+			},
+		),
+	)
+	defer hook.Close()
+	w := seedWebhook(t, db, hook.URL, api.EventUserDeleted)
+	db.WebhookDelivery.Create().SetWebhookID(w.ID).
+		SetEvent(api.EventUserDeleted).
+		SetPayload(map[string]interface{}{}).ExecX(qc)
+	require.Nil(t, svr.deliverWebhooks(qc))
+	require.True(
+		t, leased.After(time.Now().Add(svr.webhookClient.Timeout)),
+	)
+	require.Zero(t, picked)
+}
+
+func Test_DispatchWebhooks_stops_when_context_cancelled(t *testing.T) {
+	svr, _, _, _ := setupTestCase(t, false)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		svr.DispatchWebhooks(ctx, time.Millisecond)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("dispatcher did not stop")
+	}
+}
+
+func Test_signWebhook_returns_hmac_sha256(t *testing.T) {
+	require.Equal(
+		t,
+		"b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163",
+		signWebhook("secret", "1700000000", []byte(`{}`)),
+	)
+}
+
+func Test_webhookBackoff_doubles_up_to_limit(t *testing.T) {
+	require.Equal(t, 30*time.Second, webhookBackoff(1))
+	require.Equal(t, time.Minute, webhookBackoff(2))
+	require.Equal(t, 4*time.Minute, webhookBackoff(4))
+	require.Equal(t, 1024*30*time.Second, webhookBackoff(11))
+	require.Equal(t, 1024*30*time.Second, webhookBackoff(20))
+}
+
+func Test_changedIds_returns_symmetric_difference(t *testing.T) {
+	require.Equal(t, []int{1, 4}, changedIds([]int{1, 2, 3}, []int{2, 3, 4}))
+	require.Nil(t, changedIds([]int{1, 2}, []int{2, 1}))
+}
+
+func Test_emitEvent_only_writes_outbox_for_webhooks_of_organization(t *testing.T) {
+	body := CreateUserJSONBody{Username: "test_user", Password: "Abcd_1234"}
+	svr, engine, db, res := setupTestCase(t, true)
+	qc := context.Background()
+	w := seedWebhook(t, db, "https://example.com/hook", api.EventUserCreated)
+	o, _, _ := seedOrganization(t, db)
+	db.Webhook.Create().SetOrganizationID(o.ID).
+		SetURL("https://example.com/tenant").SetSecret(randomSecret(32)).
+		SetEvents([]string{api.EventUserCreated}).ExecX(qc)
+	u := getUserById(t, db, 1)
+	req, err := svr.postAs(u, "/users", body)
+	require.Nil(t, err)
+	engine.ServeHTTP(res, req)
+	require.Equal(t, http.StatusCreated, res.Code)
+	rows := db.WebhookDelivery.Query().AllX(qc)
+	require.Len(t, rows, 1)
+	require.Equal(t, w.ID, rows[0].WebhookID)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Names of the cookies the server sets at login.
+const (
+	AccessTokenCookie  = "access_token"
+	RefreshTokenCookie = "refresh_token"
+)
+
+// BearerAuth authenticates requests with the access token, including OAuth
+// ones, e.g.
+//
+//	c, err := NewClientWithResponses(url, WithRequestEditorFn(BearerAuth(at)))
+func BearerAuth(token string) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
+
+// PersonalTokenAuth authenticates requests with the personal token.
+func PersonalTokenAuth(token string) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Token "+token)
+		return nil
+	}
+}
+
+// CookieAuth authenticates requests with the cookies the server set at login,
+// as returned by SessionCookies. Only names and values are sent, regardless of
+// the domain, path and secure attributes of the cookies.
+func CookieAuth(cookies ...*http.Cookie) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		for _, c := range cookies {
+			req.AddCookie(&http.Cookie{Name: c.Name, Value: c.Value})
+		}
+		return nil
+	}
+}
+
+// SessionCookies returns the access and refresh token cookies set by the
+// response, e.g. of Login and RefreshAccessToken.
+func SessionCookies(res *http.Response) []*http.Cookie {
+	var cookies []*http.Cookie
+	for _, c := range res.Cookies() {
+		if AccessTokenCookie == c.Name || RefreshTokenCookie == c.Name {
+			cookies = append(cookies, c)
+		}
+	}
+	return cookies
+}
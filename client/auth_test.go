@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BearerAuth_sets_authorization_header(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	require.Nil(t, BearerAuth("abc")(context.Background(), req))
+	require.Equal(t, "Bearer abc", req.Header.Get("Authorization"))
+}
+
+func Test_PersonalTokenAuth_sets_authorization_header(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	require.Nil(t, PersonalTokenAuth("abc")(context.Background(), req))
+	require.Equal(t, "Token abc", req.Header.Get("Authorization"))
+}
+
+func Test_CookieAuth_sends_names_and_values_only(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	editor := CookieAuth(
+		&http.Cookie{
+			Name: AccessTokenCookie, Value: "abc", Domain: "example.com",
+			Secure: true,
+		},
+	)
+	require.Nil(t, editor(context.Background(), req))
+	require.Equal(t, "access_token=abc", req.Header.Get("Cookie"))
+}
+
+func Test_SessionCookies_returns_token_cookies(t *testing.T) {
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Add("Set-Cookie", "access_token=abc; Path=/; Secure")
+	res.Header.Add("Set-Cookie", "refresh_token=def; Path=/access-token")
+	res.Header.Add("Set-Cookie", "other=ghi")
+	cookies := SessionCookies(res)
+	require.Len(t, cookies, 2)
+	require.Equal(t, AccessTokenCookie, cookies[0].Name)
+	require.Equal(t, "abc", cookies[0].Value)
+	require.Equal(t, RefreshTokenCookie, cookies[1].Name)
+	require.Equal(t, "def", cookies[1].Value)
+}
@@ -0,0 +1,14 @@
+// Package client is the Go client of the REST API, generated from
+// `ent/openapi.json` into client.gen.go, along with helpers to authenticate
+// requests and to walk through paginated lists.
+//
+//	c, err := client.NewClientWithResponses(
+//		"https://auth.example.com",
+//		client.WithRequestEditorFn(client.PersonalTokenAuth(token)),
+//	)
+//	users, err := client.All[client.UserList](
+//		ctx, func(ctx context.Context, page int) (*http.Response, error) {
+//			return c.ListUser(ctx, &client.ListUserParams{Page: &page})
+//		},
+//	)
+package client
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/eidng8/go-utils"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eidng8/go-attr-rbac/api"
+	"github.com/eidng8/go-attr-rbac/api/handlers"
+	"github.com/eidng8/go-attr-rbac/ent/enttest"
+)
+
+const rootPassword = "Root_123"
+
+// Starts the server with an empty database, and returns a client of it and
+// its URL. The root user's password is rootPassword.
+func setupTestServer(tb testing.TB) (*ClientWithResponses, string) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	require.Nil(tb, err)
+	require.Nil(tb, os.Setenv(api.BaseUrlName, "http://localhost"))
+	require.Nil(
+		tb, os.Setenv(
+			api.PrivateKeyName, base64.StdEncoding.EncodeToString(secret),
+		),
+	)
+	db := enttest.Open(tb, "sqlite3", ":memory:?_fk=1")
+	tb.Cleanup(func() { require.Nil(tb, db.Close()) })
+	_, engine, err := handlers.NewEngine(db)
+	require.Nil(tb, err)
+	hash, err := utils.HashPassword(rootPassword)
+	require.Nil(tb, err)
+	db.User.UpdateOneID(1).SetPassword(hash).ExecX(context.Background())
+	svr := httptest.NewServer(engine)
+	tb.Cleanup(svr.Close)
+	c, err := NewClientWithResponses(svr.URL)
+	require.Nil(tb, err)
+	return c, svr.URL
+}
+
+// Logs in as root and returns the session cookies.
+func login(tb testing.TB, c *ClientWithResponses) []*http.Cookie {
+	username := "root"
+	res, err := c.LoginWithResponse(
+		context.Background(),
+		LoginJSONRequestBody{Username: &username, Password: rootPassword},
+	)
+	require.Nil(tb, err)
+	require.Equal(tb, http.StatusOK, res.StatusCode())
+	require.Equal(tb, "root", res.JSON200.Username)
+	cookies := SessionCookies(res.HTTPResponse)
+	require.Len(tb, cookies, 2)
+	return cookies
+}
+
+func accessToken(cookies []*http.Cookie) string {
+	for _, c := range cookies {
+		if AccessTokenCookie == c.Name {
+			return c.Value
+		}
+	}
+	return ""
+}
+
+func Test_Client_returns_401_without_auth(t *testing.T) {
+	c, _ := setupTestServer(t)
+	res, err := c.ReadUserWithResponse(context.Background(), 1, nil)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode())
+}
+
+func Test_Client_authenticates_with_cookies(t *testing.T) {
+	c, _ := setupTestServer(t)
+	cookies := login(t, c)
+	res, err := c.ReadUserWithResponse(
+		context.Background(), 1, nil, CookieAuth(cookies...),
+	)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.Equal(t, "root", res.JSON200.Username)
+}
+
+func Test_Client_refreshes_with_cookies(t *testing.T) {
+	c, _ := setupTestServer(t)
+	cookies := login(t, c)
+	res, err := c.RefreshAccessTokenWithResponse(
+		context.Background(), CookieAuth(cookies...),
+	)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusNoContent, res.StatusCode())
+	require.Len(t, SessionCookies(res.HTTPResponse), 2)
+}
+
+func Test_Client_authenticates_with_bearer_token(t *testing.T) {
+	c, _ := setupTestServer(t)
+	at := accessToken(login(t, c))
+	op := "auth:ListUser"
+	res, err := c.CheckAccessTokenWithResponse(
+		context.Background(), &CheckAccessTokenParams{Operation: &op},
+		BearerAuth(at),
+	)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusNoContent, res.StatusCode())
+}
+
+func Test_Client_authenticates_with_personal_token(t *testing.T) {
+	c, url := setupTestServer(t)
+	cookies := login(t, c)
+	res, err := c.CreatePersonalTokenWithResponse(
+		context.Background(), CreatePersonalTokenJSONRequestBody{
+			Description: "test", Scopes: []string{"auth:ReadUser"}, Ttl: 3600,
+		}, CookieAuth(cookies...),
+	)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusCreated, res.StatusCode())
+	pc, err := NewClientWithResponses(
+		url, WithRequestEditorFn(PersonalTokenAuth(res.JSON201.Token)),
+	)
+	require.Nil(t, err)
+	ru, err := pc.ReadUserWithResponse(context.Background(), 1, nil)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, ru.StatusCode())
+}
+
+func Test_Client_creates_and_lists_users(t *testing.T) {
+	c, _ := setupTestServer(t)
+	auth := CookieAuth(login(t, c)...)
+	for _, name := range []string{"alice", "bob", "carol"} {
+		res, err := c.CreateUserWithResponse(
+			context.Background(), CreateUserJSONRequestBody{
+				Username: name, Password: "Test_123",
+			}, auth,
+		)
+		require.Nil(t, err)
+		require.Equal(t, http.StatusCreated, res.StatusCode())
+		require.Equal(t, name, res.JSON201.Username)
+	}
+	perPage := 2
+	users, err := All[UserList](
+		context.Background(),
+		func(ctx context.Context, page int) (*http.Response, error) {
+			return c.ListUser(
+				ctx, &ListUserParams{Page: &page, PerPage: &perPage}, auth,
+			)
+		},
+	)
+	require.Nil(t, err)
+	names := make([]string, len(users))
+	for i, u := range users {
+		names[i] = u.Username
+	}
+	require.Equal(t, []string{"root", "alice", "bob", "carol"}, names)
+}
+
+func Test_All_returns_every_permission(t *testing.T) {
+	c, _ := setupTestServer(t)
+	auth := CookieAuth(login(t, c)...)
+	perPage := 10
+	fetch := func(ctx context.Context, page int) (*http.Response, error) {
+		return c.ListPermission(
+			ctx, &ListPermissionParams{Page: &page, PerPage: &perPage}, auth,
+		)
+	}
+	first, err := FetchPage[PermissionList](context.Background(), fetch, 1)
+	require.Nil(t, err)
+	require.Len(t, first.Data, perPage)
+	perms, err := All[PermissionList](context.Background(), fetch)
+	require.Nil(t, err)
+	require.Len(t, perms, first.Total)
+	seen := map[uint32]bool{}
+	for _, p := range perms {
+		require.False(t, seen[p.Id])
+		seen[p.Id] = true
+	}
+}
+
+func Test_All_returns_ResponseError_without_auth(t *testing.T) {
+	c, _ := setupTestServer(t)
+	_, err := All[PermissionList](
+		context.Background(),
+		func(ctx context.Context, page int) (*http.Response, error) {
+			return c.ListPermission(ctx, &ListPermissionParams{Page: &page})
+		},
+	)
+	var re *ResponseError
+	require.ErrorAs(t, err, &re)
+	require.Equal(t, http.StatusUnauthorized, re.StatusCode)
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// Page is the paginated list returned by list operations.
+type Page[T any] struct {
+	// CurrentPage is the page number, 1-based.
+	CurrentPage int `json:"current_page"`
+	// Data is the items of the page.
+	Data []T `json:"data"`
+	// From is the 1-based index of the first item of the page.
+	From int `json:"from"`
+	// To is the 1-based index of the last item of the page.
+	To int `json:"to"`
+	// LastPage is the last page number.
+	LastPage int `json:"last_page"`
+	// PerPage is the number of items per page.
+	PerPage int `json:"per_page"`
+	// Total is the number of items of all pages.
+	Total int `json:"total"`
+}
+
+// PageFunc requests the page of a list operation, e.g.
+//
+//	func(ctx context.Context, page int) (*http.Response, error) {
+//		return c.ListUser(ctx, &ListUserParams{Page: &page})
+//	}
+type PageFunc func(ctx context.Context, page int) (*http.Response, error)
+
+// ResponseError is returned when the server responds with unexpected status.
+type ResponseError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+// FetchPage requests and decodes the page, returns ResponseError if the
+// server responds other than 200.
+func FetchPage[T any](
+	ctx context.Context, fetch PageFunc, page int,
+) (*Page[T], error) {
+	res, err := fetch(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if http.StatusOK != res.StatusCode {
+		body, _ := io.ReadAll(res.Body)
+		return nil, &ResponseError{StatusCode: res.StatusCode, Body: body}
+	}
+	var p Page[T]
+	if err = json.NewDecoder(res.Body).Decode(&p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Items yields the items of all pages in order, requesting pages as they're
+// consumed. Stops after yielding the first error.
+func Items[T any](ctx context.Context, fetch PageFunc) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page := 1; ; page++ {
+			p, err := FetchPage[T](ctx, fetch, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range p.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if p.CurrentPage >= p.LastPage || 0 == len(p.Data) {
+				return
+			}
+		}
+	}
+}
+
+// All returns the items of all pages.
+func All[T any](ctx context.Context, fetch PageFunc) ([]T, error) {
+	var items []T
+	for item, err := range Items[T](ctx, fetch) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
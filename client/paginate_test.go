@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Returns a PageFunc serving the items in pages of the size, and counting the
+// pages requested.
+func testPages(items []int, size int, requested *int) PageFunc {
+	return func(_ context.Context, page int) (*http.Response, error) {
+		*requested++
+		from := min((page-1)*size, len(items))
+		to := min(from+size, len(items))
+		res := httptest.NewRecorder()
+		_ = json.NewEncoder(res).Encode(
+			map[string]interface{}{
+				"current_page": page,
+				"data":         items[from:to],
+				"from":         from + 1,
+				"to":           to,
+				"last_page":    max(1, (len(items)+size-1)/size),
+				"per_page":     size,
+				"total":        len(items),
+			},
+		)
+		return res.Result(), nil
+	}
+}
+
+func Test_FetchPage_decodes_page(t *testing.T) {
+	requested := 0
+	p, err := FetchPage[int](
+		context.Background(), testPages([]int{1, 2, 3}, 2, &requested), 2,
+	)
+	require.Nil(t, err)
+	require.Equal(t, 2, p.CurrentPage)
+	require.Equal(t, []int{3}, p.Data)
+	require.Equal(t, 2, p.LastPage)
+	require.Equal(t, 3, p.Total)
+}
+
+func Test_FetchPage_returns_ResponseError_if_not_200(t *testing.T) {
+	fetch := func(_ context.Context, _ int) (*http.Response, error) {
+		res := httptest.NewRecorder()
+		res.WriteHeader(http.StatusForbidden)
+		_, _ = res.WriteString("denied")
+		return res.Result(), nil
+	}
+	_, err := FetchPage[int](context.Background(), fetch, 1)
+	var re *ResponseError
+	require.ErrorAs(t, err, &re)
+	require.Equal(t, http.StatusForbidden, re.StatusCode)
+	require.Equal(t, "denied", string(re.Body))
+}
+
+func Test_All_returns_items_of_all_pages(t *testing.T) {
+	requested := 0
+	items, err := All[int](
+		context.Background(), testPages([]int{1, 2, 3, 4, 5}, 2, &requested),
+	)
+	require.Nil(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	require.Equal(t, 3, requested)
+}
+
+func Test_All_returns_empty_list(t *testing.T) {
+	requested := 0
+	items, err := All[int](
+		context.Background(), testPages(nil, 2, &requested),
+	)
+	require.Nil(t, err)
+	require.Empty(t, items)
+	require.Equal(t, 1, requested)
+}
+
+func Test_Items_requests_pages_as_consumed(t *testing.T) {
+	requested := 0
+	var items []int
+	for item, err := range Items[int](
+		context.Background(), testPages([]int{1, 2, 3, 4, 5}, 2, &requested),
+	) {
+		require.Nil(t, err)
+		items = append(items, item)
+		if 3 == item {
+			break
+		}
+	}
+	require.Equal(t, []int{1, 2, 3}, items)
+	require.Equal(t, 2, requested)
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Stores role access requests
+type AccessRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uint32 `json:"organization_id,omitempty"`
+	// RequesterID holds the value of the "requester_id" field.
+	RequesterID uint64 `json:"requester_id,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID uint32 `json:"role_id,omitempty"`
+	// Justification holds the value of the "justification" field.
+	Justification string `json:"justification,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration *uint32 `json:"duration,omitempty"`
+	// Status holds the value of the "status" field.
+	Status accessrequest.Status `json:"status,omitempty"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID *uint64 `json:"reviewer_id,omitempty"`
+	// ReviewComment holds the value of the "review_comment" field.
+	ReviewComment string `json:"review_comment,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccessRequestQuery when eager-loading is set.
+	Edges        AccessRequestEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// AccessRequestEdges holds the relations/edges for other nodes in the graph.
+type AccessRequestEdges struct {
+	// Requester holds the value of the requester edge.
+	Requester *User `json:"requester,omitempty"`
+	// Role holds the value of the role edge.
+	Role *Role `json:"role,omitempty"`
+	// Reviewer holds the value of the reviewer edge.
+	Reviewer *User `json:"reviewer,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// RequesterOrErr returns the Requester value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessRequestEdges) RequesterOrErr() (*User, error) {
+	if e.Requester != nil {
+		return e.Requester, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "requester"}
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessRequestEdges) RoleOrErr() (*Role, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// ReviewerOrErr returns the Reviewer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccessRequestEdges) ReviewerOrErr() (*User, error) {
+	if e.Reviewer != nil {
+		return e.Reviewer, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "reviewer"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accessrequest.FieldID, accessrequest.FieldOrganizationID, accessrequest.FieldRequesterID, accessrequest.FieldRoleID, accessrequest.FieldDuration, accessrequest.FieldReviewerID:
+			values[i] = new(sql.NullInt64)
+		case accessrequest.FieldJustification, accessrequest.FieldStatus, accessrequest.FieldReviewComment:
+			values[i] = new(sql.NullString)
+		case accessrequest.FieldReviewedAt, accessrequest.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessRequest fields.
+func (ar *AccessRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accessrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ar.ID = uint64(value.Int64)
+		case accessrequest.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				ar.OrganizationID = uint32(value.Int64)
+			}
+		case accessrequest.FieldRequesterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requester_id", values[i])
+			} else if value.Valid {
+				ar.RequesterID = uint64(value.Int64)
+			}
+		case accessrequest.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				ar.RoleID = uint32(value.Int64)
+			}
+		case accessrequest.FieldJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field justification", values[i])
+			} else if value.Valid {
+				ar.Justification = value.String
+			}
+		case accessrequest.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				ar.Duration = new(uint32)
+				*ar.Duration = uint32(value.Int64)
+			}
+		case accessrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ar.Status = accessrequest.Status(value.String)
+			}
+		case accessrequest.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				ar.ReviewerID = new(uint64)
+				*ar.ReviewerID = uint64(value.Int64)
+			}
+		case accessrequest.FieldReviewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[i])
+			} else if value.Valid {
+				ar.ReviewComment = value.String
+			}
+		case accessrequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				ar.ReviewedAt = new(time.Time)
+				*ar.ReviewedAt = value.Time
+			}
+		case accessrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		default:
+			ar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccessRequest.
+// This includes values selected through modifiers, order, etc.
+func (ar *AccessRequest) Value(name string) (ent.Value, error) {
+	return ar.selectValues.Get(name)
+}
+
+// QueryRequester queries the "requester" edge of the AccessRequest entity.
+func (ar *AccessRequest) QueryRequester() *UserQuery {
+	return NewAccessRequestClient(ar.config).QueryRequester(ar)
+}
+
+// QueryRole queries the "role" edge of the AccessRequest entity.
+func (ar *AccessRequest) QueryRole() *RoleQuery {
+	return NewAccessRequestClient(ar.config).QueryRole(ar)
+}
+
+// QueryReviewer queries the "reviewer" edge of the AccessRequest entity.
+func (ar *AccessRequest) QueryReviewer() *UserQuery {
+	return NewAccessRequestClient(ar.config).QueryReviewer(ar)
+}
+
+// Update returns a builder for updating this AccessRequest.
+// Note that you need to call AccessRequest.Unwrap() before calling this method if this AccessRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *AccessRequest) Update() *AccessRequestUpdateOne {
+	return NewAccessRequestClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the AccessRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *AccessRequest) Unwrap() *AccessRequest {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessRequest is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *AccessRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("requester_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.RequesterID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("justification=")
+	builder.WriteString(ar.Justification)
+	builder.WriteString(", ")
+	if v := ar.Duration; v != nil {
+		builder.WriteString("duration=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Status))
+	builder.WriteString(", ")
+	if v := ar.ReviewerID; v != nil {
+		builder.WriteString("reviewer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_comment=")
+	builder.WriteString(ar.ReviewComment)
+	builder.WriteString(", ")
+	if v := ar.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckAccessRequestID returns the "ID" field value.
+func PluckAccessRequestID(ar *AccessRequest) uint64 {
+	return ar.ID
+}
+
+// PluckAccessRequestOrganizationID returns the "organization_id" field value.
+func PluckAccessRequestOrganizationID(ar *AccessRequest) uint32 {
+	return ar.OrganizationID
+}
+
+// PluckAccessRequestRequesterID returns the "requester_id" field value.
+func PluckAccessRequestRequesterID(ar *AccessRequest) uint64 {
+	return ar.RequesterID
+}
+
+// PluckAccessRequestRoleID returns the "role_id" field value.
+func PluckAccessRequestRoleID(ar *AccessRequest) uint32 {
+	return ar.RoleID
+}
+
+// PluckAccessRequestJustification returns the "justification" field value.
+func PluckAccessRequestJustification(ar *AccessRequest) string {
+	return ar.Justification
+}
+
+// PluckAccessRequestDuration returns the "duration" field value.
+func PluckAccessRequestDuration(ar *AccessRequest) *uint32 {
+	return ar.Duration
+}
+
+// PluckAccessRequestStatus returns the "status" field value.
+func PluckAccessRequestStatus(ar *AccessRequest) accessrequest.Status {
+	return ar.Status
+}
+
+// PluckAccessRequestReviewerID returns the "reviewer_id" field value.
+func PluckAccessRequestReviewerID(ar *AccessRequest) *uint64 {
+	return ar.ReviewerID
+}
+
+// PluckAccessRequestReviewComment returns the "review_comment" field value.
+func PluckAccessRequestReviewComment(ar *AccessRequest) string {
+	return ar.ReviewComment
+}
+
+// PluckAccessRequestReviewedAt returns the "reviewed_at" field value.
+func PluckAccessRequestReviewedAt(ar *AccessRequest) *time.Time {
+	return ar.ReviewedAt
+}
+
+// PluckAccessRequestCreatedAt returns the "created_at" field value.
+func PluckAccessRequestCreatedAt(ar *AccessRequest) time.Time {
+	return ar.CreatedAt
+}
+
+// AccessRequests is a parsable slice of AccessRequest.
+type AccessRequests []*AccessRequest
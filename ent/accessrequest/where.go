@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accessrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// RequesterID applies equality check predicate on the "requester_id" field. It's identical to RequesterIDEQ.
+func RequesterID(v uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldRoleID, v))
+}
+
+// Justification applies equality check predicate on the "justification" field. It's identical to JustificationEQ.
+func Justification(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldJustification, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldDuration, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewComment applies equality check predicate on the "review_comment" field. It's identical to ReviewCommentEQ.
+func ReviewComment(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// RequesterIDEQ applies the EQ predicate on the "requester_id" field.
+func RequesterIDEQ(v uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// RequesterIDNEQ applies the NEQ predicate on the "requester_id" field.
+func RequesterIDNEQ(v uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldRequesterID, v))
+}
+
+// RequesterIDIn applies the In predicate on the "requester_id" field.
+func RequesterIDIn(vs ...uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldRequesterID, vs...))
+}
+
+// RequesterIDNotIn applies the NotIn predicate on the "requester_id" field.
+func RequesterIDNotIn(vs ...uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldRequesterID, vs...))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// JustificationEQ applies the EQ predicate on the "justification" field.
+func JustificationEQ(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldJustification, v))
+}
+
+// JustificationNEQ applies the NEQ predicate on the "justification" field.
+func JustificationNEQ(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldJustification, v))
+}
+
+// JustificationIn applies the In predicate on the "justification" field.
+func JustificationIn(vs ...string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldJustification, vs...))
+}
+
+// JustificationNotIn applies the NotIn predicate on the "justification" field.
+func JustificationNotIn(vs ...string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldJustification, vs...))
+}
+
+// JustificationGT applies the GT predicate on the "justification" field.
+func JustificationGT(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGT(FieldJustification, v))
+}
+
+// JustificationGTE applies the GTE predicate on the "justification" field.
+func JustificationGTE(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGTE(FieldJustification, v))
+}
+
+// JustificationLT applies the LT predicate on the "justification" field.
+func JustificationLT(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLT(FieldJustification, v))
+}
+
+// JustificationLTE applies the LTE predicate on the "justification" field.
+func JustificationLTE(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLTE(FieldJustification, v))
+}
+
+// JustificationContains applies the Contains predicate on the "justification" field.
+func JustificationContains(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldContains(FieldJustification, v))
+}
+
+// JustificationHasPrefix applies the HasPrefix predicate on the "justification" field.
+func JustificationHasPrefix(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldHasPrefix(FieldJustification, v))
+}
+
+// JustificationHasSuffix applies the HasSuffix predicate on the "justification" field.
+func JustificationHasSuffix(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldHasSuffix(FieldJustification, v))
+}
+
+// JustificationEqualFold applies the EqualFold predicate on the "justification" field.
+func JustificationEqualFold(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEqualFold(FieldJustification, v))
+}
+
+// JustificationContainsFold applies the ContainsFold predicate on the "justification" field.
+func JustificationContainsFold(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldContainsFold(FieldJustification, v))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v uint32) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLTE(FieldDuration, v))
+}
+
+// DurationIsNil applies the IsNil predicate on the "duration" field.
+func DurationIsNil() predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIsNull(FieldDuration))
+}
+
+// DurationNotNil applies the NotNil predicate on the "duration" field.
+func DurationNotNil() predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotNull(FieldDuration))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...uint64) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDIsNil applies the IsNil predicate on the "reviewer_id" field.
+func ReviewerIDIsNil() predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIsNull(FieldReviewerID))
+}
+
+// ReviewerIDNotNil applies the NotNil predicate on the "reviewer_id" field.
+func ReviewerIDNotNil() predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotNull(FieldReviewerID))
+}
+
+// ReviewCommentEQ applies the EQ predicate on the "review_comment" field.
+func ReviewCommentEQ(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentNEQ applies the NEQ predicate on the "review_comment" field.
+func ReviewCommentNEQ(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentIn applies the In predicate on the "review_comment" field.
+func ReviewCommentIn(vs ...string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentNotIn applies the NotIn predicate on the "review_comment" field.
+func ReviewCommentNotIn(vs ...string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentGT applies the GT predicate on the "review_comment" field.
+func ReviewCommentGT(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGT(FieldReviewComment, v))
+}
+
+// ReviewCommentGTE applies the GTE predicate on the "review_comment" field.
+func ReviewCommentGTE(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGTE(FieldReviewComment, v))
+}
+
+// ReviewCommentLT applies the LT predicate on the "review_comment" field.
+func ReviewCommentLT(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLT(FieldReviewComment, v))
+}
+
+// ReviewCommentLTE applies the LTE predicate on the "review_comment" field.
+func ReviewCommentLTE(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLTE(FieldReviewComment, v))
+}
+
+// ReviewCommentContains applies the Contains predicate on the "review_comment" field.
+func ReviewCommentContains(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldContains(FieldReviewComment, v))
+}
+
+// ReviewCommentHasPrefix applies the HasPrefix predicate on the "review_comment" field.
+func ReviewCommentHasPrefix(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldHasPrefix(FieldReviewComment, v))
+}
+
+// ReviewCommentHasSuffix applies the HasSuffix predicate on the "review_comment" field.
+func ReviewCommentHasSuffix(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldHasSuffix(FieldReviewComment, v))
+}
+
+// ReviewCommentIsNil applies the IsNil predicate on the "review_comment" field.
+func ReviewCommentIsNil() predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIsNull(FieldReviewComment))
+}
+
+// ReviewCommentNotNil applies the NotNil predicate on the "review_comment" field.
+func ReviewCommentNotNil() predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotNull(FieldReviewComment))
+}
+
+// ReviewCommentEqualFold applies the EqualFold predicate on the "review_comment" field.
+func ReviewCommentEqualFold(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEqualFold(FieldReviewComment, v))
+}
+
+// ReviewCommentContainsFold applies the ContainsFold predicate on the "review_comment" field.
+func ReviewCommentContainsFold(v string) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldContainsFold(FieldReviewComment, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRequester applies the HasEdge predicate on the "requester" edge.
+func HasRequester() predicate.AccessRequest {
+	return predicate.AccessRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RequesterTable, RequesterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequesterWith applies the HasEdge predicate on the "requester" edge with a given conditions (other predicates).
+func HasRequesterWith(preds ...predicate.User) predicate.AccessRequest {
+	return predicate.AccessRequest(func(s *sql.Selector) {
+		step := newRequesterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRole applies the HasEdge predicate on the "role" edge.
+func HasRole() predicate.AccessRequest {
+	return predicate.AccessRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RoleTable, RoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleWith applies the HasEdge predicate on the "role" edge with a given conditions (other predicates).
+func HasRoleWith(preds ...predicate.Role) predicate.AccessRequest {
+	return predicate.AccessRequest(func(s *sql.Selector) {
+		step := newRoleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewer applies the HasEdge predicate on the "reviewer" edge.
+func HasReviewer() predicate.AccessRequest {
+	return predicate.AccessRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReviewerTable, ReviewerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewerWith applies the HasEdge predicate on the "reviewer" edge with a given conditions (other predicates).
+func HasReviewerWith(preds ...predicate.User) predicate.AccessRequest {
+	return predicate.AccessRequest(func(s *sql.Selector) {
+		step := newReviewerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccessRequest) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccessRequest) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccessRequest) predicate.AccessRequest {
+	return predicate.AccessRequest(sql.NotPredicates(p))
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// AccessRequestCreate is the builder for creating a AccessRequest entity.
+type AccessRequestCreate struct {
+	config
+	mutation *AccessRequestMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (arc *AccessRequestCreate) SetOrganizationID(u uint32) *AccessRequestCreate {
+	arc.mutation.SetOrganizationID(u)
+	return arc
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (arc *AccessRequestCreate) SetRequesterID(u uint64) *AccessRequestCreate {
+	arc.mutation.SetRequesterID(u)
+	return arc
+}
+
+// SetRoleID sets the "role_id" field.
+func (arc *AccessRequestCreate) SetRoleID(u uint32) *AccessRequestCreate {
+	arc.mutation.SetRoleID(u)
+	return arc
+}
+
+// SetJustification sets the "justification" field.
+func (arc *AccessRequestCreate) SetJustification(s string) *AccessRequestCreate {
+	arc.mutation.SetJustification(s)
+	return arc
+}
+
+// SetDuration sets the "duration" field.
+func (arc *AccessRequestCreate) SetDuration(u uint32) *AccessRequestCreate {
+	arc.mutation.SetDuration(u)
+	return arc
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (arc *AccessRequestCreate) SetNillableDuration(u *uint32) *AccessRequestCreate {
+	if u != nil {
+		arc.SetDuration(*u)
+	}
+	return arc
+}
+
+// SetStatus sets the "status" field.
+func (arc *AccessRequestCreate) SetStatus(a accessrequest.Status) *AccessRequestCreate {
+	arc.mutation.SetStatus(a)
+	return arc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (arc *AccessRequestCreate) SetNillableStatus(a *accessrequest.Status) *AccessRequestCreate {
+	if a != nil {
+		arc.SetStatus(*a)
+	}
+	return arc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (arc *AccessRequestCreate) SetReviewerID(u uint64) *AccessRequestCreate {
+	arc.mutation.SetReviewerID(u)
+	return arc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (arc *AccessRequestCreate) SetNillableReviewerID(u *uint64) *AccessRequestCreate {
+	if u != nil {
+		arc.SetReviewerID(*u)
+	}
+	return arc
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (arc *AccessRequestCreate) SetReviewComment(s string) *AccessRequestCreate {
+	arc.mutation.SetReviewComment(s)
+	return arc
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (arc *AccessRequestCreate) SetNillableReviewComment(s *string) *AccessRequestCreate {
+	if s != nil {
+		arc.SetReviewComment(*s)
+	}
+	return arc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (arc *AccessRequestCreate) SetReviewedAt(t time.Time) *AccessRequestCreate {
+	arc.mutation.SetReviewedAt(t)
+	return arc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (arc *AccessRequestCreate) SetNillableReviewedAt(t *time.Time) *AccessRequestCreate {
+	if t != nil {
+		arc.SetReviewedAt(*t)
+	}
+	return arc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *AccessRequestCreate) SetCreatedAt(t time.Time) *AccessRequestCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *AccessRequestCreate) SetNillableCreatedAt(t *time.Time) *AccessRequestCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetID sets the "id" field.
+func (arc *AccessRequestCreate) SetID(u uint64) *AccessRequestCreate {
+	arc.mutation.SetID(u)
+	return arc
+}
+
+// SetRequester sets the "requester" edge to the User entity.
+func (arc *AccessRequestCreate) SetRequester(u *User) *AccessRequestCreate {
+	return arc.SetRequesterID(u.ID)
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (arc *AccessRequestCreate) SetRole(r *Role) *AccessRequestCreate {
+	return arc.SetRoleID(r.ID)
+}
+
+// SetReviewer sets the "reviewer" edge to the User entity.
+func (arc *AccessRequestCreate) SetReviewer(u *User) *AccessRequestCreate {
+	return arc.SetReviewerID(u.ID)
+}
+
+// Mutation returns the AccessRequestMutation object of the builder.
+func (arc *AccessRequestCreate) Mutation() *AccessRequestMutation {
+	return arc.mutation
+}
+
+// Save creates the AccessRequest in the database.
+func (arc *AccessRequestCreate) Save(ctx context.Context) (*AccessRequest, error) {
+	arc.defaults()
+	return withHooks(ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *AccessRequestCreate) SaveX(ctx context.Context) *AccessRequest {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *AccessRequestCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *AccessRequestCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *AccessRequestCreate) defaults() {
+	if _, ok := arc.mutation.Status(); !ok {
+		v := accessrequest.DefaultStatus
+		arc.mutation.SetStatus(v)
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := accessrequest.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *AccessRequestCreate) check() error {
+	if _, ok := arc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "AccessRequest.organization_id"`)}
+	}
+	if _, ok := arc.mutation.RequesterID(); !ok {
+		return &ValidationError{Name: "requester_id", err: errors.New(`ent: missing required field "AccessRequest.requester_id"`)}
+	}
+	if _, ok := arc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "AccessRequest.role_id"`)}
+	}
+	if _, ok := arc.mutation.Justification(); !ok {
+		return &ValidationError{Name: "justification", err: errors.New(`ent: missing required field "AccessRequest.justification"`)}
+	}
+	if v, ok := arc.mutation.Justification(); ok {
+		if err := accessrequest.JustificationValidator(v); err != nil {
+			return &ValidationError{Name: "justification", err: fmt.Errorf(`ent: validator failed for field "AccessRequest.justification": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AccessRequest.status"`)}
+	}
+	if v, ok := arc.mutation.Status(); ok {
+		if err := accessrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccessRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := arc.mutation.ReviewComment(); ok {
+		if err := accessrequest.ReviewCommentValidator(v); err != nil {
+			return &ValidationError{Name: "review_comment", err: fmt.Errorf(`ent: validator failed for field "AccessRequest.review_comment": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessRequest.created_at"`)}
+	}
+	if len(arc.mutation.RequesterIDs()) == 0 {
+		return &ValidationError{Name: "requester", err: errors.New(`ent: missing required edge "AccessRequest.requester"`)}
+	}
+	if len(arc.mutation.RoleIDs()) == 0 {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "AccessRequest.role"`)}
+	}
+	return nil
+}
+
+func (arc *AccessRequestCreate) sqlSave(ctx context.Context) (*AccessRequest, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *AccessRequestCreate) createSpec() (*AccessRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessRequest{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(accessrequest.Table, sqlgraph.NewFieldSpec(accessrequest.FieldID, field.TypeUint64))
+	)
+	if id, ok := arc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := arc.mutation.OrganizationID(); ok {
+		_spec.SetField(accessrequest.FieldOrganizationID, field.TypeUint32, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := arc.mutation.Justification(); ok {
+		_spec.SetField(accessrequest.FieldJustification, field.TypeString, value)
+		_node.Justification = value
+	}
+	if value, ok := arc.mutation.Duration(); ok {
+		_spec.SetField(accessrequest.FieldDuration, field.TypeUint32, value)
+		_node.Duration = &value
+	}
+	if value, ok := arc.mutation.Status(); ok {
+		_spec.SetField(accessrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := arc.mutation.ReviewComment(); ok {
+		_spec.SetField(accessrequest.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = value
+	}
+	if value, ok := arc.mutation.ReviewedAt(); ok {
+		_spec.SetField(accessrequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.SetField(accessrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := arc.mutation.RequesterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   accessrequest.RequesterTable,
+			Columns: []string{accessrequest.RequesterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RequesterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := arc.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   accessrequest.RoleTable,
+			Columns: []string{accessrequest.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := arc.mutation.ReviewerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   accessrequest.ReviewerTable,
+			Columns: []string{accessrequest.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReviewerID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AccessRequestCreateBulk is the builder for creating many AccessRequest entities in bulk.
+type AccessRequestCreateBulk struct {
+	config
+	err      error
+	builders []*AccessRequestCreate
+}
+
+// Save creates the AccessRequest entities in the database.
+func (arcb *AccessRequestCreateBulk) Save(ctx context.Context) ([]*AccessRequest, error) {
+	if arcb.err != nil {
+		return nil, arcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*AccessRequest, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *AccessRequestCreateBulk) SaveX(ctx context.Context) []*AccessRequest {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *AccessRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *AccessRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
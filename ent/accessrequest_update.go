@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// AccessRequestUpdate is the builder for updating AccessRequest entities.
+type AccessRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessRequestMutation
+}
+
+// Where appends a list predicates to the AccessRequestUpdate builder.
+func (aru *AccessRequestUpdate) Where(ps ...predicate.AccessRequest) *AccessRequestUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// SetStatus sets the "status" field.
+func (aru *AccessRequestUpdate) SetStatus(a accessrequest.Status) *AccessRequestUpdate {
+	aru.mutation.SetStatus(a)
+	return aru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aru *AccessRequestUpdate) SetNillableStatus(a *accessrequest.Status) *AccessRequestUpdate {
+	if a != nil {
+		aru.SetStatus(*a)
+	}
+	return aru
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (aru *AccessRequestUpdate) SetReviewerID(u uint64) *AccessRequestUpdate {
+	aru.mutation.SetReviewerID(u)
+	return aru
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (aru *AccessRequestUpdate) SetNillableReviewerID(u *uint64) *AccessRequestUpdate {
+	if u != nil {
+		aru.SetReviewerID(*u)
+	}
+	return aru
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (aru *AccessRequestUpdate) ClearReviewerID() *AccessRequestUpdate {
+	aru.mutation.ClearReviewerID()
+	return aru
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (aru *AccessRequestUpdate) SetReviewComment(s string) *AccessRequestUpdate {
+	aru.mutation.SetReviewComment(s)
+	return aru
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (aru *AccessRequestUpdate) SetNillableReviewComment(s *string) *AccessRequestUpdate {
+	if s != nil {
+		aru.SetReviewComment(*s)
+	}
+	return aru
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (aru *AccessRequestUpdate) ClearReviewComment() *AccessRequestUpdate {
+	aru.mutation.ClearReviewComment()
+	return aru
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (aru *AccessRequestUpdate) SetReviewedAt(t time.Time) *AccessRequestUpdate {
+	aru.mutation.SetReviewedAt(t)
+	return aru
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (aru *AccessRequestUpdate) SetNillableReviewedAt(t *time.Time) *AccessRequestUpdate {
+	if t != nil {
+		aru.SetReviewedAt(*t)
+	}
+	return aru
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (aru *AccessRequestUpdate) ClearReviewedAt() *AccessRequestUpdate {
+	aru.mutation.ClearReviewedAt()
+	return aru
+}
+
+// SetReviewer sets the "reviewer" edge to the User entity.
+func (aru *AccessRequestUpdate) SetReviewer(u *User) *AccessRequestUpdate {
+	return aru.SetReviewerID(u.ID)
+}
+
+// Mutation returns the AccessRequestMutation object of the builder.
+func (aru *AccessRequestUpdate) Mutation() *AccessRequestMutation {
+	return aru.mutation
+}
+
+// ClearReviewer clears the "reviewer" edge to the User entity.
+func (aru *AccessRequestUpdate) ClearReviewer() *AccessRequestUpdate {
+	aru.mutation.ClearReviewer()
+	return aru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *AccessRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aru.sqlSave, aru.mutation, aru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *AccessRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *AccessRequestUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *AccessRequestUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aru *AccessRequestUpdate) check() error {
+	if v, ok := aru.mutation.Status(); ok {
+		if err := accessrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccessRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := aru.mutation.ReviewComment(); ok {
+		if err := accessrequest.ReviewCommentValidator(v); err != nil {
+			return &ValidationError{Name: "review_comment", err: fmt.Errorf(`ent: validator failed for field "AccessRequest.review_comment": %w`, err)}
+		}
+	}
+	if aru.mutation.RequesterCleared() && len(aru.mutation.RequesterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccessRequest.requester"`)
+	}
+	if aru.mutation.RoleCleared() && len(aru.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccessRequest.role"`)
+	}
+	return nil
+}
+
+func (aru *AccessRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accessrequest.Table, accessrequest.Columns, sqlgraph.NewFieldSpec(accessrequest.FieldID, field.TypeUint64))
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aru.mutation.DurationCleared() {
+		_spec.ClearField(accessrequest.FieldDuration, field.TypeUint32)
+	}
+	if value, ok := aru.mutation.Status(); ok {
+		_spec.SetField(accessrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aru.mutation.ReviewComment(); ok {
+		_spec.SetField(accessrequest.FieldReviewComment, field.TypeString, value)
+	}
+	if aru.mutation.ReviewCommentCleared() {
+		_spec.ClearField(accessrequest.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := aru.mutation.ReviewedAt(); ok {
+		_spec.SetField(accessrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if aru.mutation.ReviewedAtCleared() {
+		_spec.ClearField(accessrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if aru.mutation.ReviewerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   accessrequest.ReviewerTable,
+			Columns: []string{accessrequest.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aru.mutation.ReviewerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   accessrequest.ReviewerTable,
+			Columns: []string{accessrequest.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aru.mutation.done = true
+	return n, nil
+}
+
+// AccessRequestUpdateOne is the builder for updating a single AccessRequest entity.
+type AccessRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessRequestMutation
+}
+
+// SetStatus sets the "status" field.
+func (aruo *AccessRequestUpdateOne) SetStatus(a accessrequest.Status) *AccessRequestUpdateOne {
+	aruo.mutation.SetStatus(a)
+	return aruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aruo *AccessRequestUpdateOne) SetNillableStatus(a *accessrequest.Status) *AccessRequestUpdateOne {
+	if a != nil {
+		aruo.SetStatus(*a)
+	}
+	return aruo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (aruo *AccessRequestUpdateOne) SetReviewerID(u uint64) *AccessRequestUpdateOne {
+	aruo.mutation.SetReviewerID(u)
+	return aruo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (aruo *AccessRequestUpdateOne) SetNillableReviewerID(u *uint64) *AccessRequestUpdateOne {
+	if u != nil {
+		aruo.SetReviewerID(*u)
+	}
+	return aruo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (aruo *AccessRequestUpdateOne) ClearReviewerID() *AccessRequestUpdateOne {
+	aruo.mutation.ClearReviewerID()
+	return aruo
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (aruo *AccessRequestUpdateOne) SetReviewComment(s string) *AccessRequestUpdateOne {
+	aruo.mutation.SetReviewComment(s)
+	return aruo
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (aruo *AccessRequestUpdateOne) SetNillableReviewComment(s *string) *AccessRequestUpdateOne {
+	if s != nil {
+		aruo.SetReviewComment(*s)
+	}
+	return aruo
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (aruo *AccessRequestUpdateOne) ClearReviewComment() *AccessRequestUpdateOne {
+	aruo.mutation.ClearReviewComment()
+	return aruo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (aruo *AccessRequestUpdateOne) SetReviewedAt(t time.Time) *AccessRequestUpdateOne {
+	aruo.mutation.SetReviewedAt(t)
+	return aruo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (aruo *AccessRequestUpdateOne) SetNillableReviewedAt(t *time.Time) *AccessRequestUpdateOne {
+	if t != nil {
+		aruo.SetReviewedAt(*t)
+	}
+	return aruo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (aruo *AccessRequestUpdateOne) ClearReviewedAt() *AccessRequestUpdateOne {
+	aruo.mutation.ClearReviewedAt()
+	return aruo
+}
+
+// SetReviewer sets the "reviewer" edge to the User entity.
+func (aruo *AccessRequestUpdateOne) SetReviewer(u *User) *AccessRequestUpdateOne {
+	return aruo.SetReviewerID(u.ID)
+}
+
+// Mutation returns the AccessRequestMutation object of the builder.
+func (aruo *AccessRequestUpdateOne) Mutation() *AccessRequestMutation {
+	return aruo.mutation
+}
+
+// ClearReviewer clears the "reviewer" edge to the User entity.
+func (aruo *AccessRequestUpdateOne) ClearReviewer() *AccessRequestUpdateOne {
+	aruo.mutation.ClearReviewer()
+	return aruo
+}
+
+// Where appends a list predicates to the AccessRequestUpdate builder.
+func (aruo *AccessRequestUpdateOne) Where(ps ...predicate.AccessRequest) *AccessRequestUpdateOne {
+	aruo.mutation.Where(ps...)
+	return aruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *AccessRequestUpdateOne) Select(field string, fields ...string) *AccessRequestUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated AccessRequest entity.
+func (aruo *AccessRequestUpdateOne) Save(ctx context.Context) (*AccessRequest, error) {
+	return withHooks(ctx, aruo.sqlSave, aruo.mutation, aruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *AccessRequestUpdateOne) SaveX(ctx context.Context) *AccessRequest {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *AccessRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *AccessRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aruo *AccessRequestUpdateOne) check() error {
+	if v, ok := aruo.mutation.Status(); ok {
+		if err := accessrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AccessRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := aruo.mutation.ReviewComment(); ok {
+		if err := accessrequest.ReviewCommentValidator(v); err != nil {
+			return &ValidationError{Name: "review_comment", err: fmt.Errorf(`ent: validator failed for field "AccessRequest.review_comment": %w`, err)}
+		}
+	}
+	if aruo.mutation.RequesterCleared() && len(aruo.mutation.RequesterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccessRequest.requester"`)
+	}
+	if aruo.mutation.RoleCleared() && len(aruo.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccessRequest.role"`)
+	}
+	return nil
+}
+
+func (aruo *AccessRequestUpdateOne) sqlSave(ctx context.Context) (_node *AccessRequest, err error) {
+	if err := aruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accessrequest.Table, accessrequest.Columns, sqlgraph.NewFieldSpec(accessrequest.FieldID, field.TypeUint64))
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accessrequest.FieldID)
+		for _, f := range fields {
+			if !accessrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accessrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aruo.mutation.DurationCleared() {
+		_spec.ClearField(accessrequest.FieldDuration, field.TypeUint32)
+	}
+	if value, ok := aruo.mutation.Status(); ok {
+		_spec.SetField(accessrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aruo.mutation.ReviewComment(); ok {
+		_spec.SetField(accessrequest.FieldReviewComment, field.TypeString, value)
+	}
+	if aruo.mutation.ReviewCommentCleared() {
+		_spec.ClearField(accessrequest.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := aruo.mutation.ReviewedAt(); ok {
+		_spec.SetField(accessrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if aruo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(accessrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if aruo.mutation.ReviewerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   accessrequest.ReviewerTable,
+			Columns: []string{accessrequest.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aruo.mutation.ReviewerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   accessrequest.ReviewerTable,
+			Columns: []string{accessrequest.ReviewerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AccessRequest{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accessrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aruo.mutation.done = true
+	return _node, nil
+}
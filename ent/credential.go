@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/credential"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Stores WebAuthn credentials of users
+type Credential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// CredentialID holds the value of the "credential_id" field.
+	CredentialID []byte `json:"credential_id,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey []byte `json:"public_key,omitempty"`
+	// AttestationType holds the value of the "attestation_type" field.
+	AttestationType string `json:"attestation_type,omitempty"`
+	// Transports holds the value of the "transports" field.
+	Transports []string `json:"transports,omitempty"`
+	// Aaguid holds the value of the "aaguid" field.
+	Aaguid []byte `json:"aaguid,omitempty"`
+	// SignCount holds the value of the "sign_count" field.
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// BackupEligible holds the value of the "backup_eligible" field.
+	BackupEligible bool `json:"backup_eligible,omitempty"`
+	// BackupState holds the value of the "backup_state" field.
+	BackupState bool `json:"backup_state,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CredentialQuery when eager-loading is set.
+	Edges        CredentialEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// CredentialEdges holds the relations/edges for other nodes in the graph.
+type CredentialEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CredentialEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Credential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case credential.FieldCredentialID, credential.FieldPublicKey, credential.FieldTransports, credential.FieldAaguid:
+			values[i] = new([]byte)
+		case credential.FieldBackupEligible, credential.FieldBackupState:
+			values[i] = new(sql.NullBool)
+		case credential.FieldID, credential.FieldUserID, credential.FieldSignCount:
+			values[i] = new(sql.NullInt64)
+		case credential.FieldName, credential.FieldAttestationType:
+			values[i] = new(sql.NullString)
+		case credential.FieldLastUsedAt, credential.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Credential fields.
+func (c *Credential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case credential.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = uint64(value.Int64)
+		case credential.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				c.UserID = uint64(value.Int64)
+			}
+		case credential.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case credential.FieldCredentialID:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_id", values[i])
+			} else if value != nil {
+				c.CredentialID = *value
+			}
+		case credential.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				c.PublicKey = *value
+			}
+		case credential.FieldAttestationType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_type", values[i])
+			} else if value.Valid {
+				c.AttestationType = value.String
+			}
+		case credential.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case credential.FieldAaguid:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value != nil {
+				c.Aaguid = *value
+			}
+		case credential.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				c.SignCount = uint32(value.Int64)
+			}
+		case credential.FieldBackupEligible:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_eligible", values[i])
+			} else if value.Valid {
+				c.BackupEligible = value.Bool
+			}
+		case credential.FieldBackupState:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backup_state", values[i])
+			} else if value.Valid {
+				c.BackupState = value.Bool
+			}
+		case credential.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				c.LastUsedAt = new(time.Time)
+				*c.LastUsedAt = value.Time
+			}
+		case credential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Credential.
+// This includes values selected through modifiers, order, etc.
+func (c *Credential) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Credential entity.
+func (c *Credential) QueryUser() *UserQuery {
+	return NewCredentialClient(c.config).QueryUser(c)
+}
+
+// Update returns a builder for updating this Credential.
+// Note that you need to call Credential.Unwrap() before calling this method if this Credential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Credential) Update() *CredentialUpdateOne {
+	return NewCredentialClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Credential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Credential) Unwrap() *Credential {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Credential is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Credential) String() string {
+	var builder strings.Builder
+	builder.WriteString("Credential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("credential_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.CredentialID))
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(fmt.Sprintf("%v", c.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("attestation_type=")
+	builder.WriteString(c.AttestationType)
+	builder.WriteString(", ")
+	builder.WriteString("transports=")
+	builder.WriteString(fmt.Sprintf("%v", c.Transports))
+	builder.WriteString(", ")
+	builder.WriteString("aaguid=")
+	builder.WriteString(fmt.Sprintf("%v", c.Aaguid))
+	builder.WriteString(", ")
+	builder.WriteString("sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", c.SignCount))
+	builder.WriteString(", ")
+	builder.WriteString("backup_eligible=")
+	builder.WriteString(fmt.Sprintf("%v", c.BackupEligible))
+	builder.WriteString(", ")
+	builder.WriteString("backup_state=")
+	builder.WriteString(fmt.Sprintf("%v", c.BackupState))
+	builder.WriteString(", ")
+	if v := c.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckCredentialID returns the "ID" field value.
+func PluckCredentialID(c *Credential) uint64 {
+	return c.ID
+}
+
+// PluckCredentialUserID returns the "user_id" field value.
+func PluckCredentialUserID(c *Credential) uint64 {
+	return c.UserID
+}
+
+// PluckCredentialName returns the "name" field value.
+func PluckCredentialName(c *Credential) string {
+	return c.Name
+}
+
+// PluckCredentialCredentialID returns the "credential_id" field value.
+func PluckCredentialCredentialID(c *Credential) []byte {
+	return c.CredentialID
+}
+
+// PluckCredentialPublicKey returns the "public_key" field value.
+func PluckCredentialPublicKey(c *Credential) []byte {
+	return c.PublicKey
+}
+
+// PluckCredentialAttestationType returns the "attestation_type" field value.
+func PluckCredentialAttestationType(c *Credential) string {
+	return c.AttestationType
+}
+
+// PluckCredentialTransports returns the "transports" field value.
+func PluckCredentialTransports(c *Credential) []string {
+	return c.Transports
+}
+
+// PluckCredentialAaguid returns the "aaguid" field value.
+func PluckCredentialAaguid(c *Credential) []byte {
+	return c.Aaguid
+}
+
+// PluckCredentialSignCount returns the "sign_count" field value.
+func PluckCredentialSignCount(c *Credential) uint32 {
+	return c.SignCount
+}
+
+// PluckCredentialBackupEligible returns the "backup_eligible" field value.
+func PluckCredentialBackupEligible(c *Credential) bool {
+	return c.BackupEligible
+}
+
+// PluckCredentialBackupState returns the "backup_state" field value.
+func PluckCredentialBackupState(c *Credential) bool {
+	return c.BackupState
+}
+
+// PluckCredentialLastUsedAt returns the "last_used_at" field value.
+func PluckCredentialLastUsedAt(c *Credential) *time.Time {
+	return c.LastUsedAt
+}
+
+// PluckCredentialCreatedAt returns the "created_at" field value.
+func PluckCredentialCreatedAt(c *Credential) time.Time {
+	return c.CreatedAt
+}
+
+// Credentials is a parsable slice of Credential.
+type Credentials []*Credential
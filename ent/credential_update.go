@@ -0,0 +1,437 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/credential"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+)
+
+// CredentialUpdate is the builder for updating Credential entities.
+type CredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CredentialMutation
+}
+
+// Where appends a list predicates to the CredentialUpdate builder.
+func (cu *CredentialUpdate) Where(ps ...predicate.Credential) *CredentialUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CredentialUpdate) SetName(s string) *CredentialUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableName(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetTransports sets the "transports" field.
+func (cu *CredentialUpdate) SetTransports(s []string) *CredentialUpdate {
+	cu.mutation.SetTransports(s)
+	return cu
+}
+
+// AppendTransports appends s to the "transports" field.
+func (cu *CredentialUpdate) AppendTransports(s []string) *CredentialUpdate {
+	cu.mutation.AppendTransports(s)
+	return cu
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (cu *CredentialUpdate) ClearTransports() *CredentialUpdate {
+	cu.mutation.ClearTransports()
+	return cu
+}
+
+// SetSignCount sets the "sign_count" field.
+func (cu *CredentialUpdate) SetSignCount(u uint32) *CredentialUpdate {
+	cu.mutation.ResetSignCount()
+	cu.mutation.SetSignCount(u)
+	return cu
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableSignCount(u *uint32) *CredentialUpdate {
+	if u != nil {
+		cu.SetSignCount(*u)
+	}
+	return cu
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (cu *CredentialUpdate) AddSignCount(u int32) *CredentialUpdate {
+	cu.mutation.AddSignCount(u)
+	return cu
+}
+
+// SetBackupState sets the "backup_state" field.
+func (cu *CredentialUpdate) SetBackupState(b bool) *CredentialUpdate {
+	cu.mutation.SetBackupState(b)
+	return cu
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableBackupState(b *bool) *CredentialUpdate {
+	if b != nil {
+		cu.SetBackupState(*b)
+	}
+	return cu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (cu *CredentialUpdate) SetLastUsedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetLastUsedAt(t)
+	return cu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableLastUsedAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetLastUsedAt(*t)
+	}
+	return cu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (cu *CredentialUpdate) ClearLastUsedAt() *CredentialUpdate {
+	cu.mutation.ClearLastUsedAt()
+	return cu
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cu *CredentialUpdate) Mutation() *CredentialMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CredentialUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CredentialUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CredentialUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CredentialUpdate) check() error {
+	if cu.mutation.UserCleared() && len(cu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Credential.user"`)
+	}
+	return nil
+}
+
+func (cu *CredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credential.Table, credential.Columns, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeUint64))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(credential.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Transports(); ok {
+		_spec.SetField(credential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, credential.FieldTransports, value)
+		})
+	}
+	if cu.mutation.TransportsCleared() {
+		_spec.ClearField(credential.FieldTransports, field.TypeJSON)
+	}
+	if cu.mutation.AaguidCleared() {
+		_spec.ClearField(credential.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := cu.mutation.SignCount(); ok {
+		_spec.SetField(credential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := cu.mutation.AddedSignCount(); ok {
+		_spec.AddField(credential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := cu.mutation.BackupState(); ok {
+		_spec.SetField(credential.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.LastUsedAt(); ok {
+		_spec.SetField(credential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if cu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(credential.FieldLastUsedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CredentialUpdateOne is the builder for updating a single Credential entity.
+type CredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CredentialMutation
+}
+
+// SetName sets the "name" field.
+func (cuo *CredentialUpdateOne) SetName(s string) *CredentialUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableName(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetTransports sets the "transports" field.
+func (cuo *CredentialUpdateOne) SetTransports(s []string) *CredentialUpdateOne {
+	cuo.mutation.SetTransports(s)
+	return cuo
+}
+
+// AppendTransports appends s to the "transports" field.
+func (cuo *CredentialUpdateOne) AppendTransports(s []string) *CredentialUpdateOne {
+	cuo.mutation.AppendTransports(s)
+	return cuo
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (cuo *CredentialUpdateOne) ClearTransports() *CredentialUpdateOne {
+	cuo.mutation.ClearTransports()
+	return cuo
+}
+
+// SetSignCount sets the "sign_count" field.
+func (cuo *CredentialUpdateOne) SetSignCount(u uint32) *CredentialUpdateOne {
+	cuo.mutation.ResetSignCount()
+	cuo.mutation.SetSignCount(u)
+	return cuo
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableSignCount(u *uint32) *CredentialUpdateOne {
+	if u != nil {
+		cuo.SetSignCount(*u)
+	}
+	return cuo
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (cuo *CredentialUpdateOne) AddSignCount(u int32) *CredentialUpdateOne {
+	cuo.mutation.AddSignCount(u)
+	return cuo
+}
+
+// SetBackupState sets the "backup_state" field.
+func (cuo *CredentialUpdateOne) SetBackupState(b bool) *CredentialUpdateOne {
+	cuo.mutation.SetBackupState(b)
+	return cuo
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableBackupState(b *bool) *CredentialUpdateOne {
+	if b != nil {
+		cuo.SetBackupState(*b)
+	}
+	return cuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (cuo *CredentialUpdateOne) SetLastUsedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetLastUsedAt(t)
+	return cuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableLastUsedAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetLastUsedAt(*t)
+	}
+	return cuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (cuo *CredentialUpdateOne) ClearLastUsedAt() *CredentialUpdateOne {
+	cuo.mutation.ClearLastUsedAt()
+	return cuo
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cuo *CredentialUpdateOne) Mutation() *CredentialMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CredentialUpdate builder.
+func (cuo *CredentialUpdateOne) Where(ps ...predicate.Credential) *CredentialUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CredentialUpdateOne) Select(field string, fields ...string) *CredentialUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Credential entity.
+func (cuo *CredentialUpdateOne) Save(ctx context.Context) (*Credential, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CredentialUpdateOne) SaveX(ctx context.Context) *Credential {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CredentialUpdateOne) check() error {
+	if cuo.mutation.UserCleared() && len(cuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Credential.user"`)
+	}
+	return nil
+}
+
+func (cuo *CredentialUpdateOne) sqlSave(ctx context.Context) (_node *Credential, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credential.Table, credential.Columns, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeUint64))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Credential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credential.FieldID)
+		for _, f := range fields {
+			if !credential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(credential.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Transports(); ok {
+		_spec.SetField(credential.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, credential.FieldTransports, value)
+		})
+	}
+	if cuo.mutation.TransportsCleared() {
+		_spec.ClearField(credential.FieldTransports, field.TypeJSON)
+	}
+	if cuo.mutation.AaguidCleared() {
+		_spec.ClearField(credential.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := cuo.mutation.SignCount(); ok {
+		_spec.SetField(credential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := cuo.mutation.AddedSignCount(); ok {
+		_spec.AddField(credential.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := cuo.mutation.BackupState(); ok {
+		_spec.SetField(credential.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(credential.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(credential.FieldLastUsedAt, field.TypeTime)
+	}
+	_node = &Credential{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -13,11 +13,28 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
 	"github.com/eidng8/go-attr-rbac/ent/accesstoken"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/credential"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/linkedidentity"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/passwordhistory"
+	"github.com/eidng8/go-attr-rbac/ent/passwordreset"
 	"github.com/eidng8/go-attr-rbac/ent/permission"
 	"github.com/eidng8/go-attr-rbac/ent/personaltoken"
 	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+	"github.com/eidng8/go-attr-rbac/ent/session"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
 	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+	"github.com/eidng8/go-attr-rbac/ent/webauthnchallenge"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+	"github.com/eidng8/go-attr-rbac/ent/webhookdelivery"
 	"github.com/eidng8/go-utils"
 )
 
@@ -79,11 +96,28 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			accesstoken.Table:   accesstoken.ValidColumn,
-			permission.Table:    permission.ValidColumn,
-			personaltoken.Table: personaltoken.ValidColumn,
-			role.Table:          role.ValidColumn,
-			user.Table:          user.ValidColumn,
+			accessrequest.Table:     accessrequest.ValidColumn,
+			accesstoken.Table:       accesstoken.ValidColumn,
+			auditlog.Table:          auditlog.ValidColumn,
+			credential.Table:        credential.ValidColumn,
+			group.Table:             group.ValidColumn,
+			linkedidentity.Table:    linkedidentity.ValidColumn,
+			oauthclient.Table:       oauthclient.ValidColumn,
+			oauthcode.Table:         oauthcode.ValidColumn,
+			organization.Table:      organization.ValidColumn,
+			passwordhistory.Table:   passwordhistory.ValidColumn,
+			passwordreset.Table:     passwordreset.ValidColumn,
+			permission.Table:        permission.ValidColumn,
+			personaltoken.Table:     personaltoken.ValidColumn,
+			role.Table:              role.ValidColumn,
+			serviceaccount.Table:    serviceaccount.ValidColumn,
+			session.Table:           session.ValidColumn,
+			sodconstraint.Table:     sodconstraint.ValidColumn,
+			user.Table:              user.ValidColumn,
+			userrole.Table:          userrole.ValidColumn,
+			webauthnchallenge.Table: webauthnchallenge.ValidColumn,
+			webhook.Table:           webhook.ValidColumn,
+			webhookdelivery.Table:   webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -6,4 +6,4 @@
 // Package internal holds a loadable version of the latest schema.
 package internal
 
-const Schema = "{\"Schema\":\"github.com/eidng8/go-attr-rbac/ent/schema\",\"Package\":\"github.com/eidng8/go-attr-rbac/ent\",\"Schemas\":[{\"name\":\"AccessToken\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"owner\",\"type\":\"User\",\"ref_name\":\"access_tokens\",\"unique\":true,\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"access_token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(16)\",\"postgres\":\"binary(16)\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"refresh_token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(16)\",\"postgres\":\"binary(16)\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores revoked access tokens\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"Permission\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"roles\",\"type\":\"Role\",\"ref_name\":\"permissions\",\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":1,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"PersonalToken\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"owner\",\"type\":\"User\",\"ref_name\":\"personal_tokens\",\"unique\":true,\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"maxLength\":255,\"minLength\":2,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(16)\",\"postgres\":\"binary(16)\",\"sqlite3\":\"blob\"},\"annotations\":{\"Comment\":{\"Text\":\"token JTI\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores issued long-lived tokens\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\",\"with_comments\":true}}},{\"name\":\"Role\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"permissions\",\"type\":\"Permission\",\"annotations\":{\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"users\",\"type\":\"User\",\"annotations\":{\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":1,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"roles\",\"type\":\"Role\",\"ref_name\":\"users\",\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":2},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"access_tokens\",\"type\":\"AccessToken\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"refresh_tokens\",\"type\":\"AccessToken\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"personal_tokens\",\"type\":\"PersonalToken\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"username\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":2,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"email\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"nillable\":true,\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"email\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"password\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":8,\"type\":\"string\"},\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"attr\",\"type\":{\"Type\":3,\"Ident\":\"*map[string]interface {}\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"map[string]interface {}\",\"Kind\":22,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"properties\":{\"dept\":{\"format\":\"uint32\",\"minimum\":1,\"summary\":\"Department ID\",\"type\":\"integer\"},\"level\":{\"format\":\"uint8\",\"minimum\":1,\"summary\":\"Security Clarence Level\",\"type\":\"integer\"}},\"required\":[\"dept\",\"level\"],\"type\":\"object\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"hooks\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}],\"interceptors\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}],\"annotations\":{\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"Features\":[\"intercept\",\"schema/snapshot\",\"sql/execquery\",\"sql/versioned-migration\"]}"
+const Schema = "{\"Schema\":\"github.com/eidng8/go-attr-rbac/ent/schema\",\"Package\":\"github.com/eidng8/go-attr-rbac/ent\",\"Schemas\":[{\"name\":\"AccessRequest\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"requester\",\"type\":\"User\",\"field\":\"requester_id\",\"unique\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"role\",\"type\":\"Role\",\"field\":\"role_id\",\"unique\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"reviewer\",\"type\":\"User\",\"field\":\"reviewer_id\",\"unique\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"SET NULL\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"organization_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"requester_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"role_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"justification\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":1000,\"immutable\":true,\"validators\":2,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":1000,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"duration\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"status\",\"type\":{\"Type\":6,\"Ident\":\"accessrequest.Status\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"enums\":[{\"N\":\"pending\",\"V\":\"pending\"},{\"N\":\"approved\",\"V\":\"approved\"},{\"N\":\"denied\",\"V\":\"denied\"}],\"default\":true,\"default_value\":\"pending\",\"default_kind\":24,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"reviewer_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"review_comment\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":1000,\"optional\":true,\"validators\":1,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":1000,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"reviewed_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"fields\":[\"organization_id\",\"status\",\"created_at\"]},{\"fields\":[\"requester_id\",\"role_id\",\"status\"]}],\"annotations\":{\"Comment\":{\"Text\":\"Stores role access requests\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"AccessToken\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"owner\",\"type\":\"User\",\"ref_name\":\"access_tokens\",\"unique\":true,\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"access_token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(16)\",\"postgres\":\"binary(16)\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"refresh_token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(16)\",\"postgres\":\"binary(16)\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores revoked access tokens\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"AuditLog\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"organization_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"action\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"actor_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"impersonator_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"details\",\"type\":{\"Type\":3,\"Ident\":\"map[string]interface {}\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"map[string]interface {}\",\"Kind\":21,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"type\":\"object\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"fields\":[\"organization_id\",\"action\",\"created_at\"]}],\"annotations\":{\"Comment\":{\"Text\":\"Stores audit trail\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}}}},{\"name\":\"Credential\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"credentials\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"credential_id\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"size\":1023,\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"public_key\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"attestation_type\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"transports\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"aaguid\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"optional\":true,\"immutable\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"sign_count\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":0,\"default_kind\":10,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"backup_eligible\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"immutable\":true,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"backup_state\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"last_used_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":12,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores WebAuthn credentials of users\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"with_comments\":true}}},{\"name\":\"Group\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"organization\",\"type\":\"Organization\",\"field\":\"organization_id\",\"ref_name\":\"groups\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"users\",\"type\":\"User\"},{\"name\":\"roles\",\"type\":\"Role\"},{\"name\":\"service_accounts\",\"type\":\"ServiceAccount\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"organization_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":1,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"unique\":true,\"fields\":[\"organization_id\",\"name\"]}],\"annotations\":{\"Comment\":{\"Text\":\"Stores user groups\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"LinkedIdentity\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"identities\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"provider\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"subject\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"last_login_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"unique\":true,\"fields\":[\"provider\",\"subject\"]},{\"fields\":[\"user_id\"]}],\"annotations\":{\"Comment\":{\"Text\":\"Links identities of external providers to users\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"with_comments\":true}}},{\"name\":\"OauthClient\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"organization\",\"type\":\"Organization\",\"field\":\"organization_id\",\"ref_name\":\"oauth_clients\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"oauth_clients\",\"unique\":true,\"inverse\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"codes\",\"type\":\"OauthCode\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"organization_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":255,\"validators\":2,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":2,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"client_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"secret\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"public\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"redirect_uris\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"items\":{\"format\":\"uri\",\"maxLength\":2048,\"type\":\"string\"},\"type\":\"array\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"grant_types\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"items\":{\"enum\":[\"authorization_code\",\"refresh_token\",\"client_credentials\"],\"type\":\"string\"},\"minItems\":1,\"type\":\"array\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"public_key\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":4096,\"nillable\":true,\"optional\":true,\"validators\":1,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":4096,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores registered OAuth clients\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"on_delete\":\"RESTRICT\",\"with_comments\":true}}},{\"name\":\"OauthCode\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"client\",\"type\":\"OauthClient\",\"field\":\"client_id\",\"ref_name\":\"codes\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"oauth_codes\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"code\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(32)\",\"postgres\":\"bytea\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"client_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"redirect_uri\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"scopes\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"nonce\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"code_challenge\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"validators\":1,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"expires_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"fields\":[\"expires_at\"]}],\"annotations\":{\"Comment\":{\"Text\":\"Stores unused OAuth authorization codes\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"with_comments\":true}}},{\"name\":\"Organization\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"users\",\"type\":\"User\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"roles\",\"type\":\"Role\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"groups\",\"type\":\"Group\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"sod_constraints\",\"type\":\"SodConstraint\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"webhooks\",\"type\":\"Webhook\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"oauth_clients\",\"type\":\"OauthClient\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":1,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"scim_token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"optional\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(32)\",\"postgres\":\"binary(32)\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores tenants\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"PasswordHistory\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"password_history\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"password\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"fields\":[\"user_id\",\"created_at\"]}],\"annotations\":{\"Comment\":{\"Text\":\"Stores hashes of previous passwords of users\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"with_comments\":true}}},{\"name\":\"PasswordReset\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"password_resets\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(32)\",\"postgres\":\"binary(32)\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"expires_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"used_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores password reset tokens\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"with_comments\":true}}},{\"name\":\"Permission\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"roles\",\"type\":\"Role\",\"ref_name\":\"permissions\",\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"validators\":1,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":1,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"PersonalToken\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"owner\",\"type\":\"User\",\"ref_name\":\"personal_tokens\",\"unique\":true,\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"maxLength\":255,\"minLength\":2,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(16)\",\"postgres\":\"binary(16)\",\"sqlite3\":\"blob\"},\"annotations\":{\"Comment\":{\"Text\":\"token JTI\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores issued long-lived tokens\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\",\"with_comments\":true}}},{\"name\":\"Role\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"organization\",\"type\":\"Organization\",\"field\":\"organization_id\",\"ref_name\":\"roles\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"permissions\",\"type\":\"Permission\",\"annotations\":{\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"users\",\"type\":\"User\",\"through\":{\"N\":\"assignments\",\"T\":\"UserRole\"},\"annotations\":{\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"groups\",\"type\":\"Group\",\"ref_name\":\"roles\",\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"approvers\",\"type\":\"User\"},{\"name\":\"sod_constraints\",\"type\":\"SodConstraint\",\"ref_name\":\"roles\",\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"organization_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":1,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"require_mfa\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"unique\":true,\"fields\":[\"organization_id\",\"name\"]}],\"annotations\":{\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"ServiceAccount\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"owner\",\"type\":\"Group\",\"field\":\"owner_id\",\"ref_name\":\"service_accounts\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"service_account\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":255,\"immutable\":true,\"validators\":2,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":2,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"owner_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"client_id\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"unique\":true,\"immutable\":true,\"validators\":1,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores service accounts\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"on_delete\":\"RESTRICT\",\"with_comments\":true}}},{\"name\":\"Session\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"ref_name\":\"sessions\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"access_token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(16)\",\"postgres\":\"binary(16)\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"refresh_token\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(16)\",\"postgres\":\"binary(16)\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"user_agent\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":255,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"ip\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":45,\"default\":true,\"default_value\":\"\",\"default_kind\":24,\"validators\":1,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"expires_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"refreshed_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores signed in sessions of users\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"with_comments\":true}}},{\"name\":\"SodConstraint\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"organization\",\"type\":\"Organization\",\"field\":\"organization_id\",\"ref_name\":\"sod_constraints\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"roles\",\"type\":\"Role\"}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"organization_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"name\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":1,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"description\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"unique\":true,\"fields\":[\"organization_id\",\"name\"]}],\"annotations\":{\"Comment\":{\"Text\":\"Stores separation-of-duties constraints\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"User\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"organization\",\"type\":\"Organization\",\"field\":\"organization_id\",\"ref_name\":\"users\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"roles\",\"type\":\"Role\",\"ref_name\":\"users\",\"through\":{\"N\":\"assignments\",\"T\":\"UserRole\"},\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":2},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"groups\",\"type\":\"Group\",\"ref_name\":\"users\",\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"approvable_roles\",\"type\":\"Role\",\"ref_name\":\"approvers\",\"inverse\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"access_tokens\",\"type\":\"AccessToken\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"refresh_tokens\",\"type\":\"AccessToken\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"personal_tokens\",\"type\":\"PersonalToken\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"sessions\",\"type\":\"Session\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"credentials\",\"type\":\"Credential\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"password_resets\",\"type\":\"PasswordReset\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"password_history\",\"type\":\"PasswordHistory\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"identities\",\"type\":\"LinkedIdentity\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"oauth_clients\",\"type\":\"OauthClient\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"oauth_codes\",\"type\":\"OauthCode\",\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"service_account\",\"type\":\"ServiceAccount\",\"unique\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"deleted_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":0,\"MixedIn\":true,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"organization_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"username\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":2,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"email\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"email\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"password\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"optional\":true,\"validators\":1,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":8,\"type\":\"string\"},\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"totp_secret\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"totp_confirmed_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"totp_last_step\",\"type\":{\"Type\":13,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":0,\"default_kind\":6,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"recovery_codes\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"tokens_valid_after\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"must_change_password\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"auth_provider\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":11,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"status\",\"type\":{\"Type\":6,\"Ident\":\"user.Status\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"enums\":[{\"N\":\"active\",\"V\":\"active\"},{\"N\":\"disabled\",\"V\":\"disabled\"},{\"N\":\"deleted\",\"V\":\"deleted\"},{\"N\":\"locked\",\"V\":\"locked\"}],\"default\":true,\"default_value\":\"active\",\"default_kind\":24,\"position\":{\"Index\":12,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"is_service_account\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":false,\"default_kind\":1,\"immutable\":true,\"position\":{\"Index\":13,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"attr\",\"type\":{\"Type\":3,\"Ident\":\"*map[string]interface {}\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"map[string]interface {}\",\"Kind\":22,\"PkgPath\":\"\",\"Methods\":{}}},\"optional\":true,\"position\":{\"Index\":14,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"properties\":{\"dept\":{\"format\":\"uint32\",\"minimum\":1,\"summary\":\"Department ID\",\"type\":\"integer\"},\"level\":{\"format\":\"uint8\",\"minimum\":1,\"summary\":\"Security Clarence Level\",\"type\":\"integer\"}},\"required\":[\"dept\",\"level\"],\"type\":\"object\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":15,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":16,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"unique\":true,\"fields\":[\"organization_id\",\"is_service_account\",\"username\"]},{\"unique\":true,\"fields\":[\"organization_id\",\"email\"]}],\"hooks\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}],\"interceptors\":[{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}],\"annotations\":{\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"UserRole\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"role\",\"type\":\"Role\",\"field\":\"role_id\",\"unique\":true,\"required\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}},{\"name\":\"user\",\"type\":\"User\",\"field\":\"user_id\",\"unique\":true,\"required\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"CASCADE\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"role_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"user_id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"starts_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"expires_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}}],\"indexes\":[{\"unique\":true,\"fields\":[\"role_id\",\"user_id\"]},{\"fields\":[\"expires_at\"]}],\"annotations\":{\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"table\":\"role_users\"}}},{\"name\":\"WebauthnChallenge\",\"config\":{\"Table\":\"\"},\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"challenge\",\"type\":{\"Type\":5,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":null},\"unique\":true,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"schema_type\":{\"mysql\":\"binary(32)\",\"postgres\":\"binary(32)\",\"sqlite3\":\"blob\"},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"expires_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores consumed WebAuthn challenges\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"with_comments\":true}}},{\"name\":\"Webhook\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"organization\",\"type\":\"Organization\",\"field\":\"organization_id\",\"ref_name\":\"webhooks\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"deliveries\",\"type\":\"WebhookDelivery\",\"annotations\":{\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"organization_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":1,\"default_kind\":10,\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"url\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"size\":2048,\"validators\":2,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uri\",\"maxLength\":2048,\"minLength\":1,\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"secret\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"validators\":1,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"sensitive\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"maxLength\":255,\"minLength\":16,\"type\":\"string\"},\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"events\",\"type\":{\"Type\":3,\"Ident\":\"[]string\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"[]string\",\"Kind\":23,\"PkgPath\":\"\",\"Methods\":{}}},\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"items\":{\"enum\":[\"user.created\",\"user.deleted\",\"user.roles_changed\",\"role.permissions_changed\",\"token.revoked\"],\"type\":\"string\"},\"minItems\":1,\"type\":\"array\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"active\",\"type\":{\"Type\":1,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":true,\"default_kind\":1,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"updated_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"update_default\":true,\"immutable\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":true,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"annotations\":{\"Comment\":{\"Text\":\"Stores webhook subscriptions\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}},{\"name\":\"WebhookDelivery\",\"config\":{\"Table\":\"\"},\"edges\":[{\"name\":\"webhook\",\"type\":\"Webhook\",\"field\":\"webhook_id\",\"ref_name\":\"deliveries\",\"unique\":true,\"inverse\":true,\"required\":true,\"immutable\":true,\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"fields\":[{\"name\":\"id\",\"type\":{\"Type\":18,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"position\":{\"Index\":0,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint64\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"webhook_id\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"position\":{\"Index\":1,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"uint32\",\"minimum\":1,\"type\":\"integer\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"event\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"immutable\":true,\"validators\":1,\"position\":{\"Index\":2,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"payload\",\"type\":{\"Type\":3,\"Ident\":\"map[string]interface {}\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":true,\"RType\":{\"Name\":\"\",\"Ident\":\"map[string]interface {}\",\"Kind\":21,\"PkgPath\":\"\",\"Methods\":{}}},\"immutable\":true,\"position\":{\"Index\":3,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":null,\"Skip\":true,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"status\",\"type\":{\"Type\":6,\"Ident\":\"webhookdelivery.Status\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"enums\":[{\"N\":\"pending\",\"V\":\"pending\"},{\"N\":\"delivered\",\"V\":\"delivered\"},{\"N\":\"failed\",\"V\":\"failed\"}],\"default\":true,\"default_value\":\"pending\",\"default_kind\":24,\"position\":{\"Index\":4,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"attempts\",\"type\":{\"Type\":16,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_value\":0,\"default_kind\":10,\"position\":{\"Index\":5,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"response_code\",\"type\":{\"Type\":12,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":6,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"last_error\",\"type\":{\"Type\":7,\"Ident\":\"\",\"PkgPath\":\"\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":7,\"MixedIn\":false,\"MixinIndex\":0}},{\"name\":\"next_attempt_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"default\":true,\"default_kind\":19,\"position\":{\"Index\":8,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"delivered_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"position\":{\"Index\":9,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}},{\"name\":\"created_at\",\"type\":{\"Type\":2,\"Ident\":\"\",\"PkgPath\":\"time\",\"PkgName\":\"\",\"Nillable\":false,\"RType\":null},\"nillable\":true,\"optional\":true,\"default\":true,\"default_kind\":19,\"immutable\":true,\"position\":{\"Index\":10,\"MixedIn\":false,\"MixinIndex\":0},\"annotations\":{\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":0},\"Delete\":{\"Groups\":null,\"Policy\":0},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":0},\"Read\":{\"Groups\":null,\"Policy\":0},\"ReadOnly\":false,\"Schema\":{\"format\":\"date-time\",\"type\":\"string\"},\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":0}}}}],\"indexes\":[{\"fields\":[\"status\",\"next_attempt_at\"]}],\"annotations\":{\"Comment\":{\"Text\":\"Outbox of webhook deliveries\"},\"Edges\":{\"StructTag\":\"json:\\\"-\\\"\"},\"EntOAS\":{\"Create\":{\"Groups\":null,\"Policy\":1},\"Delete\":{\"Groups\":null,\"Policy\":1},\"Example\":null,\"Groups\":null,\"List\":{\"Groups\":null,\"Policy\":1},\"Read\":{\"Groups\":null,\"Policy\":1},\"ReadOnly\":false,\"Schema\":null,\"Skip\":false,\"Update\":{\"Groups\":null,\"Policy\":1}},\"EntSQL\":{\"on_delete\":\"RESTRICT\"}}}],\"Features\":[\"intercept\",\"schema/snapshot\",\"sql/execquery\",\"sql/versioned-migration\"]}"
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/linkedidentity"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Links identities of external providers to users
+type LinkedIdentity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LastLoginAt holds the value of the "last_login_at" field.
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LinkedIdentityQuery when eager-loading is set.
+	Edges        LinkedIdentityEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// LinkedIdentityEdges holds the relations/edges for other nodes in the graph.
+type LinkedIdentityEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkedIdentityEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LinkedIdentity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case linkedidentity.FieldID, linkedidentity.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case linkedidentity.FieldProvider, linkedidentity.FieldSubject:
+			values[i] = new(sql.NullString)
+		case linkedidentity.FieldCreatedAt, linkedidentity.FieldLastLoginAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LinkedIdentity fields.
+func (li *LinkedIdentity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case linkedidentity.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			li.ID = uint64(value.Int64)
+		case linkedidentity.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				li.UserID = uint64(value.Int64)
+			}
+		case linkedidentity.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				li.Provider = value.String
+			}
+		case linkedidentity.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				li.Subject = value.String
+			}
+		case linkedidentity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				li.CreatedAt = value.Time
+			}
+		case linkedidentity.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				li.LastLoginAt = new(time.Time)
+				*li.LastLoginAt = value.Time
+			}
+		default:
+			li.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LinkedIdentity.
+// This includes values selected through modifiers, order, etc.
+func (li *LinkedIdentity) Value(name string) (ent.Value, error) {
+	return li.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LinkedIdentity entity.
+func (li *LinkedIdentity) QueryUser() *UserQuery {
+	return NewLinkedIdentityClient(li.config).QueryUser(li)
+}
+
+// Update returns a builder for updating this LinkedIdentity.
+// Note that you need to call LinkedIdentity.Unwrap() before calling this method if this LinkedIdentity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (li *LinkedIdentity) Update() *LinkedIdentityUpdateOne {
+	return NewLinkedIdentityClient(li.config).UpdateOne(li)
+}
+
+// Unwrap unwraps the LinkedIdentity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (li *LinkedIdentity) Unwrap() *LinkedIdentity {
+	_tx, ok := li.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LinkedIdentity is not a transactional entity")
+	}
+	li.config.driver = _tx.drv
+	return li
+}
+
+// String implements the fmt.Stringer.
+func (li *LinkedIdentity) String() string {
+	var builder strings.Builder
+	builder.WriteString("LinkedIdentity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", li.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", li.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(li.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(li.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(li.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := li.LastLoginAt; v != nil {
+		builder.WriteString("last_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckLinkedIdentityID returns the "ID" field value.
+func PluckLinkedIdentityID(li *LinkedIdentity) uint64 {
+	return li.ID
+}
+
+// PluckLinkedIdentityUserID returns the "user_id" field value.
+func PluckLinkedIdentityUserID(li *LinkedIdentity) uint64 {
+	return li.UserID
+}
+
+// PluckLinkedIdentityProvider returns the "provider" field value.
+func PluckLinkedIdentityProvider(li *LinkedIdentity) string {
+	return li.Provider
+}
+
+// PluckLinkedIdentitySubject returns the "subject" field value.
+func PluckLinkedIdentitySubject(li *LinkedIdentity) string {
+	return li.Subject
+}
+
+// PluckLinkedIdentityCreatedAt returns the "created_at" field value.
+func PluckLinkedIdentityCreatedAt(li *LinkedIdentity) time.Time {
+	return li.CreatedAt
+}
+
+// PluckLinkedIdentityLastLoginAt returns the "last_login_at" field value.
+func PluckLinkedIdentityLastLoginAt(li *LinkedIdentity) *time.Time {
+	return li.LastLoginAt
+}
+
+// LinkedIdentities is a parsable slice of LinkedIdentity.
+type LinkedIdentities []*LinkedIdentity
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/linkedidentity"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+)
+
+// LinkedIdentityDelete is the builder for deleting a LinkedIdentity entity.
+type LinkedIdentityDelete struct {
+	config
+	hooks    []Hook
+	mutation *LinkedIdentityMutation
+}
+
+// Where appends a list predicates to the LinkedIdentityDelete builder.
+func (lid *LinkedIdentityDelete) Where(ps ...predicate.LinkedIdentity) *LinkedIdentityDelete {
+	lid.mutation.Where(ps...)
+	return lid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lid *LinkedIdentityDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lid.sqlExec, lid.mutation, lid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lid *LinkedIdentityDelete) ExecX(ctx context.Context) int {
+	n, err := lid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lid *LinkedIdentityDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(linkedidentity.Table, sqlgraph.NewFieldSpec(linkedidentity.FieldID, field.TypeUint64))
+	if ps := lid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lid.mutation.done = true
+	return affected, err
+}
+
+// LinkedIdentityDeleteOne is the builder for deleting a single LinkedIdentity entity.
+type LinkedIdentityDeleteOne struct {
+	lid *LinkedIdentityDelete
+}
+
+// Where appends a list predicates to the LinkedIdentityDelete builder.
+func (lido *LinkedIdentityDeleteOne) Where(ps ...predicate.LinkedIdentity) *LinkedIdentityDeleteOne {
+	lido.lid.mutation.Where(ps...)
+	return lido
+}
+
+// Exec executes the deletion query.
+func (lido *LinkedIdentityDeleteOne) Exec(ctx context.Context) error {
+	n, err := lido.lid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{linkedidentity.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lido *LinkedIdentityDeleteOne) ExecX(ctx context.Context) {
+	if err := lido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
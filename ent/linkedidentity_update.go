@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/linkedidentity"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+)
+
+// LinkedIdentityUpdate is the builder for updating LinkedIdentity entities.
+type LinkedIdentityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LinkedIdentityMutation
+}
+
+// Where appends a list predicates to the LinkedIdentityUpdate builder.
+func (liu *LinkedIdentityUpdate) Where(ps ...predicate.LinkedIdentity) *LinkedIdentityUpdate {
+	liu.mutation.Where(ps...)
+	return liu
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (liu *LinkedIdentityUpdate) SetLastLoginAt(t time.Time) *LinkedIdentityUpdate {
+	liu.mutation.SetLastLoginAt(t)
+	return liu
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (liu *LinkedIdentityUpdate) SetNillableLastLoginAt(t *time.Time) *LinkedIdentityUpdate {
+	if t != nil {
+		liu.SetLastLoginAt(*t)
+	}
+	return liu
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (liu *LinkedIdentityUpdate) ClearLastLoginAt() *LinkedIdentityUpdate {
+	liu.mutation.ClearLastLoginAt()
+	return liu
+}
+
+// Mutation returns the LinkedIdentityMutation object of the builder.
+func (liu *LinkedIdentityUpdate) Mutation() *LinkedIdentityMutation {
+	return liu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (liu *LinkedIdentityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, liu.sqlSave, liu.mutation, liu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (liu *LinkedIdentityUpdate) SaveX(ctx context.Context) int {
+	affected, err := liu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (liu *LinkedIdentityUpdate) Exec(ctx context.Context) error {
+	_, err := liu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (liu *LinkedIdentityUpdate) ExecX(ctx context.Context) {
+	if err := liu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (liu *LinkedIdentityUpdate) check() error {
+	if liu.mutation.UserCleared() && len(liu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkedIdentity.user"`)
+	}
+	return nil
+}
+
+func (liu *LinkedIdentityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := liu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkedidentity.Table, linkedidentity.Columns, sqlgraph.NewFieldSpec(linkedidentity.FieldID, field.TypeUint64))
+	if ps := liu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := liu.mutation.LastLoginAt(); ok {
+		_spec.SetField(linkedidentity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if liu.mutation.LastLoginAtCleared() {
+		_spec.ClearField(linkedidentity.FieldLastLoginAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, liu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkedidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	liu.mutation.done = true
+	return n, nil
+}
+
+// LinkedIdentityUpdateOne is the builder for updating a single LinkedIdentity entity.
+type LinkedIdentityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LinkedIdentityMutation
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (liuo *LinkedIdentityUpdateOne) SetLastLoginAt(t time.Time) *LinkedIdentityUpdateOne {
+	liuo.mutation.SetLastLoginAt(t)
+	return liuo
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (liuo *LinkedIdentityUpdateOne) SetNillableLastLoginAt(t *time.Time) *LinkedIdentityUpdateOne {
+	if t != nil {
+		liuo.SetLastLoginAt(*t)
+	}
+	return liuo
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (liuo *LinkedIdentityUpdateOne) ClearLastLoginAt() *LinkedIdentityUpdateOne {
+	liuo.mutation.ClearLastLoginAt()
+	return liuo
+}
+
+// Mutation returns the LinkedIdentityMutation object of the builder.
+func (liuo *LinkedIdentityUpdateOne) Mutation() *LinkedIdentityMutation {
+	return liuo.mutation
+}
+
+// Where appends a list predicates to the LinkedIdentityUpdate builder.
+func (liuo *LinkedIdentityUpdateOne) Where(ps ...predicate.LinkedIdentity) *LinkedIdentityUpdateOne {
+	liuo.mutation.Where(ps...)
+	return liuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (liuo *LinkedIdentityUpdateOne) Select(field string, fields ...string) *LinkedIdentityUpdateOne {
+	liuo.fields = append([]string{field}, fields...)
+	return liuo
+}
+
+// Save executes the query and returns the updated LinkedIdentity entity.
+func (liuo *LinkedIdentityUpdateOne) Save(ctx context.Context) (*LinkedIdentity, error) {
+	return withHooks(ctx, liuo.sqlSave, liuo.mutation, liuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (liuo *LinkedIdentityUpdateOne) SaveX(ctx context.Context) *LinkedIdentity {
+	node, err := liuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (liuo *LinkedIdentityUpdateOne) Exec(ctx context.Context) error {
+	_, err := liuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (liuo *LinkedIdentityUpdateOne) ExecX(ctx context.Context) {
+	if err := liuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (liuo *LinkedIdentityUpdateOne) check() error {
+	if liuo.mutation.UserCleared() && len(liuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkedIdentity.user"`)
+	}
+	return nil
+}
+
+func (liuo *LinkedIdentityUpdateOne) sqlSave(ctx context.Context) (_node *LinkedIdentity, err error) {
+	if err := liuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkedidentity.Table, linkedidentity.Columns, sqlgraph.NewFieldSpec(linkedidentity.FieldID, field.TypeUint64))
+	id, ok := liuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkedIdentity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := liuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkedidentity.FieldID)
+		for _, f := range fields {
+			if !linkedidentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linkedidentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := liuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := liuo.mutation.LastLoginAt(); ok {
+		_spec.SetField(linkedidentity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if liuo.mutation.LastLoginAtCleared() {
+		_spec.ClearField(linkedidentity.FieldLastLoginAt, field.TypeTime)
+	}
+	_node = &LinkedIdentity{config: liuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, liuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkedidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	liuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,58 @@ import (
 )
 
 var (
+	// AccessRequestsColumns holds the columns for the "access_requests" table.
+	AccessRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "organization_id", Type: field.TypeUint32},
+		{Name: "justification", Type: field.TypeString, Size: 1000},
+		{Name: "duration", Type: field.TypeUint32, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "denied"}, Default: "pending"},
+		{Name: "review_comment", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "requester_id", Type: field.TypeUint64},
+		{Name: "role_id", Type: field.TypeUint32},
+		{Name: "reviewer_id", Type: field.TypeUint64, Nullable: true},
+	}
+	// AccessRequestsTable holds the schema information for the "access_requests" table.
+	AccessRequestsTable = &schema.Table{
+		Name:       "access_requests",
+		Columns:    AccessRequestsColumns,
+		PrimaryKey: []*schema.Column{AccessRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_requests_users_requester",
+				Columns:    []*schema.Column{AccessRequestsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "access_requests_roles_role",
+				Columns:    []*schema.Column{AccessRequestsColumns[9]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "access_requests_users_reviewer",
+				Columns:    []*schema.Column{AccessRequestsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accessrequest_organization_id_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccessRequestsColumns[1], AccessRequestsColumns[4], AccessRequestsColumns[7]},
+			},
+			{
+				Name:    "accessrequest_requester_id_role_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{AccessRequestsColumns[8], AccessRequestsColumns[9], AccessRequestsColumns[4]},
+			},
+		},
+	}
 	// AccessTokensColumns holds the columns for the "access_tokens" table.
 	AccessTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -39,6 +91,270 @@ var (
 			},
 		},
 	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "organization_id", Type: field.TypeUint32, Default: 1},
+		{Name: "action", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "impersonator_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_organization_id_action_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[2], AuditLogsColumns[6]},
+			},
+		},
+	}
+	// CredentialsColumns holds the columns for the "credentials" table.
+	CredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "credential_id", Type: field.TypeBytes, Unique: true, Size: 1023},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "attestation_type", Type: field.TypeString},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "backup_eligible", Type: field.TypeBool, Default: false},
+		{Name: "backup_state", Type: field.TypeBool, Default: false},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// CredentialsTable holds the schema information for the "credentials" table.
+	CredentialsTable = &schema.Table{
+		Name:       "credentials",
+		Comment:    "Stores WebAuthn credentials of users",
+		Columns:    CredentialsColumns,
+		PrimaryKey: []*schema.Column{CredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "credentials_users_credentials",
+				Columns:    []*schema.Column{CredentialsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// GroupsColumns holds the columns for the "groups" table.
+	GroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUint32, Default: 1},
+	}
+	// GroupsTable holds the schema information for the "groups" table.
+	GroupsTable = &schema.Table{
+		Name:       "groups",
+		Columns:    GroupsColumns,
+		PrimaryKey: []*schema.Column{GroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "groups_organizations_groups",
+				Columns:    []*schema.Column{GroupsColumns[5]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "group_organization_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{GroupsColumns[5], GroupsColumns[1]},
+			},
+		},
+	}
+	// LinkedIdentitiesColumns holds the columns for the "linked_identities" table.
+	LinkedIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// LinkedIdentitiesTable holds the schema information for the "linked_identities" table.
+	LinkedIdentitiesTable = &schema.Table{
+		Name:       "linked_identities",
+		Comment:    "Links identities of external providers to users",
+		Columns:    LinkedIdentitiesColumns,
+		PrimaryKey: []*schema.Column{LinkedIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "linked_identities_users_identities",
+				Columns:    []*schema.Column{LinkedIdentitiesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkedidentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{LinkedIdentitiesColumns[1], LinkedIdentitiesColumns[2]},
+			},
+			{
+				Name:    "linkedidentity_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{LinkedIdentitiesColumns[5]},
+			},
+		},
+	}
+	// OauthClientsColumns holds the columns for the "oauth_clients" table.
+	OauthClientsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "client_id", Type: field.TypeString, Unique: true},
+		{Name: "secret", Type: field.TypeString, Nullable: true},
+		{Name: "public", Type: field.TypeBool, Default: false},
+		{Name: "redirect_uris", Type: field.TypeJSON, Nullable: true},
+		{Name: "grant_types", Type: field.TypeJSON},
+		{Name: "public_key", Type: field.TypeString, Nullable: true, Size: 4096},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUint32, Default: 1},
+		{Name: "user_id", Type: field.TypeUint64, Nullable: true},
+	}
+	// OauthClientsTable holds the schema information for the "oauth_clients" table.
+	OauthClientsTable = &schema.Table{
+		Name:       "oauth_clients",
+		Comment:    "Stores registered OAuth clients",
+		Columns:    OauthClientsColumns,
+		PrimaryKey: []*schema.Column{OauthClientsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oauth_clients_organizations_oauth_clients",
+				Columns:    []*schema.Column{OauthClientsColumns[10]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+			{
+				Symbol:     "oauth_clients_users_oauth_clients",
+				Columns:    []*schema.Column{OauthClientsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+		},
+	}
+	// OauthCodesColumns holds the columns for the "oauth_codes" table.
+	OauthCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "code", Type: field.TypeBytes, Unique: true, SchemaType: map[string]string{"mysql": "binary(32)", "postgres": "bytea", "sqlite3": "blob"}},
+		{Name: "redirect_uri", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "nonce", Type: field.TypeString, Nullable: true},
+		{Name: "code_challenge", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "client_id", Type: field.TypeUint32},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// OauthCodesTable holds the schema information for the "oauth_codes" table.
+	OauthCodesTable = &schema.Table{
+		Name:       "oauth_codes",
+		Comment:    "Stores unused OAuth authorization codes",
+		Columns:    OauthCodesColumns,
+		PrimaryKey: []*schema.Column{OauthCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oauth_codes_oauth_clients_codes",
+				Columns:    []*schema.Column{OauthCodesColumns[7]},
+				RefColumns: []*schema.Column{OauthClientsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "oauth_codes_users_oauth_codes",
+				Columns:    []*schema.Column{OauthCodesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthcode_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{OauthCodesColumns[6]},
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "scim_token", Type: field.TypeBytes, Unique: true, Nullable: true, SchemaType: map[string]string{"mysql": "binary(32)", "postgres": "binary(32)", "sqlite3": "blob"}},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
+	// PasswordHistoriesColumns holds the columns for the "password_histories" table.
+	PasswordHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "password", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// PasswordHistoriesTable holds the schema information for the "password_histories" table.
+	PasswordHistoriesTable = &schema.Table{
+		Name:       "password_histories",
+		Comment:    "Stores hashes of previous passwords of users",
+		Columns:    PasswordHistoriesColumns,
+		PrimaryKey: []*schema.Column{PasswordHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_histories_users_password_history",
+				Columns:    []*schema.Column{PasswordHistoriesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordhistory_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordHistoriesColumns[3], PasswordHistoriesColumns[2]},
+			},
+		},
+	}
+	// PasswordResetsColumns holds the columns for the "password_resets" table.
+	PasswordResetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "token", Type: field.TypeBytes, Unique: true, SchemaType: map[string]string{"mysql": "binary(32)", "postgres": "binary(32)", "sqlite3": "blob"}},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// PasswordResetsTable holds the schema information for the "password_resets" table.
+	PasswordResetsTable = &schema.Table{
+		Name:       "password_resets",
+		Comment:    "Stores password reset tokens",
+		Columns:    PasswordResetsColumns,
+		PrimaryKey: []*schema.Column{PasswordResetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_resets_users_password_resets",
+				Columns:    []*schema.Column{PasswordResetsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// PermissionsColumns holds the columns for the "permissions" table.
 	PermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true},
@@ -80,33 +396,333 @@ var (
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true},
-		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "require_mfa", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUint32, Default: 1},
 	}
 	// RolesTable holds the schema information for the "roles" table.
 	RolesTable = &schema.Table{
 		Name:       "roles",
 		Columns:    RolesColumns,
 		PrimaryKey: []*schema.Column{RolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "roles_organizations_roles",
+				Columns:    []*schema.Column{RolesColumns[6]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "role_organization_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{RolesColumns[6], RolesColumns[1]},
+			},
+		},
+	}
+	// ServiceAccountsColumns holds the columns for the "service_accounts" table.
+	ServiceAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "client_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "owner_id", Type: field.TypeUint32},
+		{Name: "user_id", Type: field.TypeUint64, Unique: true},
+	}
+	// ServiceAccountsTable holds the schema information for the "service_accounts" table.
+	ServiceAccountsTable = &schema.Table{
+		Name:       "service_accounts",
+		Comment:    "Stores service accounts",
+		Columns:    ServiceAccountsColumns,
+		PrimaryKey: []*schema.Column{ServiceAccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "service_accounts_groups_service_accounts",
+				Columns:    []*schema.Column{ServiceAccountsColumns[6]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+			{
+				Symbol:     "service_accounts_users_service_account",
+				Columns:    []*schema.Column{ServiceAccountsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "access_token", Type: field.TypeBytes, Unique: true, SchemaType: map[string]string{"mysql": "binary(16)", "postgres": "binary(16)", "sqlite3": "blob"}},
+		{Name: "refresh_token", Type: field.TypeBytes, Unique: true, SchemaType: map[string]string{"mysql": "binary(16)", "postgres": "binary(16)", "sqlite3": "blob"}},
+		{Name: "user_agent", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "ip", Type: field.TypeString, Size: 45, Default: ""},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "refreshed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Comment:    "Stores signed in sessions of users",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SodConstraintsColumns holds the columns for the "sod_constraints" table.
+	SodConstraintsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUint32, Default: 1},
+	}
+	// SodConstraintsTable holds the schema information for the "sod_constraints" table.
+	SodConstraintsTable = &schema.Table{
+		Name:       "sod_constraints",
+		Columns:    SodConstraintsColumns,
+		PrimaryKey: []*schema.Column{SodConstraintsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sod_constraints_organizations_sod_constraints",
+				Columns:    []*schema.Column{SodConstraintsColumns[5]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sodconstraint_organization_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SodConstraintsColumns[5], SodConstraintsColumns[1]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "username", Type: field.TypeString, Unique: true},
-		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true},
-		{Name: "password", Type: field.TypeString},
+		{Name: "username", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "password", Type: field.TypeString, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_confirmed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "recovery_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "tokens_valid_after", Type: field.TypeTime, Nullable: true},
+		{Name: "must_change_password", Type: field.TypeBool, Default: false},
+		{Name: "auth_provider", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "disabled", "deleted", "locked"}, Default: "active"},
+		{Name: "is_service_account", Type: field.TypeBool, Default: false},
 		{Name: "attr", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUint32, Default: 1},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "users_organizations_users",
+				Columns:    []*schema.Column{UsersColumns[17]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_organization_id_is_service_account_username",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[17], UsersColumns[13], UsersColumns[2]},
+			},
+			{
+				Name:    "user_organization_id_email",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[17], UsersColumns[3]},
+			},
+		},
+	}
+	// RoleUsersColumns holds the columns for the "role_users" table.
+	RoleUsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "role_id", Type: field.TypeUint32},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// RoleUsersTable holds the schema information for the "role_users" table.
+	RoleUsersTable = &schema.Table{
+		Name:       "role_users",
+		Columns:    RoleUsersColumns,
+		PrimaryKey: []*schema.Column{RoleUsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_users_roles_role",
+				Columns:    []*schema.Column{RoleUsersColumns[3]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "role_users_users_user",
+				Columns:    []*schema.Column{RoleUsersColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userrole_role_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{RoleUsersColumns[3], RoleUsersColumns[4]},
+			},
+			{
+				Name:    "userrole_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RoleUsersColumns[2]},
+			},
+		},
+	}
+	// WebauthnChallengesColumns holds the columns for the "webauthn_challenges" table.
+	WebauthnChallengesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "challenge", Type: field.TypeBytes, Unique: true, SchemaType: map[string]string{"mysql": "binary(32)", "postgres": "binary(32)", "sqlite3": "blob"}},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebauthnChallengesTable holds the schema information for the "webauthn_challenges" table.
+	WebauthnChallengesTable = &schema.Table{
+		Name:       "webauthn_challenges",
+		Comment:    "Stores consumed WebAuthn challenges",
+		Columns:    WebauthnChallengesColumns,
+		PrimaryKey: []*schema.Column{WebauthnChallengesColumns[0]},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUint32, Default: 1},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_organizations_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[7]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "delivered", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeUint32, Default: 0},
+		{Name: "response_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeUint32},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[10]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Restrict,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3], WebhookDeliveriesColumns[7]},
+			},
+		},
+	}
+	// GroupUsersColumns holds the columns for the "group_users" table.
+	GroupUsersColumns = []*schema.Column{
+		{Name: "group_id", Type: field.TypeUint32},
+		{Name: "user_id", Type: field.TypeUint64},
+	}
+	// GroupUsersTable holds the schema information for the "group_users" table.
+	GroupUsersTable = &schema.Table{
+		Name:       "group_users",
+		Columns:    GroupUsersColumns,
+		PrimaryKey: []*schema.Column{GroupUsersColumns[0], GroupUsersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_users_group_id",
+				Columns:    []*schema.Column{GroupUsersColumns[0]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_users_user_id",
+				Columns:    []*schema.Column{GroupUsersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// GroupRolesColumns holds the columns for the "group_roles" table.
+	GroupRolesColumns = []*schema.Column{
+		{Name: "group_id", Type: field.TypeUint32},
+		{Name: "role_id", Type: field.TypeUint32},
+	}
+	// GroupRolesTable holds the schema information for the "group_roles" table.
+	GroupRolesTable = &schema.Table{
+		Name:       "group_roles",
+		Columns:    GroupRolesColumns,
+		PrimaryKey: []*schema.Column{GroupRolesColumns[0], GroupRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_roles_group_id",
+				Columns:    []*schema.Column{GroupRolesColumns[0]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_roles_role_id",
+				Columns:    []*schema.Column{GroupRolesColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// RolePermissionsColumns holds the columns for the "role_permissions" table.
 	RolePermissionsColumns = []*schema.Column{
@@ -133,56 +749,145 @@ var (
 			},
 		},
 	}
-	// RoleUsersColumns holds the columns for the "role_users" table.
-	RoleUsersColumns = []*schema.Column{
+	// RoleApproversColumns holds the columns for the "role_approvers" table.
+	RoleApproversColumns = []*schema.Column{
 		{Name: "role_id", Type: field.TypeUint32},
 		{Name: "user_id", Type: field.TypeUint64},
 	}
-	// RoleUsersTable holds the schema information for the "role_users" table.
-	RoleUsersTable = &schema.Table{
-		Name:       "role_users",
-		Columns:    RoleUsersColumns,
-		PrimaryKey: []*schema.Column{RoleUsersColumns[0], RoleUsersColumns[1]},
+	// RoleApproversTable holds the schema information for the "role_approvers" table.
+	RoleApproversTable = &schema.Table{
+		Name:       "role_approvers",
+		Columns:    RoleApproversColumns,
+		PrimaryKey: []*schema.Column{RoleApproversColumns[0], RoleApproversColumns[1]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "role_users_role_id",
-				Columns:    []*schema.Column{RoleUsersColumns[0]},
+				Symbol:     "role_approvers_role_id",
+				Columns:    []*schema.Column{RoleApproversColumns[0]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
-				Symbol:     "role_users_user_id",
-				Columns:    []*schema.Column{RoleUsersColumns[1]},
+				Symbol:     "role_approvers_user_id",
+				Columns:    []*schema.Column{RoleApproversColumns[1]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// SodConstraintRolesColumns holds the columns for the "sod_constraint_roles" table.
+	SodConstraintRolesColumns = []*schema.Column{
+		{Name: "sod_constraint_id", Type: field.TypeUint32},
+		{Name: "role_id", Type: field.TypeUint32},
+	}
+	// SodConstraintRolesTable holds the schema information for the "sod_constraint_roles" table.
+	SodConstraintRolesTable = &schema.Table{
+		Name:       "sod_constraint_roles",
+		Columns:    SodConstraintRolesColumns,
+		PrimaryKey: []*schema.Column{SodConstraintRolesColumns[0], SodConstraintRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sod_constraint_roles_sod_constraint_id",
+				Columns:    []*schema.Column{SodConstraintRolesColumns[0]},
+				RefColumns: []*schema.Column{SodConstraintsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "sod_constraint_roles_role_id",
+				Columns:    []*schema.Column{SodConstraintRolesColumns[1]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessRequestsTable,
 		AccessTokensTable,
+		AuditLogsTable,
+		CredentialsTable,
+		GroupsTable,
+		LinkedIdentitiesTable,
+		OauthClientsTable,
+		OauthCodesTable,
+		OrganizationsTable,
+		PasswordHistoriesTable,
+		PasswordResetsTable,
 		PermissionsTable,
 		PersonalTokensTable,
 		RolesTable,
+		ServiceAccountsTable,
+		SessionsTable,
+		SodConstraintsTable,
 		UsersTable,
-		RolePermissionsTable,
 		RoleUsersTable,
+		WebauthnChallengesTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
+		GroupUsersTable,
+		GroupRolesTable,
+		RolePermissionsTable,
+		RoleApproversTable,
+		SodConstraintRolesTable,
 	}
 )
 
 func init() {
+	AccessRequestsTable.ForeignKeys[0].RefTable = UsersTable
+	AccessRequestsTable.ForeignKeys[1].RefTable = RolesTable
+	AccessRequestsTable.ForeignKeys[2].RefTable = UsersTable
 	AccessTokensTable.ForeignKeys[0].RefTable = UsersTable
 	AccessTokensTable.ForeignKeys[1].RefTable = UsersTable
 	AccessTokensTable.Annotation = &entsql.Annotation{}
+	CredentialsTable.ForeignKeys[0].RefTable = UsersTable
+	CredentialsTable.Annotation = &entsql.Annotation{}
+	GroupsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	GroupsTable.Annotation = &entsql.Annotation{}
+	LinkedIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	LinkedIdentitiesTable.Annotation = &entsql.Annotation{}
+	OauthClientsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OauthClientsTable.ForeignKeys[1].RefTable = UsersTable
+	OauthClientsTable.Annotation = &entsql.Annotation{}
+	OauthCodesTable.ForeignKeys[0].RefTable = OauthClientsTable
+	OauthCodesTable.ForeignKeys[1].RefTable = UsersTable
+	OauthCodesTable.Annotation = &entsql.Annotation{}
+	OrganizationsTable.Annotation = &entsql.Annotation{}
+	PasswordHistoriesTable.ForeignKeys[0].RefTable = UsersTable
+	PasswordHistoriesTable.Annotation = &entsql.Annotation{}
+	PasswordResetsTable.ForeignKeys[0].RefTable = UsersTable
+	PasswordResetsTable.Annotation = &entsql.Annotation{}
 	PermissionsTable.Annotation = &entsql.Annotation{}
 	PersonalTokensTable.ForeignKeys[0].RefTable = UsersTable
 	PersonalTokensTable.Annotation = &entsql.Annotation{}
+	RolesTable.ForeignKeys[0].RefTable = OrganizationsTable
 	RolesTable.Annotation = &entsql.Annotation{}
+	ServiceAccountsTable.ForeignKeys[0].RefTable = GroupsTable
+	ServiceAccountsTable.ForeignKeys[1].RefTable = UsersTable
+	ServiceAccountsTable.Annotation = &entsql.Annotation{}
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.Annotation = &entsql.Annotation{}
+	SodConstraintsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	SodConstraintsTable.Annotation = &entsql.Annotation{}
+	UsersTable.ForeignKeys[0].RefTable = OrganizationsTable
 	UsersTable.Annotation = &entsql.Annotation{}
+	RoleUsersTable.ForeignKeys[0].RefTable = RolesTable
+	RoleUsersTable.ForeignKeys[1].RefTable = UsersTable
+	RoleUsersTable.Annotation = &entsql.Annotation{
+		Table: "role_users",
+	}
+	WebauthnChallengesTable.Annotation = &entsql.Annotation{}
+	WebhooksTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WebhooksTable.Annotation = &entsql.Annotation{}
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	WebhookDeliveriesTable.Annotation = &entsql.Annotation{}
+	GroupUsersTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupUsersTable.ForeignKeys[1].RefTable = UsersTable
+	GroupRolesTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupRolesTable.ForeignKeys[1].RefTable = RolesTable
 	RolePermissionsTable.ForeignKeys[0].RefTable = RolesTable
 	RolePermissionsTable.ForeignKeys[1].RefTable = PermissionsTable
 	RolePermissionsTable.Annotation = &entsql.Annotation{}
-	RoleUsersTable.ForeignKeys[0].RefTable = RolesTable
-	RoleUsersTable.ForeignKeys[1].RefTable = UsersTable
-	RoleUsersTable.Annotation = &entsql.Annotation{}
+	RoleApproversTable.ForeignKeys[0].RefTable = RolesTable
+	RoleApproversTable.ForeignKeys[1].RefTable = UsersTable
+	SodConstraintRolesTable.ForeignKeys[0].RefTable = SodConstraintsTable
+	SodConstraintRolesTable.ForeignKeys[1].RefTable = RolesTable
 }
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Stores registered OAuth clients
+type OauthClient struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uint32 `json:"organization_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret *string `json:"-"`
+	// Public holds the value of the "public" field.
+	Public bool `json:"public,omitempty"`
+	// RedirectUris holds the value of the "redirect_uris" field.
+	RedirectUris []string `json:"redirect_uris,omitempty"`
+	// GrantTypes holds the value of the "grant_types" field.
+	GrantTypes []string `json:"grant_types,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey *string `json:"public_key,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uint64 `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OauthClientQuery when eager-loading is set.
+	Edges        OauthClientEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// OauthClientEdges holds the relations/edges for other nodes in the graph.
+type OauthClientEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Codes holds the value of the codes edge.
+	Codes []*OauthCode `json:"codes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OauthClientEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OauthClientEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// CodesOrErr returns the Codes value or an error if the edge
+// was not loaded in eager-loading.
+func (e OauthClientEdges) CodesOrErr() ([]*OauthCode, error) {
+	if e.loadedTypes[2] {
+		return e.Codes, nil
+	}
+	return nil, &NotLoadedError{edge: "codes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OauthClient) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthclient.FieldRedirectUris, oauthclient.FieldGrantTypes:
+			values[i] = new([]byte)
+		case oauthclient.FieldPublic:
+			values[i] = new(sql.NullBool)
+		case oauthclient.FieldID, oauthclient.FieldOrganizationID, oauthclient.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case oauthclient.FieldName, oauthclient.FieldClientID, oauthclient.FieldSecret, oauthclient.FieldPublicKey:
+			values[i] = new(sql.NullString)
+		case oauthclient.FieldCreatedAt, oauthclient.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OauthClient fields.
+func (oc *OauthClient) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthclient.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oc.ID = uint32(value.Int64)
+		case oauthclient.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				oc.OrganizationID = uint32(value.Int64)
+			}
+		case oauthclient.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				oc.Name = value.String
+			}
+		case oauthclient.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				oc.ClientID = value.String
+			}
+		case oauthclient.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				oc.Secret = new(string)
+				*oc.Secret = value.String
+			}
+		case oauthclient.FieldPublic:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field public", values[i])
+			} else if value.Valid {
+				oc.Public = value.Bool
+			}
+		case oauthclient.FieldRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oc.RedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field redirect_uris: %w", err)
+				}
+			}
+		case oauthclient.FieldGrantTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field grant_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oc.GrantTypes); err != nil {
+					return fmt.Errorf("unmarshal field grant_types: %w", err)
+				}
+			}
+		case oauthclient.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				oc.PublicKey = new(string)
+				*oc.PublicKey = value.String
+			}
+		case oauthclient.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				oc.UserID = new(uint64)
+				*oc.UserID = uint64(value.Int64)
+			}
+		case oauthclient.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oc.CreatedAt = new(time.Time)
+				*oc.CreatedAt = value.Time
+			}
+		case oauthclient.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				oc.UpdatedAt = new(time.Time)
+				*oc.UpdatedAt = value.Time
+			}
+		default:
+			oc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OauthClient.
+// This includes values selected through modifiers, order, etc.
+func (oc *OauthClient) Value(name string) (ent.Value, error) {
+	return oc.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the OauthClient entity.
+func (oc *OauthClient) QueryOrganization() *OrganizationQuery {
+	return NewOauthClientClient(oc.config).QueryOrganization(oc)
+}
+
+// QueryUser queries the "user" edge of the OauthClient entity.
+func (oc *OauthClient) QueryUser() *UserQuery {
+	return NewOauthClientClient(oc.config).QueryUser(oc)
+}
+
+// QueryCodes queries the "codes" edge of the OauthClient entity.
+func (oc *OauthClient) QueryCodes() *OauthCodeQuery {
+	return NewOauthClientClient(oc.config).QueryCodes(oc)
+}
+
+// Update returns a builder for updating this OauthClient.
+// Note that you need to call OauthClient.Unwrap() before calling this method if this OauthClient
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oc *OauthClient) Update() *OauthClientUpdateOne {
+	return NewOauthClientClient(oc.config).UpdateOne(oc)
+}
+
+// Unwrap unwraps the OauthClient entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oc *OauthClient) Unwrap() *OauthClient {
+	_tx, ok := oc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OauthClient is not a transactional entity")
+	}
+	oc.config.driver = _tx.drv
+	return oc
+}
+
+// String implements the fmt.Stringer.
+func (oc *OauthClient) String() string {
+	var builder strings.Builder
+	builder.WriteString("OauthClient(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oc.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", oc.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(oc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(oc.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("public=")
+	builder.WriteString(fmt.Sprintf("%v", oc.Public))
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", oc.RedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("grant_types=")
+	builder.WriteString(fmt.Sprintf("%v", oc.GrantTypes))
+	builder.WriteString(", ")
+	if v := oc.PublicKey; v != nil {
+		builder.WriteString("public_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := oc.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := oc.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := oc.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckOauthClientID returns the "ID" field value.
+func PluckOauthClientID(oc *OauthClient) uint32 {
+	return oc.ID
+}
+
+// PluckOauthClientOrganizationID returns the "organization_id" field value.
+func PluckOauthClientOrganizationID(oc *OauthClient) uint32 {
+	return oc.OrganizationID
+}
+
+// PluckOauthClientName returns the "name" field value.
+func PluckOauthClientName(oc *OauthClient) string {
+	return oc.Name
+}
+
+// PluckOauthClientClientID returns the "client_id" field value.
+func PluckOauthClientClientID(oc *OauthClient) string {
+	return oc.ClientID
+}
+
+// PluckOauthClientSecret returns the "secret" field value.
+func PluckOauthClientSecret(oc *OauthClient) *string {
+	return oc.Secret
+}
+
+// PluckOauthClientPublic returns the "public" field value.
+func PluckOauthClientPublic(oc *OauthClient) bool {
+	return oc.Public
+}
+
+// PluckOauthClientRedirectUris returns the "redirect_uris" field value.
+func PluckOauthClientRedirectUris(oc *OauthClient) []string {
+	return oc.RedirectUris
+}
+
+// PluckOauthClientGrantTypes returns the "grant_types" field value.
+func PluckOauthClientGrantTypes(oc *OauthClient) []string {
+	return oc.GrantTypes
+}
+
+// PluckOauthClientPublicKey returns the "public_key" field value.
+func PluckOauthClientPublicKey(oc *OauthClient) *string {
+	return oc.PublicKey
+}
+
+// PluckOauthClientUserID returns the "user_id" field value.
+func PluckOauthClientUserID(oc *OauthClient) *uint64 {
+	return oc.UserID
+}
+
+// PluckOauthClientCreatedAt returns the "created_at" field value.
+func PluckOauthClientCreatedAt(oc *OauthClient) *time.Time {
+	return oc.CreatedAt
+}
+
+// PluckOauthClientUpdatedAt returns the "updated_at" field value.
+func PluckOauthClientUpdatedAt(oc *OauthClient) *time.Time {
+	return oc.UpdatedAt
+}
+
+// OauthClients is a parsable slice of OauthClient.
+type OauthClients []*OauthClient
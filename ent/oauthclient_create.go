@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// OauthClientCreate is the builder for creating a OauthClient entity.
+type OauthClientCreate struct {
+	config
+	mutation *OauthClientMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (occ *OauthClientCreate) SetOrganizationID(u uint32) *OauthClientCreate {
+	occ.mutation.SetOrganizationID(u)
+	return occ
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableOrganizationID(u *uint32) *OauthClientCreate {
+	if u != nil {
+		occ.SetOrganizationID(*u)
+	}
+	return occ
+}
+
+// SetName sets the "name" field.
+func (occ *OauthClientCreate) SetName(s string) *OauthClientCreate {
+	occ.mutation.SetName(s)
+	return occ
+}
+
+// SetClientID sets the "client_id" field.
+func (occ *OauthClientCreate) SetClientID(s string) *OauthClientCreate {
+	occ.mutation.SetClientID(s)
+	return occ
+}
+
+// SetSecret sets the "secret" field.
+func (occ *OauthClientCreate) SetSecret(s string) *OauthClientCreate {
+	occ.mutation.SetSecret(s)
+	return occ
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableSecret(s *string) *OauthClientCreate {
+	if s != nil {
+		occ.SetSecret(*s)
+	}
+	return occ
+}
+
+// SetPublic sets the "public" field.
+func (occ *OauthClientCreate) SetPublic(b bool) *OauthClientCreate {
+	occ.mutation.SetPublic(b)
+	return occ
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillablePublic(b *bool) *OauthClientCreate {
+	if b != nil {
+		occ.SetPublic(*b)
+	}
+	return occ
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (occ *OauthClientCreate) SetRedirectUris(s []string) *OauthClientCreate {
+	occ.mutation.SetRedirectUris(s)
+	return occ
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (occ *OauthClientCreate) SetGrantTypes(s []string) *OauthClientCreate {
+	occ.mutation.SetGrantTypes(s)
+	return occ
+}
+
+// SetPublicKey sets the "public_key" field.
+func (occ *OauthClientCreate) SetPublicKey(s string) *OauthClientCreate {
+	occ.mutation.SetPublicKey(s)
+	return occ
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillablePublicKey(s *string) *OauthClientCreate {
+	if s != nil {
+		occ.SetPublicKey(*s)
+	}
+	return occ
+}
+
+// SetUserID sets the "user_id" field.
+func (occ *OauthClientCreate) SetUserID(u uint64) *OauthClientCreate {
+	occ.mutation.SetUserID(u)
+	return occ
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableUserID(u *uint64) *OauthClientCreate {
+	if u != nil {
+		occ.SetUserID(*u)
+	}
+	return occ
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (occ *OauthClientCreate) SetCreatedAt(t time.Time) *OauthClientCreate {
+	occ.mutation.SetCreatedAt(t)
+	return occ
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableCreatedAt(t *time.Time) *OauthClientCreate {
+	if t != nil {
+		occ.SetCreatedAt(*t)
+	}
+	return occ
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (occ *OauthClientCreate) SetUpdatedAt(t time.Time) *OauthClientCreate {
+	occ.mutation.SetUpdatedAt(t)
+	return occ
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (occ *OauthClientCreate) SetNillableUpdatedAt(t *time.Time) *OauthClientCreate {
+	if t != nil {
+		occ.SetUpdatedAt(*t)
+	}
+	return occ
+}
+
+// SetID sets the "id" field.
+func (occ *OauthClientCreate) SetID(u uint32) *OauthClientCreate {
+	occ.mutation.SetID(u)
+	return occ
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (occ *OauthClientCreate) SetOrganization(o *Organization) *OauthClientCreate {
+	return occ.SetOrganizationID(o.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (occ *OauthClientCreate) SetUser(u *User) *OauthClientCreate {
+	return occ.SetUserID(u.ID)
+}
+
+// AddCodeIDs adds the "codes" edge to the OauthCode entity by IDs.
+func (occ *OauthClientCreate) AddCodeIDs(ids ...uint64) *OauthClientCreate {
+	occ.mutation.AddCodeIDs(ids...)
+	return occ
+}
+
+// AddCodes adds the "codes" edges to the OauthCode entity.
+func (occ *OauthClientCreate) AddCodes(o ...*OauthCode) *OauthClientCreate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return occ.AddCodeIDs(ids...)
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (occ *OauthClientCreate) Mutation() *OauthClientMutation {
+	return occ.mutation
+}
+
+// Save creates the OauthClient in the database.
+func (occ *OauthClientCreate) Save(ctx context.Context) (*OauthClient, error) {
+	occ.defaults()
+	return withHooks(ctx, occ.sqlSave, occ.mutation, occ.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (occ *OauthClientCreate) SaveX(ctx context.Context) *OauthClient {
+	v, err := occ.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occ *OauthClientCreate) Exec(ctx context.Context) error {
+	_, err := occ.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occ *OauthClientCreate) ExecX(ctx context.Context) {
+	if err := occ.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (occ *OauthClientCreate) defaults() {
+	if _, ok := occ.mutation.OrganizationID(); !ok {
+		v := oauthclient.DefaultOrganizationID
+		occ.mutation.SetOrganizationID(v)
+	}
+	if _, ok := occ.mutation.Public(); !ok {
+		v := oauthclient.DefaultPublic
+		occ.mutation.SetPublic(v)
+	}
+	if _, ok := occ.mutation.CreatedAt(); !ok {
+		v := oauthclient.DefaultCreatedAt()
+		occ.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (occ *OauthClientCreate) check() error {
+	if _, ok := occ.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "OauthClient.organization_id"`)}
+	}
+	if _, ok := occ.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "OauthClient.name"`)}
+	}
+	if v, ok := occ.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if _, ok := occ.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OauthClient.client_id"`)}
+	}
+	if v, ok := occ.mutation.ClientID(); ok {
+		if err := oauthclient.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OauthClient.client_id": %w`, err)}
+		}
+	}
+	if _, ok := occ.mutation.Public(); !ok {
+		return &ValidationError{Name: "public", err: errors.New(`ent: missing required field "OauthClient.public"`)}
+	}
+	if _, ok := occ.mutation.GrantTypes(); !ok {
+		return &ValidationError{Name: "grant_types", err: errors.New(`ent: missing required field "OauthClient.grant_types"`)}
+	}
+	if v, ok := occ.mutation.PublicKey(); ok {
+		if err := oauthclient.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "OauthClient.public_key": %w`, err)}
+		}
+	}
+	if len(occ.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "OauthClient.organization"`)}
+	}
+	return nil
+}
+
+func (occ *OauthClientCreate) sqlSave(ctx context.Context) (*OauthClient, error) {
+	if err := occ.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := occ.createSpec()
+	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	occ.mutation.id = &_node.ID
+	occ.mutation.done = true
+	return _node, nil
+}
+
+func (occ *OauthClientCreate) createSpec() (*OauthClient, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OauthClient{config: occ.config}
+		_spec = sqlgraph.NewCreateSpec(oauthclient.Table, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32))
+	)
+	if id, ok := occ.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := occ.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := occ.mutation.ClientID(); ok {
+		_spec.SetField(oauthclient.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := occ.mutation.Secret(); ok {
+		_spec.SetField(oauthclient.FieldSecret, field.TypeString, value)
+		_node.Secret = &value
+	}
+	if value, ok := occ.mutation.Public(); ok {
+		_spec.SetField(oauthclient.FieldPublic, field.TypeBool, value)
+		_node.Public = value
+	}
+	if value, ok := occ.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := occ.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+		_node.GrantTypes = value
+	}
+	if value, ok := occ.mutation.PublicKey(); ok {
+		_spec.SetField(oauthclient.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = &value
+	}
+	if value, ok := occ.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthclient.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := occ.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if nodes := occ.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthclient.OrganizationTable,
+			Columns: []string{oauthclient.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := occ.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthclient.UserTable,
+			Columns: []string{oauthclient.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := occ.mutation.CodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.CodesTable,
+			Columns: []string{oauthclient.CodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OauthClientCreateBulk is the builder for creating many OauthClient entities in bulk.
+type OauthClientCreateBulk struct {
+	config
+	err      error
+	builders []*OauthClientCreate
+}
+
+// Save creates the OauthClient entities in the database.
+func (occb *OauthClientCreateBulk) Save(ctx context.Context) ([]*OauthClient, error) {
+	if occb.err != nil {
+		return nil, occb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
+	nodes := make([]*OauthClient, len(occb.builders))
+	mutators := make([]Mutator, len(occb.builders))
+	for i := range occb.builders {
+		func(i int, root context.Context) {
+			builder := occb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OauthClientMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, occb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, occb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (occb *OauthClientCreateBulk) SaveX(ctx context.Context) []*OauthClient {
+	v, err := occb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occb *OauthClientCreateBulk) Exec(ctx context.Context) error {
+	_, err := occb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occb *OauthClientCreateBulk) ExecX(ctx context.Context) {
+	if err := occb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
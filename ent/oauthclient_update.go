@@ -0,0 +1,653 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+)
+
+// OauthClientUpdate is the builder for updating OauthClient entities.
+type OauthClientUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OauthClientMutation
+}
+
+// Where appends a list predicates to the OauthClientUpdate builder.
+func (ocu *OauthClientUpdate) Where(ps ...predicate.OauthClient) *OauthClientUpdate {
+	ocu.mutation.Where(ps...)
+	return ocu
+}
+
+// SetName sets the "name" field.
+func (ocu *OauthClientUpdate) SetName(s string) *OauthClientUpdate {
+	ocu.mutation.SetName(s)
+	return ocu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocu *OauthClientUpdate) SetNillableName(s *string) *OauthClientUpdate {
+	if s != nil {
+		ocu.SetName(*s)
+	}
+	return ocu
+}
+
+// SetSecret sets the "secret" field.
+func (ocu *OauthClientUpdate) SetSecret(s string) *OauthClientUpdate {
+	ocu.mutation.SetSecret(s)
+	return ocu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (ocu *OauthClientUpdate) SetNillableSecret(s *string) *OauthClientUpdate {
+	if s != nil {
+		ocu.SetSecret(*s)
+	}
+	return ocu
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (ocu *OauthClientUpdate) ClearSecret() *OauthClientUpdate {
+	ocu.mutation.ClearSecret()
+	return ocu
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocu *OauthClientUpdate) SetRedirectUris(s []string) *OauthClientUpdate {
+	ocu.mutation.SetRedirectUris(s)
+	return ocu
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ocu *OauthClientUpdate) AppendRedirectUris(s []string) *OauthClientUpdate {
+	ocu.mutation.AppendRedirectUris(s)
+	return ocu
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (ocu *OauthClientUpdate) ClearRedirectUris() *OauthClientUpdate {
+	ocu.mutation.ClearRedirectUris()
+	return ocu
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (ocu *OauthClientUpdate) SetGrantTypes(s []string) *OauthClientUpdate {
+	ocu.mutation.SetGrantTypes(s)
+	return ocu
+}
+
+// AppendGrantTypes appends s to the "grant_types" field.
+func (ocu *OauthClientUpdate) AppendGrantTypes(s []string) *OauthClientUpdate {
+	ocu.mutation.AppendGrantTypes(s)
+	return ocu
+}
+
+// SetPublicKey sets the "public_key" field.
+func (ocu *OauthClientUpdate) SetPublicKey(s string) *OauthClientUpdate {
+	ocu.mutation.SetPublicKey(s)
+	return ocu
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (ocu *OauthClientUpdate) SetNillablePublicKey(s *string) *OauthClientUpdate {
+	if s != nil {
+		ocu.SetPublicKey(*s)
+	}
+	return ocu
+}
+
+// ClearPublicKey clears the value of the "public_key" field.
+func (ocu *OauthClientUpdate) ClearPublicKey() *OauthClientUpdate {
+	ocu.mutation.ClearPublicKey()
+	return ocu
+}
+
+// AddCodeIDs adds the "codes" edge to the OauthCode entity by IDs.
+func (ocu *OauthClientUpdate) AddCodeIDs(ids ...uint64) *OauthClientUpdate {
+	ocu.mutation.AddCodeIDs(ids...)
+	return ocu
+}
+
+// AddCodes adds the "codes" edges to the OauthCode entity.
+func (ocu *OauthClientUpdate) AddCodes(o ...*OauthCode) *OauthClientUpdate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocu.AddCodeIDs(ids...)
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (ocu *OauthClientUpdate) Mutation() *OauthClientMutation {
+	return ocu.mutation
+}
+
+// ClearCodes clears all "codes" edges to the OauthCode entity.
+func (ocu *OauthClientUpdate) ClearCodes() *OauthClientUpdate {
+	ocu.mutation.ClearCodes()
+	return ocu
+}
+
+// RemoveCodeIDs removes the "codes" edge to OauthCode entities by IDs.
+func (ocu *OauthClientUpdate) RemoveCodeIDs(ids ...uint64) *OauthClientUpdate {
+	ocu.mutation.RemoveCodeIDs(ids...)
+	return ocu
+}
+
+// RemoveCodes removes "codes" edges to OauthCode entities.
+func (ocu *OauthClientUpdate) RemoveCodes(o ...*OauthCode) *OauthClientUpdate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocu.RemoveCodeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OauthClientUpdate) Save(ctx context.Context) (int, error) {
+	ocu.defaults()
+	return withHooks(ctx, ocu.sqlSave, ocu.mutation, ocu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OauthClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OauthClientUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OauthClientUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocu *OauthClientUpdate) defaults() {
+	if _, ok := ocu.mutation.UpdatedAt(); !ok && !ocu.mutation.UpdatedAtCleared() {
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		ocu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocu *OauthClientUpdate) check() error {
+	if v, ok := ocu.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := ocu.mutation.PublicKey(); ok {
+		if err := oauthclient.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "OauthClient.public_key": %w`, err)}
+		}
+	}
+	if ocu.mutation.OrganizationCleared() && len(ocu.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OauthClient.organization"`)
+	}
+	return nil
+}
+
+func (ocu *OauthClientUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ocu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32))
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocu.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocu.mutation.Secret(); ok {
+		_spec.SetField(oauthclient.FieldSecret, field.TypeString, value)
+	}
+	if ocu.mutation.SecretCleared() {
+		_spec.ClearField(oauthclient.FieldSecret, field.TypeString)
+	}
+	if value, ok := ocu.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ocu.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if ocu.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauthclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := ocu.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := ocu.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldGrantTypes, value)
+		})
+	}
+	if value, ok := ocu.mutation.PublicKey(); ok {
+		_spec.SetField(oauthclient.FieldPublicKey, field.TypeString, value)
+	}
+	if ocu.mutation.PublicKeyCleared() {
+		_spec.ClearField(oauthclient.FieldPublicKey, field.TypeString)
+	}
+	if ocu.mutation.CreatedAtCleared() {
+		_spec.ClearField(oauthclient.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := ocu.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ocu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(oauthclient.FieldUpdatedAt, field.TypeTime)
+	}
+	if ocu.mutation.CodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.CodesTable,
+			Columns: []string{oauthclient.CodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.RemovedCodesIDs(); len(nodes) > 0 && !ocu.mutation.CodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.CodesTable,
+			Columns: []string{oauthclient.CodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.CodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.CodesTable,
+			Columns: []string{oauthclient.CodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ocu.mutation.done = true
+	return n, nil
+}
+
+// OauthClientUpdateOne is the builder for updating a single OauthClient entity.
+type OauthClientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OauthClientMutation
+}
+
+// SetName sets the "name" field.
+func (ocuo *OauthClientUpdateOne) SetName(s string) *OauthClientUpdateOne {
+	ocuo.mutation.SetName(s)
+	return ocuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ocuo *OauthClientUpdateOne) SetNillableName(s *string) *OauthClientUpdateOne {
+	if s != nil {
+		ocuo.SetName(*s)
+	}
+	return ocuo
+}
+
+// SetSecret sets the "secret" field.
+func (ocuo *OauthClientUpdateOne) SetSecret(s string) *OauthClientUpdateOne {
+	ocuo.mutation.SetSecret(s)
+	return ocuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (ocuo *OauthClientUpdateOne) SetNillableSecret(s *string) *OauthClientUpdateOne {
+	if s != nil {
+		ocuo.SetSecret(*s)
+	}
+	return ocuo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (ocuo *OauthClientUpdateOne) ClearSecret() *OauthClientUpdateOne {
+	ocuo.mutation.ClearSecret()
+	return ocuo
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ocuo *OauthClientUpdateOne) SetRedirectUris(s []string) *OauthClientUpdateOne {
+	ocuo.mutation.SetRedirectUris(s)
+	return ocuo
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ocuo *OauthClientUpdateOne) AppendRedirectUris(s []string) *OauthClientUpdateOne {
+	ocuo.mutation.AppendRedirectUris(s)
+	return ocuo
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (ocuo *OauthClientUpdateOne) ClearRedirectUris() *OauthClientUpdateOne {
+	ocuo.mutation.ClearRedirectUris()
+	return ocuo
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (ocuo *OauthClientUpdateOne) SetGrantTypes(s []string) *OauthClientUpdateOne {
+	ocuo.mutation.SetGrantTypes(s)
+	return ocuo
+}
+
+// AppendGrantTypes appends s to the "grant_types" field.
+func (ocuo *OauthClientUpdateOne) AppendGrantTypes(s []string) *OauthClientUpdateOne {
+	ocuo.mutation.AppendGrantTypes(s)
+	return ocuo
+}
+
+// SetPublicKey sets the "public_key" field.
+func (ocuo *OauthClientUpdateOne) SetPublicKey(s string) *OauthClientUpdateOne {
+	ocuo.mutation.SetPublicKey(s)
+	return ocuo
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (ocuo *OauthClientUpdateOne) SetNillablePublicKey(s *string) *OauthClientUpdateOne {
+	if s != nil {
+		ocuo.SetPublicKey(*s)
+	}
+	return ocuo
+}
+
+// ClearPublicKey clears the value of the "public_key" field.
+func (ocuo *OauthClientUpdateOne) ClearPublicKey() *OauthClientUpdateOne {
+	ocuo.mutation.ClearPublicKey()
+	return ocuo
+}
+
+// AddCodeIDs adds the "codes" edge to the OauthCode entity by IDs.
+func (ocuo *OauthClientUpdateOne) AddCodeIDs(ids ...uint64) *OauthClientUpdateOne {
+	ocuo.mutation.AddCodeIDs(ids...)
+	return ocuo
+}
+
+// AddCodes adds the "codes" edges to the OauthCode entity.
+func (ocuo *OauthClientUpdateOne) AddCodes(o ...*OauthCode) *OauthClientUpdateOne {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocuo.AddCodeIDs(ids...)
+}
+
+// Mutation returns the OauthClientMutation object of the builder.
+func (ocuo *OauthClientUpdateOne) Mutation() *OauthClientMutation {
+	return ocuo.mutation
+}
+
+// ClearCodes clears all "codes" edges to the OauthCode entity.
+func (ocuo *OauthClientUpdateOne) ClearCodes() *OauthClientUpdateOne {
+	ocuo.mutation.ClearCodes()
+	return ocuo
+}
+
+// RemoveCodeIDs removes the "codes" edge to OauthCode entities by IDs.
+func (ocuo *OauthClientUpdateOne) RemoveCodeIDs(ids ...uint64) *OauthClientUpdateOne {
+	ocuo.mutation.RemoveCodeIDs(ids...)
+	return ocuo
+}
+
+// RemoveCodes removes "codes" edges to OauthCode entities.
+func (ocuo *OauthClientUpdateOne) RemoveCodes(o ...*OauthCode) *OauthClientUpdateOne {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ocuo.RemoveCodeIDs(ids...)
+}
+
+// Where appends a list predicates to the OauthClientUpdate builder.
+func (ocuo *OauthClientUpdateOne) Where(ps ...predicate.OauthClient) *OauthClientUpdateOne {
+	ocuo.mutation.Where(ps...)
+	return ocuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocuo *OauthClientUpdateOne) Select(field string, fields ...string) *OauthClientUpdateOne {
+	ocuo.fields = append([]string{field}, fields...)
+	return ocuo
+}
+
+// Save executes the query and returns the updated OauthClient entity.
+func (ocuo *OauthClientUpdateOne) Save(ctx context.Context) (*OauthClient, error) {
+	ocuo.defaults()
+	return withHooks(ctx, ocuo.sqlSave, ocuo.mutation, ocuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OauthClientUpdateOne) SaveX(ctx context.Context) *OauthClient {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OauthClientUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OauthClientUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ocuo *OauthClientUpdateOne) defaults() {
+	if _, ok := ocuo.mutation.UpdatedAt(); !ok && !ocuo.mutation.UpdatedAtCleared() {
+		v := oauthclient.UpdateDefaultUpdatedAt()
+		ocuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocuo *OauthClientUpdateOne) check() error {
+	if v, ok := ocuo.mutation.Name(); ok {
+		if err := oauthclient.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OauthClient.name": %w`, err)}
+		}
+	}
+	if v, ok := ocuo.mutation.PublicKey(); ok {
+		if err := oauthclient.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "OauthClient.public_key": %w`, err)}
+		}
+	}
+	if ocuo.mutation.OrganizationCleared() && len(ocuo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OauthClient.organization"`)
+	}
+	return nil
+}
+
+func (ocuo *OauthClientUpdateOne) sqlSave(ctx context.Context) (_node *OauthClient, err error) {
+	if err := ocuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthclient.Table, oauthclient.Columns, sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32))
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OauthClient.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthclient.FieldID)
+		for _, f := range fields {
+			if !oauthclient.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthclient.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocuo.mutation.Name(); ok {
+		_spec.SetField(oauthclient.FieldName, field.TypeString, value)
+	}
+	if value, ok := ocuo.mutation.Secret(); ok {
+		_spec.SetField(oauthclient.FieldSecret, field.TypeString, value)
+	}
+	if ocuo.mutation.SecretCleared() {
+		_spec.ClearField(oauthclient.FieldSecret, field.TypeString)
+	}
+	if value, ok := ocuo.mutation.RedirectUris(); ok {
+		_spec.SetField(oauthclient.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ocuo.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldRedirectUris, value)
+		})
+	}
+	if ocuo.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauthclient.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := ocuo.mutation.GrantTypes(); ok {
+		_spec.SetField(oauthclient.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := ocuo.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthclient.FieldGrantTypes, value)
+		})
+	}
+	if value, ok := ocuo.mutation.PublicKey(); ok {
+		_spec.SetField(oauthclient.FieldPublicKey, field.TypeString, value)
+	}
+	if ocuo.mutation.PublicKeyCleared() {
+		_spec.ClearField(oauthclient.FieldPublicKey, field.TypeString)
+	}
+	if ocuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(oauthclient.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := ocuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthclient.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ocuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(oauthclient.FieldUpdatedAt, field.TypeTime)
+	}
+	if ocuo.mutation.CodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.CodesTable,
+			Columns: []string{oauthclient.CodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.RemovedCodesIDs(); len(nodes) > 0 && !ocuo.mutation.CodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.CodesTable,
+			Columns: []string{oauthclient.CodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.CodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauthclient.CodesTable,
+			Columns: []string{oauthclient.CodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OauthClient{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthclient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ocuo.mutation.done = true
+	return _node, nil
+}
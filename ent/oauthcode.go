@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Stores unused OAuth authorization codes
+type OauthCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code []byte `json:"-"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID uint32 `json:"client_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// RedirectURI holds the value of the "redirect_uri" field.
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce *string `json:"nonce,omitempty"`
+	// CodeChallenge holds the value of the "code_challenge" field.
+	CodeChallenge string `json:"code_challenge,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OauthCodeQuery when eager-loading is set.
+	Edges        OauthCodeEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// OauthCodeEdges holds the relations/edges for other nodes in the graph.
+type OauthCodeEdges struct {
+	// Client holds the value of the client edge.
+	Client *OauthClient `json:"client,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ClientOrErr returns the Client value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OauthCodeEdges) ClientOrErr() (*OauthClient, error) {
+	if e.Client != nil {
+		return e.Client, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: oauthclient.Label}
+	}
+	return nil, &NotLoadedError{edge: "client"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OauthCodeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OauthCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthcode.FieldCode, oauthcode.FieldScopes:
+			values[i] = new([]byte)
+		case oauthcode.FieldID, oauthcode.FieldClientID, oauthcode.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case oauthcode.FieldRedirectURI, oauthcode.FieldNonce, oauthcode.FieldCodeChallenge:
+			values[i] = new(sql.NullString)
+		case oauthcode.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OauthCode fields.
+func (oc *OauthCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthcode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oc.ID = uint64(value.Int64)
+		case oauthcode.FieldCode:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value != nil {
+				oc.Code = *value
+			}
+		case oauthcode.FieldClientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				oc.ClientID = uint32(value.Int64)
+			}
+		case oauthcode.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				oc.UserID = uint64(value.Int64)
+			}
+		case oauthcode.FieldRedirectURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uri", values[i])
+			} else if value.Valid {
+				oc.RedirectURI = value.String
+			}
+		case oauthcode.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oc.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthcode.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				oc.Nonce = new(string)
+				*oc.Nonce = value.String
+			}
+		case oauthcode.FieldCodeChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge", values[i])
+			} else if value.Valid {
+				oc.CodeChallenge = value.String
+			}
+		case oauthcode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				oc.ExpiresAt = value.Time
+			}
+		default:
+			oc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OauthCode.
+// This includes values selected through modifiers, order, etc.
+func (oc *OauthCode) Value(name string) (ent.Value, error) {
+	return oc.selectValues.Get(name)
+}
+
+// QueryClient queries the "client" edge of the OauthCode entity.
+func (oc *OauthCode) QueryClient() *OauthClientQuery {
+	return NewOauthCodeClient(oc.config).QueryClient(oc)
+}
+
+// QueryUser queries the "user" edge of the OauthCode entity.
+func (oc *OauthCode) QueryUser() *UserQuery {
+	return NewOauthCodeClient(oc.config).QueryUser(oc)
+}
+
+// Update returns a builder for updating this OauthCode.
+// Note that you need to call OauthCode.Unwrap() before calling this method if this OauthCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oc *OauthCode) Update() *OauthCodeUpdateOne {
+	return NewOauthCodeClient(oc.config).UpdateOne(oc)
+}
+
+// Unwrap unwraps the OauthCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oc *OauthCode) Unwrap() *OauthCode {
+	_tx, ok := oc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OauthCode is not a transactional entity")
+	}
+	oc.config.driver = _tx.drv
+	return oc
+}
+
+// String implements the fmt.Stringer.
+func (oc *OauthCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("OauthCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oc.ID))
+	builder.WriteString("code=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", oc.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", oc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uri=")
+	builder.WriteString(oc.RedirectURI)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", oc.Scopes))
+	builder.WriteString(", ")
+	if v := oc.Nonce; v != nil {
+		builder.WriteString("nonce=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge=")
+	builder.WriteString(oc.CodeChallenge)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(oc.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckOauthCodeID returns the "ID" field value.
+func PluckOauthCodeID(oc *OauthCode) uint64 {
+	return oc.ID
+}
+
+// PluckOauthCodeCode returns the "code" field value.
+func PluckOauthCodeCode(oc *OauthCode) []byte {
+	return oc.Code
+}
+
+// PluckOauthCodeClientID returns the "client_id" field value.
+func PluckOauthCodeClientID(oc *OauthCode) uint32 {
+	return oc.ClientID
+}
+
+// PluckOauthCodeUserID returns the "user_id" field value.
+func PluckOauthCodeUserID(oc *OauthCode) uint64 {
+	return oc.UserID
+}
+
+// PluckOauthCodeRedirectURI returns the "redirect_uri" field value.
+func PluckOauthCodeRedirectURI(oc *OauthCode) string {
+	return oc.RedirectURI
+}
+
+// PluckOauthCodeScopes returns the "scopes" field value.
+func PluckOauthCodeScopes(oc *OauthCode) []string {
+	return oc.Scopes
+}
+
+// PluckOauthCodeNonce returns the "nonce" field value.
+func PluckOauthCodeNonce(oc *OauthCode) *string {
+	return oc.Nonce
+}
+
+// PluckOauthCodeCodeChallenge returns the "code_challenge" field value.
+func PluckOauthCodeCodeChallenge(oc *OauthCode) string {
+	return oc.CodeChallenge
+}
+
+// PluckOauthCodeExpiresAt returns the "expires_at" field value.
+func PluckOauthCodeExpiresAt(oc *OauthCode) time.Time {
+	return oc.ExpiresAt
+}
+
+// OauthCodes is a parsable slice of OauthCode.
+type OauthCodes []*OauthCode
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// OauthCodeCreate is the builder for creating a OauthCode entity.
+type OauthCodeCreate struct {
+	config
+	mutation *OauthCodeMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (occ *OauthCodeCreate) SetCode(b []byte) *OauthCodeCreate {
+	occ.mutation.SetCode(b)
+	return occ
+}
+
+// SetClientID sets the "client_id" field.
+func (occ *OauthCodeCreate) SetClientID(u uint32) *OauthCodeCreate {
+	occ.mutation.SetClientID(u)
+	return occ
+}
+
+// SetUserID sets the "user_id" field.
+func (occ *OauthCodeCreate) SetUserID(u uint64) *OauthCodeCreate {
+	occ.mutation.SetUserID(u)
+	return occ
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (occ *OauthCodeCreate) SetRedirectURI(s string) *OauthCodeCreate {
+	occ.mutation.SetRedirectURI(s)
+	return occ
+}
+
+// SetScopes sets the "scopes" field.
+func (occ *OauthCodeCreate) SetScopes(s []string) *OauthCodeCreate {
+	occ.mutation.SetScopes(s)
+	return occ
+}
+
+// SetNonce sets the "nonce" field.
+func (occ *OauthCodeCreate) SetNonce(s string) *OauthCodeCreate {
+	occ.mutation.SetNonce(s)
+	return occ
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (occ *OauthCodeCreate) SetNillableNonce(s *string) *OauthCodeCreate {
+	if s != nil {
+		occ.SetNonce(*s)
+	}
+	return occ
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (occ *OauthCodeCreate) SetCodeChallenge(s string) *OauthCodeCreate {
+	occ.mutation.SetCodeChallenge(s)
+	return occ
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (occ *OauthCodeCreate) SetExpiresAt(t time.Time) *OauthCodeCreate {
+	occ.mutation.SetExpiresAt(t)
+	return occ
+}
+
+// SetID sets the "id" field.
+func (occ *OauthCodeCreate) SetID(u uint64) *OauthCodeCreate {
+	occ.mutation.SetID(u)
+	return occ
+}
+
+// SetClient sets the "client" edge to the OauthClient entity.
+func (occ *OauthCodeCreate) SetClient(o *OauthClient) *OauthCodeCreate {
+	return occ.SetClientID(o.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (occ *OauthCodeCreate) SetUser(u *User) *OauthCodeCreate {
+	return occ.SetUserID(u.ID)
+}
+
+// Mutation returns the OauthCodeMutation object of the builder.
+func (occ *OauthCodeCreate) Mutation() *OauthCodeMutation {
+	return occ.mutation
+}
+
+// Save creates the OauthCode in the database.
+func (occ *OauthCodeCreate) Save(ctx context.Context) (*OauthCode, error) {
+	return withHooks(ctx, occ.sqlSave, occ.mutation, occ.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (occ *OauthCodeCreate) SaveX(ctx context.Context) *OauthCode {
+	v, err := occ.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occ *OauthCodeCreate) Exec(ctx context.Context) error {
+	_, err := occ.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occ *OauthCodeCreate) ExecX(ctx context.Context) {
+	if err := occ.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (occ *OauthCodeCreate) check() error {
+	if _, ok := occ.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "OauthCode.code"`)}
+	}
+	if _, ok := occ.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OauthCode.client_id"`)}
+	}
+	if _, ok := occ.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OauthCode.user_id"`)}
+	}
+	if _, ok := occ.mutation.RedirectURI(); !ok {
+		return &ValidationError{Name: "redirect_uri", err: errors.New(`ent: missing required field "OauthCode.redirect_uri"`)}
+	}
+	if _, ok := occ.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "OauthCode.scopes"`)}
+	}
+	if _, ok := occ.mutation.CodeChallenge(); !ok {
+		return &ValidationError{Name: "code_challenge", err: errors.New(`ent: missing required field "OauthCode.code_challenge"`)}
+	}
+	if v, ok := occ.mutation.CodeChallenge(); ok {
+		if err := oauthcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OauthCode.code_challenge": %w`, err)}
+		}
+	}
+	if _, ok := occ.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OauthCode.expires_at"`)}
+	}
+	if len(occ.mutation.ClientIDs()) == 0 {
+		return &ValidationError{Name: "client", err: errors.New(`ent: missing required edge "OauthCode.client"`)}
+	}
+	if len(occ.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "OauthCode.user"`)}
+	}
+	return nil
+}
+
+func (occ *OauthCodeCreate) sqlSave(ctx context.Context) (*OauthCode, error) {
+	if err := occ.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := occ.createSpec()
+	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	occ.mutation.id = &_node.ID
+	occ.mutation.done = true
+	return _node, nil
+}
+
+func (occ *OauthCodeCreate) createSpec() (*OauthCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OauthCode{config: occ.config}
+		_spec = sqlgraph.NewCreateSpec(oauthcode.Table, sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64))
+	)
+	if id, ok := occ.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := occ.mutation.Code(); ok {
+		_spec.SetField(oauthcode.FieldCode, field.TypeBytes, value)
+		_node.Code = value
+	}
+	if value, ok := occ.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthcode.FieldRedirectURI, field.TypeString, value)
+		_node.RedirectURI = value
+	}
+	if value, ok := occ.mutation.Scopes(); ok {
+		_spec.SetField(oauthcode.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := occ.mutation.Nonce(); ok {
+		_spec.SetField(oauthcode.FieldNonce, field.TypeString, value)
+		_node.Nonce = &value
+	}
+	if value, ok := occ.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthcode.FieldCodeChallenge, field.TypeString, value)
+		_node.CodeChallenge = value
+	}
+	if value, ok := occ.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := occ.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthcode.ClientTable,
+			Columns: []string{oauthcode.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ClientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := occ.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthcode.UserTable,
+			Columns: []string{oauthcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OauthCodeCreateBulk is the builder for creating many OauthCode entities in bulk.
+type OauthCodeCreateBulk struct {
+	config
+	err      error
+	builders []*OauthCodeCreate
+}
+
+// Save creates the OauthCode entities in the database.
+func (occb *OauthCodeCreateBulk) Save(ctx context.Context) ([]*OauthCode, error) {
+	if occb.err != nil {
+		return nil, occb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
+	nodes := make([]*OauthCode, len(occb.builders))
+	mutators := make([]Mutator, len(occb.builders))
+	for i := range occb.builders {
+		func(i int, root context.Context) {
+			builder := occb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OauthCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, occb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, occb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (occb *OauthCodeCreateBulk) SaveX(ctx context.Context) []*OauthCode {
+	v, err := occb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (occb *OauthCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := occb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (occb *OauthCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := occb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
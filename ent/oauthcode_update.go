@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+)
+
+// OauthCodeUpdate is the builder for updating OauthCode entities.
+type OauthCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OauthCodeMutation
+}
+
+// Where appends a list predicates to the OauthCodeUpdate builder.
+func (ocu *OauthCodeUpdate) Where(ps ...predicate.OauthCode) *OauthCodeUpdate {
+	ocu.mutation.Where(ps...)
+	return ocu
+}
+
+// Mutation returns the OauthCodeMutation object of the builder.
+func (ocu *OauthCodeUpdate) Mutation() *OauthCodeMutation {
+	return ocu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OauthCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ocu.sqlSave, ocu.mutation, ocu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OauthCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OauthCodeUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OauthCodeUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocu *OauthCodeUpdate) check() error {
+	if ocu.mutation.ClientCleared() && len(ocu.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OauthCode.client"`)
+	}
+	if ocu.mutation.UserCleared() && len(ocu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OauthCode.user"`)
+	}
+	return nil
+}
+
+func (ocu *OauthCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ocu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthcode.Table, oauthcode.Columns, sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64))
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ocu.mutation.NonceCleared() {
+		_spec.ClearField(oauthcode.FieldNonce, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ocu.mutation.done = true
+	return n, nil
+}
+
+// OauthCodeUpdateOne is the builder for updating a single OauthCode entity.
+type OauthCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OauthCodeMutation
+}
+
+// Mutation returns the OauthCodeMutation object of the builder.
+func (ocuo *OauthCodeUpdateOne) Mutation() *OauthCodeMutation {
+	return ocuo.mutation
+}
+
+// Where appends a list predicates to the OauthCodeUpdate builder.
+func (ocuo *OauthCodeUpdateOne) Where(ps ...predicate.OauthCode) *OauthCodeUpdateOne {
+	ocuo.mutation.Where(ps...)
+	return ocuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ocuo *OauthCodeUpdateOne) Select(field string, fields ...string) *OauthCodeUpdateOne {
+	ocuo.fields = append([]string{field}, fields...)
+	return ocuo
+}
+
+// Save executes the query and returns the updated OauthCode entity.
+func (ocuo *OauthCodeUpdateOne) Save(ctx context.Context) (*OauthCode, error) {
+	return withHooks(ctx, ocuo.sqlSave, ocuo.mutation, ocuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OauthCodeUpdateOne) SaveX(ctx context.Context) *OauthCode {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OauthCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OauthCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocuo *OauthCodeUpdateOne) check() error {
+	if ocuo.mutation.ClientCleared() && len(ocuo.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OauthCode.client"`)
+	}
+	if ocuo.mutation.UserCleared() && len(ocuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OauthCode.user"`)
+	}
+	return nil
+}
+
+func (ocuo *OauthCodeUpdateOne) sqlSave(ctx context.Context) (_node *OauthCode, err error) {
+	if err := ocuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthcode.Table, oauthcode.Columns, sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64))
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OauthCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ocuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthcode.FieldID)
+		for _, f := range fields {
+			if !oauthcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ocuo.mutation.NonceCleared() {
+		_spec.ClearField(oauthcode.FieldNonce, field.TypeString)
+	}
+	_node = &OauthCode{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ocuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+)
+
+// Stores tenants
+type Organization struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// ScimToken holds the value of the "scim_token" field.
+	ScimToken []byte `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrganizationQuery when eager-loading is set.
+	Edges        OrganizationEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// OrganizationEdges holds the relations/edges for other nodes in the graph.
+type OrganizationEdges struct {
+	// Users holds the value of the users edge.
+	Users []*User `json:"users,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// SodConstraints holds the value of the sod_constraints edge.
+	SodConstraints []*SodConstraint `json:"sod_constraints,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// OauthClients holds the value of the oauth_clients edge.
+	OauthClients []*OauthClient `json:"oauth_clients,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [6]bool
+}
+
+// UsersOrErr returns the Users value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) UsersOrErr() ([]*User, error) {
+	if e.loadedTypes[0] {
+		return e.Users, nil
+	}
+	return nil, &NotLoadedError{edge: "users"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[1] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[2] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// SodConstraintsOrErr returns the SodConstraints value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) SodConstraintsOrErr() ([]*SodConstraint, error) {
+	if e.loadedTypes[3] {
+		return e.SodConstraints, nil
+	}
+	return nil, &NotLoadedError{edge: "sod_constraints"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[4] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// OauthClientsOrErr returns the OauthClients value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) OauthClientsOrErr() ([]*OauthClient, error) {
+	if e.loadedTypes[5] {
+		return e.OauthClients, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_clients"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Organization) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case organization.FieldScimToken:
+			values[i] = new([]byte)
+		case organization.FieldID:
+			values[i] = new(sql.NullInt64)
+		case organization.FieldName, organization.FieldDescription:
+			values[i] = new(sql.NullString)
+		case organization.FieldCreatedAt, organization.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Organization fields.
+func (o *Organization) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case organization.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			o.ID = uint32(value.Int64)
+		case organization.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				o.Name = value.String
+			}
+		case organization.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				o.Description = value.String
+			}
+		case organization.FieldScimToken:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scim_token", values[i])
+			} else if value != nil {
+				o.ScimToken = *value
+			}
+		case organization.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				o.CreatedAt = new(time.Time)
+				*o.CreatedAt = value.Time
+			}
+		case organization.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				o.UpdatedAt = new(time.Time)
+				*o.UpdatedAt = value.Time
+			}
+		default:
+			o.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Organization.
+// This includes values selected through modifiers, order, etc.
+func (o *Organization) Value(name string) (ent.Value, error) {
+	return o.selectValues.Get(name)
+}
+
+// QueryUsers queries the "users" edge of the Organization entity.
+func (o *Organization) QueryUsers() *UserQuery {
+	return NewOrganizationClient(o.config).QueryUsers(o)
+}
+
+// QueryRoles queries the "roles" edge of the Organization entity.
+func (o *Organization) QueryRoles() *RoleQuery {
+	return NewOrganizationClient(o.config).QueryRoles(o)
+}
+
+// QueryGroups queries the "groups" edge of the Organization entity.
+func (o *Organization) QueryGroups() *GroupQuery {
+	return NewOrganizationClient(o.config).QueryGroups(o)
+}
+
+// QuerySodConstraints queries the "sod_constraints" edge of the Organization entity.
+func (o *Organization) QuerySodConstraints() *SodConstraintQuery {
+	return NewOrganizationClient(o.config).QuerySodConstraints(o)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Organization entity.
+func (o *Organization) QueryWebhooks() *WebhookQuery {
+	return NewOrganizationClient(o.config).QueryWebhooks(o)
+}
+
+// QueryOauthClients queries the "oauth_clients" edge of the Organization entity.
+func (o *Organization) QueryOauthClients() *OauthClientQuery {
+	return NewOrganizationClient(o.config).QueryOauthClients(o)
+}
+
+// Update returns a builder for updating this Organization.
+// Note that you need to call Organization.Unwrap() before calling this method if this Organization
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (o *Organization) Update() *OrganizationUpdateOne {
+	return NewOrganizationClient(o.config).UpdateOne(o)
+}
+
+// Unwrap unwraps the Organization entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (o *Organization) Unwrap() *Organization {
+	_tx, ok := o.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Organization is not a transactional entity")
+	}
+	o.config.driver = _tx.drv
+	return o
+}
+
+// String implements the fmt.Stringer.
+func (o *Organization) String() string {
+	var builder strings.Builder
+	builder.WriteString("Organization(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
+	builder.WriteString("name=")
+	builder.WriteString(o.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(o.Description)
+	builder.WriteString(", ")
+	builder.WriteString("scim_token=<sensitive>")
+	builder.WriteString(", ")
+	if v := o.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := o.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckOrganizationID returns the "ID" field value.
+func PluckOrganizationID(o *Organization) uint32 {
+	return o.ID
+}
+
+// PluckOrganizationName returns the "name" field value.
+func PluckOrganizationName(o *Organization) string {
+	return o.Name
+}
+
+// PluckOrganizationDescription returns the "description" field value.
+func PluckOrganizationDescription(o *Organization) string {
+	return o.Description
+}
+
+// PluckOrganizationScimToken returns the "scim_token" field value.
+func PluckOrganizationScimToken(o *Organization) []byte {
+	return o.ScimToken
+}
+
+// PluckOrganizationCreatedAt returns the "created_at" field value.
+func PluckOrganizationCreatedAt(o *Organization) *time.Time {
+	return o.CreatedAt
+}
+
+// PluckOrganizationUpdatedAt returns the "updated_at" field value.
+func PluckOrganizationUpdatedAt(o *Organization) *time.Time {
+	return o.UpdatedAt
+}
+
+// Organizations is a parsable slice of Organization.
+type Organizations []*Organization
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/passwordhistory"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Stores hashes of previous passwords of users
+type PasswordHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// Password holds the value of the "password" field.
+	Password string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PasswordHistoryQuery when eager-loading is set.
+	Edges        PasswordHistoryEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// PasswordHistoryEdges holds the relations/edges for other nodes in the graph.
+type PasswordHistoryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PasswordHistoryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PasswordHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case passwordhistory.FieldID, passwordhistory.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case passwordhistory.FieldPassword:
+			values[i] = new(sql.NullString)
+		case passwordhistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PasswordHistory fields.
+func (ph *PasswordHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case passwordhistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ph.ID = uint64(value.Int64)
+		case passwordhistory.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ph.UserID = uint64(value.Int64)
+			}
+		case passwordhistory.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				ph.Password = value.String
+			}
+		case passwordhistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ph.CreatedAt = value.Time
+			}
+		default:
+			ph.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PasswordHistory.
+// This includes values selected through modifiers, order, etc.
+func (ph *PasswordHistory) Value(name string) (ent.Value, error) {
+	return ph.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PasswordHistory entity.
+func (ph *PasswordHistory) QueryUser() *UserQuery {
+	return NewPasswordHistoryClient(ph.config).QueryUser(ph)
+}
+
+// Update returns a builder for updating this PasswordHistory.
+// Note that you need to call PasswordHistory.Unwrap() before calling this method if this PasswordHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ph *PasswordHistory) Update() *PasswordHistoryUpdateOne {
+	return NewPasswordHistoryClient(ph.config).UpdateOne(ph)
+}
+
+// Unwrap unwraps the PasswordHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ph *PasswordHistory) Unwrap() *PasswordHistory {
+	_tx, ok := ph.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PasswordHistory is not a transactional entity")
+	}
+	ph.config.driver = _tx.drv
+	return ph
+}
+
+// String implements the fmt.Stringer.
+func (ph *PasswordHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("PasswordHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ph.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ph.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ph.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckPasswordHistoryID returns the "ID" field value.
+func PluckPasswordHistoryID(ph *PasswordHistory) uint64 {
+	return ph.ID
+}
+
+// PluckPasswordHistoryUserID returns the "user_id" field value.
+func PluckPasswordHistoryUserID(ph *PasswordHistory) uint64 {
+	return ph.UserID
+}
+
+// PluckPasswordHistoryPassword returns the "password" field value.
+func PluckPasswordHistoryPassword(ph *PasswordHistory) string {
+	return ph.Password
+}
+
+// PluckPasswordHistoryCreatedAt returns the "created_at" field value.
+func PluckPasswordHistoryCreatedAt(ph *PasswordHistory) time.Time {
+	return ph.CreatedAt
+}
+
+// PasswordHistories is a parsable slice of PasswordHistory.
+type PasswordHistories []*PasswordHistory
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/passwordhistory"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// PasswordHistoryCreate is the builder for creating a PasswordHistory entity.
+type PasswordHistoryCreate struct {
+	config
+	mutation *PasswordHistoryMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (phc *PasswordHistoryCreate) SetUserID(u uint64) *PasswordHistoryCreate {
+	phc.mutation.SetUserID(u)
+	return phc
+}
+
+// SetPassword sets the "password" field.
+func (phc *PasswordHistoryCreate) SetPassword(s string) *PasswordHistoryCreate {
+	phc.mutation.SetPassword(s)
+	return phc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (phc *PasswordHistoryCreate) SetCreatedAt(t time.Time) *PasswordHistoryCreate {
+	phc.mutation.SetCreatedAt(t)
+	return phc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (phc *PasswordHistoryCreate) SetNillableCreatedAt(t *time.Time) *PasswordHistoryCreate {
+	if t != nil {
+		phc.SetCreatedAt(*t)
+	}
+	return phc
+}
+
+// SetID sets the "id" field.
+func (phc *PasswordHistoryCreate) SetID(u uint64) *PasswordHistoryCreate {
+	phc.mutation.SetID(u)
+	return phc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (phc *PasswordHistoryCreate) SetUser(u *User) *PasswordHistoryCreate {
+	return phc.SetUserID(u.ID)
+}
+
+// Mutation returns the PasswordHistoryMutation object of the builder.
+func (phc *PasswordHistoryCreate) Mutation() *PasswordHistoryMutation {
+	return phc.mutation
+}
+
+// Save creates the PasswordHistory in the database.
+func (phc *PasswordHistoryCreate) Save(ctx context.Context) (*PasswordHistory, error) {
+	phc.defaults()
+	return withHooks(ctx, phc.sqlSave, phc.mutation, phc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (phc *PasswordHistoryCreate) SaveX(ctx context.Context) *PasswordHistory {
+	v, err := phc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (phc *PasswordHistoryCreate) Exec(ctx context.Context) error {
+	_, err := phc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phc *PasswordHistoryCreate) ExecX(ctx context.Context) {
+	if err := phc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (phc *PasswordHistoryCreate) defaults() {
+	if _, ok := phc.mutation.CreatedAt(); !ok {
+		v := passwordhistory.DefaultCreatedAt()
+		phc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phc *PasswordHistoryCreate) check() error {
+	if _, ok := phc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PasswordHistory.user_id"`)}
+	}
+	if _, ok := phc.mutation.Password(); !ok {
+		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "PasswordHistory.password"`)}
+	}
+	if v, ok := phc.mutation.Password(); ok {
+		if err := passwordhistory.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "PasswordHistory.password": %w`, err)}
+		}
+	}
+	if _, ok := phc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PasswordHistory.created_at"`)}
+	}
+	if len(phc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PasswordHistory.user"`)}
+	}
+	return nil
+}
+
+func (phc *PasswordHistoryCreate) sqlSave(ctx context.Context) (*PasswordHistory, error) {
+	if err := phc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := phc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, phc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	phc.mutation.id = &_node.ID
+	phc.mutation.done = true
+	return _node, nil
+}
+
+func (phc *PasswordHistoryCreate) createSpec() (*PasswordHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PasswordHistory{config: phc.config}
+		_spec = sqlgraph.NewCreateSpec(passwordhistory.Table, sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeUint64))
+	)
+	if id, ok := phc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := phc.mutation.Password(); ok {
+		_spec.SetField(passwordhistory.FieldPassword, field.TypeString, value)
+		_node.Password = value
+	}
+	if value, ok := phc.mutation.CreatedAt(); ok {
+		_spec.SetField(passwordhistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := phc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordhistory.UserTable,
+			Columns: []string{passwordhistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PasswordHistoryCreateBulk is the builder for creating many PasswordHistory entities in bulk.
+type PasswordHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*PasswordHistoryCreate
+}
+
+// Save creates the PasswordHistory entities in the database.
+func (phcb *PasswordHistoryCreateBulk) Save(ctx context.Context) ([]*PasswordHistory, error) {
+	if phcb.err != nil {
+		return nil, phcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(phcb.builders))
+	nodes := make([]*PasswordHistory, len(phcb.builders))
+	mutators := make([]Mutator, len(phcb.builders))
+	for i := range phcb.builders {
+		func(i int, root context.Context) {
+			builder := phcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasswordHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, phcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, phcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, phcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phcb *PasswordHistoryCreateBulk) SaveX(ctx context.Context) []*PasswordHistory {
+	v, err := phcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (phcb *PasswordHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := phcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phcb *PasswordHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := phcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/passwordhistory"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+)
+
+// PasswordHistoryUpdate is the builder for updating PasswordHistory entities.
+type PasswordHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasswordHistoryMutation
+}
+
+// Where appends a list predicates to the PasswordHistoryUpdate builder.
+func (phu *PasswordHistoryUpdate) Where(ps ...predicate.PasswordHistory) *PasswordHistoryUpdate {
+	phu.mutation.Where(ps...)
+	return phu
+}
+
+// Mutation returns the PasswordHistoryMutation object of the builder.
+func (phu *PasswordHistoryUpdate) Mutation() *PasswordHistoryMutation {
+	return phu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (phu *PasswordHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, phu.sqlSave, phu.mutation, phu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phu *PasswordHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := phu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (phu *PasswordHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := phu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phu *PasswordHistoryUpdate) ExecX(ctx context.Context) {
+	if err := phu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phu *PasswordHistoryUpdate) check() error {
+	if phu.mutation.UserCleared() && len(phu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PasswordHistory.user"`)
+	}
+	return nil
+}
+
+func (phu *PasswordHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := phu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordhistory.Table, passwordhistory.Columns, sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeUint64))
+	if ps := phu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, phu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	phu.mutation.done = true
+	return n, nil
+}
+
+// PasswordHistoryUpdateOne is the builder for updating a single PasswordHistory entity.
+type PasswordHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasswordHistoryMutation
+}
+
+// Mutation returns the PasswordHistoryMutation object of the builder.
+func (phuo *PasswordHistoryUpdateOne) Mutation() *PasswordHistoryMutation {
+	return phuo.mutation
+}
+
+// Where appends a list predicates to the PasswordHistoryUpdate builder.
+func (phuo *PasswordHistoryUpdateOne) Where(ps ...predicate.PasswordHistory) *PasswordHistoryUpdateOne {
+	phuo.mutation.Where(ps...)
+	return phuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (phuo *PasswordHistoryUpdateOne) Select(field string, fields ...string) *PasswordHistoryUpdateOne {
+	phuo.fields = append([]string{field}, fields...)
+	return phuo
+}
+
+// Save executes the query and returns the updated PasswordHistory entity.
+func (phuo *PasswordHistoryUpdateOne) Save(ctx context.Context) (*PasswordHistory, error) {
+	return withHooks(ctx, phuo.sqlSave, phuo.mutation, phuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phuo *PasswordHistoryUpdateOne) SaveX(ctx context.Context) *PasswordHistory {
+	node, err := phuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (phuo *PasswordHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := phuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phuo *PasswordHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := phuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phuo *PasswordHistoryUpdateOne) check() error {
+	if phuo.mutation.UserCleared() && len(phuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PasswordHistory.user"`)
+	}
+	return nil
+}
+
+func (phuo *PasswordHistoryUpdateOne) sqlSave(ctx context.Context) (_node *PasswordHistory, err error) {
+	if err := phuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordhistory.Table, passwordhistory.Columns, sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeUint64))
+	id, ok := phuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasswordHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := phuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordhistory.FieldID)
+		for _, f := range fields {
+			if !passwordhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passwordhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := phuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &PasswordHistory{config: phuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, phuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	phuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/passwordreset"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+)
+
+// PasswordResetUpdate is the builder for updating PasswordReset entities.
+type PasswordResetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasswordResetMutation
+}
+
+// Where appends a list predicates to the PasswordResetUpdate builder.
+func (pru *PasswordResetUpdate) Where(ps ...predicate.PasswordReset) *PasswordResetUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetUsedAt sets the "used_at" field.
+func (pru *PasswordResetUpdate) SetUsedAt(t time.Time) *PasswordResetUpdate {
+	pru.mutation.SetUsedAt(t)
+	return pru
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableUsedAt(t *time.Time) *PasswordResetUpdate {
+	if t != nil {
+		pru.SetUsedAt(*t)
+	}
+	return pru
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (pru *PasswordResetUpdate) ClearUsedAt() *PasswordResetUpdate {
+	pru.mutation.ClearUsedAt()
+	return pru
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (pru *PasswordResetUpdate) Mutation() *PasswordResetMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PasswordResetUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PasswordResetUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PasswordResetUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PasswordResetUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PasswordResetUpdate) check() error {
+	if pru.mutation.UserCleared() && len(pru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PasswordReset.user"`)
+	}
+	return nil
+}
+
+func (pru *PasswordResetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordreset.Table, passwordreset.Columns, sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeUint64))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.UsedAt(); ok {
+		_spec.SetField(passwordreset.FieldUsedAt, field.TypeTime, value)
+	}
+	if pru.mutation.UsedAtCleared() {
+		_spec.ClearField(passwordreset.FieldUsedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PasswordResetUpdateOne is the builder for updating a single PasswordReset entity.
+type PasswordResetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasswordResetMutation
+}
+
+// SetUsedAt sets the "used_at" field.
+func (pruo *PasswordResetUpdateOne) SetUsedAt(t time.Time) *PasswordResetUpdateOne {
+	pruo.mutation.SetUsedAt(t)
+	return pruo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableUsedAt(t *time.Time) *PasswordResetUpdateOne {
+	if t != nil {
+		pruo.SetUsedAt(*t)
+	}
+	return pruo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (pruo *PasswordResetUpdateOne) ClearUsedAt() *PasswordResetUpdateOne {
+	pruo.mutation.ClearUsedAt()
+	return pruo
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (pruo *PasswordResetUpdateOne) Mutation() *PasswordResetMutation {
+	return pruo.mutation
+}
+
+// Where appends a list predicates to the PasswordResetUpdate builder.
+func (pruo *PasswordResetUpdateOne) Where(ps ...predicate.PasswordReset) *PasswordResetUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PasswordResetUpdateOne) Select(field string, fields ...string) *PasswordResetUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PasswordReset entity.
+func (pruo *PasswordResetUpdateOne) Save(ctx context.Context) (*PasswordReset, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PasswordResetUpdateOne) SaveX(ctx context.Context) *PasswordReset {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PasswordResetUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PasswordResetUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PasswordResetUpdateOne) check() error {
+	if pruo.mutation.UserCleared() && len(pruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PasswordReset.user"`)
+	}
+	return nil
+}
+
+func (pruo *PasswordResetUpdateOne) sqlSave(ctx context.Context) (_node *PasswordReset, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordreset.Table, passwordreset.Columns, sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeUint64))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasswordReset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordreset.FieldID)
+		for _, f := range fields {
+			if !passwordreset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passwordreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.UsedAt(); ok {
+		_spec.SetField(passwordreset.FieldUsedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.UsedAtCleared() {
+		_spec.ClearField(passwordreset.FieldUsedAt, field.TypeTime)
+	}
+	_node = &PasswordReset{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
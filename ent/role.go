@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
 	"github.com/eidng8/go-attr-rbac/ent/role"
 )
 
@@ -17,10 +18,14 @@ type Role struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uint32 `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uint32 `json:"organization_id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// RequireMfa holds the value of the "require_mfa" field.
+	RequireMfa bool `json:"require_mfa,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -33,19 +38,40 @@ type Role struct {
 
 // RoleEdges holds the relations/edges for other nodes in the graph.
 type RoleEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// Permissions holds the value of the permissions edge.
 	Permissions []*Permission `json:"permissions,omitempty"`
 	// Users holds the value of the users edge.
 	Users []*User `json:"users,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// Approvers holds the value of the approvers edge.
+	Approvers []*User `json:"approvers,omitempty"`
+	// SodConstraints holds the value of the sod_constraints edge.
+	SodConstraints []*SodConstraint `json:"sod_constraints,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*UserRole `json:"assignments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [7]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
 }
 
 // PermissionsOrErr returns the Permissions value or an error if the edge
 // was not loaded in eager-loading.
 func (e RoleEdges) PermissionsOrErr() ([]*Permission, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		return e.Permissions, nil
 	}
 	return nil, &NotLoadedError{edge: "permissions"}
@@ -54,18 +80,56 @@ func (e RoleEdges) PermissionsOrErr() ([]*Permission, error) {
 // UsersOrErr returns the Users value or an error if the edge
 // was not loaded in eager-loading.
 func (e RoleEdges) UsersOrErr() ([]*User, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Users, nil
 	}
 	return nil, &NotLoadedError{edge: "users"}
 }
 
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[3] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// ApproversOrErr returns the Approvers value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) ApproversOrErr() ([]*User, error) {
+	if e.loadedTypes[4] {
+		return e.Approvers, nil
+	}
+	return nil, &NotLoadedError{edge: "approvers"}
+}
+
+// SodConstraintsOrErr returns the SodConstraints value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) SodConstraintsOrErr() ([]*SodConstraint, error) {
+	if e.loadedTypes[5] {
+		return e.SodConstraints, nil
+	}
+	return nil, &NotLoadedError{edge: "sod_constraints"}
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) AssignmentsOrErr() ([]*UserRole, error) {
+	if e.loadedTypes[6] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Role) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case role.FieldID:
+		case role.FieldRequireMfa:
+			values[i] = new(sql.NullBool)
+		case role.FieldID, role.FieldOrganizationID:
 			values[i] = new(sql.NullInt64)
 		case role.FieldName, role.FieldDescription:
 			values[i] = new(sql.NullString)
@@ -92,6 +156,12 @@ func (r *Role) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			r.ID = uint32(value.Int64)
+		case role.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				r.OrganizationID = uint32(value.Int64)
+			}
 		case role.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -104,6 +174,12 @@ func (r *Role) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.Description = value.String
 			}
+		case role.FieldRequireMfa:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_mfa", values[i])
+			} else if value.Valid {
+				r.RequireMfa = value.Bool
+			}
 		case role.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -131,6 +207,11 @@ func (r *Role) Value(name string) (ent.Value, error) {
 	return r.selectValues.Get(name)
 }
 
+// QueryOrganization queries the "organization" edge of the Role entity.
+func (r *Role) QueryOrganization() *OrganizationQuery {
+	return NewRoleClient(r.config).QueryOrganization(r)
+}
+
 // QueryPermissions queries the "permissions" edge of the Role entity.
 func (r *Role) QueryPermissions() *PermissionQuery {
 	return NewRoleClient(r.config).QueryPermissions(r)
@@ -141,6 +222,26 @@ func (r *Role) QueryUsers() *UserQuery {
 	return NewRoleClient(r.config).QueryUsers(r)
 }
 
+// QueryGroups queries the "groups" edge of the Role entity.
+func (r *Role) QueryGroups() *GroupQuery {
+	return NewRoleClient(r.config).QueryGroups(r)
+}
+
+// QueryApprovers queries the "approvers" edge of the Role entity.
+func (r *Role) QueryApprovers() *UserQuery {
+	return NewRoleClient(r.config).QueryApprovers(r)
+}
+
+// QuerySodConstraints queries the "sod_constraints" edge of the Role entity.
+func (r *Role) QuerySodConstraints() *SodConstraintQuery {
+	return NewRoleClient(r.config).QuerySodConstraints(r)
+}
+
+// QueryAssignments queries the "assignments" edge of the Role entity.
+func (r *Role) QueryAssignments() *UserRoleQuery {
+	return NewRoleClient(r.config).QueryAssignments(r)
+}
+
 // Update returns a builder for updating this Role.
 // Note that you need to call Role.Unwrap() before calling this method if this Role
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -164,12 +265,18 @@ func (r *Role) String() string {
 	var builder strings.Builder
 	builder.WriteString("Role(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.OrganizationID))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(r.Name)
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(r.Description)
 	builder.WriteString(", ")
+	builder.WriteString("require_mfa=")
+	builder.WriteString(fmt.Sprintf("%v", r.RequireMfa))
+	builder.WriteString(", ")
 	if v := r.CreatedAt; v != nil {
 		builder.WriteString("created_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -188,6 +295,11 @@ func PluckRoleID(r *Role) uint32 {
 	return r.ID
 }
 
+// PluckRoleOrganizationID returns the "organization_id" field value.
+func PluckRoleOrganizationID(r *Role) uint32 {
+	return r.OrganizationID
+}
+
 // PluckRoleName returns the "name" field value.
 func PluckRoleName(r *Role) string {
 	return r.Name
@@ -198,6 +310,11 @@ func PluckRoleDescription(r *Role) string {
 	return r.Description
 }
 
+// PluckRoleRequireMfa returns the "require_mfa" field value.
+func PluckRoleRequireMfa(r *Role) bool {
+	return r.RequireMfa
+}
+
 // PluckRoleCreatedAt returns the "created_at" field value.
 func PluckRoleCreatedAt(r *Role) *time.Time {
 	return r.CreatedAt
@@ -10,9 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
 	"github.com/eidng8/go-attr-rbac/ent/permission"
 	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
 	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
 )
 
 // RoleCreate is the builder for creating a Role entity.
@@ -22,6 +26,20 @@ type RoleCreate struct {
 	hooks    []Hook
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (rc *RoleCreate) SetOrganizationID(u uint32) *RoleCreate {
+	rc.mutation.SetOrganizationID(u)
+	return rc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableOrganizationID(u *uint32) *RoleCreate {
+	if u != nil {
+		rc.SetOrganizationID(*u)
+	}
+	return rc
+}
+
 // SetName sets the "name" field.
 func (rc *RoleCreate) SetName(s string) *RoleCreate {
 	rc.mutation.SetName(s)
@@ -42,6 +60,20 @@ func (rc *RoleCreate) SetNillableDescription(s *string) *RoleCreate {
 	return rc
 }
 
+// SetRequireMfa sets the "require_mfa" field.
+func (rc *RoleCreate) SetRequireMfa(b bool) *RoleCreate {
+	rc.mutation.SetRequireMfa(b)
+	return rc
+}
+
+// SetNillableRequireMfa sets the "require_mfa" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableRequireMfa(b *bool) *RoleCreate {
+	if b != nil {
+		rc.SetRequireMfa(*b)
+	}
+	return rc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rc *RoleCreate) SetCreatedAt(t time.Time) *RoleCreate {
 	rc.mutation.SetCreatedAt(t)
@@ -76,6 +108,11 @@ func (rc *RoleCreate) SetID(u uint32) *RoleCreate {
 	return rc
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (rc *RoleCreate) SetOrganization(o *Organization) *RoleCreate {
+	return rc.SetOrganizationID(o.ID)
+}
+
 // AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
 func (rc *RoleCreate) AddPermissionIDs(ids ...uint32) *RoleCreate {
 	rc.mutation.AddPermissionIDs(ids...)
@@ -106,6 +143,66 @@ func (rc *RoleCreate) AddUsers(u ...*User) *RoleCreate {
 	return rc.AddUserIDs(ids...)
 }
 
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (rc *RoleCreate) AddGroupIDs(ids ...uint32) *RoleCreate {
+	rc.mutation.AddGroupIDs(ids...)
+	return rc
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (rc *RoleCreate) AddGroups(g ...*Group) *RoleCreate {
+	ids := make([]uint32, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return rc.AddGroupIDs(ids...)
+}
+
+// AddApproverIDs adds the "approvers" edge to the User entity by IDs.
+func (rc *RoleCreate) AddApproverIDs(ids ...uint64) *RoleCreate {
+	rc.mutation.AddApproverIDs(ids...)
+	return rc
+}
+
+// AddApprovers adds the "approvers" edges to the User entity.
+func (rc *RoleCreate) AddApprovers(u ...*User) *RoleCreate {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return rc.AddApproverIDs(ids...)
+}
+
+// AddSodConstraintIDs adds the "sod_constraints" edge to the SodConstraint entity by IDs.
+func (rc *RoleCreate) AddSodConstraintIDs(ids ...uint32) *RoleCreate {
+	rc.mutation.AddSodConstraintIDs(ids...)
+	return rc
+}
+
+// AddSodConstraints adds the "sod_constraints" edges to the SodConstraint entity.
+func (rc *RoleCreate) AddSodConstraints(s ...*SodConstraint) *RoleCreate {
+	ids := make([]uint32, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rc.AddSodConstraintIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the UserRole entity by IDs.
+func (rc *RoleCreate) AddAssignmentIDs(ids ...uint64) *RoleCreate {
+	rc.mutation.AddAssignmentIDs(ids...)
+	return rc
+}
+
+// AddAssignments adds the "assignments" edges to the UserRole entity.
+func (rc *RoleCreate) AddAssignments(u ...*UserRole) *RoleCreate {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return rc.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (rc *RoleCreate) Mutation() *RoleMutation {
 	return rc.mutation
@@ -141,6 +238,14 @@ func (rc *RoleCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (rc *RoleCreate) defaults() {
+	if _, ok := rc.mutation.OrganizationID(); !ok {
+		v := role.DefaultOrganizationID
+		rc.mutation.SetOrganizationID(v)
+	}
+	if _, ok := rc.mutation.RequireMfa(); !ok {
+		v := role.DefaultRequireMfa
+		rc.mutation.SetRequireMfa(v)
+	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
 		v := role.DefaultCreatedAt()
 		rc.mutation.SetCreatedAt(v)
@@ -149,6 +254,9 @@ func (rc *RoleCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (rc *RoleCreate) check() error {
+	if _, ok := rc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "Role.organization_id"`)}
+	}
 	if _, ok := rc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Role.name"`)}
 	}
@@ -157,6 +265,12 @@ func (rc *RoleCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Role.name": %w`, err)}
 		}
 	}
+	if _, ok := rc.mutation.RequireMfa(); !ok {
+		return &ValidationError{Name: "require_mfa", err: errors.New(`ent: missing required field "Role.require_mfa"`)}
+	}
+	if len(rc.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "Role.organization"`)}
+	}
 	return nil
 }
 
@@ -197,6 +311,10 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := rc.mutation.RequireMfa(); ok {
+		_spec.SetField(role.FieldRequireMfa, field.TypeBool, value)
+		_node.RequireMfa = value
+	}
 	if value, ok := rc.mutation.CreatedAt(); ok {
 		_spec.SetField(role.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = &value
@@ -205,6 +323,23 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = &value
 	}
+	if nodes := rc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   role.OrganizationTable,
+			Columns: []string{role.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := rc.mutation.PermissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -237,6 +372,70 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.GroupsTable,
+			Columns: role.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.ApproversIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.ApproversTable,
+			Columns: role.ApproversPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.SodConstraintsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SodConstraintsTable,
+			Columns: role.SodConstraintsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   role.AssignmentsTable,
+			Columns: []string{role.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -10,10 +10,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/group"
 	"github.com/eidng8/go-attr-rbac/ent/permission"
 	"github.com/eidng8/go-attr-rbac/ent/predicate"
 	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
 	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
 )
 
 // RoleUpdate is the builder for updating Role entities.
@@ -63,6 +66,20 @@ func (ru *RoleUpdate) ClearDescription() *RoleUpdate {
 	return ru
 }
 
+// SetRequireMfa sets the "require_mfa" field.
+func (ru *RoleUpdate) SetRequireMfa(b bool) *RoleUpdate {
+	ru.mutation.SetRequireMfa(b)
+	return ru
+}
+
+// SetNillableRequireMfa sets the "require_mfa" field if the given value is not nil.
+func (ru *RoleUpdate) SetNillableRequireMfa(b *bool) *RoleUpdate {
+	if b != nil {
+		ru.SetRequireMfa(*b)
+	}
+	return ru
+}
+
 // AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
 func (ru *RoleUpdate) AddPermissionIDs(ids ...uint32) *RoleUpdate {
 	ru.mutation.AddPermissionIDs(ids...)
@@ -93,6 +110,66 @@ func (ru *RoleUpdate) AddUsers(u ...*User) *RoleUpdate {
 	return ru.AddUserIDs(ids...)
 }
 
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (ru *RoleUpdate) AddGroupIDs(ids ...uint32) *RoleUpdate {
+	ru.mutation.AddGroupIDs(ids...)
+	return ru
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (ru *RoleUpdate) AddGroups(g ...*Group) *RoleUpdate {
+	ids := make([]uint32, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ru.AddGroupIDs(ids...)
+}
+
+// AddApproverIDs adds the "approvers" edge to the User entity by IDs.
+func (ru *RoleUpdate) AddApproverIDs(ids ...uint64) *RoleUpdate {
+	ru.mutation.AddApproverIDs(ids...)
+	return ru
+}
+
+// AddApprovers adds the "approvers" edges to the User entity.
+func (ru *RoleUpdate) AddApprovers(u ...*User) *RoleUpdate {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ru.AddApproverIDs(ids...)
+}
+
+// AddSodConstraintIDs adds the "sod_constraints" edge to the SodConstraint entity by IDs.
+func (ru *RoleUpdate) AddSodConstraintIDs(ids ...uint32) *RoleUpdate {
+	ru.mutation.AddSodConstraintIDs(ids...)
+	return ru
+}
+
+// AddSodConstraints adds the "sod_constraints" edges to the SodConstraint entity.
+func (ru *RoleUpdate) AddSodConstraints(s ...*SodConstraint) *RoleUpdate {
+	ids := make([]uint32, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.AddSodConstraintIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the UserRole entity by IDs.
+func (ru *RoleUpdate) AddAssignmentIDs(ids ...uint64) *RoleUpdate {
+	ru.mutation.AddAssignmentIDs(ids...)
+	return ru
+}
+
+// AddAssignments adds the "assignments" edges to the UserRole entity.
+func (ru *RoleUpdate) AddAssignments(u ...*UserRole) *RoleUpdate {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ru.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ru *RoleUpdate) Mutation() *RoleMutation {
 	return ru.mutation
@@ -140,6 +217,90 @@ func (ru *RoleUpdate) RemoveUsers(u ...*User) *RoleUpdate {
 	return ru.RemoveUserIDs(ids...)
 }
 
+// ClearGroups clears all "groups" edges to the Group entity.
+func (ru *RoleUpdate) ClearGroups() *RoleUpdate {
+	ru.mutation.ClearGroups()
+	return ru
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (ru *RoleUpdate) RemoveGroupIDs(ids ...uint32) *RoleUpdate {
+	ru.mutation.RemoveGroupIDs(ids...)
+	return ru
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (ru *RoleUpdate) RemoveGroups(g ...*Group) *RoleUpdate {
+	ids := make([]uint32, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ru.RemoveGroupIDs(ids...)
+}
+
+// ClearApprovers clears all "approvers" edges to the User entity.
+func (ru *RoleUpdate) ClearApprovers() *RoleUpdate {
+	ru.mutation.ClearApprovers()
+	return ru
+}
+
+// RemoveApproverIDs removes the "approvers" edge to User entities by IDs.
+func (ru *RoleUpdate) RemoveApproverIDs(ids ...uint64) *RoleUpdate {
+	ru.mutation.RemoveApproverIDs(ids...)
+	return ru
+}
+
+// RemoveApprovers removes "approvers" edges to User entities.
+func (ru *RoleUpdate) RemoveApprovers(u ...*User) *RoleUpdate {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ru.RemoveApproverIDs(ids...)
+}
+
+// ClearSodConstraints clears all "sod_constraints" edges to the SodConstraint entity.
+func (ru *RoleUpdate) ClearSodConstraints() *RoleUpdate {
+	ru.mutation.ClearSodConstraints()
+	return ru
+}
+
+// RemoveSodConstraintIDs removes the "sod_constraints" edge to SodConstraint entities by IDs.
+func (ru *RoleUpdate) RemoveSodConstraintIDs(ids ...uint32) *RoleUpdate {
+	ru.mutation.RemoveSodConstraintIDs(ids...)
+	return ru
+}
+
+// RemoveSodConstraints removes "sod_constraints" edges to SodConstraint entities.
+func (ru *RoleUpdate) RemoveSodConstraints(s ...*SodConstraint) *RoleUpdate {
+	ids := make([]uint32, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.RemoveSodConstraintIDs(ids...)
+}
+
+// ClearAssignments clears all "assignments" edges to the UserRole entity.
+func (ru *RoleUpdate) ClearAssignments() *RoleUpdate {
+	ru.mutation.ClearAssignments()
+	return ru
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to UserRole entities by IDs.
+func (ru *RoleUpdate) RemoveAssignmentIDs(ids ...uint64) *RoleUpdate {
+	ru.mutation.RemoveAssignmentIDs(ids...)
+	return ru
+}
+
+// RemoveAssignments removes "assignments" edges to UserRole entities.
+func (ru *RoleUpdate) RemoveAssignments(u ...*UserRole) *RoleUpdate {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ru.RemoveAssignmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *RoleUpdate) Save(ctx context.Context) (int, error) {
 	ru.defaults()
@@ -183,6 +344,9 @@ func (ru *RoleUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Role.name": %w`, err)}
 		}
 	}
+	if ru.mutation.OrganizationCleared() && len(ru.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Role.organization"`)
+	}
 	return nil
 }
 
@@ -207,6 +371,9 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ru.mutation.DescriptionCleared() {
 		_spec.ClearField(role.FieldDescription, field.TypeString)
 	}
+	if value, ok := ru.mutation.RequireMfa(); ok {
+		_spec.SetField(role.FieldRequireMfa, field.TypeBool, value)
+	}
 	if ru.mutation.CreatedAtCleared() {
 		_spec.ClearField(role.FieldCreatedAt, field.TypeTime)
 	}
@@ -306,6 +473,186 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.GroupsTable,
+			Columns: role.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !ru.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.GroupsTable,
+			Columns: role.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.GroupsTable,
+			Columns: role.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.ApproversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.ApproversTable,
+			Columns: role.ApproversPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedApproversIDs(); len(nodes) > 0 && !ru.mutation.ApproversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.ApproversTable,
+			Columns: role.ApproversPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ApproversIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.ApproversTable,
+			Columns: role.ApproversPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.SodConstraintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SodConstraintsTable,
+			Columns: role.SodConstraintsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedSodConstraintsIDs(); len(nodes) > 0 && !ru.mutation.SodConstraintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SodConstraintsTable,
+			Columns: role.SodConstraintsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.SodConstraintsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SodConstraintsTable,
+			Columns: role.SodConstraintsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   role.AssignmentsTable,
+			Columns: []string{role.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !ru.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   role.AssignmentsTable,
+			Columns: []string{role.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   role.AssignmentsTable,
+			Columns: []string{role.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{role.Label}
@@ -360,6 +707,20 @@ func (ruo *RoleUpdateOne) ClearDescription() *RoleUpdateOne {
 	return ruo
 }
 
+// SetRequireMfa sets the "require_mfa" field.
+func (ruo *RoleUpdateOne) SetRequireMfa(b bool) *RoleUpdateOne {
+	ruo.mutation.SetRequireMfa(b)
+	return ruo
+}
+
+// SetNillableRequireMfa sets the "require_mfa" field if the given value is not nil.
+func (ruo *RoleUpdateOne) SetNillableRequireMfa(b *bool) *RoleUpdateOne {
+	if b != nil {
+		ruo.SetRequireMfa(*b)
+	}
+	return ruo
+}
+
 // AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
 func (ruo *RoleUpdateOne) AddPermissionIDs(ids ...uint32) *RoleUpdateOne {
 	ruo.mutation.AddPermissionIDs(ids...)
@@ -390,6 +751,66 @@ func (ruo *RoleUpdateOne) AddUsers(u ...*User) *RoleUpdateOne {
 	return ruo.AddUserIDs(ids...)
 }
 
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (ruo *RoleUpdateOne) AddGroupIDs(ids ...uint32) *RoleUpdateOne {
+	ruo.mutation.AddGroupIDs(ids...)
+	return ruo
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (ruo *RoleUpdateOne) AddGroups(g ...*Group) *RoleUpdateOne {
+	ids := make([]uint32, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ruo.AddGroupIDs(ids...)
+}
+
+// AddApproverIDs adds the "approvers" edge to the User entity by IDs.
+func (ruo *RoleUpdateOne) AddApproverIDs(ids ...uint64) *RoleUpdateOne {
+	ruo.mutation.AddApproverIDs(ids...)
+	return ruo
+}
+
+// AddApprovers adds the "approvers" edges to the User entity.
+func (ruo *RoleUpdateOne) AddApprovers(u ...*User) *RoleUpdateOne {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ruo.AddApproverIDs(ids...)
+}
+
+// AddSodConstraintIDs adds the "sod_constraints" edge to the SodConstraint entity by IDs.
+func (ruo *RoleUpdateOne) AddSodConstraintIDs(ids ...uint32) *RoleUpdateOne {
+	ruo.mutation.AddSodConstraintIDs(ids...)
+	return ruo
+}
+
+// AddSodConstraints adds the "sod_constraints" edges to the SodConstraint entity.
+func (ruo *RoleUpdateOne) AddSodConstraints(s ...*SodConstraint) *RoleUpdateOne {
+	ids := make([]uint32, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.AddSodConstraintIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the UserRole entity by IDs.
+func (ruo *RoleUpdateOne) AddAssignmentIDs(ids ...uint64) *RoleUpdateOne {
+	ruo.mutation.AddAssignmentIDs(ids...)
+	return ruo
+}
+
+// AddAssignments adds the "assignments" edges to the UserRole entity.
+func (ruo *RoleUpdateOne) AddAssignments(u ...*UserRole) *RoleUpdateOne {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ruo.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ruo *RoleUpdateOne) Mutation() *RoleMutation {
 	return ruo.mutation
@@ -437,6 +858,90 @@ func (ruo *RoleUpdateOne) RemoveUsers(u ...*User) *RoleUpdateOne {
 	return ruo.RemoveUserIDs(ids...)
 }
 
+// ClearGroups clears all "groups" edges to the Group entity.
+func (ruo *RoleUpdateOne) ClearGroups() *RoleUpdateOne {
+	ruo.mutation.ClearGroups()
+	return ruo
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (ruo *RoleUpdateOne) RemoveGroupIDs(ids ...uint32) *RoleUpdateOne {
+	ruo.mutation.RemoveGroupIDs(ids...)
+	return ruo
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (ruo *RoleUpdateOne) RemoveGroups(g ...*Group) *RoleUpdateOne {
+	ids := make([]uint32, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ruo.RemoveGroupIDs(ids...)
+}
+
+// ClearApprovers clears all "approvers" edges to the User entity.
+func (ruo *RoleUpdateOne) ClearApprovers() *RoleUpdateOne {
+	ruo.mutation.ClearApprovers()
+	return ruo
+}
+
+// RemoveApproverIDs removes the "approvers" edge to User entities by IDs.
+func (ruo *RoleUpdateOne) RemoveApproverIDs(ids ...uint64) *RoleUpdateOne {
+	ruo.mutation.RemoveApproverIDs(ids...)
+	return ruo
+}
+
+// RemoveApprovers removes "approvers" edges to User entities.
+func (ruo *RoleUpdateOne) RemoveApprovers(u ...*User) *RoleUpdateOne {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ruo.RemoveApproverIDs(ids...)
+}
+
+// ClearSodConstraints clears all "sod_constraints" edges to the SodConstraint entity.
+func (ruo *RoleUpdateOne) ClearSodConstraints() *RoleUpdateOne {
+	ruo.mutation.ClearSodConstraints()
+	return ruo
+}
+
+// RemoveSodConstraintIDs removes the "sod_constraints" edge to SodConstraint entities by IDs.
+func (ruo *RoleUpdateOne) RemoveSodConstraintIDs(ids ...uint32) *RoleUpdateOne {
+	ruo.mutation.RemoveSodConstraintIDs(ids...)
+	return ruo
+}
+
+// RemoveSodConstraints removes "sod_constraints" edges to SodConstraint entities.
+func (ruo *RoleUpdateOne) RemoveSodConstraints(s ...*SodConstraint) *RoleUpdateOne {
+	ids := make([]uint32, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.RemoveSodConstraintIDs(ids...)
+}
+
+// ClearAssignments clears all "assignments" edges to the UserRole entity.
+func (ruo *RoleUpdateOne) ClearAssignments() *RoleUpdateOne {
+	ruo.mutation.ClearAssignments()
+	return ruo
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to UserRole entities by IDs.
+func (ruo *RoleUpdateOne) RemoveAssignmentIDs(ids ...uint64) *RoleUpdateOne {
+	ruo.mutation.RemoveAssignmentIDs(ids...)
+	return ruo
+}
+
+// RemoveAssignments removes "assignments" edges to UserRole entities.
+func (ruo *RoleUpdateOne) RemoveAssignments(u ...*UserRole) *RoleUpdateOne {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ruo.RemoveAssignmentIDs(ids...)
+}
+
 // Where appends a list predicates to the RoleUpdate builder.
 func (ruo *RoleUpdateOne) Where(ps ...predicate.Role) *RoleUpdateOne {
 	ruo.mutation.Where(ps...)
@@ -493,6 +998,9 @@ func (ruo *RoleUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Role.name": %w`, err)}
 		}
 	}
+	if ruo.mutation.OrganizationCleared() && len(ruo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Role.organization"`)
+	}
 	return nil
 }
 
@@ -534,6 +1042,9 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 	if ruo.mutation.DescriptionCleared() {
 		_spec.ClearField(role.FieldDescription, field.TypeString)
 	}
+	if value, ok := ruo.mutation.RequireMfa(); ok {
+		_spec.SetField(role.FieldRequireMfa, field.TypeBool, value)
+	}
 	if ruo.mutation.CreatedAtCleared() {
 		_spec.ClearField(role.FieldCreatedAt, field.TypeTime)
 	}
@@ -633,6 +1144,186 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.GroupsTable,
+			Columns: role.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !ruo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.GroupsTable,
+			Columns: role.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.GroupsTable,
+			Columns: role.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.ApproversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.ApproversTable,
+			Columns: role.ApproversPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedApproversIDs(); len(nodes) > 0 && !ruo.mutation.ApproversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.ApproversTable,
+			Columns: role.ApproversPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ApproversIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.ApproversTable,
+			Columns: role.ApproversPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.SodConstraintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SodConstraintsTable,
+			Columns: role.SodConstraintsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedSodConstraintsIDs(); len(nodes) > 0 && !ruo.mutation.SodConstraintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SodConstraintsTable,
+			Columns: role.SodConstraintsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.SodConstraintsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.SodConstraintsTable,
+			Columns: role.SodConstraintsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   role.AssignmentsTable,
+			Columns: []string{role.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !ruo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   role.AssignmentsTable,
+			Columns: []string{role.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   role.AssignmentsTable,
+			Columns: []string{role.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Role{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
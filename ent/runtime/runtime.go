@@ -5,24 +5,220 @@ package runtime
 import (
 	"time"
 
+	"github.com/eidng8/go-attr-rbac/ent/accessrequest"
 	"github.com/eidng8/go-attr-rbac/ent/accesstoken"
+	"github.com/eidng8/go-attr-rbac/ent/auditlog"
+	"github.com/eidng8/go-attr-rbac/ent/credential"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/linkedidentity"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/passwordhistory"
+	"github.com/eidng8/go-attr-rbac/ent/passwordreset"
 	"github.com/eidng8/go-attr-rbac/ent/permission"
 	"github.com/eidng8/go-attr-rbac/ent/personaltoken"
 	"github.com/eidng8/go-attr-rbac/ent/role"
 	"github.com/eidng8/go-attr-rbac/ent/schema"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+	"github.com/eidng8/go-attr-rbac/ent/session"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
 	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/webauthnchallenge"
+	"github.com/eidng8/go-attr-rbac/ent/webhook"
+	"github.com/eidng8/go-attr-rbac/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accessrequestFields := schema.AccessRequest{}.Fields()
+	_ = accessrequestFields
+	// accessrequestDescJustification is the schema descriptor for justification field.
+	accessrequestDescJustification := accessrequestFields[4].Descriptor()
+	// accessrequest.JustificationValidator is a validator for the "justification" field. It is called by the builders before save.
+	accessrequest.JustificationValidator = func() func(string) error {
+		validators := accessrequestDescJustification.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(justification string) error {
+			for _, fn := range fns {
+				if err := fn(justification); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accessrequestDescReviewComment is the schema descriptor for review_comment field.
+	accessrequestDescReviewComment := accessrequestFields[8].Descriptor()
+	// accessrequest.ReviewCommentValidator is a validator for the "review_comment" field. It is called by the builders before save.
+	accessrequest.ReviewCommentValidator = accessrequestDescReviewComment.Validators[0].(func(string) error)
+	// accessrequestDescCreatedAt is the schema descriptor for created_at field.
+	accessrequestDescCreatedAt := accessrequestFields[10].Descriptor()
+	// accessrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accessrequest.DefaultCreatedAt = accessrequestDescCreatedAt.Default.(func() time.Time)
 	accesstokenFields := schema.AccessToken{}.Fields()
 	_ = accesstokenFields
 	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
 	accesstokenDescCreatedAt := accesstokenFields[4].Descriptor()
 	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
 	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescOrganizationID is the schema descriptor for organization_id field.
+	auditlogDescOrganizationID := auditlogFields[1].Descriptor()
+	// auditlog.DefaultOrganizationID holds the default value on creation for the organization_id field.
+	auditlog.DefaultOrganizationID = auditlogDescOrganizationID.Default.(uint32)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[2].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[6].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	credentialFields := schema.Credential{}.Fields()
+	_ = credentialFields
+	// credentialDescName is the schema descriptor for name field.
+	credentialDescName := credentialFields[2].Descriptor()
+	// credential.DefaultName holds the default value on creation for the name field.
+	credential.DefaultName = credentialDescName.Default.(string)
+	// credentialDescCredentialID is the schema descriptor for credential_id field.
+	credentialDescCredentialID := credentialFields[3].Descriptor()
+	// credential.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	credential.CredentialIDValidator = credentialDescCredentialID.Validators[0].(func([]byte) error)
+	// credentialDescSignCount is the schema descriptor for sign_count field.
+	credentialDescSignCount := credentialFields[8].Descriptor()
+	// credential.DefaultSignCount holds the default value on creation for the sign_count field.
+	credential.DefaultSignCount = credentialDescSignCount.Default.(uint32)
+	// credentialDescBackupEligible is the schema descriptor for backup_eligible field.
+	credentialDescBackupEligible := credentialFields[9].Descriptor()
+	// credential.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	credential.DefaultBackupEligible = credentialDescBackupEligible.Default.(bool)
+	// credentialDescBackupState is the schema descriptor for backup_state field.
+	credentialDescBackupState := credentialFields[10].Descriptor()
+	// credential.DefaultBackupState holds the default value on creation for the backup_state field.
+	credential.DefaultBackupState = credentialDescBackupState.Default.(bool)
+	// credentialDescCreatedAt is the schema descriptor for created_at field.
+	credentialDescCreatedAt := credentialFields[12].Descriptor()
+	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	groupFields := schema.Group{}.Fields()
+	_ = groupFields
+	// groupDescOrganizationID is the schema descriptor for organization_id field.
+	groupDescOrganizationID := groupFields[1].Descriptor()
+	// group.DefaultOrganizationID holds the default value on creation for the organization_id field.
+	group.DefaultOrganizationID = groupDescOrganizationID.Default.(uint32)
+	// groupDescName is the schema descriptor for name field.
+	groupDescName := groupFields[2].Descriptor()
+	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	group.NameValidator = groupDescName.Validators[0].(func(string) error)
+	// groupDescCreatedAt is the schema descriptor for created_at field.
+	groupDescCreatedAt := groupFields[4].Descriptor()
+	// group.DefaultCreatedAt holds the default value on creation for the created_at field.
+	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
+	// groupDescUpdatedAt is the schema descriptor for updated_at field.
+	groupDescUpdatedAt := groupFields[5].Descriptor()
+	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	linkedidentityFields := schema.LinkedIdentity{}.Fields()
+	_ = linkedidentityFields
+	// linkedidentityDescProvider is the schema descriptor for provider field.
+	linkedidentityDescProvider := linkedidentityFields[2].Descriptor()
+	// linkedidentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	linkedidentity.ProviderValidator = linkedidentityDescProvider.Validators[0].(func(string) error)
+	// linkedidentityDescSubject is the schema descriptor for subject field.
+	linkedidentityDescSubject := linkedidentityFields[3].Descriptor()
+	// linkedidentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	linkedidentity.SubjectValidator = linkedidentityDescSubject.Validators[0].(func(string) error)
+	// linkedidentityDescCreatedAt is the schema descriptor for created_at field.
+	linkedidentityDescCreatedAt := linkedidentityFields[4].Descriptor()
+	// linkedidentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	linkedidentity.DefaultCreatedAt = linkedidentityDescCreatedAt.Default.(func() time.Time)
+	oauthclientFields := schema.OauthClient{}.Fields()
+	_ = oauthclientFields
+	// oauthclientDescOrganizationID is the schema descriptor for organization_id field.
+	oauthclientDescOrganizationID := oauthclientFields[1].Descriptor()
+	// oauthclient.DefaultOrganizationID holds the default value on creation for the organization_id field.
+	oauthclient.DefaultOrganizationID = oauthclientDescOrganizationID.Default.(uint32)
+	// oauthclientDescName is the schema descriptor for name field.
+	oauthclientDescName := oauthclientFields[2].Descriptor()
+	// oauthclient.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oauthclient.NameValidator = func() func(string) error {
+		validators := oauthclientDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthclientDescClientID is the schema descriptor for client_id field.
+	oauthclientDescClientID := oauthclientFields[3].Descriptor()
+	// oauthclient.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oauthclient.ClientIDValidator = oauthclientDescClientID.Validators[0].(func(string) error)
+	// oauthclientDescPublic is the schema descriptor for public field.
+	oauthclientDescPublic := oauthclientFields[5].Descriptor()
+	// oauthclient.DefaultPublic holds the default value on creation for the public field.
+	oauthclient.DefaultPublic = oauthclientDescPublic.Default.(bool)
+	// oauthclientDescPublicKey is the schema descriptor for public_key field.
+	oauthclientDescPublicKey := oauthclientFields[8].Descriptor()
+	// oauthclient.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	oauthclient.PublicKeyValidator = oauthclientDescPublicKey.Validators[0].(func(string) error)
+	// oauthclientDescCreatedAt is the schema descriptor for created_at field.
+	oauthclientDescCreatedAt := oauthclientFields[10].Descriptor()
+	// oauthclient.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthclient.DefaultCreatedAt = oauthclientDescCreatedAt.Default.(func() time.Time)
+	// oauthclientDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthclientDescUpdatedAt := oauthclientFields[11].Descriptor()
+	// oauthclient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthclient.UpdateDefaultUpdatedAt = oauthclientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	oauthcodeFields := schema.OauthCode{}.Fields()
+	_ = oauthcodeFields
+	// oauthcodeDescCodeChallenge is the schema descriptor for code_challenge field.
+	oauthcodeDescCodeChallenge := oauthcodeFields[7].Descriptor()
+	// oauthcode.CodeChallengeValidator is a validator for the "code_challenge" field. It is called by the builders before save.
+	oauthcode.CodeChallengeValidator = oauthcodeDescCodeChallenge.Validators[0].(func(string) error)
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[1].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationFields[4].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationFields[5].Descriptor()
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	passwordhistoryFields := schema.PasswordHistory{}.Fields()
+	_ = passwordhistoryFields
+	// passwordhistoryDescPassword is the schema descriptor for password field.
+	passwordhistoryDescPassword := passwordhistoryFields[2].Descriptor()
+	// passwordhistory.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	passwordhistory.PasswordValidator = passwordhistoryDescPassword.Validators[0].(func(string) error)
+	// passwordhistoryDescCreatedAt is the schema descriptor for created_at field.
+	passwordhistoryDescCreatedAt := passwordhistoryFields[3].Descriptor()
+	// passwordhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordhistory.DefaultCreatedAt = passwordhistoryDescCreatedAt.Default.(func() time.Time)
+	passwordresetFields := schema.PasswordReset{}.Fields()
+	_ = passwordresetFields
+	// passwordresetDescCreatedAt is the schema descriptor for created_at field.
+	passwordresetDescCreatedAt := passwordresetFields[5].Descriptor()
+	// passwordreset.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordreset.DefaultCreatedAt = passwordresetDescCreatedAt.Default.(func() time.Time)
 	permissionFields := schema.Permission{}.Fields()
 	_ = permissionFields
 	// permissionDescName is the schema descriptor for name field.
@@ -49,40 +245,196 @@ func init() {
 	personaltoken.DefaultCreatedAt = personaltokenDescCreatedAt.Default.(func() time.Time)
 	roleFields := schema.Role{}.Fields()
 	_ = roleFields
+	// roleDescOrganizationID is the schema descriptor for organization_id field.
+	roleDescOrganizationID := roleFields[1].Descriptor()
+	// role.DefaultOrganizationID holds the default value on creation for the organization_id field.
+	role.DefaultOrganizationID = roleDescOrganizationID.Default.(uint32)
 	// roleDescName is the schema descriptor for name field.
-	roleDescName := roleFields[1].Descriptor()
+	roleDescName := roleFields[2].Descriptor()
 	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescRequireMfa is the schema descriptor for require_mfa field.
+	roleDescRequireMfa := roleFields[4].Descriptor()
+	// role.DefaultRequireMfa holds the default value on creation for the require_mfa field.
+	role.DefaultRequireMfa = roleDescRequireMfa.Default.(bool)
 	// roleDescCreatedAt is the schema descriptor for created_at field.
-	roleDescCreatedAt := roleFields[3].Descriptor()
+	roleDescCreatedAt := roleFields[5].Descriptor()
 	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
 	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
 	// roleDescUpdatedAt is the schema descriptor for updated_at field.
-	roleDescUpdatedAt := roleFields[4].Descriptor()
+	roleDescUpdatedAt := roleFields[6].Descriptor()
 	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	serviceaccountFields := schema.ServiceAccount{}.Fields()
+	_ = serviceaccountFields
+	// serviceaccountDescName is the schema descriptor for name field.
+	serviceaccountDescName := serviceaccountFields[1].Descriptor()
+	// serviceaccount.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	serviceaccount.NameValidator = func() func(string) error {
+		validators := serviceaccountDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// serviceaccountDescClientID is the schema descriptor for client_id field.
+	serviceaccountDescClientID := serviceaccountFields[5].Descriptor()
+	// serviceaccount.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	serviceaccount.ClientIDValidator = serviceaccountDescClientID.Validators[0].(func(string) error)
+	// serviceaccountDescCreatedAt is the schema descriptor for created_at field.
+	serviceaccountDescCreatedAt := serviceaccountFields[6].Descriptor()
+	// serviceaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	serviceaccount.DefaultCreatedAt = serviceaccountDescCreatedAt.Default.(func() time.Time)
+	// serviceaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	serviceaccountDescUpdatedAt := serviceaccountFields[7].Descriptor()
+	// serviceaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	serviceaccount.UpdateDefaultUpdatedAt = serviceaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[4].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the user_agent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// session.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	session.UserAgentValidator = sessionDescUserAgent.Validators[0].(func(string) error)
+	// sessionDescIP is the schema descriptor for ip field.
+	sessionDescIP := sessionFields[5].Descriptor()
+	// session.DefaultIP holds the default value on creation for the ip field.
+	session.DefaultIP = sessionDescIP.Default.(string)
+	// session.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	session.IPValidator = sessionDescIP.Validators[0].(func(string) error)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[7].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	sodconstraintFields := schema.SodConstraint{}.Fields()
+	_ = sodconstraintFields
+	// sodconstraintDescOrganizationID is the schema descriptor for organization_id field.
+	sodconstraintDescOrganizationID := sodconstraintFields[1].Descriptor()
+	// sodconstraint.DefaultOrganizationID holds the default value on creation for the organization_id field.
+	sodconstraint.DefaultOrganizationID = sodconstraintDescOrganizationID.Default.(uint32)
+	// sodconstraintDescName is the schema descriptor for name field.
+	sodconstraintDescName := sodconstraintFields[2].Descriptor()
+	// sodconstraint.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	sodconstraint.NameValidator = sodconstraintDescName.Validators[0].(func(string) error)
+	// sodconstraintDescCreatedAt is the schema descriptor for created_at field.
+	sodconstraintDescCreatedAt := sodconstraintFields[4].Descriptor()
+	// sodconstraint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sodconstraint.DefaultCreatedAt = sodconstraintDescCreatedAt.Default.(func() time.Time)
+	// sodconstraintDescUpdatedAt is the schema descriptor for updated_at field.
+	sodconstraintDescUpdatedAt := sodconstraintFields[5].Descriptor()
+	// sodconstraint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sodconstraint.UpdateDefaultUpdatedAt = sodconstraintDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userHooks := schema.User{}.Hooks()
 	user.Hooks[0] = userHooks[0]
 	userInters := schema.User{}.Interceptors()
 	user.Interceptors[0] = userInters[0]
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescOrganizationID is the schema descriptor for organization_id field.
+	userDescOrganizationID := userFields[1].Descriptor()
+	// user.DefaultOrganizationID holds the default value on creation for the organization_id field.
+	user.DefaultOrganizationID = userDescOrganizationID.Default.(uint32)
 	// userDescUsername is the schema descriptor for username field.
-	userDescUsername := userFields[1].Descriptor()
+	userDescUsername := userFields[2].Descriptor()
 	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
 	// userDescPassword is the schema descriptor for password field.
-	userDescPassword := userFields[3].Descriptor()
+	userDescPassword := userFields[4].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[7].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	// userDescMustChangePassword is the schema descriptor for must_change_password field.
+	userDescMustChangePassword := userFields[10].Descriptor()
+	// user.DefaultMustChangePassword holds the default value on creation for the must_change_password field.
+	user.DefaultMustChangePassword = userDescMustChangePassword.Default.(bool)
+	// userDescIsServiceAccount is the schema descriptor for is_service_account field.
+	userDescIsServiceAccount := userFields[13].Descriptor()
+	// user.DefaultIsServiceAccount holds the default value on creation for the is_service_account field.
+	user.DefaultIsServiceAccount = userDescIsServiceAccount.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[5].Descriptor()
+	userDescCreatedAt := userFields[15].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[6].Descriptor()
+	userDescUpdatedAt := userFields[16].Descriptor()
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webauthnchallengeFields := schema.WebauthnChallenge{}.Fields()
+	_ = webauthnchallengeFields
+	// webauthnchallengeDescCreatedAt is the schema descriptor for created_at field.
+	webauthnchallengeDescCreatedAt := webauthnchallengeFields[3].Descriptor()
+	// webauthnchallenge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthnchallenge.DefaultCreatedAt = webauthnchallengeDescCreatedAt.Default.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescOrganizationID is the schema descriptor for organization_id field.
+	webhookDescOrganizationID := webhookFields[1].Descriptor()
+	// webhook.DefaultOrganizationID holds the default value on creation for the organization_id field.
+	webhook.DefaultOrganizationID = webhookDescOrganizationID.Default.(uint32)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[2].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = func() func(string) error {
+		validators := webhookDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[3].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[5].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[6].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[7].Descriptor()
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(uint32)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
 
 const (
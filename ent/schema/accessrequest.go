@@ -0,0 +1,145 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/ogen-go/ogen"
+)
+
+// AccessRequest holds the schema definition for the AccessRequest entity.
+// A user requests a role, which is assigned to the user once an approver of
+// the role approves the request. Requests are never deleted, so that the full
+// history is kept.
+type AccessRequest struct {
+	ent.Schema
+}
+
+func (AccessRequest) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		edge.Annotation{StructTag: `json:"-"`},
+		schema.Comment("Stores role access requests"),
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (AccessRequest) Fields() []ent.Field {
+	u1000 := uint64(1000)
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint32("organization_id").Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint32",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint64("requester_id").Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint32("role_id").Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint32",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.String("justification").NotEmpty().Immutable().
+			MaxLen(int(u1000)).Annotations(
+			entoas.Schema(&ogen.Schema{Type: "string", MaxLength: &u1000}),
+		),
+		// duration of the assignment in seconds, empty for indefinite
+		field.Uint32("duration").Optional().Nillable().Immutable().
+			Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+		field.Enum("status").Values("pending", "approved", "denied").
+			Default("pending"),
+		field.Uint64("reviewer_id").Optional().Nillable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.String("review_comment").Optional().MaxLen(int(u1000)).
+			Annotations(
+				entoas.Schema(
+					&ogen.Schema{Type: "string", MaxLength: &u1000},
+				),
+			),
+		field.Time("reviewed_at").Optional().Nillable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+		field.Time("created_at").Immutable().Default(time.Now).Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+	}
+}
+
+// Requests are removed along with the requester or the role, and the reviewer
+// is cleared if the reviewer is removed.
+func (AccessRequest) Edges() []ent.Edge {
+	p := entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude))
+	return []ent.Edge{
+		edge.To("requester", User.Type).Field("requester_id").Unique().
+			Required().Immutable().
+			Annotations(p, entoas.Skip(true), entsql.OnDelete(entsql.Cascade)),
+		edge.To("role", Role.Type).Field("role_id").Unique().Required().
+			Immutable().
+			Annotations(p, entoas.Skip(true), entsql.OnDelete(entsql.Cascade)),
+		edge.To("reviewer", User.Type).Field("reviewer_id").Unique().
+			Annotations(p, entoas.Skip(true), entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+func (AccessRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "status", "created_at"),
+		index.Fields("requester_id", "role_id", "status"),
+	}
+}
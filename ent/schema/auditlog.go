@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/ogen-go/ogen"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// Entries are append-only records of security relevant changes.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		schema.Comment("Stores audit trail"),
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint32("organization_id").Immutable().
+			Default(api.DefaultOrganizationId).Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint32",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.String("action").NotEmpty().Immutable(),
+		// ID of the user who made the change, empty for system changes
+		field.Uint64("actor_id").Optional().Nillable().Immutable().
+			Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint64",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+		// ID of the user impersonating the actor, empty if not impersonated
+		field.Uint64("impersonator_id").Optional().Nillable().Immutable().
+			Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint64",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+		field.JSON("details", map[string]interface{}{}).Optional().
+			Immutable().Annotations(
+			entoas.Schema(&ogen.Schema{Type: "object"}),
+		),
+		field.Time("created_at").Immutable().Default(time.Now).Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "action", "created_at"),
+	}
+}
@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+)
+
+// Credential holds the schema definition for the Credential entity.
+// Each row is a WebAuthn public key credential (passkey) registered by a user.
+type Credential struct {
+	ent.Schema
+}
+
+func (Credential) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Stores WebAuthn credentials of users"),
+		edge.Annotation{StructTag: `json:"-"`},
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (Credential) Fields() []ent.Field {
+	u255 := uint64(255)
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint64("user_id").Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.String("name").Default("").Annotations(
+			entoas.Schema(&ogen.Schema{Type: "string", MaxLength: &u255}),
+		),
+		// ID of the credential chosen by the authenticator
+		field.Bytes("credential_id").Unique().Immutable().MaxLen(1023).
+			Annotations(entoas.Skip(true)),
+		// COSE encoded public key
+		field.Bytes("public_key").Immutable().Annotations(entoas.Skip(true)),
+		field.String("attestation_type").Immutable().
+			Annotations(entoas.Skip(true)),
+		field.JSON("transports", []string{}).Optional().
+			Annotations(entoas.Skip(true)),
+		// model of the authenticator
+		field.Bytes("aaguid").Optional().Immutable().
+			Annotations(entoas.Skip(true)),
+		// signature counter of the authenticator, to detect cloned keys
+		field.Uint32("sign_count").Default(0).Annotations(entoas.Skip(true)),
+		// whether the credential can be synced to other devices, never changes
+		field.Bool("backup_eligible").Default(false).Immutable().
+			Annotations(entoas.Skip(true)),
+		field.Bool("backup_state").Default(false).
+			Annotations(entoas.Skip(true)),
+		field.Time("last_used_at").Optional().Nillable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+		field.Time("created_at").Immutable().Default(time.Now).Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+	}
+}
+
+func (Credential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("credentials").Field("user_id").
+			Unique().Required().Immutable().Annotations(
+			entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entoas.Skip(true),
+		),
+	}
+}
@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	ee "github.com/eidng8/go-ent"
+	"github.com/ogen-go/ogen"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// Group holds the schema definition for the Group entity.
+// Members of a group are granted all roles of the group.
+type Group struct {
+	ent.Schema
+}
+
+func (Group) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.OnDelete(entsql.Restrict),
+		edge.Annotation{StructTag: `json:"-"`},
+		schema.Comment("Stores user groups"),
+	}
+}
+
+func (Group) Fields() []ent.Field {
+	u1 := uint64(1)
+	u255 := uint64(255)
+	return append(
+		[]ent.Field{
+			field.Uint32("id").Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+			field.Uint32("organization_id").
+				Default(api.DefaultOrganizationId).Immutable().
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:    "integer",
+							Format:  "uint32",
+							Minimum: ogen.Num("1"),
+						},
+					),
+				),
+			field.String("name").NotEmpty().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						MinLength: &u1,
+						MaxLength: &u255,
+					},
+				),
+			),
+			field.String("description").Optional().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						MaxLength: &u255,
+					},
+				),
+			),
+		},
+		ee.Timestamps()...,
+	)
+}
+
+// Memberships and role assignments are removed along with the group. Groups
+// owning service accounts can't be deleted.
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).Ref("groups").
+			Field("organization_id").Unique().Required().Immutable().
+			Annotations(
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+				entoas.Skip(true),
+			),
+		edge.To("users", User.Type),
+		edge.To("roles", Role.Type),
+		edge.To("service_accounts", ServiceAccount.Type).Annotations(
+			entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entsql.OnDelete(entsql.Restrict), entoas.Skip(true),
+		),
+	}
+}
+
+// Group names are unique within an organization.
+func (Group) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "name").Unique(),
+	}
+}
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/ogen-go/ogen"
+)
+
+// LinkedIdentity holds the schema definition for the LinkedIdentity entity.
+// Each row connects the subject of an OpenID Connect provider to the local
+// user it signs in as.
+type LinkedIdentity struct {
+	ent.Schema
+}
+
+func (LinkedIdentity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Links identities of external providers to users"),
+		edge.Annotation{StructTag: `json:"-"`},
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (LinkedIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint64("user_id").Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		// name of the provider in the configuration
+		field.String("provider").NotEmpty().Immutable().
+			Annotations(entoas.Skip(true)),
+		// `sub` claim of the provider, unique per provider
+		field.String("subject").NotEmpty().Immutable().
+			Annotations(entoas.Skip(true)),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(entoas.Skip(true)),
+		field.Time("last_login_at").Optional().Nillable().
+			Annotations(entoas.Skip(true)),
+	}
+}
+
+func (LinkedIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("identities").Field("user_id").
+			Unique().Required().Immutable().Annotations(
+			entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entoas.Skip(true),
+		),
+	}
+}
+
+func (LinkedIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").Unique(),
+		index.Fields("user_id"),
+	}
+}
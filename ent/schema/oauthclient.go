@@ -0,0 +1,156 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	ee "github.com/eidng8/go-ent"
+	"github.com/ogen-go/ogen"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// OauthClient holds the schema definition for the OauthClient entity.
+// Each row registers an application signing users in with OAuth 2.0.
+type OauthClient struct {
+	ent.Schema
+}
+
+func (OauthClient) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.OnDelete(entsql.Restrict),
+		entsql.WithComments(true),
+		schema.Comment("Stores registered OAuth clients"),
+		edge.Annotation{StructTag: `json:"-"`},
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (OauthClient) Fields() []ent.Field {
+	u1 := uint64(1)
+	u2 := uint64(2)
+	u255 := uint64(255)
+	u2048 := uint64(2048)
+	u4096 := uint64(4096)
+	grants := make([]json.RawMessage, len(api.OauthGrantTypes))
+	for i, g := range api.OauthGrantTypes {
+		grants[i] = json.RawMessage(`"` + g + `"`)
+	}
+	return append(
+		[]ent.Field{
+			field.Uint32("id").Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+			field.Uint32("organization_id").
+				Default(api.DefaultOrganizationId).Immutable().
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:    "integer",
+							Format:  "uint32",
+							Minimum: ogen.Num("1"),
+						},
+					),
+				),
+			field.String("name").NotEmpty().MaxLen(255).Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						MinLength: &u2,
+						MaxLength: &u255,
+					},
+				),
+			),
+			// generated identifier the client authenticates with
+			field.String("client_id").NotEmpty().Unique().Immutable().
+				Annotations(entoas.ReadOnly(true)),
+			// SHA-256 hash of the client secret, nil for public clients
+			field.String("secret").Sensitive().Optional().Nillable().
+				Annotations(entoas.Skip(true)),
+			// public clients, such as SPAs and CLIs, can't keep a secret
+			field.Bool("public").Default(false).Immutable(),
+			field.JSON("redirect_uris", []string{}).Optional().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type: "array",
+						Items: &ogen.Items{
+							Item: &ogen.Schema{
+								Type:      "string",
+								Format:    "uri",
+								MaxLength: &u2048,
+							},
+						},
+					},
+				),
+			),
+			field.JSON("grant_types", []string{}).Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:     "array",
+						MinItems: &u1,
+						Items: &ogen.Items{
+							Item: &ogen.Schema{Type: "string", Enum: grants},
+						},
+					},
+				),
+			),
+			// PEM encoded public key verifying the JWT assertions the client
+			// authenticates with, instead of a secret
+			field.String("public_key").Optional().Nillable().MaxLen(4096).
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:      "string",
+							MaxLength: &u4096,
+						},
+					),
+				),
+			// user the client acts as with the client credentials grant
+			field.Uint64("user_id").Optional().Nillable().Immutable().
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:    "integer",
+							Format:  "uint64",
+							Minimum: ogen.Num("1"),
+						},
+					),
+				),
+		},
+		ee.Timestamps()...,
+	)
+}
+
+// Clients only sign in users of their organization.
+func (OauthClient) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).Ref("oauth_clients").
+			Field("organization_id").Unique().Required().Immutable().
+			Annotations(
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+				entoas.Skip(true),
+			),
+		edge.From("user", User.Type).Ref("oauth_clients").Field("user_id").
+			Unique().Immutable().Annotations(
+			entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entoas.Skip(true),
+		),
+		edge.To("codes", OauthCode.Type).Annotations(
+			entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entsql.OnDelete(entsql.Cascade), entoas.Skip(true),
+		),
+	}
+}
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/ogen-go/ogen"
+)
+
+// OauthCode holds the schema definition for the OauthCode entity.
+// This table stores the authorization codes issued to OAuth clients until
+// they are exchanged for tokens, and shall be cleaned up periodically to
+// remove expired codes.
+type OauthCode struct {
+	ent.Schema
+}
+
+func (OauthCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Stores unused OAuth authorization codes"),
+		edge.Annotation{StructTag: `json:"-"`},
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (OauthCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		// SHA-256 hash of the code
+		field.Bytes("code").Sensitive().Unique().Immutable().
+			SchemaType(
+				map[string]string{
+					dialect.MySQL:    "binary(32)",
+					dialect.Postgres: "bytea",
+					dialect.SQLite:   "blob",
+				},
+			).Annotations(entoas.Skip(true)),
+		field.Uint32("client_id").Immutable().
+			Annotations(entoas.Skip(true)),
+		field.Uint64("user_id").Immutable().
+			Annotations(entoas.Skip(true)),
+		// must be sent again when exchanging the code
+		field.String("redirect_uri").Immutable().
+			Annotations(entoas.Skip(true)),
+		field.JSON("scopes", []string{}).Immutable().
+			Annotations(entoas.Skip(true)),
+		// OpenID Connect nonce, included in the ID token
+		field.String("nonce").Optional().Nillable().Immutable().
+			Annotations(entoas.Skip(true)),
+		// PKCE S256 challenge the code verifier must match
+		field.String("code_challenge").NotEmpty().Immutable().
+			Annotations(entoas.Skip(true)),
+		field.Time("expires_at").Immutable().
+			Annotations(entoas.Skip(true)),
+	}
+}
+
+func (OauthCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("client", OauthClient.Type).Ref("codes").
+			Field("client_id").Unique().Required().Immutable().Annotations(
+			entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entoas.Skip(true),
+		),
+		edge.From("user", User.Type).Ref("oauth_codes").Field("user_id").
+			Unique().Required().Immutable().Annotations(
+			entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entoas.Skip(true),
+		),
+	}
+}
+
+func (OauthCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	ee "github.com/eidng8/go-ent"
+	"github.com/ogen-go/ogen"
+)
+
+// Organization holds the schema definition for the Organization entity.
+// Each organization is a tenant owning its own users, roles and groups.
+type Organization struct {
+	ent.Schema
+}
+
+func (Organization) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.OnDelete(entsql.Restrict),
+		edge.Annotation{StructTag: `json:"-"`},
+		schema.Comment("Stores tenants"),
+	}
+}
+
+func (Organization) Fields() []ent.Field {
+	u1 := uint64(1)
+	u255 := uint64(255)
+	return append(
+		[]ent.Field{
+			field.Uint32("id").Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+			field.String("name").Unique().NotEmpty().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						MinLength: &u1,
+						MaxLength: &u255,
+					},
+				),
+			),
+			field.String("description").Optional().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						MaxLength: &u255,
+					},
+				),
+			),
+			// SHA-256 hash of the bearer token of the organization's SCIM
+			// client, SCIM is disabled for the organization if not set
+			field.Bytes("scim_token").Sensitive().Optional().Unique().
+				SchemaType(
+					map[string]string{
+						dialect.MySQL:    "binary(32)",
+						dialect.Postgres: "binary(32)",
+						dialect.SQLite:   "blob",
+					},
+				).Annotations(entoas.Skip(true)),
+		},
+		ee.Timestamps()...,
+	)
+}
+
+func (Organization) Edges() []ent.Edge {
+	p := entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude))
+	l := entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude))
+	return []ent.Edge{
+		edge.To("users", User.Type).Annotations(
+			p, l, entoas.Skip(true), entsql.OnDelete(entsql.Restrict),
+		),
+		edge.To("roles", Role.Type).Annotations(
+			p, l, entoas.Skip(true), entsql.OnDelete(entsql.Restrict),
+		),
+		edge.To("groups", Group.Type).Annotations(
+			p, l, entoas.Skip(true), entsql.OnDelete(entsql.Restrict),
+		),
+		edge.To("sod_constraints", SodConstraint.Type).Annotations(
+			p, l, entoas.Skip(true), entsql.OnDelete(entsql.Restrict),
+		),
+		edge.To("webhooks", Webhook.Type).Annotations(
+			p, l, entoas.Skip(true), entsql.OnDelete(entsql.Restrict),
+		),
+		edge.To("oauth_clients", OauthClient.Type).Annotations(
+			p, l, entoas.Skip(true), entsql.OnDelete(entsql.Restrict),
+		),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/ogen-go/ogen"
+)
+
+// PasswordHistory holds the schema definition for the PasswordHistory entity.
+// Each row is the hash of a password the user had before, kept to prevent
+// reusing recent passwords.
+type PasswordHistory struct {
+	ent.Schema
+}
+
+func (PasswordHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Stores hashes of previous passwords of users"),
+		edge.Annotation{StructTag: `json:"-"`},
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (PasswordHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint64("user_id").Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.String("password").Sensitive().NotEmpty().Immutable().
+			Annotations(entoas.Skip(true)),
+		// time the password was replaced
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(entoas.Skip(true)),
+	}
+}
+
+func (PasswordHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("password_history").Field("user_id").
+			Unique().Required().Immutable().Annotations(
+			entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entoas.Skip(true),
+		),
+	}
+}
+
+func (PasswordHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+)
+
+// PasswordReset holds the schema definition for the PasswordReset entity.
+// Each row is a password reset token sent to a user, which can be used once
+// before it expires. Only the hash of the token is stored.
+type PasswordReset struct {
+	ent.Schema
+}
+
+func (PasswordReset) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Stores password reset tokens"),
+		edge.Annotation{StructTag: `json:"-"`},
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (PasswordReset) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint64("user_id").Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		// SHA-256 hash of the token
+		field.Bytes("token").Sensitive().Unique().Immutable().
+			SchemaType(
+				map[string]string{
+					dialect.MySQL:    "binary(32)",
+					dialect.Postgres: "binary(32)",
+					dialect.SQLite:   "blob",
+				},
+			).Annotations(entoas.Skip(true)),
+		field.Time("expires_at").Immutable().Annotations(entoas.Skip(true)),
+		// time the token was used, or superseded by another reset
+		field.Time("used_at").Optional().Nillable().
+			Annotations(entoas.Skip(true)),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(entoas.Skip(true)),
+	}
+}
+
+func (PasswordReset) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("password_resets").Field("user_id").
+			Unique().Required().Immutable().Annotations(
+			entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entoas.Skip(true),
+		),
+	}
+}
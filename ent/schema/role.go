@@ -7,8 +7,11 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	ee "github.com/eidng8/go-ent"
 	"github.com/ogen-go/ogen"
+
+	"github.com/eidng8/go-attr-rbac/api"
 )
 
 type Role struct {
@@ -36,7 +39,18 @@ func (Role) Fields() []ent.Field {
 					},
 				),
 			),
-			field.String("name").Unique().NotEmpty().Annotations(
+			field.Uint32("organization_id").
+				Default(api.DefaultOrganizationId).Immutable().
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:    "integer",
+							Format:  "uint32",
+							Minimum: ogen.Num("1"),
+						},
+					),
+				),
+			field.String("name").NotEmpty().Annotations(
 				entoas.Schema(
 					&ogen.Schema{
 						Type:      "string",
@@ -53,6 +67,8 @@ func (Role) Fields() []ent.Field {
 					},
 				),
 			),
+			// holders of the role must sign in with a second factor
+			field.Bool("require_mfa").Default(false),
 		},
 		ee.Timestamps()...,
 	)
@@ -60,9 +76,37 @@ func (Role) Fields() []ent.Field {
 
 func (Role) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("organization", Organization.Type).Ref("roles").
+			Field("organization_id").Unique().Required().Immutable().
+			Annotations(
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+				entoas.Skip(true),
+			),
 		edge.To("permissions", Permission.Type).
 			Annotations(entsql.OnDelete(entsql.Restrict)),
-		edge.To("users", User.Type).
+		edge.To("users", User.Type).Through("assignments", UserRole.Type).
 			Annotations(entsql.OnDelete(entsql.Restrict)),
+		edge.From("groups", Group.Type).Ref("roles").Annotations(
+			entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entoas.Skip(true),
+		),
+		// users who can approve access requests of the role
+		edge.To("approvers", User.Type),
+		edge.From("sod_constraints", SodConstraint.Type).Ref("roles").
+			Annotations(
+				entoas.ListOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+				entoas.Skip(true),
+			),
+	}
+}
+
+// Role names are unique within an organization.
+func (Role) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "name").Unique(),
 	}
 }
@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	ee "github.com/eidng8/go-ent"
+	"github.com/ogen-go/ogen"
+)
+
+// ServiceAccount holds the schema definition for the ServiceAccount entity.
+// Each service account is a non-login principal owned by a group. It is backed
+// by a user, which holds its role assignments, and authenticates with the
+// client credentials grant of its OAuth client.
+type ServiceAccount struct {
+	ent.Schema
+}
+
+func (ServiceAccount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.OnDelete(entsql.Restrict),
+		entsql.WithComments(true),
+		schema.Comment("Stores service accounts"),
+		edge.Annotation{StructTag: `json:"-"`},
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (ServiceAccount) Fields() []ent.Field {
+	u2 := uint64(2)
+	u255 := uint64(255)
+	return append(
+		[]ent.Field{
+			field.Uint32("id").Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+			// also the username of the backing user
+			field.String("name").NotEmpty().MaxLen(255).Immutable().
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:      "string",
+							MinLength: &u2,
+							MaxLength: &u255,
+						},
+					),
+				),
+			field.String("description").Optional().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						MaxLength: &u255,
+					},
+				),
+			),
+			field.Uint32("owner_id").Immutable().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+			// user backing the account, roles are assigned to it
+			field.Uint64("user_id").Unique().Immutable().Annotations(
+				entoas.ReadOnly(true),
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint64",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+			// ID of the OAuth client the account authenticates with
+			field.String("client_id").NotEmpty().Unique().Immutable().
+				Annotations(entoas.ReadOnly(true)),
+		},
+		ee.Timestamps()...,
+	)
+}
+
+func (ServiceAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Group.Type).Ref("service_accounts").
+			Field("owner_id").Unique().Required().Immutable().
+			Annotations(
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+				entoas.Skip(true),
+			),
+		edge.From("user", User.Type).Ref("service_account").
+			Field("user_id").Unique().Required().Immutable().
+			Annotations(
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+				entoas.Skip(true),
+			),
+	}
+}
@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+)
+
+// Session holds the schema definition for the Session entity.
+// Each row is a device signed in by a user. It tracks the current access and
+// refresh tokens of the device, which are replaced on every refresh, so the
+// session can be signed out remotely by revoking them.
+type Session struct {
+	ent.Schema
+}
+
+func (Session) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Stores signed in sessions of users"),
+		edge.Annotation{StructTag: `json:"-"`},
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (Session) Fields() []ent.Field {
+	u255 := uint64(255)
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint64("user_id").Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		// JTI of the current access token
+		field.Bytes("access_token").Sensitive().Unique().
+			SchemaType(
+				map[string]string{
+					dialect.MySQL:    "binary(16)",
+					dialect.Postgres: "binary(16)",
+					dialect.SQLite:   "blob",
+				},
+			).Annotations(entoas.Skip(true)),
+		// JTI of the current refresh token
+		field.Bytes("refresh_token").Sensitive().Unique().
+			SchemaType(
+				map[string]string{
+					dialect.MySQL:    "binary(16)",
+					dialect.Postgres: "binary(16)",
+					dialect.SQLite:   "blob",
+				},
+			).Annotations(entoas.Skip(true)),
+		field.String("user_agent").Default("").MaxLen(255).Annotations(
+			entoas.Schema(&ogen.Schema{Type: "string", MaxLength: &u255}),
+		),
+		field.String("ip").Default("").MaxLen(45).Annotations(
+			entoas.Schema(&ogen.Schema{Type: "string"}),
+		),
+		// expiration of the current refresh token, after which the session
+		// can't continue
+		field.Time("expires_at").Annotations(entoas.Skip(true)),
+		field.Time("created_at").Immutable().Default(time.Now).Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+		field.Time("refreshed_at").Optional().Nillable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+	}
+}
+
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("sessions").Field("user_id").
+			Unique().Required().Immutable().Annotations(
+			entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entoas.Skip(true),
+		),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	ee "github.com/eidng8/go-ent"
+	"github.com/ogen-go/ogen"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// SodConstraint holds the schema definition for the SodConstraint entity.
+// It is a separation-of-duties constraint, defining a set of mutually
+// exclusive roles. No user may hold more than one role of the set.
+type SodConstraint struct {
+	ent.Schema
+}
+
+func (SodConstraint) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.OnDelete(entsql.Restrict),
+		edge.Annotation{StructTag: `json:"-"`},
+		schema.Comment("Stores separation-of-duties constraints"),
+	}
+}
+
+func (SodConstraint) Fields() []ent.Field {
+	u1 := uint64(1)
+	u255 := uint64(255)
+	return append(
+		[]ent.Field{
+			field.Uint32("id").Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+			field.Uint32("organization_id").
+				Default(api.DefaultOrganizationId).Immutable().
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:    "integer",
+							Format:  "uint32",
+							Minimum: ogen.Num("1"),
+						},
+					),
+				),
+			field.String("name").NotEmpty().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						MinLength: &u1,
+						MaxLength: &u255,
+					},
+				),
+			),
+			field.String("description").Optional().Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						MaxLength: &u255,
+					},
+				),
+			),
+		},
+		ee.Timestamps()...,
+	)
+}
+
+func (SodConstraint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).Ref("sod_constraints").
+			Field("organization_id").Unique().Required().Immutable().
+			Annotations(
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+				entoas.Skip(true),
+			),
+		edge.To("roles", Role.Type),
+	}
+}
+
+// Constraint names are unique within an organization.
+func (SodConstraint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "name").Unique(),
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	ee "github.com/eidng8/go-ent"
 	"github.com/eidng8/go-ent/softdelete"
 	"github.com/ogen-go/ogen"
 
+	"github.com/eidng8/go-attr-rbac/api"
 	gen "github.com/eidng8/go-attr-rbac/ent"
 	"github.com/eidng8/go-attr-rbac/ent/intercept"
 )
@@ -41,7 +43,18 @@ func (User) Fields() []ent.Field {
 					},
 				),
 			),
-			field.String("username").Unique().NotEmpty().Immutable().
+			field.Uint32("organization_id").
+				Default(api.DefaultOrganizationId).Immutable().
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:    "integer",
+							Format:  "uint32",
+							Minimum: ogen.Num("1"),
+						},
+					),
+				),
+			field.String("username").NotEmpty().Immutable().
 				Annotations(
 					entoas.Schema(
 						&ogen.Schema{
@@ -51,7 +64,7 @@ func (User) Fields() []ent.Field {
 						},
 					),
 				),
-			field.String("email").Optional().Nillable().Unique().Annotations(
+			field.String("email").Optional().Nillable().Annotations(
 				entoas.Schema(
 					&ogen.Schema{
 						Type:   "string",
@@ -59,16 +72,50 @@ func (User) Fields() []ent.Field {
 					},
 				),
 			),
-			field.String("password").Sensitive().NotEmpty().Annotations(
-				entoas.Skip(true),
-				entoas.Schema(
-					&ogen.Schema{
-						Type:      "string",
-						MinLength: &u8,
-						MaxLength: &u255,
-					},
+			// empty for service accounts, which can't log in
+			field.String("password").Sensitive().NotEmpty().Optional().
+				Annotations(
+					entoas.Skip(true),
+					entoas.Schema(
+						&ogen.Schema{
+							Type:      "string",
+							MinLength: &u8,
+							MaxLength: &u255,
+						},
+					),
 				),
-			),
+			// base32 encoded TOTP secret, set at enrollment
+			field.String("totp_secret").Sensitive().Optional().Nillable().
+				Annotations(entoas.Skip(true)),
+			// time the TOTP enrollment was confirmed, TOTP is enabled if set
+			field.Time("totp_confirmed_at").Optional().Nillable().
+				Annotations(entoas.Skip(true)),
+			// last accepted TOTP time step, codes can't be used twice
+			field.Int64("totp_last_step").Default(0).
+				Annotations(entoas.Skip(true)),
+			// SHA-256 hashes of the unused recovery codes
+			field.JSON("recovery_codes", []string{}).Sensitive().Optional().
+				Annotations(entoas.Skip(true)),
+			// tokens issued before it are rejected, bumped to sign out the
+			// user everywhere
+			field.Time("tokens_valid_after").Optional().Nillable().
+				Annotations(entoas.Skip(true)),
+			// set when an administrator assigned a temporary password
+			field.Bool("must_change_password").Default(false).
+				Annotations(entoas.Skip(true)),
+			// name of the external authenticator that provisioned the user,
+			// whose passwords are verified by it, nil for local users
+			field.String("auth_provider").Optional().Nillable().
+				Annotations(entoas.Skip(true)),
+			// state of the account, disabled and deleted users can't
+			// authenticate, locked users can't sign in until the login
+			// lockout expires
+			field.Enum("status").
+				Values("active", "disabled", "deleted", "locked").
+				Default("active").Annotations(entoas.Skip(true)),
+			// set for the users backing service accounts
+			field.Bool("is_service_account").Default(false).Immutable().
+				Annotations(entoas.Skip(true)),
 			field.JSON("attr", &map[string]interface{}{}).Optional().
 				Annotations(
 					entoas.Schema(
@@ -106,10 +153,24 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	p := entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude))
 	return []ent.Edge{
-		edge.From("roles", Role.Type).Ref("users").Annotations(
+		edge.From("organization", Organization.Type).Ref("users").
+			Field("organization_id").Unique().Required().Immutable().
+			Annotations(
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+				entoas.Skip(true),
+			),
+		edge.From("roles", Role.Type).Ref("users").
+			Through("assignments", UserRole.Type).Annotations(
 			p, entsql.OnDelete(entsql.Restrict),
 			entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExpose)),
 		),
+		edge.From("groups", Group.Type).Ref("users").Annotations(
+			p, entoas.Skip(true),
+		),
+		edge.From("approvable_roles", Role.Type).Ref("approvers").
+			Annotations(p, entoas.Skip(true)),
 		edge.To("access_tokens", AccessToken.Type).Annotations(
 			p, entsql.OnDelete(entsql.Restrict),
 		),
@@ -119,6 +180,42 @@ func (User) Edges() []ent.Edge {
 		edge.To("personal_tokens", PersonalToken.Type).Annotations(
 			p, entsql.OnDelete(entsql.Restrict),
 		),
+		edge.To("sessions", Session.Type).Annotations(
+			p, entsql.OnDelete(entsql.Cascade), entoas.Skip(true),
+		),
+		edge.To("credentials", Credential.Type).Annotations(
+			p, entsql.OnDelete(entsql.Cascade), entoas.Skip(true),
+		),
+		edge.To("password_resets", PasswordReset.Type).Annotations(
+			p, entsql.OnDelete(entsql.Cascade), entoas.Skip(true),
+		),
+		edge.To("password_history", PasswordHistory.Type).Annotations(
+			p, entsql.OnDelete(entsql.Cascade), entoas.Skip(true),
+		),
+		edge.To("identities", LinkedIdentity.Type).Annotations(
+			p, entsql.OnDelete(entsql.Cascade), entoas.Skip(true),
+		),
+		edge.To("oauth_clients", OauthClient.Type).Annotations(
+			p, entsql.OnDelete(entsql.Restrict), entoas.Skip(true),
+		),
+		edge.To("oauth_codes", OauthCode.Type).Annotations(
+			p, entsql.OnDelete(entsql.Cascade), entoas.Skip(true),
+		),
+		edge.To("service_account", ServiceAccount.Type).Unique().Annotations(
+			entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+			entsql.OnDelete(entsql.Restrict), entoas.Skip(true),
+		),
+	}
+}
+
+// Usernames and emails are unique within an organization. Service accounts
+// have a username namespace of their own, so they never take or collide with
+// usernames of users.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "is_service_account", "username").
+			Unique(),
+		index.Fields("organization_id", "email").Unique(),
 	}
 }
 
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserRole holds the schema definition for the UserRole entity.
+// It is the assignment of a role to a user, which is optionally limited to
+// the time window between `starts_at` and `expires_at`.
+type UserRole struct {
+	ent.Schema
+}
+
+func (UserRole) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// keeps the join table of the former plain many-to-many edge
+		entsql.Annotation{Table: "role_users"},
+		edge.Annotation{StructTag: `json:"-"`},
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (UserRole) Fields() []ent.Field {
+	return []ent.Field{
+		// surrogate key, as entoas doesn't support composite keys
+		field.Uint64("id"),
+		field.Uint32("role_id"),
+		field.Uint64("user_id"),
+		field.Time("starts_at").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+	}
+}
+
+func (UserRole) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("role", Role.Type).Unique().Required().Field("role_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+			),
+		edge.To("user", User.Type).Unique().Required().Field("user_id").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+			),
+	}
+}
+
+func (UserRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role_id", "user_id").Unique(),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+)
+
+// WebauthnChallenge holds the schema definition for the WebauthnChallenge
+// entity. Each row is the challenge of a finished WebAuthn ceremony, so the
+// same assertion can't be replayed before the ceremony expires. Only the hash
+// of the challenge is stored.
+type WebauthnChallenge struct {
+	ent.Schema
+}
+
+func (WebauthnChallenge) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Stores consumed WebAuthn challenges"),
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (WebauthnChallenge) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		// SHA-256 hash of the challenge
+		field.Bytes("challenge").Sensitive().Unique().Immutable().
+			SchemaType(
+				map[string]string{
+					dialect.MySQL:    "binary(32)",
+					dialect.Postgres: "binary(32)",
+					dialect.SQLite:   "blob",
+				},
+			).Annotations(entoas.Skip(true)),
+		// the ceremony expires at, the row can be removed afterwards
+		field.Time("expires_at").Immutable().Annotations(entoas.Skip(true)),
+		field.Time("created_at").Immutable().Default(time.Now).
+			Annotations(entoas.Skip(true)),
+	}
+}
@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	ee "github.com/eidng8/go-ent"
+	"github.com/ogen-go/ogen"
+
+	"github.com/eidng8/go-attr-rbac/api"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// Each webhook subscribes a URL to a set of identity and access events.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.OnDelete(entsql.Restrict),
+		edge.Annotation{StructTag: `json:"-"`},
+		schema.Comment("Stores webhook subscriptions"),
+	}
+}
+
+func (Webhook) Fields() []ent.Field {
+	u1 := uint64(1)
+	u16 := uint64(16)
+	u255 := uint64(255)
+	u2048 := uint64(2048)
+	events := make([]json.RawMessage, len(api.WebhookEvents))
+	for i, e := range api.WebhookEvents {
+		events[i] = json.RawMessage(`"` + e + `"`)
+	}
+	return append(
+		[]ent.Field{
+			field.Uint32("id").Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+					},
+				),
+			),
+			field.Uint32("organization_id").
+				Default(api.DefaultOrganizationId).Immutable().
+				Annotations(
+					entoas.Schema(
+						&ogen.Schema{
+							Type:    "integer",
+							Format:  "uint32",
+							Minimum: ogen.Num("1"),
+						},
+					),
+				),
+			field.String("url").NotEmpty().MaxLen(2048).Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						Format:    "uri",
+						MinLength: &u1,
+						MaxLength: &u2048,
+					},
+				),
+			),
+			field.String("secret").Sensitive().NotEmpty().Annotations(
+				entoas.Skip(true),
+				entoas.Schema(
+					&ogen.Schema{
+						Type:      "string",
+						MinLength: &u16,
+						MaxLength: &u255,
+					},
+				),
+			),
+			field.JSON("events", []string{}).Annotations(
+				entoas.Schema(
+					&ogen.Schema{
+						Type:     "array",
+						MinItems: &u1,
+						Items: &ogen.Items{
+							Item: &ogen.Schema{Type: "string", Enum: events},
+						},
+					},
+				),
+			),
+			field.Bool("active").Default(true),
+		},
+		ee.Timestamps()...,
+	)
+}
+
+// Webhooks only receive events of their organization.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).Ref("webhooks").
+			Field("organization_id").Unique().Required().Immutable().
+			Annotations(
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+				entoas.Skip(true),
+			),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Restrict)),
+	}
+}
@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entoas"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/ogen-go/ogen"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// This table is the outbox of webhook events. Rows are written in the same
+// transaction as the change that triggers the event, and are picked up by the
+// dispatcher until they are delivered or run out of attempts.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.OnDelete(entsql.Restrict),
+		edge.Annotation{StructTag: `json:"-"`},
+		schema.Comment("Outbox of webhook deliveries"),
+		entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ReadOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.UpdateOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.DeleteOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+		entoas.ListOperation(entoas.OperationPolicy(entoas.PolicyExclude)),
+	}
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.Uint32("webhook_id").Immutable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:    "integer",
+					Format:  "uint32",
+					Minimum: ogen.Num("1"),
+				},
+			),
+		),
+		field.String("event").NotEmpty().Immutable(),
+		field.JSON("payload", map[string]interface{}{}).Immutable().
+			Annotations(entoas.Skip(true)),
+		field.Enum("status").Values("pending", "delivered", "failed").
+			Default("pending"),
+		field.Uint32("attempts").Default(0),
+		field.Int("response_code").Optional().Nillable(),
+		field.String("last_error").Optional().Nillable(),
+		field.Time("next_attempt_at").Default(time.Now).Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+		field.Time("delivered_at").Optional().Nillable().Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+		field.Time("created_at").Optional().Nillable().Immutable().
+			Default(time.Now).Annotations(
+			entoas.Schema(
+				&ogen.Schema{
+					Type:   "string",
+					Format: "date-time",
+				},
+			),
+		),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).Ref("deliveries").Unique().
+			Required().Immutable().Field("webhook_id").
+			Annotations(
+				entsql.OnDelete(entsql.Restrict),
+				entoas.ReadOperation(
+					entoas.OperationPolicy(entoas.PolicyExclude),
+				),
+			),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// Stores service accounts
+type ServiceAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID uint32 `json:"owner_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ServiceAccountQuery when eager-loading is set.
+	Edges        ServiceAccountEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// ServiceAccountEdges holds the relations/edges for other nodes in the graph.
+type ServiceAccountEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *Group `json:"owner,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ServiceAccountEdges) OwnerOrErr() (*Group, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: group.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ServiceAccountEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ServiceAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case serviceaccount.FieldID, serviceaccount.FieldOwnerID, serviceaccount.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case serviceaccount.FieldName, serviceaccount.FieldDescription, serviceaccount.FieldClientID:
+			values[i] = new(sql.NullString)
+		case serviceaccount.FieldCreatedAt, serviceaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ServiceAccount fields.
+func (sa *ServiceAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case serviceaccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sa.ID = uint32(value.Int64)
+		case serviceaccount.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sa.Name = value.String
+			}
+		case serviceaccount.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sa.Description = value.String
+			}
+		case serviceaccount.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				sa.OwnerID = uint32(value.Int64)
+			}
+		case serviceaccount.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sa.UserID = uint64(value.Int64)
+			}
+		case serviceaccount.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				sa.ClientID = value.String
+			}
+		case serviceaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = new(time.Time)
+				*sa.CreatedAt = value.Time
+			}
+		case serviceaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sa.UpdatedAt = new(time.Time)
+				*sa.UpdatedAt = value.Time
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ServiceAccount.
+// This includes values selected through modifiers, order, etc.
+func (sa *ServiceAccount) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the ServiceAccount entity.
+func (sa *ServiceAccount) QueryOwner() *GroupQuery {
+	return NewServiceAccountClient(sa.config).QueryOwner(sa)
+}
+
+// QueryUser queries the "user" edge of the ServiceAccount entity.
+func (sa *ServiceAccount) QueryUser() *UserQuery {
+	return NewServiceAccountClient(sa.config).QueryUser(sa)
+}
+
+// Update returns a builder for updating this ServiceAccount.
+// Note that you need to call ServiceAccount.Unwrap() before calling this method if this ServiceAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *ServiceAccount) Update() *ServiceAccountUpdateOne {
+	return NewServiceAccountClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the ServiceAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *ServiceAccount) Unwrap() *ServiceAccount {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ServiceAccount is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *ServiceAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("ServiceAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("name=")
+	builder.WriteString(sa.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sa.Description)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(sa.ClientID)
+	builder.WriteString(", ")
+	if v := sa.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sa.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckServiceAccountID returns the "ID" field value.
+func PluckServiceAccountID(sa *ServiceAccount) uint32 {
+	return sa.ID
+}
+
+// PluckServiceAccountName returns the "name" field value.
+func PluckServiceAccountName(sa *ServiceAccount) string {
+	return sa.Name
+}
+
+// PluckServiceAccountDescription returns the "description" field value.
+func PluckServiceAccountDescription(sa *ServiceAccount) string {
+	return sa.Description
+}
+
+// PluckServiceAccountOwnerID returns the "owner_id" field value.
+func PluckServiceAccountOwnerID(sa *ServiceAccount) uint32 {
+	return sa.OwnerID
+}
+
+// PluckServiceAccountUserID returns the "user_id" field value.
+func PluckServiceAccountUserID(sa *ServiceAccount) uint64 {
+	return sa.UserID
+}
+
+// PluckServiceAccountClientID returns the "client_id" field value.
+func PluckServiceAccountClientID(sa *ServiceAccount) string {
+	return sa.ClientID
+}
+
+// PluckServiceAccountCreatedAt returns the "created_at" field value.
+func PluckServiceAccountCreatedAt(sa *ServiceAccount) *time.Time {
+	return sa.CreatedAt
+}
+
+// PluckServiceAccountUpdatedAt returns the "updated_at" field value.
+func PluckServiceAccountUpdatedAt(sa *ServiceAccount) *time.Time {
+	return sa.UpdatedAt
+}
+
+// ServiceAccounts is a parsable slice of ServiceAccount.
+type ServiceAccounts []*ServiceAccount
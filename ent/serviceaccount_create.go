@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+)
+
+// ServiceAccountCreate is the builder for creating a ServiceAccount entity.
+type ServiceAccountCreate struct {
+	config
+	mutation *ServiceAccountMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sac *ServiceAccountCreate) SetName(s string) *ServiceAccountCreate {
+	sac.mutation.SetName(s)
+	return sac
+}
+
+// SetDescription sets the "description" field.
+func (sac *ServiceAccountCreate) SetDescription(s string) *ServiceAccountCreate {
+	sac.mutation.SetDescription(s)
+	return sac
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableDescription(s *string) *ServiceAccountCreate {
+	if s != nil {
+		sac.SetDescription(*s)
+	}
+	return sac
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (sac *ServiceAccountCreate) SetOwnerID(u uint32) *ServiceAccountCreate {
+	sac.mutation.SetOwnerID(u)
+	return sac
+}
+
+// SetUserID sets the "user_id" field.
+func (sac *ServiceAccountCreate) SetUserID(u uint64) *ServiceAccountCreate {
+	sac.mutation.SetUserID(u)
+	return sac
+}
+
+// SetClientID sets the "client_id" field.
+func (sac *ServiceAccountCreate) SetClientID(s string) *ServiceAccountCreate {
+	sac.mutation.SetClientID(s)
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *ServiceAccountCreate) SetCreatedAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableCreatedAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sac *ServiceAccountCreate) SetUpdatedAt(t time.Time) *ServiceAccountCreate {
+	sac.mutation.SetUpdatedAt(t)
+	return sac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sac *ServiceAccountCreate) SetNillableUpdatedAt(t *time.Time) *ServiceAccountCreate {
+	if t != nil {
+		sac.SetUpdatedAt(*t)
+	}
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *ServiceAccountCreate) SetID(u uint32) *ServiceAccountCreate {
+	sac.mutation.SetID(u)
+	return sac
+}
+
+// SetOwner sets the "owner" edge to the Group entity.
+func (sac *ServiceAccountCreate) SetOwner(g *Group) *ServiceAccountCreate {
+	return sac.SetOwnerID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sac *ServiceAccountCreate) SetUser(u *User) *ServiceAccountCreate {
+	return sac.SetUserID(u.ID)
+}
+
+// Mutation returns the ServiceAccountMutation object of the builder.
+func (sac *ServiceAccountCreate) Mutation() *ServiceAccountMutation {
+	return sac.mutation
+}
+
+// Save creates the ServiceAccount in the database.
+func (sac *ServiceAccountCreate) Save(ctx context.Context) (*ServiceAccount, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *ServiceAccountCreate) SaveX(ctx context.Context) *ServiceAccount {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *ServiceAccountCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *ServiceAccountCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *ServiceAccountCreate) defaults() {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := serviceaccount.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *ServiceAccountCreate) check() error {
+	if _, ok := sac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ServiceAccount.name"`)}
+	}
+	if v, ok := sac.mutation.Name(); ok {
+		if err := serviceaccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ServiceAccount.name": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "ServiceAccount.owner_id"`)}
+	}
+	if _, ok := sac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ServiceAccount.user_id"`)}
+	}
+	if _, ok := sac.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "ServiceAccount.client_id"`)}
+	}
+	if v, ok := sac.mutation.ClientID(); ok {
+		if err := serviceaccount.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ServiceAccount.client_id": %w`, err)}
+		}
+	}
+	if len(sac.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "ServiceAccount.owner"`)}
+	}
+	if len(sac.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ServiceAccount.user"`)}
+	}
+	return nil
+}
+
+func (sac *ServiceAccountCreate) sqlSave(ctx context.Context) (*ServiceAccount, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *ServiceAccountCreate) createSpec() (*ServiceAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ServiceAccount{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(serviceaccount.Table, sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUint32))
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sac.mutation.Name(); ok {
+		_spec.SetField(serviceaccount.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sac.mutation.Description(); ok {
+		_spec.SetField(serviceaccount.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := sac.mutation.ClientID(); ok {
+		_spec.SetField(serviceaccount.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := sac.mutation.UpdatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if nodes := sac.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serviceaccount.OwnerTable,
+			Columns: []string{serviceaccount.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   serviceaccount.UserTable,
+			Columns: []string{serviceaccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ServiceAccountCreateBulk is the builder for creating many ServiceAccount entities in bulk.
+type ServiceAccountCreateBulk struct {
+	config
+	err      error
+	builders []*ServiceAccountCreate
+}
+
+// Save creates the ServiceAccount entities in the database.
+func (sacb *ServiceAccountCreateBulk) Save(ctx context.Context) ([]*ServiceAccount, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*ServiceAccount, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ServiceAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *ServiceAccountCreateBulk) SaveX(ctx context.Context) []*ServiceAccount {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *ServiceAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *ServiceAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
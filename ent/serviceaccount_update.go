@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+)
+
+// ServiceAccountUpdate is the builder for updating ServiceAccount entities.
+type ServiceAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ServiceAccountMutation
+}
+
+// Where appends a list predicates to the ServiceAccountUpdate builder.
+func (sau *ServiceAccountUpdate) Where(ps ...predicate.ServiceAccount) *ServiceAccountUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetDescription sets the "description" field.
+func (sau *ServiceAccountUpdate) SetDescription(s string) *ServiceAccountUpdate {
+	sau.mutation.SetDescription(s)
+	return sau
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sau *ServiceAccountUpdate) SetNillableDescription(s *string) *ServiceAccountUpdate {
+	if s != nil {
+		sau.SetDescription(*s)
+	}
+	return sau
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sau *ServiceAccountUpdate) ClearDescription() *ServiceAccountUpdate {
+	sau.mutation.ClearDescription()
+	return sau
+}
+
+// Mutation returns the ServiceAccountMutation object of the builder.
+func (sau *ServiceAccountUpdate) Mutation() *ServiceAccountMutation {
+	return sau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *ServiceAccountUpdate) Save(ctx context.Context) (int, error) {
+	sau.defaults()
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *ServiceAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *ServiceAccountUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *ServiceAccountUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sau *ServiceAccountUpdate) defaults() {
+	if _, ok := sau.mutation.UpdatedAt(); !ok && !sau.mutation.UpdatedAtCleared() {
+		v := serviceaccount.UpdateDefaultUpdatedAt()
+		sau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *ServiceAccountUpdate) check() error {
+	if sau.mutation.OwnerCleared() && len(sau.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServiceAccount.owner"`)
+	}
+	if sau.mutation.UserCleared() && len(sau.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServiceAccount.user"`)
+	}
+	return nil
+}
+
+func (sau *ServiceAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(serviceaccount.Table, serviceaccount.Columns, sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUint32))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.Description(); ok {
+		_spec.SetField(serviceaccount.FieldDescription, field.TypeString, value)
+	}
+	if sau.mutation.DescriptionCleared() {
+		_spec.ClearField(serviceaccount.FieldDescription, field.TypeString)
+	}
+	if sau.mutation.CreatedAtCleared() {
+		_spec.ClearField(serviceaccount.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := sau.mutation.UpdatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sau.mutation.UpdatedAtCleared() {
+		_spec.ClearField(serviceaccount.FieldUpdatedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{serviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// ServiceAccountUpdateOne is the builder for updating a single ServiceAccount entity.
+type ServiceAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ServiceAccountMutation
+}
+
+// SetDescription sets the "description" field.
+func (sauo *ServiceAccountUpdateOne) SetDescription(s string) *ServiceAccountUpdateOne {
+	sauo.mutation.SetDescription(s)
+	return sauo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sauo *ServiceAccountUpdateOne) SetNillableDescription(s *string) *ServiceAccountUpdateOne {
+	if s != nil {
+		sauo.SetDescription(*s)
+	}
+	return sauo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sauo *ServiceAccountUpdateOne) ClearDescription() *ServiceAccountUpdateOne {
+	sauo.mutation.ClearDescription()
+	return sauo
+}
+
+// Mutation returns the ServiceAccountMutation object of the builder.
+func (sauo *ServiceAccountUpdateOne) Mutation() *ServiceAccountMutation {
+	return sauo.mutation
+}
+
+// Where appends a list predicates to the ServiceAccountUpdate builder.
+func (sauo *ServiceAccountUpdateOne) Where(ps ...predicate.ServiceAccount) *ServiceAccountUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *ServiceAccountUpdateOne) Select(field string, fields ...string) *ServiceAccountUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated ServiceAccount entity.
+func (sauo *ServiceAccountUpdateOne) Save(ctx context.Context) (*ServiceAccount, error) {
+	sauo.defaults()
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *ServiceAccountUpdateOne) SaveX(ctx context.Context) *ServiceAccount {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *ServiceAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *ServiceAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sauo *ServiceAccountUpdateOne) defaults() {
+	if _, ok := sauo.mutation.UpdatedAt(); !ok && !sauo.mutation.UpdatedAtCleared() {
+		v := serviceaccount.UpdateDefaultUpdatedAt()
+		sauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *ServiceAccountUpdateOne) check() error {
+	if sauo.mutation.OwnerCleared() && len(sauo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServiceAccount.owner"`)
+	}
+	if sauo.mutation.UserCleared() && len(sauo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServiceAccount.user"`)
+	}
+	return nil
+}
+
+func (sauo *ServiceAccountUpdateOne) sqlSave(ctx context.Context) (_node *ServiceAccount, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(serviceaccount.Table, serviceaccount.Columns, sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUint32))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ServiceAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, serviceaccount.FieldID)
+		for _, f := range fields {
+			if !serviceaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != serviceaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.Description(); ok {
+		_spec.SetField(serviceaccount.FieldDescription, field.TypeString, value)
+	}
+	if sauo.mutation.DescriptionCleared() {
+		_spec.ClearField(serviceaccount.FieldDescription, field.TypeString)
+	}
+	if sauo.mutation.CreatedAtCleared() {
+		_spec.ClearField(serviceaccount.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := sauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(serviceaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sauo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(serviceaccount.FieldUpdatedAt, field.TypeTime)
+	}
+	_node = &ServiceAccount{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{serviceaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+// Stores separation-of-duties constraints
+type SodConstraint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint32 `json:"id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uint32 `json:"organization_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SodConstraintQuery when eager-loading is set.
+	Edges        SodConstraintEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// SodConstraintEdges holds the relations/edges for other nodes in the graph.
+type SodConstraintEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SodConstraintEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e SodConstraintEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[1] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SodConstraint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sodconstraint.FieldID, sodconstraint.FieldOrganizationID:
+			values[i] = new(sql.NullInt64)
+		case sodconstraint.FieldName, sodconstraint.FieldDescription:
+			values[i] = new(sql.NullString)
+		case sodconstraint.FieldCreatedAt, sodconstraint.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SodConstraint fields.
+func (sc *SodConstraint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sodconstraint.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sc.ID = uint32(value.Int64)
+		case sodconstraint.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				sc.OrganizationID = uint32(value.Int64)
+			}
+		case sodconstraint.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sc.Name = value.String
+			}
+		case sodconstraint.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sc.Description = value.String
+			}
+		case sodconstraint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sc.CreatedAt = new(time.Time)
+				*sc.CreatedAt = value.Time
+			}
+		case sodconstraint.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sc.UpdatedAt = new(time.Time)
+				*sc.UpdatedAt = value.Time
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SodConstraint.
+// This includes values selected through modifiers, order, etc.
+func (sc *SodConstraint) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the SodConstraint entity.
+func (sc *SodConstraint) QueryOrganization() *OrganizationQuery {
+	return NewSodConstraintClient(sc.config).QueryOrganization(sc)
+}
+
+// QueryRoles queries the "roles" edge of the SodConstraint entity.
+func (sc *SodConstraint) QueryRoles() *RoleQuery {
+	return NewSodConstraintClient(sc.config).QueryRoles(sc)
+}
+
+// Update returns a builder for updating this SodConstraint.
+// Note that you need to call SodConstraint.Unwrap() before calling this method if this SodConstraint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *SodConstraint) Update() *SodConstraintUpdateOne {
+	return NewSodConstraintClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the SodConstraint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *SodConstraint) Unwrap() *SodConstraint {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SodConstraint is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *SodConstraint) String() string {
+	var builder strings.Builder
+	builder.WriteString("SodConstraint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", sc.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sc.Description)
+	builder.WriteString(", ")
+	if v := sc.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sc.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckSodConstraintID returns the "ID" field value.
+func PluckSodConstraintID(sc *SodConstraint) uint32 {
+	return sc.ID
+}
+
+// PluckSodConstraintOrganizationID returns the "organization_id" field value.
+func PluckSodConstraintOrganizationID(sc *SodConstraint) uint32 {
+	return sc.OrganizationID
+}
+
+// PluckSodConstraintName returns the "name" field value.
+func PluckSodConstraintName(sc *SodConstraint) string {
+	return sc.Name
+}
+
+// PluckSodConstraintDescription returns the "description" field value.
+func PluckSodConstraintDescription(sc *SodConstraint) string {
+	return sc.Description
+}
+
+// PluckSodConstraintCreatedAt returns the "created_at" field value.
+func PluckSodConstraintCreatedAt(sc *SodConstraint) *time.Time {
+	return sc.CreatedAt
+}
+
+// PluckSodConstraintUpdatedAt returns the "updated_at" field value.
+func PluckSodConstraintUpdatedAt(sc *SodConstraint) *time.Time {
+	return sc.UpdatedAt
+}
+
+// SodConstraints is a parsable slice of SodConstraint.
+type SodConstraints []*SodConstraint
@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+// SodConstraintCreate is the builder for creating a SodConstraint entity.
+type SodConstraintCreate struct {
+	config
+	mutation *SodConstraintMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (scc *SodConstraintCreate) SetOrganizationID(u uint32) *SodConstraintCreate {
+	scc.mutation.SetOrganizationID(u)
+	return scc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (scc *SodConstraintCreate) SetNillableOrganizationID(u *uint32) *SodConstraintCreate {
+	if u != nil {
+		scc.SetOrganizationID(*u)
+	}
+	return scc
+}
+
+// SetName sets the "name" field.
+func (scc *SodConstraintCreate) SetName(s string) *SodConstraintCreate {
+	scc.mutation.SetName(s)
+	return scc
+}
+
+// SetDescription sets the "description" field.
+func (scc *SodConstraintCreate) SetDescription(s string) *SodConstraintCreate {
+	scc.mutation.SetDescription(s)
+	return scc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (scc *SodConstraintCreate) SetNillableDescription(s *string) *SodConstraintCreate {
+	if s != nil {
+		scc.SetDescription(*s)
+	}
+	return scc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (scc *SodConstraintCreate) SetCreatedAt(t time.Time) *SodConstraintCreate {
+	scc.mutation.SetCreatedAt(t)
+	return scc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (scc *SodConstraintCreate) SetNillableCreatedAt(t *time.Time) *SodConstraintCreate {
+	if t != nil {
+		scc.SetCreatedAt(*t)
+	}
+	return scc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scc *SodConstraintCreate) SetUpdatedAt(t time.Time) *SodConstraintCreate {
+	scc.mutation.SetUpdatedAt(t)
+	return scc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (scc *SodConstraintCreate) SetNillableUpdatedAt(t *time.Time) *SodConstraintCreate {
+	if t != nil {
+		scc.SetUpdatedAt(*t)
+	}
+	return scc
+}
+
+// SetID sets the "id" field.
+func (scc *SodConstraintCreate) SetID(u uint32) *SodConstraintCreate {
+	scc.mutation.SetID(u)
+	return scc
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (scc *SodConstraintCreate) SetOrganization(o *Organization) *SodConstraintCreate {
+	return scc.SetOrganizationID(o.ID)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (scc *SodConstraintCreate) AddRoleIDs(ids ...uint32) *SodConstraintCreate {
+	scc.mutation.AddRoleIDs(ids...)
+	return scc
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (scc *SodConstraintCreate) AddRoles(r ...*Role) *SodConstraintCreate {
+	ids := make([]uint32, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return scc.AddRoleIDs(ids...)
+}
+
+// Mutation returns the SodConstraintMutation object of the builder.
+func (scc *SodConstraintCreate) Mutation() *SodConstraintMutation {
+	return scc.mutation
+}
+
+// Save creates the SodConstraint in the database.
+func (scc *SodConstraintCreate) Save(ctx context.Context) (*SodConstraint, error) {
+	scc.defaults()
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *SodConstraintCreate) SaveX(ctx context.Context) *SodConstraint {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *SodConstraintCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *SodConstraintCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scc *SodConstraintCreate) defaults() {
+	if _, ok := scc.mutation.OrganizationID(); !ok {
+		v := sodconstraint.DefaultOrganizationID
+		scc.mutation.SetOrganizationID(v)
+	}
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		v := sodconstraint.DefaultCreatedAt()
+		scc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *SodConstraintCreate) check() error {
+	if _, ok := scc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "SodConstraint.organization_id"`)}
+	}
+	if _, ok := scc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SodConstraint.name"`)}
+	}
+	if v, ok := scc.mutation.Name(); ok {
+		if err := sodconstraint.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SodConstraint.name": %w`, err)}
+		}
+	}
+	if len(scc.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "SodConstraint.organization"`)}
+	}
+	return nil
+}
+
+func (scc *SodConstraintCreate) sqlSave(ctx context.Context) (*SodConstraint, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *SodConstraintCreate) createSpec() (*SodConstraint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SodConstraint{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(sodconstraint.Table, sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32))
+	)
+	if id, ok := scc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := scc.mutation.Name(); ok {
+		_spec.SetField(sodconstraint.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := scc.mutation.Description(); ok {
+		_spec.SetField(sodconstraint.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := scc.mutation.CreatedAt(); ok {
+		_spec.SetField(sodconstraint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := scc.mutation.UpdatedAt(); ok {
+		_spec.SetField(sodconstraint.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if nodes := scc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sodconstraint.OrganizationTable,
+			Columns: []string{sodconstraint.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scc.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   sodconstraint.RolesTable,
+			Columns: sodconstraint.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SodConstraintCreateBulk is the builder for creating many SodConstraint entities in bulk.
+type SodConstraintCreateBulk struct {
+	config
+	err      error
+	builders []*SodConstraintCreate
+}
+
+// Save creates the SodConstraint entities in the database.
+func (sccb *SodConstraintCreateBulk) Save(ctx context.Context) ([]*SodConstraint, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*SodConstraint, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SodConstraintMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *SodConstraintCreateBulk) SaveX(ctx context.Context) []*SodConstraint {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *SodConstraintCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *SodConstraintCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
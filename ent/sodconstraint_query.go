@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+// SodConstraintQuery is the builder for querying SodConstraint entities.
+type SodConstraintQuery struct {
+	config
+	ctx              *QueryContext
+	order            []sodconstraint.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.SodConstraint
+	withOrganization *OrganizationQuery
+	withRoles        *RoleQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SodConstraintQuery builder.
+func (scq *SodConstraintQuery) Where(ps ...predicate.SodConstraint) *SodConstraintQuery {
+	scq.predicates = append(scq.predicates, ps...)
+	return scq
+}
+
+// Limit the number of records to be returned by this query.
+func (scq *SodConstraintQuery) Limit(limit int) *SodConstraintQuery {
+	scq.ctx.Limit = &limit
+	return scq
+}
+
+// Offset to start from.
+func (scq *SodConstraintQuery) Offset(offset int) *SodConstraintQuery {
+	scq.ctx.Offset = &offset
+	return scq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (scq *SodConstraintQuery) Unique(unique bool) *SodConstraintQuery {
+	scq.ctx.Unique = &unique
+	return scq
+}
+
+// Order specifies how the records should be ordered.
+func (scq *SodConstraintQuery) Order(o ...sodconstraint.OrderOption) *SodConstraintQuery {
+	scq.order = append(scq.order, o...)
+	return scq
+}
+
+// QueryOrganization chains the current query on the "organization" edge.
+func (scq *SodConstraintQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sodconstraint.Table, sodconstraint.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sodconstraint.OrganizationTable, sodconstraint.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRoles chains the current query on the "roles" edge.
+func (scq *SodConstraintQuery) QueryRoles() *RoleQuery {
+	query := (&RoleClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sodconstraint.Table, sodconstraint.FieldID, selector),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, sodconstraint.RolesTable, sodconstraint.RolesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SodConstraint entity from the query.
+// Returns a *NotFoundError when no SodConstraint was found.
+func (scq *SodConstraintQuery) First(ctx context.Context) (*SodConstraint, error) {
+	nodes, err := scq.Limit(1).All(setContextOp(ctx, scq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sodconstraint.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (scq *SodConstraintQuery) FirstX(ctx context.Context) *SodConstraint {
+	node, err := scq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SodConstraint ID from the query.
+// Returns a *NotFoundError when no SodConstraint ID was found.
+func (scq *SodConstraintQuery) FirstID(ctx context.Context) (id uint32, err error) {
+	var ids []uint32
+	if ids, err = scq.Limit(1).IDs(setContextOp(ctx, scq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sodconstraint.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (scq *SodConstraintQuery) FirstIDX(ctx context.Context) uint32 {
+	id, err := scq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SodConstraint entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SodConstraint entity is found.
+// Returns a *NotFoundError when no SodConstraint entities are found.
+func (scq *SodConstraintQuery) Only(ctx context.Context) (*SodConstraint, error) {
+	nodes, err := scq.Limit(2).All(setContextOp(ctx, scq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sodconstraint.Label}
+	default:
+		return nil, &NotSingularError{sodconstraint.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (scq *SodConstraintQuery) OnlyX(ctx context.Context) *SodConstraint {
+	node, err := scq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SodConstraint ID in the query.
+// Returns a *NotSingularError when more than one SodConstraint ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (scq *SodConstraintQuery) OnlyID(ctx context.Context) (id uint32, err error) {
+	var ids []uint32
+	if ids, err = scq.Limit(2).IDs(setContextOp(ctx, scq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sodconstraint.Label}
+	default:
+		err = &NotSingularError{sodconstraint.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (scq *SodConstraintQuery) OnlyIDX(ctx context.Context) uint32 {
+	id, err := scq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SodConstraints.
+func (scq *SodConstraintQuery) All(ctx context.Context) ([]*SodConstraint, error) {
+	ctx = setContextOp(ctx, scq.ctx, ent.OpQueryAll)
+	if err := scq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SodConstraint, *SodConstraintQuery]()
+	return withInterceptors[[]*SodConstraint](ctx, scq, qr, scq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (scq *SodConstraintQuery) AllX(ctx context.Context) []*SodConstraint {
+	nodes, err := scq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SodConstraint IDs.
+func (scq *SodConstraintQuery) IDs(ctx context.Context) (ids []uint32, err error) {
+	if scq.ctx.Unique == nil && scq.path != nil {
+		scq.Unique(true)
+	}
+	ctx = setContextOp(ctx, scq.ctx, ent.OpQueryIDs)
+	if err = scq.Select(sodconstraint.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (scq *SodConstraintQuery) IDsX(ctx context.Context) []uint32 {
+	ids, err := scq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (scq *SodConstraintQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, scq.ctx, ent.OpQueryCount)
+	if err := scq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, scq, querierCount[*SodConstraintQuery](), scq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (scq *SodConstraintQuery) CountX(ctx context.Context) int {
+	count, err := scq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (scq *SodConstraintQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, scq.ctx, ent.OpQueryExist)
+	switch _, err := scq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (scq *SodConstraintQuery) ExistX(ctx context.Context) bool {
+	exist, err := scq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SodConstraintQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (scq *SodConstraintQuery) Clone() *SodConstraintQuery {
+	if scq == nil {
+		return nil
+	}
+	return &SodConstraintQuery{
+		config:           scq.config,
+		ctx:              scq.ctx.Clone(),
+		order:            append([]sodconstraint.OrderOption{}, scq.order...),
+		inters:           append([]Interceptor{}, scq.inters...),
+		predicates:       append([]predicate.SodConstraint{}, scq.predicates...),
+		withOrganization: scq.withOrganization.Clone(),
+		withRoles:        scq.withRoles.Clone(),
+		// clone intermediate query.
+		sql:  scq.sql.Clone(),
+		path: scq.path,
+	}
+}
+
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SodConstraintQuery) WithOrganization(opts ...func(*OrganizationQuery)) *SodConstraintQuery {
+	query := (&OrganizationClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withOrganization = query
+	return scq
+}
+
+// WithRoles tells the query-builder to eager-load the nodes that are connected to
+// the "roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SodConstraintQuery) WithRoles(opts ...func(*RoleQuery)) *SodConstraintQuery {
+	query := (&RoleClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withRoles = query
+	return scq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uint32 `json:"organization_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SodConstraint.Query().
+//		GroupBy(sodconstraint.FieldOrganizationID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (scq *SodConstraintQuery) GroupBy(field string, fields ...string) *SodConstraintGroupBy {
+	scq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SodConstraintGroupBy{build: scq}
+	grbuild.flds = &scq.ctx.Fields
+	grbuild.label = sodconstraint.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrganizationID uint32 `json:"organization_id,omitempty"`
+//	}
+//
+//	client.SodConstraint.Query().
+//		Select(sodconstraint.FieldOrganizationID).
+//		Scan(ctx, &v)
+func (scq *SodConstraintQuery) Select(fields ...string) *SodConstraintSelect {
+	scq.ctx.Fields = append(scq.ctx.Fields, fields...)
+	sbuild := &SodConstraintSelect{SodConstraintQuery: scq}
+	sbuild.label = sodconstraint.Label
+	sbuild.flds, sbuild.scan = &scq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SodConstraintSelect configured with the given aggregations.
+func (scq *SodConstraintQuery) Aggregate(fns ...AggregateFunc) *SodConstraintSelect {
+	return scq.Select().Aggregate(fns...)
+}
+
+func (scq *SodConstraintQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range scq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, scq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range scq.ctx.Fields {
+		if !sodconstraint.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if scq.path != nil {
+		prev, err := scq.path(ctx)
+		if err != nil {
+			return err
+		}
+		scq.sql = prev
+	}
+	return nil
+}
+
+func (scq *SodConstraintQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SodConstraint, error) {
+	var (
+		nodes       = []*SodConstraint{}
+		_spec       = scq.querySpec()
+		loadedTypes = [2]bool{
+			scq.withOrganization != nil,
+			scq.withRoles != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SodConstraint).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SodConstraint{config: scq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, scq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := scq.withOrganization; query != nil {
+		if err := scq.loadOrganization(ctx, query, nodes, nil,
+			func(n *SodConstraint, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := scq.withRoles; query != nil {
+		if err := scq.loadRoles(ctx, query, nodes,
+			func(n *SodConstraint) { n.Edges.Roles = []*Role{} },
+			func(n *SodConstraint, e *Role) { n.Edges.Roles = append(n.Edges.Roles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (scq *SodConstraintQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*SodConstraint, init func(*SodConstraint), assign func(*SodConstraint, *Organization)) error {
+	ids := make([]uint32, 0, len(nodes))
+	nodeids := make(map[uint32][]*SodConstraint)
+	for i := range nodes {
+		fk := nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (scq *SodConstraintQuery) loadRoles(ctx context.Context, query *RoleQuery, nodes []*SodConstraint, init func(*SodConstraint), assign func(*SodConstraint, *Role)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint32]*SodConstraint)
+	nids := make(map[uint32]map[*SodConstraint]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(sodconstraint.RolesTable)
+		s.Join(joinT).On(s.C(role.FieldID), joinT.C(sodconstraint.RolesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(sodconstraint.RolesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(sodconstraint.RolesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint32(values[0].(*sql.NullInt64).Int64)
+				inValue := uint32(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*SodConstraint]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Role](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "roles" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (scq *SodConstraintQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := scq.querySpec()
+	_spec.Node.Columns = scq.ctx.Fields
+	if len(scq.ctx.Fields) > 0 {
+		_spec.Unique = scq.ctx.Unique != nil && *scq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, scq.driver, _spec)
+}
+
+func (scq *SodConstraintQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sodconstraint.Table, sodconstraint.Columns, sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32))
+	_spec.From = scq.sql
+	if unique := scq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if scq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := scq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sodconstraint.FieldID)
+		for i := range fields {
+			if fields[i] != sodconstraint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if scq.withOrganization != nil {
+			_spec.Node.AddColumnOnce(sodconstraint.FieldOrganizationID)
+		}
+	}
+	if ps := scq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := scq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (scq *SodConstraintQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(scq.driver.Dialect())
+	t1 := builder.Table(sodconstraint.Table)
+	columns := scq.ctx.Fields
+	if len(columns) == 0 {
+		columns = sodconstraint.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if scq.sql != nil {
+		selector = scq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if scq.ctx.Unique != nil && *scq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range scq.predicates {
+		p(selector)
+	}
+	for _, p := range scq.order {
+		p(selector)
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SodConstraintGroupBy is the group-by builder for SodConstraint entities.
+type SodConstraintGroupBy struct {
+	selector
+	build *SodConstraintQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (scgb *SodConstraintGroupBy) Aggregate(fns ...AggregateFunc) *SodConstraintGroupBy {
+	scgb.fns = append(scgb.fns, fns...)
+	return scgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scgb *SodConstraintGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scgb.build.ctx, ent.OpQueryGroupBy)
+	if err := scgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SodConstraintQuery, *SodConstraintGroupBy](ctx, scgb.build, scgb, scgb.build.inters, v)
+}
+
+func (scgb *SodConstraintGroupBy) sqlScan(ctx context.Context, root *SodConstraintQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(scgb.fns))
+	for _, fn := range scgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*scgb.flds)+len(scgb.fns))
+		for _, f := range *scgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*scgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SodConstraintSelect is the builder for selecting fields of SodConstraint entities.
+type SodConstraintSelect struct {
+	*SodConstraintQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (scs *SodConstraintSelect) Aggregate(fns ...AggregateFunc) *SodConstraintSelect {
+	scs.fns = append(scs.fns, fns...)
+	return scs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scs *SodConstraintSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scs.ctx, ent.OpQuerySelect)
+	if err := scs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SodConstraintQuery, *SodConstraintSelect](ctx, scs.SodConstraintQuery, scs, scs.inters, v)
+}
+
+func (scs *SodConstraintSelect) sqlScan(ctx context.Context, root *SodConstraintQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(scs.fns))
+	for _, fn := range scs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*scs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/sodconstraint"
+)
+
+// SodConstraintUpdate is the builder for updating SodConstraint entities.
+type SodConstraintUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SodConstraintMutation
+}
+
+// Where appends a list predicates to the SodConstraintUpdate builder.
+func (scu *SodConstraintUpdate) Where(ps ...predicate.SodConstraint) *SodConstraintUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetName sets the "name" field.
+func (scu *SodConstraintUpdate) SetName(s string) *SodConstraintUpdate {
+	scu.mutation.SetName(s)
+	return scu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (scu *SodConstraintUpdate) SetNillableName(s *string) *SodConstraintUpdate {
+	if s != nil {
+		scu.SetName(*s)
+	}
+	return scu
+}
+
+// SetDescription sets the "description" field.
+func (scu *SodConstraintUpdate) SetDescription(s string) *SodConstraintUpdate {
+	scu.mutation.SetDescription(s)
+	return scu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (scu *SodConstraintUpdate) SetNillableDescription(s *string) *SodConstraintUpdate {
+	if s != nil {
+		scu.SetDescription(*s)
+	}
+	return scu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (scu *SodConstraintUpdate) ClearDescription() *SodConstraintUpdate {
+	scu.mutation.ClearDescription()
+	return scu
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (scu *SodConstraintUpdate) AddRoleIDs(ids ...uint32) *SodConstraintUpdate {
+	scu.mutation.AddRoleIDs(ids...)
+	return scu
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (scu *SodConstraintUpdate) AddRoles(r ...*Role) *SodConstraintUpdate {
+	ids := make([]uint32, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return scu.AddRoleIDs(ids...)
+}
+
+// Mutation returns the SodConstraintMutation object of the builder.
+func (scu *SodConstraintUpdate) Mutation() *SodConstraintMutation {
+	return scu.mutation
+}
+
+// ClearRoles clears all "roles" edges to the Role entity.
+func (scu *SodConstraintUpdate) ClearRoles() *SodConstraintUpdate {
+	scu.mutation.ClearRoles()
+	return scu
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (scu *SodConstraintUpdate) RemoveRoleIDs(ids ...uint32) *SodConstraintUpdate {
+	scu.mutation.RemoveRoleIDs(ids...)
+	return scu
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (scu *SodConstraintUpdate) RemoveRoles(r ...*Role) *SodConstraintUpdate {
+	ids := make([]uint32, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return scu.RemoveRoleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *SodConstraintUpdate) Save(ctx context.Context) (int, error) {
+	scu.defaults()
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *SodConstraintUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *SodConstraintUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *SodConstraintUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scu *SodConstraintUpdate) defaults() {
+	if _, ok := scu.mutation.UpdatedAt(); !ok && !scu.mutation.UpdatedAtCleared() {
+		v := sodconstraint.UpdateDefaultUpdatedAt()
+		scu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *SodConstraintUpdate) check() error {
+	if v, ok := scu.mutation.Name(); ok {
+		if err := sodconstraint.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SodConstraint.name": %w`, err)}
+		}
+	}
+	if scu.mutation.OrganizationCleared() && len(scu.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SodConstraint.organization"`)
+	}
+	return nil
+}
+
+func (scu *SodConstraintUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sodconstraint.Table, sodconstraint.Columns, sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.Name(); ok {
+		_spec.SetField(sodconstraint.FieldName, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.Description(); ok {
+		_spec.SetField(sodconstraint.FieldDescription, field.TypeString, value)
+	}
+	if scu.mutation.DescriptionCleared() {
+		_spec.ClearField(sodconstraint.FieldDescription, field.TypeString)
+	}
+	if scu.mutation.CreatedAtCleared() {
+		_spec.ClearField(sodconstraint.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := scu.mutation.UpdatedAt(); ok {
+		_spec.SetField(sodconstraint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if scu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(sodconstraint.FieldUpdatedAt, field.TypeTime)
+	}
+	if scu.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   sodconstraint.RolesTable,
+			Columns: sodconstraint.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.RemovedRolesIDs(); len(nodes) > 0 && !scu.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   sodconstraint.RolesTable,
+			Columns: sodconstraint.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   sodconstraint.RolesTable,
+			Columns: sodconstraint.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sodconstraint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// SodConstraintUpdateOne is the builder for updating a single SodConstraint entity.
+type SodConstraintUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SodConstraintMutation
+}
+
+// SetName sets the "name" field.
+func (scuo *SodConstraintUpdateOne) SetName(s string) *SodConstraintUpdateOne {
+	scuo.mutation.SetName(s)
+	return scuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (scuo *SodConstraintUpdateOne) SetNillableName(s *string) *SodConstraintUpdateOne {
+	if s != nil {
+		scuo.SetName(*s)
+	}
+	return scuo
+}
+
+// SetDescription sets the "description" field.
+func (scuo *SodConstraintUpdateOne) SetDescription(s string) *SodConstraintUpdateOne {
+	scuo.mutation.SetDescription(s)
+	return scuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (scuo *SodConstraintUpdateOne) SetNillableDescription(s *string) *SodConstraintUpdateOne {
+	if s != nil {
+		scuo.SetDescription(*s)
+	}
+	return scuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (scuo *SodConstraintUpdateOne) ClearDescription() *SodConstraintUpdateOne {
+	scuo.mutation.ClearDescription()
+	return scuo
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (scuo *SodConstraintUpdateOne) AddRoleIDs(ids ...uint32) *SodConstraintUpdateOne {
+	scuo.mutation.AddRoleIDs(ids...)
+	return scuo
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (scuo *SodConstraintUpdateOne) AddRoles(r ...*Role) *SodConstraintUpdateOne {
+	ids := make([]uint32, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return scuo.AddRoleIDs(ids...)
+}
+
+// Mutation returns the SodConstraintMutation object of the builder.
+func (scuo *SodConstraintUpdateOne) Mutation() *SodConstraintMutation {
+	return scuo.mutation
+}
+
+// ClearRoles clears all "roles" edges to the Role entity.
+func (scuo *SodConstraintUpdateOne) ClearRoles() *SodConstraintUpdateOne {
+	scuo.mutation.ClearRoles()
+	return scuo
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (scuo *SodConstraintUpdateOne) RemoveRoleIDs(ids ...uint32) *SodConstraintUpdateOne {
+	scuo.mutation.RemoveRoleIDs(ids...)
+	return scuo
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (scuo *SodConstraintUpdateOne) RemoveRoles(r ...*Role) *SodConstraintUpdateOne {
+	ids := make([]uint32, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return scuo.RemoveRoleIDs(ids...)
+}
+
+// Where appends a list predicates to the SodConstraintUpdate builder.
+func (scuo *SodConstraintUpdateOne) Where(ps ...predicate.SodConstraint) *SodConstraintUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *SodConstraintUpdateOne) Select(field string, fields ...string) *SodConstraintUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated SodConstraint entity.
+func (scuo *SodConstraintUpdateOne) Save(ctx context.Context) (*SodConstraint, error) {
+	scuo.defaults()
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *SodConstraintUpdateOne) SaveX(ctx context.Context) *SodConstraint {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *SodConstraintUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *SodConstraintUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scuo *SodConstraintUpdateOne) defaults() {
+	if _, ok := scuo.mutation.UpdatedAt(); !ok && !scuo.mutation.UpdatedAtCleared() {
+		v := sodconstraint.UpdateDefaultUpdatedAt()
+		scuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *SodConstraintUpdateOne) check() error {
+	if v, ok := scuo.mutation.Name(); ok {
+		if err := sodconstraint.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SodConstraint.name": %w`, err)}
+		}
+	}
+	if scuo.mutation.OrganizationCleared() && len(scuo.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SodConstraint.organization"`)
+	}
+	return nil
+}
+
+func (scuo *SodConstraintUpdateOne) sqlSave(ctx context.Context) (_node *SodConstraint, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sodconstraint.Table, sodconstraint.Columns, sqlgraph.NewFieldSpec(sodconstraint.FieldID, field.TypeUint32))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SodConstraint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sodconstraint.FieldID)
+		for _, f := range fields {
+			if !sodconstraint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sodconstraint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.Name(); ok {
+		_spec.SetField(sodconstraint.FieldName, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.Description(); ok {
+		_spec.SetField(sodconstraint.FieldDescription, field.TypeString, value)
+	}
+	if scuo.mutation.DescriptionCleared() {
+		_spec.ClearField(sodconstraint.FieldDescription, field.TypeString)
+	}
+	if scuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(sodconstraint.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := scuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(sodconstraint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if scuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(sodconstraint.FieldUpdatedAt, field.TypeTime)
+	}
+	if scuo.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   sodconstraint.RolesTable,
+			Columns: sodconstraint.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.RemovedRolesIDs(); len(nodes) > 0 && !scuo.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   sodconstraint.RolesTable,
+			Columns: sodconstraint.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   sodconstraint.RolesTable,
+			Columns: sodconstraint.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SodConstraint{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sodconstraint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
@@ -14,16 +14,50 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessRequest is the client for interacting with the AccessRequest builders.
+	AccessRequest *AccessRequestClient
 	// AccessToken is the client for interacting with the AccessToken builders.
 	AccessToken *AccessTokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Credential is the client for interacting with the Credential builders.
+	Credential *CredentialClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
+	// LinkedIdentity is the client for interacting with the LinkedIdentity builders.
+	LinkedIdentity *LinkedIdentityClient
+	// OauthClient is the client for interacting with the OauthClient builders.
+	OauthClient *OauthClientClient
+	// OauthCode is the client for interacting with the OauthCode builders.
+	OauthCode *OauthCodeClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
+	// PasswordHistory is the client for interacting with the PasswordHistory builders.
+	PasswordHistory *PasswordHistoryClient
+	// PasswordReset is the client for interacting with the PasswordReset builders.
+	PasswordReset *PasswordResetClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
 	// PersonalToken is the client for interacting with the PersonalToken builders.
 	PersonalToken *PersonalTokenClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// ServiceAccount is the client for interacting with the ServiceAccount builders.
+	ServiceAccount *ServiceAccountClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// SodConstraint is the client for interacting with the SodConstraint builders.
+	SodConstraint *SodConstraintClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserRole is the client for interacting with the UserRole builders.
+	UserRole *UserRoleClient
+	// WebauthnChallenge is the client for interacting with the WebauthnChallenge builders.
+	WebauthnChallenge *WebauthnChallengeClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,11 +189,28 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessRequest = NewAccessRequestClient(tx.config)
 	tx.AccessToken = NewAccessTokenClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Credential = NewCredentialClient(tx.config)
+	tx.Group = NewGroupClient(tx.config)
+	tx.LinkedIdentity = NewLinkedIdentityClient(tx.config)
+	tx.OauthClient = NewOauthClientClient(tx.config)
+	tx.OauthCode = NewOauthCodeClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
+	tx.PasswordHistory = NewPasswordHistoryClient(tx.config)
+	tx.PasswordReset = NewPasswordResetClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
 	tx.PersonalToken = NewPersonalTokenClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.ServiceAccount = NewServiceAccountClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.SodConstraint = NewSodConstraintClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserRole = NewUserRoleClient(tx.config)
+	tx.WebauthnChallenge = NewWebauthnChallengeClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -169,7 +220,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AccessToken.QueryXXX(), the query will be executed
+// applies a query, for example: AccessRequest.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -10,6 +10,8 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
 	"github.com/eidng8/go-attr-rbac/ent/user"
 )
 
@@ -20,12 +22,32 @@ type User struct {
 	ID uint64 `json:"id,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uint32 `json:"organization_id,omitempty"`
 	// Username holds the value of the "username" field.
 	Username string `json:"username,omitempty"`
 	// Email holds the value of the "email" field.
 	Email *string `json:"email,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"-"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret *string `json:"-"`
+	// TotpConfirmedAt holds the value of the "totp_confirmed_at" field.
+	TotpConfirmedAt *time.Time `json:"totp_confirmed_at,omitempty"`
+	// TotpLastStep holds the value of the "totp_last_step" field.
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
+	// RecoveryCodes holds the value of the "recovery_codes" field.
+	RecoveryCodes []string `json:"-"`
+	// TokensValidAfter holds the value of the "tokens_valid_after" field.
+	TokensValidAfter *time.Time `json:"tokens_valid_after,omitempty"`
+	// MustChangePassword holds the value of the "must_change_password" field.
+	MustChangePassword bool `json:"must_change_password,omitempty"`
+	// AuthProvider holds the value of the "auth_provider" field.
+	AuthProvider *string `json:"auth_provider,omitempty"`
+	// Status holds the value of the "status" field.
+	Status user.Status `json:"status,omitempty"`
+	// IsServiceAccount holds the value of the "is_service_account" field.
+	IsServiceAccount bool `json:"is_service_account,omitempty"`
 	// Attr holds the value of the "attr" field.
 	Attr *map[string]interface{} `json:"attr,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -40,32 +62,85 @@ type User struct {
 
 // UserEdges holds the relations/edges for other nodes in the graph.
 type UserEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// Roles holds the value of the roles edge.
 	Roles []*Role `json:"roles,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// ApprovableRoles holds the value of the approvable_roles edge.
+	ApprovableRoles []*Role `json:"approvable_roles,omitempty"`
 	// AccessTokens holds the value of the access_tokens edge.
 	AccessTokens []*AccessToken `json:"access_tokens,omitempty"`
 	// RefreshTokens holds the value of the refresh_tokens edge.
 	RefreshTokens []*AccessToken `json:"refresh_tokens,omitempty"`
 	// PersonalTokens holds the value of the personal_tokens edge.
 	PersonalTokens []*PersonalToken `json:"personal_tokens,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// Credentials holds the value of the credentials edge.
+	Credentials []*Credential `json:"credentials,omitempty"`
+	// PasswordResets holds the value of the password_resets edge.
+	PasswordResets []*PasswordReset `json:"password_resets,omitempty"`
+	// PasswordHistory holds the value of the password_history edge.
+	PasswordHistory []*PasswordHistory `json:"password_history,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*LinkedIdentity `json:"identities,omitempty"`
+	// OauthClients holds the value of the oauth_clients edge.
+	OauthClients []*OauthClient `json:"oauth_clients,omitempty"`
+	// OauthCodes holds the value of the oauth_codes edge.
+	OauthCodes []*OauthCode `json:"oauth_codes,omitempty"`
+	// ServiceAccount holds the value of the service_account edge.
+	ServiceAccount *ServiceAccount `json:"service_account,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*UserRole `json:"assignments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [16]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
 }
 
 // RolesOrErr returns the Roles value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) RolesOrErr() ([]*Role, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		return e.Roles, nil
 	}
 	return nil, &NotLoadedError{edge: "roles"}
 }
 
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[2] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// ApprovableRolesOrErr returns the ApprovableRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ApprovableRolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[3] {
+		return e.ApprovableRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "approvable_roles"}
+}
+
 // AccessTokensOrErr returns the AccessTokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) AccessTokensOrErr() ([]*AccessToken, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[4] {
 		return e.AccessTokens, nil
 	}
 	return nil, &NotLoadedError{edge: "access_tokens"}
@@ -74,7 +149,7 @@ func (e UserEdges) AccessTokensOrErr() ([]*AccessToken, error) {
 // RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) RefreshTokensOrErr() ([]*AccessToken, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[5] {
 		return e.RefreshTokens, nil
 	}
 	return nil, &NotLoadedError{edge: "refresh_tokens"}
@@ -83,24 +158,109 @@ func (e UserEdges) RefreshTokensOrErr() ([]*AccessToken, error) {
 // PersonalTokensOrErr returns the PersonalTokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) PersonalTokensOrErr() ([]*PersonalToken, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[6] {
 		return e.PersonalTokens, nil
 	}
 	return nil, &NotLoadedError{edge: "personal_tokens"}
 }
 
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[7] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// CredentialsOrErr returns the Credentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CredentialsOrErr() ([]*Credential, error) {
+	if e.loadedTypes[8] {
+		return e.Credentials, nil
+	}
+	return nil, &NotLoadedError{edge: "credentials"}
+}
+
+// PasswordResetsOrErr returns the PasswordResets value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordResetsOrErr() ([]*PasswordReset, error) {
+	if e.loadedTypes[9] {
+		return e.PasswordResets, nil
+	}
+	return nil, &NotLoadedError{edge: "password_resets"}
+}
+
+// PasswordHistoryOrErr returns the PasswordHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordHistoryOrErr() ([]*PasswordHistory, error) {
+	if e.loadedTypes[10] {
+		return e.PasswordHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "password_history"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*LinkedIdentity, error) {
+	if e.loadedTypes[11] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// OauthClientsOrErr returns the OauthClients value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthClientsOrErr() ([]*OauthClient, error) {
+	if e.loadedTypes[12] {
+		return e.OauthClients, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_clients"}
+}
+
+// OauthCodesOrErr returns the OauthCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthCodesOrErr() ([]*OauthCode, error) {
+	if e.loadedTypes[13] {
+		return e.OauthCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_codes"}
+}
+
+// ServiceAccountOrErr returns the ServiceAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) ServiceAccountOrErr() (*ServiceAccount, error) {
+	if e.ServiceAccount != nil {
+		return e.ServiceAccount, nil
+	} else if e.loadedTypes[14] {
+		return nil, &NotFoundError{label: serviceaccount.Label}
+	}
+	return nil, &NotLoadedError{edge: "service_account"}
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AssignmentsOrErr() ([]*UserRole, error) {
+	if e.loadedTypes[15] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldAttr:
+		case user.FieldRecoveryCodes, user.FieldAttr:
 			values[i] = new([]byte)
-		case user.FieldID:
+		case user.FieldMustChangePassword, user.FieldIsServiceAccount:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldOrganizationID, user.FieldTotpLastStep:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldEmail, user.FieldPassword:
+		case user.FieldUsername, user.FieldEmail, user.FieldPassword, user.FieldTotpSecret, user.FieldAuthProvider, user.FieldStatus:
 			values[i] = new(sql.NullString)
-		case user.FieldDeletedAt, user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldDeletedAt, user.FieldTotpConfirmedAt, user.FieldTokensValidAfter, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -130,6 +290,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.DeletedAt = new(time.Time)
 				*u.DeletedAt = value.Time
 			}
+		case user.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				u.OrganizationID = uint32(value.Int64)
+			}
 		case user.FieldUsername:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field username", values[i])
@@ -149,6 +315,66 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Password = value.String
 			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = new(string)
+				*u.TotpSecret = value.String
+			}
+		case user.FieldTotpConfirmedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_confirmed_at", values[i])
+			} else if value.Valid {
+				u.TotpConfirmedAt = new(time.Time)
+				*u.TotpConfirmedAt = value.Time
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				u.TotpLastStep = value.Int64
+			}
+		case user.FieldRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.RecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field recovery_codes: %w", err)
+				}
+			}
+		case user.FieldTokensValidAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens_valid_after", values[i])
+			} else if value.Valid {
+				u.TokensValidAfter = new(time.Time)
+				*u.TokensValidAfter = value.Time
+			}
+		case user.FieldMustChangePassword:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field must_change_password", values[i])
+			} else if value.Valid {
+				u.MustChangePassword = value.Bool
+			}
+		case user.FieldAuthProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_provider", values[i])
+			} else if value.Valid {
+				u.AuthProvider = new(string)
+				*u.AuthProvider = value.String
+			}
+		case user.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				u.Status = user.Status(value.String)
+			}
+		case user.FieldIsServiceAccount:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_service_account", values[i])
+			} else if value.Valid {
+				u.IsServiceAccount = value.Bool
+			}
 		case user.FieldAttr:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field attr", values[i])
@@ -184,11 +410,26 @@ func (u *User) Value(name string) (ent.Value, error) {
 	return u.selectValues.Get(name)
 }
 
+// QueryOrganization queries the "organization" edge of the User entity.
+func (u *User) QueryOrganization() *OrganizationQuery {
+	return NewUserClient(u.config).QueryOrganization(u)
+}
+
 // QueryRoles queries the "roles" edge of the User entity.
 func (u *User) QueryRoles() *RoleQuery {
 	return NewUserClient(u.config).QueryRoles(u)
 }
 
+// QueryGroups queries the "groups" edge of the User entity.
+func (u *User) QueryGroups() *GroupQuery {
+	return NewUserClient(u.config).QueryGroups(u)
+}
+
+// QueryApprovableRoles queries the "approvable_roles" edge of the User entity.
+func (u *User) QueryApprovableRoles() *RoleQuery {
+	return NewUserClient(u.config).QueryApprovableRoles(u)
+}
+
 // QueryAccessTokens queries the "access_tokens" edge of the User entity.
 func (u *User) QueryAccessTokens() *AccessTokenQuery {
 	return NewUserClient(u.config).QueryAccessTokens(u)
@@ -204,6 +445,51 @@ func (u *User) QueryPersonalTokens() *PersonalTokenQuery {
 	return NewUserClient(u.config).QueryPersonalTokens(u)
 }
 
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *SessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
+// QueryCredentials queries the "credentials" edge of the User entity.
+func (u *User) QueryCredentials() *CredentialQuery {
+	return NewUserClient(u.config).QueryCredentials(u)
+}
+
+// QueryPasswordResets queries the "password_resets" edge of the User entity.
+func (u *User) QueryPasswordResets() *PasswordResetQuery {
+	return NewUserClient(u.config).QueryPasswordResets(u)
+}
+
+// QueryPasswordHistory queries the "password_history" edge of the User entity.
+func (u *User) QueryPasswordHistory() *PasswordHistoryQuery {
+	return NewUserClient(u.config).QueryPasswordHistory(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *LinkedIdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
+// QueryOauthClients queries the "oauth_clients" edge of the User entity.
+func (u *User) QueryOauthClients() *OauthClientQuery {
+	return NewUserClient(u.config).QueryOauthClients(u)
+}
+
+// QueryOauthCodes queries the "oauth_codes" edge of the User entity.
+func (u *User) QueryOauthCodes() *OauthCodeQuery {
+	return NewUserClient(u.config).QueryOauthCodes(u)
+}
+
+// QueryServiceAccount queries the "service_account" edge of the User entity.
+func (u *User) QueryServiceAccount() *ServiceAccountQuery {
+	return NewUserClient(u.config).QueryServiceAccount(u)
+}
+
+// QueryAssignments queries the "assignments" edge of the User entity.
+func (u *User) QueryAssignments() *UserRoleQuery {
+	return NewUserClient(u.config).QueryAssignments(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -232,6 +518,9 @@ func (u *User) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", u.OrganizationID))
+	builder.WriteString(", ")
 	builder.WriteString("username=")
 	builder.WriteString(u.Username)
 	builder.WriteString(", ")
@@ -242,6 +531,37 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.TotpConfirmedAt; v != nil {
+		builder.WriteString("totp_confirmed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("recovery_codes=<sensitive>")
+	builder.WriteString(", ")
+	if v := u.TokensValidAfter; v != nil {
+		builder.WriteString("tokens_valid_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("must_change_password=")
+	builder.WriteString(fmt.Sprintf("%v", u.MustChangePassword))
+	builder.WriteString(", ")
+	if v := u.AuthProvider; v != nil {
+		builder.WriteString("auth_provider=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", u.Status))
+	builder.WriteString(", ")
+	builder.WriteString("is_service_account=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsServiceAccount))
+	builder.WriteString(", ")
 	builder.WriteString("attr=")
 	builder.WriteString(fmt.Sprintf("%v", u.Attr))
 	builder.WriteString(", ")
@@ -268,6 +588,11 @@ func PluckUserDeletedAt(u *User) *time.Time {
 	return u.DeletedAt
 }
 
+// PluckUserOrganizationID returns the "organization_id" field value.
+func PluckUserOrganizationID(u *User) uint32 {
+	return u.OrganizationID
+}
+
 // PluckUserUsername returns the "username" field value.
 func PluckUserUsername(u *User) string {
 	return u.Username
@@ -283,6 +608,51 @@ func PluckUserPassword(u *User) string {
 	return u.Password
 }
 
+// PluckUserTotpSecret returns the "totp_secret" field value.
+func PluckUserTotpSecret(u *User) *string {
+	return u.TotpSecret
+}
+
+// PluckUserTotpConfirmedAt returns the "totp_confirmed_at" field value.
+func PluckUserTotpConfirmedAt(u *User) *time.Time {
+	return u.TotpConfirmedAt
+}
+
+// PluckUserTotpLastStep returns the "totp_last_step" field value.
+func PluckUserTotpLastStep(u *User) int64 {
+	return u.TotpLastStep
+}
+
+// PluckUserRecoveryCodes returns the "recovery_codes" field value.
+func PluckUserRecoveryCodes(u *User) []string {
+	return u.RecoveryCodes
+}
+
+// PluckUserTokensValidAfter returns the "tokens_valid_after" field value.
+func PluckUserTokensValidAfter(u *User) *time.Time {
+	return u.TokensValidAfter
+}
+
+// PluckUserMustChangePassword returns the "must_change_password" field value.
+func PluckUserMustChangePassword(u *User) bool {
+	return u.MustChangePassword
+}
+
+// PluckUserAuthProvider returns the "auth_provider" field value.
+func PluckUserAuthProvider(u *User) *string {
+	return u.AuthProvider
+}
+
+// PluckUserStatus returns the "status" field value.
+func PluckUserStatus(u *User) user.Status {
+	return u.Status
+}
+
+// PluckUserIsServiceAccount returns the "is_service_account" field value.
+func PluckUserIsServiceAccount(u *User) bool {
+	return u.IsServiceAccount
+}
+
 // PluckUserAttr returns the "attr" field value.
 func PluckUserAttr(u *User) *map[string]interface{} {
 	return u.Attr
@@ -11,9 +11,20 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/eidng8/go-attr-rbac/ent/accesstoken"
+	"github.com/eidng8/go-attr-rbac/ent/credential"
+	"github.com/eidng8/go-attr-rbac/ent/group"
+	"github.com/eidng8/go-attr-rbac/ent/linkedidentity"
+	"github.com/eidng8/go-attr-rbac/ent/oauthclient"
+	"github.com/eidng8/go-attr-rbac/ent/oauthcode"
+	"github.com/eidng8/go-attr-rbac/ent/organization"
+	"github.com/eidng8/go-attr-rbac/ent/passwordhistory"
+	"github.com/eidng8/go-attr-rbac/ent/passwordreset"
 	"github.com/eidng8/go-attr-rbac/ent/personaltoken"
 	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/serviceaccount"
+	"github.com/eidng8/go-attr-rbac/ent/session"
 	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -37,6 +48,20 @@ func (uc *UserCreate) SetNillableDeletedAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (uc *UserCreate) SetOrganizationID(u uint32) *UserCreate {
+	uc.mutation.SetOrganizationID(u)
+	return uc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableOrganizationID(u *uint32) *UserCreate {
+	if u != nil {
+		uc.SetOrganizationID(*u)
+	}
+	return uc
+}
+
 // SetUsername sets the "username" field.
 func (uc *UserCreate) SetUsername(s string) *UserCreate {
 	uc.mutation.SetUsername(s)
@@ -63,6 +88,132 @@ func (uc *UserCreate) SetPassword(s string) *UserCreate {
 	return uc
 }
 
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePassword(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPassword(*s)
+	}
+	return uc
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uc *UserCreate) SetTotpSecret(s string) *UserCreate {
+	uc.mutation.SetTotpSecret(s)
+	return uc
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpSecret(*s)
+	}
+	return uc
+}
+
+// SetTotpConfirmedAt sets the "totp_confirmed_at" field.
+func (uc *UserCreate) SetTotpConfirmedAt(t time.Time) *UserCreate {
+	uc.mutation.SetTotpConfirmedAt(t)
+	return uc
+}
+
+// SetNillableTotpConfirmedAt sets the "totp_confirmed_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpConfirmedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetTotpConfirmedAt(*t)
+	}
+	return uc
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uc *UserCreate) SetTotpLastStep(i int64) *UserCreate {
+	uc.mutation.SetTotpLastStep(i)
+	return uc
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpLastStep(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTotpLastStep(*i)
+	}
+	return uc
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (uc *UserCreate) SetRecoveryCodes(s []string) *UserCreate {
+	uc.mutation.SetRecoveryCodes(s)
+	return uc
+}
+
+// SetTokensValidAfter sets the "tokens_valid_after" field.
+func (uc *UserCreate) SetTokensValidAfter(t time.Time) *UserCreate {
+	uc.mutation.SetTokensValidAfter(t)
+	return uc
+}
+
+// SetNillableTokensValidAfter sets the "tokens_valid_after" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTokensValidAfter(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetTokensValidAfter(*t)
+	}
+	return uc
+}
+
+// SetMustChangePassword sets the "must_change_password" field.
+func (uc *UserCreate) SetMustChangePassword(b bool) *UserCreate {
+	uc.mutation.SetMustChangePassword(b)
+	return uc
+}
+
+// SetNillableMustChangePassword sets the "must_change_password" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMustChangePassword(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetMustChangePassword(*b)
+	}
+	return uc
+}
+
+// SetAuthProvider sets the "auth_provider" field.
+func (uc *UserCreate) SetAuthProvider(s string) *UserCreate {
+	uc.mutation.SetAuthProvider(s)
+	return uc
+}
+
+// SetNillableAuthProvider sets the "auth_provider" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAuthProvider(s *string) *UserCreate {
+	if s != nil {
+		uc.SetAuthProvider(*s)
+	}
+	return uc
+}
+
+// SetStatus sets the "status" field.
+func (uc *UserCreate) SetStatus(u user.Status) *UserCreate {
+	uc.mutation.SetStatus(u)
+	return uc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (uc *UserCreate) SetNillableStatus(u *user.Status) *UserCreate {
+	if u != nil {
+		uc.SetStatus(*u)
+	}
+	return uc
+}
+
+// SetIsServiceAccount sets the "is_service_account" field.
+func (uc *UserCreate) SetIsServiceAccount(b bool) *UserCreate {
+	uc.mutation.SetIsServiceAccount(b)
+	return uc
+}
+
+// SetNillableIsServiceAccount sets the "is_service_account" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsServiceAccount(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsServiceAccount(*b)
+	}
+	return uc
+}
+
 // SetAttr sets the "attr" field.
 func (uc *UserCreate) SetAttr(m *map[string]interface{}) *UserCreate {
 	uc.mutation.SetAttr(m)
@@ -103,6 +254,11 @@ func (uc *UserCreate) SetID(u uint64) *UserCreate {
 	return uc
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (uc *UserCreate) SetOrganization(o *Organization) *UserCreate {
+	return uc.SetOrganizationID(o.ID)
+}
+
 // AddRoleIDs adds the "roles" edge to the Role entity by IDs.
 func (uc *UserCreate) AddRoleIDs(ids ...uint32) *UserCreate {
 	uc.mutation.AddRoleIDs(ids...)
@@ -118,6 +274,36 @@ func (uc *UserCreate) AddRoles(r ...*Role) *UserCreate {
 	return uc.AddRoleIDs(ids...)
 }
 
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (uc *UserCreate) AddGroupIDs(ids ...uint32) *UserCreate {
+	uc.mutation.AddGroupIDs(ids...)
+	return uc
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (uc *UserCreate) AddGroups(g ...*Group) *UserCreate {
+	ids := make([]uint32, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupIDs(ids...)
+}
+
+// AddApprovableRoleIDs adds the "approvable_roles" edge to the Role entity by IDs.
+func (uc *UserCreate) AddApprovableRoleIDs(ids ...uint32) *UserCreate {
+	uc.mutation.AddApprovableRoleIDs(ids...)
+	return uc
+}
+
+// AddApprovableRoles adds the "approvable_roles" edges to the Role entity.
+func (uc *UserCreate) AddApprovableRoles(r ...*Role) *UserCreate {
+	ids := make([]uint32, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddApprovableRoleIDs(ids...)
+}
+
 // AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by IDs.
 func (uc *UserCreate) AddAccessTokenIDs(ids ...uint64) *UserCreate {
 	uc.mutation.AddAccessTokenIDs(ids...)
@@ -163,6 +349,145 @@ func (uc *UserCreate) AddPersonalTokens(p ...*PersonalToken) *UserCreate {
 	return uc.AddPersonalTokenIDs(ids...)
 }
 
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uc *UserCreate) AddSessionIDs(ids ...uint64) *UserCreate {
+	uc.mutation.AddSessionIDs(ids...)
+	return uc
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uc *UserCreate) AddSessions(s ...*Session) *UserCreate {
+	ids := make([]uint64, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSessionIDs(ids...)
+}
+
+// AddCredentialIDs adds the "credentials" edge to the Credential entity by IDs.
+func (uc *UserCreate) AddCredentialIDs(ids ...uint64) *UserCreate {
+	uc.mutation.AddCredentialIDs(ids...)
+	return uc
+}
+
+// AddCredentials adds the "credentials" edges to the Credential entity.
+func (uc *UserCreate) AddCredentials(c ...*Credential) *UserCreate {
+	ids := make([]uint64, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddCredentialIDs(ids...)
+}
+
+// AddPasswordResetIDs adds the "password_resets" edge to the PasswordReset entity by IDs.
+func (uc *UserCreate) AddPasswordResetIDs(ids ...uint64) *UserCreate {
+	uc.mutation.AddPasswordResetIDs(ids...)
+	return uc
+}
+
+// AddPasswordResets adds the "password_resets" edges to the PasswordReset entity.
+func (uc *UserCreate) AddPasswordResets(p ...*PasswordReset) *UserCreate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPasswordResetIDs(ids...)
+}
+
+// AddPasswordHistoryIDs adds the "password_history" edge to the PasswordHistory entity by IDs.
+func (uc *UserCreate) AddPasswordHistoryIDs(ids ...uint64) *UserCreate {
+	uc.mutation.AddPasswordHistoryIDs(ids...)
+	return uc
+}
+
+// AddPasswordHistory adds the "password_history" edges to the PasswordHistory entity.
+func (uc *UserCreate) AddPasswordHistory(p ...*PasswordHistory) *UserCreate {
+	ids := make([]uint64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPasswordHistoryIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the LinkedIdentity entity by IDs.
+func (uc *UserCreate) AddIdentityIDs(ids ...uint64) *UserCreate {
+	uc.mutation.AddIdentityIDs(ids...)
+	return uc
+}
+
+// AddIdentities adds the "identities" edges to the LinkedIdentity entity.
+func (uc *UserCreate) AddIdentities(l ...*LinkedIdentity) *UserCreate {
+	ids := make([]uint64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uc.AddIdentityIDs(ids...)
+}
+
+// AddOauthClientIDs adds the "oauth_clients" edge to the OauthClient entity by IDs.
+func (uc *UserCreate) AddOauthClientIDs(ids ...uint32) *UserCreate {
+	uc.mutation.AddOauthClientIDs(ids...)
+	return uc
+}
+
+// AddOauthClients adds the "oauth_clients" edges to the OauthClient entity.
+func (uc *UserCreate) AddOauthClients(o ...*OauthClient) *UserCreate {
+	ids := make([]uint32, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddOauthClientIDs(ids...)
+}
+
+// AddOauthCodeIDs adds the "oauth_codes" edge to the OauthCode entity by IDs.
+func (uc *UserCreate) AddOauthCodeIDs(ids ...uint64) *UserCreate {
+	uc.mutation.AddOauthCodeIDs(ids...)
+	return uc
+}
+
+// AddOauthCodes adds the "oauth_codes" edges to the OauthCode entity.
+func (uc *UserCreate) AddOauthCodes(o ...*OauthCode) *UserCreate {
+	ids := make([]uint64, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddOauthCodeIDs(ids...)
+}
+
+// SetServiceAccountID sets the "service_account" edge to the ServiceAccount entity by ID.
+func (uc *UserCreate) SetServiceAccountID(id uint32) *UserCreate {
+	uc.mutation.SetServiceAccountID(id)
+	return uc
+}
+
+// SetNillableServiceAccountID sets the "service_account" edge to the ServiceAccount entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableServiceAccountID(id *uint32) *UserCreate {
+	if id != nil {
+		uc = uc.SetServiceAccountID(*id)
+	}
+	return uc
+}
+
+// SetServiceAccount sets the "service_account" edge to the ServiceAccount entity.
+func (uc *UserCreate) SetServiceAccount(s *ServiceAccount) *UserCreate {
+	return uc.SetServiceAccountID(s.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the UserRole entity by IDs.
+func (uc *UserCreate) AddAssignmentIDs(ids ...uint64) *UserCreate {
+	uc.mutation.AddAssignmentIDs(ids...)
+	return uc
+}
+
+// AddAssignments adds the "assignments" edges to the UserRole entity.
+func (uc *UserCreate) AddAssignments(u ...*UserRole) *UserCreate {
+	ids := make([]uint64, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -200,6 +525,26 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() error {
+	if _, ok := uc.mutation.OrganizationID(); !ok {
+		v := user.DefaultOrganizationID
+		uc.mutation.SetOrganizationID(v)
+	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		v := user.DefaultTotpLastStep
+		uc.mutation.SetTotpLastStep(v)
+	}
+	if _, ok := uc.mutation.MustChangePassword(); !ok {
+		v := user.DefaultMustChangePassword
+		uc.mutation.SetMustChangePassword(v)
+	}
+	if _, ok := uc.mutation.Status(); !ok {
+		v := user.DefaultStatus
+		uc.mutation.SetStatus(v)
+	}
+	if _, ok := uc.mutation.IsServiceAccount(); !ok {
+		v := user.DefaultIsServiceAccount
+		uc.mutation.SetIsServiceAccount(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		if user.DefaultCreatedAt == nil {
 			return fmt.Errorf("ent: uninitialized user.DefaultCreatedAt (forgotten import ent/runtime?)")
@@ -212,6 +557,9 @@ func (uc *UserCreate) defaults() error {
 
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
+	if _, ok := uc.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "User.organization_id"`)}
+	}
 	if _, ok := uc.mutation.Username(); !ok {
 		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "User.username"`)}
 	}
@@ -220,14 +568,31 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
 		}
 	}
-	if _, ok := uc.mutation.Password(); !ok {
-		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "User.password"`)}
-	}
 	if v, ok := uc.mutation.Password(); ok {
 		if err := user.PasswordValidator(v); err != nil {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "User.totp_last_step"`)}
+	}
+	if _, ok := uc.mutation.MustChangePassword(); !ok {
+		return &ValidationError{Name: "must_change_password", err: errors.New(`ent: missing required field "User.must_change_password"`)}
+	}
+	if _, ok := uc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "User.status"`)}
+	}
+	if v, ok := uc.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.IsServiceAccount(); !ok {
+		return &ValidationError{Name: "is_service_account", err: errors.New(`ent: missing required field "User.is_service_account"`)}
+	}
+	if len(uc.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "User.organization"`)}
+	}
 	return nil
 }
 
@@ -276,6 +641,42 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 		_node.Password = value
 	}
+	if value, ok := uc.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = &value
+	}
+	if value, ok := uc.mutation.TotpConfirmedAt(); ok {
+		_spec.SetField(user.FieldTotpConfirmedAt, field.TypeTime, value)
+		_node.TotpConfirmedAt = &value
+	}
+	if value, ok := uc.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
+	if value, ok := uc.mutation.RecoveryCodes(); ok {
+		_spec.SetField(user.FieldRecoveryCodes, field.TypeJSON, value)
+		_node.RecoveryCodes = value
+	}
+	if value, ok := uc.mutation.TokensValidAfter(); ok {
+		_spec.SetField(user.FieldTokensValidAfter, field.TypeTime, value)
+		_node.TokensValidAfter = &value
+	}
+	if value, ok := uc.mutation.MustChangePassword(); ok {
+		_spec.SetField(user.FieldMustChangePassword, field.TypeBool, value)
+		_node.MustChangePassword = value
+	}
+	if value, ok := uc.mutation.AuthProvider(); ok {
+		_spec.SetField(user.FieldAuthProvider, field.TypeString, value)
+		_node.AuthProvider = &value
+	}
+	if value, ok := uc.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := uc.mutation.IsServiceAccount(); ok {
+		_spec.SetField(user.FieldIsServiceAccount, field.TypeBool, value)
+		_node.IsServiceAccount = value
+	}
 	if value, ok := uc.mutation.Attr(); ok {
 		_spec.SetField(user.FieldAttr, field.TypeJSON, value)
 		_node.Attr = value
@@ -288,6 +689,23 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = &value
 	}
+	if nodes := uc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.OrganizationTable,
+			Columns: []string{user.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.RolesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -304,6 +722,38 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ApprovableRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.ApprovableRolesTable,
+			Columns: user.ApprovableRolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.AccessTokensIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -352,6 +802,150 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.CredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CredentialsTable,
+			Columns: []string{user.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PasswordResetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordResetsTable,
+			Columns: []string{user.PasswordResetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PasswordHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordHistoryTable,
+			Columns: []string{user.PasswordHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkedidentity.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.OauthClientsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthClientsTable,
+			Columns: []string{user.OauthClientsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthclient.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.OauthCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthCodesTable,
+			Columns: []string{user.OauthCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthcode.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ServiceAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ServiceAccountTable,
+			Columns: []string{user.ServiceAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serviceaccount.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.AssignmentsTable,
+			Columns: []string{user.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
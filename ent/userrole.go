@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+// UserRole is the model entity for the UserRole schema.
+type UserRole struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID uint32 `json:"role_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uint64 `json:"user_id,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserRoleQuery when eager-loading is set.
+	Edges        UserRoleEdges `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// UserRoleEdges holds the relations/edges for other nodes in the graph.
+type UserRoleEdges struct {
+	// Role holds the value of the role edge.
+	Role *Role `json:"role,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserRoleEdges) RoleOrErr() (*Role, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: role.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserRoleEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserRole) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userrole.FieldID, userrole.FieldRoleID, userrole.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case userrole.FieldStartsAt, userrole.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserRole fields.
+func (ur *UserRole) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userrole.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ur.ID = uint64(value.Int64)
+		case userrole.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				ur.RoleID = uint32(value.Int64)
+			}
+		case userrole.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ur.UserID = uint64(value.Int64)
+			}
+		case userrole.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				ur.StartsAt = new(time.Time)
+				*ur.StartsAt = value.Time
+			}
+		case userrole.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ur.ExpiresAt = new(time.Time)
+				*ur.ExpiresAt = value.Time
+			}
+		default:
+			ur.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserRole.
+// This includes values selected through modifiers, order, etc.
+func (ur *UserRole) Value(name string) (ent.Value, error) {
+	return ur.selectValues.Get(name)
+}
+
+// QueryRole queries the "role" edge of the UserRole entity.
+func (ur *UserRole) QueryRole() *RoleQuery {
+	return NewUserRoleClient(ur.config).QueryRole(ur)
+}
+
+// QueryUser queries the "user" edge of the UserRole entity.
+func (ur *UserRole) QueryUser() *UserQuery {
+	return NewUserRoleClient(ur.config).QueryUser(ur)
+}
+
+// Update returns a builder for updating this UserRole.
+// Note that you need to call UserRole.Unwrap() before calling this method if this UserRole
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ur *UserRole) Update() *UserRoleUpdateOne {
+	return NewUserRoleClient(ur.config).UpdateOne(ur)
+}
+
+// Unwrap unwraps the UserRole entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ur *UserRole) Unwrap() *UserRole {
+	_tx, ok := ur.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserRole is not a transactional entity")
+	}
+	ur.config.driver = _tx.drv
+	return ur
+}
+
+// String implements the fmt.Stringer.
+func (ur *UserRole) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserRole(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ur.ID))
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", ur.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ur.UserID))
+	builder.WriteString(", ")
+	if v := ur.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ur.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckUserRoleID returns the "ID" field value.
+func PluckUserRoleID(ur *UserRole) uint64 {
+	return ur.ID
+}
+
+// PluckUserRoleRoleID returns the "role_id" field value.
+func PluckUserRoleRoleID(ur *UserRole) uint32 {
+	return ur.RoleID
+}
+
+// PluckUserRoleUserID returns the "user_id" field value.
+func PluckUserRoleUserID(ur *UserRole) uint64 {
+	return ur.UserID
+}
+
+// PluckUserRoleStartsAt returns the "starts_at" field value.
+func PluckUserRoleStartsAt(ur *UserRole) *time.Time {
+	return ur.StartsAt
+}
+
+// PluckUserRoleExpiresAt returns the "expires_at" field value.
+func PluckUserRoleExpiresAt(ur *UserRole) *time.Time {
+	return ur.ExpiresAt
+}
+
+// UserRoles is a parsable slice of UserRole.
+type UserRoles []*UserRole
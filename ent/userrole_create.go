@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+// UserRoleCreate is the builder for creating a UserRole entity.
+type UserRoleCreate struct {
+	config
+	mutation *UserRoleMutation
+	hooks    []Hook
+}
+
+// SetRoleID sets the "role_id" field.
+func (urc *UserRoleCreate) SetRoleID(u uint32) *UserRoleCreate {
+	urc.mutation.SetRoleID(u)
+	return urc
+}
+
+// SetUserID sets the "user_id" field.
+func (urc *UserRoleCreate) SetUserID(u uint64) *UserRoleCreate {
+	urc.mutation.SetUserID(u)
+	return urc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (urc *UserRoleCreate) SetStartsAt(t time.Time) *UserRoleCreate {
+	urc.mutation.SetStartsAt(t)
+	return urc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (urc *UserRoleCreate) SetNillableStartsAt(t *time.Time) *UserRoleCreate {
+	if t != nil {
+		urc.SetStartsAt(*t)
+	}
+	return urc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (urc *UserRoleCreate) SetExpiresAt(t time.Time) *UserRoleCreate {
+	urc.mutation.SetExpiresAt(t)
+	return urc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (urc *UserRoleCreate) SetNillableExpiresAt(t *time.Time) *UserRoleCreate {
+	if t != nil {
+		urc.SetExpiresAt(*t)
+	}
+	return urc
+}
+
+// SetID sets the "id" field.
+func (urc *UserRoleCreate) SetID(u uint64) *UserRoleCreate {
+	urc.mutation.SetID(u)
+	return urc
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (urc *UserRoleCreate) SetRole(r *Role) *UserRoleCreate {
+	return urc.SetRoleID(r.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (urc *UserRoleCreate) SetUser(u *User) *UserRoleCreate {
+	return urc.SetUserID(u.ID)
+}
+
+// Mutation returns the UserRoleMutation object of the builder.
+func (urc *UserRoleCreate) Mutation() *UserRoleMutation {
+	return urc.mutation
+}
+
+// Save creates the UserRole in the database.
+func (urc *UserRoleCreate) Save(ctx context.Context) (*UserRole, error) {
+	return withHooks(ctx, urc.sqlSave, urc.mutation, urc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (urc *UserRoleCreate) SaveX(ctx context.Context) *UserRole {
+	v, err := urc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (urc *UserRoleCreate) Exec(ctx context.Context) error {
+	_, err := urc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urc *UserRoleCreate) ExecX(ctx context.Context) {
+	if err := urc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (urc *UserRoleCreate) check() error {
+	if _, ok := urc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "UserRole.role_id"`)}
+	}
+	if _, ok := urc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserRole.user_id"`)}
+	}
+	if len(urc.mutation.RoleIDs()) == 0 {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "UserRole.role"`)}
+	}
+	if len(urc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserRole.user"`)}
+	}
+	return nil
+}
+
+func (urc *UserRoleCreate) sqlSave(ctx context.Context) (*UserRole, error) {
+	if err := urc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := urc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, urc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	urc.mutation.id = &_node.ID
+	urc.mutation.done = true
+	return _node, nil
+}
+
+func (urc *UserRoleCreate) createSpec() (*UserRole, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserRole{config: urc.config}
+		_spec = sqlgraph.NewCreateSpec(userrole.Table, sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64))
+	)
+	if id, ok := urc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := urc.mutation.StartsAt(); ok {
+		_spec.SetField(userrole.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := urc.mutation.ExpiresAt(); ok {
+		_spec.SetField(userrole.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if nodes := urc.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.RoleTable,
+			Columns: []string{userrole.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := urc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.UserTable,
+			Columns: []string{userrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserRoleCreateBulk is the builder for creating many UserRole entities in bulk.
+type UserRoleCreateBulk struct {
+	config
+	err      error
+	builders []*UserRoleCreate
+}
+
+// Save creates the UserRole entities in the database.
+func (urcb *UserRoleCreateBulk) Save(ctx context.Context) ([]*UserRole, error) {
+	if urcb.err != nil {
+		return nil, urcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(urcb.builders))
+	nodes := make([]*UserRole, len(urcb.builders))
+	mutators := make([]Mutator, len(urcb.builders))
+	for i := range urcb.builders {
+		func(i int, root context.Context) {
+			builder := urcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserRoleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, urcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, urcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, urcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (urcb *UserRoleCreateBulk) SaveX(ctx context.Context) []*UserRole {
+	v, err := urcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (urcb *UserRoleCreateBulk) Exec(ctx context.Context) error {
+	_, err := urcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (urcb *UserRoleCreateBulk) ExecX(ctx context.Context) {
+	if err := urcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
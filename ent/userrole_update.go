@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/predicate"
+	"github.com/eidng8/go-attr-rbac/ent/role"
+	"github.com/eidng8/go-attr-rbac/ent/user"
+	"github.com/eidng8/go-attr-rbac/ent/userrole"
+)
+
+// UserRoleUpdate is the builder for updating UserRole entities.
+type UserRoleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserRoleMutation
+}
+
+// Where appends a list predicates to the UserRoleUpdate builder.
+func (uru *UserRoleUpdate) Where(ps ...predicate.UserRole) *UserRoleUpdate {
+	uru.mutation.Where(ps...)
+	return uru
+}
+
+// SetRoleID sets the "role_id" field.
+func (uru *UserRoleUpdate) SetRoleID(u uint32) *UserRoleUpdate {
+	uru.mutation.SetRoleID(u)
+	return uru
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (uru *UserRoleUpdate) SetNillableRoleID(u *uint32) *UserRoleUpdate {
+	if u != nil {
+		uru.SetRoleID(*u)
+	}
+	return uru
+}
+
+// SetUserID sets the "user_id" field.
+func (uru *UserRoleUpdate) SetUserID(u uint64) *UserRoleUpdate {
+	uru.mutation.SetUserID(u)
+	return uru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uru *UserRoleUpdate) SetNillableUserID(u *uint64) *UserRoleUpdate {
+	if u != nil {
+		uru.SetUserID(*u)
+	}
+	return uru
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (uru *UserRoleUpdate) SetStartsAt(t time.Time) *UserRoleUpdate {
+	uru.mutation.SetStartsAt(t)
+	return uru
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (uru *UserRoleUpdate) SetNillableStartsAt(t *time.Time) *UserRoleUpdate {
+	if t != nil {
+		uru.SetStartsAt(*t)
+	}
+	return uru
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (uru *UserRoleUpdate) ClearStartsAt() *UserRoleUpdate {
+	uru.mutation.ClearStartsAt()
+	return uru
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (uru *UserRoleUpdate) SetExpiresAt(t time.Time) *UserRoleUpdate {
+	uru.mutation.SetExpiresAt(t)
+	return uru
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (uru *UserRoleUpdate) SetNillableExpiresAt(t *time.Time) *UserRoleUpdate {
+	if t != nil {
+		uru.SetExpiresAt(*t)
+	}
+	return uru
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (uru *UserRoleUpdate) ClearExpiresAt() *UserRoleUpdate {
+	uru.mutation.ClearExpiresAt()
+	return uru
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (uru *UserRoleUpdate) SetRole(r *Role) *UserRoleUpdate {
+	return uru.SetRoleID(r.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uru *UserRoleUpdate) SetUser(u *User) *UserRoleUpdate {
+	return uru.SetUserID(u.ID)
+}
+
+// Mutation returns the UserRoleMutation object of the builder.
+func (uru *UserRoleUpdate) Mutation() *UserRoleMutation {
+	return uru.mutation
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (uru *UserRoleUpdate) ClearRole() *UserRoleUpdate {
+	uru.mutation.ClearRole()
+	return uru
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (uru *UserRoleUpdate) ClearUser() *UserRoleUpdate {
+	uru.mutation.ClearUser()
+	return uru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uru *UserRoleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, uru.sqlSave, uru.mutation, uru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uru *UserRoleUpdate) SaveX(ctx context.Context) int {
+	affected, err := uru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uru *UserRoleUpdate) Exec(ctx context.Context) error {
+	_, err := uru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uru *UserRoleUpdate) ExecX(ctx context.Context) {
+	if err := uru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uru *UserRoleUpdate) check() error {
+	if uru.mutation.RoleCleared() && len(uru.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserRole.role"`)
+	}
+	if uru.mutation.UserCleared() && len(uru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserRole.user"`)
+	}
+	return nil
+}
+
+func (uru *UserRoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userrole.Table, userrole.Columns, sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64))
+	if ps := uru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uru.mutation.StartsAt(); ok {
+		_spec.SetField(userrole.FieldStartsAt, field.TypeTime, value)
+	}
+	if uru.mutation.StartsAtCleared() {
+		_spec.ClearField(userrole.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := uru.mutation.ExpiresAt(); ok {
+		_spec.SetField(userrole.FieldExpiresAt, field.TypeTime, value)
+	}
+	if uru.mutation.ExpiresAtCleared() {
+		_spec.ClearField(userrole.FieldExpiresAt, field.TypeTime)
+	}
+	if uru.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.RoleTable,
+			Columns: []string{userrole.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uru.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.RoleTable,
+			Columns: []string{userrole.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.UserTable,
+			Columns: []string{userrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.UserTable,
+			Columns: []string{userrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uru.mutation.done = true
+	return n, nil
+}
+
+// UserRoleUpdateOne is the builder for updating a single UserRole entity.
+type UserRoleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserRoleMutation
+}
+
+// SetRoleID sets the "role_id" field.
+func (uruo *UserRoleUpdateOne) SetRoleID(u uint32) *UserRoleUpdateOne {
+	uruo.mutation.SetRoleID(u)
+	return uruo
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (uruo *UserRoleUpdateOne) SetNillableRoleID(u *uint32) *UserRoleUpdateOne {
+	if u != nil {
+		uruo.SetRoleID(*u)
+	}
+	return uruo
+}
+
+// SetUserID sets the "user_id" field.
+func (uruo *UserRoleUpdateOne) SetUserID(u uint64) *UserRoleUpdateOne {
+	uruo.mutation.SetUserID(u)
+	return uruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uruo *UserRoleUpdateOne) SetNillableUserID(u *uint64) *UserRoleUpdateOne {
+	if u != nil {
+		uruo.SetUserID(*u)
+	}
+	return uruo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (uruo *UserRoleUpdateOne) SetStartsAt(t time.Time) *UserRoleUpdateOne {
+	uruo.mutation.SetStartsAt(t)
+	return uruo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (uruo *UserRoleUpdateOne) SetNillableStartsAt(t *time.Time) *UserRoleUpdateOne {
+	if t != nil {
+		uruo.SetStartsAt(*t)
+	}
+	return uruo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (uruo *UserRoleUpdateOne) ClearStartsAt() *UserRoleUpdateOne {
+	uruo.mutation.ClearStartsAt()
+	return uruo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (uruo *UserRoleUpdateOne) SetExpiresAt(t time.Time) *UserRoleUpdateOne {
+	uruo.mutation.SetExpiresAt(t)
+	return uruo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (uruo *UserRoleUpdateOne) SetNillableExpiresAt(t *time.Time) *UserRoleUpdateOne {
+	if t != nil {
+		uruo.SetExpiresAt(*t)
+	}
+	return uruo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (uruo *UserRoleUpdateOne) ClearExpiresAt() *UserRoleUpdateOne {
+	uruo.mutation.ClearExpiresAt()
+	return uruo
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (uruo *UserRoleUpdateOne) SetRole(r *Role) *UserRoleUpdateOne {
+	return uruo.SetRoleID(r.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uruo *UserRoleUpdateOne) SetUser(u *User) *UserRoleUpdateOne {
+	return uruo.SetUserID(u.ID)
+}
+
+// Mutation returns the UserRoleMutation object of the builder.
+func (uruo *UserRoleUpdateOne) Mutation() *UserRoleMutation {
+	return uruo.mutation
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (uruo *UserRoleUpdateOne) ClearRole() *UserRoleUpdateOne {
+	uruo.mutation.ClearRole()
+	return uruo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (uruo *UserRoleUpdateOne) ClearUser() *UserRoleUpdateOne {
+	uruo.mutation.ClearUser()
+	return uruo
+}
+
+// Where appends a list predicates to the UserRoleUpdate builder.
+func (uruo *UserRoleUpdateOne) Where(ps ...predicate.UserRole) *UserRoleUpdateOne {
+	uruo.mutation.Where(ps...)
+	return uruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uruo *UserRoleUpdateOne) Select(field string, fields ...string) *UserRoleUpdateOne {
+	uruo.fields = append([]string{field}, fields...)
+	return uruo
+}
+
+// Save executes the query and returns the updated UserRole entity.
+func (uruo *UserRoleUpdateOne) Save(ctx context.Context) (*UserRole, error) {
+	return withHooks(ctx, uruo.sqlSave, uruo.mutation, uruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uruo *UserRoleUpdateOne) SaveX(ctx context.Context) *UserRole {
+	node, err := uruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uruo *UserRoleUpdateOne) Exec(ctx context.Context) error {
+	_, err := uruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uruo *UserRoleUpdateOne) ExecX(ctx context.Context) {
+	if err := uruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uruo *UserRoleUpdateOne) check() error {
+	if uruo.mutation.RoleCleared() && len(uruo.mutation.RoleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserRole.role"`)
+	}
+	if uruo.mutation.UserCleared() && len(uruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserRole.user"`)
+	}
+	return nil
+}
+
+func (uruo *UserRoleUpdateOne) sqlSave(ctx context.Context) (_node *UserRole, err error) {
+	if err := uruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userrole.Table, userrole.Columns, sqlgraph.NewFieldSpec(userrole.FieldID, field.TypeUint64))
+	id, ok := uruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserRole.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userrole.FieldID)
+		for _, f := range fields {
+			if !userrole.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uruo.mutation.StartsAt(); ok {
+		_spec.SetField(userrole.FieldStartsAt, field.TypeTime, value)
+	}
+	if uruo.mutation.StartsAtCleared() {
+		_spec.ClearField(userrole.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := uruo.mutation.ExpiresAt(); ok {
+		_spec.SetField(userrole.FieldExpiresAt, field.TypeTime, value)
+	}
+	if uruo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(userrole.FieldExpiresAt, field.TypeTime)
+	}
+	if uruo.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.RoleTable,
+			Columns: []string{userrole.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uruo.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.RoleTable,
+			Columns: []string{userrole.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeUint32),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.UserTable,
+			Columns: []string{userrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   userrole.UserTable,
+			Columns: []string{userrole.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserRole{config: uruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eidng8/go-attr-rbac/ent/webauthnchallenge"
+)
+
+// Stores consumed WebAuthn challenges
+type WebauthnChallenge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint64 `json:"id,omitempty"`
+	// Challenge holds the value of the "challenge" field.
+	Challenge []byte `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebauthnChallenge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webauthnchallenge.FieldChallenge:
+			values[i] = new([]byte)
+		case webauthnchallenge.FieldID:
+			values[i] = new(sql.NullInt64)
+		case webauthnchallenge.FieldExpiresAt, webauthnchallenge.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebauthnChallenge fields.
+func (wc *WebauthnChallenge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webauthnchallenge.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wc.ID = uint64(value.Int64)
+		case webauthnchallenge.FieldChallenge:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field challenge", values[i])
+			} else if value != nil {
+				wc.Challenge = *value
+			}
+		case webauthnchallenge.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				wc.ExpiresAt = value.Time
+			}
+		case webauthnchallenge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wc.CreatedAt = value.Time
+			}
+		default:
+			wc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebauthnChallenge.
+// This includes values selected through modifiers, order, etc.
+func (wc *WebauthnChallenge) Value(name string) (ent.Value, error) {
+	return wc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebauthnChallenge.
+// Note that you need to call WebauthnChallenge.Unwrap() before calling this method if this WebauthnChallenge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wc *WebauthnChallenge) Update() *WebauthnChallengeUpdateOne {
+	return NewWebauthnChallengeClient(wc.config).UpdateOne(wc)
+}
+
+// Unwrap unwraps the WebauthnChallenge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wc *WebauthnChallenge) Unwrap() *WebauthnChallenge {
+	_tx, ok := wc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebauthnChallenge is not a transactional entity")
+	}
+	wc.config.driver = _tx.drv
+	return wc
+}
+
+// String implements the fmt.Stringer.
+func (wc *WebauthnChallenge) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebauthnChallenge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wc.ID))
+	builder.WriteString("challenge=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(wc.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PluckWebauthnChallengeID returns the "ID" field value.
+func PluckWebauthnChallengeID(wc *WebauthnChallenge) uint64 {
+	return wc.ID
+}
+
+// PluckWebauthnChallengeChallenge returns the "challenge" field value.
+func PluckWebauthnChallengeChallenge(wc *WebauthnChallenge) []byte {
+	return wc.Challenge
+}
+
+// PluckWebauthnChallengeExpiresAt returns the "expires_at" field value.
+func PluckWebauthnChallengeExpiresAt(wc *WebauthnChallenge) time.Time {
+	return wc.ExpiresAt
+}
+
+// PluckWebauthnChallengeCreatedAt returns the "created_at" field value.
+func PluckWebauthnChallengeCreatedAt(wc *WebauthnChallenge) time.Time {
+	return wc.CreatedAt
+}
+
+// WebauthnChallenges is a parsable slice of WebauthnChallenge.
+type WebauthnChallenges []*WebauthnChallenge
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eidng8/go-attr-rbac/ent/webauthnchallenge"
+)
+
+// WebauthnChallengeCreate is the builder for creating a WebauthnChallenge entity.
+type WebauthnChallengeCreate struct {
+	config
+	mutation *WebauthnChallengeMutation
+	hooks    []Hook
+}
+
+// SetChallenge sets the "challenge" field.
+func (wcc *WebauthnChallengeCreate) SetChallenge(b []byte) *WebauthnChallengeCreate {
+	wcc.mutation.SetChallenge(b)
+	return wcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (wcc *WebauthnChallengeCreate) SetExpiresAt(t time.Time) *WebauthnChallengeCreate {
+	wcc.mutation.SetExpiresAt(t)
+	return wcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wcc *WebauthnChallengeCreate) SetCreatedAt(t time.Time) *WebauthnChallengeCreate {
+	wcc.mutation.SetCreatedAt(t)
+	return wcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wcc *WebauthnChallengeCreate) SetNillableCreatedAt(t *time.Time) *WebauthnChallengeCreate {
+	if t != nil {
+		wcc.SetCreatedAt(*t)
+	}
+	return wcc
+}
+
+// SetID sets the "id" field.
+func (wcc *WebauthnChallengeCreate) SetID(u uint64) *WebauthnChallengeCreate {
+	wcc.mutation.SetID(u)
+	return wcc
+}
+
+// Mutation returns the WebauthnChallengeMutation object of the builder.
+func (wcc *WebauthnChallengeCreate) Mutation() *WebauthnChallengeMutation {
+	return wcc.mutation
+}
+
+// Save creates the WebauthnChallenge in the database.
+func (wcc *WebauthnChallengeCreate) Save(ctx context.Context) (*WebauthnChallenge, error) {
+	wcc.defaults()
+	return withHooks(ctx, wcc.sqlSave, wcc.mutation, wcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wcc *WebauthnChallengeCreate) SaveX(ctx context.Context) *WebauthnChallenge {
+	v, err := wcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcc *WebauthnChallengeCreate) Exec(ctx context.Context) error {
+	_, err := wcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcc *WebauthnChallengeCreate) ExecX(ctx context.Context) {
+	if err := wcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wcc *WebauthnChallengeCreate) defaults() {
+	if _, ok := wcc.mutation.CreatedAt(); !ok {
+		v := webauthnchallenge.DefaultCreatedAt()
+		wcc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wcc *WebauthnChallengeCreate) check() error {
+	if _, ok := wcc.mutation.Challenge(); !ok {
+		return &ValidationError{Name: "challenge", err: errors.New(`ent: missing required field "WebauthnChallenge.challenge"`)}
+	}
+	if _, ok := wcc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "WebauthnChallenge.expires_at"`)}
+	}
+	if _, ok := wcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebauthnChallenge.created_at"`)}
+	}
+	return nil
+}
+
+func (wcc *WebauthnChallengeCreate) sqlSave(ctx context.Context) (*WebauthnChallenge, error) {
+	if err := wcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	wcc.mutation.id = &_node.ID
+	wcc.mutation.done = true
+	return _node, nil
+}
+
+func (wcc *WebauthnChallengeCreate) createSpec() (*WebauthnChallenge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebauthnChallenge{config: wcc.config}
+		_spec = sqlgraph.NewCreateSpec(webauthnchallenge.Table, sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeUint64))
+	)
+	if id, ok := wcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wcc.mutation.Challenge(); ok {
+		_spec.SetField(webauthnchallenge.FieldChallenge, field.TypeBytes, value)
+		_node.Challenge = value
+	}
+	if value, ok := wcc.mutation.ExpiresAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := wcc.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WebauthnChallengeCreateBulk is the builder for creating many WebauthnChallenge entities in bulk.
+type WebauthnChallengeCreateBulk struct {
+	config
+	err      error
+	builders []*WebauthnChallengeCreate
+}
+
+// Save creates the WebauthnChallenge entities in the database.
+func (wccb *WebauthnChallengeCreateBulk) Save(ctx context.Context) ([]*WebauthnChallenge, error) {
+	if wccb.err != nil {
+		return nil, wccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wccb.builders))
+	nodes := make([]*WebauthnChallenge, len(wccb.builders))
+	mutators := make([]Mutator, len(wccb.builders))
+	for i := range wccb.builders {
+		func(i int, root context.Context) {
+			builder := wccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebauthnChallengeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wccb *WebauthnChallengeCreateBulk) SaveX(ctx context.Context) []*WebauthnChallenge {
+	v, err := wccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wccb *WebauthnChallengeCreateBulk) Exec(ctx context.Context) error {
+	_, err := wccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wccb *WebauthnChallengeCreateBulk) ExecX(ctx context.Context) {
+	if err := wccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
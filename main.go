@@ -1,6 +1,9 @@
 package main
 
 import (
+	"context"
+	"time"
+
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/eidng8/go-db"
 	"github.com/eidng8/go-utils"
@@ -13,7 +16,9 @@ import (
 func main() {
 	ec := ent.NewClient(ent.Driver(entsql.OpenDB(db.ConnectX())))
 	defer api.Log.PanicIfError(ec.Close())
-	_, engine, err := handlers.NewEngine(ec)
+	server, engine, err := handlers.NewEngine(ec)
 	api.Log.PanicIfError(err)
+	go server.DispatchWebhooks(context.Background(), 10*time.Second)
+	go server.SweepExpiredAssignments(context.Background(), time.Minute)
 	api.Log.PanicIfError(engine.Run(utils.GetEnvWithDefault("LISTEN", ":80")))
 }
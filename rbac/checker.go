@@ -0,0 +1,149 @@
+package rbac
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Answers cached by a checker at most, the oldest ones are dropped to make room
+// for new ones.
+const maxCachedChecks = 10000
+
+// Checker asks the service whether tokens are still accepted, and whether
+// their users are allowed operations. Answers are cached for the TTL, so
+// revocations and permission changes take up to the TTL to take effect.
+//
+// Users need the `auth:CheckAccessToken` permission, or they're reported
+// forbidden whenever an operation is asked for.
+type Checker struct {
+	url    string
+	client *http.Client
+	ttl    time.Duration
+	limit  int
+	mu     sync.Mutex
+	cache  map[[sha256.Size]byte]checkResult
+	// keys in the order they were stored, which is also the order they
+	// expire, since the TTL is the same for all
+	queue []cachedKey
+}
+
+type checkResult struct {
+	err     error
+	expires time.Time
+}
+
+type cachedKey struct {
+	key     [sha256.Size]byte
+	expires time.Time
+}
+
+// NewChecker creates a checker of the service at the base URL, e.g.
+// `https://auth.example.com`. Answers are not cached if ttl is zero.
+func NewChecker(baseUrl string, ttl time.Duration) *Checker {
+	return &Checker{
+		url:    strings.TrimSuffix(baseUrl, "/") + "/access-token",
+		client: http.DefaultClient,
+		ttl:    ttl,
+		limit:  maxCachedChecks,
+		cache:  map[[sha256.Size]byte]checkResult{},
+	}
+}
+
+// UseHttpClient sets the client to call the service with.
+func (c *Checker) UseHttpClient(client *http.Client) {
+	c.client = client
+}
+
+// Check asks whether the token of the scheme is still accepted, and whether
+// its user is allowed the operation if it isn't empty. Returns ErrRevoked if
+// the token is no longer accepted, and ErrForbidden if the user isn't allowed
+// the operation. Other errors mean the service couldn't be asked, which are
+// not cached.
+func (c *Checker) Check(
+	ctx context.Context, scheme, token, operation string,
+) error {
+	key := sha256.Sum256([]byte(scheme + " " + token + "\n" + operation))
+	if err, ok := c.cached(key); ok {
+		return err
+	}
+	err := c.ask(ctx, scheme, token, operation)
+	switch err {
+	case nil, ErrRevoked, ErrForbidden:
+		c.store(key, err)
+	}
+	return err
+}
+
+func (c *Checker) ask(
+	ctx context.Context, scheme, token, operation string,
+) error {
+	u := c.url
+	if "" != operation {
+		u += "?" + url.Values{"operation": {operation}}.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", scheme+" "+token)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusUnauthorized:
+		return ErrRevoked
+	case http.StatusForbidden:
+		if "" == operation {
+			// the token is accepted, its user just can't check it
+			return nil
+		}
+		return ErrForbidden
+	}
+	return fmt.Errorf("check token: unexpected status %d", res.StatusCode)
+}
+
+func (c *Checker) cached(key [sha256.Size]byte) (error, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, ok := c.cache[key]
+	if !ok || time.Now().After(r.expires) {
+		return nil, false
+	}
+	return r.err, true
+}
+
+func (c *Checker) store(key [sha256.Size]byte, err error) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for len(c.queue) > 0 &&
+		(len(c.queue) >= c.limit || now.After(c.queue[0].expires)) {
+		c.evict()
+	}
+	expires := now.Add(c.ttl)
+	c.cache[key] = checkResult{err: err, expires: expires}
+	c.queue = append(c.queue, cachedKey{key: key, expires: expires})
+}
+
+// Drops the oldest key of the queue. Its answer is kept if the key was stored
+// again since, which is queued once more.
+func (c *Checker) evict() {
+	q := c.queue[0]
+	c.queue = c.queue[1:]
+	if r, ok := c.cache[q.key]; ok && r.expires.Equal(q.expires) {
+		delete(c.cache, q.key)
+	}
+}
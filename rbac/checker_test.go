@@ -0,0 +1,171 @@
+package rbac
+
+import (
+	"context"
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct {
+	hits      atomic.Int32
+	status    atomic.Int32
+	url       string
+	header    string
+	operation string
+}
+
+// Serves GET /access-token answering the status, which can be changed by the
+// test.
+func newTestService(tb testing.TB, status int) *testService {
+	s := &testService{}
+	s.status.Store(int32(status))
+	svr := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				s.hits.Add(1)
+				if "/access-token" != r.URL.Path {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				s.header = r.Header.Get("Authorization")
+				s.operation = r.URL.Query().Get("operation")
+				w.WriteHeader(int(s.status.Load()))
+			},
+		),
+	)
+	tb.Cleanup(svr.Close)
+	s.url = svr.URL
+	return s
+}
+
+func Test_Check_returns_nil_if_allowed(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	c := NewChecker(s.url+"/", time.Minute)
+	err := c.Check(context.Background(), SchemeToken, "abc", "svc:Op")
+	require.Nil(t, err)
+	require.Equal(t, "Token abc", s.header)
+	require.Equal(t, "svc:Op", s.operation)
+}
+
+func Test_Check_returns_ErrRevoked_if_401(t *testing.T) {
+	s := newTestService(t, http.StatusUnauthorized)
+	c := NewChecker(s.url, time.Minute)
+	err := c.Check(context.Background(), SchemeBearer, "abc", "svc:Op")
+	require.ErrorIs(t, err, ErrRevoked)
+}
+
+func Test_Check_returns_ErrForbidden_if_403(t *testing.T) {
+	s := newTestService(t, http.StatusForbidden)
+	c := NewChecker(s.url, time.Minute)
+	err := c.Check(context.Background(), SchemeBearer, "abc", "svc:Op")
+	require.ErrorIs(t, err, ErrForbidden)
+}
+
+func Test_Check_returns_nil_if_403_without_operation(t *testing.T) {
+	s := newTestService(t, http.StatusForbidden)
+	c := NewChecker(s.url, time.Minute)
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "abc", ""))
+	require.Equal(t, "", s.operation)
+}
+
+func Test_Check_returns_error_if_unexpected_status(t *testing.T) {
+	s := newTestService(t, http.StatusInternalServerError)
+	c := NewChecker(s.url, time.Minute)
+	err := c.Check(context.Background(), SchemeBearer, "abc", "svc:Op")
+	require.NotNil(t, err)
+	require.NotErrorIs(t, err, ErrRevoked)
+	require.NotErrorIs(t, err, ErrForbidden)
+}
+
+func Test_Check_caches_answers(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	c := NewChecker(s.url, time.Minute)
+	for i := 0; i < 3; i++ {
+		require.Nil(
+			t, c.Check(context.Background(), SchemeBearer, "abc", "svc:Op"),
+		)
+	}
+	require.Equal(t, int32(1), s.hits.Load())
+	s.status.Store(http.StatusUnauthorized)
+	require.Nil(
+		t, c.Check(context.Background(), SchemeBearer, "abc", "svc:Op"),
+	)
+	err := c.Check(context.Background(), SchemeBearer, "abc", "svc:Other")
+	require.ErrorIs(t, err, ErrRevoked)
+	require.Equal(t, int32(2), s.hits.Load())
+}
+
+func Test_Check_asks_again_after_ttl(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	c := NewChecker(s.url, time.Millisecond)
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "abc", "op"))
+	time.Sleep(5 * time.Millisecond)
+	s.status.Store(http.StatusUnauthorized)
+	err := c.Check(context.Background(), SchemeBearer, "abc", "op")
+	require.ErrorIs(t, err, ErrRevoked)
+	require.Equal(t, int32(2), s.hits.Load())
+}
+
+func Test_Check_drops_expired_answers(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	c := NewChecker(s.url, time.Millisecond)
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "abc", "op"))
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "def", "op"))
+	time.Sleep(5 * time.Millisecond)
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "ghi", "op"))
+	require.Len(t, c.cache, 1)
+	require.Len(t, c.queue, 1)
+}
+
+func Test_Check_drops_oldest_answers_beyond_limit(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	c := NewChecker(s.url, time.Minute)
+	c.limit = 2
+	for _, op := range []string{"a", "b", "c"} {
+		require.Nil(t, c.Check(context.Background(), SchemeBearer, "abc", op))
+	}
+	require.Len(t, c.cache, 2)
+	require.Equal(t, int32(3), s.hits.Load())
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "abc", "c"))
+	require.Equal(t, int32(3), s.hits.Load())
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "abc", "a"))
+	require.Equal(t, int32(4), s.hits.Load())
+	require.Len(t, c.cache, 2)
+}
+
+func Test_Check_keeps_answers_stored_again(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	c := NewChecker(s.url, time.Minute)
+	key := [sha256.Size]byte{1}
+	c.store(key, nil)
+	time.Sleep(time.Millisecond)
+	c.store(key, ErrRevoked)
+	// the stale queue entry doesn't drop the answer stored again
+	c.evict()
+	err, ok := c.cached(key)
+	require.True(t, ok)
+	require.ErrorIs(t, err, ErrRevoked)
+}
+
+func Test_Check_doesnt_cache_errors(t *testing.T) {
+	s := newTestService(t, http.StatusBadGateway)
+	c := NewChecker(s.url, time.Minute)
+	require.NotNil(t, c.Check(context.Background(), SchemeBearer, "abc", ""))
+	s.status.Store(http.StatusNoContent)
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "abc", ""))
+	require.Equal(t, int32(2), s.hits.Load())
+}
+
+func Test_Check_doesnt_cache_without_ttl(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	c := NewChecker(s.url, 0)
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "abc", ""))
+	require.Nil(t, c.Check(context.Background(), SchemeBearer, "abc", ""))
+	require.Equal(t, int32(2), s.hits.Load())
+}
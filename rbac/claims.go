@@ -0,0 +1,73 @@
+package rbac
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims of the tokens issued by the service.
+type Claims struct {
+	jwt.RegisteredClaims
+	// Roles of the user when the token was issued.
+	Roles []string `json:"roles,omitempty"`
+	// Attributes of the user, such as `dept` and `level`.
+	Attributes map[string]int64 `json:"attr,omitempty"`
+	// Scopes of personal and OAuth tokens.
+	Scopes []string `json:"scopes,omitempty"`
+	// Tenant is the ID of the organization the user belongs to.
+	Tenant uint32 `json:"tenant"`
+	// Actor is the impersonator, only present in impersonation tokens.
+	Actor *Actor `json:"act,omitempty"`
+	// ClientId is the OAuth client the token was issued to, only present in
+	// OAuth tokens.
+	ClientId string `json:"client_id,omitempty"`
+	// Mfa is the pending second factor, only present in login challenges,
+	// which are never accepted.
+	Mfa string `json:"mfa,omitempty"`
+}
+
+// Actor identifies the party acting on behalf of the subject.
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+// UserId returns the ID of the user, or of the user backing the service
+// account, from the subject.
+func (c *Claims) UserId() (uint64, error) {
+	id, err := strconv.ParseUint(
+		strings.TrimPrefix(c.Subject, ServiceSubjectPrefix), 10, 64,
+	)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+	return id, nil
+}
+
+// IsServiceAccount tells whether the token was issued to a service account.
+func (c *Claims) IsServiceAccount() bool {
+	return strings.HasPrefix(c.Subject, ServiceSubjectPrefix)
+}
+
+// IsImpersonated tells whether the token was issued by impersonation.
+func (c *Claims) IsImpersonated() bool {
+	return nil != c.Actor
+}
+
+// HasRole tells whether the user had the role when the token was issued.
+func (c *Claims) HasRole(role string) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+// HasScope tells whether the token was granted the scope.
+func (c *Claims) HasScope(scope string) bool {
+	return slices.Contains(c.Scopes, scope)
+}
+
+// Attribute returns the attribute of the user, false if it isn't set.
+func (c *Claims) Attribute(name string) (int64, bool) {
+	v, ok := c.Attributes[name]
+	return v, ok
+}
@@ -0,0 +1,55 @@
+package rbac
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Claims_unmarshals_service_claims(t *testing.T) {
+	var c Claims
+	require.Nil(
+		t, json.Unmarshal(
+			[]byte(
+				`{"sub":"2","roles":["user"],"attr":{"dept":1,"level":2},`+
+					`"scopes":["auth:ListUser"],"tenant":3,"act":{"sub":"1"},`+
+					`"client_id":"app"}`,
+			),
+			&c,
+		),
+	)
+	require.True(t, c.HasRole("user"))
+	require.False(t, c.HasRole("root"))
+	require.True(t, c.HasScope("auth:ListUser"))
+	require.False(t, c.HasScope("auth:DeleteUser"))
+	level, ok := c.Attribute("level")
+	require.True(t, ok)
+	require.Equal(t, int64(2), level)
+	_, ok = c.Attribute("missing")
+	require.False(t, ok)
+	require.Equal(t, uint32(3), c.Tenant)
+	require.True(t, c.IsImpersonated())
+	require.Equal(t, "1", c.Actor.Subject)
+	require.Equal(t, "app", c.ClientId)
+	id, err := c.UserId()
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), id)
+	require.False(t, c.IsServiceAccount())
+}
+
+func Test_Claims_UserId_of_service_account(t *testing.T) {
+	c := Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "svc:5"}}
+	id, err := c.UserId()
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), id)
+	require.True(t, c.IsServiceAccount())
+	require.False(t, c.IsImpersonated())
+}
+
+func Test_Claims_UserId_returns_error_if_invalid_subject(t *testing.T) {
+	c := Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "abc"}}
+	_, err := c.UserId()
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
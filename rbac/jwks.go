@@ -0,0 +1,175 @@
+package rbac
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Unknown key IDs refresh the key set at most once in this interval, so that
+// forged tokens can't flood the service with requests.
+const jwksRefreshInterval = time.Minute
+
+var errUnknownKey = errors.New("unknown key")
+
+// JSON web key, only the members needed to verify signatures.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Public key of the set, pinned to the algorithm of its type, so that tokens
+// can't pick another algorithm for it.
+type publicKey struct {
+	key crypto.PublicKey
+	alg string
+}
+
+// Caches the JSON web key set of the service, refreshing it when tokens are
+// signed by unknown keys, e.g. after the service rotated its key.
+type keySet struct {
+	url       string
+	client    *http.Client
+	mu        sync.Mutex
+	keys      map[string]publicKey
+	fetchedAt time.Time
+}
+
+func newKeySet(url string, client *http.Client) *keySet {
+	return &keySet{url: url, client: client}
+}
+
+// Returns the key of the ID. Tokens without key ID are accepted if the set
+// has only one key.
+func (ks *keySet) get(ctx context.Context, kid string) (publicKey, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	if key, ok := ks.lookup(kid); ok {
+		return key, nil
+	}
+	if time.Since(ks.fetchedAt) < jwksRefreshInterval {
+		return publicKey{}, errUnknownKey
+	}
+	if err := ks.fetch(ctx); err != nil {
+		return publicKey{}, err
+	}
+	if key, ok := ks.lookup(kid); ok {
+		return key, nil
+	}
+	return publicKey{}, errUnknownKey
+}
+
+func (ks *keySet) lookup(kid string) (publicKey, bool) {
+	if "" == kid && 1 == len(ks.keys) {
+		for _, key := range ks.keys {
+			return key, true
+		}
+	}
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+func (ks *keySet) fetch(ctx context.Context) error {
+	ks.fetchedAt = time.Now()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := ks.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if http.StatusOK != res.StatusCode {
+		return fmt.Errorf("fetch JWKS: unexpected status %d", res.StatusCode)
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return err
+	}
+	keys := make(map[string]publicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if "" != k.Use && "sig" != k.Use {
+			continue
+		}
+		key, alg, err := k.publicKey()
+		if err != nil || ("" != k.Alg && alg != k.Alg) {
+			// skip keys of unsupported types or algorithms, others may still
+			// be usable
+			continue
+		}
+		keys[k.Kid] = publicKey{key: key, alg: alg}
+	}
+	ks.keys = keys
+	return nil
+}
+
+// Returns the public key and the algorithm it verifies.
+func (k jwk) publicKey() (crypto.PublicKey, string, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, "", err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, "", err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, "", errors.New("invalid RSA exponent")
+		}
+		key := &rsa.PublicKey{N: n, E: int(e.Int64())}
+		return key, jwt.SigningMethodRS256.Alg(), nil
+	case "EC":
+		if "P-256" != k.Crv {
+			return nil, "", fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, "", err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, "", err
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		if !key.Curve.IsOnCurve(x, y) {
+			return nil, "", errors.New("invalid EC point")
+		}
+		return key, jwt.SigningMethodES256.Alg(), nil
+	}
+	return nil, "", fmt.Errorf("unsupported key type %s", k.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if 0 == len(b) {
+		return nil, errors.New("empty JWK member")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,195 @@
+package rbac
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type testJwks struct {
+	keys []map[string]string
+	hits atomic.Int32
+	url  string
+}
+
+// Serves the key set, which can be changed by the test.
+func newTestJwks(tb testing.TB) *testJwks {
+	j := &testJwks{}
+	svr := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, _ *http.Request) {
+				j.hits.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(
+					map[string]interface{}{"keys": j.keys},
+				)
+			},
+		),
+	)
+	tb.Cleanup(svr.Close)
+	j.url = svr.URL
+	return j
+}
+
+func (j *testJwks) addRsa(tb testing.TB, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(tb, err)
+	j.keys = append(
+		j.keys, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(
+				big.NewInt(int64(key.E)).Bytes(),
+			),
+		},
+	)
+	return key
+}
+
+func (j *testJwks) verifier(tb testing.TB) *Verifier {
+	v, err := NewVerifier(VerifierConfig{JwksUrl: j.url})
+	require.Nil(tb, err)
+	return v
+}
+
+func Test_Verify_returns_claims_of_rs256_token(t *testing.T) {
+	j := newTestJwks(t)
+	key := j.addRsa(t, "k1")
+	claims, err := j.verifier(t).Verify(
+		context.Background(), signRs256(t, testClaims(), key, "k1"),
+	)
+	require.Nil(t, err)
+	require.Equal(t, "2", claims.Subject)
+}
+
+func Test_Verify_accepts_token_without_kid_if_only_one_key(t *testing.T) {
+	j := newTestJwks(t)
+	key := j.addRsa(t, "k1")
+	_, err := j.verifier(t).Verify(
+		context.Background(), signRs256(t, testClaims(), key, ""),
+	)
+	require.Nil(t, err)
+}
+
+func Test_Verify_returns_claims_of_es256_token(t *testing.T) {
+	j := newTestJwks(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	j.keys = append(
+		j.keys, map[string]string{
+			"kty": "EC",
+			"kid": "ec",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+		},
+	)
+	tk := jwt.NewWithClaims(jwt.SigningMethodES256, testClaims())
+	tk.Header["kid"] = "ec"
+	s, err := tk.SignedString(key)
+	require.Nil(t, err)
+	_, err = j.verifier(t).Verify(context.Background(), s)
+	require.Nil(t, err)
+}
+
+func Test_Verify_returns_error_if_unknown_kid(t *testing.T) {
+	j := newTestJwks(t)
+	j.addRsa(t, "k1")
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	_, err = j.verifier(t).Verify(
+		context.Background(), signRs256(t, testClaims(), key, "k1"),
+	)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_returns_error_if_algorithm_not_of_key(t *testing.T) {
+	j := newTestJwks(t)
+	j.addRsa(t, "k1")
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	tk := jwt.NewWithClaims(jwt.SigningMethodES256, testClaims())
+	tk.Header["kid"] = "k1"
+	s, err := tk.SignedString(key)
+	require.Nil(t, err)
+	_, err = j.verifier(t).Verify(context.Background(), s)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_skips_keys_of_other_algorithms(t *testing.T) {
+	j := newTestJwks(t)
+	key := j.addRsa(t, "k1")
+	j.keys[0]["alg"] = "RS384"
+	_, err := j.verifier(t).Verify(
+		context.Background(), signRs256(t, testClaims(), key, "k1"),
+	)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_refetches_jwks_after_key_rotation(t *testing.T) {
+	j := newTestJwks(t)
+	k1 := j.addRsa(t, "k1")
+	v := j.verifier(t)
+	_, err := v.Verify(
+		context.Background(), signRs256(t, testClaims(), k1, "k1"),
+	)
+	require.Nil(t, err)
+	k2 := j.addRsa(t, "k2")
+	v.keys.fetchedAt = time.Now().Add(-jwksRefreshInterval)
+	_, err = v.Verify(
+		context.Background(), signRs256(t, testClaims(), k2, "k2"),
+	)
+	require.Nil(t, err)
+	require.Equal(t, int32(2), j.hits.Load())
+}
+
+func Test_Verify_limits_jwks_refetches(t *testing.T) {
+	j := newTestJwks(t)
+	key := j.addRsa(t, "k1")
+	v := j.verifier(t)
+	for i := 0; i < 3; i++ {
+		_, err := v.Verify(
+			context.Background(), signRs256(t, testClaims(), key, "unknown"),
+		)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	}
+	require.Equal(t, int32(1), j.hits.Load())
+}
+
+func Test_Verify_returns_error_if_jwks_unavailable(t *testing.T) {
+	svr := httptest.NewServer(http.NotFoundHandler())
+	defer svr.Close()
+	v, err := NewVerifier(VerifierConfig{JwksUrl: svr.URL})
+	require.Nil(t, err)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	_, err = v.Verify(
+		context.Background(), signRs256(t, testClaims(), key, "k1"),
+	)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_jwk_publicKey_returns_error_if_unsupported(t *testing.T) {
+	_, _, err := jwk{Kty: "oct"}.publicKey()
+	require.NotNil(t, err)
+	_, _, err = jwk{Kty: "EC", Crv: "P-521"}.publicKey()
+	require.NotNil(t, err)
+	_, _, err = jwk{Kty: "RSA", N: "", E: "AQAB"}.publicKey()
+	require.NotNil(t, err)
+}
@@ -0,0 +1,132 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type claimsKey struct{}
+
+// Guard authorizes requests to a downstream service. Tokens are verified by
+// the verifier, then the checker asks the service whether they're still
+// accepted and their users are allowed the operation.
+type Guard struct {
+	verifier *Verifier
+	checker  *Checker
+	domain   string
+}
+
+// NewGuard creates a guard of the domain, which qualifies operations without
+// one. The checker may be nil, in which case tokens are only verified, and
+// operations aren't enforced.
+func NewGuard(verifier *Verifier, checker *Checker, domain string) *Guard {
+	return &Guard{verifier: verifier, checker: checker, domain: domain}
+}
+
+// Authorize authorizes the request for the operation, and returns the claims
+// of its token.
+func (g *Guard) Authorize(r *http.Request, operation string) (*Claims, error) {
+	scheme, token, err := TokenFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := g.verifier.Verify(r.Context(), token)
+	if err != nil {
+		return nil, err
+	}
+	if nil == g.checker {
+		return claims, nil
+	}
+	err = g.checker.Check(
+		r.Context(), scheme, token, QualifyOperation(g.domain, operation),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// Require returns middleware that requires the operation. Requests are
+// rejected with 401 if the token is missing, invalid or revoked, 403 if the
+// user isn't allowed the operation, and 500 if the service can't be asked.
+// Claims of authorized requests are available from ClaimsFromContext.
+func (g *Guard) Require(operation string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				claims, err := g.Authorize(r, operation)
+				if err != nil {
+					w.WriteHeader(statusOf(err))
+					return
+				}
+				next.ServeHTTP(
+					w, r.WithContext(
+						context.WithValue(r.Context(), claimsKey{}, claims),
+					),
+				)
+			},
+		)
+	}
+}
+
+// RequireGin is the gin flavor of Require.
+func (g *Guard) RequireGin(operation string) gin.HandlerFunc {
+	return func(gc *gin.Context) {
+		claims, err := g.Authorize(gc.Request, operation)
+		if err != nil {
+			gc.AbortWithStatus(statusOf(err))
+			return
+		}
+		gc.Request = gc.Request.WithContext(
+			context.WithValue(gc.Request.Context(), claimsKey{}, claims),
+		)
+		gc.Next()
+	}
+}
+
+// ClaimsFromContext returns the claims stored by the middleware, use
+// `gc.Request.Context()` for gin.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// TokenFromRequest returns the scheme and token of the Authorization header,
+// or the access token cookie set by the service at login.
+func TokenFromRequest(r *http.Request) (string, string, error) {
+	header := r.Header.Get("Authorization")
+	if "" == header {
+		c, err := r.Cookie(AccessTokenCookie)
+		if err != nil || "" == c.Value {
+			return "", "", ErrNoToken
+		}
+		return SchemeBearer, c.Value, nil
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	token = strings.TrimSpace(token)
+	if !ok || "" == token {
+		return "", "", ErrInvalidToken
+	}
+	switch {
+	case strings.EqualFold(scheme, SchemeBearer):
+		return SchemeBearer, token, nil
+	case strings.EqualFold(scheme, SchemeToken):
+		return SchemeToken, token, nil
+	}
+	return "", "", ErrInvalidToken
+}
+
+func statusOf(err error) int {
+	switch {
+	case errors.Is(err, ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, ErrNoToken), errors.Is(err, ErrInvalidToken),
+		errors.Is(err, ErrRevoked):
+		return http.StatusUnauthorized
+	}
+	return http.StatusInternalServerError
+}
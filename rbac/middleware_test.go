@@ -0,0 +1,155 @@
+package rbac
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+// Serves the handler behind the guard requiring `billing:Create`, which
+// responds with the subject of the token.
+func guarded(g *Guard, r *http.Request) *httptest.ResponseRecorder {
+	res := httptest.NewRecorder()
+	g.Require("Create")(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				claims, ok := ClaimsFromContext(r.Context())
+				if !ok {
+					w.WriteHeader(http.StatusTeapot)
+					return
+				}
+				_, _ = w.Write([]byte(claims.Subject))
+			},
+		),
+	).ServeHTTP(res, r)
+	return res
+}
+
+func guardedGin(g *Guard, r *http.Request) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET(
+		"/", g.RequireGin("Create"), func(gc *gin.Context) {
+			claims, ok := ClaimsFromContext(gc.Request.Context())
+			if !ok {
+				gc.Status(http.StatusTeapot)
+				return
+			}
+			gc.String(http.StatusOK, claims.Subject)
+		},
+	)
+	res := httptest.NewRecorder()
+	engine.ServeHTTP(res, r)
+	return res
+}
+
+func requestWith(header string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if "" != header {
+		r.Header.Set("Authorization", header)
+	}
+	return r
+}
+
+func Test_Require_by_service_answer(t *testing.T) {
+	expected := map[int]int{
+		http.StatusNoContent:           http.StatusOK,
+		http.StatusUnauthorized:        http.StatusUnauthorized,
+		http.StatusForbidden:           http.StatusForbidden,
+		http.StatusInternalServerError: http.StatusInternalServerError,
+	}
+	serve := map[string]func(*Guard, *http.Request) *httptest.ResponseRecorder{
+		"net/http": guarded,
+		"gin":      guardedGin,
+	}
+	for name, fn := range serve {
+		for answer, status := range expected {
+			t.Run(
+				name+" "+http.StatusText(answer), func(t *testing.T) {
+					s := newTestService(t, answer)
+					g := NewGuard(
+						newTestVerifier(t), NewChecker(s.url, 0), "billing",
+					)
+					token := signHs256(t, testClaims())
+					res := fn(g, requestWith("Bearer "+token))
+					require.Equal(t, status, res.Code)
+					require.Equal(t, "billing:Create", s.operation)
+					if http.StatusOK == status {
+						require.Equal(t, "2", res.Body.String())
+					}
+				},
+			)
+		}
+	}
+}
+
+func Test_Require_returns_401_if_no_token(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	g := NewGuard(newTestVerifier(t), NewChecker(s.url, 0), "billing")
+	res := guarded(g, requestWith(""))
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Equal(t, int32(0), s.hits.Load())
+}
+
+func Test_Require_returns_401_if_invalid_token(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	g := NewGuard(newTestVerifier(t), NewChecker(s.url, 0), "billing")
+	res := guarded(g, requestWith("Bearer abc"))
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+	require.Equal(t, int32(0), s.hits.Load())
+}
+
+func Test_Require_accepts_cookie(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	g := NewGuard(newTestVerifier(t), NewChecker(s.url, 0), "billing")
+	token := signHs256(t, testClaims())
+	r := requestWith("")
+	r.AddCookie(&http.Cookie{Name: AccessTokenCookie, Value: token})
+	require.Equal(t, http.StatusOK, guarded(g, r).Code)
+	require.Equal(t, "Bearer "+token, s.header)
+}
+
+func Test_Require_without_checker_only_verifies(t *testing.T) {
+	g := NewGuard(newTestVerifier(t), nil, "billing")
+	token := signHs256(t, testClaims())
+	res := guarded(g, requestWith("Bearer "+token))
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func Test_TokenFromRequest_parses_header(t *testing.T) {
+	tests := map[string][]string{
+		"Bearer abc": {SchemeBearer, "abc"},
+		"bearer abc": {SchemeBearer, "abc"},
+		"Token abc":  {SchemeToken, "abc"},
+		"TOKEN abc":  {SchemeToken, "abc"},
+	}
+	for header, expected := range tests {
+		scheme, token, err := TokenFromRequest(requestWith(header))
+		require.Nil(t, err)
+		require.Equal(t, expected, []string{scheme, token})
+	}
+}
+
+func Test_TokenFromRequest_returns_error_if_invalid_header(t *testing.T) {
+	for _, header := range []string{"Bearer", "Bearer ", "Basic abc"} {
+		_, _, err := TokenFromRequest(requestWith(header))
+		require.ErrorIs(t, err, ErrInvalidToken, header)
+	}
+}
+
+func Test_Require_caches_checks(t *testing.T) {
+	s := newTestService(t, http.StatusNoContent)
+	g := NewGuard(
+		newTestVerifier(t), NewChecker(s.url, time.Minute), "billing",
+	)
+	token := signHs256(t, testClaims())
+	for i := 0; i < 3; i++ {
+		res := guarded(g, requestWith("Bearer "+token))
+		require.Equal(t, http.StatusOK, res.Code)
+	}
+	require.Equal(t, int32(1), s.hits.Load())
+}
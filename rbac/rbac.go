@@ -0,0 +1,50 @@
+// Package rbac lets downstream services accept the tokens issued by the
+// service. It verifies tokens and parses their claims, asks the service
+// whether they are revoked, and provides middleware requiring operations.
+//
+// Operations are named `domain:Operation` like the permissions of the service,
+// e.g. `billing:CreateInvoice`. Users are allowed the operations granted to
+// their roles.
+package rbac
+
+import (
+	"errors"
+	"strings"
+)
+
+// AuthDomain is the domain of the operations of the service itself.
+const AuthDomain = "auth"
+
+// Schemes of the Authorization header accepted by the service.
+const (
+	// SchemeBearer carries access tokens, including the OAuth ones.
+	SchemeBearer = "Bearer"
+	// SchemeToken carries personal tokens.
+	SchemeToken = "Token"
+)
+
+// AccessTokenCookie is the cookie the service sets the access token in.
+const AccessTokenCookie = "access_token"
+
+// ServiceSubjectPrefix prefixes the `sub` claim of the tokens issued to
+// service accounts, the same as `api.ServiceSubjectPrefix` of the service.
+const ServiceSubjectPrefix = "svc:"
+
+var (
+	// ErrNoToken denotes the request carries no token.
+	ErrNoToken = errors.New("no_token")
+	// ErrInvalidToken denotes the token is malformed, forged or expired.
+	ErrInvalidToken = errors.New("invalid_token")
+	// ErrRevoked denotes the service no longer accepts the token.
+	ErrRevoked = errors.New("revoked_token")
+	// ErrForbidden denotes the user isn't allowed the operation.
+	ErrForbidden = errors.New("access_denied")
+)
+
+// QualifyOperation adds the domain qualifier to the operation if it has none.
+func QualifyOperation(domain, operation string) string {
+	if !strings.Contains(operation, ":") {
+		return domain + ":" + operation
+	}
+	return operation
+}
@@ -0,0 +1,17 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_QualifyOperation_adds_domain(t *testing.T) {
+	require.Equal(t, "billing:Create", QualifyOperation("billing", "Create"))
+}
+
+func Test_QualifyOperation_keeps_qualified_operation(t *testing.T) {
+	require.Equal(
+		t, "auth:ListUser", QualifyOperation("billing", "auth:ListUser"),
+	)
+}
@@ -0,0 +1,111 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// VerifierConfig configures a Verifier. At least one of Key and JwksUrl must
+// be set.
+type VerifierConfig struct {
+	// Key is the key shared with the service, i.e. its base64 decoded
+	// PRIVATE_KEY, which verifies HS256 tokens.
+	Key []byte
+	// JwksUrl is the URL of the JSON web key set of the service, e.g.
+	// `https://auth.example.com/oauth/jwks`, which verifies RS256 tokens.
+	JwksUrl string
+	// Issuer is the expected `iss` claim, not checked if empty.
+	Issuer string
+	// Audience is the expected `aud` claim, not checked if empty.
+	Audience string
+	// HttpClient fetches the key set, http.DefaultClient if nil.
+	HttpClient *http.Client
+}
+
+// Verifier verifies tokens issued by the service, and parses their claims.
+// Tokens are verified offline, use Checker to find out revoked ones. Only
+// access tokens are accepted, ID tokens aren't meant to authorize requests.
+type Verifier struct {
+	key     []byte
+	keys    *keySet
+	methods []string
+	options []jwt.ParserOption
+}
+
+// NewVerifier creates a verifier from the configuration.
+func NewVerifier(cfg VerifierConfig) (*Verifier, error) {
+	if 0 == len(cfg.Key) && "" == cfg.JwksUrl {
+		return nil, errors.New("either key or JWKS URL must be set")
+	}
+	v := &Verifier{key: cfg.Key}
+	if len(cfg.Key) > 0 {
+		v.methods = append(v.methods, jwt.SigningMethodHS256.Alg())
+	}
+	if "" != cfg.JwksUrl {
+		client := cfg.HttpClient
+		if nil == client {
+			client = http.DefaultClient
+		}
+		v.keys = newKeySet(cfg.JwksUrl, client)
+		v.methods = append(
+			v.methods, jwt.SigningMethodRS256.Alg(),
+			jwt.SigningMethodES256.Alg(),
+		)
+	}
+	v.options = []jwt.ParserOption{
+		jwt.WithValidMethods(v.methods), jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+	if "" != cfg.Issuer {
+		v.options = append(v.options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if "" != cfg.Audience {
+		v.options = append(v.options, jwt.WithAudience(cfg.Audience))
+	}
+	return v, nil
+}
+
+// Verify verifies the token and returns its claims. Returns ErrInvalidToken
+// if the token is malformed, forged, expired, a login challenge, or not an
+// access token of the service.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(
+		token, claims, func(t *jwt.Token) (interface{}, error) {
+			return v.keyFor(ctx, t)
+		}, v.options...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if "" != claims.Mfa {
+		return nil, fmt.Errorf("%w: login challenge", ErrInvalidToken)
+	}
+	// access tokens always carry the organization of the user, ID tokens
+	// signed by the same key don't
+	if 0 == claims.Tenant {
+		return nil, fmt.Errorf("%w: not an access token", ErrInvalidToken)
+	}
+	return claims, nil
+}
+
+func (v *Verifier) keyFor(ctx context.Context, t *jwt.Token) (
+	interface{}, error,
+) {
+	if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+		return v.key, nil
+	}
+	kid, _ := t.Header["kid"].(string)
+	key, err := v.keys.get(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	if t.Method.Alg() != key.alg {
+		return nil, fmt.Errorf("key %s doesn't verify %s", kid, t.Method.Alg())
+	}
+	return key.key, nil
+}
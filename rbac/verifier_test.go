@@ -0,0 +1,135 @@
+package rbac
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func testClaims() *Claims {
+	now := time.Now()
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "test",
+			Issuer:    "localhost",
+			Audience:  jwt.ClaimStrings{"localhost"},
+			Subject:   "2",
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		Roles:  []string{"user"},
+		Tenant: 1,
+	}
+}
+
+func signHs256(tb testing.TB, claims *Claims) string {
+	s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+		SignedString(testKey)
+	require.Nil(tb, err)
+	return s
+}
+
+func signRs256(
+	tb testing.TB, claims *Claims, key *rsa.PrivateKey, kid string,
+) string {
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if "" != kid {
+		t.Header["kid"] = kid
+	}
+	s, err := t.SignedString(key)
+	require.Nil(tb, err)
+	return s
+}
+
+func newTestVerifier(tb testing.TB) *Verifier {
+	v, err := NewVerifier(
+		VerifierConfig{Key: testKey, Issuer: "localhost", Audience: "localhost"},
+	)
+	require.Nil(tb, err)
+	return v
+}
+
+func Test_NewVerifier_returns_error_without_key(t *testing.T) {
+	_, err := NewVerifier(VerifierConfig{})
+	require.NotNil(t, err)
+}
+
+func Test_Verify_returns_claims_of_hs256_token(t *testing.T) {
+	v := newTestVerifier(t)
+	claims, err := v.Verify(context.Background(), signHs256(t, testClaims()))
+	require.Nil(t, err)
+	require.Equal(t, "2", claims.Subject)
+	require.Equal(t, []string{"user"}, claims.Roles)
+}
+
+func Test_Verify_returns_error_if_forged(t *testing.T) {
+	v, err := NewVerifier(VerifierConfig{Key: []byte("another key")})
+	require.Nil(t, err)
+	_, err = v.Verify(context.Background(), signHs256(t, testClaims()))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_returns_error_if_expired(t *testing.T) {
+	c := testClaims()
+	c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	_, err := newTestVerifier(t).Verify(context.Background(), signHs256(t, c))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_returns_error_if_no_expiry(t *testing.T) {
+	c := testClaims()
+	c.ExpiresAt = nil
+	_, err := newTestVerifier(t).Verify(context.Background(), signHs256(t, c))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_returns_error_if_wrong_issuer(t *testing.T) {
+	c := testClaims()
+	c.Issuer = "example.com"
+	_, err := newTestVerifier(t).Verify(context.Background(), signHs256(t, c))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_returns_error_if_wrong_audience(t *testing.T) {
+	c := testClaims()
+	c.Audience = jwt.ClaimStrings{"example.com"}
+	_, err := newTestVerifier(t).Verify(context.Background(), signHs256(t, c))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_returns_error_if_login_challenge(t *testing.T) {
+	c := testClaims()
+	c.Mfa = "totp"
+	_, err := newTestVerifier(t).Verify(context.Background(), signHs256(t, c))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_returns_error_if_alg_none(t *testing.T) {
+	s, err := jwt.NewWithClaims(jwt.SigningMethodNone, testClaims()).
+		SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.Nil(t, err)
+	_, err = newTestVerifier(t).Verify(context.Background(), s)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_returns_error_if_rs256_without_jwks(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(t, err)
+	s := signRs256(t, testClaims(), key, "test")
+	_, err = newTestVerifier(t).Verify(context.Background(), s)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func Test_Verify_returns_error_if_not_access_token(t *testing.T) {
+	c := testClaims()
+	c.Tenant = 0
+	_, err := newTestVerifier(t).Verify(context.Background(), signHs256(t, c))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
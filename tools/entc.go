@@ -4,8 +4,10 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"entgo.io/contrib/entoas"
@@ -19,33 +21,57 @@ import (
 	"github.com/eidng8/go-attr-rbac/api"
 )
 
-// UsernamePasswordParams is sample schema for username and password sign-in.
+// UsernamePasswordParams is schema for username or email and password sign-in.
+// Which of the identifiers are accepted is configured per deployment.
 var UsernamePasswordParams = ogen.Schema{
 	Type: "object",
 	Properties: []ogen.Property{
-		{Name: "username", Schema: &ogen.Schema{Type: "string"}},
+		{
+			Name: "username",
+			Schema: &ogen.Schema{
+				Type:        "string",
+				Description: "Username, or email if the deployment accepts either",
+			},
+		},
+		{
+			Name: "email",
+			Schema: &ogen.Schema{
+				Type:        "string",
+				Format:      "email",
+				Description: "Email, if the deployment accepts email sign-in",
+			},
+		},
 		{Name: "password", Schema: &ogen.Schema{Type: "string"}},
+		{
+			Name: "organization",
+			Schema: &ogen.Schema{
+				Type:        "integer",
+				Format:      "uint32",
+				Minimum:     ogen.Num("1"),
+				Description: "ID of the organization to sign in to, defaults to 1",
+			},
+		},
 	},
-	Required: []string{"username", "password"},
+	Required: []string{"password"},
 }
 
-// UsernamePasswordParams is sample schema for email and password sign-in.
+// EmailPasswordParams is sample schema for email and password sign-in.
 var EmailPasswordParams = ogen.Schema{
 	Type: "object",
 	Properties: []ogen.Property{
 		{Name: "email", Schema: &ogen.Schema{Type: "string", Format: "email"}},
 		{Name: "password", Schema: &ogen.Schema{Type: "string"}},
 	},
-	Required: []string{"username", "password"},
+	Required: []string{"email", "password"},
 }
 
-// UsernamePasswordParams is sample schema for token sign-in.
+// TokenParams is sample schema for token sign-in.
 var TokenParams = ogen.Schema{
 	Type: "object",
 	Properties: []ogen.Property{
 		{Name: "token", Schema: &ogen.Schema{Type: "string"}},
 	},
-	Required: []string{"username", "password"},
+	Required: []string{"token"},
 }
 
 func main() {
@@ -78,6 +104,24 @@ func newOasExtension() (*entoas.Extension, error) {
 				fixPaths(s)
 				constraintRequestBody(s.Paths)
 				fixTokenPaths(s)
+				fixWebhookPaths(s)
+				fixOrganizationPaths(s)
+				addScimTokenOperations(s)
+				fixGroupPaths(s)
+				fixAssignmentPaths(s)
+				fixSodConstraintPaths(s)
+				fixAccessRequestPaths(s)
+				addImpersonateOperation(s)
+				addUnlockOperation(s)
+				addDisableOperations(s)
+				addTotpOperations(s)
+				addWebauthnOperations(s)
+				addPasswordOperations(s)
+				addPasswordResetOperations(s)
+				addOidcOperations(s)
+				addOauthOperations(s)
+				addServiceAccountOperations(s)
+				addSessionOperations(s)
 				addHintOperations(s)
 				addRoleOperations(s)
 				err := addSoftDelete(s)
@@ -86,6 +130,7 @@ func newOasExtension() (*entoas.Extension, error) {
 				}
 				fixResponses(s)
 				addPingPath(s)
+				addScimOperations(s)
 				return nil
 			},
 		),
@@ -156,6 +201,307 @@ func addPingPath(s *ogen.Spec) {
 	}
 }
 
+func addScimOperations(s *ogen.Spec) {
+	ref := func(schema string) *ogen.Schema {
+		return &ogen.Schema{Ref: "#/components/schemas/" + schema}
+	}
+	scim := func(schema string) map[string]ogen.Media {
+		return map[string]ogen.Media{
+			"application/scim+json": {Schema: ref(schema)},
+			"application/json":      {Schema: ref(schema)},
+		}
+	}
+	str := &ogen.Schema{Type: "string"}
+	strs := &ogen.Schema{Type: "array", Items: &ogen.Items{Item: str}}
+	s.Components.Schemas["ScimMeta"] = &ogen.Schema{
+		Type:        "object",
+		Description: "SCIM resource metadata",
+		Properties: []ogen.Property{
+			{Name: "resourceType", Schema: str},
+			{
+				Name:   "created",
+				Schema: &ogen.Schema{Type: "string", Format: "date-time"},
+			},
+			{
+				Name:   "lastModified",
+				Schema: &ogen.Schema{Type: "string", Format: "date-time"},
+			},
+			{Name: "location", Schema: str},
+		},
+	}
+	s.Components.Schemas["ScimEmail"] = &ogen.Schema{
+		Type:        "object",
+		Description: "SCIM user email",
+		Properties: []ogen.Property{
+			{Name: "value", Schema: str},
+			{Name: "type", Schema: str},
+			{Name: "primary", Schema: &ogen.Schema{Type: "boolean"}},
+		},
+		Required: []string{"value"},
+	}
+	s.Components.Schemas["ScimMember"] = &ogen.Schema{
+		Type:        "object",
+		Description: "SCIM group member",
+		Properties: []ogen.Property{
+			{Name: "value", Schema: str},
+			{Name: "display", Schema: str},
+			{Name: "$ref", Schema: str},
+		},
+		Required: []string{"value"},
+	}
+	s.Components.Schemas["ScimPatchOperation"] = &ogen.Schema{
+		Type:        "object",
+		Description: "SCIM PATCH operation",
+		Properties: []ogen.Property{
+			{Name: "op", Schema: str},
+			{Name: "path", Schema: str},
+			{Name: "value", Schema: &ogen.Schema{}},
+		},
+		Required: []string{"op"},
+	}
+	s.Components.Schemas["ScimUser"] = &ogen.Schema{
+		Type:        "object",
+		Description: "SCIM user resource, maps to User",
+		Properties: []ogen.Property{
+			{Name: "schemas", Schema: strs},
+			{Name: "id", Schema: str},
+			{Name: "externalId", Schema: str},
+			{Name: "userName", Schema: str},
+			{Name: "active", Schema: &ogen.Schema{Type: "boolean"}},
+			{
+				Name: "emails",
+				Schema: &ogen.Schema{
+					Type: "array", Items: &ogen.Items{Item: ref("ScimEmail")},
+				},
+			},
+			{
+				Name:   "password",
+				Schema: &ogen.Schema{Type: "string", Format: "password"},
+			},
+			{Name: "meta", Schema: ref("ScimMeta")},
+		},
+		Required: []string{"userName"},
+	}
+	s.Components.Schemas["ScimGroup"] = &ogen.Schema{
+		Type:        "object",
+		Description: "SCIM group resource, maps to Role",
+		Properties: []ogen.Property{
+			{Name: "schemas", Schema: strs},
+			{Name: "id", Schema: str},
+			{Name: "externalId", Schema: str},
+			{Name: "displayName", Schema: str},
+			{
+				Name: "members",
+				Schema: &ogen.Schema{
+					Type: "array", Items: &ogen.Items{Item: ref("ScimMember")},
+				},
+			},
+			{Name: "meta", Schema: ref("ScimMeta")},
+		},
+		Required: []string{"displayName"},
+	}
+	s.Components.Schemas["ScimPatchOp"] = &ogen.Schema{
+		Type:        "object",
+		Description: "SCIM PATCH request",
+		Properties: []ogen.Property{
+			{Name: "schemas", Schema: strs},
+			{
+				Name: "Operations",
+				Schema: &ogen.Schema{
+					Type:  "array",
+					Items: &ogen.Items{Item: ref("ScimPatchOperation")},
+				},
+			},
+		},
+		Required: []string{"Operations"},
+	}
+	list := func(name, item string) {
+		s.Components.Schemas[name] = &ogen.Schema{
+			Type:        "object",
+			Description: "SCIM list response",
+			Properties: []ogen.Property{
+				{Name: "schemas", Schema: strs},
+				{Name: "totalResults", Schema: &ogen.Schema{Type: "integer"}},
+				{Name: "startIndex", Schema: &ogen.Schema{Type: "integer"}},
+				{Name: "itemsPerPage", Schema: &ogen.Schema{Type: "integer"}},
+				{
+					Name: "Resources",
+					Schema: &ogen.Schema{
+						Type: "array",
+						Items: &ogen.Items{
+							Item: ref(item),
+						},
+					},
+				},
+			},
+			Required: []string{
+				"schemas", "totalResults", "startIndex", "itemsPerPage",
+				"Resources",
+			},
+		}
+	}
+	list("ScimUserList", "ScimUser")
+	list("ScimGroupList", "ScimGroup")
+	s.Components.Schemas["ScimError"] = &ogen.Schema{
+		Type:        "object",
+		Description: "SCIM error response",
+		Properties: []ogen.Property{
+			{Name: "schemas", Schema: strs},
+			{Name: "status", Schema: str},
+			{Name: "scimType", Schema: str},
+			{Name: "detail", Schema: str},
+		},
+		Required: []string{"schemas", "status"},
+	}
+	scimError := func(status int) *ogen.Response {
+		return &ogen.Response{
+			Description: http.StatusText(status),
+			Content: map[string]ogen.Media{
+				"application/scim+json": {
+					Schema: ref("ScimError"),
+				},
+			},
+		}
+	}
+	resource := func(schema string, status int) *ogen.Response {
+		return &ogen.Response{
+			Description: http.StatusText(status),
+			Content: map[string]ogen.Media{
+				"application/scim+json": {
+					Schema: ref(schema),
+				},
+			},
+		}
+	}
+	idParam := func(format string) *ogen.Parameter {
+		return &ogen.Parameter{
+			Name:        "id",
+			In:          "path",
+			Description: "ID of the resource",
+			Required:    true,
+			Schema: &ogen.Schema{
+				Type:    "integer",
+				Format:  format,
+				Minimum: ogen.Num("1"),
+			},
+		}
+	}
+	listParams := []*ogen.Parameter{
+		{
+			Name:        "filter",
+			In:          "query",
+			Description: "SCIM filter expression",
+			Schema:      str,
+		},
+		{
+			Name:        "startIndex",
+			In:          "query",
+			Description: "1-based index of the first result",
+			Schema:      &ogen.Schema{Type: "integer", Minimum: ogen.Num("1")},
+		},
+		{
+			Name:        "count",
+			In:          "query",
+			Description: "Maximum number of results per page",
+			Schema:      &ogen.Schema{Type: "integer", Minimum: ogen.Num("0")},
+		},
+	}
+	add := func(path, name, format string) {
+		s.Paths["/scim/v2/"+path+"s"] = &ogen.PathItem{
+			Get: &ogen.Operation{
+				Summary:     "List SCIM " + strings.ToLower(name) + "s",
+				OperationID: "scimList" + name + "s",
+				Parameters:  listParams,
+				Responses: map[string]*ogen.Response{
+					"200": resource("Scim"+name+"List", http.StatusOK),
+					"400": scimError(http.StatusBadRequest),
+				},
+			},
+			Post: &ogen.Operation{
+				Summary:     "Create SCIM " + strings.ToLower(name),
+				OperationID: "scimCreate" + name,
+				RequestBody: &ogen.RequestBody{
+					Required: true,
+					Content:  scim("Scim" + name),
+				},
+				Responses: map[string]*ogen.Response{
+					"201": resource("Scim"+name, http.StatusCreated),
+					"400": scimError(http.StatusBadRequest),
+					"409": scimError(http.StatusConflict),
+				},
+			},
+		}
+		s.Paths["/scim/v2/"+path+"s/{id}"] = &ogen.PathItem{
+			Get: &ogen.Operation{
+				Summary:     "Read SCIM " + strings.ToLower(name),
+				OperationID: "scimRead" + name,
+				Parameters:  []*ogen.Parameter{idParam(format)},
+				Responses: map[string]*ogen.Response{
+					"200": resource("Scim"+name, http.StatusOK),
+					"404": scimError(http.StatusNotFound),
+				},
+			},
+			Put: &ogen.Operation{
+				Summary:     "Replace SCIM " + strings.ToLower(name),
+				OperationID: "scimReplace" + name,
+				Parameters:  []*ogen.Parameter{idParam(format)},
+				RequestBody: &ogen.RequestBody{
+					Required: true,
+					Content:  scim("Scim" + name),
+				},
+				Responses: map[string]*ogen.Response{
+					"200": resource("Scim"+name, http.StatusOK),
+					"400": scimError(http.StatusBadRequest),
+					"404": scimError(http.StatusNotFound),
+					"409": scimError(http.StatusConflict),
+				},
+			},
+			Patch: &ogen.Operation{
+				Summary:     "Patch SCIM " + strings.ToLower(name),
+				OperationID: "scimPatch" + name,
+				Parameters:  []*ogen.Parameter{idParam(format)},
+				RequestBody: &ogen.RequestBody{
+					Required: true,
+					Content:  scim("ScimPatchOp"),
+				},
+				Responses: map[string]*ogen.Response{
+					"200": resource("Scim"+name, http.StatusOK),
+					"400": scimError(http.StatusBadRequest),
+					"404": scimError(http.StatusNotFound),
+					"409": scimError(http.StatusConflict),
+				},
+			},
+			Delete: &ogen.Operation{
+				Summary:     "Delete SCIM " + strings.ToLower(name),
+				OperationID: "scimDelete" + name,
+				Parameters:  []*ogen.Parameter{idParam(format)},
+				Responses: map[string]*ogen.Response{
+					"204": {Description: "Resource was deleted"},
+					"404": scimError(http.StatusNotFound),
+				},
+			},
+		}
+	}
+	add("User", "User", "uint64")
+	add("Group", "Group", "uint32")
+	s.Paths["/scim/v2/ServiceProviderConfig"] = &ogen.PathItem{
+		Get: &ogen.Operation{
+			Summary:     "SCIM service provider configuration",
+			OperationID: "scimServiceProviderConfig",
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: http.StatusText(http.StatusOK),
+					Content: map[string]ogen.Media{
+						"application/scim+json": {
+							Schema: &ogen.Schema{Type: "object"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func addRoleOperations(s *ogen.Spec) {
 	s.Paths["/role/{id}/permissions"].Post = &ogen.Operation{
 		Summary:     "Assign permissions to role",
@@ -214,6 +560,20 @@ func addRoleOperations(s *ogen.Spec) {
 					Minimum: ogen.Num("1"),
 				},
 			},
+			{
+				Name:        "starts_at",
+				In:          "query",
+				Description: "Time from which the assignments are in effect",
+				Required:    false,
+				Schema:      &ogen.Schema{Type: "string", Format: "date-time"},
+			},
+			{
+				Name:        "expires_at",
+				In:          "query",
+				Description: "Time at which the assignments expire",
+				Required:    false,
+				Schema:      &ogen.Schema{Type: "string", Format: "date-time"},
+			},
 		},
 		RequestBody: &ogen.RequestBody{
 			Required: true,
@@ -238,6 +598,7 @@ func addRoleOperations(s *ogen.Spec) {
 			"401": {Ref: "#/components/responses/401"},
 			"403": {Ref: "#/components/responses/403"},
 			"404": {Ref: "#/components/responses/404"},
+			"409": {Ref: "#/components/responses/409"},
 			"500": {Ref: "#/components/responses/500"},
 		},
 	}
@@ -315,6 +676,18 @@ func fixComponents(spec *ogen.Spec) {
 	}
 	spec.Components.Responses["422"].Content =
 		spec.Components.Responses["403"].Content
+	spec.Components.Responses["429"] = &ogen.Response{
+		Description: http.StatusText(http.StatusTooManyRequests),
+		Headers: map[string]*ogen.Header{
+			"Retry-After": {
+				Description: "Seconds to wait before trying again",
+				Required:    true,
+				Schema:      &ogen.Schema{Type: "integer"},
+			},
+		},
+	}
+	spec.Components.Responses["429"].Content =
+		spec.Components.Responses["403"].Content
 	spec.Components.Schemas["PersonalTokenCreate"].Properties =
 		append(
 			spec.Components.Schemas["PersonalTokenCreate"].Properties,
@@ -445,6 +818,24 @@ func fixResponses(spec *ogen.Spec) {
 	spec.Paths["/users"].Post.Responses["201"] =
 		spec.Paths["/users"].Post.Responses["200"]
 	delete(spec.Paths["/users"].Post.Responses, "200")
+	spec.Paths["/webhooks"].Post.Responses["201"] =
+		spec.Paths["/webhooks"].Post.Responses["200"]
+	delete(spec.Paths["/webhooks"].Post.Responses, "200")
+	spec.Paths["/organizations"].Post.Responses["201"] =
+		spec.Paths["/organizations"].Post.Responses["200"]
+	delete(spec.Paths["/organizations"].Post.Responses, "200")
+	spec.Paths["/groups"].Post.Responses["201"] =
+		spec.Paths["/groups"].Post.Responses["200"]
+	delete(spec.Paths["/groups"].Post.Responses, "200")
+	spec.Paths["/oauth-clients"].Post.Responses["201"] =
+		spec.Paths["/oauth-clients"].Post.Responses["200"]
+	delete(spec.Paths["/oauth-clients"].Post.Responses, "200")
+	spec.Paths["/sod-constraints"].Post.Responses["201"] =
+		spec.Paths["/sod-constraints"].Post.Responses["200"]
+	delete(spec.Paths["/sod-constraints"].Post.Responses, "200")
+	spec.Paths["/service-accounts"].Post.Responses["201"] =
+		spec.Paths["/service-accounts"].Post.Responses["200"]
+	delete(spec.Paths["/service-accounts"].Post.Responses, "200")
 	for _, path := range spec.Paths {
 		if nil == path {
 			continue
@@ -493,6 +884,15 @@ func fixTokenPaths(spec *ogen.Spec) {
 	delete(spec.Paths, "/access-tokens/{id}")
 	spec.Paths["/access-token"].Get.SetOperationID("checkAccessToken")
 	spec.Paths["/access-token"].Get.SetParameters(nil)
+	spec.Paths["/access-token"].Get.AddParameters(
+		&ogen.Parameter{
+			Name:        "operation",
+			In:          "query",
+			Description: "Also check the user is allowed the operation, named as `domain:Operation`, the `auth` domain if omitted",
+			Required:    false,
+			Schema:      &ogen.Schema{Type: "string"},
+		},
+	)
 	spec.Paths["/access-token"].Get.SetSummary("Validate current access token")
 	spec.Paths["/access-token"].Get.SetDescription("")
 	delete(spec.Paths["/access-token"].Get.Responses, "200")
@@ -584,6 +984,7 @@ func fixTokenPaths(spec *ogen.Spec) {
 				},
 				"400": {Ref: "#/components/responses/400"},
 				// "401": {Ref: "#/components/responses/401"},
+				"429": {Ref: "#/components/responses/429"},
 				"500": {Ref: "#/components/responses/500"},
 			},
 		},
@@ -601,15 +1002,1806 @@ func fixTokenPaths(spec *ogen.Spec) {
 	}
 }
 
-func findPropertyByName(props []ogen.Property, name string) (
-	int, *ogen.Property,
-) {
-	for i, prop := range props {
-		if prop.Name == name {
-			return i, &prop
+func fixWebhookPaths(spec *ogen.Spec) {
+	spec.Paths["/webhook/{id}"] = spec.Paths["/webhooks/{id}"]
+	delete(spec.Paths, "/webhooks/{id}")
+	spec.Paths["/webhook/{id}/deliveries"] =
+		spec.Paths["/webhooks/{id}/deliveries"]
+	delete(spec.Paths, "/webhooks/{id}/deliveries")
+	paginate.AttachTo(
+		spec.Paths["/webhooks"].Get,
+		"Paginated list of webhooks",
+		spec.Paths["/webhooks"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	paginate.AttachTo(
+		spec.Paths["/webhook/{id}/deliveries"].Get,
+		"Paginated list of webhook deliveries",
+		spec.Paths["/webhook/{id}/deliveries"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	u16 := uint64(16)
+	u255 := uint64(255)
+	secret := ogen.Property{
+		Name: "secret",
+		Schema: &ogen.Schema{
+			Type:        "string",
+			MinLength:   &u16,
+			MaxLength:   &u255,
+			Description: "Secret used to sign deliveries with HMAC-SHA256",
+		},
+	}
+	for _, op := range []*ogen.Operation{
+		spec.Paths["/webhooks"].Post, spec.Paths["/webhook/{id}"].Patch,
+	} {
+		sch := op.RequestBody.Content["application/json"].Schema
+		i, _ := findPropertyByName(sch.Properties, "deliveries")
+		sch.Properties = append(sch.Properties[:i], sch.Properties[i+1:]...)
+		sch.Properties = append(sch.Properties, secret)
+	}
+	removeProperties(spec.Paths["/webhooks"].Post, "organization")
+	removeProperties(spec.Paths["/webhook/{id}"].Patch, "organization")
+	// organization_id is optional on creation, it defaults to the current
+	// user's organization
+	sch := spec.Paths["/webhooks"].Post.RequestBody.
+		Content["application/json"].Schema
+	sch.Required = []string{"url", "events", "secret"}
+}
+
+func fixOrganizationPaths(spec *ogen.Spec) {
+	spec.Paths["/organization/{id}"] = spec.Paths["/organizations/{id}"]
+	delete(spec.Paths, "/organizations/{id}")
+	paginate.AttachTo(
+		spec.Paths["/organizations"].Get,
+		"Paginated list of organizations",
+		spec.Paths["/organizations"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	org := spec.Components.Schemas["Organization"]
+	for _, edge := range []string{"webhooks", "oauth_clients"} {
+		i, _ := findPropertyByName(org.Properties, edge)
+		org.Properties = append(org.Properties[:i], org.Properties[i+1:]...)
+	}
+	for _, op := range []*ogen.Operation{
+		spec.Paths["/organizations"].Post,
+		spec.Paths["/organization/{id}"].Patch,
+	} {
+		removeProperties(op, "users", "roles", "webhooks", "oauth_clients")
+	}
+	// organization_id is optional on creation, it defaults to the current
+	// user's organization
+	for _, op := range []*ogen.Operation{
+		spec.Paths["/users"].Post, spec.Paths["/roles"].Post,
+	} {
+		sch := op.RequestBody.Content["application/json"].Schema
+		removeProperties(op, "organization")
+		i := slices.Index(sch.Required, "organization_id")
+		sch.Required = slices.Delete(sch.Required, i, i+1)
+	}
+	removeProperties(spec.Paths["/user/{id}"].Patch, "organization")
+	removeProperties(spec.Paths["/role/{id}"].Patch, "organization")
+}
+
+// Adds the endpoints issuing and revoking the SCIM token of organizations.
+func addScimTokenOperations(spec *ogen.Spec) {
+	spec.Components.Schemas["ScimToken"] = &ogen.Schema{
+		Type: "object",
+		Properties: []ogen.Property{
+			{
+				Name: "token",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "Bearer token of the SCIM client, only returned once",
+				},
+			},
+		},
+		Required: []string{"token"},
+	}
+	id := []*ogen.Parameter{
+		{
+			Name:        "id",
+			In:          "path",
+			Description: "ID of the organization",
+			Required:    true,
+			Schema: &ogen.Schema{
+				Type:    "integer",
+				Format:  "uint32",
+				Minimum: ogen.Num("1"),
+			},
+		},
+	}
+	spec.Paths["/organization/{id}/scim-token"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Issue the SCIM token of the organization",
+			Description: "The SCIM client of the organization provisions its users and roles with the token. The previous token is revoked.",
+			OperationID: "issueScimToken",
+			Parameters:  id,
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "SCIM token issued",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Ref: "#/components/schemas/ScimToken",
+							},
+						},
+					},
+				},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+		Delete: &ogen.Operation{
+			Summary:     "Revoke the SCIM token of the organization",
+			Description: "SCIM is disabled for the organization until a new token is issued.",
+			OperationID: "revokeScimToken",
+			Parameters:  id,
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "SCIM token revoked"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func fixGroupPaths(spec *ogen.Spec) {
+	spec.Paths["/group/{id}"] = spec.Paths["/groups/{id}"]
+	delete(spec.Paths, "/groups/{id}")
+	spec.Paths["/group/{id}/users"] = spec.Paths["/groups/{id}/users"]
+	delete(spec.Paths, "/groups/{id}/users")
+	spec.Paths["/group/{id}/roles"] = spec.Paths["/groups/{id}/roles"]
+	delete(spec.Paths, "/groups/{id}/roles")
+	spec.Paths["/groups"].Get.AddParameters(nameParam("group"))
+	paginate.AttachTo(
+		spec.Paths["/groups"].Get,
+		"Paginated list of groups",
+		spec.Paths["/groups"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	spec.Paths["/group/{id}/users"].Get.AddParameters(nameParam("user"))
+	paginate.AttachTo(
+		spec.Paths["/group/{id}/users"].Get,
+		"Paginated list of group members",
+		spec.Paths["/group/{id}/users"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	spec.Paths["/group/{id}/roles"].Get.AddParameters(nameParam("role"))
+	paginate.AttachTo(
+		spec.Paths["/group/{id}/roles"].Get,
+		"Paginated list of group roles",
+		spec.Paths["/group/{id}/roles"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	// organization_id is optional on creation, it defaults to the current
+	// user's organization
+	sch := spec.Paths["/groups"].Post.RequestBody.
+		Content["application/json"].Schema
+	removeProperties(spec.Paths["/groups"].Post, "organization")
+	i := slices.Index(sch.Required, "organization_id")
+	sch.Required = slices.Delete(sch.Required, i, i+1)
+	removeProperties(spec.Paths["/group/{id}"].Patch, "organization")
+	// groups are managed from the group side only
+	for _, op := range []*ogen.Operation{
+		spec.Paths["/users"].Post, spec.Paths["/user/{id}"].Patch,
+		spec.Paths["/roles"].Post, spec.Paths["/role/{id}"].Patch,
+	} {
+		removeProperties(op, "groups")
+	}
+	groupId := &ogen.Parameter{
+		Name:        "id",
+		In:          "path",
+		Description: "ID of the group",
+		Required:    true,
+		Schema: &ogen.Schema{
+			Type:    "integer",
+			Format:  "uint32",
+			Minimum: ogen.Num("1"),
+		},
+	}
+	idList := func(format string) *ogen.RequestBody {
+		return &ogen.RequestBody{
+			Required: true,
+			Content: map[string]ogen.Media{
+				"application/json": {
+					Schema: &ogen.Schema{
+						Type: "array",
+						Items: &ogen.Items{
+							Item: &ogen.Schema{
+								Type:    "integer",
+								Format:  format,
+								Minimum: ogen.Num("1"),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	spec.Paths["/group/{id}/users"].Post = &ogen.Operation{
+		Summary:     "Add members to group",
+		OperationID: "addGroupMembers",
+		Parameters:  []*ogen.Parameter{groupId},
+		RequestBody: idList("uint64"),
+		Responses: map[string]*ogen.Response{
+			"204": {Description: "Successfully added members to group"},
+			"400": {Ref: "#/components/responses/400"},
+			"401": {Ref: "#/components/responses/401"},
+			"403": {Ref: "#/components/responses/403"},
+			"404": {Ref: "#/components/responses/404"},
+			"409": {Ref: "#/components/responses/409"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	spec.Paths["/group/{id}/user/{user_id}"] = &ogen.PathItem{
+		Delete: &ogen.Operation{
+			Summary:     "Remove member from group",
+			OperationID: "removeGroupMember",
+			Parameters: []*ogen.Parameter{
+				groupId,
+				{
+					Name:        "user_id",
+					In:          "path",
+					Description: "ID of the user",
+					Required:    true,
+					Schema: &ogen.Schema{
+						Type:    "integer",
+						Format:  "uint64",
+						Minimum: ogen.Num("1"),
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "Successfully removed member from group"},
+				"401": {Ref: "#/components/responses/401"},
+				"403": {Ref: "#/components/responses/403"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/group/{id}/roles"].Post = &ogen.Operation{
+		Summary:     "Assign roles to group",
+		OperationID: "assignGroupRoles",
+		Parameters:  []*ogen.Parameter{groupId},
+		RequestBody: idList("uint32"),
+		Responses: map[string]*ogen.Response{
+			"204": {Description: "Successfully assigned roles to group"},
+			"400": {Ref: "#/components/responses/400"},
+			"401": {Ref: "#/components/responses/401"},
+			"403": {Ref: "#/components/responses/403"},
+			"404": {Ref: "#/components/responses/404"},
+			"409": {Ref: "#/components/responses/409"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+}
+
+func fixAssignmentPaths(spec *ogen.Spec) {
+	// assignments are managed through the user and role endpoints
+	delete(spec.Paths, "/roles/{id}/assignments")
+	delete(spec.Paths, "/users/{id}/assignments")
+	delete(spec.Components.Schemas, "Role_AssignmentsList")
+	delete(spec.Components.Schemas, "User_AssignmentsList")
+	for _, op := range []*ogen.Operation{
+		spec.Paths["/users"].Post, spec.Paths["/user/{id}"].Patch,
+		spec.Paths["/roles"].Post, spec.Paths["/role/{id}"].Patch,
+	} {
+		removeProperties(op, "assignments")
+	}
+	sch := spec.Components.Schemas["User_RolesList"]
+	sch.Properties = append(
+		sch.Properties,
+		ogen.Property{
+			Name: "starts_at",
+			Schema: &ogen.Schema{
+				Type:        "string",
+				Format:      "date-time",
+				Description: "Time from which the assignment is in effect",
+			},
+		},
+		ogen.Property{
+			Name: "expires_at",
+			Schema: &ogen.Schema{
+				Type:        "string",
+				Format:      "date-time",
+				Description: "Time at which the assignment expires",
+			},
+		},
+	)
+	spec.Paths["/audit-logs"].Get.AddParameters(
+		&ogen.Parameter{
+			Name:        "action",
+			In:          "query",
+			Description: "Action of the entries",
+			Required:    false,
+			Schema:      &ogen.Schema{Type: "string"},
+		},
+	)
+	paginate.AttachTo(
+		spec.Paths["/audit-logs"].Get,
+		"Paginated list of audit log entries",
+		spec.Paths["/audit-logs"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+}
+
+func fixSodConstraintPaths(spec *ogen.Spec) {
+	spec.Paths["/sod-constraint/{id}"] = spec.Paths["/sod-constraints/{id}"]
+	delete(spec.Paths, "/sod-constraints/{id}")
+	spec.Paths["/sod-constraint/{id}/roles"] =
+		spec.Paths["/sod-constraints/{id}/roles"]
+	delete(spec.Paths, "/sod-constraints/{id}/roles")
+	spec.Paths["/sod-constraints"].Get.AddParameters(nameParam("constraint"))
+	paginate.AttachTo(
+		spec.Paths["/sod-constraints"].Get,
+		"Paginated list of separation-of-duties constraints",
+		spec.Paths["/sod-constraints"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	spec.Paths["/sod-constraint/{id}/roles"].Get.
+		AddParameters(nameParam("role"))
+	paginate.AttachTo(
+		spec.Paths["/sod-constraint/{id}/roles"].Get,
+		"Paginated list of mutually exclusive roles",
+		spec.Paths["/sod-constraint/{id}/roles"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	// organization_id is optional on creation, it defaults to the current
+	// user's organization
+	create := spec.Paths["/sod-constraints"].Post
+	sch := create.RequestBody.Content["application/json"].Schema
+	removeProperties(create, "organization")
+	i := slices.Index(sch.Required, "organization_id")
+	sch.Required = slices.Delete(sch.Required, i, i+1)
+	// a constraint needs at least 2 roles to exclude each other
+	u2 := uint64(2)
+	sch.Required = append(sch.Required, "roles")
+	_, prop := findPropertyByName(sch.Properties, "roles")
+	prop.Schema.MinItems = &u2
+	update := spec.Paths["/sod-constraint/{id}"].Patch
+	removeProperties(update, "organization")
+	_, prop = findPropertyByName(
+		update.RequestBody.Content["application/json"].Schema.Properties,
+		"roles",
+	)
+	prop.Schema.MinItems = &u2
+	spec.Components.Schemas["SodViolation"] = &ogen.Schema{
+		Type: "object",
+		Properties: []ogen.Property{
+			{
+				Name: "user_id",
+				Schema: &ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			},
+			{Name: "username", Schema: &ogen.Schema{Type: "string"}},
+			{
+				Name: "roles",
+				Schema: &ogen.Schema{
+					Type:  "array",
+					Items: &ogen.Items{Item: &ogen.Schema{Type: "string"}},
+				},
+			},
+		},
+		Required: []string{"user_id", "username", "roles"},
+	}
+	spec.Paths["/sod-constraint/{id}/violations"] = &ogen.PathItem{
+		Get: &ogen.Operation{
+			Summary:     "List users violating the constraint",
+			OperationID: "listSodViolations",
+			Parameters: []*ogen.Parameter{
+				{
+					Name:        "id",
+					In:          "path",
+					Description: "ID of the constraint",
+					Required:    true,
+					Schema: &ogen.Schema{
+						Type:    "integer",
+						Format:  "uint32",
+						Minimum: ogen.Num("1"),
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "Users holding more than one of the roles",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Type: "array",
+								Items: &ogen.Items{
+									Item: &ogen.Schema{
+										Ref: "#/components/schemas/SodViolation",
+									},
+								},
+							},
+						},
+					},
+				},
+				"401": {Ref: "#/components/responses/401"},
+				"403": {Ref: "#/components/responses/403"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func fixAccessRequestPaths(spec *ogen.Spec) {
+	spec.Paths["/access-request/{id}"] = spec.Paths["/access-requests/{id}"]
+	delete(spec.Paths, "/access-requests/{id}")
+	spec.Paths["/role/{id}/approvers"] = spec.Paths["/roles/{id}/approvers"]
+	delete(spec.Paths, "/roles/{id}/approvers")
+	spec.Paths["/role/{id}/approvers"].Get.AddParameters(nameParam("user"))
+	paginate.AttachTo(
+		spec.Paths["/role/{id}/approvers"].Get,
+		"Paginated list of role approvers",
+		spec.Paths["/role/{id}/approvers"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	// approvers are managed from the role side only
+	removeProperties(spec.Paths["/users"].Post, "approvable_roles")
+	removeProperties(spec.Paths["/user/{id}"].Patch, "approvable_roles")
+	list := spec.Paths["/access-requests"].Get
+	list.AddParameters(
+		&ogen.Parameter{
+			Name:        "status",
+			In:          "query",
+			Description: "Status of the requests",
+			Required:    false,
+			Schema: &ogen.Schema{
+				Type: "string",
+				Enum: []json.RawMessage{
+					json.RawMessage(`"pending"`),
+					json.RawMessage(`"approved"`),
+					json.RawMessage(`"denied"`),
+				},
+			},
+		},
+		&ogen.Parameter{
+			Name:        "role_id",
+			In:          "query",
+			Description: "ID of the requested role",
+			Required:    false,
+			Schema: &ogen.Schema{
+				Type:    "integer",
+				Format:  "uint32",
+				Minimum: ogen.Num("1"),
+			},
+		},
+		&ogen.Parameter{
+			Name:        "requester_id",
+			In:          "query",
+			Description: "ID of the requesting user",
+			Required:    false,
+			Schema: &ogen.Schema{
+				Type:    "integer",
+				Format:  "uint64",
+				Minimum: ogen.Num("1"),
+			},
+		},
+	)
+	paginate.AttachTo(
+		list,
+		"Paginated list of access requests",
+		list.Responses["200"].Content["application/json"].Schema.Items.Item.Ref,
+	)
+	u1 := uint64(1)
+	u1000 := uint64(1000)
+	spec.Paths["/access-requests"].Post = &ogen.Operation{
+		Summary:     "Request a role",
+		OperationID: "createAccessRequest",
+		RequestBody: &ogen.RequestBody{
+			Required: true,
+			Content: map[string]ogen.Media{
+				"application/json": {
+					Schema: &ogen.Schema{
+						Type: "object",
+						Properties: []ogen.Property{
+							{
+								Name: "role_id",
+								Schema: &ogen.Schema{
+									Type:    "integer",
+									Format:  "uint32",
+									Minimum: ogen.Num("1"),
+								},
+							},
+							{
+								Name: "justification",
+								Schema: &ogen.Schema{
+									Type:      "string",
+									MinLength: &u1,
+									MaxLength: &u1000,
+								},
+							},
+							{
+								Name: "duration",
+								Schema: &ogen.Schema{
+									Type:        "integer",
+									Format:      "uint32",
+									Minimum:     ogen.Num("1"),
+									Description: "Duration of the assignment in seconds, omit for indefinite",
+								},
+							},
+						},
+						Required: []string{"role_id", "justification"},
+					},
+				},
+			},
+		},
+		Responses: map[string]*ogen.Response{
+			"201": {
+				Description: "Access request created",
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Ref: "#/components/schemas/AccessRequestRead",
+						},
+					},
+				},
+			},
+			"400": {Ref: "#/components/responses/400"},
+			"401": {Ref: "#/components/responses/401"},
+			"403": {Ref: "#/components/responses/403"},
+			"500": {Ref: "#/components/responses/500"},
+		},
+	}
+	review := func(id, summary, desc string) *ogen.PathItem {
+		return &ogen.PathItem{
+			Post: &ogen.Operation{
+				Summary:     summary,
+				OperationID: id,
+				Parameters: []*ogen.Parameter{
+					{
+						Name:        "id",
+						In:          "path",
+						Description: "ID of the access request",
+						Required:    true,
+						Schema: &ogen.Schema{
+							Type:    "integer",
+							Format:  "uint64",
+							Minimum: ogen.Num("1"),
+						},
+					},
+				},
+				RequestBody: &ogen.RequestBody{
+					Required: false,
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Type: "object",
+								Properties: []ogen.Property{
+									{
+										Name: "comment",
+										Schema: &ogen.Schema{
+											Type:      "string",
+											MaxLength: &u1000,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Responses: map[string]*ogen.Response{
+					"200": {
+						Description: desc,
+						Content: map[string]ogen.Media{
+							"application/json": {
+								Schema: &ogen.Schema{
+									Ref: "#/components/schemas/AccessRequestRead",
+								},
+							},
+						},
+					},
+					"400": {Ref: "#/components/responses/400"},
+					"401": {Ref: "#/components/responses/401"},
+					"403": {Ref: "#/components/responses/403"},
+					"404": {Ref: "#/components/responses/404"},
+					"409": {Ref: "#/components/responses/409"},
+					"500": {Ref: "#/components/responses/500"},
+				},
+			},
+		}
+	}
+	spec.Paths["/access-request/{id}/approve"] = review(
+		"approveAccessRequest", "Approve an access request",
+		"Access request approved and the role assigned",
+	)
+	spec.Paths["/access-request/{id}/deny"] = review(
+		"denyAccessRequest", "Deny an access request",
+		"Access request denied",
+	)
+}
+
+func addImpersonateOperation(spec *ogen.Spec) {
+	spec.Components.Schemas["ImpersonationToken"] = &ogen.Schema{
+		Type: "object",
+		Properties: []ogen.Property{
+			{
+				Name: "access_token",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "Access token carrying an `act` claim of the impersonator",
+				},
+			},
+			{
+				Name: "token_type",
+				Schema: &ogen.Schema{
+					Type: "string",
+					Enum: []json.RawMessage{json.RawMessage(`"Bearer"`)},
+				},
+			},
+			{
+				Name:   "expires_at",
+				Schema: &ogen.Schema{Type: "string", Format: "date-time"},
+			},
+		},
+		Required: []string{"access_token", "token_type", "expires_at"},
+	}
+	spec.Paths["/user/{id}/impersonate"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Issue a short-lived access token to act as the user",
+			OperationID: "impersonate",
+			Parameters: []*ogen.Parameter{
+				{
+					Name:        "id",
+					In:          "path",
+					Description: "ID of the user to impersonate",
+					Required:    true,
+					Schema: &ogen.Schema{
+						Type:    "integer",
+						Format:  "uint64",
+						Minimum: ogen.Num("1"),
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "Access token of the impersonated user",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Ref: "#/components/schemas/ImpersonationToken",
+							},
+						},
+					},
+				},
+				"401": {Ref: "#/components/responses/401"},
+				"403": {Ref: "#/components/responses/403"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func addUnlockOperation(spec *ogen.Spec) {
+	spec.Paths["/user/{id}/unlock"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Lift the login lockout of the user",
+			OperationID: "unlockUser",
+			Parameters: []*ogen.Parameter{
+				{
+					Name:        "id",
+					In:          "path",
+					Description: "ID of the user to unlock",
+					Required:    true,
+					Schema: &ogen.Schema{
+						Type:    "integer",
+						Format:  "uint64",
+						Minimum: ogen.Num("1"),
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "User unlocked"},
+				"401": {Ref: "#/components/responses/401"},
+				"403": {Ref: "#/components/responses/403"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func addDisableOperations(spec *ogen.Spec) {
+	sch := spec.Components.Schemas["UserRead"]
+	sch.Properties = append(
+		sch.Properties, ogen.Property{
+			Name: "status",
+			Schema: &ogen.Schema{
+				Type:        "string",
+				Description: "State of the account, disabled and deleted users can't authenticate",
+				Enum: []json.RawMessage{
+					json.RawMessage(`"active"`),
+					json.RawMessage(`"disabled"`),
+					json.RawMessage(`"deleted"`),
+					json.RawMessage(`"locked"`),
+				},
+			},
+		},
+	)
+	id := &ogen.Parameter{
+		Name:        "id",
+		In:          "path",
+		Description: "ID of the user",
+		Required:    true,
+		Schema: &ogen.Schema{
+			Type:    "integer",
+			Format:  "uint64",
+			Minimum: ogen.Num("1"),
+		},
+	}
+	spec.Paths["/user/{id}/disable"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Disable the user",
+			Description: "Disabled users can't sign in, and their tokens are revoked.",
+			OperationID: "disableUser",
+			Parameters:  []*ogen.Parameter{id},
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "User disabled"},
+				"404": {Ref: "#/components/responses/404"},
+				"409": {Ref: "#/components/responses/409"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/user/{id}/enable"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Enable the disabled user",
+			OperationID: "enableUser",
+			Parameters:  []*ogen.Parameter{id},
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "User enabled"},
+				"404": {Ref: "#/components/responses/404"},
+				"409": {Ref: "#/components/responses/409"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func addTotpOperations(spec *ogen.Spec) {
+	u6 := uint64(6)
+	u64 := uint64(64)
+	code := &ogen.Schema{
+		Type:        "object",
+		Description: "One-time code of the authenticator app, or a recovery code",
+		Properties: []ogen.Property{
+			{
+				Name: "code",
+				Schema: &ogen.Schema{
+					Type: "string", MinLength: &u6, MaxLength: &u64,
+				},
+			},
+		},
+		Required: []string{"code"},
+	}
+	spec.Components.Schemas["TotpEnrollment"] = &ogen.Schema{
+		Type: "object",
+		Properties: []ogen.Property{
+			{
+				Name: "secret",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "Base32 encoded secret for manual entry",
+				},
+			},
+			{
+				Name: "provisioning_uri",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "`otpauth://` URI to render as QR code",
+				},
+			},
+		},
+		Required: []string{"secret", "provisioning_uri"},
+	}
+	spec.Components.Schemas["LoginChallenge"] = &ogen.Schema{
+		Type:        "object",
+		Description: "Challenge to complete login with a second factor",
+		Properties: []ogen.Property{
+			{Name: "challenge_token", Schema: &ogen.Schema{Type: "string"}},
+			{
+				Name:   "expires_at",
+				Schema: &ogen.Schema{Type: "string", Format: "date-time"},
+			},
+		},
+		Required: []string{"challenge_token", "expires_at"},
+	}
+	// defaults to false
+	sch := spec.Paths["/roles"].Post.RequestBody.
+		Content["application/json"].Schema
+	if i := slices.Index(sch.Required, "require_mfa"); i > -1 {
+		sch.Required = slices.Delete(sch.Required, i, i+1)
+	}
+	login := spec.Paths["/login"].Post
+	login.Responses["202"] = &ogen.Response{
+		Description: "Password accepted, a one-time code is required",
+		Content: map[string]ogen.Media{
+			"application/json": {
+				Schema: &ogen.Schema{
+					Ref: "#/components/schemas/LoginChallenge",
+				},
+			},
+		},
+	}
+	spec.Paths["/login/totp"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Complete login with a one-time code",
+			OperationID: "verifyTotp",
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Type: "object",
+							Properties: []ogen.Property{
+								{
+									Name:   "challenge_token",
+									Schema: &ogen.Schema{Type: "string"},
+								},
+								code.Properties[0],
+							},
+							Required: []string{"challenge_token", "code"},
+						},
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"200": login.Responses["200"],
+				"400": {Ref: "#/components/responses/400"},
+				"429": {Ref: "#/components/responses/429"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/me/totp"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Start TOTP enrollment of the current user",
+			OperationID: "enrollTotp",
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "Secret to register in the authenticator app",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Ref: "#/components/schemas/TotpEnrollment",
+							},
+						},
+					},
+				},
+				"409": {Ref: "#/components/responses/409"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/me/totp/confirm"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Enable TOTP with the first code of the authenticator",
+			OperationID: "confirmTotp",
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content: map[string]ogen.Media{
+					"application/json": {Schema: code},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "TOTP enabled, recovery codes are shown once",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Type: "object",
+								Properties: []ogen.Property{
+									{
+										Name: "recovery_codes",
+										Schema: &ogen.Schema{
+											Type: "array",
+											Items: &ogen.Items{
+												Item: &ogen.Schema{
+													Type: "string",
+												},
+											},
+										},
+									},
+								},
+								Required: []string{"recovery_codes"},
+							},
+						},
+					},
+				},
+				"400": {Ref: "#/components/responses/400"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/me/totp/disable"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Disable TOTP of the current user",
+			OperationID: "disableTotp",
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content: map[string]ogen.Media{
+					"application/json": {Schema: code},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "TOTP disabled"},
+				"400": {Ref: "#/components/responses/400"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func addWebauthnOperations(spec *ogen.Spec) {
+	sch := spec.Components.Schemas["Credential"]
+	i, _ := findPropertyByName(sch.Properties, "user")
+	sch.Properties = append(sch.Properties[:i], sch.Properties[i+1:]...)
+	sch.Required = slices.DeleteFunc(
+		sch.Required, func(s string) bool { return "user" == s },
+	)
+	// passkeys are managed by their owners only
+	usr := spec.Components.Schemas["User"]
+	i, _ = findPropertyByName(usr.Properties, "credentials")
+	usr.Properties = append(usr.Properties[:i], usr.Properties[i+1:]...)
+	removeProperties(spec.Paths["/users"].Post, "credentials")
+	removeProperties(spec.Paths["/user/{id}"].Patch, "credentials")
+	spec.Components.Schemas["WebauthnCeremony"] = &ogen.Schema{
+		Type:        "object",
+		Description: "Options to pass to the browser's WebAuthn API",
+		Properties: []ogen.Property{
+			{
+				Name: "session_token",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "Token to send back to finish the ceremony",
+				},
+			},
+			{
+				Name: "options",
+				Schema: &ogen.Schema{
+					Type:        "object",
+					Description: "`CredentialCreationOptions` or `CredentialRequestOptions`",
+				},
+			},
+		},
+		Required: []string{"session_token", "options"},
+	}
+	ceremony := &ogen.Response{
+		Description: "Ceremony started",
+		Content: map[string]ogen.Media{
+			"application/json": {
+				Schema: &ogen.Schema{
+					Ref: "#/components/schemas/WebauthnCeremony",
+				},
+			},
+		},
+	}
+	body := func(props ...ogen.Property) *ogen.RequestBody {
+		return &ogen.RequestBody{
+			Required: true,
+			Content: map[string]ogen.Media{
+				"application/json": {
+					Schema: &ogen.Schema{
+						Type: "object",
+						Properties: append(
+							[]ogen.Property{
+								{
+									Name:   "session_token",
+									Schema: &ogen.Schema{Type: "string"},
+								},
+								{
+									Name: "credential",
+									Schema: &ogen.Schema{
+										Type:        "object",
+										Description: "`PublicKeyCredential` returned by the browser",
+									},
+								},
+							}, props...,
+						),
+						Required: []string{"session_token", "credential"},
+					},
+				},
+			},
+		}
+	}
+	u255 := uint64(255)
+	spec.Paths["/me/webauthn/register"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Start registering a passkey of the current user",
+			OperationID: "beginWebauthnRegistration",
+			Responses: map[string]*ogen.Response{
+				"200": ceremony,
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/me/webauthn/register/finish"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Store the passkey created by the authenticator",
+			OperationID: "finishWebauthnRegistration",
+			RequestBody: body(
+				ogen.Property{
+					Name: "name",
+					Schema: &ogen.Schema{
+						Type:        "string",
+						MaxLength:   &u255,
+						Description: "Name to tell the passkey apart",
+					},
+				},
+			),
+			Responses: map[string]*ogen.Response{
+				"201": {
+					Description: "Passkey registered",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Ref: "#/components/schemas/Credential",
+							},
+						},
+					},
+				},
+				"400": {Ref: "#/components/responses/400"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/login/webauthn"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Start signing in with a passkey",
+			OperationID: "beginWebauthnLogin",
+			Responses: map[string]*ogen.Response{
+				"200": ceremony,
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/login/webauthn/finish"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Sign in with the assertion of the authenticator",
+			OperationID: "finishWebauthnLogin",
+			RequestBody: body(),
+			Responses: map[string]*ogen.Response{
+				"200": spec.Paths["/login"].Post.Responses["200"],
+				"429": {Ref: "#/components/responses/429"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func addPasswordOperations(spec *ogen.Spec) {
+	// previous passwords are never exposed
+	delete(spec.Components.Schemas, "PasswordHistory")
+	usr := spec.Components.Schemas["User"]
+	i, _ := findPropertyByName(usr.Properties, "password_history")
+	usr.Properties = append(usr.Properties[:i], usr.Properties[i+1:]...)
+	removeProperties(spec.Paths["/users"].Post, "password_history")
+	removeProperties(spec.Paths["/user/{id}"].Patch, "password_history")
+	body := spec.Paths["/users"].Post.RequestBody.Content["application/json"].Schema
+	_, pwd := findPropertyByName(body.Properties, "password")
+	spec.Paths["/me/password"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Change password of the current user",
+			Description: "Other sessions of the user are signed out, the current one gets new tokens.",
+			OperationID: "changePassword",
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Type: "object",
+							Properties: []ogen.Property{
+								{
+									Name:   "current_password",
+									Schema: &ogen.Schema{Type: "string"},
+								},
+								{Name: "password", Schema: pwd.Schema},
+							},
+							Required: []string{"current_password", "password"},
+						},
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "Password changed"},
+				"400": {Ref: "#/components/responses/400"},
+				"429": {Ref: "#/components/responses/429"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/user/{id}/password"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Set a temporary password of the user",
+			Description: "The user has to change it after signing in, and all sessions of the user are signed out.",
+			OperationID: "setUserPassword",
+			Parameters: []*ogen.Parameter{
+				{
+					Name:        "id",
+					In:          "path",
+					Description: "ID of the user",
+					Required:    true,
+					Schema: &ogen.Schema{
+						Type:    "integer",
+						Format:  "uint64",
+						Minimum: ogen.Num("1"),
+					},
+				},
+			},
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Type: "object",
+							Properties: []ogen.Property{
+								{Name: "password", Schema: pwd.Schema},
+							},
+							Required: []string{"password"},
+						},
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "Password set"},
+				"400": {Ref: "#/components/responses/400"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func addPasswordResetOperations(spec *ogen.Spec) {
+	// reset tokens are never exposed
+	delete(spec.Components.Schemas, "PasswordReset")
+	usr := spec.Components.Schemas["User"]
+	i, _ := findPropertyByName(usr.Properties, "password_resets")
+	usr.Properties = append(usr.Properties[:i], usr.Properties[i+1:]...)
+	removeProperties(spec.Paths["/users"].Post, "password_resets")
+	removeProperties(spec.Paths["/user/{id}"].Patch, "password_resets")
+	login := spec.Paths["/login"].Post.RequestBody.Content["application/json"].Schema
+	_, org := findPropertyByName(login.Properties, "organization")
+	body := spec.Paths["/users"].Post.RequestBody.Content["application/json"].Schema
+	_, pwd := findPropertyByName(body.Properties, "password")
+	spec.Paths["/password/forgot"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Send a password reset token to the email of the user",
+			Description: "The response is the same whether the account exists or not.",
+			OperationID: "forgotPassword",
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Type: "object",
+							Properties: []ogen.Property{
+								{
+									Name: "email",
+									Schema: &ogen.Schema{
+										Type:   "string",
+										Format: "email",
+									},
+								},
+								{Name: "organization", Schema: org.Schema},
+							},
+							Required: []string{"email"},
+						},
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"202": {Description: "Reset token sent if the account exists"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/password/reset"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Set a new password with a reset token",
+			Description: "All sessions of the user are signed out.",
+			OperationID: "resetPassword",
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Type: "object",
+							Properties: []ogen.Property{
+								{
+									Name: "token",
+									Schema: &ogen.Schema{
+										Type:        "string",
+										Description: "Token received by email",
+									},
+								},
+								{Name: "password", Schema: pwd.Schema},
+							},
+							Required: []string{"token", "password"},
+						},
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "Password reset"},
+				"400": {Ref: "#/components/responses/400"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func addOidcOperations(spec *ogen.Spec) {
+	// linked identities are only used to sign in
+	delete(spec.Components.Schemas, "LinkedIdentity")
+	usr := spec.Components.Schemas["User"]
+	i, _ := findPropertyByName(usr.Properties, "identities")
+	usr.Properties = append(usr.Properties[:i], usr.Properties[i+1:]...)
+	removeProperties(spec.Paths["/users"].Post, "identities")
+	removeProperties(spec.Paths["/user/{id}"].Patch, "identities")
+	provider := &ogen.Parameter{
+		Name:        "provider",
+		In:          "path",
+		Description: "Name of the OpenID Connect provider",
+		Required:    true,
+		Schema:      &ogen.Schema{Type: "string"},
+	}
+	spec.Paths["/login/oidc/{provider}"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Start signing in with an OpenID Connect provider",
+			Description: "Send the browser to the authorization URL, the provider redirects back to the frontend with the `code` and `state` query parameters.",
+			OperationID: "beginOidcLogin",
+			Parameters:  []*ogen.Parameter{provider},
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "Authorization started",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Type: "object",
+								Properties: []ogen.Property{
+									{
+										Name: "authorization_url",
+										Schema: &ogen.Schema{
+											Type:   "string",
+											Format: "uri",
+										},
+									},
+									{
+										Name: "session_token",
+										Schema: &ogen.Schema{
+											Type:        "string",
+											Description: "Token to send back to finish signing in",
+										},
+									},
+								},
+								Required: []string{
+									"authorization_url", "session_token",
+								},
+							},
+						},
+					},
+				},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/login/oidc/{provider}/finish"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Sign in with the authorization code of the provider",
+			OperationID: "finishOidcLogin",
+			Parameters:  []*ogen.Parameter{provider},
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content: map[string]ogen.Media{
+					"application/json": {
+						Schema: &ogen.Schema{
+							Type: "object",
+							Properties: []ogen.Property{
+								{
+									Name:   "session_token",
+									Schema: &ogen.Schema{Type: "string"},
+								},
+								{
+									Name:   "code",
+									Schema: &ogen.Schema{Type: "string"},
+								},
+								{
+									Name:   "state",
+									Schema: &ogen.Schema{Type: "string"},
+								},
+							},
+							Required: []string{"session_token", "code", "state"},
+						},
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"200": spec.Paths["/login"].Post.Responses["200"],
+				"401": {Ref: "#/components/responses/401"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+func addOauthOperations(spec *ogen.Spec) {
+	// authorization codes are only used by the token endpoint
+	delete(spec.Components.Schemas, "OauthCode")
+	usr := spec.Components.Schemas["User"]
+	for _, edge := range []string{"oauth_clients", "oauth_codes"} {
+		i, _ := findPropertyByName(usr.Properties, edge)
+		usr.Properties = append(usr.Properties[:i], usr.Properties[i+1:]...)
+	}
+	removeProperties(spec.Paths["/users"].Post, "oauth_clients", "oauth_codes")
+	removeProperties(
+		spec.Paths["/user/{id}"].Patch, "oauth_clients", "oauth_codes",
+	)
+	client := spec.Components.Schemas["OauthClient"]
+	for _, edge := range []string{"user", "codes"} {
+		i, _ := findPropertyByName(client.Properties, edge)
+		client.Properties = append(
+			client.Properties[:i], client.Properties[i+1:]...,
+		)
+	}
+	spec.Paths["/oauth-client/{id}"] = spec.Paths["/oauth-clients/{id}"]
+	delete(spec.Paths, "/oauth-clients/{id}")
+	paginate.AttachTo(
+		spec.Paths["/oauth-clients"].Get,
+		"Paginated list of OAuth clients",
+		spec.Paths["/oauth-clients"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	create := spec.Paths["/oauth-clients"].Post
+	removeProperties(create, "user", "codes", "organization")
+	// organization_id is optional on creation, it defaults to the current
+	// user's organization
+	sch := create.RequestBody.Content["application/json"].Schema
+	sch.Required = []string{"name", "grant_types"}
+	created := spec.Components.Schemas["OauthClientCreate"]
+	created.Properties = append(
+		created.Properties, ogen.Property{
+			Name: "client_secret",
+			Schema: &ogen.Schema{
+				Type:        "string",
+				Description: "Secret of confidential clients, only returned on creation",
+			},
+		},
+	)
+	addOauthProtocolOperations(spec)
+}
+
+func addServiceAccountOperations(spec *ogen.Spec) {
+	for name, edge := range map[string]string{
+		"User": "service_account", "Group": "service_accounts",
+	} {
+		sch := spec.Components.Schemas[name]
+		i, _ := findPropertyByName(sch.Properties, edge)
+		sch.Properties = append(sch.Properties[:i], sch.Properties[i+1:]...)
+	}
+	for _, op := range []*ogen.Operation{
+		spec.Paths["/users"].Post, spec.Paths["/user/{id}"].Patch,
+	} {
+		removeProperties(op, "service_account")
+	}
+	for _, op := range []*ogen.Operation{
+		spec.Paths["/groups"].Post, spec.Paths["/group/{id}"].Patch,
+	} {
+		removeProperties(op, "service_accounts")
+	}
+	account := spec.Components.Schemas["ServiceAccount"]
+	for _, edge := range []string{"owner", "user"} {
+		i, _ := findPropertyByName(account.Properties, edge)
+		account.Properties = append(
+			account.Properties[:i], account.Properties[i+1:]...,
+		)
+		i = slices.Index(account.Required, edge)
+		account.Required = slices.Delete(account.Required, i, i+1)
+	}
+	spec.Paths["/service-account/{id}"] = spec.Paths["/service-accounts/{id}"]
+	delete(spec.Paths, "/service-accounts/{id}")
+	paginate.AttachTo(
+		spec.Paths["/service-accounts"].Get,
+		"Paginated list of service accounts",
+		spec.Paths["/service-accounts"].Get.Responses["200"].
+			Content["application/json"].Schema.Items.Item.Ref,
+	)
+	u4096 := uint64(4096)
+	create := spec.Paths["/service-accounts"].Post
+	removeProperties(create, "owner", "user")
+	sch := create.RequestBody.Content["application/json"].Schema
+	sch.Properties = append(
+		sch.Properties, ogen.Property{
+			Name: "public_key",
+			Schema: &ogen.Schema{
+				Type:        "string",
+				MaxLength:   &u4096,
+				Description: "PEM encoded public key verifying the JWT assertions the account authenticates with. A client secret is generated if omitted.",
+			},
+		},
+	)
+	sch.Required = []string{"name", "owner_id"}
+	created := spec.Components.Schemas["ServiceAccountCreate"]
+	created.Properties = append(
+		created.Properties, ogen.Property{
+			Name: "client_secret",
+			Schema: &ogen.Schema{
+				Type:        "string",
+				Description: "Secret of accounts without public key, only returned on creation",
+			},
+		},
+	)
+}
+
+// Adds the endpoints listing and signing out the sessions of users, and
+// revoking their tokens.
+func addSessionOperations(spec *ogen.Spec) {
+	// sessions are only exposed through the endpoints below
+	usr := spec.Components.Schemas["User"]
+	i, _ := findPropertyByName(usr.Properties, "sessions")
+	usr.Properties = append(usr.Properties[:i], usr.Properties[i+1:]...)
+	removeProperties(spec.Paths["/users"].Post, "sessions")
+	removeProperties(spec.Paths["/user/{id}"].Patch, "sessions")
+	sch := spec.Components.Schemas["Session"]
+	i, _ = findPropertyByName(sch.Properties, "user")
+	sch.Properties = append(sch.Properties[:i], sch.Properties[i+1:]...)
+	sch.Required = slices.DeleteFunc(
+		sch.Required, func(s string) bool { return "user" == s },
+	)
+	sch.Properties = append(
+		sch.Properties, ogen.Property{
+			Name: "current",
+			Schema: &ogen.Schema{
+				Type:        "boolean",
+				Description: "Whether it is the session making the request",
+			},
+		},
+	)
+	sch.Required = append(sch.Required, "current")
+	id := func(desc string) []*ogen.Parameter {
+		return []*ogen.Parameter{
+			{
+				Name:        "id",
+				In:          "path",
+				Description: desc,
+				Required:    true,
+				Schema: &ogen.Schema{
+					Type:    "integer",
+					Format:  "uint64",
+					Minimum: ogen.Num("1"),
+				},
+			},
+		}
+	}
+	spec.Paths["/me/sessions"] = &ogen.PathItem{
+		Get: &ogen.Operation{
+			Summary:     "List active sessions of the current user",
+			OperationID: "listMySessions",
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "Sessions, most recently active first",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Type: "array",
+								Items: &ogen.Items{
+									Item: &ogen.Schema{
+										Ref: "#/components/schemas/Session",
+									},
+								},
+							},
+						},
+					},
+				},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/me/sessions/{id}"] = &ogen.PathItem{
+		Delete: &ogen.Operation{
+			Summary:     "Sign out a session of the current user",
+			Description: "Tokens of the session are revoked.",
+			OperationID: "deleteMySession",
+			Parameters:  id("ID of the session"),
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "Session signed out"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/user/{id}/sessions/revoke-all"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Sign out all sessions of the user",
+			Description: "Tokens of the sessions are revoked.",
+			OperationID: "revokeUserSessions",
+			Parameters:  id("ID of the user"),
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "Sessions signed out"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/user/{id}/revoke-tokens"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Revoke all tokens of the user",
+			Description: "Access, refresh and personal tokens issued so far are rejected, and all sessions are signed out.",
+			OperationID: "revokeUserTokens",
+			Parameters:  id("ID of the user"),
+			Responses: map[string]*ogen.Response{
+				"204": {Description: "Tokens revoked"},
+				"404": {Ref: "#/components/responses/404"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+}
+
+// Adds the endpoints of the OAuth 2.0 authorization server. They follow RFC
+// 6749 and OpenID Connect instead of the conventions of the other endpoints.
+func addOauthProtocolOperations(spec *ogen.Spec) {
+	str := &ogen.Schema{Type: "string"}
+	list := &ogen.Schema{Type: "array", Items: &ogen.Items{Item: str}}
+	query := func(name, description string) *ogen.Parameter {
+		return &ogen.Parameter{
+			Name:        name,
+			In:          "query",
+			Description: description,
+			Schema:      str,
+		}
+	}
+	oauthError := &ogen.Response{
+		Description: "OAuth error",
+		Content: map[string]ogen.Media{
+			"application/json": {
+				Schema: &ogen.Schema{
+					Type: "object",
+					Properties: []ogen.Property{
+						{Name: "error", Schema: str},
+						{Name: "error_description", Schema: str},
+					},
+					Required: []string{"error"},
+				},
+			},
+		},
+	}
+	spec.Paths["/oauth/authorize"] = &ogen.PathItem{
+		Get: &ogen.Operation{
+			Summary:     "Authorize an OAuth client",
+			Description: "Issues an authorization code to the client for the signed in user, and redirects the browser back to the client. The PKCE S256 code challenge is required. Users without session are sent to the login page of the frontend, if configured.",
+			OperationID: "oauthAuthorize",
+			Parameters: []*ogen.Parameter{
+				query("response_type", "Must be `code`"),
+				query("client_id", "Client to issue the code to"),
+				query("redirect_uri", "One of the redirect URIs of the client"),
+				query("scope", "Scopes separated by space"),
+				query("state", "Returned to the client unchanged"),
+				query("nonce", "OpenID Connect nonce"),
+				query("code_challenge", "PKCE code challenge"),
+				query("code_challenge_method", "Must be `S256`"),
+			},
+			Responses: map[string]*ogen.Response{
+				"302": {
+					Description: "Redirect to the client or the login page",
+					Headers: map[string]*ogen.Header{
+						"Location": {Required: true, Schema: str},
+					},
+				},
+				"400": {Ref: "#/components/responses/400"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	// the request validator decodes missing form fields as null
+	field := &ogen.Schema{Type: "string", Nullable: true}
+	spec.Paths["/oauth/token"] = &ogen.PathItem{
+		Post: &ogen.Operation{
+			Summary:     "Issue tokens to an OAuth client",
+			Description: "Confidential clients authenticate with HTTP basic authentication, the `client_id` and `client_secret` parameters, or a JWT assertion signed with the key of the client (RFC 7523).",
+			OperationID: "oauthToken",
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content: map[string]ogen.Media{
+					"application/x-www-form-urlencoded": {
+						Schema: &ogen.Schema{
+							Type: "object",
+							Properties: []ogen.Property{
+								{Name: "grant_type", Schema: str},
+								{Name: "code", Schema: field},
+								{Name: "redirect_uri", Schema: field},
+								{Name: "code_verifier", Schema: field},
+								{Name: "refresh_token", Schema: field},
+								{Name: "scope", Schema: field},
+								{Name: "client_id", Schema: field},
+								{Name: "client_secret", Schema: field},
+								{Name: "client_assertion_type", Schema: field},
+								{Name: "client_assertion", Schema: field},
+							},
+							Required: []string{"grant_type"},
+						},
+					},
+				},
+			},
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "Tokens issued",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Type: "object",
+								Properties: []ogen.Property{
+									{Name: "access_token", Schema: str},
+									{Name: "token_type", Schema: str},
+									{
+										Name:   "expires_in",
+										Schema: &ogen.Schema{Type: "integer"},
+									},
+									{Name: "refresh_token", Schema: str},
+									{Name: "id_token", Schema: str},
+									{Name: "scope", Schema: str},
+								},
+								Required: []string{
+									"access_token", "token_type", "expires_in",
+								},
+							},
+						},
+					},
+				},
+				"400": oauthError,
+				"401": oauthError,
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/userinfo"] = &ogen.PathItem{
+		Get: &ogen.Operation{
+			Summary:     "Claims of the user of an OAuth access token",
+			Description: "Only accepts access tokens issued by `/oauth/token` with the `openid` scope.",
+			OperationID: "oauthUserinfo",
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "Claims of the user",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Type: "object",
+								Properties: []ogen.Property{
+									{Name: "sub", Schema: str},
+									{Name: "preferred_username", Schema: str},
+									{
+										Name: "email",
+										Schema: &ogen.Schema{
+											Type:   "string",
+											Format: "email",
+										},
+									},
+								},
+								Required: []string{"sub"},
+							},
+						},
+					},
+				},
+				"401": {Ref: "#/components/responses/401"},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/oauth/jwks"] = &ogen.PathItem{
+		Get: &ogen.Operation{
+			Summary:     "Keys verifying ID tokens",
+			OperationID: "oauthJwks",
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "JSON Web Key Set",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Type: "object",
+								Properties: []ogen.Property{
+									{
+										Name: "keys",
+										Schema: &ogen.Schema{
+											Type: "array",
+											Items: &ogen.Items{
+												Item: &ogen.Schema{
+													Type: "object",
+													AdditionalProperties: &ogen.AdditionalProperties{
+														Schema: *str,
+													},
+												},
+											},
+										},
+									},
+								},
+								Required: []string{"keys"},
+							},
+						},
+					},
+				},
+				"500": {Ref: "#/components/responses/500"},
+			},
+		},
+	}
+	spec.Paths["/.well-known/openid-configuration"] = &ogen.PathItem{
+		Get: &ogen.Operation{
+			Summary:     "OpenID Connect discovery document",
+			OperationID: "getOpenidConfiguration",
+			Responses: map[string]*ogen.Response{
+				"200": {
+					Description: "Provider metadata",
+					Content: map[string]ogen.Media{
+						"application/json": {
+							Schema: &ogen.Schema{
+								Type: "object",
+								Properties: []ogen.Property{
+									{Name: "issuer", Schema: str},
+									{Name: "authorization_endpoint", Schema: str},
+									{Name: "token_endpoint", Schema: str},
+									{Name: "userinfo_endpoint", Schema: str},
+									{Name: "jwks_uri", Schema: str},
+									{Name: "response_types_supported", Schema: list},
+									{Name: "grant_types_supported", Schema: list},
+									{Name: "subject_types_supported", Schema: list},
+									{Name: "id_token_signing_alg_values_supported", Schema: list},
+									{Name: "scopes_supported", Schema: list},
+									{Name: "token_endpoint_auth_methods_supported", Schema: list},
+									{Name: "code_challenge_methods_supported", Schema: list},
+									{Name: "claims_supported", Schema: list},
+								},
+								Required: []string{
+									"issuer", "authorization_endpoint",
+									"token_endpoint", "userinfo_endpoint",
+									"jwks_uri", "response_types_supported",
+									"grant_types_supported",
+									"subject_types_supported",
+									"id_token_signing_alg_values_supported",
+									"scopes_supported",
+									"token_endpoint_auth_methods_supported",
+									"code_challenge_methods_supported",
+									"claims_supported",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func findPropertyByName(props []ogen.Property, name string) (
+	int, *ogen.Property,
+) {
+	for i, prop := range props {
+		if prop.Name == name {
+			return i, &prop
+		}
+	}
+	return -1, nil
+}
+
+func removeProperties(op *ogen.Operation, props ...string) {
+	sch := op.RequestBody.Content["application/json"].Schema
+	for _, prop := range props {
+		if i, _ := findPropertyByName(sch.Properties, prop); i > -1 {
+			sch.Properties = append(
+				sch.Properties[:i], sch.Properties[i+1:]...,
+			)
+		}
+		if i := slices.Index(sch.Required, prop); i > -1 {
+			sch.Required = slices.Delete(sch.Required, i, i+1)
 		}
 	}
-	return -1, nil
 }
 
 func nameParam(subject string) *ogen.Parameter {